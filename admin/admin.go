@@ -18,6 +18,7 @@ type Options struct {
 	ProvisionerSetJSON string
 	DefaultProvisioner string
 	ExternalURL        string
+	FrontendURL        string
 	VersionNumber      string
 	VersionCommit      string
 	MetricsProjectOrg  string
@@ -32,6 +33,7 @@ type Service struct {
 	Github           Github
 	AI               ai.Client
 	Used             *usedFlusher
+	previews         *previewDeployer
 	Logger           *zap.Logger
 	opts             *Options
 	issuer           *auth.Issuer
@@ -96,6 +98,7 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Is
 		Github:           github,
 		AI:               aiClient,
 		Used:             newUsedFlusher(logger, db),
+		previews:         newPreviewDeployer(),
 		Logger:           logger,
 		opts:             opts,
 		issuer:           issuer,
@@ -107,6 +110,7 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Is
 }
 
 func (s *Service) Close() error {
+	s.previews.Close()
 	s.Used.Close()
 	return s.DB.Close()
 }
@@ -0,0 +1,80 @@
+package admin
+
+import (
+	"context"
+	"sort"
+
+	"github.com/rilldata/rill/admin/database"
+)
+
+// AuditActor identifies the principal that made an audited change.
+type AuditActor struct {
+	Type database.AuditActorType
+	ID   string
+	// Name is the email of a user or the name of a service.
+	Name string
+	// Superuser is true if the change was made by a superuser, which may not be a member of the org.
+	Superuser bool
+}
+
+// AuditEvent describes a change to record in an org's audit log.
+type AuditEvent struct {
+	OrgID string
+	// Project is the project the change applies to. It is nil for org-level changes.
+	Project *database.Project
+	Action  string
+	Target  string
+	Details map[string]any
+}
+
+// RecordAuditEvent appends an event to an org's audit log.
+// It should be called with the ctx of the transaction that applies the change, so the event is persisted if and only if the change is committed.
+func (s *Service) RecordAuditEvent(ctx context.Context, actor *AuditActor, evt *AuditEvent) error {
+	opts := &database.InsertAuditEventOptions{
+		OrgID:          evt.OrgID,
+		ActorType:      actor.Type,
+		ActorID:        actor.ID,
+		ActorName:      actor.Name,
+		ActorSuperuser: actor.Superuser,
+		Action:         evt.Action,
+		Target:         evt.Target,
+		Details:        evt.Details,
+	}
+	if evt.Project != nil {
+		opts.ProjectID = &evt.Project.ID
+		opts.ProjectName = evt.Project.Name
+	}
+
+	_, err := s.DB.InsertAuditEvent(ctx, opts)
+	return err
+}
+
+// variablesDiff returns the names of variables added, changed and removed between two sets of variables.
+// It is used to record changes to variables without recording their values, which may be secrets.
+func variablesDiff(prev, next map[string]string) map[string]any {
+	added := []string{}
+	changed := []string{}
+	removed := []string{}
+	for k, v := range next {
+		pv, ok := prev[k]
+		if !ok {
+			added = append(added, k)
+		} else if pv != v {
+			changed = append(changed, k)
+		}
+	}
+	for k := range prev {
+		if _, ok := next[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(changed)
+	sort.Strings(removed)
+
+	return map[string]any{
+		"added":   added,
+		"changed": changed,
+		"removed": removed,
+	}
+}
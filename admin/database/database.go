@@ -96,6 +96,10 @@ type DB interface {
 	UpdateDeploymentRuntimeVersion(ctx context.Context, id, version string) (*Deployment, error)
 	UpdateDeploymentBranch(ctx context.Context, id, branch string) (*Deployment, error)
 	UpdateDeploymentUsedOn(ctx context.Context, ids []string) error
+	UpdateDeploymentExpiresOn(ctx context.Context, id string, expiresOn time.Time) (*Deployment, error)
+	FindPreviewDeployment(ctx context.Context, projectID string, pullRequest int) (*Deployment, error)
+	FindPreviewDeploymentsForBranch(ctx context.Context, projectID, branch string) ([]*Deployment, error)
+	FindExpiredPreviewDeployments(ctx context.Context) ([]*Deployment, error)
 	CountDeploymentsForOrganization(ctx context.Context, orgID string) (*DeploymentsCount, error)
 
 	ResolveRuntimeSlotsUsed(ctx context.Context) ([]*RuntimeSlotsUsed, error)
@@ -114,10 +118,21 @@ type DB interface {
 	CheckUserIsAnOrganizationMember(ctx context.Context, userID, orgID string) (bool, error)
 	CheckUserIsAProjectMember(ctx context.Context, userID, projectID string) (bool, error)
 
+	// CheckUserIsSCIMProvisioned checks if the user has been provisioned into the org through SCIM.
+	// Provisioning records are kept when the user is deprovisioned, so they can be used to scope re-activation.
+	CheckUserIsSCIMProvisioned(ctx context.Context, userID, orgID string) (bool, error)
+	InsertSCIMProvisionedUser(ctx context.Context, orgID, userID string) error
+
+	FindUsergroup(ctx context.Context, id string) (*Usergroup, error)
+	FindUsergroupByName(ctx context.Context, orgID, name string) (*Usergroup, error)
 	InsertUsergroup(ctx context.Context, opts *InsertUsergroupOptions) (*Usergroup, error)
+	UpdateUsergroup(ctx context.Context, id string, opts *UpdateUsergroupOptions) (*Usergroup, error)
+	DeleteUsergroup(ctx context.Context, id string) error
 	FindUsergroupsForUser(ctx context.Context, userID, orgID string) ([]*Usergroup, error)
+	FindUsergroupMemberUsers(ctx context.Context, groupID, afterEmail string, limit int) ([]*UsergroupMemberUser, error)
 	InsertUsergroupMember(ctx context.Context, groupID, userID string) error
 	DeleteUsergroupMember(ctx context.Context, groupID, userID string) error
+	DeleteUsergroupsMemberUser(ctx context.Context, orgID, userID string) error
 
 	FindUserAuthTokens(ctx context.Context, userID string) ([]*UserAuthToken, error)
 	FindUserAuthToken(ctx context.Context, id string) (*UserAuthToken, error)
@@ -158,21 +173,43 @@ type DB interface {
 	DeleteAuthorizationCode(ctx context.Context, code string) error
 	DeleteExpiredAuthorizationCodes(ctx context.Context, retention time.Duration) error
 
-	FindOrganizationRole(ctx context.Context, name string) (*OrganizationRole, error)
-	FindProjectRole(ctx context.Context, name string) (*ProjectRole, error)
+	FindOrganizationRoles(ctx context.Context, orgID string) ([]*OrganizationRole, error)
+	FindOrganizationRole(ctx context.Context, orgID, name string) (*OrganizationRole, error)
+	InsertOrganizationRole(ctx context.Context, opts *InsertOrganizationRoleOptions) (*OrganizationRole, error)
+	UpdateOrganizationRole(ctx context.Context, id string, opts *UpdateOrganizationRoleOptions) (*OrganizationRole, error)
+	DeleteOrganizationRole(ctx context.Context, id string) error
+	CheckOrganizationRoleIsUsed(ctx context.Context, id string) (bool, error)
+	FindProjectRoles(ctx context.Context, orgID string) ([]*ProjectRole, error)
+	FindProjectRole(ctx context.Context, orgID, name string) (*ProjectRole, error)
+	InsertProjectRole(ctx context.Context, opts *InsertProjectRoleOptions) (*ProjectRole, error)
+	UpdateProjectRole(ctx context.Context, id string, opts *UpdateProjectRoleOptions) (*ProjectRole, error)
+	DeleteProjectRole(ctx context.Context, id string) error
+	CheckProjectRoleIsUsed(ctx context.Context, id string) (bool, error)
 	ResolveOrganizationRolesForUser(ctx context.Context, userID, orgID string) ([]*OrganizationRole, error)
 	ResolveProjectRolesForUser(ctx context.Context, userID, projectID string) ([]*ProjectRole, error)
 
 	FindOrganizationMemberUsers(ctx context.Context, orgID, afterEmail string, limit int) ([]*Member, error)
+	FindOrganizationMemberUsersWithOffset(ctx context.Context, orgID string, offset, limit int) ([]*Member, error)
+	CountOrganizationMemberUsers(ctx context.Context, orgID string) (int, error)
 	FindOrganizationMemberUsersByRole(ctx context.Context, orgID, roleID string) ([]*User, error)
 	InsertOrganizationMemberUser(ctx context.Context, orgID, userID, roleID string) error
 	DeleteOrganizationMemberUser(ctx context.Context, orgID, userID string) error
 	UpdateOrganizationMemberUserRole(ctx context.Context, orgID, userID, roleID string) error
 	CountSingleuserOrganizationsForMemberUser(ctx context.Context, userID string) (int, error)
 
+	FindOrganizationMemberUsergroups(ctx context.Context, orgID, afterName string, limit int) ([]*MemberUsergroup, error)
+	FindOrganizationUnmanagedUsergroupsWithOffset(ctx context.Context, orgID string, offset, limit int) ([]*MemberUsergroup, error)
+	CountOrganizationUnmanagedUsergroups(ctx context.Context, orgID string) (int, error)
+	InsertOrganizationMemberUsergroup(ctx context.Context, groupID, orgID, roleID string) error
+	UpdateOrganizationMemberUsergroup(ctx context.Context, groupID, orgID, roleID string) error
+	DeleteOrganizationMemberUsergroup(ctx context.Context, groupID, orgID string) error
+
 	FindProjectMemberUsers(ctx context.Context, projectID, afterEmail string, limit int) ([]*Member, error)
 	InsertProjectMemberUser(ctx context.Context, projectID, userID, roleID string) error
+	FindProjectMemberUsergroups(ctx context.Context, projectID, afterName string, limit int) ([]*MemberUsergroup, error)
 	InsertProjectMemberUsergroup(ctx context.Context, groupID, projectID, roleID string) error
+	UpdateProjectMemberUsergroup(ctx context.Context, groupID, projectID, roleID string) error
+	DeleteProjectMemberUsergroup(ctx context.Context, groupID, projectID string) error
 	DeleteProjectMemberUser(ctx context.Context, projectID, userID string) error
 	DeleteAllProjectMemberUserForOrganization(ctx context.Context, orgID, userID string) error
 	UpdateProjectMemberUserRole(ctx context.Context, projectID, userID, roleID string) error
@@ -206,6 +243,10 @@ type DB interface {
 	UpsertVirtualFile(ctx context.Context, opts *InsertVirtualFileOptions) error
 	UpdateVirtualFileDeleted(ctx context.Context, projectID, branch, path string) error
 	DeleteExpiredVirtualFiles(ctx context.Context, retention time.Duration) error
+
+	// FindAuditEvents lists audit events in an org, most recent first.
+	FindAuditEvents(ctx context.Context, opts *FindAuditEventsOptions) ([]*AuditEvent, error)
+	InsertAuditEvent(ctx context.Context, opts *InsertAuditEventOptions) (*AuditEvent, error)
 }
 
 // Tx represents a database transaction. It can only be used to commit and rollback transactions.
@@ -283,6 +324,7 @@ type Project struct {
 	ProdSlots            int               `db:"prod_slots"`
 	ProdTTLSeconds       *int64            `db:"prod_ttl_seconds"`
 	ProdDeploymentID     *string           `db:"prod_deployment_id"`
+	PreviewDeployments   bool              `db:"preview_deployments"`
 	Annotations          map[string]string `db:"annotations"`
 	CreatedOn            time.Time         `db:"created_on"`
 	UpdatedOn            time.Time         `db:"updated_on"`
@@ -322,6 +364,7 @@ type UpdateProjectOptions struct {
 	ProdDeploymentID     *string
 	ProdSlots            int
 	ProdTTLSeconds       *int64
+	PreviewDeployments   bool
 	Annotations          map[string]string
 }
 
@@ -337,6 +380,8 @@ const (
 
 // Deployment is a single deployment of a git branch.
 // Deployments belong to a project.
+// A project has at most one prod deployment (referenced by Project.ProdDeploymentID) and any number of preview deployments.
+// Preview deployments deploy the branch of a Github pull request and are torn down when the pull request is closed or ExpiresOn is reached.
 type Deployment struct {
 	ID                string           `db:"id"`
 	ProjectID         string           `db:"project_id"`
@@ -353,6 +398,8 @@ type Deployment struct {
 	CreatedOn         time.Time        `db:"created_on"`
 	UpdatedOn         time.Time        `db:"updated_on"`
 	UsedOn            time.Time        `db:"used_on"`
+	PullRequest       *int             `db:"pull_request"`
+	ExpiresOn         *time.Time       `db:"expires_on"`
 }
 
 // InsertDeploymentOptions defines options for inserting a new Deployment.
@@ -368,6 +415,8 @@ type InsertDeploymentOptions struct {
 	RuntimeAudience   string
 	Status            DeploymentStatus
 	StatusMessage     string
+	PullRequest       *int
+	ExpiresOn         *time.Time
 }
 
 // RuntimeSlotsUsed is the result of a ResolveRuntimeSlotsUsed query.
@@ -436,9 +485,11 @@ type UpdateServiceOptions struct {
 
 // Usergroup represents a group of org members
 type Usergroup struct {
-	ID    string `db:"id"`
-	OrgID string `db:"org_id"`
-	Name  string `db:"name" validate:"slug"`
+	ID        string    `db:"id"`
+	OrgID     string    `db:"org_id"`
+	Name      string    `db:"name" validate:"slug"`
+	CreatedOn time.Time `db:"created_on"`
+	UpdatedOn time.Time `db:"updated_on"`
 }
 
 // InsertUsergroupOptions defines options for inserting a new usergroup
@@ -447,6 +498,19 @@ type InsertUsergroupOptions struct {
 	Name  string `validate:"slug"`
 }
 
+// UpdateUsergroupOptions defines options for updating an existing usergroup
+type UpdateUsergroupOptions struct {
+	Name string `validate:"slug"`
+}
+
+// UsergroupMemberUser is a user in a usergroup.
+type UsergroupMemberUser struct {
+	ID          string    `db:"id"`
+	Email       string    `db:"email"`
+	DisplayName string    `db:"display_name"`
+	CreatedOn   time.Time `db:"created_on"`
+}
+
 // UserAuthToken is a persistent API token for a user.
 type UserAuthToken struct {
 	ID                 string
@@ -571,36 +635,110 @@ const (
 )
 
 // OrganizationRole represents roles for orgs.
+// OrgID is nil for the built-in roles, which are available in all orgs.
+// Custom roles are defined by an org and are only available in that org.
 type OrganizationRole struct {
 	ID               string
+	OrgID            *string `db:"org_id"`
 	Name             string
-	ReadOrg          bool `db:"read_org"`
-	ManageOrg        bool `db:"manage_org"`
-	ReadProjects     bool `db:"read_projects"`
-	CreateProjects   bool `db:"create_projects"`
-	ManageProjects   bool `db:"manage_projects"`
-	ReadOrgMembers   bool `db:"read_org_members"`
-	ManageOrgMembers bool `db:"manage_org_members"`
+	ReadOrg          bool      `db:"read_org"`
+	ManageOrg        bool      `db:"manage_org"`
+	ReadProjects     bool      `db:"read_projects"`
+	CreateProjects   bool      `db:"create_projects"`
+	ManageProjects   bool      `db:"manage_projects"`
+	ReadOrgMembers   bool      `db:"read_org_members"`
+	ManageOrgMembers bool      `db:"manage_org_members"`
+	CreatedOn        time.Time `db:"created_on"`
+	UpdatedOn        time.Time `db:"updated_on"`
+}
+
+// InsertOrganizationRoleOptions defines options for inserting a custom org role.
+type InsertOrganizationRoleOptions struct {
+	OrgID            string `validate:"required"`
+	Name             string `validate:"slug"`
+	ReadOrg          bool
+	ManageOrg        bool
+	ReadProjects     bool
+	CreateProjects   bool
+	ManageProjects   bool
+	ReadOrgMembers   bool
+	ManageOrgMembers bool
+}
+
+// UpdateOrganizationRoleOptions defines options for updating a custom org role.
+type UpdateOrganizationRoleOptions struct {
+	Name             string `validate:"slug"`
+	ReadOrg          bool
+	ManageOrg        bool
+	ReadProjects     bool
+	CreateProjects   bool
+	ManageProjects   bool
+	ReadOrgMembers   bool
+	ManageOrgMembers bool
 }
 
 // ProjectRole represents roles for projects.
+// OrgID is nil for the built-in roles, which are available in all orgs.
+// Custom roles are defined by an org and are only available in the projects of that org.
 type ProjectRole struct {
 	ID                   string
+	OrgID                *string `db:"org_id"`
 	Name                 string
-	ReadProject          bool `db:"read_project"`
-	ManageProject        bool `db:"manage_project"`
-	ReadProd             bool `db:"read_prod"`
-	ReadProdStatus       bool `db:"read_prod_status"`
-	ManageProd           bool `db:"manage_prod"`
-	ReadDev              bool `db:"read_dev"`
-	ReadDevStatus        bool `db:"read_dev_status"`
-	ManageDev            bool `db:"manage_dev"`
-	ReadProjectMembers   bool `db:"read_project_members"`
-	ManageProjectMembers bool `db:"manage_project_members"`
-	CreateReports        bool `db:"create_reports"`
-	ManageReports        bool `db:"manage_reports"`
-	CreateAlerts         bool `db:"create_alerts"`
-	ManageAlerts         bool `db:"manage_alerts"`
+	ReadProject          bool      `db:"read_project"`
+	ManageProject        bool      `db:"manage_project"`
+	ReadProd             bool      `db:"read_prod"`
+	ReadProdStatus       bool      `db:"read_prod_status"`
+	ManageProd           bool      `db:"manage_prod"`
+	ReadDev              bool      `db:"read_dev"`
+	ReadDevStatus        bool      `db:"read_dev_status"`
+	ManageDev            bool      `db:"manage_dev"`
+	ReadProjectMembers   bool      `db:"read_project_members"`
+	ManageProjectMembers bool      `db:"manage_project_members"`
+	CreateReports        bool      `db:"create_reports"`
+	ManageReports        bool      `db:"manage_reports"`
+	CreateAlerts         bool      `db:"create_alerts"`
+	ManageAlerts         bool      `db:"manage_alerts"`
+	CreatedOn            time.Time `db:"created_on"`
+	UpdatedOn            time.Time `db:"updated_on"`
+}
+
+// InsertProjectRoleOptions defines options for inserting a custom project role.
+type InsertProjectRoleOptions struct {
+	OrgID                string `validate:"required"`
+	Name                 string `validate:"slug"`
+	ReadProject          bool
+	ManageProject        bool
+	ReadProd             bool
+	ReadProdStatus       bool
+	ManageProd           bool
+	ReadDev              bool
+	ReadDevStatus        bool
+	ManageDev            bool
+	ReadProjectMembers   bool
+	ManageProjectMembers bool
+	CreateReports        bool
+	ManageReports        bool
+	CreateAlerts         bool
+	ManageAlerts         bool
+}
+
+// UpdateProjectRoleOptions defines options for updating a custom project role.
+type UpdateProjectRoleOptions struct {
+	Name                 string `validate:"slug"`
+	ReadProject          bool
+	ManageProject        bool
+	ReadProd             bool
+	ReadProdStatus       bool
+	ManageProd           bool
+	ReadDev              bool
+	ReadDevStatus        bool
+	ManageDev            bool
+	ReadProjectMembers   bool
+	ManageProjectMembers bool
+	CreateReports        bool
+	ManageReports        bool
+	CreateAlerts         bool
+	ManageAlerts         bool
 }
 
 // Member is a convenience type used for display-friendly representation of an org or project member.
@@ -613,6 +751,16 @@ type Member struct {
 	UpdatedOn   time.Time `db:"updated_on"`
 }
 
+// MemberUsergroup is a usergroup and its role in an org or project.
+// RoleName is empty when listing the usergroups of an org that have no org role.
+type MemberUsergroup struct {
+	ID        string    `db:"id"`
+	Name      string    `db:"name"`
+	RoleName  string    `db:"role_name"`
+	CreatedOn time.Time `db:"created_on"`
+	UpdatedOn time.Time `db:"updated_on"`
+}
+
 // OrganizationInvite represents an outstanding invitation to join an org.
 type OrganizationInvite struct {
 	ID              string
@@ -761,3 +909,96 @@ type InsertVirtualFileOptions struct {
 	Path      string `validate:"required"`
 	Data      []byte `validate:"max=8192"` // 8kb
 }
+
+// AuditActorType is an enum of the types of principals that make audited changes.
+type AuditActorType string
+
+const (
+	AuditActorTypeUser       AuditActorType = "user"
+	AuditActorTypeService    AuditActorType = "service"
+	AuditActorTypeDeployment AuditActorType = "deployment"
+	AuditActorTypeSystem     AuditActorType = "system"
+)
+
+// Constants for the actions recorded in the audit log.
+const (
+	AuditActionOrganizationMemberAdd               = "org.member.add"
+	AuditActionOrganizationMemberRemove            = "org.member.remove"
+	AuditActionOrganizationMemberSetRole           = "org.member.set_role"
+	AuditActionOrganizationRoleCreate              = "org.role.create"
+	AuditActionOrganizationRoleUpdate              = "org.role.update"
+	AuditActionOrganizationRoleDelete              = "org.role.delete"
+	AuditActionOrganizationProjectRoleCreate       = "org.project_role.create"
+	AuditActionOrganizationProjectRoleUpdate       = "org.project_role.update"
+	AuditActionOrganizationProjectRoleDelete       = "org.project_role.delete"
+	AuditActionOrganizationUsergroupAdd            = "org.usergroup.add"
+	AuditActionOrganizationUsergroupRemove         = "org.usergroup.remove"
+	AuditActionOrganizationUsergroupSetRole        = "org.usergroup.set_role"
+	AuditActionOrganizationWhitelistedDomainAdd    = "org.whitelisted_domain.add"
+	AuditActionOrganizationWhitelistedDomainRemove = "org.whitelisted_domain.remove"
+	AuditActionProjectMemberAdd                    = "project.member.add"
+	AuditActionProjectMemberRemove                 = "project.member.remove"
+	AuditActionProjectMemberSetRole                = "project.member.set_role"
+	AuditActionProjectUsergroupAdd                 = "project.usergroup.add"
+	AuditActionProjectUsergroupRemove              = "project.usergroup.remove"
+	AuditActionProjectUsergroupSetRole             = "project.usergroup.set_role"
+	AuditActionProjectVariablesUpdate              = "project.variables.update"
+	AuditActionProjectWhitelistedDomainAdd         = "project.whitelisted_domain.add"
+	AuditActionProjectWhitelistedDomainRemove      = "project.whitelisted_domain.remove"
+	AuditActionServiceCreate                       = "service.create"
+	AuditActionServiceUpdate                       = "service.update"
+	AuditActionServiceDelete                       = "service.delete"
+	AuditActionServiceTokenIssue                   = "service.token.issue"
+	AuditActionServiceTokenRevoke                  = "service.token.revoke"
+	AuditActionUsergroupCreate                     = "usergroup.create"
+	AuditActionUsergroupRename                     = "usergroup.rename"
+	AuditActionUsergroupDelete                     = "usergroup.delete"
+	AuditActionUsergroupMemberAdd                  = "usergroup.member.add"
+	AuditActionUsergroupMemberRemove               = "usergroup.member.remove"
+)
+
+// AuditEvent is an entry in an org's append-only log of administrative changes.
+// The project and actor names are recorded at the time of the change, so they are retained if the project, user or service is later renamed or deleted.
+type AuditEvent struct {
+	ID             string
+	OrgID          string         `db:"org_id"`
+	ProjectID      *string        `db:"project_id"`
+	ProjectName    string         `db:"project_name"`
+	ActorType      AuditActorType `db:"actor_type"`
+	ActorID        string         `db:"actor_id"`
+	ActorName      string         `db:"actor_name"`
+	ActorSuperuser bool           `db:"actor_superuser"`
+	Action         string
+	Target         string
+	Details        map[string]any `db:"details"`
+	CreatedOn      time.Time      `db:"created_on"`
+}
+
+// InsertAuditEventOptions defines options for inserting an AuditEvent.
+type InsertAuditEventOptions struct {
+	OrgID          string `validate:"required"`
+	ProjectID      *string
+	ProjectName    string
+	ActorType      AuditActorType `validate:"required"`
+	ActorID        string
+	ActorName      string
+	ActorSuperuser bool
+	Action         string `validate:"required"`
+	Target         string
+	Details        map[string]any
+}
+
+// FindAuditEventsOptions defines filters and pagination for FindAuditEvents.
+// Zero values of the filter fields match all events.
+type FindAuditEventsOptions struct {
+	OrgID     string `validate:"required"`
+	ProjectID string
+	ActorName string
+	Action    string
+	After     time.Time
+	Before    time.Time
+	// BeforeCreatedOn and BeforeID are the position of the last event of the previous page.
+	BeforeCreatedOn time.Time
+	BeforeID        string
+	Limit           int `validate:"min=1"`
+}
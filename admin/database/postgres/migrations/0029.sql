@@ -0,0 +1,19 @@
+-- Append-only log of administrative changes in an org.
+-- The project and actor are denormalized so events are retained and readable after they are renamed or deleted.
+CREATE TABLE audit_events (
+    id UUID NOT NULL PRIMARY KEY DEFAULT uuid_generate_v4(),
+    org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+    project_id UUID,
+    project_name TEXT NOT NULL DEFAULT '',
+    actor_type TEXT NOT NULL,
+    actor_id TEXT NOT NULL DEFAULT '',
+    actor_name TEXT NOT NULL DEFAULT '',
+    actor_superuser BOOLEAN NOT NULL DEFAULT FALSE,
+    action TEXT NOT NULL,
+    target TEXT NOT NULL DEFAULT '',
+    details JSONB DEFAULT '{}'::JSONB NOT NULL,
+    created_on TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX audit_events_org_id_created_on_idx ON audit_events (org_id, created_on DESC, id DESC);
+CREATE INDEX audit_events_project_id_created_on_idx ON audit_events (project_id, created_on DESC, id DESC);
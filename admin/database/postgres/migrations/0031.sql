@@ -0,0 +1,13 @@
+ALTER TABLE org_roles ADD org_id UUID REFERENCES orgs (id) ON DELETE CASCADE;
+ALTER TABLE org_roles ADD created_on TIMESTAMPTZ DEFAULT now() NOT NULL;
+ALTER TABLE org_roles ADD updated_on TIMESTAMPTZ DEFAULT now() NOT NULL;
+DROP INDEX org_roles_name_idx;
+CREATE UNIQUE INDEX org_roles_name_idx ON org_roles (lower(name)) WHERE org_id IS NULL;
+CREATE UNIQUE INDEX org_roles_org_id_name_idx ON org_roles (org_id, lower(name)) WHERE org_id IS NOT NULL;
+
+ALTER TABLE project_roles ADD org_id UUID REFERENCES orgs (id) ON DELETE CASCADE;
+ALTER TABLE project_roles ADD created_on TIMESTAMPTZ DEFAULT now() NOT NULL;
+ALTER TABLE project_roles ADD updated_on TIMESTAMPTZ DEFAULT now() NOT NULL;
+DROP INDEX project_roles_name_idx;
+CREATE UNIQUE INDEX project_roles_name_idx ON project_roles (lower(name)) WHERE org_id IS NULL;
+CREATE UNIQUE INDEX project_roles_org_id_name_idx ON project_roles (org_id, lower(name)) WHERE org_id IS NOT NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE projects ADD COLUMN preview_deployments BOOLEAN DEFAULT false NOT NULL;
+ALTER TABLE deployments ADD COLUMN pull_request INTEGER;
+ALTER TABLE deployments ADD COLUMN expires_on TIMESTAMPTZ;
+CREATE UNIQUE INDEX deployments_project_id_pull_request_idx ON deployments (project_id, pull_request) WHERE pull_request IS NOT NULL;
+CREATE INDEX deployments_expires_on_idx ON deployments (expires_on) WHERE expires_on IS NOT NULL;
@@ -0,0 +1,6 @@
+CREATE TABLE scim_users (
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	PRIMARY KEY (org_id, user_id)
+);
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/XSAM/otelsql"
@@ -377,9 +378,9 @@ func (c *connection) UpdateProject(ctx context.Context, id string, opts *databas
 
 	res := &projectDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		UPDATE projects SET name=$1, description=$2, public=$3, prod_branch=$4, prod_variables=$5, github_url=$6, github_installation_id=$7, prod_deployment_id=$8, provisioner=$9, prod_slots=$10, prod_ttl_seconds=$11, annotations=$12, prod_version=$13, updated_on=now()
-		WHERE id=$14 RETURNING *`,
-		opts.Name, opts.Description, opts.Public, opts.ProdBranch, opts.ProdVariables, opts.GithubURL, opts.GithubInstallationID, opts.ProdDeploymentID, opts.Provisioner, opts.ProdSlots, opts.ProdTTLSeconds, opts.Annotations, opts.ProdVersion, id,
+		UPDATE projects SET name=$1, description=$2, public=$3, prod_branch=$4, prod_variables=$5, github_url=$6, github_installation_id=$7, prod_deployment_id=$8, provisioner=$9, prod_slots=$10, prod_ttl_seconds=$11, annotations=$12, prod_version=$13, preview_deployments=$14, updated_on=now()
+		WHERE id=$15 RETURNING *`,
+		opts.Name, opts.Description, opts.Public, opts.ProdBranch, opts.ProdVariables, opts.GithubURL, opts.GithubInstallationID, opts.ProdDeploymentID, opts.Provisioner, opts.ProdSlots, opts.ProdTTLSeconds, opts.Annotations, opts.ProdVersion, opts.PreviewDeployments, id,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
@@ -447,7 +448,7 @@ func (c *connection) FindExpiredDeployments(ctx context.Context) ([]*database.De
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT d.* FROM deployments d
 		JOIN projects p ON d.project_id = p.id
-		WHERE p.prod_ttl_seconds IS NOT NULL AND d.used_on + p.prod_ttl_seconds * interval '1 second' < now()
+		WHERE d.pull_request IS NULL AND p.prod_ttl_seconds IS NOT NULL AND d.used_on + p.prod_ttl_seconds * interval '1 second' < now()
 	`)
 	if err != nil {
 		return nil, parseErr("deployments", err)
@@ -489,9 +490,9 @@ func (c *connection) InsertDeployment(ctx context.Context, opts *database.Insert
 
 	res := &database.Deployment{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO deployments (project_id, provisioner, provision_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, runtime_version, status, status_message)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING *`,
-		opts.ProjectID, opts.Provisioner, opts.ProvisionID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.RuntimeVersion, opts.Status, opts.StatusMessage,
+		INSERT INTO deployments (project_id, provisioner, provision_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, runtime_version, status, status_message, pull_request, expires_on)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING *`,
+		opts.ProjectID, opts.Provisioner, opts.ProvisionID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.RuntimeVersion, opts.Status, opts.StatusMessage, opts.PullRequest, opts.ExpiresOn,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
@@ -530,6 +531,50 @@ func (c *connection) UpdateDeploymentUsedOn(ctx context.Context, ids []string) e
 	return nil
 }
 
+func (c *connection) UpdateDeploymentExpiresOn(ctx context.Context, id string, expiresOn time.Time) (*database.Deployment, error) {
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET expires_on=$1, updated_on=now() WHERE id=$2 RETURNING *", expiresOn, id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("deployment", err)
+	}
+	return res, nil
+}
+
+// FindPreviewDeployment returns the preview deployment of a pull request.
+func (c *connection) FindPreviewDeployment(ctx context.Context, projectID string, pullRequest int) (*database.Deployment, error) {
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM deployments d WHERE d.project_id=$1 AND d.pull_request=$2", projectID, pullRequest).StructScan(res)
+	if err != nil {
+		return nil, parseErr("deployment", err)
+	}
+	return res, nil
+}
+
+// FindPreviewDeploymentsForBranch returns the preview deployments of a branch, most recently created first.
+// There's usually at most one, but a branch can be the head of more than one open pull request.
+func (c *connection) FindPreviewDeploymentsForBranch(ctx context.Context, projectID, branch string) ([]*database.Deployment, error) {
+	var res []*database.Deployment
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT * FROM deployments d
+		WHERE d.project_id=$1 AND d.branch=$2 AND d.pull_request IS NOT NULL
+		ORDER BY d.created_on DESC
+	`, projectID, branch)
+	if err != nil {
+		return nil, parseErr("deployments", err)
+	}
+	return res, nil
+}
+
+// FindExpiredPreviewDeployments returns the preview deployments that have passed their expiration time.
+func (c *connection) FindExpiredPreviewDeployments(ctx context.Context) ([]*database.Deployment, error) {
+	var res []*database.Deployment
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM deployments d WHERE d.pull_request IS NOT NULL AND d.expires_on < now()")
+	if err != nil {
+		return nil, parseErr("deployments", err)
+	}
+	return res, nil
+}
+
 func (c *connection) UpdateDeploymentBranch(ctx context.Context, id, branch string) (*database.Deployment, error) {
 	res := &database.Deployment{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET branch=$1, updated_on=now() WHERE id=$2 RETURNING *", branch, id).StructScan(res)
@@ -688,6 +733,41 @@ func (c *connection) CheckUserIsAProjectMember(ctx context.Context, userID, proj
 	return res, nil
 }
 
+func (c *connection) CheckUserIsSCIMProvisioned(ctx context.Context, userID, orgID string) (bool, error) {
+	var res bool
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT EXISTS (SELECT 1 FROM scim_users WHERE user_id=$1 AND org_id=$2)", userID, orgID).Scan(&res)
+	if err != nil {
+		return false, parseErr("check", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertSCIMProvisionedUser(ctx context.Context, orgID, userID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO scim_users (org_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING", orgID, userID)
+	if err != nil {
+		return parseErr("scim user", err)
+	}
+	return nil
+}
+
+func (c *connection) FindUsergroup(ctx context.Context, id string) (*database.Usergroup, error) {
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM usergroups WHERE id=$1", id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindUsergroupByName(ctx context.Context, orgID, name string) (*database.Usergroup, error) {
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM usergroups WHERE org_id=$1 AND lower(name)=lower($2)", orgID, name).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
 func (c *connection) InsertUsergroup(ctx context.Context, opts *database.InsertUsergroupOptions) (*database.Usergroup, error) {
 	if err := database.Validate(opts); err != nil {
 		return nil, err
@@ -703,6 +783,28 @@ func (c *connection) InsertUsergroup(ctx context.Context, opts *database.InsertU
 	return res, nil
 }
 
+func (c *connection) UpdateUsergroup(ctx context.Context, id string, opts *database.UpdateUsergroupOptions) (*database.Usergroup, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE usergroups SET name=$1, updated_on=now()
+		WHERE id=$2 RETURNING *`,
+		opts.Name, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteUsergroup(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups WHERE id=$1", id)
+	return checkDeleteRow("usergroup", res, err)
+}
+
 func (c *connection) FindUsergroupsForUser(ctx context.Context, userID, orgID string) ([]*database.Usergroup, error) {
 	var res []*database.Usergroup
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
@@ -715,6 +817,20 @@ func (c *connection) FindUsergroupsForUser(ctx context.Context, userID, orgID st
 	return res, nil
 }
 
+func (c *connection) FindUsergroupMemberUsers(ctx context.Context, groupID, afterEmail string, limit int) ([]*database.UsergroupMemberUser, error) {
+	var res []*database.UsergroupMemberUser
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT u.id, u.email, u.display_name, uug.created_on FROM users u
+		JOIN usergroups_users uug ON u.id = uug.user_id
+		WHERE uug.usergroup_id=$1 AND lower(u.email) > lower($2)
+		ORDER BY lower(u.email) LIMIT $3
+	`, groupID, afterEmail, limit)
+	if err != nil {
+		return nil, parseErr("usergroup members", err)
+	}
+	return res, nil
+}
+
 func (c *connection) InsertUsergroupMember(ctx context.Context, groupID, userID string) error {
 	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO usergroups_users (user_id, usergroup_id) VALUES ($1, $2)", userID, groupID)
 	if err != nil {
@@ -728,6 +844,14 @@ func (c *connection) DeleteUsergroupMember(ctx context.Context, groupID, userID
 	return checkDeleteRow("usergroup member", res, err)
 }
 
+func (c *connection) DeleteUsergroupsMemberUser(ctx context.Context, orgID, userID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups_users uug WHERE uug.user_id = $1 AND uug.usergroup_id IN (SELECT ug.id FROM usergroups ug WHERE ug.org_id = $2)", userID, orgID)
+	if err != nil {
+		return parseErr("usergroup member", err)
+	}
+	return nil
+}
+
 func (c *connection) FindUserAuthTokens(ctx context.Context, userID string) ([]*database.UserAuthToken, error) {
 	var res []*database.UserAuthToken
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT t.* FROM user_auth_tokens t WHERE t.user_id=$1", userID)
@@ -1036,35 +1160,179 @@ func (c *connection) DeleteExpiredAuthorizationCodes(ctx context.Context, retent
 	return parseErr("authorization code", err)
 }
 
-func (c *connection) FindOrganizationRole(ctx context.Context, name string) (*database.OrganizationRole, error) {
+func (c *connection) FindOrganizationRoles(ctx context.Context, orgID string) ([]*database.OrganizationRole, error) {
+	var res []*database.OrganizationRole
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT * FROM org_roles WHERE org_id IS NULL OR org_id=$1
+		ORDER BY org_id NULLS FIRST, lower(name)
+	`, orgID)
+	if err != nil {
+		return nil, parseErr("org roles", err)
+	}
+	return res, nil
+}
+
+// FindOrganizationRole finds a built-in org role or a custom role of the org by name.
+func (c *connection) FindOrganizationRole(ctx context.Context, orgID, name string) (*database.OrganizationRole, error) {
 	role := &database.OrganizationRole{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM org_roles WHERE lower(name)=lower($1)", name).StructScan(role)
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT * FROM org_roles WHERE lower(name)=lower($2) AND (org_id IS NULL OR org_id=$1)
+		ORDER BY org_id NULLS FIRST LIMIT 1
+	`, orgID, name).StructScan(role)
 	if err != nil {
 		return nil, parseErr("org role", err)
 	}
 	return role, nil
 }
 
-func (c *connection) FindProjectRole(ctx context.Context, name string) (*database.ProjectRole, error) {
+func (c *connection) InsertOrganizationRole(ctx context.Context, opts *database.InsertOrganizationRoleOptions) (*database.OrganizationRole, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.OrganizationRole{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO org_roles (org_id, name, read_org, manage_org, read_projects, create_projects, manage_projects, read_org_members, manage_org_members)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING *`,
+		opts.OrgID, opts.Name, opts.ReadOrg, opts.ManageOrg, opts.ReadProjects, opts.CreateProjects, opts.ManageProjects, opts.ReadOrgMembers, opts.ManageOrgMembers,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org role", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpdateOrganizationRole(ctx context.Context, id string, opts *database.UpdateOrganizationRoleOptions) (*database.OrganizationRole, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.OrganizationRole{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE org_roles SET name=$1, read_org=$2, manage_org=$3, read_projects=$4, create_projects=$5, manage_projects=$6, read_org_members=$7, manage_org_members=$8, updated_on=now()
+		WHERE id=$9 AND org_id IS NOT NULL RETURNING *`,
+		opts.Name, opts.ReadOrg, opts.ManageOrg, opts.ReadProjects, opts.CreateProjects, opts.ManageProjects, opts.ReadOrgMembers, opts.ManageOrgMembers, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org role", err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteOrganizationRole(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM org_roles WHERE id=$1 AND org_id IS NOT NULL", id)
+	return checkDeleteRow("org role", res, err)
+}
+
+// CheckOrganizationRoleIsUsed checks if an org role is assigned to any users, user groups, invites or whitelisted domains.
+func (c *connection) CheckOrganizationRoleIsUsed(ctx context.Context, id string) (bool, error) {
+	var res bool
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM users_orgs_roles WHERE org_role_id=$1)
+			OR EXISTS (SELECT 1 FROM usergroups_orgs_roles WHERE org_role_id=$1)
+			OR EXISTS (SELECT 1 FROM org_invites WHERE org_role_id=$1)
+			OR EXISTS (SELECT 1 FROM orgs_autoinvite_domains WHERE org_role_id=$1)
+	`, id).Scan(&res)
+	if err != nil {
+		return false, parseErr("check", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindProjectRoles(ctx context.Context, orgID string) ([]*database.ProjectRole, error) {
+	var res []*database.ProjectRole
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT * FROM project_roles WHERE org_id IS NULL OR org_id=$1
+		ORDER BY org_id NULLS FIRST, lower(name)
+	`, orgID)
+	if err != nil {
+		return nil, parseErr("project roles", err)
+	}
+	return res, nil
+}
+
+// FindProjectRole finds a built-in project role or a custom project role of the org by name.
+func (c *connection) FindProjectRole(ctx context.Context, orgID, name string) (*database.ProjectRole, error) {
 	role := &database.ProjectRole{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM project_roles WHERE lower(name)=lower($1)", name).StructScan(role)
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT * FROM project_roles WHERE lower(name)=lower($2) AND (org_id IS NULL OR org_id=$1)
+		ORDER BY org_id NULLS FIRST LIMIT 1
+	`, orgID, name).StructScan(role)
 	if err != nil {
 		return nil, parseErr("project role", err)
 	}
 	return role, nil
 }
 
+func (c *connection) InsertProjectRole(ctx context.Context, opts *database.InsertProjectRoleOptions) (*database.ProjectRole, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.ProjectRole{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO project_roles (org_id, name, read_project, manage_project, read_prod, read_prod_status, manage_prod, read_dev, read_dev_status, manage_dev,
+			read_project_members, manage_project_members, create_reports, manage_reports, create_alerts, manage_alerts)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16) RETURNING *`,
+		opts.OrgID, opts.Name, opts.ReadProject, opts.ManageProject, opts.ReadProd, opts.ReadProdStatus, opts.ManageProd, opts.ReadDev, opts.ReadDevStatus, opts.ManageDev,
+		opts.ReadProjectMembers, opts.ManageProjectMembers, opts.CreateReports, opts.ManageReports, opts.CreateAlerts, opts.ManageAlerts,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project role", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpdateProjectRole(ctx context.Context, id string, opts *database.UpdateProjectRoleOptions) (*database.ProjectRole, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.ProjectRole{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE project_roles SET name=$1, read_project=$2, manage_project=$3, read_prod=$4, read_prod_status=$5, manage_prod=$6, read_dev=$7, read_dev_status=$8, manage_dev=$9,
+			read_project_members=$10, manage_project_members=$11, create_reports=$12, manage_reports=$13, create_alerts=$14, manage_alerts=$15, updated_on=now()
+		WHERE id=$16 AND org_id IS NOT NULL RETURNING *`,
+		opts.Name, opts.ReadProject, opts.ManageProject, opts.ReadProd, opts.ReadProdStatus, opts.ManageProd, opts.ReadDev, opts.ReadDevStatus, opts.ManageDev,
+		opts.ReadProjectMembers, opts.ManageProjectMembers, opts.CreateReports, opts.ManageReports, opts.CreateAlerts, opts.ManageAlerts, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project role", err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteProjectRole(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM project_roles WHERE id=$1 AND org_id IS NOT NULL", id)
+	return checkDeleteRow("project role", res, err)
+}
+
+// CheckProjectRoleIsUsed checks if a project role is assigned to any users, user groups, invites or whitelisted domains.
+func (c *connection) CheckProjectRoleIsUsed(ctx context.Context, id string) (bool, error) {
+	var res bool
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM users_projects_roles WHERE project_role_id=$1)
+			OR EXISTS (SELECT 1 FROM usergroups_projects_roles WHERE project_role_id=$1)
+			OR EXISTS (SELECT 1 FROM project_invites WHERE project_role_id=$1)
+			OR EXISTS (SELECT 1 FROM projects_autoinvite_domains WHERE project_role_id=$1)
+	`, id).Scan(&res)
+	if err != nil {
+		return false, parseErr("check", err)
+	}
+	return res, nil
+}
+
 func (c *connection) ResolveOrganizationRolesForUser(ctx context.Context, userID, orgID string) ([]*database.OrganizationRole, error) {
 	var res []*database.OrganizationRole
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT r.* FROM users_orgs_roles uor
 		JOIN org_roles r ON uor.org_role_id = r.id
-		WHERE uor.user_id = $1 AND uor.org_id = $2
+		WHERE uor.user_id = $1 AND uor.org_id = $2 AND (r.org_id IS NULL OR r.org_id = $2)
 		UNION
 		SELECT * FROM org_roles WHERE id IN (
 			SELECT org_role_id FROM usergroups_orgs_roles uor JOIN usergroups_users uug
 			ON uor.usergroup_id = uug.usergroup_id WHERE uug.user_id = $1 AND uor.org_id = $2
-		)`, userID, orgID)
+		) AND (org_id IS NULL OR org_id = $2)`, userID, orgID)
 	if err != nil {
 		return nil, parseErr("org roles", err)
 	}
@@ -1076,12 +1344,13 @@ func (c *connection) ResolveProjectRolesForUser(ctx context.Context, userID, pro
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT r.* FROM users_projects_roles upr
 		JOIN project_roles r ON upr.project_role_id = r.id
-		WHERE upr.user_id = $1 AND upr.project_id = $2
+		JOIN projects p ON upr.project_id = p.id
+		WHERE upr.user_id = $1 AND upr.project_id = $2 AND (r.org_id IS NULL OR r.org_id = p.org_id)
 		UNION
 		SELECT * FROM project_roles WHERE id IN (
 			SELECT project_role_id FROM usergroups_projects_roles upr JOIN usergroups_users uug
 			ON upr.usergroup_id = uug.usergroup_id WHERE uug.user_id = $1 AND upr.project_id = $2
-		)`, userID, projectID)
+		) AND (org_id IS NULL OR org_id = (SELECT org_id FROM projects WHERE id = $2))`, userID, projectID)
 	if err != nil {
 		return nil, parseErr("project roles", err)
 	}
@@ -1103,6 +1372,30 @@ func (c *connection) FindOrganizationMemberUsers(ctx context.Context, orgID, aft
 	return res, nil
 }
 
+func (c *connection) FindOrganizationMemberUsersWithOffset(ctx context.Context, orgID string, offset, limit int) ([]*database.Member, error) {
+	var res []*database.Member
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT u.id, u.email, u.display_name, u.created_on, u.updated_on, r.name FROM users u
+		JOIN users_orgs_roles uor ON u.id = uor.user_id
+		JOIN org_roles r ON r.id = uor.org_role_id
+		WHERE uor.org_id=$1
+		ORDER BY lower(u.email) OFFSET $2 LIMIT $3
+	`, orgID, offset, limit)
+	if err != nil {
+		return nil, parseErr("org members", err)
+	}
+	return res, nil
+}
+
+func (c *connection) CountOrganizationMemberUsers(ctx context.Context, orgID string) (int, error) {
+	var count int
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT COUNT(*) FROM users_orgs_roles WHERE org_id = $1", orgID).Scan(&count)
+	if err != nil {
+		return 0, parseErr("org members count", err)
+	}
+	return count, nil
+}
+
 func (c *connection) FindOrganizationMemberUsersByRole(ctx context.Context, orgID, roleID string) ([]*database.User, error) {
 	var res []*database.User
 	err := c.getDB(ctx).SelectContext(
@@ -1153,6 +1446,83 @@ func (c *connection) CountSingleuserOrganizationsForMemberUser(ctx context.Conte
 	return count, nil
 }
 
+func (c *connection) FindOrganizationMemberUsergroups(ctx context.Context, orgID, afterName string, limit int) ([]*database.MemberUsergroup, error) {
+	var res []*database.MemberUsergroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT ug.id, ug.name, COALESCE(r.name, '') as role_name, ug.created_on, ug.updated_on FROM usergroups ug
+		LEFT JOIN usergroups_orgs_roles ugor ON ug.id = ugor.usergroup_id AND ugor.org_id = ug.org_id
+		LEFT JOIN org_roles r ON r.id = ugor.org_role_id
+		WHERE ug.org_id=$1 AND lower(ug.name) > lower($2)
+		ORDER BY lower(ug.name) LIMIT $3
+	`, orgID, afterName, limit)
+	if err != nil {
+		return nil, parseErr("org usergroups", err)
+	}
+	return res, nil
+}
+
+// FindOrganizationUnmanagedUsergroupsWithOffset returns the user groups of an org except for its managed group of all members.
+func (c *connection) FindOrganizationUnmanagedUsergroupsWithOffset(ctx context.Context, orgID string, offset, limit int) ([]*database.MemberUsergroup, error) {
+	var res []*database.MemberUsergroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT ug.id, ug.name, COALESCE(r.name, '') as role_name, ug.created_on, ug.updated_on FROM usergroups ug
+		JOIN orgs o ON o.id = ug.org_id
+		LEFT JOIN usergroups_orgs_roles ugor ON ug.id = ugor.usergroup_id AND ugor.org_id = ug.org_id
+		LEFT JOIN org_roles r ON r.id = ugor.org_role_id
+		WHERE ug.org_id=$1 AND ug.id IS DISTINCT FROM o.all_usergroup_id
+		ORDER BY lower(ug.name) OFFSET $2 LIMIT $3
+	`, orgID, offset, limit)
+	if err != nil {
+		return nil, parseErr("org usergroups", err)
+	}
+	return res, nil
+}
+
+func (c *connection) CountOrganizationUnmanagedUsergroups(ctx context.Context, orgID string) (int, error) {
+	var count int
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT COUNT(*) FROM usergroups ug JOIN orgs o ON o.id = ug.org_id
+		WHERE ug.org_id=$1 AND ug.id IS DISTINCT FROM o.all_usergroup_id
+	`, orgID).Scan(&count)
+	if err != nil {
+		return 0, parseErr("org usergroups count", err)
+	}
+	return count, nil
+}
+
+func (c *connection) InsertOrganizationMemberUsergroup(ctx context.Context, groupID, orgID, roleID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO usergroups_orgs_roles (usergroup_id, org_id, org_role_id) VALUES ($1, $2, $3)", groupID, orgID, roleID)
+	if err != nil {
+		return parseErr("org group member", err)
+	}
+	return nil
+}
+
+func (c *connection) UpdateOrganizationMemberUsergroup(ctx context.Context, groupID, orgID, roleID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "UPDATE usergroups_orgs_roles SET org_role_id = $1 WHERE usergroup_id = $2 AND org_id = $3", roleID, groupID, orgID)
+	return checkUpdateRow("org group member", res, err)
+}
+
+func (c *connection) DeleteOrganizationMemberUsergroup(ctx context.Context, groupID, orgID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups_orgs_roles WHERE usergroup_id = $1 AND org_id = $2", groupID, orgID)
+	return checkDeleteRow("org group member", res, err)
+}
+
+func (c *connection) FindProjectMemberUsergroups(ctx context.Context, projectID, afterName string, limit int) ([]*database.MemberUsergroup, error) {
+	var res []*database.MemberUsergroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT ug.id, ug.name, r.name as role_name, ug.created_on, ug.updated_on FROM usergroups ug
+		JOIN usergroups_projects_roles ugpr ON ug.id = ugpr.usergroup_id
+		JOIN project_roles r ON r.id = ugpr.project_role_id
+		WHERE ugpr.project_id=$1 AND lower(ug.name) > lower($2)
+		ORDER BY lower(ug.name) LIMIT $3
+	`, projectID, afterName, limit)
+	if err != nil {
+		return nil, parseErr("project usergroups", err)
+	}
+	return res, nil
+}
+
 func (c *connection) FindProjectMemberUsers(ctx context.Context, projectID, afterEmail string, limit int) ([]*database.Member, error) {
 	var res []*database.Member
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
@@ -1205,6 +1575,16 @@ func (c *connection) InsertProjectMemberUsergroup(ctx context.Context, groupID,
 	return nil
 }
 
+func (c *connection) UpdateProjectMemberUsergroup(ctx context.Context, groupID, projectID, roleID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "UPDATE usergroups_projects_roles SET project_role_id = $1 WHERE usergroup_id = $2 AND project_id = $3", roleID, groupID, projectID)
+	return checkUpdateRow("project group member", res, err)
+}
+
+func (c *connection) DeleteProjectMemberUsergroup(ctx context.Context, groupID, projectID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups_projects_roles WHERE usergroup_id = $1 AND project_id = $2", groupID, projectID)
+	return checkDeleteRow("project group member", res, err)
+}
+
 func (c *connection) DeleteProjectMemberUser(ctx context.Context, projectID, userID string) error {
 	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM users_projects_roles WHERE user_id = $1 AND project_id = $2", userID, projectID)
 	return checkDeleteRow("project member", res, err)
@@ -1470,6 +1850,70 @@ func (c *connection) DeleteExpiredVirtualFiles(ctx context.Context, retention ti
 	return parseErr("virtual files", err)
 }
 
+func (c *connection) FindAuditEvents(ctx context.Context, opts *database.FindAuditEventsOptions) ([]*database.AuditEvent, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	var where []string
+	var args []any
+	addFilter := func(cond string, arg any) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+
+	addFilter("org_id=$%d", opts.OrgID)
+	if opts.ProjectID != "" {
+		addFilter("project_id=$%d", opts.ProjectID)
+	}
+	if opts.ActorName != "" {
+		addFilter("lower(actor_name)=lower($%d)", opts.ActorName)
+	}
+	if opts.Action != "" {
+		addFilter("action=$%d", opts.Action)
+	}
+	if !opts.After.IsZero() {
+		addFilter("created_on>=$%d", opts.After)
+	}
+	if !opts.Before.IsZero() {
+		addFilter("created_on<$%d", opts.Before)
+	}
+	if !opts.BeforeCreatedOn.IsZero() {
+		args = append(args, opts.BeforeCreatedOn, opts.BeforeID)
+		where = append(where, fmt.Sprintf("(created_on, id)<($%d, $%d)", len(args)-1, len(args)))
+	}
+	args = append(args, opts.Limit)
+
+	qry := fmt.Sprintf("SELECT * FROM audit_events WHERE %s ORDER BY created_on DESC, id DESC LIMIT $%d", strings.Join(where, " AND "), len(args))
+
+	var res []*auditEventDTO
+	err := c.getDB(ctx).SelectContext(ctx, &res, qry, args...)
+	if err != nil {
+		return nil, parseErr("audit events", err)
+	}
+	return auditEventsFromDTOs(res)
+}
+
+func (c *connection) InsertAuditEvent(ctx context.Context, opts *database.InsertAuditEventOptions) (*database.AuditEvent, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+	if opts.Details == nil {
+		opts.Details = make(map[string]any)
+	}
+
+	res := &auditEventDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO audit_events (org_id, project_id, project_name, actor_type, actor_id, actor_name, actor_superuser, action, target, details)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING *`,
+		opts.OrgID, opts.ProjectID, opts.ProjectName, opts.ActorType, opts.ActorID, opts.ActorName, opts.ActorSuperuser, opts.Action, opts.Target, opts.Details,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("audit event", err)
+	}
+	return res.AsAuditEvent()
+}
+
 // projectDTO wraps database.Project, using the pgtype package to handle types that pgx can't read directly into their native Go types.
 type projectDTO struct {
 	*database.Project
@@ -1503,6 +1947,32 @@ func projectsFromDTOs(dtos []*projectDTO) ([]*database.Project, error) {
 	return res, nil
 }
 
+// auditEventDTO wraps database.AuditEvent, using the pgtype package to read the details JSON.
+type auditEventDTO struct {
+	*database.AuditEvent
+	Details pgtype.JSON `db:"details"`
+}
+
+func (e *auditEventDTO) AsAuditEvent() (*database.AuditEvent, error) {
+	err := e.Details.AssignTo(&e.AuditEvent.Details)
+	if err != nil {
+		return nil, err
+	}
+	return e.AuditEvent, nil
+}
+
+func auditEventsFromDTOs(dtos []*auditEventDTO) ([]*database.AuditEvent, error) {
+	res := make([]*database.AuditEvent, len(dtos))
+	for i, dto := range dtos {
+		var err error
+		res[i], err = dto.AsAuditEvent()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func checkUpdateRow(target string, res sql.Result, err error) error {
 	if err != nil {
 		return parseErr(target, err)
@@ -1587,6 +2057,8 @@ func parseErr(target string, err error) error {
 			return newAlreadyExistsErr("a service with that name already exists in the org")
 		case "virtual_files_pkey":
 			return newAlreadyExistsErr("a virtual file already exists at that path")
+		case "deployments_project_id_pull_request_idx":
+			return newAlreadyExistsErr("a preview deployment already exists for that pull request")
 		default:
 			if target == "" {
 				return database.ErrNotUnique
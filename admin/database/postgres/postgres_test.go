@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -32,6 +33,10 @@ func TestPostgres(t *testing.T) {
 	t.Run("TestProjectsWithPagination", func(t *testing.T) { testProjectsWithPagination(t, db) })
 	t.Run("TestProjectsForUsersWithPagination", func(t *testing.T) { testProjectsForUserWithPagination(t, db) })
 	t.Run("TestMembersWithPagination", func(t *testing.T) { testOrgsMembersPagination(t, db) })
+	t.Run("TestAuditEvents", func(t *testing.T) { testAuditEvents(t, db) })
+	t.Run("TestUsergroups", func(t *testing.T) { testUsergroups(t, db) })
+	t.Run("TestRoles", func(t *testing.T) { testRoles(t, db) })
+	t.Run("TestPreviewDeployments", func(t *testing.T) { testPreviewDeployments(t, db) })
 	// Add new tests here
 
 	require.NoError(t, db.Close())
@@ -94,12 +99,12 @@ func testOrgsWithPagination(t *testing.T, db database.DB) {
 	require.NoError(t, err)
 	require.Equal(t, "test@rilldata.com", user.Email)
 
-	role, err := db.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
-
 	// add org and give user permission
 	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "alpha"})
 	require.NoError(t, err)
 	require.Equal(t, "alpha", org.Name)
+	role, err := db.FindOrganizationRole(ctx, org.ID, database.OrganizationRoleNameAdmin)
+	require.NoError(t, err)
 	require.NoError(t, db.InsertOrganizationMemberUser(ctx, org.ID, user.ID, role.ID))
 
 	// add org and give user permission
@@ -323,14 +328,15 @@ func testProjectsForUserWithPagination(t *testing.T, db database.DB) {
 	require.NoError(t, err)
 	require.Equal(t, "test@rilldata.com", user.Email)
 
-	// fetch role
-	role, err := db.FindProjectRole(ctx, database.ProjectRoleNameCollaborator)
-
 	// add org
 	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "test"})
 	require.NoError(t, err)
 	require.Equal(t, "test", org.Name)
 
+	// fetch role
+	role, err := db.FindProjectRole(ctx, org.ID, database.ProjectRoleNameCollaborator)
+	require.NoError(t, err)
+
 	// add projects
 	// public project
 	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "alpha", Public: true})
@@ -384,12 +390,13 @@ func testOrgsMembersPagination(t *testing.T, db database.DB) {
 	viewerUser, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "test2@rilldata.com"})
 	require.NoError(t, err)
 
-	admin, err := db.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
-	viewer, err := db.FindOrganizationRole(ctx, database.OrganizationRoleNameViewer)
-
 	// add org and give user permission
 	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "alpha"})
 	require.NoError(t, err)
+	admin, err := db.FindOrganizationRole(ctx, org.ID, database.OrganizationRoleNameAdmin)
+	require.NoError(t, err)
+	viewer, err := db.FindOrganizationRole(ctx, org.ID, database.OrganizationRoleNameViewer)
+	require.NoError(t, err)
 	require.NoError(t, db.InsertOrganizationMemberUser(ctx, org.ID, adminUser.ID, admin.ID))
 	require.NoError(t, db.InsertOrganizationMemberUser(ctx, org.ID, viewerUser.ID, viewer.ID))
 	require.NoError(t, db.InsertOrganizationInvite(ctx, &database.InsertOrganizationInviteOptions{Email: "test3@rilldata.com", InviterID: adminUser.ID, OrgID: org.ID, RoleID: viewer.ID}))
@@ -406,6 +413,15 @@ func testOrgsMembersPagination(t *testing.T, db database.DB) {
 	require.Equal(t, len(users), 1)
 	require.Equal(t, "test2@rilldata.com", users[0].Email)
 
+	// fetch members by offset
+	users, err = db.FindOrganizationMemberUsersWithOffset(ctx, org.ID, 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, len(users), 1)
+	require.Equal(t, "test2@rilldata.com", users[0].Email)
+	count, err := db.CountOrganizationMemberUsers(ctx, org.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
 	// fetch invites without name filter
 	invites, err := db.FindOrganizationInvites(ctx, org.ID, "", 1)
 	require.NoError(t, err)
@@ -419,3 +435,346 @@ func testOrgsMembersPagination(t *testing.T, db database.DB) {
 	//cleanup
 	require.NoError(t, db.DeleteOrganization(ctx, "alpha"))
 }
+
+func testAuditEvents(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "audited"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "foo"})
+	require.NoError(t, err)
+
+	// insert events in order
+	for i := 0; i < 3; i++ {
+		_, err = db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{
+			OrgID:     org.ID,
+			ActorType: database.AuditActorTypeUser,
+			ActorName: "admin@rilldata.com",
+			Action:    database.AuditActionOrganizationMemberAdd,
+			Target:    fmt.Sprintf("user%d@rilldata.com", i),
+			Details:   map[string]any{"role": "viewer"},
+		})
+		require.NoError(t, err)
+	}
+	e, err := db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{
+		OrgID:       org.ID,
+		ProjectID:   &proj.ID,
+		ProjectName: proj.Name,
+		ActorType:   database.AuditActorTypeService,
+		ActorName:   "ci",
+		Action:      database.AuditActionProjectVariablesUpdate,
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{}, e.Details)
+
+	// paginate through all events, most recent first
+	events, err := db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, Limit: 3})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, database.AuditActionProjectVariablesUpdate, events[0].Action)
+	require.Equal(t, map[string]any{"role": "viewer"}, events[1].Details)
+	last := events[2]
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, BeforeCreatedOn: last.CreatedOn, BeforeID: last.ID, Limit: 3})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "user0@rilldata.com", events[0].Target)
+
+	// filter events
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, ProjectID: proj.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, ActorName: "Admin@rilldata.com", Action: database.AuditActionOrganizationMemberAdd, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, After: time.Now().Add(time.Hour), Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 0)
+
+	// events are retained when the project is deleted
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, ProjectID: proj.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "foo", events[0].ProjectName)
+
+	//cleanup
+	require.NoError(t, db.DeleteOrganization(ctx, "audited"))
+}
+
+func testUsergroups(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	user1, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "group1@rilldata.com"})
+	require.NoError(t, err)
+	user2, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "group2@rilldata.com"})
+	require.NoError(t, err)
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "grouped"})
+	require.NoError(t, err)
+
+	orgViewer, err := db.FindOrganizationRole(ctx, org.ID, database.OrganizationRoleNameViewer)
+	require.NoError(t, err)
+	projViewer, err := db.FindProjectRole(ctx, org.ID, database.ProjectRoleNameViewer)
+	require.NoError(t, err)
+	projAdmin, err := db.FindProjectRole(ctx, org.ID, database.ProjectRoleNameAdmin)
+	require.NoError(t, err)
+
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "foo"})
+	require.NoError(t, err)
+
+	analysts, err := db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "analysts"})
+	require.NoError(t, err)
+	_, err = db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "Analysts"})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+	_, err = db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "engineers"})
+	require.NoError(t, err)
+
+	// rename and find by name
+	analysts, err = db.UpdateUsergroup(ctx, analysts.ID, &database.UpdateUsergroupOptions{Name: "data-analysts"})
+	require.NoError(t, err)
+	group, err := db.FindUsergroupByName(ctx, org.ID, "Data-Analysts")
+	require.NoError(t, err)
+	require.Equal(t, analysts.ID, group.ID)
+	group, err = db.FindUsergroup(ctx, analysts.ID)
+	require.NoError(t, err)
+	require.Equal(t, "data-analysts", group.Name)
+
+	// members
+	require.NoError(t, db.InsertUsergroupMember(ctx, analysts.ID, user1.ID))
+	require.NoError(t, db.InsertUsergroupMember(ctx, analysts.ID, user2.ID))
+	members, err := db.FindUsergroupMemberUsers(ctx, analysts.ID, "", 1)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, "group1@rilldata.com", members[0].Email)
+	members, err = db.FindUsergroupMemberUsers(ctx, analysts.ID, members[0].Email, 10)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, "group2@rilldata.com", members[0].Email)
+
+	// SCIM provisioning records are idempotent
+	ok, err := db.CheckUserIsSCIMProvisioned(ctx, user1.ID, org.ID)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.NoError(t, db.InsertSCIMProvisionedUser(ctx, org.ID, user1.ID))
+	require.NoError(t, db.InsertSCIMProvisionedUser(ctx, org.ID, user1.ID))
+	ok, err = db.CheckUserIsSCIMProvisioned(ctx, user1.ID, org.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// org roles are listed for all groups, with an empty role for groups without one
+	require.NoError(t, db.InsertOrganizationMemberUsergroup(ctx, analysts.ID, org.ID, orgViewer.ID))
+	groups, err := db.FindOrganizationMemberUsergroups(ctx, org.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	require.Equal(t, "data-analysts", groups[0].Name)
+	require.Equal(t, database.OrganizationRoleNameViewer, groups[0].RoleName)
+	require.Equal(t, "engineers", groups[1].Name)
+	require.Equal(t, "", groups[1].RoleName)
+
+	// the org's managed group of all members is excluded from unmanaged groups
+	all, err := db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "all-users"})
+	require.NoError(t, err)
+	_, err = db.UpdateOrganizationAllUsergroup(ctx, org.ID, all.ID)
+	require.NoError(t, err)
+	count, err := db.CountOrganizationUnmanagedUsergroups(ctx, org.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	groups, err = db.FindOrganizationUnmanagedUsergroupsWithOffset(ctx, org.ID, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	require.Equal(t, "engineers", groups[0].Name)
+
+	// project roles are resolved through group membership
+	require.NoError(t, db.InsertProjectMemberUsergroup(ctx, analysts.ID, proj.ID, projViewer.ID))
+	require.NoError(t, db.UpdateProjectMemberUsergroup(ctx, analysts.ID, proj.ID, projAdmin.ID))
+	groups, err = db.FindProjectMemberUsergroups(ctx, proj.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	require.Equal(t, database.ProjectRoleNameAdmin, groups[0].RoleName)
+	roles, err := db.ResolveProjectRolesForUser(ctx, user1.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	require.Equal(t, database.ProjectRoleNameAdmin, roles[0].Name)
+
+	// removing a user from the org's groups revokes the group's roles
+	require.NoError(t, db.DeleteUsergroupsMemberUser(ctx, org.ID, user1.ID))
+	roles, err = db.ResolveProjectRolesForUser(ctx, user1.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 0)
+	roles, err = db.ResolveProjectRolesForUser(ctx, user2.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+
+	// deleting the group revokes its roles
+	require.NoError(t, db.DeleteProjectMemberUsergroup(ctx, analysts.ID, proj.ID))
+	require.ErrorIs(t, db.DeleteProjectMemberUsergroup(ctx, analysts.ID, proj.ID), database.ErrNotFound)
+	require.NoError(t, db.DeleteUsergroup(ctx, analysts.ID))
+	orgRoles, err := db.ResolveOrganizationRolesForUser(ctx, user2.ID, org.ID)
+	require.NoError(t, err)
+	require.Len(t, orgRoles, 0)
+
+	//cleanup
+	require.NoError(t, db.DeleteOrganization(ctx, "grouped"))
+}
+
+func testRoles(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	user, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "roles@rilldata.com"})
+	require.NoError(t, err)
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "roles"})
+	require.NoError(t, err)
+	other, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "roles-other"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "foo"})
+	require.NoError(t, err)
+
+	// built-in roles can't be changed
+	viewer, err := db.FindOrganizationRole(ctx, org.ID, database.OrganizationRoleNameViewer)
+	require.NoError(t, err)
+	require.Nil(t, viewer.OrgID)
+	require.ErrorIs(t, db.DeleteOrganizationRole(ctx, viewer.ID), database.ErrNotFound)
+
+	// custom org role
+	orgRole, err := db.InsertOrganizationRole(ctx, &database.InsertOrganizationRoleOptions{OrgID: org.ID, Name: "auditor", ReadOrg: true, ReadOrgMembers: true})
+	require.NoError(t, err)
+	require.Equal(t, org.ID, *orgRole.OrgID)
+	_, err = db.InsertOrganizationRole(ctx, &database.InsertOrganizationRoleOptions{OrgID: org.ID, Name: "Auditor"})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+	_, err = db.InsertOrganizationRole(ctx, &database.InsertOrganizationRoleOptions{OrgID: other.ID, Name: "auditor"})
+	require.NoError(t, err)
+
+	roles, err := db.FindOrganizationRoles(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 4)
+	require.Equal(t, "auditor", roles[3].Name)
+	_, err = db.FindOrganizationRole(ctx, other.ID, "missing")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// custom project role
+	projRole, err := db.InsertProjectRole(ctx, &database.InsertProjectRoleOptions{OrgID: org.ID, Name: "alerter", ReadProject: true, ReadProd: true, CreateAlerts: true})
+	require.NoError(t, err)
+	projRole, err = db.UpdateProjectRole(ctx, projRole.ID, &database.UpdateProjectRoleOptions{Name: "alert-creator", ReadProject: true, ReadProd: true, CreateAlerts: true, CreateReports: true})
+	require.NoError(t, err)
+	found, err := db.FindProjectRole(ctx, org.ID, "Alert-Creator")
+	require.NoError(t, err)
+	require.Equal(t, projRole.ID, found.ID)
+	require.True(t, found.CreateReports)
+	_, err = db.FindProjectRole(ctx, other.ID, "alert-creator")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// custom roles resolve like built-in roles
+	used, err := db.CheckOrganizationRoleIsUsed(ctx, orgRole.ID)
+	require.NoError(t, err)
+	require.False(t, used)
+	require.NoError(t, db.InsertOrganizationMemberUser(ctx, org.ID, user.ID, orgRole.ID))
+	require.NoError(t, db.InsertProjectMemberUser(ctx, proj.ID, user.ID, projRole.ID))
+	used, err = db.CheckOrganizationRoleIsUsed(ctx, orgRole.ID)
+	require.NoError(t, err)
+	require.True(t, used)
+	used, err = db.CheckProjectRoleIsUsed(ctx, projRole.ID)
+	require.NoError(t, err)
+	require.True(t, used)
+
+	orgRoles, err := db.ResolveOrganizationRolesForUser(ctx, user.ID, org.ID)
+	require.NoError(t, err)
+	require.Len(t, orgRoles, 1)
+	require.True(t, orgRoles[0].ReadOrgMembers)
+	require.False(t, orgRoles[0].ManageOrgMembers)
+	projRoles, err := db.ResolveProjectRolesForUser(ctx, user.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, projRoles, 1)
+	require.True(t, projRoles[0].CreateAlerts)
+	require.False(t, projRoles[0].ManageProd)
+
+	// delete
+	require.NoError(t, db.DeleteProjectMemberUser(ctx, proj.ID, user.ID))
+	require.NoError(t, db.DeleteProjectRole(ctx, projRole.ID))
+	_, err = db.FindProjectRole(ctx, org.ID, "alert-creator")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	//cleanup
+	require.NoError(t, db.DeleteOrganization(ctx, "roles"))
+	require.NoError(t, db.DeleteOrganization(ctx, "roles-other"))
+}
+
+func testPreviewDeployments(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "previews"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "proj", ProdBranch: "main"})
+	require.NoError(t, err)
+	require.False(t, proj.PreviewDeployments)
+
+	proj, err = db.UpdateProject(ctx, proj.ID, &database.UpdateProjectOptions{
+		Name:               proj.Name,
+		ProdBranch:         proj.ProdBranch,
+		PreviewDeployments: true,
+	})
+	require.NoError(t, err)
+	require.True(t, proj.PreviewDeployments)
+
+	insertOpts := func(branch string, pullRequest *int, expiresOn *time.Time) *database.InsertDeploymentOptions {
+		return &database.InsertDeploymentOptions{
+			ProjectID:         proj.ID,
+			Provisioner:       "static",
+			Branch:            branch,
+			RuntimeHost:       "http://localhost:9091",
+			RuntimeInstanceID: branch,
+			PullRequest:       pullRequest,
+			ExpiresOn:         expiresOn,
+		}
+	}
+
+	// prod deployment
+	prod, err := db.InsertDeployment(ctx, insertOpts("main", nil, nil))
+	require.NoError(t, err)
+	require.Nil(t, prod.PullRequest)
+	require.Nil(t, prod.ExpiresOn)
+
+	// preview deployment
+	pr := 42
+	expiresOn := time.Now().Add(time.Hour)
+	preview, err := db.InsertDeployment(ctx, insertOpts("feature", &pr, &expiresOn))
+	require.NoError(t, err)
+	require.Equal(t, pr, *preview.PullRequest)
+	require.WithinDuration(t, expiresOn, *preview.ExpiresOn, time.Second)
+
+	// a pull request can only have one preview deployment
+	_, err = db.InsertDeployment(ctx, insertOpts("feature-2", &pr, &expiresOn))
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	depl, err := db.FindPreviewDeployment(ctx, proj.ID, pr)
+	require.NoError(t, err)
+	require.Equal(t, preview.ID, depl.ID)
+	_, err = db.FindPreviewDeployment(ctx, proj.ID, 43)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	ds, err := db.FindPreviewDeploymentsForBranch(ctx, proj.ID, "feature")
+	require.NoError(t, err)
+	require.Len(t, ds, 1)
+	require.Equal(t, preview.ID, ds[0].ID)
+	ds, err = db.FindPreviewDeploymentsForBranch(ctx, proj.ID, "main")
+	require.NoError(t, err)
+	require.Len(t, ds, 0)
+
+	// expiration
+	ds, err = db.FindExpiredPreviewDeployments(ctx)
+	require.NoError(t, err)
+	require.Len(t, ds, 0)
+	depl, err = db.UpdateDeploymentExpiresOn(ctx, preview.ID, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	require.True(t, depl.ExpiresOn.Before(time.Now()))
+	ds, err = db.FindExpiredPreviewDeployments(ctx)
+	require.NoError(t, err)
+	require.Len(t, ds, 1)
+	require.Equal(t, preview.ID, ds[0].ID)
+
+	// cleanup
+	require.NoError(t, db.DeleteDeployment(ctx, preview.ID))
+	require.NoError(t, db.DeleteDeployment(ctx, prod.ID))
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
@@ -30,6 +30,9 @@ type createDeploymentOptions struct {
 	ProdOLAPDSN    string
 	ProdSlots      int
 	ProdVersion    string
+	Environment    string     // Defaults to "prod"
+	PullRequest    *int       // Only set for preview deployments
+	ExpiresOn      *time.Time // Only set for preview deployments
 }
 
 func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOptions) (*database.Deployment, error) {
@@ -126,9 +129,12 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 		RuntimeAudience:   alloc.Audience,
 		RuntimeVersion:    runtimeVersion,
 		Status:            database.DeploymentStatusPending,
+		PullRequest:       opts.PullRequest,
+		ExpiresOn:         opts.ExpiresOn,
 	})
 	if err != nil {
-		return nil, err
+		err2 := p.Deprovision(ctx, provisionID)
+		return nil, multierr.Combine(err, err2)
 	}
 
 	// Wait for the runtime to be ready
@@ -173,9 +179,13 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 	})
 
 	// Create the instance
+	environment := opts.Environment
+	if environment == "" {
+		environment = "prod"
+	}
 	_, err = rt.CreateInstance(ctx, &runtimev1.CreateInstanceRequest{
 		InstanceId:     instanceID,
-		Environment:    "prod",
+		Environment:    environment,
 		OlapConnector:  olapConnector,
 		RepoConnector:  "admin",
 		AdminConnector: "admin",
@@ -343,6 +353,7 @@ func (s *Service) HibernateDeployments(ctx context.Context) error {
 			ProdVariables:        proj.ProdVariables,
 			ProdSlots:            proj.ProdSlots,
 			ProdTTLSeconds:       proj.ProdTTLSeconds,
+			PreviewDeployments:   proj.PreviewDeployments,
 			ProdDeploymentID:     nil,
 			Annotations:          proj.Annotations,
 		})
@@ -176,6 +176,9 @@ func (s *Service) ProcessGithubEvent(ctx context.Context, rawEvent any) error {
 	// Triggered on push to repository
 	case *github.PushEvent:
 		return s.processGithubPush(ctx, event)
+	// Triggered when a pull request is opened, closed, reopened or receives new commits
+	case *github.PullRequestEvent:
+		return s.processGithubPullRequest(ctx, event)
 	// Triggered during first installation of app to an account (org or user) or one or more repos
 	case *github.InstallationEvent:
 		return s.processGithubInstallationEvent(ctx, event)
@@ -8,6 +8,7 @@ import (
 )
 
 // OrganizationPermissionsForUser resolves organization permissions for a user.
+// The permissions are the union of the permissions of the built-in and custom roles the user has in the org, directly or through user groups.
 func (s *Service) OrganizationPermissionsForUser(ctx context.Context, orgID, userID string) (*adminv1.OrganizationPermissions, error) {
 	roles, err := s.DB.ResolveOrganizationRolesForUser(ctx, userID, orgID)
 	if err != nil {
@@ -53,6 +54,7 @@ func (s *Service) OrganizationPermissionsForDeployment(ctx context.Context, orgI
 }
 
 // ProjectPermissionsForUser resolves project permissions for a user.
+// The permissions are the union of the permissions of the built-in and custom roles the user has in the project, directly or through user groups.
 func (s *Service) ProjectPermissionsForUser(ctx context.Context, projectID, userID string, orgPerms *adminv1.OrganizationPermissions) (*adminv1.ProjectPermissions, error) {
 	// ManageProjects permission on the org gives full access to all projects in the org (only org admins have this)
 	if orgPerms.ManageProjects {
@@ -19,11 +19,22 @@ type Container struct {
 
 // New creates and starts a new Postgres testcontainer. Call Terminate() when done using it.
 func New(t *testing.T) Container {
+	return NewWithSettings(t)
+}
+
+// NewWithSettings is like New, but starts Postgres with the given server settings in the form "name=value" (for example "wal_level=logical").
+func NewWithSettings(t *testing.T, settings ...string) Container {
+	cmd := []string{"postgres"}
+	for _, s := range settings {
+		cmd = append(cmd, "-c", s)
+	}
+
 	ctx := context.Background()
 	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
 		Started: true,
 		ContainerRequest: testcontainers.ContainerRequest{
 			Image:        "postgres:14",
+			Cmd:          cmd,
 			ExposedPorts: []string{"5432/tcp"},
 			WaitingFor:   wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(15 * time.Second),
 			Env: map[string]string{
@@ -0,0 +1,455 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Attributes holds the values of a resource's attributes for evaluating filters.
+// Keys are lowercase attribute paths, such as "username" or "members.value". Empty values are treated as absent.
+// Multi-valued attributes have one value per item, and boolean attributes are represented as "true" or "false".
+type Attributes map[string][]string
+
+// Filter is a parsed filter expression (RFC 7644 section 3.4.2.2).
+type Filter interface {
+	// Match returns true if the resource with the given attributes matches the filter.
+	Match(attrs Attributes) bool
+}
+
+// ParseFilter parses a filter expression, such as `userName eq "jane@example.com"` or `members[value eq "123"]`.
+// It supports all comparison and logical operators and grouping.
+// Within a value path (e.g. `emails[type eq "work" and value co "@example.com"]`), each sub-attribute comparison is evaluated against all items independently.
+// String comparisons are case-insensitive.
+func ParseFilter(s string) (Filter, error) {
+	toks, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	f, err := p.parseOr("")
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q in filter", p.peek().text)
+	}
+	return f, nil
+}
+
+// EqualityValue returns the value that attr must be equal to for a resource to match the filter.
+// It returns false if the filter doesn't require a single non-null value for attr, i.e. unless it is an "eq" comparison of attr or an "and" that includes one.
+// It lets callers look up the candidate resources directly before evaluating the full filter against them.
+// The attribute name must be lowercase, and the returned value is lowercase since string comparisons are case-insensitive.
+func EqualityValue(f Filter, attr string) (string, bool) {
+	switch f := f.(type) {
+	case compareFilter:
+		if f.attr == attr && f.op == "eq" && f.value != "null" {
+			return f.value, true
+		}
+	case andFilter:
+		if v, ok := EqualityValue(f.left, attr); ok {
+			return v, true
+		}
+		return EqualityValue(f.right, attr)
+	}
+	return "", false
+}
+
+// Path is a parsed attribute path of a PATCH operation, such as `members[value eq "123"]` or `name.givenName`.
+type Path struct {
+	// Attribute is the lowercase name of the attribute, such as "members".
+	Attribute string
+	// Filter selects items of a multi-valued attribute. It is nil if the path does not have a value filter.
+	Filter Filter
+	// SubAttribute is the lowercase name of the sub-attribute, such as "givenname". It may be empty.
+	SubAttribute string
+}
+
+// ParsePath parses the path of a PATCH operation.
+func ParsePath(s string) (*Path, error) {
+	s = stripSchema(strings.TrimSpace(s))
+	if s == "" {
+		return nil, fmt.Errorf("empty path")
+	}
+
+	res := &Path{}
+	if i := strings.IndexByte(s, '['); i >= 0 {
+		j := strings.LastIndexByte(s, ']')
+		if j < i {
+			return nil, fmt.Errorf("invalid path %q", s)
+		}
+		f, err := ParseFilter(s[i+1 : j])
+		if err != nil {
+			return nil, err
+		}
+		res.Filter = f
+		rest := s[j+1:]
+		if rest != "" {
+			if !strings.HasPrefix(rest, ".") || len(rest) == 1 {
+				return nil, fmt.Errorf("invalid path %q", s)
+			}
+			res.SubAttribute = strings.ToLower(rest[1:])
+		}
+		s = s[:i]
+	} else if i := strings.IndexByte(s, '.'); i >= 0 {
+		res.SubAttribute = strings.ToLower(s[i+1:])
+		s = s[:i]
+	}
+
+	if !isAttrName(s) {
+		return nil, fmt.Errorf("invalid path %q", s)
+	}
+	res.Attribute = strings.ToLower(s)
+	return res, nil
+}
+
+// stripSchema removes a core schema URN prefix from an attribute path.
+// For example, "urn:ietf:params:scim:schemas:core:2.0:User:userName" becomes "userName".
+func stripSchema(path string) string {
+	for _, schema := range []string{SchemaUser, SchemaGroup} {
+		if len(path) > len(schema) && strings.EqualFold(path[:len(schema)+1], schema+":") {
+			return path[len(schema)+1:]
+		}
+	}
+	return path
+}
+
+func isAttrName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' && r != '$' {
+			return false
+		}
+	}
+	return true
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// lex splits a filter expression into tokens.
+func lex(s string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			toks = append(toks, token{kind: tokenLParen, text: "("})
+			i++
+		case c == ')':
+			toks = append(toks, token{kind: tokenRParen, text: ")"})
+			i++
+		case c == '[':
+			toks = append(toks, token{kind: tokenLBracket, text: "["})
+			i++
+		case c == ']':
+			toks = append(toks, token{kind: tokenRBracket, text: "]"})
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(s); j++ {
+				if s[j] == '\\' {
+					j++
+					continue
+				}
+				if s[j] == '"' {
+					break
+				}
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string in filter")
+			}
+			var str string
+			if err := json.Unmarshal([]byte(s[i:j+1]), &str); err != nil {
+				return nil, fmt.Errorf("invalid string %s in filter", s[i:j+1])
+			}
+			toks = append(toks, token{kind: tokenString, text: str})
+			i = j + 1
+		default:
+			j := i
+			for j < len(s) && !strings.ContainsRune(" \t\n\r()[]\"", rune(s[j])) {
+				j++
+			}
+			toks = append(toks, token{kind: tokenWord, text: s[i:j]})
+			i = j
+		}
+	}
+	return toks, nil
+}
+
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.toks)
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.pos]
+}
+
+func (p *parser) next() (token, error) {
+	if p.done() {
+		return token{}, fmt.Errorf("unexpected end of filter")
+	}
+	t := p.toks[p.pos]
+	p.pos++
+	return t, nil
+}
+
+func (p *parser) peekKeyword(kw string) bool {
+	return !p.done() && p.peek().kind == tokenWord && strings.EqualFold(p.peek().text, kw)
+}
+
+// parseOr parses a sequence of "and" expressions separated by "or".
+// The prefix is prepended to attribute paths when parsing the filter of a value path.
+func (p *parser) parseOr(prefix string) (Filter, error) {
+	left, err := p.parseAnd(prefix)
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd(prefix)
+		if err != nil {
+			return nil, err
+		}
+		left = orFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd(prefix string) (Filter, error) {
+	left, err := p.parseNot(prefix)
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseNot(prefix)
+		if err != nil {
+			return nil, err
+		}
+		left = andFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot(prefix string) (Filter, error) {
+	if p.peekKeyword("not") {
+		p.pos++
+		f, err := p.parseAtom(prefix)
+		if err != nil {
+			return nil, err
+		}
+		return notFilter{f}, nil
+	}
+	return p.parseAtom(prefix)
+}
+
+func (p *parser) parseAtom(prefix string) (Filter, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if t.kind == tokenLParen {
+		f, err := p.parseOr(prefix)
+		if err != nil {
+			return nil, err
+		}
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if t.kind != tokenRParen {
+			return nil, fmt.Errorf("expected ')' in filter, got %q", t.text)
+		}
+		return f, nil
+	}
+
+	if t.kind != tokenWord || !isAttrPath(stripSchema(t.text)) {
+		return nil, fmt.Errorf("expected attribute in filter, got %q", t.text)
+	}
+	attr := prefix + strings.ToLower(stripSchema(t.text))
+
+	// Handle a value path, such as `members[value eq "123"]`
+	if !p.done() && p.peek().kind == tokenLBracket {
+		if prefix != "" {
+			return nil, fmt.Errorf("nested value paths are not supported in filter")
+		}
+		p.pos++
+		f, err := p.parseOr(attr + ".")
+		if err != nil {
+			return nil, err
+		}
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if t.kind != tokenRBracket {
+			return nil, fmt.Errorf("expected ']' in filter, got %q", t.text)
+		}
+		return f, nil
+	}
+
+	t, err = p.next()
+	if err != nil {
+		return nil, err
+	}
+	if t.kind != tokenWord {
+		return nil, fmt.Errorf("expected operator in filter, got %q", t.text)
+	}
+	op := strings.ToLower(t.text)
+	if op == "pr" {
+		return presentFilter{attr: attr}, nil
+	}
+	if _, ok := compareOps[op]; !ok {
+		return nil, fmt.Errorf("unsupported operator %q in filter", t.text)
+	}
+
+	t, err = p.next()
+	if err != nil {
+		return nil, err
+	}
+	var val string
+	switch {
+	case t.kind == tokenString:
+		val = t.text
+	case t.kind == tokenWord && (t.text == "true" || t.text == "false" || t.text == "null" || isNumber(t.text)):
+		val = t.text
+	default:
+		return nil, fmt.Errorf("expected value in filter, got %q", t.text)
+	}
+
+	return compareFilter{attr: attr, op: op, value: strings.ToLower(val)}, nil
+}
+
+func isAttrPath(s string) bool {
+	for _, part := range strings.Split(s, ".") {
+		if !isAttrName(part) {
+			return false
+		}
+	}
+	return true
+}
+
+func isNumber(s string) bool {
+	var f float64
+	return json.Unmarshal([]byte(s), &f) == nil
+}
+
+var compareOps = map[string]func(a, b string) bool{
+	"eq": func(a, b string) bool { return a == b },
+	"ne": func(a, b string) bool { return a != b },
+	"co": strings.Contains,
+	"sw": strings.HasPrefix,
+	"ew": strings.HasSuffix,
+	"gt": func(a, b string) bool { return a > b },
+	"ge": func(a, b string) bool { return a >= b },
+	"lt": func(a, b string) bool { return a < b },
+	"le": func(a, b string) bool { return a <= b },
+}
+
+type compareFilter struct {
+	attr  string
+	op    string
+	value string
+}
+
+func (f compareFilter) Match(attrs Attributes) bool {
+	// Empty values are treated as absent
+	var vals []string
+	for _, v := range attrs[f.attr] {
+		if v != "" {
+			vals = append(vals, strings.ToLower(v))
+		}
+	}
+
+	// An absent attribute is equal to null and not equal to any other value
+	if len(vals) == 0 {
+		switch f.op {
+		case "eq":
+			return f.value == "null"
+		case "ne":
+			return f.value != "null"
+		default:
+			return false
+		}
+	}
+
+	// For "ne", all values must differ. For other operators, any value may match.
+	cmp := compareOps[f.op]
+	if f.op == "ne" {
+		for _, v := range vals {
+			if !cmp(v, f.value) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range vals {
+		if cmp(v, f.value) {
+			return true
+		}
+	}
+	return false
+}
+
+type presentFilter struct {
+	attr string
+}
+
+func (f presentFilter) Match(attrs Attributes) bool {
+	for _, v := range attrs[f.attr] {
+		if v != "" {
+			return true
+		}
+	}
+	return false
+}
+
+type andFilter struct {
+	left, right Filter
+}
+
+func (f andFilter) Match(attrs Attributes) bool {
+	return f.left.Match(attrs) && f.right.Match(attrs)
+}
+
+type orFilter struct {
+	left, right Filter
+}
+
+func (f orFilter) Match(attrs Attributes) bool {
+	return f.left.Match(attrs) || f.right.Match(attrs)
+}
+
+type notFilter struct {
+	inner Filter
+}
+
+func (f notFilter) Match(attrs Attributes) bool {
+	return !f.inner.Match(attrs)
+}
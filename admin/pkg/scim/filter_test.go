@@ -0,0 +1,165 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	jane := Attributes{
+		"id":            {"0c3a3f06"},
+		"username":      {"Jane@Example.com"},
+		"displayname":   {"Jane Doe"},
+		"emails.value":  {"jane@example.com", "jane@personal.com"},
+		"emails.type":   {"work", "home"},
+		"active":        {"true"},
+		"meta.created":  {"2024-03-01T10:00:00Z"},
+		"members.value": nil,
+	}
+
+	tt := []struct {
+		filter string
+		match  bool
+	}{
+		{`userName eq "jane@example.com"`, true},
+		{`USERNAME Eq "JANE@example.com"`, true},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName eq "jane@example.com"`, true},
+		{`userName eq "john@example.com"`, false},
+		{`userName ne "john@example.com"`, true},
+		{`displayName co "doe"`, true},
+		{`displayName sw "jane"`, true},
+		{`displayName ew "jane"`, false},
+		{`active eq true`, true},
+		{`active eq false`, false},
+		{`externalId pr`, false},
+		{`displayName pr`, true},
+		{`externalId eq null`, true},
+		{`meta.created gt "2024-01-01T00:00:00Z"`, true},
+		{`meta.created lt "2024-01-01T00:00:00Z"`, false},
+		{`emails.value eq "jane@personal.com"`, true},
+		{`emails[type eq "work" and value co "@example.com"]`, true},
+		{`emails[value eq "jane@other.com"]`, false},
+		{`userName eq "john@example.com" or displayName sw "Jane"`, true},
+		{`userName eq "jane@example.com" and not (active eq true)`, false},
+		{`(userName eq "x" or userName eq "y") and active eq true`, false},
+		{`displayName eq "Jane \"J\" Doe" or id eq "0c3a3f06"`, true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.filter, func(t *testing.T) {
+			f, err := ParseFilter(tc.filter)
+			require.NoError(t, err)
+			require.Equal(t, tc.match, f.Match(jane))
+		})
+	}
+
+	invalid := []string{
+		``,
+		`userName`,
+		`userName eq`,
+		`userName foo "x"`,
+		`userName eq "x`,
+		`userName eq unquoted`,
+		`(userName eq "x"`,
+		`userName eq "x" and`,
+		`emails[type eq "work"`,
+		`"x" eq userName`,
+	}
+	for _, s := range invalid {
+		_, err := ParseFilter(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	p, err := ParsePath("members")
+	require.NoError(t, err)
+	require.Equal(t, "members", p.Attribute)
+	require.Nil(t, p.Filter)
+	require.Empty(t, p.SubAttribute)
+
+	p, err = ParsePath("name.givenName")
+	require.NoError(t, err)
+	require.Equal(t, "name", p.Attribute)
+	require.Equal(t, "givenname", p.SubAttribute)
+
+	p, err = ParsePath(`members[value eq "123"]`)
+	require.NoError(t, err)
+	require.Equal(t, "members", p.Attribute)
+	require.True(t, p.Filter.Match(Attributes{"value": {"123"}}))
+	require.False(t, p.Filter.Match(Attributes{"value": {"456"}}))
+
+	p, err = ParsePath(`emails[type eq "work"].value`)
+	require.NoError(t, err)
+	require.Equal(t, "emails", p.Attribute)
+	require.Equal(t, "value", p.SubAttribute)
+
+	p, err = ParsePath("urn:ietf:params:scim:schemas:core:2.0:User:active")
+	require.NoError(t, err)
+	require.Equal(t, "active", p.Attribute)
+
+	for _, s := range []string{"", "members[", `members[value eq "1"]x`, "foo bar"} {
+		_, err := ParsePath(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestFilterResources(t *testing.T) {
+	active := true
+	user := &User{
+		ID:          "0c3a3f06",
+		UserName:    "jane@example.com",
+		DisplayName: "Jane Doe",
+		Emails:      []MultiValue{{Value: "jane@example.com", Primary: true}},
+		Active:      &active,
+	}
+	group := &Group{
+		ID:          "9b1d7e2a",
+		DisplayName: "analysts",
+		Members:     []MultiValue{{Value: "0c3a3f06", Display: "jane@example.com"}},
+	}
+
+	tt := []struct {
+		filter string
+		res    interface{ Attributes() Attributes }
+		match  bool
+	}{
+		{`userName eq "jane@example.com" and active eq true`, user, true},
+		{`emails[primary eq true]`, user, true},
+		{`externalId eq null`, user, true},
+		{`externalId pr`, user, false},
+		{`displayName eq "analysts"`, group, true},
+		{`members[value eq "0c3a3f06"]`, group, true},
+		{`members.display co "john"`, group, false},
+	}
+	for _, tc := range tt {
+		f, err := ParseFilter(tc.filter)
+		require.NoError(t, err)
+		require.Equal(t, tc.match, f.Match(tc.res.Attributes()), tc.filter)
+	}
+}
+
+func TestEqualityValue(t *testing.T) {
+	tt := []struct {
+		filter string
+		value  string
+		ok     bool
+	}{
+		{`userName eq "Jane@Example.com"`, "jane@example.com", true},
+		{`userName eq "jane@example.com" and active eq true`, "jane@example.com", true},
+		{`active eq true and (userName eq "jane@example.com")`, "jane@example.com", true},
+		{`userName eq "jane@example.com" or active eq true`, "", false},
+		{`not (userName eq "jane@example.com")`, "", false},
+		{`userName ne "jane@example.com"`, "", false},
+		{`userName eq null`, "", false},
+		{`emails[value eq "jane@example.com"]`, "", false},
+		{`displayName eq "Jane Doe"`, "", false},
+	}
+	for _, tc := range tt {
+		f, err := ParseFilter(tc.filter)
+		require.NoError(t, err)
+		v, ok := EqualityValue(f, "username")
+		require.Equal(t, tc.ok, ok, tc.filter)
+		require.Equal(t, tc.value, v, tc.filter)
+	}
+}
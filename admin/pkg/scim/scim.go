@@ -0,0 +1,254 @@
+// Package scim implements the resources and protocol messages of SCIM 2.0 (RFC 7643 and RFC 7644) used to provision users and groups from an identity provider.
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ContentType is the media type of SCIM requests and responses.
+const ContentType = "application/scim+json"
+
+// Schema URNs of the supported resources and messages.
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// Error types for the scimType field of an Error (RFC 7644 section 3.12).
+const (
+	ErrorTypeInvalidFilter = "invalidFilter"
+	ErrorTypeUniqueness    = "uniqueness"
+	ErrorTypeMutability    = "mutability"
+	ErrorTypeInvalidSyntax = "invalidSyntax"
+	ErrorTypeInvalidPath   = "invalidPath"
+	ErrorTypeInvalidValue  = "invalidValue"
+)
+
+// Meta contains the common metadata of a resource.
+type Meta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+// addAttributes adds the values of the meta attributes for evaluating filters. Timestamps are formatted as RFC 3339 for comparisons.
+func (m *Meta) addAttributes(attrs Attributes) {
+	if m == nil {
+		return
+	}
+	attrs["meta.resourcetype"] = []string{m.ResourceType}
+	if m.Created != nil {
+		attrs["meta.created"] = []string{m.Created.UTC().Format(time.RFC3339Nano)}
+	}
+	if m.LastModified != nil {
+		attrs["meta.lastmodified"] = []string{m.LastModified.UTC().Format(time.RFC3339Nano)}
+	}
+}
+
+// MultiValue is an item of a multi-valued attribute, such as a user's emails or a group's members.
+type MultiValue struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// Name is the components of a user's name.
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// User is a SCIM User resource.
+type User struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	UserName    string       `json:"userName"`
+	Name        *Name        `json:"name,omitempty"`
+	DisplayName string       `json:"displayName,omitempty"`
+	Emails      []MultiValue `json:"emails,omitempty"`
+	Active      *bool        `json:"active,omitempty"`
+	Meta        *Meta        `json:"meta,omitempty"`
+}
+
+// Email returns the email address of the user.
+// It is the userName if it is set, and otherwise the primary (or first) email.
+func (u *User) Email() string {
+	if u.UserName != "" {
+		return u.UserName
+	}
+	for _, e := range u.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+// FullName returns the display name of the user, falling back to the components of its name.
+func (u *User) FullName() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name == nil {
+		return ""
+	}
+	if u.Name.Formatted != "" {
+		return u.Name.Formatted
+	}
+	return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+}
+
+// Attributes returns the values of the user's attributes for evaluating filters.
+func (u *User) Attributes() Attributes {
+	attrs := Attributes{
+		"id":          {u.ID},
+		"externalid":  {u.ExternalID},
+		"username":    {u.UserName},
+		"displayname": {u.DisplayName},
+	}
+	if u.Name != nil {
+		attrs["name.formatted"] = []string{u.Name.Formatted}
+		attrs["name.givenname"] = []string{u.Name.GivenName}
+		attrs["name.familyname"] = []string{u.Name.FamilyName}
+	}
+	for _, e := range u.Emails {
+		attrs["emails"] = append(attrs["emails"], e.Value)
+		attrs["emails.value"] = append(attrs["emails.value"], e.Value)
+		attrs["emails.type"] = append(attrs["emails.type"], e.Type)
+		attrs["emails.primary"] = append(attrs["emails.primary"], strconv.FormatBool(e.Primary))
+	}
+	if u.Active != nil {
+		attrs["active"] = []string{strconv.FormatBool(*u.Active)}
+	}
+	u.Meta.addAttributes(attrs)
+	return attrs
+}
+
+// Group is a SCIM Group resource.
+type Group struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []MultiValue `json:"members"`
+	Meta        *Meta        `json:"meta,omitempty"`
+}
+
+// Attributes returns the values of the group's attributes for evaluating filters.
+func (g *Group) Attributes() Attributes {
+	attrs := Attributes{
+		"id":          {g.ID},
+		"externalid":  {g.ExternalID},
+		"displayname": {g.DisplayName},
+	}
+	for _, m := range g.Members {
+		attrs["members"] = append(attrs["members"], m.Value)
+		attrs["members.value"] = append(attrs["members.value"], m.Value)
+		attrs["members.display"] = append(attrs["members.display"], m.Display)
+	}
+	g.Meta.addAttributes(attrs)
+	return attrs
+}
+
+// ListResponse is the response to a query of resources.
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// NewListResponse creates a ListResponse for a page of resources.
+func NewListResponse(resources []any, total, startIndex int) *ListResponse {
+	if resources == nil {
+		resources = []any{}
+	}
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+// PatchRequest is the body of a PATCH request.
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation is a single operation of a PATCH request.
+type PatchOperation struct {
+	// Op is "add", "remove" or "replace". Some identity providers capitalize it, so it should be compared case-insensitively.
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Error is a SCIM error response. It implements the error interface so it can be returned from handlers.
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// NewError creates an Error with a HTTP status code, an optional SCIM error type and a formatted detail message.
+func NewError(statusCode int, scimType, format string, args ...any) *Error {
+	return &Error{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(statusCode),
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Detail
+}
+
+// StatusCode returns the HTTP status code of the error.
+func (e *Error) StatusCode() int {
+	code, err := strconv.Atoi(e.Status)
+	if err != nil {
+		return http.StatusInternalServerError
+	}
+	return code
+}
+
+// ParseBool parses a boolean attribute value.
+// Some identity providers send booleans as strings (e.g. "False"), so both are accepted.
+func ParseBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return false, fmt.Errorf("invalid boolean value %s", string(raw))
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, fmt.Errorf("invalid boolean value %q", s)
+	}
+	return b, nil
+}
@@ -0,0 +1,440 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v50/github"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/gitutil"
+	"github.com/rilldata/rill/admin/pkg/urlutil"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// previewDeploymentTTL is how long a preview deployment is kept after the last push to its pull request.
+// Preview deployments of pull requests that are left open are torn down by the delete_expired_preview_deployments job.
+const previewDeploymentTTL = 72 * time.Hour
+
+// previewDeploymentMaxSlots is the maximum number of slots allocated for a preview deployment.
+const previewDeploymentMaxSlots = 1
+
+// previewDeploymentTimeout is the timeout for creating or updating a preview deployment in the background.
+const previewDeploymentTimeout = 30 * time.Minute
+
+// previewReconcileTimeout is how long deployPreview waits for a preview deployment to finish reconciling before reporting its status.
+const previewReconcileTimeout = 10 * time.Minute
+
+// previewReconcilePollInterval is how often deployPreview polls a preview deployment's resources while it's reconciling.
+const previewReconcilePollInterval = 5 * time.Second
+
+// previewStatusContext is the context of the commit statuses that report on preview deployments in Github.
+const previewStatusContext = "rill/preview"
+
+// previewEnvironment is the environment of the runtime instances of preview deployments.
+// Projects can configure their previews with environment-specific overrides for it in their YAML files.
+const previewEnvironment = "preview"
+
+// previewVariables returns the variables of preview deployments.
+// Preview deployments run code from branches that have not been reviewed or merged,
+// so they don't receive the project's variables, which may contain credentials for production data sources.
+// They also disable notifications so their reports and alerts don't notify the project's real recipients.
+func previewVariables() map[string]string {
+	return map[string]string{
+		"rill.notifications.disabled": "true",
+	}
+}
+
+// DeploymentBranchAndVariables returns the branch and variables that a deployment of the project should deploy.
+// Preview deployments keep deploying the branch of their pull request, and never receive the project's variables (see previewVariables).
+func DeploymentBranchAndVariables(proj *database.Project, depl *database.Deployment) (string, map[string]string) {
+	if depl.PullRequest != nil {
+		return depl.Branch, previewVariables()
+	}
+	return proj.ProdBranch, proj.ProdVariables
+}
+
+// processGithubPullRequest creates, updates or tears down the preview deployments of a pull request.
+// Preview deployments are only created for projects that have opted in to them.
+func (s *Service) processGithubPullRequest(ctx context.Context, event *github.PullRequestEvent) error {
+	// Find Rill projects matching the repo of the pull request
+	repo := event.GetRepo()
+	projects, err := s.DB.FindProjectsByGithubURL(ctx, repo.GetHTMLURL())
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// App is installed on repo not currently deployed. Do nothing.
+			return nil
+		}
+		return err
+	}
+
+	pr := event.GetPullRequest()
+	head := pr.GetHead()
+
+	for _, proj := range projects {
+		switch event.GetAction() {
+		case "opened", "reopened", "synchronize":
+			if !proj.PreviewDeployments {
+				continue
+			}
+
+			// We can only deploy branches of the project's repo (not of forks) since the runtime clones the project's repo
+			if head.GetRepo().GetID() != repo.GetID() {
+				continue
+			}
+
+			// The prod deployment already deploys the prod branch
+			if head.GetRef() == proj.ProdBranch {
+				continue
+			}
+
+			// Creating a deployment takes longer than Github's webhook timeout, so we deploy in the background
+			proj, pullRequest, branch, sha := proj, pr.GetNumber(), head.GetRef(), head.GetSHA()
+			s.previews.run(proj.ID, pullRequest, func(ctx context.Context) {
+				s.deployPreview(ctx, proj, pullRequest, branch, sha)
+			})
+		case "closed":
+			// Not checking PreviewDeployments since the preview may have been created before the project opted out.
+			// The teardown runs after any in-progress deploy of the pull request has finished, so it doesn't race with it.
+			proj, pullRequest := proj, pr.GetNumber()
+			s.previews.run(proj.ID, pullRequest, func(ctx context.Context) {
+				err := s.teardownPreviewDeployment(ctx, proj, pullRequest)
+				if err != nil {
+					s.Logger.Error("preview: failed to teardown deployment", zap.String("project_id", proj.ID), zap.Int("pull_request", pullRequest), zap.Error(err), observability.ZapCtx(ctx))
+				}
+			})
+		}
+	}
+
+	return nil
+}
+
+// deployPreview creates or updates the preview deployment of a pull request and reports its status on the head commit.
+// The final status is reported once the deployment has finished reconciling the commit, and links to the preview in the frontend.
+func (s *Service) deployPreview(ctx context.Context, proj *database.Project, pullRequest int, branch, sha string) {
+	s.setPreviewStatus(ctx, proj, sha, "pending", "Deploying preview", "")
+
+	depl, err := s.CreateOrUpdatePreviewDeployment(ctx, proj, pullRequest, branch)
+	if err != nil {
+		if ctx.Err() != nil {
+			// The service is shutting down
+			return
+		}
+		s.Logger.Error("preview: failed to deploy", zap.String("project_id", proj.ID), zap.Int("pull_request", pullRequest), zap.Error(err), observability.ZapCtx(ctx))
+		s.setPreviewStatus(ctx, proj, sha, "error", "Failed to deploy preview", "")
+		return
+	}
+
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		s.Logger.Error("preview: could not find org", zap.String("project_id", proj.ID), zap.Error(err), observability.ZapCtx(ctx))
+		return
+	}
+	targetURL := s.previewURL(org, proj, depl.Branch)
+
+	s.setPreviewStatus(ctx, proj, sha, "pending", "Reconciling preview", targetURL)
+
+	errs, err := s.awaitPreviewReconcile(ctx, depl, sha)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			// The service is shutting down
+			return
+		}
+		s.Logger.Error("preview: failed to await reconcile", zap.String("project_id", proj.ID), zap.Int("pull_request", pullRequest), zap.Error(err), observability.ZapCtx(ctx))
+		s.setPreviewStatus(ctx, proj, sha, "error", "Preview did not finish reconciling", targetURL)
+		return
+	}
+	if errs > 0 {
+		s.setPreviewStatus(ctx, proj, sha, "failure", fmt.Sprintf("Preview deployed with %d errors", errs), targetURL)
+		return
+	}
+
+	s.setPreviewStatus(ctx, proj, sha, "success", "Preview deployed", targetURL)
+}
+
+// awaitPreviewReconcile polls a preview deployment until it has parsed the given commit and all its resources are idle.
+// It returns the number of parse and reconcile errors of the deployment's resources.
+func (s *Service) awaitPreviewReconcile(ctx context.Context, depl *database.Deployment, sha string) (int, error) {
+	rt, err := s.openRuntimeClientForDeployment(depl)
+	if err != nil {
+		return 0, err
+	}
+	defer rt.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, previewReconcileTimeout)
+	defer cancel()
+	ticker := time.NewTicker(previewReconcilePollInterval)
+	defer ticker.Stop()
+	for {
+		res, err := rt.ListResources(ctx, &runtimev1.ListResourcesRequest{InstanceId: depl.RuntimeInstanceID})
+		if err == nil {
+			parsed := false
+			idle := true
+			errs := 0
+			for _, r := range res.Resources {
+				if pp := r.GetProjectParser(); pp != nil {
+					parsed = pp.State.CurrentCommitSha == sha
+					errs += len(pp.State.ParseErrors)
+				}
+				if r.Meta.ReconcileStatus != runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE {
+					idle = false
+				}
+				if r.Meta.ReconcileError != "" {
+					errs++
+				}
+			}
+			if parsed && idle {
+				return errs, nil
+			}
+		} else {
+			// The runtime instance may still be starting
+			s.Logger.Debug("preview: failed to list resources", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// CreateOrUpdatePreviewDeployment creates a preview deployment of a pull request's branch.
+// If the pull request already has a preview deployment, it extends its expiration time and triggers a reconcile instead.
+func (s *Service) CreateOrUpdatePreviewDeployment(ctx context.Context, proj *database.Project, pullRequest int, branch string) (*database.Deployment, error) {
+	expiresOn := time.Now().Add(previewDeploymentTTL)
+
+	depl, err := s.DB.FindPreviewDeployment(ctx, proj.ID, pullRequest)
+	if err == nil {
+		depl, err = s.DB.UpdateDeploymentExpiresOn(ctx, depl.ID, expiresOn)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.TriggerReconcile(ctx, depl)
+		if err != nil {
+			return nil, err
+		}
+
+		return depl, nil
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, err
+	}
+
+	// Previews of projects with an external OLAP database would share (and possibly overwrite) the tables of the prod deployment
+	switch proj.ProdOLAPDriver {
+	case "duckdb", "duckdb-ext-storage":
+	default:
+		return nil, fmt.Errorf("preview deployments are not supported for OLAP driver %q", proj.ProdOLAPDriver)
+	}
+
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Preview deployments count towards the org's quotas
+	slots := min(proj.ProdSlots, previewDeploymentMaxSlots)
+	stats, err := s.DB.CountDeploymentsForOrganization(ctx, org.ID)
+	if err != nil {
+		return nil, err
+	}
+	if org.QuotaDeployments >= 0 && stats.Deployments >= org.QuotaDeployments {
+		return nil, fmt.Errorf("quota exceeded: org %q is limited to %d deployments", org.Name, org.QuotaDeployments)
+	}
+	if org.QuotaSlotsTotal >= 0 && stats.Slots+slots > org.QuotaSlotsTotal {
+		return nil, fmt.Errorf("quota exceeded: org %q is limited to %d total slots", org.Name, org.QuotaSlotsTotal)
+	}
+
+	depl, err = s.createDeployment(ctx, &createDeploymentOptions{
+		ProjectID:      proj.ID,
+		Provisioner:    proj.Provisioner,
+		Annotations:    s.NewDeploymentAnnotations(org, proj),
+		ProdBranch:     branch,
+		ProdVariables:  previewVariables(),
+		ProdOLAPDriver: proj.ProdOLAPDriver,
+		ProdOLAPDSN:    proj.ProdOLAPDSN,
+		ProdSlots:      slots,
+		ProdVersion:    proj.ProdVersion,
+		Environment:    previewEnvironment,
+		PullRequest:    &pullRequest,
+		ExpiresOn:      &expiresOn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("preview: created deployment", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.Int("pull_request", pullRequest), zap.String("branch", branch), observability.ZapCtx(ctx))
+
+	return depl, nil
+}
+
+// DeleteExpiredPreviewDeployments tears down preview deployments that have passed their expiration time.
+func (s *Service) DeleteExpiredPreviewDeployments(ctx context.Context) error {
+	depls, err := s.DB.FindExpiredPreviewDeployments(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, depl := range depls {
+		s.Logger.Info("preview: deleting expired deployment", zap.String("project_id", depl.ProjectID), zap.String("deployment_id", depl.ID), zap.Intp("pull_request", depl.PullRequest), observability.ZapCtx(ctx))
+
+		err := s.teardownDeployment(ctx, depl)
+		if err != nil {
+			s.Logger.Error("preview: teardown deployment error", zap.String("project_id", depl.ProjectID), zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+			continue
+		}
+	}
+
+	return nil
+}
+
+// teardownPreviewDeployment tears down the preview deployment of a pull request if it has one.
+func (s *Service) teardownPreviewDeployment(ctx context.Context, proj *database.Project, pullRequest int) error {
+	depl, err := s.DB.FindPreviewDeployment(ctx, proj.ID, pullRequest)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	s.Logger.Info("preview: deleting deployment", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.Int("pull_request", pullRequest), observability.ZapCtx(ctx))
+
+	return s.teardownDeployment(ctx, depl)
+}
+
+// teardownPreviewDeployments tears down all preview deployments of a project.
+func (s *Service) teardownPreviewDeployments(ctx context.Context, proj *database.Project) error {
+	ds, err := s.DB.FindDeploymentsForProject(ctx, proj.ID)
+	if err != nil {
+		return err
+	}
+
+	var multiErr error
+	for _, d := range ds {
+		if d.PullRequest == nil {
+			continue
+		}
+
+		err := s.teardownDeployment(ctx, d)
+		if err != nil {
+			multiErr = multierr.Combine(multiErr, fmt.Errorf("unable to delete deployment %q: %w", d.ID, err))
+		}
+	}
+	return multiErr
+}
+
+// setPreviewStatus sets the status of the preview deployment on a commit in Github.
+// Errors are logged and not returned since the status is informational.
+func (s *Service) setPreviewStatus(ctx context.Context, proj *database.Project, sha, state, description, targetURL string) {
+	if proj.GithubURL == nil || proj.GithubInstallationID == nil {
+		return
+	}
+
+	account, repo, ok := gitutil.SplitGithubURL(*proj.GithubURL)
+	if !ok {
+		return
+	}
+
+	gh, err := s.Github.InstallationClient(*proj.GithubInstallationID)
+	if err != nil {
+		s.Logger.Error("preview: failed to create github installation client", zap.String("project_id", proj.ID), zap.Error(err), observability.ZapCtx(ctx))
+		return
+	}
+
+	status := &github.RepoStatus{
+		State:       github.String(state),
+		Description: github.String(description),
+		Context:     github.String(previewStatusContext),
+	}
+	if targetURL != "" {
+		status.TargetURL = github.String(targetURL)
+	}
+
+	_, _, err = gh.Repositories.CreateStatus(ctx, account, repo, sha, status)
+	if err != nil {
+		s.Logger.Warn("preview: failed to set github commit status", zap.String("project_id", proj.ID), zap.String("sha", sha), zap.Error(err), observability.ZapCtx(ctx))
+	}
+}
+
+// previewURL returns the frontend URL for viewing the preview deployment of a branch.
+func (s *Service) previewURL(org *database.Organization, proj *database.Project, branch string) string {
+	u, err := urlutil.WithQuery(urlutil.MustJoinURL(s.opts.FrontendURL, org.Name, proj.Name), map[string]string{"branch": branch})
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// previewDeployer runs the background tasks that deploy and tear down preview deployments.
+// Tasks for the same pull request run one at a time in the order they were started,
+// so a teardown or a deploy of a new push never races with an in-progress deploy of the same pull request.
+type previewDeployer struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	mu     sync.Mutex
+	tasks  map[string]chan struct{} // Closed when the most recently started task for a pull request finishes
+	wg     sync.WaitGroup
+}
+
+func newPreviewDeployer() *previewDeployer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &previewDeployer{
+		ctx:    ctx,
+		cancel: cancel,
+		tasks:  make(map[string]chan struct{}),
+	}
+}
+
+// run starts fn in the background once the pull request's previous task (if any) has finished.
+// The ctx passed to fn times out after previewDeploymentTimeout and is cancelled when the deployer is closed.
+func (d *previewDeployer) run(projectID string, pullRequest int, fn func(ctx context.Context)) {
+	key := fmt.Sprintf("%s/%d", projectID, pullRequest)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	prev := d.tasks[key]
+	done := make(chan struct{})
+	d.tasks[key] = done
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		defer close(done)
+
+		if prev != nil {
+			<-prev
+		}
+
+		ctx, cancel := context.WithTimeout(d.ctx, previewDeploymentTimeout)
+		defer cancel()
+		if ctx.Err() == nil {
+			fn(ctx)
+		}
+
+		d.mu.Lock()
+		if d.tasks[key] == done {
+			delete(d.tasks, key)
+		}
+		d.mu.Unlock()
+	}()
+}
+
+// wait blocks until all started tasks have finished.
+func (d *previewDeployer) wait() {
+	d.wg.Wait()
+}
+
+// Close cancels the running tasks and waits for them to finish.
+func (d *previewDeployer) Close() {
+	d.cancel()
+	d.wait()
+}
@@ -0,0 +1,496 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v50/github"
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/provisioner"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	runtimeauth "github.com/rilldata/rill/runtime/server/auth"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func TestPreviewDeployments(t *testing.T) {
+	ctx := context.Background()
+
+	rt := newFakeRuntime(t)
+	gh := newFakeGithub(t)
+	prov := &fakeProvisioner{host: rt.host}
+
+	installationID := int64(1)
+	githubURL := "https://github.com/acme/dashboards"
+	org := &database.Organization{ID: "org", Name: "acme", QuotaDeployments: -1, QuotaSlotsTotal: -1}
+	proj := &database.Project{
+		ID:                   "proj",
+		OrganizationID:       org.ID,
+		Name:                 "dashboards",
+		Provisioner:          "fake",
+		GithubURL:            &githubURL,
+		GithubInstallationID: &installationID,
+		ProdBranch:           "main",
+		ProdVariables:        map[string]string{"connector.s3.aws_secret_access_key": "secret"},
+		ProdOLAPDriver:       "duckdb",
+		ProdSlots:            2,
+		ProdVersion:          "latest",
+		PreviewDeployments:   true,
+	}
+	db := &fakeDB{orgs: []*database.Organization{org}, projects: []*database.Project{proj}}
+
+	issuer, err := runtimeauth.NewEphemeralIssuer("")
+	require.NoError(t, err)
+
+	s := &Service{
+		DB:             db,
+		ProvisionerSet: map[string]provisioner.Provisioner{"fake": prov},
+		Github:         gh,
+		Logger:         zap.NewNop(),
+		opts:           &Options{ExternalURL: "http://localhost:9090", FrontendURL: "http://localhost:3000"},
+		issuer:         issuer,
+		previews:       newPreviewDeployer(),
+	}
+	defer s.previews.Close()
+
+	event := func(action, sha string, headRepoID int64) *github.PullRequestEvent {
+		return &github.PullRequestEvent{
+			Action: github.String(action),
+			Repo:   &github.Repository{ID: github.Int64(1), HTMLURL: github.String(githubURL)},
+			PullRequest: &github.PullRequest{
+				Number: github.Int(7),
+				Head: &github.PullRequestBranch{
+					Ref:  github.String("feature"),
+					SHA:  github.String(sha),
+					Repo: &github.Repository{ID: github.Int64(headRepoID)},
+				},
+			},
+		}
+	}
+
+	// Opening a pull request creates a preview deployment of its branch
+	rt.setCommit("sha1", 0)
+	require.NoError(t, s.ProcessGithubEvent(ctx, event("opened", "sha1", 1)))
+	s.previews.wait()
+
+	depls := db.deploymentsForProject(proj.ID)
+	require.Len(t, depls, 1)
+	depl := depls[0]
+	require.Equal(t, "feature", depl.Branch)
+	require.Equal(t, 7, *depl.PullRequest)
+	require.Equal(t, 1, depl.Slots)
+	require.Equal(t, database.DeploymentStatusOK, depl.Status)
+	expiresOn := *depl.ExpiresOn
+
+	// The preview runs in the preview environment without the project's variables
+	instances := rt.createdInstances()
+	require.Len(t, instances, 1)
+	require.Equal(t, "preview", instances[0].Environment)
+	require.Equal(t, map[string]string{"rill.notifications.disabled": "true"}, instances[0].Variables)
+	// The status is reported once the commit has been reconciled, and links to the preview
+	require.Equal(t, []string{"sha1:pending", "sha1:pending", "sha1:success"}, gh.statuses())
+	require.Equal(t, "http://localhost:3000/acme/dashboards?branch=feature", gh.lastTargetURL())
+
+	// New commits update the existing preview
+	// Resources with errors fail the status
+	rt.setCommit("sha2", 1)
+	require.NoError(t, s.ProcessGithubEvent(ctx, event("synchronize", "sha2", 1)))
+	s.previews.wait()
+
+	depls = db.deploymentsForProject(proj.ID)
+	require.Len(t, depls, 1)
+	require.Equal(t, depl.ID, depls[0].ID)
+	require.True(t, depls[0].ExpiresOn.After(expiresOn))
+	require.Len(t, rt.createdInstances(), 1)
+	require.Equal(t, 1, rt.triggerCount())
+	require.Equal(t, []string{"sha1:pending", "sha1:pending", "sha1:success", "sha2:pending", "sha2:pending", "sha2:failure"}, gh.statuses())
+
+	// Pull requests from forks are not deployed
+	require.NoError(t, s.ProcessGithubEvent(ctx, event("synchronize", "sha3", 2)))
+	s.previews.wait()
+	require.Equal(t, 1, rt.triggerCount())
+
+	// Closing the pull request tears down the preview
+	require.NoError(t, s.ProcessGithubEvent(ctx, event("closed", "sha2", 1)))
+	s.previews.wait()
+
+	require.Empty(t, db.deploymentsForProject(proj.ID))
+	require.Equal(t, []string{depl.RuntimeInstanceID}, rt.deletedInstances())
+	require.Equal(t, []string{depl.ProvisionID}, prov.deprovisioned())
+
+	// Projects that have not opted in don't get previews
+	proj.PreviewDeployments = false
+	require.NoError(t, s.ProcessGithubEvent(ctx, event("opened", "sha4", 1)))
+	s.previews.wait()
+	require.Empty(t, db.deploymentsForProject(proj.ID))
+	require.Len(t, rt.createdInstances(), 1)
+}
+
+func TestPreviewDeployerSerializesTasks(t *testing.T) {
+	d := newPreviewDeployer()
+	defer d.Close()
+
+	// Tasks for the same pull request run in order, one at a time
+	var mu sync.Mutex
+	var order []int
+	release := make(chan struct{})
+	d.run("proj", 1, func(ctx context.Context) {
+		<-release
+		mu.Lock()
+		order = append(order, 1)
+		mu.Unlock()
+	})
+	d.run("proj", 1, func(ctx context.Context) {
+		mu.Lock()
+		order = append(order, 2)
+		mu.Unlock()
+	})
+
+	// Tasks for other pull requests are not blocked
+	other := make(chan struct{})
+	d.run("proj", 2, func(ctx context.Context) {
+		close(other)
+	})
+	<-other
+
+	close(release)
+	d.wait()
+	require.Equal(t, []int{1, 2}, order)
+
+	// Closing the deployer cancels running tasks
+	started := make(chan struct{})
+	d.run("proj", 1, func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+	})
+	<-started
+	d.Close()
+}
+
+// fakeDB implements the parts of database.DB used by preview deployments.
+type fakeDB struct {
+	database.DB
+	mu          sync.Mutex
+	orgs        []*database.Organization
+	projects    []*database.Project
+	deployments []*database.Deployment
+}
+
+func (db *fakeDB) deploymentsForProject(projectID string) []*database.Deployment {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	var res []*database.Deployment
+	for _, d := range db.deployments {
+		if d.ProjectID == projectID {
+			cpy := *d
+			res = append(res, &cpy)
+		}
+	}
+	return res
+}
+
+func (db *fakeDB) FindOrganization(ctx context.Context, id string) (*database.Organization, error) {
+	for _, o := range db.orgs {
+		if o.ID == id {
+			return o, nil
+		}
+	}
+	return nil, database.ErrNotFound
+}
+
+func (db *fakeDB) FindProjectsByGithubURL(ctx context.Context, githubURL string) ([]*database.Project, error) {
+	var res []*database.Project
+	for _, p := range db.projects {
+		if p.GithubURL != nil && *p.GithubURL == githubURL {
+			res = append(res, p)
+		}
+	}
+	if len(res) == 0 {
+		return nil, database.ErrNotFound
+	}
+	return res, nil
+}
+
+func (db *fakeDB) FindDeploymentsForProject(ctx context.Context, projectID string) ([]*database.Deployment, error) {
+	return db.deploymentsForProject(projectID), nil
+}
+
+func (db *fakeDB) FindPreviewDeployment(ctx context.Context, projectID string, pullRequest int) (*database.Deployment, error) {
+	for _, d := range db.deploymentsForProject(projectID) {
+		if d.PullRequest != nil && *d.PullRequest == pullRequest {
+			return d, nil
+		}
+	}
+	return nil, database.ErrNotFound
+}
+
+func (db *fakeDB) CountDeploymentsForOrganization(ctx context.Context, orgID string) (*database.DeploymentsCount, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	res := &database.DeploymentsCount{}
+	for _, d := range db.deployments {
+		res.Deployments++
+		res.Slots += d.Slots
+	}
+	return res, nil
+}
+
+func (db *fakeDB) InsertDeployment(ctx context.Context, opts *database.InsertDeploymentOptions) (*database.Deployment, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	d := &database.Deployment{
+		ID:                uuid.NewString(),
+		ProjectID:         opts.ProjectID,
+		Provisioner:       opts.Provisioner,
+		ProvisionID:       opts.ProvisionID,
+		RuntimeVersion:    opts.RuntimeVersion,
+		Slots:             opts.Slots,
+		Branch:            opts.Branch,
+		RuntimeHost:       opts.RuntimeHost,
+		RuntimeInstanceID: opts.RuntimeInstanceID,
+		RuntimeAudience:   opts.RuntimeAudience,
+		Status:            opts.Status,
+		PullRequest:       opts.PullRequest,
+		ExpiresOn:         opts.ExpiresOn,
+	}
+	db.deployments = append(db.deployments, d)
+	cpy := *d
+	return &cpy, nil
+}
+
+func (db *fakeDB) DeleteDeployment(ctx context.Context, id string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for i, d := range db.deployments {
+		if d.ID == id {
+			db.deployments = append(db.deployments[:i], db.deployments[i+1:]...)
+			return nil
+		}
+	}
+	return database.ErrNotFound
+}
+
+func (db *fakeDB) updateDeployment(id string, fn func(d *database.Deployment)) (*database.Deployment, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, d := range db.deployments {
+		if d.ID == id {
+			fn(d)
+			cpy := *d
+			return &cpy, nil
+		}
+	}
+	return nil, database.ErrNotFound
+}
+
+func (db *fakeDB) UpdateDeploymentStatus(ctx context.Context, id string, status database.DeploymentStatus, msg string) (*database.Deployment, error) {
+	return db.updateDeployment(id, func(d *database.Deployment) {
+		d.Status = status
+		d.StatusMessage = msg
+	})
+}
+
+func (db *fakeDB) UpdateDeploymentExpiresOn(ctx context.Context, id string, expiresOn time.Time) (*database.Deployment, error) {
+	return db.updateDeployment(id, func(d *database.Deployment) {
+		d.ExpiresOn = &expiresOn
+	})
+}
+
+func (db *fakeDB) InsertDeploymentAuthToken(ctx context.Context, opts *database.InsertDeploymentAuthTokenOptions) (*database.DeploymentAuthToken, error) {
+	return &database.DeploymentAuthToken{ID: opts.ID, SecretHash: opts.SecretHash, DeploymentID: opts.DeploymentID, ExpiresOn: opts.ExpiresOn}, nil
+}
+
+// fakeProvisioner allocates all deployments on a single runtime.
+type fakeProvisioner struct {
+	host             string
+	mu               sync.Mutex
+	deprovisionedIDs []string
+}
+
+func (p *fakeProvisioner) Provision(ctx context.Context, opts *provisioner.ProvisionOptions) (*provisioner.Allocation, error) {
+	return &provisioner.Allocation{Host: p.host, Audience: p.host, CPU: 1, MemoryGB: 1, StorageBytes: 1}, nil
+}
+
+func (p *fakeProvisioner) Deprovision(ctx context.Context, provisionID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.deprovisionedIDs = append(p.deprovisionedIDs, provisionID)
+	return nil
+}
+
+func (p *fakeProvisioner) deprovisioned() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.deprovisionedIDs
+}
+
+func (p *fakeProvisioner) AwaitReady(ctx context.Context, provisionID string) error {
+	return nil
+}
+
+func (p *fakeProvisioner) Update(ctx context.Context, provisionID, newVersion string) error {
+	return nil
+}
+
+func (p *fakeProvisioner) CheckCapacity(ctx context.Context) error {
+	return nil
+}
+
+// fakeRuntime is a runtime server that records the instance and trigger requests it receives.
+type fakeRuntime struct {
+	runtimev1.UnimplementedRuntimeServiceServer
+	host     string
+	mu       sync.Mutex
+	created  []*runtimev1.CreateInstanceRequest
+	deleted  []string
+	triggers int
+	commit   string
+	errs     int
+}
+
+func newFakeRuntime(t *testing.T) *fakeRuntime {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	rt := &fakeRuntime{host: "http://" + lis.Addr().String()}
+	srv := grpc.NewServer()
+	runtimev1.RegisterRuntimeServiceServer(srv, rt)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	return rt
+}
+
+func (r *fakeRuntime) CreateInstance(ctx context.Context, req *runtimev1.CreateInstanceRequest) (*runtimev1.CreateInstanceResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.created = append(r.created, req)
+	return &runtimev1.CreateInstanceResponse{}, nil
+}
+
+func (r *fakeRuntime) DeleteInstance(ctx context.Context, req *runtimev1.DeleteInstanceRequest) (*runtimev1.DeleteInstanceResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deleted = append(r.deleted, req.InstanceId)
+	return &runtimev1.DeleteInstanceResponse{}, nil
+}
+
+func (r *fakeRuntime) CreateTrigger(ctx context.Context, req *runtimev1.CreateTriggerRequest) (*runtimev1.CreateTriggerResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.triggers++
+	return &runtimev1.CreateTriggerResponse{}, nil
+}
+
+func (r *fakeRuntime) ListResources(ctx context.Context, req *runtimev1.ListResourcesRequest) (*runtimev1.ListResourcesResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := &runtimev1.ListResourcesResponse{
+		Resources: []*runtimev1.Resource{{
+			Meta: &runtimev1.ResourceMeta{ReconcileStatus: runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE},
+			Resource: &runtimev1.Resource_ProjectParser{ProjectParser: &runtimev1.ProjectParser{
+				State: &runtimev1.ProjectParserState{CurrentCommitSha: r.commit},
+			}},
+		}},
+	}
+	for i := 0; i < r.errs; i++ {
+		res.Resources = append(res.Resources, &runtimev1.Resource{
+			Meta: &runtimev1.ResourceMeta{ReconcileStatus: runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE, ReconcileError: "failed"},
+		})
+	}
+	return res, nil
+}
+
+// setCommit sets the commit that the runtime reports as reconciled, and the number of resources with reconcile errors.
+func (r *fakeRuntime) setCommit(sha string, errs int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.commit = sha
+	r.errs = errs
+}
+
+func (r *fakeRuntime) createdInstances() []*runtimev1.CreateInstanceRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.created
+}
+
+func (r *fakeRuntime) deletedInstances() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.deleted
+}
+
+func (r *fakeRuntime) triggerCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.triggers
+}
+
+// fakeGithub is a Github API server that records the commit statuses it receives.
+type fakeGithub struct {
+	url     string
+	mu      sync.Mutex
+	sets    []string
+	targets []string
+}
+
+func newFakeGithub(t *testing.T) *fakeGithub {
+	gh := &fakeGithub{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var status github.RepoStatus
+		if err := json.NewDecoder(r.Body).Decode(&status); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		gh.mu.Lock()
+		gh.sets = append(gh.sets, path.Base(r.URL.Path)+":"+status.GetState())
+		gh.targets = append(gh.targets, status.GetTargetURL())
+		gh.mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(srv.Close)
+	gh.url = srv.URL + "/"
+	return gh
+}
+
+func (g *fakeGithub) statuses() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.sets
+}
+
+func (g *fakeGithub) lastTargetURL() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.targets[len(g.targets)-1]
+}
+
+func (g *fakeGithub) AppClient() *github.Client {
+	return nil
+}
+
+func (g *fakeGithub) InstallationClient(installationID int64) (*github.Client, error) {
+	c := github.NewClient(nil)
+	u, err := url.Parse(g.url)
+	if err != nil {
+		return nil, err
+	}
+	c.BaseURL = u
+	return c, nil
+}
+
+func (g *fakeGithub) InstallationToken(ctx context.Context, installationID int64) (string, error) {
+	return "", nil
+}
@@ -23,11 +23,11 @@ func (s *Service) CreateProject(ctx context.Context, org *database.Organization,
 	}
 
 	// Get roles for initial setup
-	adminRole, err := s.DB.FindProjectRole(ctx, database.ProjectRoleNameAdmin)
+	adminRole, err := s.DB.FindProjectRole(ctx, org.ID, database.ProjectRoleNameAdmin)
 	if err != nil {
 		panic(err)
 	}
-	viewerRole, err := s.DB.FindProjectRole(ctx, database.ProjectRoleNameViewer)
+	viewerRole, err := s.DB.FindProjectRole(ctx, org.ID, database.ProjectRoleNameViewer)
 	if err != nil {
 		panic(err)
 	}
@@ -95,6 +95,7 @@ func (s *Service) CreateProject(ctx context.Context, org *database.Organization,
 		ProdVariables:        proj.ProdVariables,
 		ProdSlots:            proj.ProdSlots,
 		ProdTTLSeconds:       proj.ProdTTLSeconds,
+		PreviewDeployments:   proj.PreviewDeployments,
 		ProdDeploymentID:     &depl.ID,
 		Annotations:          proj.Annotations,
 	})
@@ -135,21 +136,81 @@ func (s *Service) TeardownProject(ctx context.Context, p *database.Project) erro
 // UpdateProject updates a project and any impacted deployments.
 // It runs a reconcile if deployment parameters (like branch or variables) have been changed and reconcileDeployment is set.
 func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opts *database.UpdateProjectOptions) (*database.Project, error) {
-	requiresReset := (proj.Provisioner != opts.Provisioner) || (proj.ProdSlots != opts.ProdSlots) || (proj.ProdVersion != opts.ProdVersion)
+	updated, err := s.DB.UpdateProject(ctx, proj.ID, opts)
+	if err != nil {
+		return nil, err
+	}
 
-	impactsDeployments := (requiresReset ||
-		(proj.Name != opts.Name) ||
-		(proj.ProdBranch != opts.ProdBranch) ||
-		!reflect.DeepEqual(proj.Annotations, opts.Annotations) ||
-		!reflect.DeepEqual(proj.ProdVariables, opts.ProdVariables) ||
-		!reflect.DeepEqual(proj.GithubURL, opts.GithubURL) ||
-		!reflect.DeepEqual(proj.GithubInstallationID, opts.GithubInstallationID))
-
-	proj, err := s.DB.UpdateProject(ctx, proj.ID, opts)
+	return s.updateProjectDeployments(ctx, proj, updated)
+}
+
+// UpdateProjectVariables updates a project's prod variables and records the change in the org's audit log.
+// Like UpdateProject, it then updates the project's deployments.
+func (s *Service) UpdateProjectVariables(ctx context.Context, proj *database.Project, variables map[string]string, actor *AuditActor) (*database.Project, error) {
+	txCtx, tx, err := s.DB.NewTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	updated, err := s.DB.UpdateProject(txCtx, proj.ID, &database.UpdateProjectOptions{
+		Name:                 proj.Name,
+		Description:          proj.Description,
+		Public:               proj.Public,
+		GithubURL:            proj.GithubURL,
+		GithubInstallationID: proj.GithubInstallationID,
+		ProdVersion:          proj.ProdVersion,
+		ProdBranch:           proj.ProdBranch,
+		ProdVariables:        variables,
+		ProdDeploymentID:     proj.ProdDeploymentID,
+		ProdSlots:            proj.ProdSlots,
+		ProdTTLSeconds:       proj.ProdTTLSeconds,
+		PreviewDeployments:   proj.PreviewDeployments,
+		Provisioner:          proj.Provisioner,
+		Annotations:          proj.Annotations,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.RecordAuditEvent(txCtx, actor, &AuditEvent{
+		OrgID:   proj.OrganizationID,
+		Project: proj,
+		Action:  database.AuditActionProjectVariablesUpdate,
+		Details: variablesDiff(proj.ProdVariables, variables),
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.updateProjectDeployments(ctx, proj, updated)
+}
+
+// updateProjectDeployments updates the deployments of a project after the project has been changed from prev to proj.
+func (s *Service) updateProjectDeployments(ctx context.Context, prev, proj *database.Project) (*database.Project, error) {
+	// Tear down preview deployments if the project has opted out of them
+	if prev.PreviewDeployments && !proj.PreviewDeployments {
+		err := s.teardownPreviewDeployments(ctx, proj)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	requiresReset := (prev.Provisioner != proj.Provisioner) || (prev.ProdSlots != proj.ProdSlots) || (prev.ProdVersion != proj.ProdVersion)
+
+	impactsDeployments := (requiresReset ||
+		(prev.Name != proj.Name) ||
+		(prev.ProdBranch != proj.ProdBranch) ||
+		!reflect.DeepEqual(prev.Annotations, proj.Annotations) ||
+		!reflect.DeepEqual(prev.ProdVariables, proj.ProdVariables) ||
+		!reflect.DeepEqual(prev.GithubURL, proj.GithubURL) ||
+		!reflect.DeepEqual(prev.GithubInstallationID, proj.GithubInstallationID))
+
 	if !impactsDeployments {
 		return proj, nil
 	}
@@ -159,6 +220,7 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 
 		var oldDepl *database.Deployment
 		if proj.ProdDeploymentID != nil {
+			var err error
 			oldDepl, err = s.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
 			if err != nil && !errors.Is(err, database.ErrNotFound) {
 				return nil, err
@@ -181,13 +243,12 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 		return nil, err
 	}
 
-	// NOTE: This assumes every deployment (almost always, there's just one) deploys the prod branch.
-	// It needs to be refactored when implementing preview deploys.
 	for _, d := range ds {
+		branch, variables := DeploymentBranchAndVariables(proj, d)
 		err := s.UpdateDeployment(ctx, d, &UpdateDeploymentOptions{
-			Version:         opts.ProdVersion,
-			Branch:          opts.ProdBranch,
-			Variables:       opts.ProdVariables,
+			Version:         proj.ProdVersion,
+			Branch:          branch,
+			Variables:       variables,
 			Annotations:     annotations,
 			EvictCachedRepo: true,
 		})
@@ -219,9 +280,10 @@ func (s *Service) UpdateOrgDeploymentAnnotations(ctx context.Context, org *datab
 			}
 
 			for _, d := range ds {
+				branch, variables := DeploymentBranchAndVariables(proj, d)
 				err := s.UpdateDeployment(ctx, d, &UpdateDeploymentOptions{
-					Branch:          proj.ProdBranch,
-					Variables:       proj.ProdVariables,
+					Branch:          branch,
+					Variables:       variables,
 					Annotations:     s.NewDeploymentAnnotations(org, proj),
 					EvictCachedRepo: false,
 				})
@@ -242,7 +304,12 @@ func (s *Service) UpdateOrgDeploymentAnnotations(ctx context.Context, org *datab
 }
 
 // TriggerRedeploy de-provisions and re-provisions a project's prod deployment.
+// Preview deployments can't be redeployed, since that would replace the prod deployment with a new deployment of the prod branch.
 func (s *Service) TriggerRedeploy(ctx context.Context, proj *database.Project, prevDepl *database.Deployment) (*database.Project, error) {
+	if prevDepl != nil && prevDepl.PullRequest != nil {
+		return nil, fmt.Errorf("can't redeploy preview deployment %q", prevDepl.ID)
+	}
+
 	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
 	if err != nil {
 		return nil, err
@@ -278,6 +345,7 @@ func (s *Service) TriggerRedeploy(ctx context.Context, proj *database.Project, p
 		ProdDeploymentID:     &newDepl.ID,
 		ProdSlots:            proj.ProdSlots,
 		ProdTTLSeconds:       proj.ProdTTLSeconds,
+		PreviewDeployments:   proj.PreviewDeployments,
 		Annotations:          proj.Annotations,
 	})
 	if err != nil {
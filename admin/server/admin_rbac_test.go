@@ -644,6 +644,56 @@ func TestAdmin_RBAC(t *testing.T) {
 		require.Equal(t, 3, len(resp.Organizations))
 	})
 
+	t.Run("test grant role with more permissions", func(t *testing.T) {
+		// create a custom role that can manage org members but not the org itself
+		_, err := adminClient.CreateOrganizationRole(ctx, &adminv1.CreateOrganizationRoleRequest{
+			Organization: adminOrg.Organization.Name,
+			Name:         "member_manager",
+			Permissions: &adminv1.OrganizationPermissions{
+				ReadOrg:          true,
+				ReadOrgMembers:   true,
+				ManageOrgMembers: true,
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = adminClient.AddOrganizationMember(ctx, &adminv1.AddOrganizationMemberRequest{
+			Organization: adminOrg.Organization.Name,
+			Email:        testUser.Email,
+			Role:         "member_manager",
+		})
+		require.NoError(t, err)
+
+		// the member manager can't make itself or others admin
+		_, err = testClient.SetOrganizationMemberRole(ctx, &adminv1.SetOrganizationMemberRoleRequest{
+			Organization: adminOrg.Organization.Name,
+			Email:        testUser.Email,
+			Role:         "admin",
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = testClient.SetOrganizationMemberRole(ctx, &adminv1.SetOrganizationMemberRoleRequest{
+			Organization: adminOrg.Organization.Name,
+			Email:        viewerUser.Email,
+			Role:         "admin",
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = testClient.AddOrganizationMember(ctx, &adminv1.AddOrganizationMemberRequest{
+			Organization: adminOrg.Organization.Name,
+			Email:        "escalate@test.io",
+			Role:         "admin",
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// but it can grant roles within its own permissions
+		_, err = testClient.AddOrganizationMember(ctx, &adminv1.AddOrganizationMemberRequest{
+			Organization: adminOrg.Organization.Name,
+			Email:        "manager@test.io",
+			Role:         "member_manager",
+		})
+		require.NoError(t, err)
+	})
 }
 
 type bearerTokenCredential struct {
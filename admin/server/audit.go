@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListAuditEvents lists the audit log of an organization.
+func (s *Server) ListAuditEvents(ctx context.Context, req *adminv1.ListAuditEventsRequest) (*adminv1.ListAuditEventsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.actor", req.Actor),
+		attribute.String("args.action", req.Action),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) && !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to read the audit log")
+	}
+
+	pageToken, err := unmarshalStringTimestampPageToken(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pageSize := validPageSize(req.PageSize)
+
+	opts := &database.FindAuditEventsOptions{
+		OrgID:     org.ID,
+		ActorName: req.Actor,
+		Action:    req.Action,
+		Limit:     pageSize,
+	}
+	if req.Project != "" {
+		proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		opts.ProjectID = proj.ID
+	}
+	if req.After != nil {
+		opts.After = req.After.AsTime()
+	}
+	if req.Before != nil {
+		opts.Before = req.Before.AsTime()
+	}
+	if pageToken.Ts != nil {
+		opts.BeforeCreatedOn = pageToken.Ts.AsTime()
+		opts.BeforeID = pageToken.Str
+	}
+
+	events, err := s.admin.DB.FindAuditEvents(ctx, opts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextToken := ""
+	if len(events) >= pageSize {
+		e := events[len(events)-1]
+		nextToken = marshalStringTimestampPageToken(e.ID, e.CreatedOn)
+	}
+
+	dtos := make([]*adminv1.AuditEvent, len(events))
+	for i, e := range events {
+		dtos[i], err = auditEventToPB(e)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &adminv1.ListAuditEventsResponse{
+		Events:        dtos,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+// auditActor returns the actor to record in the audit log for changes made by the caller.
+func (s *Server) auditActor(ctx context.Context) (*admin.AuditActor, error) {
+	claims := auth.GetClaims(ctx)
+	actor := &admin.AuditActor{
+		ID:        claims.OwnerID(),
+		Superuser: claims.Superuser(ctx),
+	}
+
+	switch claims.OwnerType() {
+	case auth.OwnerTypeUser:
+		user, err := s.admin.DB.FindUser(ctx, claims.OwnerID())
+		if err != nil {
+			return nil, err
+		}
+		actor.Type = database.AuditActorTypeUser
+		actor.Name = user.Email
+	case auth.OwnerTypeService:
+		service, err := s.admin.DB.FindService(ctx, claims.OwnerID())
+		if err != nil {
+			return nil, err
+		}
+		actor.Type = database.AuditActorTypeService
+		actor.Name = service.Name
+	case auth.OwnerTypeDeployment:
+		actor.Type = database.AuditActorTypeDeployment
+	default:
+		return nil, fmt.Errorf("cannot audit changes made by owner type %q", claims.OwnerType())
+	}
+
+	return actor, nil
+}
+
+// recordAuditEvent appends an event for a change made by the caller to the org's audit log.
+// It should be called with the ctx of the transaction that applies the change.
+func (s *Server) recordAuditEvent(ctx context.Context, evt *admin.AuditEvent) error {
+	actor, err := s.auditActor(ctx)
+	if err != nil {
+		return err
+	}
+	return s.admin.RecordAuditEvent(ctx, actor, evt)
+}
+
+func auditEventToPB(e *database.AuditEvent) (*adminv1.AuditEvent, error) {
+	details, err := structpb.NewStruct(e.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	var projectID string
+	if e.ProjectID != nil {
+		projectID = *e.ProjectID
+	}
+
+	return &adminv1.AuditEvent{
+		Id:             e.ID,
+		ProjectId:      projectID,
+		ProjectName:    e.ProjectName,
+		ActorType:      string(e.ActorType),
+		ActorId:        e.ActorID,
+		ActorName:      e.ActorName,
+		ActorSuperuser: e.ActorSuperuser,
+		Action:         e.Action,
+		Target:         e.Target,
+		Details:        details,
+		CreatedOn:      timestamppb.New(e.CreatedOn),
+	}, nil
+}
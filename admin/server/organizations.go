@@ -6,6 +6,7 @@ import (
 	"math"
 	"strings"
 
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/pkg/publicemail"
 	"github.com/rilldata/rill/admin/server/auth"
@@ -309,10 +310,13 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		return nil, status.Errorf(codes.FailedPrecondition, "quota exceeded: org can at most have %d outstanding invitations", org.QuotaOutstandingInvites)
 	}
 
-	role, err := s.admin.DB.FindOrganizationRole(ctx, req.Role)
+	role, err := s.admin.DB.FindOrganizationRole(ctx, org.ID, req.Role)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err := checkOrganizationRoleGrant(ctx, claims, org.ID, role); err != nil {
+		return nil, err
+	}
 
 	var invitedByUserID, invitedByName string
 	if claims.OwnerType() == auth.OwnerTypeUser {
@@ -331,7 +335,13 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		}
 
 		// Invite user to join org
-		err := s.admin.DB.InsertOrganizationInvite(ctx, &database.InsertOrganizationInviteOptions{
+		txCtx, tx, err := s.admin.DB.NewTx(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		err = s.admin.DB.InsertOrganizationInvite(txCtx, &database.InsertOrganizationInviteOptions{
 			Email:     req.Email,
 			InviterID: invitedByUserID,
 			OrgID:     org.ID,
@@ -344,6 +354,21 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		err = s.recordAuditEvent(txCtx, &admin.AuditEvent{
+			OrgID:   org.ID,
+			Action:  database.AuditActionOrganizationMemberAdd,
+			Target:  req.Email,
+			Details: map[string]any{"role": role.Name, "pending_signup": true},
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
 		// Send invitation email
 		err = s.admin.Email.SendOrganizationInvite(&email.OrganizationInvite{
 			ToEmail:       req.Email,
@@ -382,6 +407,16 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		// If the user is already in the all user group, we can ignore the error
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   org.ID,
+		Action:  database.AuditActionOrganizationMemberAdd,
+		Target:  req.Email,
+		Details: map[string]any{"role": role.Name},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -437,11 +472,32 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		ctx, tx, err := s.admin.DB.NewTx(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		defer func() { _ = tx.Rollback() }()
+
 		err = s.admin.DB.DeleteOrganizationInvite(ctx, invite.ID)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:   org.ID,
+			Action:  database.AuditActionOrganizationMemberRemove,
+			Target:  req.Email,
+			Details: map[string]any{"pending_signup": true},
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
 		return &adminv1.RemoveOrganizationMemberResponse{}, nil
 	}
 
@@ -454,7 +510,7 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 	}
 
 	// Check that the user is not the last admin
-	role, err := s.admin.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
+	role, err := s.admin.DB.FindOrganizationRole(ctx, org.ID, database.OrganizationRoleNameAdmin)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -477,8 +533,8 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// delete from all user group
-	err = s.admin.DB.DeleteUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+	// delete from all user groups, including the all user group
+	err = s.admin.DB.DeleteUsergroupsMemberUser(ctx, org.ID, user.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -491,6 +547,16 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		}
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   org.ID,
+		Action:  database.AuditActionOrganizationMemberRemove,
+		Target:  user.Email,
+		Details: map[string]any{"keep_project_roles": req.KeepProjectRoles},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -515,10 +581,13 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 		return nil, status.Error(codes.PermissionDenied, "not allowed to set org members role")
 	}
 
-	role, err := s.admin.DB.FindOrganizationRole(ctx, req.Role)
+	role, err := s.admin.DB.FindOrganizationRole(ctx, org.ID, req.Role)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err := checkOrganizationRoleGrant(ctx, claims, org.ID, role); err != nil {
+		return nil, err
+	}
 
 	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
 	if err != nil {
@@ -533,16 +602,39 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 			}
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+
+		ctx, tx, err := s.admin.DB.NewTx(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		defer func() { _ = tx.Rollback() }()
+
 		err = s.admin.DB.UpdateOrganizationInviteRole(ctx, invite.ID, role.ID)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+
+		err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:   org.ID,
+			Action:  database.AuditActionOrganizationMemberSetRole,
+			Target:  req.Email,
+			Details: map[string]any{"role": role.Name, "pending_signup": true},
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
 		return &adminv1.SetOrganizationMemberRoleResponse{}, nil
 	}
 
 	// Check if the user is the last owner
 	if role.Name != database.OrganizationRoleNameAdmin {
-		adminRole, err := s.admin.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
+		adminRole, err := s.admin.DB.FindOrganizationRole(ctx, org.ID, database.OrganizationRoleNameAdmin)
 		if err != nil {
 			panic(err)
 		}
@@ -557,11 +649,32 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 		}
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.UpdateOrganizationMemberUserRole(ctx, org.ID, user.ID, role.ID)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   org.ID,
+		Action:  database.AuditActionOrganizationMemberSetRole,
+		Target:  user.Email,
+		Details: map[string]any{"role": role.Name},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.SetOrganizationMemberRoleResponse{}, nil
 }
 
@@ -585,7 +698,7 @@ func (s *Server) LeaveOrganization(ctx context.Context, req *adminv1.LeaveOrgani
 		return nil, status.Error(codes.PermissionDenied, "not allowed to remove org members")
 	}
 
-	role, err := s.admin.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
+	role, err := s.admin.DB.FindOrganizationRole(ctx, org.ID, database.OrganizationRoleNameAdmin)
 	if err != nil {
 		panic(err)
 	}
@@ -602,6 +715,11 @@ func (s *Server) LeaveOrganization(ctx context.Context, req *adminv1.LeaveOrgani
 		return nil, status.Error(codes.InvalidArgument, "cannot remove the last owner")
 	}
 
+	actor, err := s.auditActor(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	ctx, tx, err := s.admin.DB.NewTx(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -612,11 +730,21 @@ func (s *Server) LeaveOrganization(ctx context.Context, req *adminv1.LeaveOrgani
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// delete from all user group
-	err = s.admin.DB.DeleteUsergroupMember(ctx, *org.AllUsergroupID, claims.OwnerID())
+	// delete from all user groups, including the all user group
+	err = s.admin.DB.DeleteUsergroupsMemberUser(ctx, org.ID, claims.OwnerID())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.admin.RecordAuditEvent(ctx, actor, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: database.AuditActionOrganizationMemberRemove,
+		Target: actor.Name,
+	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -663,13 +791,16 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 		}
 	}
 
-	role, err := s.admin.DB.FindOrganizationRole(ctx, req.Role)
+	role, err := s.admin.DB.FindOrganizationRole(ctx, org.ID, req.Role)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "role not found")
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if err := checkOrganizationRoleGrant(ctx, claims, org.ID, role); err != nil {
+		return nil, err
+	}
 
 	// find existing users belonging to the whitelisted domain to the org
 	users, err := s.admin.DB.FindUsersByEmailPattern(ctx, "%@"+req.Domain, "", math.MaxInt)
@@ -718,6 +849,16 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 		}
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   org.ID,
+		Action:  database.AuditActionOrganizationWhitelistedDomainAdd,
+		Target:  req.Domain,
+		Details: map[string]any{"role": role.Name, "added_members": len(newUsers)},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -754,11 +895,31 @@ func (s *Server) RemoveWhitelistedDomain(ctx context.Context, req *adminv1.Remov
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteOrganizationWhitelistedDomain(ctx, invite.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: database.AuditActionOrganizationWhitelistedDomainRemove,
+		Target: req.Domain,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.RemoveWhitelistedDomainResponse{}, nil
 }
 
@@ -92,6 +92,7 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.org", req.OrganizationName),
 		attribute.String("args.project", req.Name),
+		attribute.String("args.branch", req.Branch),
 	)
 
 	org, err := s.admin.DB.FindOrganizationByName(ctx, req.OrganizationName)
@@ -126,20 +127,41 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project")
 	}
 
-	if proj.ProdDeploymentID == nil || !permissions.ReadProd {
-		return &adminv1.GetProjectResponse{
-			Project:            s.projToDTO(proj, org.Name),
-			ProjectPermissions: permissions,
-		}, nil
-	}
+	var depl *database.Deployment
+	if req.Branch == "" || req.Branch == proj.ProdBranch {
+		if proj.ProdDeploymentID == nil || !permissions.ReadProd {
+			return &adminv1.GetProjectResponse{
+				Project:            s.projToDTO(proj, org.Name),
+				ProjectPermissions: permissions,
+			}, nil
+		}
 
-	depl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
+		depl, err = s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 
-	if !permissions.ReadProdStatus {
-		depl.StatusMessage = ""
+		if !permissions.ReadProdStatus {
+			depl.StatusMessage = ""
+		}
+	} else {
+		if !permissions.ReadDev {
+			return nil, status.Error(codes.PermissionDenied, "does not have permission to read preview deployments")
+		}
+
+		// If the branch is the head of multiple pull requests, the most recent preview is returned
+		ds, err := s.admin.DB.FindPreviewDeploymentsForBranch(ctx, proj.ID, req.Branch)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if len(ds) == 0 {
+			return nil, status.Errorf(codes.NotFound, "project does not have a deployment for branch %q", req.Branch)
+		}
+		depl = ds[0]
+
+		if !permissions.ReadDevStatus {
+			depl.StatusMessage = ""
+		}
 	}
 
 	var attr map[string]any
@@ -177,12 +199,17 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 
 	s.admin.Used.Deployment(depl.ID)
 
-	return &adminv1.GetProjectResponse{
+	res := &adminv1.GetProjectResponse{
 		Project:            s.projToDTO(proj, org.Name),
-		ProdDeployment:     deploymentToDTO(depl),
 		Jwt:                jwt,
 		ProjectPermissions: permissions,
-	}, nil
+	}
+	if depl.PullRequest != nil {
+		res.PreviewDeployment = deploymentToDTO(depl)
+	} else {
+		res.ProdDeployment = deploymentToDTO(depl)
+	}
+	return res, nil
 }
 
 func (s *Server) SearchProjectNames(ctx context.Context, req *adminv1.SearchProjectNamesRequest) (*adminv1.SearchProjectNamesResponse, error) {
@@ -393,6 +420,9 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 	if req.NewName != nil {
 		observability.AddRequestAttributes(ctx, attribute.String("args.new_name", *req.NewName))
 	}
+	if req.PreviewDeployments != nil {
+		observability.AddRequestAttributes(ctx, attribute.Bool("args.preview_deployments", *req.PreviewDeployments))
+	}
 
 	// Check the request is made by a user
 	claims := auth.GetClaims(ctx)
@@ -443,6 +473,7 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 		ProdDeploymentID:     proj.ProdDeploymentID,
 		ProdSlots:            int(valOrDefault(req.ProdSlots, int64(proj.ProdSlots))),
 		ProdTTLSeconds:       prodTTLSeconds,
+		PreviewDeployments:   valOrDefault(req.PreviewDeployments, proj.PreviewDeployments),
 		Provisioner:          valOrDefault(req.Provisioner, proj.Provisioner),
 		Annotations:          proj.Annotations,
 	}
@@ -491,21 +522,12 @@ func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.Update
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to update project variables")
 	}
 
-	proj, err = s.admin.UpdateProject(ctx, proj, &database.UpdateProjectOptions{
-		Name:                 proj.Name,
-		Description:          proj.Description,
-		Public:               proj.Public,
-		GithubURL:            proj.GithubURL,
-		GithubInstallationID: proj.GithubInstallationID,
-		ProdVersion:          proj.ProdVersion,
-		ProdBranch:           proj.ProdBranch,
-		ProdVariables:        req.Variables,
-		ProdDeploymentID:     proj.ProdDeploymentID,
-		ProdSlots:            proj.ProdSlots,
-		ProdTTLSeconds:       proj.ProdTTLSeconds,
-		Provisioner:          proj.Provisioner,
-		Annotations:          proj.Annotations,
-	})
+	actor, err := s.auditActor(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	proj, err = s.admin.UpdateProjectVariables(ctx, proj, req.Variables, actor)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "variables updated failed with error %s", err.Error())
 	}
@@ -630,10 +652,13 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 		return nil, status.Errorf(codes.FailedPrecondition, "quota exceeded: org %q can at most have %d outstanding invitations", org.Name, org.QuotaOutstandingInvites)
 	}
 
-	role, err := s.admin.DB.FindProjectRole(ctx, req.Role)
+	role, err := s.admin.DB.FindProjectRole(ctx, proj.OrganizationID, req.Role)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err := checkProjectRoleGrant(ctx, claims, proj.OrganizationID, proj.ID, role); err != nil {
+		return nil, err
+	}
 
 	var invitedByUserID, invitedByName string
 	if claims.OwnerType() == auth.OwnerTypeUser {
@@ -652,7 +677,13 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 		}
 
 		// Invite user to join the project
-		err := s.admin.DB.InsertProjectInvite(ctx, &database.InsertProjectInviteOptions{
+		txCtx, tx, err := s.admin.DB.NewTx(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		err = s.admin.DB.InsertProjectInvite(txCtx, &database.InsertProjectInviteOptions{
 			Email:     req.Email,
 			InviterID: invitedByUserID,
 			ProjectID: proj.ID,
@@ -662,6 +693,22 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		err = s.recordAuditEvent(txCtx, &admin.AuditEvent{
+			OrgID:   proj.OrganizationID,
+			Project: proj,
+			Action:  database.AuditActionProjectMemberAdd,
+			Target:  req.Email,
+			Details: map[string]any{"role": role.Name, "pending_signup": true},
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
 		// Send invitation email
 		err = s.admin.Email.SendProjectInvite(&email.ProjectInvite{
 			ToEmail:       req.Email,
@@ -682,11 +729,33 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 		}, nil
 	}
 
-	err = s.admin.DB.InsertProjectMemberUser(ctx, proj.ID, user.ID, role.ID)
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DB.InsertProjectMemberUser(txCtx, proj.ID, user.ID, role.ID)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = s.recordAuditEvent(txCtx, &admin.AuditEvent{
+		OrgID:   proj.OrganizationID,
+		Project: proj,
+		Action:  database.AuditActionProjectMemberAdd,
+		Target:  req.Email,
+		Details: map[string]any{"role": role.Name},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	err = s.admin.Email.SendProjectAddition(&email.ProjectAddition{
 		ToEmail:       req.Email,
 		ToName:        "",
@@ -738,10 +807,33 @@ func (s *Server) RemoveProjectMember(ctx context.Context, req *adminv1.RemovePro
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		ctx, tx, err := s.admin.DB.NewTx(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		defer func() { _ = tx.Rollback() }()
+
 		err = s.admin.DB.DeleteProjectInvite(ctx, invite.ID)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+
+		err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:   proj.OrganizationID,
+			Project: proj,
+			Action:  database.AuditActionProjectMemberRemove,
+			Target:  req.Email,
+			Details: map[string]any{"pending_signup": true},
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
 		return &adminv1.RemoveProjectMemberResponse{}, nil
 	}
 
@@ -753,11 +845,32 @@ func (s *Server) RemoveProjectMember(ctx context.Context, req *adminv1.RemovePro
 		return nil, status.Error(codes.PermissionDenied, "not allowed to remove project members")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteProjectMemberUser(ctx, proj.ID, user.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   proj.OrganizationID,
+		Project: proj,
+		Action:  database.AuditActionProjectMemberRemove,
+		Target:  user.Email,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.RemoveProjectMemberResponse{}, nil
 }
 
@@ -778,10 +891,13 @@ func (s *Server) SetProjectMemberRole(ctx context.Context, req *adminv1.SetProje
 		return nil, status.Error(codes.PermissionDenied, "not allowed to set project member roles")
 	}
 
-	role, err := s.admin.DB.FindProjectRole(ctx, req.Role)
+	role, err := s.admin.DB.FindProjectRole(ctx, proj.OrganizationID, req.Role)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err := checkProjectRoleGrant(ctx, claims, proj.OrganizationID, proj.ID, role); err != nil {
+		return nil, err
+	}
 
 	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
 	if err != nil {
@@ -796,18 +912,64 @@ func (s *Server) SetProjectMemberRole(ctx context.Context, req *adminv1.SetProje
 			}
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+
+		ctx, tx, err := s.admin.DB.NewTx(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		defer func() { _ = tx.Rollback() }()
+
 		err = s.admin.DB.UpdateProjectInviteRole(ctx, invite.ID, role.ID)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+
+		err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:   proj.OrganizationID,
+			Project: proj,
+			Action:  database.AuditActionProjectMemberSetRole,
+			Target:  req.Email,
+			Details: map[string]any{"role": role.Name, "pending_signup": true},
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
 		return &adminv1.SetProjectMemberRoleResponse{}, nil
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.UpdateProjectMemberUserRole(ctx, proj.ID, user.ID, role.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   proj.OrganizationID,
+		Project: proj,
+		Action:  database.AuditActionProjectMemberSetRole,
+		Target:  user.Email,
+		Details: map[string]any{"role": role.Name},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.SetProjectMemberRoleResponse{}, nil
 }
 
@@ -879,6 +1041,7 @@ func (s *Server) SudoUpdateAnnotations(ctx context.Context, req *adminv1.SudoUpd
 		ProdDeploymentID:     proj.ProdDeploymentID,
 		ProdSlots:            proj.ProdSlots,
 		ProdTTLSeconds:       proj.ProdTTLSeconds,
+		PreviewDeployments:   proj.PreviewDeployments,
 		Provisioner:          proj.Provisioner,
 		Annotations:          req.Annotations,
 	})
@@ -930,13 +1093,16 @@ func (s *Server) CreateProjectWhitelistedDomain(ctx context.Context, req *adminv
 		}
 	}
 
-	role, err := s.admin.DB.FindProjectRole(ctx, req.Role)
+	role, err := s.admin.DB.FindProjectRole(ctx, proj.OrganizationID, req.Role)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "role not found")
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if err := checkProjectRoleGrant(ctx, claims, proj.OrganizationID, proj.ID, role); err != nil {
+		return nil, err
+	}
 
 	// find existing users belonging to the whitelisted domain to the project
 	users, err := s.admin.DB.FindUsersByEmailPattern(ctx, "%@"+req.Domain, "", math.MaxInt)
@@ -979,6 +1145,17 @@ func (s *Server) CreateProjectWhitelistedDomain(ctx context.Context, req *adminv
 		}
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   proj.OrganizationID,
+		Project: proj,
+		Action:  database.AuditActionProjectWhitelistedDomainAdd,
+		Target:  req.Domain,
+		Details: map[string]any{"role": role.Name, "added_members": len(newUsers)},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -1016,11 +1193,32 @@ func (s *Server) RemoveProjectWhitelistedDomain(ctx context.Context, req *adminv
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteProjectWhitelistedDomain(ctx, invite.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   proj.OrganizationID,
+		Project: proj,
+		Action:  database.AuditActionProjectWhitelistedDomainRemove,
+		Target:  req.Domain,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.RemoveProjectWhitelistedDomainResponse{}, nil
 }
 
@@ -1063,27 +1261,28 @@ func (s *Server) projToDTO(p *database.Project, orgName string) *adminv1.Project
 	frontendURL, _ := url.JoinPath(s.opts.FrontendURL, orgName, p.Name)
 
 	return &adminv1.Project{
-		Id:               p.ID,
-		Name:             p.Name,
-		OrgId:            p.OrganizationID,
-		OrgName:          orgName,
-		Description:      p.Description,
-		Public:           p.Public,
-		CreatedByUserId:  safeStr(p.CreatedByUserID),
-		Provisioner:      p.Provisioner,
-		ProdVersion:      p.ProdVersion,
-		ProdOlapDriver:   p.ProdOLAPDriver,
-		ProdOlapDsn:      p.ProdOLAPDSN,
-		ProdSlots:        int64(p.ProdSlots),
-		ProdBranch:       p.ProdBranch,
-		Subpath:          p.Subpath,
-		GithubUrl:        safeStr(p.GithubURL),
-		ProdDeploymentId: safeStr(p.ProdDeploymentID),
-		ProdTtlSeconds:   safeInt64(p.ProdTTLSeconds),
-		FrontendUrl:      frontendURL,
-		Annotations:      p.Annotations,
-		CreatedOn:        timestamppb.New(p.CreatedOn),
-		UpdatedOn:        timestamppb.New(p.UpdatedOn),
+		Id:                 p.ID,
+		Name:               p.Name,
+		OrgId:              p.OrganizationID,
+		OrgName:            orgName,
+		Description:        p.Description,
+		Public:             p.Public,
+		CreatedByUserId:    safeStr(p.CreatedByUserID),
+		Provisioner:        p.Provisioner,
+		ProdVersion:        p.ProdVersion,
+		ProdOlapDriver:     p.ProdOLAPDriver,
+		ProdOlapDsn:        p.ProdOLAPDSN,
+		ProdSlots:          int64(p.ProdSlots),
+		ProdBranch:         p.ProdBranch,
+		Subpath:            p.Subpath,
+		GithubUrl:          safeStr(p.GithubURL),
+		ProdDeploymentId:   safeStr(p.ProdDeploymentID),
+		ProdTtlSeconds:     safeInt64(p.ProdTTLSeconds),
+		FrontendUrl:        frontendURL,
+		Annotations:        p.Annotations,
+		PreviewDeployments: p.PreviewDeployments,
+		CreatedOn:          timestamppb.New(p.CreatedOn),
+		UpdatedOn:          timestamppb.New(p.UpdatedOn),
 	}
 }
 
@@ -1102,6 +1301,15 @@ func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
 		panic(fmt.Errorf("unhandled deployment status %d", d.Status))
 	}
 
+	var pullRequest int64
+	if d.PullRequest != nil {
+		pullRequest = int64(*d.PullRequest)
+	}
+	var expiresOn *timestamppb.Timestamp
+	if d.ExpiresOn != nil {
+		expiresOn = timestamppb.New(*d.ExpiresOn)
+	}
+
 	return &adminv1.Deployment{
 		Id:                d.ID,
 		ProjectId:         d.ProjectID,
@@ -1113,6 +1321,8 @@ func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
 		StatusMessage:     d.StatusMessage,
 		CreatedOn:         timestamppb.New(d.CreatedOn),
 		UpdatedOn:         timestamppb.New(d.UpdatedOn),
+		PullRequest:       pullRequest,
+		ExpiresOn:         expiresOn,
 	}
 }
 
@@ -24,6 +24,7 @@ import (
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"gopkg.in/yaml.v3"
 )
 
@@ -56,10 +57,25 @@ func (s *Server) GetReportMeta(ctx context.Context, req *adminv1.GetReportMetaRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// If the report has an owner, resolve the owner's attributes so that attached data is subject to their security policies
+	var attrPB *structpb.Struct
+	annotations := parseReportAnnotations(req.Annotations)
+	if annotations.AdminOwnerUserID != "" {
+		attr, err := s.getAttributesForUser(ctx, proj.OrganizationID, proj.ID, annotations.AdminOwnerUserID, "")
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		attrPB, err = structpb.NewStruct(attr)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	return &adminv1.GetReportMetaResponse{
-		OpenUrl:   s.urls.reportOpen(org.Name, proj.Name, req.Report, req.ExecutionTime.AsTime()),
-		ExportUrl: s.urls.reportExport(org.Name, proj.Name, req.Report),
-		EditUrl:   s.urls.reportEdit(org.Name, proj.Name, req.Report),
+		OpenUrl:            s.urls.reportOpen(org.Name, proj.Name, req.Report, req.ExecutionTime.AsTime()),
+		ExportUrl:          s.urls.reportExport(org.Name, proj.Name, req.Report),
+		EditUrl:            s.urls.reportEdit(org.Name, proj.Name, req.Report),
+		QueryForAttributes: attrPB,
 	}, nil
 }
 
@@ -37,8 +37,9 @@ func (s *Server) GetRepoMeta(ctx context.Context, req *adminv1.GetRepoMetaReques
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project repo")
 	}
 
-	if proj.ProdBranch != req.Branch {
-		return nil, status.Error(codes.InvalidArgument, "branch not found")
+	err = s.checkDeployedBranch(ctx, proj, req.Branch)
+	if err != nil {
+		return nil, err
 	}
 
 	if proj.GithubURL == nil || proj.GithubInstallationID == nil {
@@ -81,8 +82,9 @@ func (s *Server) PullVirtualRepo(ctx context.Context, req *adminv1.PullVirtualRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if proj.ProdBranch != req.Branch {
-		return nil, status.Error(codes.InvalidArgument, "branch not found")
+	err = s.checkDeployedBranch(ctx, proj, req.Branch)
+	if err != nil {
+		return nil, err
 	}
 
 	permissions := auth.GetClaims(ctx).ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
@@ -120,6 +122,23 @@ func (s *Server) PullVirtualRepo(ctx context.Context, req *adminv1.PullVirtualRe
 	}, nil
 }
 
+// checkDeployedBranch returns an error if the branch is neither the project's prod branch nor deployed by one of its preview deployments.
+func (s *Server) checkDeployedBranch(ctx context.Context, proj *database.Project, branch string) error {
+	if proj.ProdBranch == branch {
+		return nil
+	}
+
+	ds, err := s.admin.DB.FindPreviewDeploymentsForBranch(ctx, proj.ID, branch)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if len(ds) == 0 {
+		return status.Error(codes.InvalidArgument, "branch not found")
+	}
+
+	return nil
+}
+
 func virtualFileToDTO(vf *database.VirtualFile) *adminv1.VirtualFile {
 	return &adminv1.VirtualFile{
 		Path:      vf.Path,
@@ -0,0 +1,641 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListOrganizationRoles(ctx context.Context, req *adminv1.ListOrganizationRolesRequest) (*adminv1.ListOrganizationRolesResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) && !claims.OrganizationPermissions(ctx, org.ID).ReadOrg {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to read org roles")
+	}
+
+	roles, err := s.admin.DB.FindOrganizationRoles(ctx, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.OrganizationRole, len(roles))
+	for i, role := range roles {
+		dtos[i] = organizationRoleToPB(role)
+	}
+
+	return &adminv1.ListOrganizationRolesResponse{Roles: dtos}, nil
+}
+
+func (s *Server) CreateOrganizationRole(ctx context.Context, req *adminv1.CreateOrganizationRoleRequest) (*adminv1.CreateOrganizationRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.name", req.Name),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to create org roles")
+	}
+
+	// Custom roles can't shadow the built-in roles
+	_, err = s.admin.DB.FindOrganizationRole(ctx, org.ID, req.Name)
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "org role %q already exists", req.Name)
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	p := req.Permissions
+	role, err := s.admin.DB.InsertOrganizationRole(ctx, &database.InsertOrganizationRoleOptions{
+		OrgID:            org.ID,
+		Name:             req.Name,
+		ReadOrg:          p.ReadOrg,
+		ManageOrg:        p.ManageOrg,
+		ReadProjects:     p.ReadProjects,
+		CreateProjects:   p.CreateProjects,
+		ManageProjects:   p.ManageProjects,
+		ReadOrgMembers:   p.ReadOrgMembers,
+		ManageOrgMembers: p.ManageOrgMembers,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: database.AuditActionOrganizationRoleCreate,
+		Target: role.Name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.CreateOrganizationRoleResponse{
+		Role: organizationRoleToPB(role),
+	}, nil
+}
+
+func (s *Server) UpdateOrganizationRole(ctx context.Context, req *adminv1.UpdateOrganizationRoleRequest) (*adminv1.UpdateOrganizationRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.role", req.Role),
+	)
+	if req.NewName != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.new_name", *req.NewName))
+	}
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to update org roles")
+	}
+
+	role, err := s.findCustomOrganizationRole(ctx, org.ID, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	name := valOrDefault(req.NewName, role.Name)
+	if !strings.EqualFold(name, role.Name) {
+		_, err = s.admin.DB.FindOrganizationRole(ctx, org.ID, name)
+		if err == nil {
+			return nil, status.Errorf(codes.AlreadyExists, "org role %q already exists", name)
+		}
+		if !errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	opts := &database.UpdateOrganizationRoleOptions{
+		Name:             name,
+		ReadOrg:          role.ReadOrg,
+		ManageOrg:        role.ManageOrg,
+		ReadProjects:     role.ReadProjects,
+		CreateProjects:   role.CreateProjects,
+		ManageProjects:   role.ManageProjects,
+		ReadOrgMembers:   role.ReadOrgMembers,
+		ManageOrgMembers: role.ManageOrgMembers,
+	}
+	if p := req.Permissions; p != nil {
+		opts.ReadOrg = p.ReadOrg
+		opts.ManageOrg = p.ManageOrg
+		opts.ReadProjects = p.ReadProjects
+		opts.CreateProjects = p.CreateProjects
+		opts.ManageProjects = p.ManageProjects
+		opts.ReadOrgMembers = p.ReadOrgMembers
+		opts.ManageOrgMembers = p.ManageOrgMembers
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	updatedRole, err := s.admin.DB.UpdateOrganizationRole(ctx, role.ID, opts)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   org.ID,
+		Action:  database.AuditActionOrganizationRoleUpdate,
+		Target:  updatedRole.Name,
+		Details: map[string]any{"previous_name": role.Name},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.UpdateOrganizationRoleResponse{
+		Role: organizationRoleToPB(updatedRole),
+	}, nil
+}
+
+func (s *Server) DeleteOrganizationRole(ctx context.Context, req *adminv1.DeleteOrganizationRoleRequest) (*adminv1.DeleteOrganizationRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.role", req.Role),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to delete org roles")
+	}
+
+	role, err := s.findCustomOrganizationRole(ctx, org.ID, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Deleting the role would cascade to the members, invites and whitelisted domains it's assigned to
+	used, err := s.admin.DB.CheckOrganizationRoleIsUsed(ctx, role.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if used {
+		return nil, status.Errorf(codes.FailedPrecondition, "org role %q is assigned to members, user groups, invites or whitelisted domains", role.Name)
+	}
+
+	err = s.admin.DB.DeleteOrganizationRole(ctx, role.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: database.AuditActionOrganizationRoleDelete,
+		Target: role.Name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.DeleteOrganizationRoleResponse{}, nil
+}
+
+func (s *Server) ListProjectRoles(ctx context.Context, req *adminv1.ListProjectRolesRequest) (*adminv1.ListProjectRolesResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) && !claims.OrganizationPermissions(ctx, org.ID).ReadOrg {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to read project roles")
+	}
+
+	roles, err := s.admin.DB.FindProjectRoles(ctx, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.ProjectRole, len(roles))
+	for i, role := range roles {
+		dtos[i] = projectRoleToPB(role)
+	}
+
+	return &adminv1.ListProjectRolesResponse{Roles: dtos}, nil
+}
+
+func (s *Server) CreateProjectRole(ctx context.Context, req *adminv1.CreateProjectRoleRequest) (*adminv1.CreateProjectRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.name", req.Name),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to create project roles")
+	}
+
+	// Custom roles can't shadow the built-in roles
+	_, err = s.admin.DB.FindProjectRole(ctx, org.ID, req.Name)
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "project role %q already exists", req.Name)
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	p := req.Permissions
+	role, err := s.admin.DB.InsertProjectRole(ctx, &database.InsertProjectRoleOptions{
+		OrgID:                org.ID,
+		Name:                 req.Name,
+		ReadProject:          p.ReadProject,
+		ManageProject:        p.ManageProject,
+		ReadProd:             p.ReadProd,
+		ReadProdStatus:       p.ReadProdStatus,
+		ManageProd:           p.ManageProd,
+		ReadDev:              p.ReadDev,
+		ReadDevStatus:        p.ReadDevStatus,
+		ManageDev:            p.ManageDev,
+		ReadProjectMembers:   p.ReadProjectMembers,
+		ManageProjectMembers: p.ManageProjectMembers,
+		CreateReports:        p.CreateReports,
+		ManageReports:        p.ManageReports,
+		CreateAlerts:         p.CreateAlerts,
+		ManageAlerts:         p.ManageAlerts,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: database.AuditActionOrganizationProjectRoleCreate,
+		Target: role.Name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.CreateProjectRoleResponse{
+		Role: projectRoleToPB(role),
+	}, nil
+}
+
+func (s *Server) UpdateProjectRole(ctx context.Context, req *adminv1.UpdateProjectRoleRequest) (*adminv1.UpdateProjectRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.role", req.Role),
+	)
+	if req.NewName != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.new_name", *req.NewName))
+	}
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to update project roles")
+	}
+
+	role, err := s.findCustomProjectRole(ctx, org.ID, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	name := valOrDefault(req.NewName, role.Name)
+	if !strings.EqualFold(name, role.Name) {
+		_, err = s.admin.DB.FindProjectRole(ctx, org.ID, name)
+		if err == nil {
+			return nil, status.Errorf(codes.AlreadyExists, "project role %q already exists", name)
+		}
+		if !errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	opts := &database.UpdateProjectRoleOptions{
+		Name:                 name,
+		ReadProject:          role.ReadProject,
+		ManageProject:        role.ManageProject,
+		ReadProd:             role.ReadProd,
+		ReadProdStatus:       role.ReadProdStatus,
+		ManageProd:           role.ManageProd,
+		ReadDev:              role.ReadDev,
+		ReadDevStatus:        role.ReadDevStatus,
+		ManageDev:            role.ManageDev,
+		ReadProjectMembers:   role.ReadProjectMembers,
+		ManageProjectMembers: role.ManageProjectMembers,
+		CreateReports:        role.CreateReports,
+		ManageReports:        role.ManageReports,
+		CreateAlerts:         role.CreateAlerts,
+		ManageAlerts:         role.ManageAlerts,
+	}
+	if p := req.Permissions; p != nil {
+		opts.ReadProject = p.ReadProject
+		opts.ManageProject = p.ManageProject
+		opts.ReadProd = p.ReadProd
+		opts.ReadProdStatus = p.ReadProdStatus
+		opts.ManageProd = p.ManageProd
+		opts.ReadDev = p.ReadDev
+		opts.ReadDevStatus = p.ReadDevStatus
+		opts.ManageDev = p.ManageDev
+		opts.ReadProjectMembers = p.ReadProjectMembers
+		opts.ManageProjectMembers = p.ManageProjectMembers
+		opts.CreateReports = p.CreateReports
+		opts.ManageReports = p.ManageReports
+		opts.CreateAlerts = p.CreateAlerts
+		opts.ManageAlerts = p.ManageAlerts
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	updatedRole, err := s.admin.DB.UpdateProjectRole(ctx, role.ID, opts)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   org.ID,
+		Action:  database.AuditActionOrganizationProjectRoleUpdate,
+		Target:  updatedRole.Name,
+		Details: map[string]any{"previous_name": role.Name},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.UpdateProjectRoleResponse{
+		Role: projectRoleToPB(updatedRole),
+	}, nil
+}
+
+func (s *Server) DeleteProjectRole(ctx context.Context, req *adminv1.DeleteProjectRoleRequest) (*adminv1.DeleteProjectRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.role", req.Role),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to delete project roles")
+	}
+
+	role, err := s.findCustomProjectRole(ctx, org.ID, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Deleting the role would cascade to the members, invites and whitelisted domains it's assigned to
+	used, err := s.admin.DB.CheckProjectRoleIsUsed(ctx, role.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if used {
+		return nil, status.Errorf(codes.FailedPrecondition, "project role %q is assigned to members, user groups, invites or whitelisted domains", role.Name)
+	}
+
+	err = s.admin.DB.DeleteProjectRole(ctx, role.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: database.AuditActionOrganizationProjectRoleDelete,
+		Target: role.Name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.DeleteProjectRoleResponse{}, nil
+}
+
+// findCustomOrganizationRole finds a custom org role by name. It returns an error for built-in roles, which can't be changed.
+func (s *Server) findCustomOrganizationRole(ctx context.Context, orgID, name string) (*database.OrganizationRole, error) {
+	role, err := s.admin.DB.FindOrganizationRole(ctx, orgID, name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org role not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if role.OrgID == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot change the built-in org role %q", role.Name)
+	}
+	return role, nil
+}
+
+// findCustomProjectRole finds a custom project role by name. It returns an error for built-in roles, which can't be changed.
+func (s *Server) findCustomProjectRole(ctx context.Context, orgID, name string) (*database.ProjectRole, error) {
+	role, err := s.admin.DB.FindProjectRole(ctx, orgID, name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "project role not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if role.OrgID == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot change the built-in project role %q", role.Name)
+	}
+	return role, nil
+}
+
+func organizationRoleToPB(role *database.OrganizationRole) *adminv1.OrganizationRole {
+	return &adminv1.OrganizationRole{
+		Id:      role.ID,
+		Name:    role.Name,
+		Builtin: role.OrgID == nil,
+		Permissions: &adminv1.OrganizationPermissions{
+			ReadOrg:          role.ReadOrg,
+			ManageOrg:        role.ManageOrg,
+			ReadProjects:     role.ReadProjects,
+			CreateProjects:   role.CreateProjects,
+			ManageProjects:   role.ManageProjects,
+			ReadOrgMembers:   role.ReadOrgMembers,
+			ManageOrgMembers: role.ManageOrgMembers,
+		},
+		CreatedOn: timestamppb.New(role.CreatedOn),
+		UpdatedOn: timestamppb.New(role.UpdatedOn),
+	}
+}
+
+func projectRoleToPB(role *database.ProjectRole) *adminv1.ProjectRole {
+	return &adminv1.ProjectRole{
+		Id:      role.ID,
+		Name:    role.Name,
+		Builtin: role.OrgID == nil,
+		Permissions: &adminv1.ProjectPermissions{
+			ReadProject:          role.ReadProject,
+			ManageProject:        role.ManageProject,
+			ReadProd:             role.ReadProd,
+			ReadProdStatus:       role.ReadProdStatus,
+			ManageProd:           role.ManageProd,
+			ReadDev:              role.ReadDev,
+			ReadDevStatus:        role.ReadDevStatus,
+			ManageDev:            role.ManageDev,
+			ReadProjectMembers:   role.ReadProjectMembers,
+			ManageProjectMembers: role.ManageProjectMembers,
+			CreateReports:        role.CreateReports,
+			ManageReports:        role.ManageReports,
+			CreateAlerts:         role.CreateAlerts,
+			ManageAlerts:         role.ManageAlerts,
+		},
+		CreatedOn: timestamppb.New(role.CreatedOn),
+		UpdatedOn: timestamppb.New(role.UpdatedOn),
+	}
+}
+
+// checkOrganizationRoleGrant returns an error if the caller is not allowed to grant the role because it has permissions the caller doesn't have.
+// This prevents members who can only manage org members from escalating their own or others' permissions.
+func checkOrganizationRoleGrant(ctx context.Context, claims auth.Claims, orgID string, role *database.OrganizationRole) error {
+	if claims.Superuser(ctx) {
+		return nil
+	}
+	if !orgRoleWithinPermissions(role, claims.OrganizationPermissions(ctx, orgID)) {
+		return status.Errorf(codes.PermissionDenied, "not allowed to grant the role %q since it has permissions you don't have", role.Name)
+	}
+	return nil
+}
+
+// checkProjectRoleGrant returns an error if the caller is not allowed to grant the role because it has permissions the caller doesn't have.
+func checkProjectRoleGrant(ctx context.Context, claims auth.Claims, orgID, projectID string, role *database.ProjectRole) error {
+	if claims.Superuser(ctx) {
+		return nil
+	}
+	if !projectRoleWithinPermissions(role, claims.ProjectPermissions(ctx, orgID, projectID)) {
+		return status.Errorf(codes.PermissionDenied, "not allowed to grant the role %q since it has permissions you don't have", role.Name)
+	}
+	return nil
+}
+
+// orgRoleWithinPermissions returns true if every permission of the role is also in perms.
+func orgRoleWithinPermissions(role *database.OrganizationRole, perms *adminv1.OrganizationPermissions) bool {
+	return (!role.ReadOrg || perms.ReadOrg) &&
+		(!role.ManageOrg || perms.ManageOrg) &&
+		(!role.ReadProjects || perms.ReadProjects) &&
+		(!role.CreateProjects || perms.CreateProjects) &&
+		(!role.ManageProjects || perms.ManageProjects) &&
+		(!role.ReadOrgMembers || perms.ReadOrgMembers) &&
+		(!role.ManageOrgMembers || perms.ManageOrgMembers)
+}
+
+// projectRoleWithinPermissions returns true if every permission of the role is also in perms.
+func projectRoleWithinPermissions(role *database.ProjectRole, perms *adminv1.ProjectPermissions) bool {
+	return (!role.ReadProject || perms.ReadProject) &&
+		(!role.ManageProject || perms.ManageProject) &&
+		(!role.ReadProd || perms.ReadProd) &&
+		(!role.ReadProdStatus || perms.ReadProdStatus) &&
+		(!role.ManageProd || perms.ManageProd) &&
+		(!role.ReadDev || perms.ReadDev) &&
+		(!role.ReadDevStatus || perms.ReadDevStatus) &&
+		(!role.ManageDev || perms.ManageDev) &&
+		(!role.ReadProjectMembers || perms.ReadProjectMembers) &&
+		(!role.ManageProjectMembers || perms.ManageProjectMembers) &&
+		(!role.CreateReports || perms.CreateReports) &&
+		(!role.ManageReports || perms.ManageReports) &&
+		(!role.CreateAlerts || perms.CreateAlerts) &&
+		(!role.ManageAlerts || perms.ManageAlerts)
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/admin/database"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrgRoleWithinPermissions(t *testing.T) {
+	perms := &adminv1.OrganizationPermissions{
+		ReadOrg:          true,
+		ReadOrgMembers:   true,
+		ManageOrgMembers: true,
+	}
+
+	require.True(t, orgRoleWithinPermissions(&database.OrganizationRole{}, perms))
+	require.True(t, orgRoleWithinPermissions(&database.OrganizationRole{ReadOrg: true, ManageOrgMembers: true}, perms))
+	require.False(t, orgRoleWithinPermissions(&database.OrganizationRole{ReadOrg: true, ManageOrg: true}, perms))
+	require.False(t, orgRoleWithinPermissions(&database.OrganizationRole{ReadProjects: true}, perms))
+}
+
+func TestProjectRoleWithinPermissions(t *testing.T) {
+	perms := &adminv1.ProjectPermissions{
+		ReadProject:          true,
+		ReadProd:             true,
+		ReadProjectMembers:   true,
+		ManageProjectMembers: true,
+	}
+
+	require.True(t, projectRoleWithinPermissions(&database.ProjectRole{ReadProject: true, ReadProd: true}, perms))
+	require.False(t, projectRoleWithinPermissions(&database.ProjectRole{ReadProject: true, ManageProd: true}, perms))
+	require.False(t, projectRoleWithinPermissions(&database.ProjectRole{ManageAlerts: true}, perms))
+}
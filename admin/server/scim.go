@@ -0,0 +1,239 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/scim"
+	"github.com/rilldata/rill/admin/pkg/urlutil"
+	"github.com/rilldata/rill/admin/server/auth"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// scimBasePath is the path of the SCIM 2.0 service provider of an org.
+// Identity providers are configured with the base URL {external URL}/v1/orgs/{org}/scim/v2 and a service token of the org.
+const scimBasePath = "/v1/orgs/{org}/scim/v2"
+
+// scimDefaultCount is the default number of resources returned in a list response.
+const scimDefaultCount = 100
+
+// scimMaxCount is the maximum number of resources returned in a list response.
+const scimMaxCount = 1000
+
+// scimHandler is a handler for a SCIM endpoint. Errors of type *scim.Error are written as SCIM error responses.
+type scimHandler func(w http.ResponseWriter, r *http.Request, org *database.Organization) error
+
+// registerSCIMEndpoints registers the non-gRPC endpoints of the SCIM 2.0 service provider.
+// It lets identity providers provision and deprovision the members and user groups of an org.
+func (s *Server) registerSCIMEndpoints(mux *http.ServeMux) {
+	inner := http.NewServeMux()
+	handle := func(method, path string, fn scimHandler) {
+		observability.MuxHandle(inner, method+" "+scimBasePath+path, s.authenticator.HTTPMiddleware(s.scimMiddleware(fn)))
+	}
+	handle(http.MethodGet, "/ServiceProviderConfig", s.scimServiceProviderConfig)
+	handle(http.MethodGet, "/ResourceTypes", s.scimResourceTypes)
+	handle(http.MethodGet, "/Users", s.scimListUsers)
+	handle(http.MethodPost, "/Users", s.scimCreateUser)
+	handle(http.MethodGet, "/Users/{id}", s.scimGetUser)
+	handle(http.MethodPut, "/Users/{id}", s.scimReplaceUser)
+	handle(http.MethodPatch, "/Users/{id}", s.scimPatchUser)
+	handle(http.MethodDelete, "/Users/{id}", s.scimDeleteUser)
+	handle(http.MethodGet, "/Groups", s.scimListGroups)
+	handle(http.MethodPost, "/Groups", s.scimCreateGroup)
+	handle(http.MethodGet, "/Groups/{id}", s.scimGetGroup)
+	handle(http.MethodPut, "/Groups/{id}", s.scimReplaceGroup)
+	handle(http.MethodPatch, "/Groups/{id}", s.scimPatchGroup)
+	handle(http.MethodDelete, "/Groups/{id}", s.scimDeleteGroup)
+	mux.Handle(scimBasePath+"/", observability.Middleware("admin", s.logger, inner))
+}
+
+// scimMiddleware authorizes the request and resolves the org it applies to.
+// SCIM endpoints can only be called with a service token of the org that has permission to manage its members.
+func (s *Server) scimMiddleware(fn scimHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := s.scimAuthorize(w, r, fn)
+		if err == nil {
+			return
+		}
+
+		// Internal errors are logged, but not returned to the identity provider since they may contain sensitive details
+		var serr *scim.Error
+		if !errors.As(err, &serr) {
+			s.logger.Error("scim: internal error", zap.String("path", r.URL.Path), zap.Error(err), observability.ZapCtx(r.Context()))
+			serr = scim.NewError(http.StatusInternalServerError, "", "internal error")
+		}
+		err = writeSCIM(w, serr.StatusCode(), serr)
+		if err != nil {
+			s.logger.Error("scim: failed to write error response", zap.String("path", r.URL.Path), zap.Error(err), observability.ZapCtx(r.Context()))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) scimAuthorize(w http.ResponseWriter, r *http.Request, fn scimHandler) error {
+	claims := auth.GetClaims(r.Context())
+	switch claims.OwnerType() {
+	case auth.OwnerTypeService:
+		// continue
+	case auth.OwnerTypeAnon:
+		return scim.NewError(http.StatusUnauthorized, "", "missing service token")
+	default:
+		return scim.NewError(http.StatusForbidden, "", "SCIM endpoints can only be called with a service token")
+	}
+
+	org, err := s.admin.DB.FindOrganizationByName(r.Context(), r.PathValue("org"))
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return scim.NewError(http.StatusNotFound, "", "org not found")
+		}
+		return err
+	}
+
+	if !claims.OrganizationPermissions(r.Context(), org.ID).ManageOrgMembers {
+		return scim.NewError(http.StatusForbidden, "", "not allowed to manage org members")
+	}
+
+	return fn(w, r, org)
+}
+
+// scimServiceProviderConfig describes the SCIM features supported by the service provider.
+func (s *Server) scimServiceProviderConfig(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	supported := func(ok bool) map[string]any { return map[string]any{"supported": ok} }
+	return writeSCIM(w, http.StatusOK, map[string]any{
+		"schemas":          []string{scim.SchemaServiceProviderConfig},
+		"documentationUri": "https://docs.rilldata.com",
+		"patch":            supported(true),
+		"bulk":             map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":           map[string]any{"supported": true, "maxResults": scimMaxCount},
+		"changePassword":   supported(false),
+		"sort":             supported(false),
+		"etag":             supported(false),
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "Service token",
+			"description": "Authentication with a service token of the org, passed as a bearer token in the Authorization header",
+			"primary":     true,
+		}},
+		"meta": scim.Meta{
+			ResourceType: "ServiceProviderConfig",
+			Location:     s.scimURL(org, "ServiceProviderConfig"),
+		},
+	})
+}
+
+// scimResourceTypes lists the resource types supported by the service provider.
+func (s *Server) scimResourceTypes(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	resourceType := func(name, endpoint, schema string) any {
+		return map[string]any{
+			"schemas":  []string{scim.SchemaResourceType},
+			"id":       name,
+			"name":     name,
+			"endpoint": "/" + endpoint,
+			"schema":   schema,
+			"meta": scim.Meta{
+				ResourceType: "ResourceType",
+				Location:     s.scimURL(org, "ResourceTypes", name),
+			},
+		}
+	}
+	return writeSCIM(w, http.StatusOK, scim.NewListResponse([]any{
+		resourceType("User", "Users", scim.SchemaUser),
+		resourceType("Group", "Groups", scim.SchemaGroup),
+	}, 2, 1))
+}
+
+// scimURL returns the absolute URL of a SCIM endpoint of the org.
+func (s *Server) scimURL(org *database.Organization, elem ...string) string {
+	base := strings.Replace(scimBasePath, "{org}", org.Name, 1)
+	return urlutil.MustJoinURL(s.opts.ExternalURL, append([]string{base}, elem...)...)
+}
+
+// scimListParams are the parsed query parameters of a SCIM list request.
+type scimListParams struct {
+	filter     scim.Filter
+	startIndex int
+	count      int
+}
+
+// parseSCIMListParams parses the filter, startIndex and count query parameters.
+func parseSCIMListParams(r *http.Request) (*scimListParams, error) {
+	q := r.URL.Query()
+	res := &scimListParams{startIndex: 1, count: scimDefaultCount}
+
+	if f := q.Get("filter"); f != "" {
+		filter, err := scim.ParseFilter(f)
+		if err != nil {
+			return nil, scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidFilter, "%s", err.Error())
+		}
+		res.filter = filter
+	}
+
+	if v := q.Get("startIndex"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidValue, "invalid startIndex %q", v)
+		}
+		// Values less than 1 are interpreted as 1 (RFC 7644 section 3.4.2.4)
+		if i > 1 {
+			res.startIndex = i
+		}
+	}
+
+	if v := q.Get("count"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidValue, "invalid count %q", v)
+		}
+		res.count = max(0, min(i, scimMaxCount))
+	}
+
+	return res, nil
+}
+
+// page returns the items of a filtered list of n resources to return in the response.
+func (p *scimListParams) page(n int) (start, end int) {
+	start = min(p.startIndex-1, n)
+	end = min(start+p.count, n)
+	return start, end
+}
+
+// decodeSCIM decodes the JSON body of a request.
+func decodeSCIM(r *http.Request, v any) error {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidSyntax, "invalid request body: %s", err.Error())
+	}
+	return nil
+}
+
+// writeSCIM writes a SCIM JSON response.
+// It returns an error without writing anything if v can't be marshalled, so the caller can still write an error response.
+func writeSCIM(w http.ResponseWriter, statusCode int, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response: %w", err)
+	}
+	w.Header().Set("Content-Type", scim.ContentType)
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(data)
+	return nil
+}
+
+// scimNotFound returns an error for a resource that does not exist in the org.
+func scimNotFound(resourceType, id string) error {
+	return scim.NewError(http.StatusNotFound, "", "%s %q not found", resourceType, id)
+}
+
+// isUUID returns true if id is a valid UUID.
+// It's used to return a not found error for IDs that could never be found, instead of an internal error from the database.
+func isUUID(id string) bool {
+	_, err := uuid.Parse(id)
+	return err == nil
+}
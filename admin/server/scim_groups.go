@@ -0,0 +1,601 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/scim"
+)
+
+// SCIM groups map to the user groups of an org, except for the managed group of all org members.
+// The display name of a group is its name, so it must be a valid user group name.
+// The members of a group are referenced by their SCIM user IDs, and must be members of the org.
+
+// scimListGroups lists the user groups of the org that match the request's filter.
+// Filters on displayName and unfiltered pages are resolved in the database. Other filters are evaluated against all user groups.
+func (s *Server) scimListGroups(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	params, err := parseSCIMListParams(r)
+	if err != nil {
+		return err
+	}
+
+	excludeMembers := false
+	for _, attr := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attr), "members") {
+			excludeMembers = true
+		}
+	}
+
+	// toSCIM converts a user group to a SCIM group. It only looks up the members if they're returned or needed to evaluate the filter.
+	toSCIM := func(group *database.Usergroup) (*scim.Group, error) {
+		if excludeMembers && params.filter == nil {
+			return s.scimGroup(org, group, nil), nil
+		}
+		members, err := s.scimGroupMembers(r.Context(), group.ID)
+		if err != nil {
+			return nil, err
+		}
+		return s.scimGroup(org, group, members), nil
+	}
+	memberToGroup := func(mg *database.MemberUsergroup) *database.Usergroup {
+		return &database.Usergroup{
+			ID:        mg.ID,
+			OrgID:     org.ID,
+			Name:      mg.Name,
+			CreatedOn: mg.CreatedOn,
+			UpdatedOn: mg.UpdatedOn,
+		}
+	}
+
+	if params.filter == nil {
+		total, err := s.admin.DB.CountOrganizationUnmanagedUsergroups(r.Context(), org.ID)
+		if err != nil {
+			return err
+		}
+		page, err := s.admin.DB.FindOrganizationUnmanagedUsergroupsWithOffset(r.Context(), org.ID, params.startIndex-1, params.count)
+		if err != nil {
+			return err
+		}
+		resources := make([]any, 0, len(page))
+		for _, mg := range page {
+			g, err := toSCIM(memberToGroup(mg))
+			if err != nil {
+				return err
+			}
+			if excludeMembers {
+				g.Members = nil
+			}
+			resources = append(resources, g)
+		}
+		return writeSCIM(w, http.StatusOK, scim.NewListResponse(resources, total, params.startIndex))
+	}
+
+	var groups []*scim.Group
+	add := func(group *database.Usergroup) error {
+		if isManagedUsergroup(org, group) {
+			return nil
+		}
+		g, err := toSCIM(group)
+		if err != nil {
+			return err
+		}
+		if params.filter.Match(g.Attributes()) {
+			if excludeMembers {
+				g.Members = nil
+			}
+			groups = append(groups, g)
+		}
+		return nil
+	}
+
+	if name, ok := scim.EqualityValue(params.filter, "displayname"); ok {
+		// Only the user group with the name can match, so we look it up directly
+		group, err := s.admin.DB.FindUsergroupByName(r.Context(), org.ID, name)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		if group != nil {
+			if err := add(group); err != nil {
+				return err
+			}
+		}
+	} else {
+		afterName := ""
+		for {
+			page, err := s.admin.DB.FindOrganizationMemberUsergroups(r.Context(), org.ID, afterName, scimMaxCount)
+			if err != nil {
+				return err
+			}
+			for _, mg := range page {
+				if err := add(memberToGroup(mg)); err != nil {
+					return err
+				}
+			}
+			if len(page) < scimMaxCount {
+				break
+			}
+			afterName = page[len(page)-1].Name
+		}
+	}
+
+	start, end := params.page(len(groups))
+	resources := make([]any, 0, end-start)
+	for _, g := range groups[start:end] {
+		resources = append(resources, g)
+	}
+
+	return writeSCIM(w, http.StatusOK, scim.NewListResponse(resources, len(groups), params.startIndex))
+}
+
+// scimGetGroup returns a user group of the org.
+func (s *Server) scimGetGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	group, err := s.scimFindGroup(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	members, err := s.scimGroupMembers(r.Context(), group.ID)
+	if err != nil {
+		return err
+	}
+
+	return writeSCIM(w, http.StatusOK, s.scimGroup(org, group, members))
+}
+
+// scimCreateGroup creates a user group with the given members.
+func (s *Server) scimCreateGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	var req scim.Group
+	if err := decodeSCIM(r, &req); err != nil {
+		return err
+	}
+	if err := validateSCIMGroupName(req.DisplayName); err != nil {
+		return err
+	}
+
+	memberIDs := make([]string, len(req.Members))
+	for i, m := range req.Members {
+		memberIDs[i] = m.Value
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(r.Context())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	group, err := s.admin.DB.InsertUsergroup(ctx, &database.InsertUsergroupOptions{
+		OrgID: org.ID,
+		Name:  req.DisplayName,
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return scim.NewError(http.StatusConflict, scim.ErrorTypeUniqueness, "a group named %q already exists", req.DisplayName)
+		}
+		return err
+	}
+
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   org.ID,
+		Action:  database.AuditActionUsergroupCreate,
+		Target:  group.Name,
+		Details: map[string]any{"scim": true},
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.scimSetGroupMembers(ctx, org, group, nil, memberIDs)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	members, err := s.scimGroupMembers(r.Context(), group.ID)
+	if err != nil {
+		return err
+	}
+
+	res := s.scimGroup(org, group, members)
+	w.Header().Set("Location", res.Meta.Location)
+	return writeSCIM(w, http.StatusCreated, res)
+}
+
+// scimReplaceGroup replaces the name and members of a user group.
+func (s *Server) scimReplaceGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	var req scim.Group
+	if err := decodeSCIM(r, &req); err != nil {
+		return err
+	}
+
+	memberIDs := make([]string, len(req.Members))
+	for i, m := range req.Members {
+		memberIDs[i] = m.Value
+	}
+
+	return s.scimUpdateGroup(w, r, org, func(name *string, members *[]string) error {
+		*name = req.DisplayName
+		*members = memberIDs
+		return nil
+	})
+}
+
+// scimPatchGroup applies patch operations to the name and members of a user group.
+func (s *Server) scimPatchGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	var req scim.PatchRequest
+	if err := decodeSCIM(r, &req); err != nil {
+		return err
+	}
+
+	return s.scimUpdateGroup(w, r, org, func(name *string, members *[]string) error {
+		for _, op := range req.Operations {
+			err := applySCIMGroupPatch(op, name, members)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// scimDeleteGroup deletes a user group.
+func (s *Server) scimDeleteGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	group, err := s.scimFindGroup(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(r.Context())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// The group's memberships and org and project roles are deleted by cascade
+	err = s.admin.DB.DeleteUsergroup(ctx, group.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   org.ID,
+		Action:  database.AuditActionUsergroupDelete,
+		Target:  group.Name,
+		Details: map[string]any{"scim": true},
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// scimUpdateGroup updates the name and members of a user group.
+// The update function is called with the current name and member IDs of the group and should modify them in place.
+func (s *Server) scimUpdateGroup(w http.ResponseWriter, r *http.Request, org *database.Organization, update func(name *string, members *[]string) error) error {
+	group, err := s.scimFindGroup(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	current, err := s.scimGroupMembers(r.Context(), group.ID)
+	if err != nil {
+		return err
+	}
+	currentIDs := make([]string, len(current))
+	for i, m := range current {
+		currentIDs[i] = m.ID
+	}
+
+	name := group.Name
+	memberIDs := append([]string{}, currentIDs...)
+	err = update(&name, &memberIDs)
+	if err != nil {
+		return err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(r.Context())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if name != group.Name {
+		if err := validateSCIMGroupName(name); err != nil {
+			return err
+		}
+
+		prevName := group.Name
+		group, err = s.admin.DB.UpdateUsergroup(ctx, group.ID, &database.UpdateUsergroupOptions{Name: name})
+		if err != nil {
+			if errors.Is(err, database.ErrNotUnique) {
+				return scim.NewError(http.StatusConflict, scim.ErrorTypeUniqueness, "a group named %q already exists", name)
+			}
+			return err
+		}
+
+		err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:   org.ID,
+			Action:  database.AuditActionUsergroupRename,
+			Target:  group.Name,
+			Details: map[string]any{"previous_name": prevName, "scim": true},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.scimSetGroupMembers(ctx, org, group, currentIDs, memberIDs)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	members, err := s.scimGroupMembers(r.Context(), group.ID)
+	if err != nil {
+		return err
+	}
+
+	return writeSCIM(w, http.StatusOK, s.scimGroup(org, group, members))
+}
+
+// scimSetGroupMembers adds and removes members of a user group so its members change from the current to the desired user IDs.
+// It should be called with the ctx of a transaction.
+func (s *Server) scimSetGroupMembers(ctx context.Context, org *database.Organization, group *database.Usergroup, current, desired []string) error {
+	currentSet := make(map[string]bool, len(current))
+	for _, id := range current {
+		currentSet[id] = true
+	}
+	desiredSet := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		desiredSet[id] = true
+	}
+
+	added := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		if currentSet[id] || added[id] {
+			continue
+		}
+		added[id] = true
+
+		// Only org members can be added to the org's user groups
+		user, isMember, err := s.scimFindUser(ctx, org, id)
+		if err != nil {
+			var serr *scim.Error
+			if errors.As(err, &serr) {
+				return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidValue, "member %q is not a user of the org", id)
+			}
+			return err
+		}
+		if !isMember {
+			return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidValue, "member %q is not a user of the org", id)
+		}
+
+		err = s.admin.DB.InsertUsergroupMember(ctx, group.ID, user.ID)
+		if err != nil {
+			return err
+		}
+
+		err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:   org.ID,
+			Action:  database.AuditActionUsergroupMemberAdd,
+			Target:  group.Name,
+			Details: map[string]any{"email": user.Email, "scim": true},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range current {
+		if desiredSet[id] {
+			continue
+		}
+
+		user, err := s.admin.DB.FindUser(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = s.admin.DB.DeleteUsergroupMember(ctx, group.ID, user.ID)
+		if err != nil {
+			return err
+		}
+
+		err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:   org.ID,
+			Action:  database.AuditActionUsergroupMemberRemove,
+			Target:  group.Name,
+			Details: map[string]any{"email": user.Email, "scim": true},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applySCIMGroupPatch applies a patch operation to the name and member IDs of a group.
+func applySCIMGroupPatch(op scim.PatchOperation, name *string, members *[]string) error {
+	opName := strings.ToLower(op.Op)
+	switch opName {
+	case "add", "replace", "remove":
+		// continue
+	default:
+		return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidSyntax, "unsupported patch operation %q", op.Op)
+	}
+
+	// Without a path, the value is an object of attributes to add or replace
+	if op.Path == "" {
+		if opName == "remove" {
+			return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidPath, "path is required for remove operations")
+		}
+		values := map[string]json.RawMessage{}
+		if err := json.Unmarshal(op.Value, &values); err != nil {
+			return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidValue, "patch value must be an object if there is no path")
+		}
+		for path, val := range values {
+			err := applySCIMGroupPatch(scim.PatchOperation{Op: op.Op, Path: path, Value: val}, name, members)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	path, err := scim.ParsePath(op.Path)
+	if err != nil {
+		return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidPath, "%s", err.Error())
+	}
+
+	switch path.Attribute {
+	case "displayname":
+		if opName == "remove" {
+			return scim.NewError(http.StatusBadRequest, scim.ErrorTypeMutability, "displayName is required")
+		}
+		if err := json.Unmarshal(op.Value, name); err != nil {
+			return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidValue, "displayName must be a string")
+		}
+		return nil
+	case "members":
+		// continue
+	default:
+		// Other attributes, such as externalId, are not stored
+		return nil
+	}
+
+	// Parse the member values of the operation (if any)
+	var values []scim.MultiValue
+	if len(op.Value) > 0 && string(op.Value) != "null" {
+		if err := json.Unmarshal(op.Value, &values); err != nil {
+			return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidValue, "members must be a list of objects with a value")
+		}
+	}
+	ids := make(map[string]bool, len(values))
+	for _, v := range values {
+		ids[v.Value] = true
+	}
+
+	switch opName {
+	case "add":
+		for _, v := range values {
+			*members = append(*members, v.Value)
+		}
+	case "replace":
+		if path.Filter != nil {
+			return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidPath, "replacing filtered members is not supported")
+		}
+		*members = make([]string, 0, len(values))
+		for _, v := range values {
+			*members = append(*members, v.Value)
+		}
+	case "remove":
+		// Remove the members that match the path's filter, the members in the value, or all members if neither is set
+		res := make([]string, 0, len(*members))
+		for _, id := range *members {
+			var remove bool
+			switch {
+			case path.Filter != nil:
+				remove = path.Filter.Match(scim.Attributes{"value": {id}})
+			case len(values) > 0:
+				remove = ids[id]
+			default:
+				remove = true
+			}
+			if !remove {
+				res = append(res, id)
+			}
+		}
+		*members = res
+	}
+
+	return nil
+}
+
+// scimFindGroup finds a user group of the org by ID. The managed group of all org members is not exposed over SCIM.
+func (s *Server) scimFindGroup(ctx context.Context, org *database.Organization, id string) (*database.Usergroup, error) {
+	if !isUUID(id) {
+		return nil, scimNotFound("group", id)
+	}
+
+	group, err := s.admin.DB.FindUsergroup(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, scimNotFound("group", id)
+		}
+		return nil, err
+	}
+	if group.OrgID != org.ID || isManagedUsergroup(org, group) {
+		return nil, scimNotFound("group", id)
+	}
+
+	return group, nil
+}
+
+// scimGroupMembers returns all the members of a user group.
+func (s *Server) scimGroupMembers(ctx context.Context, groupID string) ([]*database.UsergroupMemberUser, error) {
+	var res []*database.UsergroupMemberUser
+	afterEmail := ""
+	for {
+		members, err := s.admin.DB.FindUsergroupMemberUsers(ctx, groupID, afterEmail, scimMaxCount)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, members...)
+		if len(members) < scimMaxCount {
+			return res, nil
+		}
+		afterEmail = members[len(members)-1].Email
+	}
+}
+
+// scimGroup converts a user group to a SCIM Group resource.
+func (s *Server) scimGroup(org *database.Organization, group *database.Usergroup, members []*database.UsergroupMemberUser) *scim.Group {
+	res := &scim.Group{
+		Schemas:     []string{scim.SchemaGroup},
+		ID:          group.ID,
+		DisplayName: group.Name,
+		Members:     make([]scim.MultiValue, len(members)),
+		Meta: &scim.Meta{
+			ResourceType: "Group",
+			Created:      &group.CreatedOn,
+			LastModified: &group.UpdatedOn,
+			Location:     s.scimURL(org, "Groups", group.ID),
+		},
+	}
+	for i, m := range members {
+		res.Members[i] = scim.MultiValue{
+			Value:   m.ID,
+			Display: m.Email,
+		}
+	}
+	return res
+}
+
+// validateSCIMGroupName returns an error if the name is not a valid user group name.
+func validateSCIMGroupName(name string) error {
+	err := database.Validate(&database.InsertUsergroupOptions{Name: name})
+	if err != nil {
+		return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidValue, "invalid group name %q: must be 3-40 letters, digits, hyphens or underscores", name)
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/ai"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
+	"github.com/rilldata/rill/admin/pkg/scim"
+	"github.com/rilldata/rill/admin/server/auth"
+	"github.com/rilldata/rill/admin/server/cookies"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	runtimeauth "github.com/rilldata/rill/runtime/server/auth"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	_ "github.com/rilldata/rill/admin/database/postgres"
+)
+
+func TestSCIM(t *testing.T) {
+	pg := pgtestcontainer.New(t)
+	defer pg.Terminate(t)
+
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	sender, err := email.NewConsoleSender(logger, "rill-test@rilldata.io", "")
+	require.NoError(t, err)
+
+	issuer, err := runtimeauth.NewEphemeralIssuer("")
+	require.NoError(t, err)
+
+	service, err := admin.New(ctx,
+		&admin.Options{
+			DatabaseDriver:     "postgres",
+			DatabaseDSN:        pg.DatabaseURL,
+			ProvisionerSetJSON: "{\"static\":{\"type\":\"static\",\"spec\":{\"runtimes\":[{\"host\":\"http://localhost:9091\",\"slots\":50,\"data_dir\":\"\",\"audience_url\":\"http://localhost:8081\"}]}}}",
+			DefaultProvisioner: "static",
+			ExternalURL:        "http://localhost:9090",
+		},
+		logger,
+		issuer,
+		email.New(sender),
+		&mockGithub{},
+		ai.NewNoop(),
+	)
+	require.NoError(t, err)
+	db := service.DB
+
+	authenticator, err := auth.NewAuthenticator(logger, service, cookies.New(logger, nil), &auth.AuthenticatorOptions{
+		AuthDomain: "gorillio-stage.auth0.com",
+	})
+	require.NoError(t, err)
+
+	server := Server{
+		admin:         service,
+		opts:          &Options{ExternalURL: "http://localhost:9090"},
+		authenticator: authenticator,
+		logger:        logger,
+	}
+	mux := http.NewServeMux()
+	server.registerSCIMEndpoints(mux)
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	// An org with an admin and a service for the identity provider
+	adminUser, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "admin@test.io", DisplayName: "admin", QuotaSingleuserOrgs: 3})
+	require.NoError(t, err)
+	org, err := service.CreateOrganizationForUser(ctx, adminUser.ID, "scim-org", "")
+	require.NoError(t, err)
+	svc, err := db.InsertService(ctx, &database.InsertServiceOptions{OrgID: org.ID, Name: "idp"})
+	require.NoError(t, err)
+	svcToken, err := service.IssueServiceAuthToken(ctx, svc.ID, nil)
+	require.NoError(t, err)
+	token := svcToken.Token().String()
+
+	// Another org with its own service
+	otherOrg, err := service.CreateOrganizationForUser(ctx, adminUser.ID, "other-org", "")
+	require.NoError(t, err)
+	otherSvc, err := db.InsertService(ctx, &database.InsertServiceOptions{OrgID: otherOrg.ID, Name: "idp"})
+	require.NoError(t, err)
+	otherSvcToken, err := service.IssueServiceAuthToken(ctx, otherSvc.ID, nil)
+	require.NoError(t, err)
+
+	// A user token of the org's admin
+	userToken, err := service.IssueUserAuthToken(ctx, adminUser.ID, database.AuthClientIDRillWeb, "test", nil, nil)
+	require.NoError(t, err)
+
+	// A user that was never provisioned into the org
+	outsider, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "outsider@test.io", DisplayName: "outsider", QuotaSingleuserOrgs: 3})
+	require.NoError(t, err)
+
+	do := func(method, path, token string, body any) (int, map[string]any) {
+		var buf bytes.Buffer
+		if body != nil {
+			require.NoError(t, json.NewEncoder(&buf).Encode(body))
+		}
+		req, err := http.NewRequestWithContext(ctx, method, ts.URL+"/v1/orgs/scim-org/scim/v2"+path, &buf)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", scim.ContentType)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		var out map[string]any
+		if res.StatusCode != http.StatusNoContent {
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+		}
+		return res.StatusCode, out
+	}
+	isMember := func(userID string) bool {
+		ok, err := db.CheckUserIsAnOrganizationMember(ctx, userID, org.ID)
+		require.NoError(t, err)
+		return ok
+	}
+	patchActive := func(active bool) map[string]any {
+		return map[string]any{
+			"schemas":    []string{scim.SchemaPatchOp},
+			"Operations": []map[string]any{{"op": "replace", "path": "active", "value": active}},
+		}
+	}
+
+	t.Run("auth", func(t *testing.T) {
+		code, _ := do(http.MethodGet, "/Users", "", nil)
+		require.Equal(t, http.StatusUnauthorized, code)
+		code, _ = do(http.MethodGet, "/Users", userToken.Token().String(), nil)
+		require.Equal(t, http.StatusForbidden, code)
+		code, _ = do(http.MethodGet, "/Users", otherSvcToken.Token().String(), nil)
+		require.Equal(t, http.StatusForbidden, code)
+		code, _ = do(http.MethodGet, "/Users", token, nil)
+		require.Equal(t, http.StatusOK, code)
+	})
+
+	var aliceID string
+	t.Run("create, deactivate and reactivate", func(t *testing.T) {
+		code, res := do(http.MethodPost, "/Users", token, map[string]any{"schemas": []string{scim.SchemaUser}, "userName": "alice@test.io"})
+		require.Equal(t, http.StatusCreated, code)
+		aliceID = res["id"].(string)
+		require.True(t, isMember(aliceID))
+
+		code, _ = do(http.MethodPost, "/Users", token, map[string]any{"schemas": []string{scim.SchemaUser}, "userName": "alice@test.io"})
+		require.Equal(t, http.StatusConflict, code)
+
+		code, res = do(http.MethodPatch, "/Users/"+aliceID, token, patchActive(false))
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, false, res["active"])
+		require.False(t, isMember(aliceID))
+		code, _ = do(http.MethodGet, "/Users/"+aliceID, token, nil)
+		require.Equal(t, http.StatusNotFound, code)
+
+		code, res = do(http.MethodPatch, "/Users/"+aliceID, token, patchActive(true))
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, true, res["active"])
+		require.True(t, isMember(aliceID))
+
+		code, _ = do(http.MethodDelete, "/Users/"+aliceID, token, nil)
+		require.Equal(t, http.StatusNoContent, code)
+		require.False(t, isMember(aliceID))
+
+		code, _ = do(http.MethodPut, "/Users/"+aliceID, token, map[string]any{"schemas": []string{scim.SchemaUser}, "userName": "alice@test.io", "active": true})
+		require.Equal(t, http.StatusOK, code)
+		require.True(t, isMember(aliceID))
+	})
+
+	t.Run("users not provisioned into the org can't be activated", func(t *testing.T) {
+		code, _ := do(http.MethodPatch, "/Users/"+outsider.ID, token, patchActive(true))
+		require.Equal(t, http.StatusNotFound, code)
+		code, _ = do(http.MethodPut, "/Users/"+outsider.ID, token, map[string]any{"schemas": []string{scim.SchemaUser}, "userName": outsider.Email, "active": true})
+		require.Equal(t, http.StatusNotFound, code)
+		require.False(t, isMember(outsider.ID))
+	})
+
+	t.Run("last admin can't be deprovisioned", func(t *testing.T) {
+		code, res := do(http.MethodDelete, "/Users/"+adminUser.ID, token, nil)
+		require.Equal(t, http.StatusBadRequest, code)
+		require.Equal(t, "cannot remove the last admin member", res["detail"])
+		require.True(t, isMember(adminUser.ID))
+	})
+
+	t.Run("group members", func(t *testing.T) {
+		code, res := do(http.MethodPost, "/Groups", token, map[string]any{
+			"schemas":     []string{scim.SchemaGroup},
+			"displayName": "analysts",
+			"members":     []map[string]any{{"value": aliceID}},
+		})
+		require.Equal(t, http.StatusCreated, code)
+		groupID := res["id"].(string)
+		members, err := db.FindUsergroupMemberUsers(ctx, groupID, "", 10)
+		require.NoError(t, err)
+		require.Len(t, members, 1)
+		require.Equal(t, aliceID, members[0].ID)
+
+		// Users that are not members of the org can't be added
+		code, _ = do(http.MethodPatch, "/Groups/"+groupID, token, map[string]any{
+			"schemas":    []string{scim.SchemaPatchOp},
+			"Operations": []map[string]any{{"op": "add", "path": "members", "value": []map[string]any{{"value": outsider.ID}}}},
+		})
+		require.Equal(t, http.StatusBadRequest, code)
+
+		// Deprovisioning a user removes it from the org's groups
+		code, _ = do(http.MethodPatch, "/Users/"+aliceID, token, patchActive(false))
+		require.Equal(t, http.StatusOK, code)
+		members, err = db.FindUsergroupMemberUsers(ctx, groupID, "", 10)
+		require.NoError(t, err)
+		require.Len(t, members, 0)
+
+		// Re-activating it doesn't restore its group memberships until the identity provider syncs the group
+		code, _ = do(http.MethodPatch, "/Users/"+aliceID, token, patchActive(true))
+		require.Equal(t, http.StatusOK, code)
+		code, res = do(http.MethodPatch, "/Groups/"+groupID, token, map[string]any{
+			"schemas":    []string{scim.SchemaPatchOp},
+			"Operations": []map[string]any{{"op": "replace", "path": "members", "value": []map[string]any{{"value": aliceID}}}},
+		})
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, res["members"], 1)
+	})
+
+	t.Run("list with filters and paging", func(t *testing.T) {
+		list := func(resource, query string) (int, []any) {
+			code, res := do(http.MethodGet, "/"+resource+"?"+query, token, nil)
+			require.Equal(t, http.StatusOK, code)
+			return int(res["totalResults"].(float64)), res["Resources"].([]any)
+		}
+		id := func(resource any) string {
+			return resource.(map[string]any)["id"].(string)
+		}
+
+		// Unfiltered lists are paged in order of email, and the total counts all members
+		total, users := list("Users", "")
+		require.Equal(t, 2, total)
+		require.Len(t, users, 2)
+		total, users = list("Users", "startIndex=2&count=1")
+		require.Equal(t, 2, total)
+		require.Len(t, users, 1)
+		require.Equal(t, aliceID, id(users[0]))
+		total, users = list("Users", "startIndex=3")
+		require.Equal(t, 2, total)
+		require.Len(t, users, 0)
+
+		// Users are looked up by userName, but only members of the org are returned
+		total, users = list("Users", "filter="+url.QueryEscape(`userName eq "ALICE@test.io"`))
+		require.Equal(t, 1, total)
+		require.Equal(t, aliceID, id(users[0]))
+		total, _ = list("Users", "filter="+url.QueryEscape(`userName eq "alice@test.io" and active eq false`))
+		require.Equal(t, 0, total)
+		total, _ = list("Users", "filter="+url.QueryEscape(`userName eq "outsider@test.io"`))
+		require.Equal(t, 0, total)
+
+		// Other filters are evaluated against all members
+		total, users = list("Users", "filter="+url.QueryEscape(`userName co "alice"`))
+		require.Equal(t, 1, total)
+		require.Equal(t, aliceID, id(users[0]))
+
+		// The managed group of all members is not listed
+		total, groups := list("Groups", "excludedAttributes=members")
+		require.Equal(t, 1, total)
+		require.Equal(t, "analysts", groups[0].(map[string]any)["displayName"])
+		require.Nil(t, groups[0].(map[string]any)["members"])
+
+		total, groups = list("Groups", "filter="+url.QueryEscape(`displayName eq "Analysts"`))
+		require.Equal(t, 1, total)
+		require.Len(t, groups[0].(map[string]any)["members"], 1)
+		total, _ = list("Groups", "filter="+url.QueryEscape(`displayName eq "analysts" and members[value eq "`+outsider.ID+`"]`))
+		require.Equal(t, 0, total)
+	})
+}
@@ -0,0 +1,428 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/scim"
+)
+
+// SCIM users map to the members of an org.
+// Provisioning a user adds it to the org with the viewer role (creating the user if it doesn't exist),
+// and deprovisioning it (by setting active to false or deleting it) removes it from the org, its user groups and its projects.
+// Profile attributes belong to the user's Rill account, which may be a member of other orgs, so they are only used when the account is created.
+
+// scimListUsers lists the members of the org that match the request's filter.
+// Filters on userName and unfiltered pages are resolved in the database. Other filters are evaluated against all members.
+func (s *Server) scimListUsers(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	params, err := parseSCIMListParams(r)
+	if err != nil {
+		return err
+	}
+
+	if params.filter == nil {
+		total, err := s.admin.DB.CountOrganizationMemberUsers(r.Context(), org.ID)
+		if err != nil {
+			return err
+		}
+		members, err := s.admin.DB.FindOrganizationMemberUsersWithOffset(r.Context(), org.ID, params.startIndex-1, params.count)
+		if err != nil {
+			return err
+		}
+		resources := make([]any, 0, len(members))
+		for _, m := range members {
+			resources = append(resources, s.scimUser(org, memberToUser(m), true))
+		}
+		return writeSCIM(w, http.StatusOK, scim.NewListResponse(resources, total, params.startIndex))
+	}
+
+	var users []*scim.User
+	if email, ok := scim.EqualityValue(params.filter, "username"); ok {
+		// Only the member with the email can match, so we look it up directly
+		user, err := s.admin.DB.FindUserByEmail(r.Context(), email)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		if user != nil {
+			isMember, err := s.admin.DB.CheckUserIsAnOrganizationMember(r.Context(), user.ID, org.ID)
+			if err != nil {
+				return err
+			}
+			if u := s.scimUser(org, user, true); isMember && params.filter.Match(u.Attributes()) {
+				users = append(users, u)
+			}
+		}
+	} else {
+		afterEmail := ""
+		for {
+			members, err := s.admin.DB.FindOrganizationMemberUsers(r.Context(), org.ID, afterEmail, scimMaxCount)
+			if err != nil {
+				return err
+			}
+			for _, m := range members {
+				u := s.scimUser(org, memberToUser(m), true)
+				if params.filter.Match(u.Attributes()) {
+					users = append(users, u)
+				}
+			}
+			if len(members) < scimMaxCount {
+				break
+			}
+			afterEmail = members[len(members)-1].Email
+		}
+	}
+
+	start, end := params.page(len(users))
+	resources := make([]any, 0, end-start)
+	for _, u := range users[start:end] {
+		resources = append(resources, u)
+	}
+
+	return writeSCIM(w, http.StatusOK, scim.NewListResponse(resources, len(users), params.startIndex))
+}
+
+// scimGetUser returns a member of the org.
+func (s *Server) scimGetUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	user, isMember, err := s.scimFindUser(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return scimNotFound("user", user.ID)
+	}
+
+	return writeSCIM(w, http.StatusOK, s.scimUser(org, user, true))
+}
+
+// scimCreateUser provisions a user as a member of the org.
+func (s *Server) scimCreateUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	var req scim.User
+	if err := decodeSCIM(r, &req); err != nil {
+		return err
+	}
+
+	email := req.Email()
+	if email == "" {
+		return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidValue, "userName is required")
+	}
+	if _, err := mail.ParseAddress(email); err != nil {
+		return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidValue, "userName must be an email address, got %q", email)
+	}
+
+	user, err := s.admin.DB.FindUserByEmail(r.Context(), email)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+
+		// Creating the user also accepts its pending invites, which may include an invite to this org.
+		user, err = s.admin.CreateOrUpdateUser(r.Context(), email, req.FullName(), "")
+		if err != nil {
+			return err
+		}
+	} else {
+		isMember, err := s.admin.DB.CheckUserIsAnOrganizationMember(r.Context(), user.ID, org.ID)
+		if err != nil {
+			return err
+		}
+		if isMember {
+			return scim.NewError(http.StatusConflict, scim.ErrorTypeUniqueness, "user %q is already a member of the org", email)
+		}
+	}
+
+	err = s.scimActivateUser(r.Context(), org, user)
+	if err != nil {
+		return err
+	}
+
+	res := s.scimUser(org, user, true)
+	w.Header().Set("Location", res.Meta.Location)
+	return writeSCIM(w, http.StatusCreated, res)
+}
+
+// scimReplaceUser replaces a user. Only its active attribute is applied.
+func (s *Server) scimReplaceUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	var req scim.User
+	if err := decodeSCIM(r, &req); err != nil {
+		return err
+	}
+
+	user, isMember, err := s.scimFindUser(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	if email := req.Email(); email != "" && !strings.EqualFold(email, user.Email) {
+		return scim.NewError(http.StatusBadRequest, scim.ErrorTypeMutability, "userName can't be changed")
+	}
+
+	active := req.Active == nil || *req.Active
+	err = s.scimSetUserActive(r.Context(), org, user, isMember, active)
+	if err != nil {
+		return err
+	}
+
+	return writeSCIM(w, http.StatusOK, s.scimUser(org, user, active))
+}
+
+// scimPatchUser updates a user. Only operations on its active attribute are applied.
+func (s *Server) scimPatchUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	var req scim.PatchRequest
+	if err := decodeSCIM(r, &req); err != nil {
+		return err
+	}
+
+	user, isMember, err := s.scimFindUser(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	active := isMember
+	for _, op := range req.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			// continue
+		case "remove":
+			// Removing attributes is not meaningful for the attributes we apply
+			continue
+		default:
+			return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidSyntax, "unsupported patch operation %q", op.Op)
+		}
+
+		// Without a path, the value is an object of attributes to set
+		values := map[string]json.RawMessage{}
+		if op.Path == "" {
+			if err := json.Unmarshal(op.Value, &values); err != nil {
+				return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidValue, "patch value must be an object if there is no path")
+			}
+		} else {
+			values[op.Path] = op.Value
+		}
+
+		for path, val := range values {
+			p, err := scim.ParsePath(path)
+			if err != nil {
+				return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidPath, "%s", err.Error())
+			}
+			if p.Attribute != "active" {
+				continue
+			}
+			active, err = scim.ParseBool(val)
+			if err != nil {
+				return scim.NewError(http.StatusBadRequest, scim.ErrorTypeInvalidValue, "%s", err.Error())
+			}
+		}
+	}
+
+	err = s.scimSetUserActive(r.Context(), org, user, isMember, active)
+	if err != nil {
+		return err
+	}
+
+	return writeSCIM(w, http.StatusOK, s.scimUser(org, user, active))
+}
+
+// scimDeleteUser deprovisions a member of the org.
+func (s *Server) scimDeleteUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	user, isMember, err := s.scimFindUser(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	err = s.scimSetUserActive(r.Context(), org, user, isMember, false)
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// scimFindUser finds a user by ID and checks if it's a member of the org.
+// Users that are not members of the org can only be found if they were previously provisioned into the org through SCIM,
+// so that they can be re-activated after being deactivated, but other users can't be added to the org by ID.
+func (s *Server) scimFindUser(ctx context.Context, org *database.Organization, id string) (*database.User, bool, error) {
+	if !isUUID(id) {
+		return nil, false, scimNotFound("user", id)
+	}
+
+	user, err := s.admin.DB.FindUser(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, false, scimNotFound("user", id)
+		}
+		return nil, false, err
+	}
+
+	isMember, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, user.ID, org.ID)
+	if err != nil {
+		return nil, false, err
+	}
+	if isMember {
+		return user, true, nil
+	}
+
+	provisioned, err := s.admin.DB.CheckUserIsSCIMProvisioned(ctx, user.ID, org.ID)
+	if err != nil {
+		return nil, false, err
+	}
+	if !provisioned {
+		return nil, false, scimNotFound("user", id)
+	}
+
+	return user, false, nil
+}
+
+// scimSetUserActive adds the user to the org if active is true, or removes it if active is false.
+// Deactivating a user that is not a member of the org returns a not found error.
+func (s *Server) scimSetUserActive(ctx context.Context, org *database.Organization, user *database.User, isMember, active bool) error {
+	switch {
+	case active && !isMember:
+		return s.scimActivateUser(ctx, org, user)
+	case !active && isMember:
+		return s.scimDeactivateUser(ctx, org, user)
+	case !active && !isMember:
+		return scimNotFound("user", user.ID)
+	default:
+		return nil
+	}
+}
+
+// scimActivateUser adds a user to the org with the viewer role and records that it was provisioned through SCIM.
+// It does nothing if the user is already a member of the org.
+func (s *Server) scimActivateUser(ctx context.Context, org *database.Organization, user *database.User) error {
+	isMember, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, user.ID, org.ID)
+	if err != nil {
+		return err
+	}
+	if isMember {
+		return nil
+	}
+
+	role, err := s.admin.DB.FindOrganizationRole(ctx, org.ID, database.OrganizationRoleNameViewer)
+	if err != nil {
+		return err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DB.InsertOrganizationMemberUser(ctx, org.ID, user.ID, role.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.DB.InsertSCIMProvisionedUser(ctx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.DB.InsertUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+	if err != nil && !errors.Is(err, database.ErrNotUnique) {
+		return err
+	}
+
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   org.ID,
+		Action:  database.AuditActionOrganizationMemberAdd,
+		Target:  user.Email,
+		Details: map[string]any{"role": role.Name, "scim": true},
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// scimDeactivateUser removes a user from the org, its user groups and its projects.
+// It records the user as provisioned through SCIM (if it was added to the org by other means), so the identity provider can re-activate it later.
+func (s *Server) scimDeactivateUser(ctx context.Context, org *database.Organization, user *database.User) error {
+	role, err := s.admin.DB.FindOrganizationRole(ctx, org.ID, database.OrganizationRoleNameAdmin)
+	if err != nil {
+		return err
+	}
+	admins, err := s.admin.DB.FindOrganizationMemberUsersByRole(ctx, org.ID, role.ID)
+	if err != nil {
+		return err
+	}
+	if len(admins) == 1 && admins[0].ID == user.ID {
+		return scim.NewError(http.StatusBadRequest, "", "cannot remove the last admin member")
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DB.DeleteOrganizationMemberUser(ctx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.DB.InsertSCIMProvisionedUser(ctx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.DB.DeleteUsergroupsMemberUser(ctx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.DB.DeleteAllProjectMemberUserForOrganization(ctx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   org.ID,
+		Action:  database.AuditActionOrganizationMemberRemove,
+		Target:  user.Email,
+		Details: map[string]any{"scim": true},
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// memberToUser converts an org member to the user fields used by scimUser.
+func memberToUser(m *database.Member) *database.User {
+	return &database.User{
+		ID:          m.ID,
+		Email:       m.Email,
+		DisplayName: m.DisplayName,
+		CreatedOn:   m.CreatedOn,
+		UpdatedOn:   m.UpdatedOn,
+	}
+}
+
+// scimUser converts a user to a SCIM User resource.
+func (s *Server) scimUser(org *database.Organization, user *database.User, active bool) *scim.User {
+	return &scim.User{
+		Schemas:     []string{scim.SchemaUser},
+		ID:          user.ID,
+		UserName:    user.Email,
+		DisplayName: user.DisplayName,
+		Emails:      []scim.MultiValue{{Value: user.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta: &scim.Meta{
+			ResourceType: "User",
+			Created:      &user.CreatedOn,
+			LastModified: &user.UpdatedOn,
+			Location:     s.scimURL(org, "Users", user.ID),
+		},
+	}
+}
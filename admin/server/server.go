@@ -246,6 +246,9 @@ func (s *Server) HTTPHandler(ctx context.Context) (http.Handler, error) {
 	// Add Github-related endpoints (not gRPC handlers, just regular endpoints on /github/*)
 	s.registerGithubEndpoints(mux)
 
+	// Add SCIM endpoints (not gRPC handlers, just regular endpoints on /v1/orgs/{org}/scim/v2/*)
+	s.registerSCIMEndpoints(mux)
+
 	// Build CORS options for admin server
 
 	// If the AllowedOrigins contains a "*" we want to return the requester's origin instead of "*" in the "Access-Control-Allow-Origin" header.
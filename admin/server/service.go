@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/server/auth"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
@@ -31,6 +32,12 @@ func (s *Server) CreateService(ctx context.Context, req *adminv1.CreateServiceRe
 		return nil, status.Error(codes.PermissionDenied, "not allowed to create a service")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	service, err := s.admin.DB.InsertService(ctx, &database.InsertServiceOptions{
 		OrgID: org.ID,
 		Name:  req.Name,
@@ -39,6 +46,20 @@ func (s *Server) CreateService(ctx context.Context, req *adminv1.CreateServiceRe
 		return nil, err
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: database.AuditActionServiceCreate,
+		Target: service.Name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.CreateServiceResponse{
 		Service: serviceToPB(service, req.OrganizationName),
 	}, nil
@@ -98,6 +119,12 @@ func (s *Server) UpdateService(ctx context.Context, req *adminv1.UpdateServiceRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	updatedService, err := s.admin.DB.UpdateService(ctx, service.ID, &database.UpdateServiceOptions{
 		Name: valOrDefault(req.NewName, service.Name),
 	})
@@ -105,6 +132,21 @@ func (s *Server) UpdateService(ctx context.Context, req *adminv1.UpdateServiceRe
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   org.ID,
+		Action:  database.AuditActionServiceUpdate,
+		Target:  updatedService.Name,
+		Details: map[string]any{"previous_name": service.Name},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.UpdateServiceResponse{
 		Service: serviceToPB(updatedService, req.OrganizationName),
 	}, nil
@@ -132,11 +174,31 @@ func (s *Server) DeleteService(ctx context.Context, req *adminv1.DeleteServiceRe
 		return nil, status.Error(codes.PermissionDenied, "not allowed to delete service")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteService(ctx, service.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: database.AuditActionServiceDelete,
+		Target: service.Name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.DeleteServiceResponse{}, nil
 }
 
@@ -203,11 +265,32 @@ func (s *Server) IssueServiceAuthToken(ctx context.Context, req *adminv1.IssueSe
 		return nil, status.Error(codes.PermissionDenied, "not allowed to update org")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	token, err := s.admin.IssueServiceAuthToken(ctx, service.ID, nil)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   org.ID,
+		Action:  database.AuditActionServiceTokenIssue,
+		Target:  service.Name,
+		Details: map[string]any{"token_id": token.Token().ID.String()},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.IssueServiceAuthTokenResponse{
 		Token: token.Token().String(),
 	}, nil
@@ -239,11 +322,32 @@ func (s *Server) RevokeServiceAuthToken(ctx context.Context, req *adminv1.Revoke
 		return nil, status.Error(codes.PermissionDenied, "not allowed to revoke auth token")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteServiceAuthToken(ctx, token.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:   org.ID,
+		Action:  database.AuditActionServiceTokenRevoke,
+		Target:  service.Name,
+		Details: map[string]any{"token_id": token.ID},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.RevokeServiceAuthTokenResponse{}, nil
 }
 
@@ -229,7 +229,7 @@ func (s *Service) prepareOrganization(ctx context.Context, orgID, userID string)
 		return nil, err
 	}
 
-	role, err := s.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
+	role, err := s.DB.FindOrganizationRole(ctx, orgID, database.OrganizationRoleNameAdmin)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,7 @@
+package worker
+
+import "context"
+
+func (w *Worker) deleteExpiredPreviewDeployments(ctx context.Context) error {
+	return w.admin.DeleteExpiredPreviewDeployments(ctx)
+}
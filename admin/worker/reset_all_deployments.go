@@ -45,6 +45,11 @@ func (w *Worker) resetAllDeploymentsForProject(ctx context.Context, proj *databa
 	}
 
 	for _, depl := range depls {
+		// Redeploying replaces the prod deployment, so preview deployments are skipped. They're torn down when their pull request is closed or they expire.
+		if depl.PullRequest != nil {
+			continue
+		}
+
 		w.logger.Info("reset all deployments: redeploying deployment", zap.String("deployment_id", depl.ID), observability.ZapCtx(ctx))
 		_, err = w.admin.TriggerRedeploy(ctx, proj, depl)
 		if err != nil {
@@ -70,6 +70,7 @@ func (w *Worker) runAutoscaler(ctx context.Context) error {
 			ProdDeploymentID:     targetProject.ProdDeploymentID,
 			ProdSlots:            rec.RecommendedSlots,
 			ProdTTLSeconds:       targetProject.ProdTTLSeconds,
+			PreviewDeployments:   targetProject.PreviewDeployments,
 			Provisioner:          targetProject.Provisioner,
 			Annotations:          targetProject.Annotations,
 		})
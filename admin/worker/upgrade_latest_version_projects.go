@@ -74,10 +74,11 @@ func (w *Worker) upgradeAllDeploymentsForProject(ctx context.Context, proj *data
 			w.logger.Info("upgrade latest version projects: upgrading deployment", zap.String("deployment_id", depl.ID), zap.String("provision_id", depl.ProvisionID), zap.String("instance_id", depl.RuntimeInstanceID), zap.String("version", latestVersion), observability.ZapCtx(ctx))
 
 			// Update deployment to latest version
+			branch, variables := admin.DeploymentBranchAndVariables(proj, depl)
 			err = w.admin.UpdateDeployment(ctx, depl, &admin.UpdateDeploymentOptions{
 				Version:         latestVersion,
-				Branch:          depl.Branch,
-				Variables:       proj.ProdVariables,
+				Branch:          branch,
+				Variables:       variables,
 				Annotations:     w.admin.NewDeploymentAnnotations(org, proj),
 				EvictCachedRepo: false,
 			})
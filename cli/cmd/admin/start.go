@@ -241,6 +241,7 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 				ProvisionerSetJSON: conf.ProvisionerSetJSON,
 				DefaultProvisioner: conf.DefaultProvisioner,
 				ExternalURL:        conf.ExternalGRPCURL, // NOTE: using gRPC url
+				FrontendURL:        conf.FrontendURL,
 				VersionNumber:      ch.Version.Number,
 				VersionCommit:      ch.Version.Commit,
 				MetricsProjectOrg:  metricsProjectOrg,
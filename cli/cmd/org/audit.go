@@ -0,0 +1,70 @@
+package org
+
+import (
+	"time"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AuditCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, actor, action string
+	var since time.Duration
+	var pageSize uint32
+	var pageToken string
+
+	auditCmd := &cobra.Command{
+		Use:     "audit",
+		Short:   "Show the audit log of changes to members, roles, variables and service accounts",
+		Args:    cobra.NoArgs,
+		PreRunE: cmdutil.CheckOrganization(ch),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			req := &adminv1.ListAuditEventsRequest{
+				Organization: ch.Org,
+				Project:      project,
+				Actor:        actor,
+				Action:       action,
+				PageSize:     pageSize,
+				PageToken:    pageToken,
+			}
+			if since > 0 {
+				req.After = timestamppb.New(time.Now().Add(-since))
+			}
+
+			res, err := client.ListAuditEvents(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if len(res.Events) == 0 {
+				ch.PrintfWarn("No audit events found\n")
+				return nil
+			}
+
+			ch.PrintAuditEvents(res.Events)
+
+			if res.NextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", res.NextPageToken)
+			}
+			return nil
+		},
+	}
+
+	auditCmd.Flags().StringVar(&ch.Org, "org", ch.Org, "Organization")
+	auditCmd.Flags().StringVar(&project, "project", "", "Only show changes to this project")
+	auditCmd.Flags().StringVar(&actor, "actor", "", "Only show changes made by this user email or service name")
+	auditCmd.Flags().StringVar(&action, "action", "", "Only show changes with this action (e.g. project.member.add)")
+	auditCmd.Flags().DurationVar(&since, "since", 0, "Only show changes made within this duration (e.g. 24h)")
+	auditCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of events to return per page")
+	auditCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return auditCmd
+}
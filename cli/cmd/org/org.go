@@ -22,6 +22,7 @@ func OrgCmd(ch *cmdutil.Helper) *cobra.Command {
 	orgCmd.AddCommand(ListCmd(ch))
 	orgCmd.AddCommand(DeleteCmd(ch))
 	orgCmd.AddCommand(RenameCmd(ch))
+	orgCmd.AddCommand(AuditCmd(ch))
 
 	return orgCmd
 }
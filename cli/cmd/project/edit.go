@@ -10,7 +10,7 @@ import (
 
 func EditCmd(ch *cmdutil.Helper) *cobra.Command {
 	var name, description, prodVersion, prodBranch, path, provisioner string
-	var public bool
+	var public, previewDeployments bool
 	var slots int
 	var prodTTL int64
 
@@ -82,6 +82,11 @@ func EditCmd(ch *cmdutil.Helper) *cobra.Command {
 				req.ProdTtlSeconds = &prodTTL
 			}
 
+			if cmd.Flags().Changed("preview-deployments") {
+				promptFlagValues = false
+				req.PreviewDeployments = &previewDeployments
+			}
+
 			if promptFlagValues {
 				resp, err := client.GetProject(ctx, &adminv1.GetProjectRequest{OrganizationName: ch.Org, Name: name})
 				if err != nil {
@@ -127,6 +132,7 @@ func EditCmd(ch *cmdutil.Helper) *cobra.Command {
 	editCmd.Flags().StringVar(&description, "description", "", "Project Description")
 	editCmd.Flags().StringVar(&prodBranch, "prod-branch", "", "Production branch name")
 	editCmd.Flags().BoolVar(&public, "public", false, "Make dashboards publicly accessible")
+	editCmd.Flags().BoolVar(&previewDeployments, "preview-deployments", false, "Deploy a preview of every pull request to the project's Github repository")
 	editCmd.Flags().StringVar(&path, "path", ".", "Project directory")
 	editCmd.Flags().StringVar(&provisioner, "provisioner", "", "Project provisioner (default: current provisioner)")
 	editCmd.Flags().Int64Var(&prodTTL, "prod-ttl-seconds", 0, "Prod deployment TTL in seconds")
@@ -2,6 +2,7 @@ package project
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
@@ -14,7 +15,7 @@ import (
 )
 
 func StatusCmd(ch *cmdutil.Helper) *cobra.Command {
-	var name, path string
+	var name, path, branch string
 
 	statusCmd := &cobra.Command{
 		Use:   "status [<project-name>]",
@@ -40,6 +41,7 @@ func StatusCmd(ch *cmdutil.Helper) *cobra.Command {
 			proj, err := client.GetProject(cmd.Context(), &adminv1.GetProjectRequest{
 				OrganizationName: ch.Org,
 				Name:             name,
+				Branch:           branch,
 			})
 			if err != nil {
 				return err
@@ -51,17 +53,23 @@ func StatusCmd(ch *cmdutil.Helper) *cobra.Command {
 			fmt.Printf("  Organization: %v\n", proj.Project.OrgName)
 			fmt.Printf("  Public: %v\n", proj.Project.Public)
 			fmt.Printf("  Github: %v\n", proj.Project.GithubUrl)
+			fmt.Printf("  Preview deployments: %v\n", proj.Project.PreviewDeployments)
 			fmt.Printf("  Created: %s\n", proj.Project.CreatedOn.AsTime().Local().Format(time.RFC3339))
 			fmt.Printf("  Updated: %s\n", proj.Project.UpdatedOn.AsTime().Local().Format(time.RFC3339))
 
 			depl := proj.ProdDeployment
+			webURL := proj.Project.FrontendUrl
+			if proj.PreviewDeployment != nil {
+				depl = proj.PreviewDeployment
+				webURL = fmt.Sprintf("%s?branch=%s", webURL, url.QueryEscape(depl.Branch))
+			}
 			if depl == nil {
 				return nil
 			}
 
 			// 2. Print deployment info
 			ch.PrintfSuccess("\nDeployment info\n\n")
-			fmt.Printf("  Web: %s\n", proj.Project.FrontendUrl)
+			fmt.Printf("  Web: %s\n", webURL)
 			fmt.Printf("  Runtime: %s\n", depl.RuntimeHost)
 			fmt.Printf("  Instance: %s\n", depl.RuntimeInstanceId)
 			fmt.Printf("  Driver: %s\n", proj.Project.ProdOlapDriver)
@@ -70,6 +78,10 @@ func StatusCmd(ch *cmdutil.Helper) *cobra.Command {
 			}
 			fmt.Printf("  Slots: %d\n", depl.Slots)
 			fmt.Printf("  Branch: %s\n", depl.Branch)
+			if depl.PullRequest != 0 {
+				fmt.Printf("  Pull request: #%d\n", depl.PullRequest)
+				fmt.Printf("  Expires: %s\n", depl.ExpiresOn.AsTime().Local().Format(time.RFC3339))
+			}
 			if proj.Project.Subpath != "" {
 				fmt.Printf("  Subpath: %s\n", proj.Project.Subpath)
 			}
@@ -127,6 +139,7 @@ func StatusCmd(ch *cmdutil.Helper) *cobra.Command {
 
 	statusCmd.Flags().StringVar(&name, "project", "", "Project Name")
 	statusCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	statusCmd.Flags().StringVar(&branch, "branch", "", "Show the preview deployment of a branch (default: production branch)")
 
 	return statusCmd
 }
@@ -0,0 +1,71 @@
+package role
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func CreateCmd(ch *cmdutil.Helper) *cobra.Command {
+	var roleType string
+	var permissions []string
+
+	createCmd := &cobra.Command{
+		Use:   "create <role-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create a custom role",
+		Example: `  rill role create auditor --permissions read_org,read_projects,read_org_members
+  rill role create alerter --type project --permissions read_project,read_prod,create_alerts`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkRoleType(roleType); err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if roleType == roleTypeProject {
+				perms := &adminv1.ProjectPermissions{}
+				if err := setPermissions(perms, permissions); err != nil {
+					return err
+				}
+				res, err := client.CreateProjectRole(cmd.Context(), &adminv1.CreateProjectRoleRequest{
+					Organization: ch.Org,
+					Name:         args[0],
+					Permissions:  perms,
+				})
+				if err != nil {
+					return err
+				}
+				ch.PrintfSuccess("Created project role %q in organization %q\n", res.Role.Name, ch.Org)
+				return nil
+			}
+
+			perms := &adminv1.OrganizationPermissions{}
+			if err := setPermissions(perms, permissions); err != nil {
+				return err
+			}
+			res, err := client.CreateOrganizationRole(cmd.Context(), &adminv1.CreateOrganizationRoleRequest{
+				Organization: ch.Org,
+				Name:         args[0],
+				Permissions:  perms,
+			})
+			if err != nil {
+				return err
+			}
+			ch.PrintfSuccess("Created org role %q in organization %q\n", res.Role.Name, ch.Org)
+
+			return nil
+		},
+	}
+
+	createCmd.Flags().StringVar(&roleType, "type", roleTypeOrg, "Type of role (options: org, project)")
+	createCmd.Flags().StringSliceVar(&permissions, "permissions", nil, fmt.Sprintf("Permissions granted by the role (org options: %s; project options: %s)", strings.Join(permissionNames(&adminv1.OrganizationPermissions{}), ", "), strings.Join(permissionNames(&adminv1.ProjectPermissions{}), ", ")))
+
+	return createCmd
+}
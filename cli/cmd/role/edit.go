@@ -0,0 +1,82 @@
+package role
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func EditCmd(ch *cmdutil.Helper) *cobra.Command {
+	var roleType string
+	var newName string
+	var permissions []string
+
+	editCmd := &cobra.Command{
+		Use:   "edit <role-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Rename a custom role or replace its permissions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkRoleType(roleType); err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			var name *string
+			if cmd.Flags().Changed("new-name") {
+				name = &newName
+			}
+			setPerms := cmd.Flags().Changed("permissions")
+
+			if roleType == roleTypeProject {
+				var perms *adminv1.ProjectPermissions
+				if setPerms {
+					perms = &adminv1.ProjectPermissions{}
+					if err := setPermissions(perms, permissions); err != nil {
+						return err
+					}
+				}
+				res, err := client.UpdateProjectRole(cmd.Context(), &adminv1.UpdateProjectRoleRequest{
+					Organization: ch.Org,
+					Role:         args[0],
+					NewName:      name,
+					Permissions:  perms,
+				})
+				if err != nil {
+					return err
+				}
+				ch.PrintfSuccess("Updated project role %q\n", res.Role.Name)
+				return nil
+			}
+
+			var perms *adminv1.OrganizationPermissions
+			if setPerms {
+				perms = &adminv1.OrganizationPermissions{}
+				if err := setPermissions(perms, permissions); err != nil {
+					return err
+				}
+			}
+			res, err := client.UpdateOrganizationRole(cmd.Context(), &adminv1.UpdateOrganizationRoleRequest{
+				Organization: ch.Org,
+				Role:         args[0],
+				NewName:      name,
+				Permissions:  perms,
+			})
+			if err != nil {
+				return err
+			}
+			ch.PrintfSuccess("Updated org role %q\n", res.Role.Name)
+
+			return nil
+		},
+	}
+
+	editCmd.Flags().StringVar(&roleType, "type", roleTypeOrg, "Type of role (options: org, project)")
+	editCmd.Flags().StringVar(&newName, "new-name", "", "New name of the role")
+	editCmd.Flags().StringSliceVar(&permissions, "permissions", nil, "Permissions granted by the role (replaces the current permissions)")
+
+	return editCmd
+}
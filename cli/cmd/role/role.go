@@ -0,0 +1,64 @@
+package role
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func RoleCmd(ch *cmdutil.Helper) *cobra.Command {
+	roleCmd := &cobra.Command{
+		Use:               "role",
+		Short:             "Manage custom roles",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(ch), cmdutil.CheckOrganization(ch)),
+	}
+
+	roleCmd.PersistentFlags().StringVar(&ch.Org, "org", ch.Org, "Organization")
+
+	roleCmd.AddCommand(ListCmd(ch))
+	roleCmd.AddCommand(CreateCmd(ch))
+	roleCmd.AddCommand(EditCmd(ch))
+	roleCmd.AddCommand(DeleteCmd(ch))
+
+	return roleCmd
+}
+
+// Role types accepted by the --type flag.
+const (
+	roleTypeOrg     = "org"
+	roleTypeProject = "project"
+)
+
+func checkRoleType(roleType string) error {
+	if roleType != roleTypeOrg && roleType != roleTypeProject {
+		return fmt.Errorf("invalid role type %q (options: %s, %s)", roleType, roleTypeOrg, roleTypeProject)
+	}
+	return nil
+}
+
+// permissionNames returns the names of the permission flags of a permissions message (e.g. adminv1.ProjectPermissions).
+func permissionNames(perms proto.Message) []string {
+	fields := perms.ProtoReflect().Descriptor().Fields()
+	names := make([]string, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		names[i] = string(fields.Get(i).Name())
+	}
+	return names
+}
+
+// setPermissions enables the named permission flags on a permissions message.
+func setPermissions(perms proto.Message, names []string) error {
+	msg := perms.ProtoReflect()
+	for _, name := range names {
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(strings.TrimSpace(name)))
+		if fd == nil {
+			return fmt.Errorf("invalid permission %q (options: %s)", name, strings.Join(permissionNames(perms), ", "))
+		}
+		msg.Set(fd, protoreflect.ValueOfBool(true))
+	}
+	return nil
+}
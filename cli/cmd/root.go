@@ -14,6 +14,7 @@ import (
 	"github.com/rilldata/rill/cli/cmd/env"
 	"github.com/rilldata/rill/cli/cmd/org"
 	"github.com/rilldata/rill/cli/cmd/project"
+	"github.com/rilldata/rill/cli/cmd/role"
 	"github.com/rilldata/rill/cli/cmd/runtime"
 	"github.com/rilldata/rill/cli/cmd/service"
 	"github.com/rilldata/rill/cli/cmd/start"
@@ -21,6 +22,7 @@ import (
 	"github.com/rilldata/rill/cli/cmd/uninstall"
 	"github.com/rilldata/rill/cli/cmd/upgrade"
 	"github.com/rilldata/rill/cli/cmd/user"
+	"github.com/rilldata/rill/cli/cmd/usergroup"
 	versioncmd "github.com/rilldata/rill/cli/cmd/version"
 	"github.com/rilldata/rill/cli/cmd/whoami"
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
@@ -147,6 +149,8 @@ func runCmd(ctx context.Context, ver cmdutil.Version) error {
 		org.OrgCmd(ch),
 		project.ProjectCmd(ch),
 		service.ServiceCmd(ch),
+		usergroup.UsergroupCmd(ch),
+		role.RoleCmd(ch),
 		auth.LoginCmd(ch),
 		auth.LogoutCmd(ch),
 		whoami.WhoamiCmd(ch),
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -15,7 +16,6 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/debugserver"
-	"github.com/rilldata/rill/runtime/pkg/email"
 	"github.com/rilldata/rill/runtime/pkg/graceful"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/pkg/ratelimit"
@@ -33,9 +33,11 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/clickhouse"
 	_ "github.com/rilldata/rill/runtime/drivers/druid"
 	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
+	_ "github.com/rilldata/rill/runtime/drivers/email"
 	_ "github.com/rilldata/rill/runtime/drivers/file"
 	_ "github.com/rilldata/rill/runtime/drivers/gcs"
 	_ "github.com/rilldata/rill/runtime/drivers/https"
+	_ "github.com/rilldata/rill/runtime/drivers/kafka"
 	_ "github.com/rilldata/rill/runtime/drivers/mysql"
 	_ "github.com/rilldata/rill/runtime/drivers/pinot"
 	_ "github.com/rilldata/rill/runtime/drivers/postgres"
@@ -45,6 +47,7 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/slack"
 	_ "github.com/rilldata/rill/runtime/drivers/snowflake"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
+	_ "github.com/rilldata/rill/runtime/drivers/webhook"
 	_ "github.com/rilldata/rill/runtime/reconcilers"
 	_ "github.com/rilldata/rill/runtime/resolvers"
 )
@@ -120,25 +123,19 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 				os.Exit(1)
 			}
 
-			// Init email client
-			var sender email.Sender
-			if conf.EmailSMTPHost != "" {
-				sender, err = email.NewSMTPSender(&email.SMTPOptions{
-					SMTPHost:     conf.EmailSMTPHost,
-					SMTPPort:     conf.EmailSMTPPort,
-					SMTPUsername: conf.EmailSMTPUsername,
-					SMTPPassword: conf.EmailSMTPPassword,
-					FromEmail:    conf.EmailSenderEmail,
-					FromName:     conf.EmailSenderName,
-					BCC:          conf.EmailBCC,
-				})
-			} else {
-				sender, err = email.NewConsoleSender(logger, conf.EmailSenderEmail, conf.EmailSenderName)
+			// Configure the runtime's SMTP server as the default for instances' email connectors (see runtime.AcquireHandle).
+			// If no SMTP server is configured, emails are logged.
+			emailConfig := map[string]string{
+				"from_email": conf.EmailSenderEmail,
+				"from_name":  conf.EmailSenderName,
 			}
-			if err != nil {
-				logger.Fatal("error creating email sender", zap.Error(err))
+			if conf.EmailSMTPHost != "" {
+				emailConfig["smtp_host"] = conf.EmailSMTPHost
+				emailConfig["smtp_port"] = strconv.Itoa(conf.EmailSMTPPort)
+				emailConfig["smtp_username"] = conf.EmailSMTPUsername
+				emailConfig["smtp_password"] = conf.EmailSMTPPassword
+				emailConfig["bcc"] = conf.EmailBCC
 			}
-			emailClient := email.New(sender)
 
 			// Parse session keys as hex strings
 			keyPairs := make([][]byte, len(conf.SessionKeyPairs))
@@ -214,9 +211,14 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 						Name:   "metastore",
 						Config: map[string]string{"dsn": conf.MetastoreURL},
 					},
+					{
+						Type:   "email",
+						Name:   "email",
+						Config: emailConfig,
+					},
 				},
 			}
-			rt, err := runtime.New(ctx, opts, logger, activityClient, emailClient)
+			rt, err := runtime.New(ctx, opts, logger, activityClient)
 			if err != nil {
 				logger.Fatal("error: could not create runtime", zap.Error(err))
 			}
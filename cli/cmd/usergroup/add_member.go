@@ -0,0 +1,45 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func AddMemberCmd(ch *cmdutil.Helper) *cobra.Command {
+	var email string
+
+	addMemberCmd := &cobra.Command{
+		Use:   "add-member <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Add an organization member to a user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmdutil.StringPromptIfEmpty(&email, "Enter email")
+			if err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.AddUsergroupMemberUser(cmd.Context(), &adminv1.AddUsergroupMemberUserRequest{
+				Organization: ch.Org,
+				Usergroup:    args[0],
+				Email:        email,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Added user %q to user group %q\n", email, args[0])
+
+			return nil
+		},
+	}
+
+	addMemberCmd.Flags().StringVar(&email, "email", "", "Email of the user")
+
+	return addMemberCmd
+}
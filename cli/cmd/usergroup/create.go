@@ -0,0 +1,35 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func CreateCmd(ch *cmdutil.Helper) *cobra.Command {
+	createCmd := &cobra.Command{
+		Use:   "create <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			res, err := client.CreateUsergroup(cmd.Context(), &adminv1.CreateUsergroupRequest{
+				Organization: ch.Org,
+				Name:         args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Created user group %q in organization %q\n", res.Usergroup.GroupName, ch.Org)
+
+			return nil
+		},
+	}
+
+	return createCmd
+}
@@ -0,0 +1,52 @@
+package usergroup
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func DeleteCmd(ch *cmdutil.Helper) *cobra.Command {
+	var force bool
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delete user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !force {
+				msg := fmt.Sprintf("Deleting user group %q will revoke the org and project roles it grants to its members. Continue?", args[0])
+				ok, err := cmdutil.ConfirmPrompt(msg, "", false)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return nil
+				}
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.DeleteUsergroup(cmd.Context(), &adminv1.DeleteUsergroupRequest{
+				Organization: ch.Org,
+				Usergroup:    args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Deleted user group %q\n", args[0])
+
+			return nil
+		},
+	}
+
+	deleteCmd.Flags().BoolVar(&force, "force", false, "Delete without confirmation prompt")
+
+	return deleteCmd
+}
@@ -0,0 +1,70 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListCmd(ch *cmdutil.Helper) *cobra.Command {
+	var projectName string
+	var pageSize uint32
+	var pageToken string
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List user groups and their roles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			var members []*adminv1.MemberUsergroup
+			var nextPageToken string
+			if projectName != "" {
+				res, err := client.ListProjectMemberUsergroups(cmd.Context(), &adminv1.ListProjectMemberUsergroupsRequest{
+					Organization: ch.Org,
+					Project:      projectName,
+					PageSize:     pageSize,
+					PageToken:    pageToken,
+				})
+				if err != nil {
+					return err
+				}
+				members, nextPageToken = res.Members, res.NextPageToken
+			} else {
+				res, err := client.ListOrganizationMemberUsergroups(cmd.Context(), &adminv1.ListOrganizationMemberUsergroupsRequest{
+					Organization: ch.Org,
+					PageSize:     pageSize,
+					PageToken:    pageToken,
+				})
+				if err != nil {
+					return err
+				}
+				members, nextPageToken = res.Members, res.NextPageToken
+			}
+
+			if len(members) == 0 {
+				ch.PrintfWarn("No user groups found\n")
+				return nil
+			}
+
+			ch.PrintMemberUsergroups(members)
+
+			if nextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", nextPageToken)
+			}
+
+			return nil
+		},
+	}
+
+	listCmd.Flags().StringVar(&projectName, "project", "", "List the user groups with a role in this project")
+	listCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of user groups to return per page")
+	listCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return listCmd
+}
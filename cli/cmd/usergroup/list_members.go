@@ -0,0 +1,53 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListMembersCmd(ch *cmdutil.Helper) *cobra.Command {
+	var pageSize uint32
+	var pageToken string
+
+	listMembersCmd := &cobra.Command{
+		Use:   "list-members <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "List the users in a user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			res, err := client.ListUsergroupMemberUsers(cmd.Context(), &adminv1.ListUsergroupMemberUsersRequest{
+				Organization: ch.Org,
+				Usergroup:    args[0],
+				PageSize:     pageSize,
+				PageToken:    pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Members) == 0 {
+				ch.PrintfWarn("No members found\n")
+				return nil
+			}
+
+			ch.PrintUsergroupMembers(res.Members)
+
+			if res.NextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", res.NextPageToken)
+			}
+
+			return nil
+		},
+	}
+
+	listMembersCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of members to return per page")
+	listMembersCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return listMembersCmd
+}
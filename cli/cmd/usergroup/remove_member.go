@@ -0,0 +1,45 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func RemoveMemberCmd(ch *cmdutil.Helper) *cobra.Command {
+	var email string
+
+	removeMemberCmd := &cobra.Command{
+		Use:   "remove-member <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove a user from a user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmdutil.StringPromptIfEmpty(&email, "Enter email")
+			if err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.RemoveUsergroupMemberUser(cmd.Context(), &adminv1.RemoveUsergroupMemberUserRequest{
+				Organization: ch.Org,
+				Usergroup:    args[0],
+				Email:        email,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Removed user %q from user group %q\n", email, args[0])
+
+			return nil
+		},
+	}
+
+	removeMemberCmd.Flags().StringVar(&email, "email", "", "Email of the user")
+
+	return removeMemberCmd
+}
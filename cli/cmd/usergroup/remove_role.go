@@ -0,0 +1,50 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func RemoveRoleCmd(ch *cmdutil.Helper) *cobra.Command {
+	var projectName string
+
+	removeRoleCmd := &cobra.Command{
+		Use:   "remove-role <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Revoke the role of a user group in the organization or a project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if projectName != "" {
+				_, err = client.RemoveProjectMemberUsergroup(cmd.Context(), &adminv1.RemoveProjectMemberUsergroupRequest{
+					Organization: ch.Org,
+					Project:      projectName,
+					Usergroup:    args[0],
+				})
+				if err != nil {
+					return err
+				}
+				ch.PrintfSuccess("Removed role of user group %q in the project \"%s/%s\"\n", args[0], ch.Org, projectName)
+			} else {
+				_, err = client.RemoveOrganizationMemberUsergroup(cmd.Context(), &adminv1.RemoveOrganizationMemberUsergroupRequest{
+					Organization: ch.Org,
+					Usergroup:    args[0],
+				})
+				if err != nil {
+					return err
+				}
+				ch.PrintfSuccess("Removed role of user group %q in the organization %q\n", args[0], ch.Org)
+			}
+
+			return nil
+		},
+	}
+
+	removeRoleCmd.Flags().StringVar(&projectName, "project", "", "Project")
+
+	return removeRoleCmd
+}
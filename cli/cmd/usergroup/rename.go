@@ -0,0 +1,45 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func RenameCmd(ch *cmdutil.Helper) *cobra.Command {
+	var newName string
+
+	renameCmd := &cobra.Command{
+		Use:   "rename <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Rename user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmdutil.StringPromptIfEmpty(&newName, "Enter new name")
+			if err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			res, err := client.RenameUsergroup(cmd.Context(), &adminv1.RenameUsergroupRequest{
+				Organization: ch.Org,
+				Usergroup:    args[0],
+				Name:         newName,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Renamed user group %q to %q\n", args[0], res.Usergroup.GroupName)
+
+			return nil
+		},
+	}
+
+	renameCmd.Flags().StringVar(&newName, "new-name", "", "New name of the user group")
+
+	return renameCmd
+}
@@ -0,0 +1,80 @@
+package usergroup
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func SetRoleCmd(ch *cmdutil.Helper) *cobra.Command {
+	var projectName string
+	var role string
+
+	setRoleCmd := &cobra.Command{
+		Use:   "set-role <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Grant a user group a role in the organization or a project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmdutil.SelectPromptIfEmpty(&role, "Select role", groupRoles, "")
+			if err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			// Add the role, or update it if the group already has a role.
+			if projectName != "" {
+				_, err = client.AddProjectMemberUsergroup(cmd.Context(), &adminv1.AddProjectMemberUsergroupRequest{
+					Organization: ch.Org,
+					Project:      projectName,
+					Usergroup:    args[0],
+					Role:         role,
+				})
+				if status.Code(err) == codes.AlreadyExists {
+					_, err = client.SetProjectMemberUsergroupRole(cmd.Context(), &adminv1.SetProjectMemberUsergroupRoleRequest{
+						Organization: ch.Org,
+						Project:      projectName,
+						Usergroup:    args[0],
+						Role:         role,
+					})
+				}
+				if err != nil {
+					return err
+				}
+				ch.PrintfSuccess("Set role of user group %q to %q in the project \"%s/%s\"\n", args[0], role, ch.Org, projectName)
+			} else {
+				_, err = client.AddOrganizationMemberUsergroup(cmd.Context(), &adminv1.AddOrganizationMemberUsergroupRequest{
+					Organization: ch.Org,
+					Usergroup:    args[0],
+					Role:         role,
+				})
+				if status.Code(err) == codes.AlreadyExists {
+					_, err = client.SetOrganizationMemberUsergroupRole(cmd.Context(), &adminv1.SetOrganizationMemberUsergroupRoleRequest{
+						Organization: ch.Org,
+						Usergroup:    args[0],
+						Role:         role,
+					})
+				}
+				if err != nil {
+					return err
+				}
+				ch.PrintfSuccess("Set role of user group %q to %q in the organization %q\n", args[0], role, ch.Org)
+			}
+
+			return nil
+		},
+	}
+
+	setRoleCmd.Flags().StringVar(&projectName, "project", "", "Project")
+	setRoleCmd.Flags().StringVar(&role, "role", "", fmt.Sprintf("Role of the user group (options: %s)", strings.Join(groupRoles, ", ")))
+
+	return setRoleCmd
+}
@@ -24,7 +24,6 @@ import (
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/debugserver"
-	"github.com/rilldata/rill/runtime/pkg/email"
 	"github.com/rilldata/rill/runtime/pkg/graceful"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/pkg/ratelimit"
@@ -139,31 +138,7 @@ func NewApp(ctx context.Context, opts *AppOptions) (*App, error) {
 		},
 	}
 
-	// Sender for sending transactional emails.
-	// We use a noop sender by default, but you can uncomment the SMTP sender to send emails from localhost for testing.
-	sender := email.NewNoopSender()
-	// Uncomment to send emails for testing:
-	// err = godotenv.Load()
-	// if err != nil {
-	// 	return nil, fmt.Errorf("failed to load .env file: %w", err)
-	// }
-	// smtpPort, err := strconv.Atoi(os.Getenv("RILL_RUNTIME_EMAIL_SMTP_PORT"))
-	// if err != nil {
-	// 	return nil, fmt.Errorf("failed to get SMTP port: %w", err)
-	// }
-	// sender, err := email.NewSMTPSender(&email.SMTPOptions{
-	// 	SMTPHost:     os.Getenv("RILL_RUNTIME_EMAIL_SMTP_HOST"),
-	// 	SMTPPort:     smtpPort,
-	// 	SMTPUsername: os.Getenv("RILL_RUNTIME_EMAIL_SMTP_USERNAME"),
-	// 	SMTPPassword: os.Getenv("RILL_RUNTIME_EMAIL_SMTP_PASSWORD"),
-	// 	FromEmail:    os.Getenv("RILL_RUNTIME_EMAIL_SENDER_EMAIL"),
-	// 	FromName:     os.Getenv("RILL_RUNTIME_EMAIL_SENDER_NAME"),
-	// 	BCC:          os.Getenv("RILL_RUNTIME_EMAIL_BCC"),
-	// })
-	// if err != nil {
-	// 	return nil, fmt.Errorf("failed to create email sender: %w", err)
-	// }
-
+	// Emails of alerts and reports are logged, unless the project configures SMTP for the email connector (see the email driver).
 	rtOpts := &runtime.Options{
 		ConnectionCacheSize:          100,
 		MetastoreConnector:           "metastore",
@@ -175,7 +150,7 @@ func NewApp(ctx context.Context, opts *AppOptions) (*App, error) {
 		ControllerLogBufferCapacity:  10000,
 		ControllerLogBufferSizeBytes: int64(datasize.MB * 16),
 	}
-	rt, err := runtime.New(ctx, rtOpts, logger, opts.Activity, email.New(sender))
+	rt, err := runtime.New(ctx, rtOpts, logger, opts.Activity)
 	if err != nil {
 		return nil, err
 	}
@@ -43,6 +43,7 @@ func AdminService(ctx context.Context, logger *zap.Logger, databaseURL string) (
 		ProvisionerSetJSON: provisionerSetJSON,
 		DefaultProvisioner: "static",
 		ExternalURL:        "http://localhost:9090",
+		FrontendURL:        "http://localhost:3000",
 		VersionNumber:      "",
 		VersionCommit:      "",
 	}
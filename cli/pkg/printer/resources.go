@@ -1,11 +1,13 @@
 package printer
 
 import (
+	"encoding/json"
 	"path/filepath"
 	"strings"
 	"time"
 
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 func (p *Printer) PrintOrgs(orgs []*adminv1.Organization, defaultOrg string) {
@@ -150,6 +152,121 @@ type member struct {
 	UpdatedOn string `header:"updated_on,timestamp(ms|utc|human)" json:"updated_on"`
 }
 
+func (p *Printer) PrintMemberUsergroups(members []*adminv1.MemberUsergroup) {
+	if len(members) == 0 {
+		return
+	}
+
+	p.PrintData(toMemberUsergroupsTable(members))
+}
+
+func toMemberUsergroupsTable(members []*adminv1.MemberUsergroup) []*memberUsergroup {
+	allMembers := make([]*memberUsergroup, 0, len(members))
+
+	for _, m := range members {
+		allMembers = append(allMembers, toMemberUsergroupRow(m))
+	}
+
+	return allMembers
+}
+
+func toMemberUsergroupRow(m *adminv1.MemberUsergroup) *memberUsergroup {
+	return &memberUsergroup{
+		Name:      m.GroupName,
+		RoleName:  m.RoleName,
+		Managed:   m.Managed,
+		CreatedOn: m.CreatedOn.AsTime().Format(time.DateTime),
+	}
+}
+
+type memberUsergroup struct {
+	Name      string `header:"name" json:"name"`
+	RoleName  string `header:"role" json:"role_name"`
+	Managed   bool   `header:"managed" json:"managed"`
+	CreatedOn string `header:"created_on,timestamp(ms|utc|human)" json:"created_on"`
+}
+
+func (p *Printer) PrintUsergroupMembers(members []*adminv1.MemberUser) {
+	if len(members) == 0 {
+		return
+	}
+
+	p.PrintData(toUsergroupMembersTable(members))
+}
+
+func toUsergroupMembersTable(members []*adminv1.MemberUser) []*usergroupMember {
+	allMembers := make([]*usergroupMember, 0, len(members))
+
+	for _, m := range members {
+		allMembers = append(allMembers, &usergroupMember{
+			Email:     m.UserEmail,
+			Name:      m.UserName,
+			CreatedOn: m.CreatedOn.AsTime().Format(time.DateTime),
+		})
+	}
+
+	return allMembers
+}
+
+type usergroupMember struct {
+	Email     string `header:"email" json:"email"`
+	Name      string `header:"name" json:"display_name"`
+	CreatedOn string `header:"added_on,timestamp(ms|utc|human)" json:"added_on"`
+}
+
+func (p *Printer) PrintOrganizationRoles(roles []*adminv1.OrganizationRole) {
+	if len(roles) == 0 {
+		return
+	}
+
+	rows := make([]*role, 0, len(roles))
+	for _, r := range roles {
+		rows = append(rows, &role{
+			Name:        r.Name,
+			Builtin:     r.Builtin,
+			Permissions: strings.Join(enabledPermissions(r.Permissions), ", "),
+		})
+	}
+
+	p.PrintData(rows)
+}
+
+func (p *Printer) PrintProjectRoles(roles []*adminv1.ProjectRole) {
+	if len(roles) == 0 {
+		return
+	}
+
+	rows := make([]*role, 0, len(roles))
+	for _, r := range roles {
+		rows = append(rows, &role{
+			Name:        r.Name,
+			Builtin:     r.Builtin,
+			Permissions: strings.Join(enabledPermissions(r.Permissions), ", "),
+		})
+	}
+
+	p.PrintData(rows)
+}
+
+// enabledPermissions returns the names of the permission flags that are set in a permissions message.
+func enabledPermissions(perms proto.Message) []string {
+	msg := perms.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	var res []string
+	for i := 0; i < fields.Len(); i++ {
+		if msg.Get(fields.Get(i)).Bool() {
+			res = append(res, string(fields.Get(i).Name()))
+		}
+	}
+	return res
+}
+
+type role struct {
+	Name        string `header:"name" json:"name"`
+	Builtin     bool   `header:"builtin" json:"builtin"`
+	Permissions string `header:"permissions" json:"permissions"`
+}
+
 func (p *Printer) PrintInvites(invites []*adminv1.UserInvite) {
 	if len(invites) == 0 {
 		return
@@ -246,3 +363,56 @@ type token struct {
 	CreatedOn string `header:"created_on,timestamp(ms|utc|human)" json:"created_on"`
 	ExpiresOn string `header:"expires_on,timestamp(ms|utc|human)" json:"expires_on"`
 }
+
+func (p *Printer) PrintAuditEvents(events []*adminv1.AuditEvent) {
+	if len(events) == 0 {
+		return
+	}
+	p.PrintData(toAuditEventsTable(events))
+}
+
+func toAuditEventsTable(evts []*adminv1.AuditEvent) []*auditEvent {
+	events := make([]*auditEvent, 0, len(evts))
+
+	for _, e := range evts {
+		events = append(events, toAuditEventRow(e))
+	}
+
+	return events
+}
+
+func toAuditEventRow(e *adminv1.AuditEvent) *auditEvent {
+	actor := e.ActorName
+	if actor == "" {
+		actor = e.ActorType
+	}
+	if e.ActorSuperuser {
+		actor += " (superuser)"
+	}
+
+	var details string
+	if len(e.Details.GetFields()) > 0 {
+		data, err := json.Marshal(e.Details.AsMap())
+		if err == nil {
+			details = string(data)
+		}
+	}
+
+	return &auditEvent{
+		CreatedOn: e.CreatedOn.AsTime().Format(time.DateTime),
+		Actor:     actor,
+		Action:    e.Action,
+		Project:   e.ProjectName,
+		Target:    e.Target,
+		Details:   details,
+	}
+}
+
+type auditEvent struct {
+	CreatedOn string `header:"created_on,timestamp(ms|utc|human)" json:"created_on"`
+	Actor     string `header:"actor" json:"actor"`
+	Action    string `header:"action" json:"action"`
+	Project   string `header:"project" json:"project"`
+	Target    string `header:"target" json:"target"`
+	Details   string `header:"details" json:"details"`
+}
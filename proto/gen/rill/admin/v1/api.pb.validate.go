@@ -1795,6 +1795,8 @@ func (m *GetProjectRequest) validate(all bool) error {
 
 	// no validation rules for AccessTokenTtlSeconds
 
+	// no validation rules for Branch
+
 	if len(errors) > 0 {
 		return GetProjectRequestMultiError(errors)
 	}
@@ -1955,6 +1957,35 @@ func (m *GetProjectResponse) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetPreviewDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProjectResponseValidationError{
+					field:  "PreviewDeployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProjectResponseValidationError{
+					field:  "PreviewDeployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreviewDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProjectResponseValidationError{
+				field:  "PreviewDeployment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	// no validation rules for Jwt
 
 	if all {
@@ -5070,6 +5101,10 @@ func (m *UpdateProjectRequest) validate(all bool) error {
 		// no validation rules for ProdVersion
 	}
 
+	if m.PreviewDeployments != nil {
+		// no validation rules for PreviewDeployments
+	}
+
 	if len(errors) > 0 {
 		return UpdateProjectRequestMultiError(errors)
 	}
@@ -10929,42 +10964,64 @@ var _ interface {
 	ErrorName() string
 } = SetProjectMemberRoleResponseValidationError{}
 
-// Validate checks the field values on GetCurrentUserRequest with the rules
+// Validate checks the field values on CreateUsergroupRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCurrentUserRequest) Validate() error {
+func (m *CreateUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCurrentUserRequest with the rules
+// ValidateAll checks the field values on CreateUsergroupRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCurrentUserRequestMultiError, or nil if none found.
-func (m *GetCurrentUserRequest) ValidateAll() error {
+// CreateUsergroupRequestMultiError, or nil if none found.
+func (m *CreateUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCurrentUserRequest) validate(all bool) error {
+func (m *CreateUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateUsergroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetCurrentUserRequestMultiError(errors)
+		return CreateUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCurrentUserRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCurrentUserRequest.ValidateAll() if the designated
+// CreateUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateUsergroupRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetCurrentUserRequestMultiError []error
+type CreateUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCurrentUserRequestMultiError) Error() string {
+func (m CreateUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10973,11 +11030,11 @@ func (m GetCurrentUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCurrentUserRequestMultiError) AllErrors() []error { return m }
+func (m CreateUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// GetCurrentUserRequestValidationError is the validation error returned by
-// GetCurrentUserRequest.Validate if the designated constraints aren't met.
-type GetCurrentUserRequestValidationError struct {
+// CreateUsergroupRequestValidationError is the validation error returned by
+// CreateUsergroupRequest.Validate if the designated constraints aren't met.
+type CreateUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10985,24 +11042,24 @@ type GetCurrentUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCurrentUserRequestValidationError) Field() string { return e.field }
+func (e CreateUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCurrentUserRequestValidationError) Reason() string { return e.reason }
+func (e CreateUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCurrentUserRequestValidationError) Cause() error { return e.cause }
+func (e CreateUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCurrentUserRequestValidationError) Key() bool { return e.key }
+func (e CreateUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCurrentUserRequestValidationError) ErrorName() string {
-	return "GetCurrentUserRequestValidationError"
+func (e CreateUsergroupRequestValidationError) ErrorName() string {
+	return "CreateUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCurrentUserRequestValidationError) Error() string {
+func (e CreateUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11014,14 +11071,14 @@ func (e GetCurrentUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCurrentUserRequest.%s: %s%s",
+		"invalid %sCreateUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCurrentUserRequestValidationError{}
+var _ error = CreateUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11029,24 +11086,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCurrentUserRequestValidationError{}
+} = CreateUsergroupRequestValidationError{}
 
-// Validate checks the field values on GetCurrentUserResponse with the rules
+// Validate checks the field values on CreateUsergroupResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCurrentUserResponse) Validate() error {
+func (m *CreateUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCurrentUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateUsergroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCurrentUserResponseMultiError, or nil if none found.
-func (m *GetCurrentUserResponse) ValidateAll() error {
+// CreateUsergroupResponseMultiError, or nil if none found.
+func (m *CreateUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCurrentUserResponse) validate(all bool) error {
+func (m *CreateUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11054,57 +11111,28 @@ func (m *GetCurrentUserResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCurrentUserResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
+		switch v := interface{}(m.GetUsergroup()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "Preferences",
+				errors = append(errors, CreateUsergroupResponseValidationError{
+					field:  "Usergroup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "Preferences",
+				errors = append(errors, CreateUsergroupResponseValidationError{
+					field:  "Usergroup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUsergroup()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetCurrentUserResponseValidationError{
-				field:  "Preferences",
+			return CreateUsergroupResponseValidationError{
+				field:  "Usergroup",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -11112,19 +11140,19 @@ func (m *GetCurrentUserResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetCurrentUserResponseMultiError(errors)
+		return CreateUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCurrentUserResponseMultiError is an error wrapping multiple validation
-// errors returned by GetCurrentUserResponse.ValidateAll() if the designated
+// CreateUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateUsergroupResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetCurrentUserResponseMultiError []error
+type CreateUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCurrentUserResponseMultiError) Error() string {
+func (m CreateUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11133,11 +11161,11 @@ func (m GetCurrentUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCurrentUserResponseMultiError) AllErrors() []error { return m }
+func (m CreateUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// GetCurrentUserResponseValidationError is the validation error returned by
-// GetCurrentUserResponse.Validate if the designated constraints aren't met.
-type GetCurrentUserResponseValidationError struct {
+// CreateUsergroupResponseValidationError is the validation error returned by
+// CreateUsergroupResponse.Validate if the designated constraints aren't met.
+type CreateUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11145,24 +11173,24 @@ type GetCurrentUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCurrentUserResponseValidationError) Field() string { return e.field }
+func (e CreateUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCurrentUserResponseValidationError) Reason() string { return e.reason }
+func (e CreateUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCurrentUserResponseValidationError) Cause() error { return e.cause }
+func (e CreateUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCurrentUserResponseValidationError) Key() bool { return e.key }
+func (e CreateUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCurrentUserResponseValidationError) ErrorName() string {
-	return "GetCurrentUserResponseValidationError"
+func (e CreateUsergroupResponseValidationError) ErrorName() string {
+	return "CreateUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCurrentUserResponseValidationError) Error() string {
+func (e CreateUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11174,14 +11202,14 @@ func (e GetCurrentUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCurrentUserResponse.%s: %s%s",
+		"invalid %sCreateUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCurrentUserResponseValidationError{}
+var _ error = CreateUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11189,35 +11217,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCurrentUserResponseValidationError{}
+} = CreateUsergroupResponseValidationError{}
 
-// Validate checks the field values on GetUserRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetUserRequest) Validate() error {
+// Validate checks the field values on GetUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetUserRequestMultiError,
-// or nil if none found.
-func (m *GetUserRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsergroupRequestMultiError, or nil if none found.
+func (m *GetUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserRequest) validate(all bool) error {
+func (m *GetUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = GetUserRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := GetUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -11225,70 +11252,31 @@ func (m *GetUserRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return GetUserRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *GetUserRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := GetUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
 		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
-
-func (m *GetUserRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return GetUsergroupRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// GetUserRequestMultiError is an error wrapping multiple validation errors
-// returned by GetUserRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserRequestMultiError []error
+// GetUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserRequestMultiError) Error() string {
+func (m GetUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11297,11 +11285,11 @@ func (m GetUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserRequestMultiError) AllErrors() []error { return m }
+func (m GetUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// GetUserRequestValidationError is the validation error returned by
-// GetUserRequest.Validate if the designated constraints aren't met.
-type GetUserRequestValidationError struct {
+// GetUsergroupRequestValidationError is the validation error returned by
+// GetUsergroupRequest.Validate if the designated constraints aren't met.
+type GetUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11309,22 +11297,24 @@ type GetUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserRequestValidationError) Field() string { return e.field }
+func (e GetUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserRequestValidationError) Reason() string { return e.reason }
+func (e GetUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserRequestValidationError) Cause() error { return e.cause }
+func (e GetUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserRequestValidationError) Key() bool { return e.key }
+func (e GetUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
+func (e GetUsergroupRequestValidationError) ErrorName() string {
+	return "GetUsergroupRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUserRequestValidationError) Error() string {
+func (e GetUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11336,14 +11326,14 @@ func (e GetUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserRequest.%s: %s%s",
+		"invalid %sGetUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserRequestValidationError{}
+var _ error = GetUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11351,24 +11341,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserRequestValidationError{}
+} = GetUsergroupRequestValidationError{}
 
-// Validate checks the field values on GetUserResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetUserResponse) Validate() error {
+// Validate checks the field values on GetUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserResponse with the rules
+// ValidateAll checks the field values on GetUsergroupResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserResponseMultiError, or nil if none found.
-func (m *GetUserResponse) ValidateAll() error {
+// GetUsergroupResponseMultiError, or nil if none found.
+func (m *GetUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserResponse) validate(all bool) error {
+func (m *GetUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11376,28 +11366,28 @@ func (m *GetUserResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetUser()).(type) {
+		switch v := interface{}(m.GetUsergroup()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
+				errors = append(errors, GetUsergroupResponseValidationError{
+					field:  "Usergroup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
+				errors = append(errors, GetUsergroupResponseValidationError{
+					field:  "Usergroup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUsergroup()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetUserResponseValidationError{
-				field:  "User",
+			return GetUsergroupResponseValidationError{
+				field:  "Usergroup",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -11405,19 +11395,19 @@ func (m *GetUserResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetUserResponseMultiError(errors)
+		return GetUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserResponseMultiError is an error wrapping multiple validation errors
-// returned by GetUserResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserResponseMultiError []error
+// GetUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserResponseMultiError) Error() string {
+func (m GetUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11426,11 +11416,11 @@ func (m GetUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserResponseMultiError) AllErrors() []error { return m }
+func (m GetUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// GetUserResponseValidationError is the validation error returned by
-// GetUserResponse.Validate if the designated constraints aren't met.
-type GetUserResponseValidationError struct {
+// GetUsergroupResponseValidationError is the validation error returned by
+// GetUsergroupResponse.Validate if the designated constraints aren't met.
+type GetUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11438,22 +11428,24 @@ type GetUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserResponseValidationError) Field() string { return e.field }
+func (e GetUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserResponseValidationError) Reason() string { return e.reason }
+func (e GetUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserResponseValidationError) Cause() error { return e.cause }
+func (e GetUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserResponseValidationError) Key() bool { return e.key }
+func (e GetUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
+func (e GetUsergroupResponseValidationError) ErrorName() string {
+	return "GetUsergroupResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUserResponseValidationError) Error() string {
+func (e GetUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11465,14 +11457,14 @@ func (e GetUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserResponse.%s: %s%s",
+		"invalid %sGetUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserResponseValidationError{}
+var _ error = GetUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11480,48 +11472,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserResponseValidationError{}
+} = GetUsergroupResponseValidationError{}
 
-// Validate checks the field values on UserPreferences with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UserPreferences) Validate() error {
+// Validate checks the field values on RenameUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserPreferences with the rules
+// ValidateAll checks the field values on RenameUsergroupRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserPreferencesMultiError, or nil if none found.
-func (m *UserPreferences) ValidateAll() error {
+// RenameUsergroupRequestMultiError, or nil if none found.
+func (m *RenameUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserPreferences) validate(all bool) error {
+func (m *RenameUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.TimeZone != nil {
-		// no validation rules for TimeZone
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RenameUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RenameUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := RenameUsergroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return UserPreferencesMultiError(errors)
+		return RenameUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserPreferencesMultiError is an error wrapping multiple validation errors
-// returned by UserPreferences.ValidateAll() if the designated constraints
-// aren't met.
-type UserPreferencesMultiError []error
+// RenameUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by RenameUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenameUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserPreferencesMultiError) Error() string {
+func (m RenameUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11530,11 +11551,11 @@ func (m UserPreferencesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserPreferencesMultiError) AllErrors() []error { return m }
+func (m RenameUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// UserPreferencesValidationError is the validation error returned by
-// UserPreferences.Validate if the designated constraints aren't met.
-type UserPreferencesValidationError struct {
+// RenameUsergroupRequestValidationError is the validation error returned by
+// RenameUsergroupRequest.Validate if the designated constraints aren't met.
+type RenameUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11542,22 +11563,24 @@ type UserPreferencesValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserPreferencesValidationError) Field() string { return e.field }
+func (e RenameUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserPreferencesValidationError) Reason() string { return e.reason }
+func (e RenameUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserPreferencesValidationError) Cause() error { return e.cause }
+func (e RenameUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserPreferencesValidationError) Key() bool { return e.key }
+func (e RenameUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserPreferencesValidationError) ErrorName() string { return "UserPreferencesValidationError" }
+func (e RenameUsergroupRequestValidationError) ErrorName() string {
+	return "RenameUsergroupRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserPreferencesValidationError) Error() string {
+func (e RenameUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11569,14 +11592,14 @@ func (e UserPreferencesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserPreferences.%s: %s%s",
+		"invalid %sRenameUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserPreferencesValidationError{}
+var _ error = RenameUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11584,24 +11607,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserPreferencesValidationError{}
+} = RenameUsergroupRequestValidationError{}
 
-// Validate checks the field values on UpdateUserPreferencesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RenameUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserPreferencesRequest) Validate() error {
+func (m *RenameUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserPreferencesRequest with the
+// ValidateAll checks the field values on RenameUsergroupResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateUserPreferencesRequestMultiError, or nil if none found.
-func (m *UpdateUserPreferencesRequest) ValidateAll() error {
+// RenameUsergroupResponseMultiError, or nil if none found.
+func (m *RenameUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserPreferencesRequest) validate(all bool) error {
+func (m *RenameUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11609,28 +11632,28 @@ func (m *UpdateUserPreferencesRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
+		switch v := interface{}(m.GetUsergroup()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUserPreferencesRequestValidationError{
-					field:  "Preferences",
+				errors = append(errors, RenameUsergroupResponseValidationError{
+					field:  "Usergroup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUserPreferencesRequestValidationError{
-					field:  "Preferences",
+				errors = append(errors, RenameUsergroupResponseValidationError{
+					field:  "Usergroup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUsergroup()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateUserPreferencesRequestValidationError{
-				field:  "Preferences",
+			return RenameUsergroupResponseValidationError{
+				field:  "Usergroup",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -11638,19 +11661,19 @@ func (m *UpdateUserPreferencesRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateUserPreferencesRequestMultiError(errors)
+		return RenameUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserPreferencesRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateUserPreferencesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateUserPreferencesRequestMultiError []error
+// RenameUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by RenameUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RenameUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserPreferencesRequestMultiError) Error() string {
+func (m RenameUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11659,12 +11682,11 @@ func (m UpdateUserPreferencesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserPreferencesRequestMultiError) AllErrors() []error { return m }
+func (m RenameUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// UpdateUserPreferencesRequestValidationError is the validation error returned
-// by UpdateUserPreferencesRequest.Validate if the designated constraints
-// aren't met.
-type UpdateUserPreferencesRequestValidationError struct {
+// RenameUsergroupResponseValidationError is the validation error returned by
+// RenameUsergroupResponse.Validate if the designated constraints aren't met.
+type RenameUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11672,24 +11694,24 @@ type UpdateUserPreferencesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserPreferencesRequestValidationError) Field() string { return e.field }
+func (e RenameUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserPreferencesRequestValidationError) Reason() string { return e.reason }
+func (e RenameUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserPreferencesRequestValidationError) Cause() error { return e.cause }
+func (e RenameUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserPreferencesRequestValidationError) Key() bool { return e.key }
+func (e RenameUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserPreferencesRequestValidationError) ErrorName() string {
-	return "UpdateUserPreferencesRequestValidationError"
+func (e RenameUsergroupResponseValidationError) ErrorName() string {
+	return "RenameUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserPreferencesRequestValidationError) Error() string {
+func (e RenameUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11701,14 +11723,14 @@ func (e UpdateUserPreferencesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserPreferencesRequest.%s: %s%s",
+		"invalid %sRenameUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserPreferencesRequestValidationError{}
+var _ error = RenameUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11716,73 +11738,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserPreferencesRequestValidationError{}
+} = RenameUsergroupResponseValidationError{}
 
-// Validate checks the field values on UpdateUserPreferencesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserPreferencesResponse) Validate() error {
+func (m *DeleteUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserPreferencesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpdateUserPreferencesResponseMultiError, or nil if none found.
-func (m *UpdateUserPreferencesResponse) ValidateAll() error {
+// ValidateAll checks the field values on DeleteUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUsergroupRequestMultiError, or nil if none found.
+func (m *DeleteUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserPreferencesResponse) validate(all bool) error {
+func (m *DeleteUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUserPreferencesResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUserPreferencesResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := DeleteUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateUserPreferencesResponseValidationError{
-				field:  "Preferences",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := DeleteUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return UpdateUserPreferencesResponseMultiError(errors)
+		return DeleteUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserPreferencesResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateUserPreferencesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type UpdateUserPreferencesResponseMultiError []error
+// DeleteUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserPreferencesResponseMultiError) Error() string {
+func (m DeleteUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11791,12 +11806,11 @@ func (m UpdateUserPreferencesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserPreferencesResponseMultiError) AllErrors() []error { return m }
+func (m DeleteUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// UpdateUserPreferencesResponseValidationError is the validation error
-// returned by UpdateUserPreferencesResponse.Validate if the designated
-// constraints aren't met.
-type UpdateUserPreferencesResponseValidationError struct {
+// DeleteUsergroupRequestValidationError is the validation error returned by
+// DeleteUsergroupRequest.Validate if the designated constraints aren't met.
+type DeleteUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11804,24 +11818,24 @@ type UpdateUserPreferencesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserPreferencesResponseValidationError) Field() string { return e.field }
+func (e DeleteUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserPreferencesResponseValidationError) Reason() string { return e.reason }
+func (e DeleteUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserPreferencesResponseValidationError) Cause() error { return e.cause }
+func (e DeleteUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserPreferencesResponseValidationError) Key() bool { return e.key }
+func (e DeleteUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserPreferencesResponseValidationError) ErrorName() string {
-	return "UpdateUserPreferencesResponseValidationError"
+func (e DeleteUsergroupRequestValidationError) ErrorName() string {
+	return "DeleteUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserPreferencesResponseValidationError) Error() string {
+func (e DeleteUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11833,14 +11847,14 @@ func (e UpdateUserPreferencesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserPreferencesResponse.%s: %s%s",
+		"invalid %sDeleteUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserPreferencesResponseValidationError{}
+var _ error = DeleteUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11848,50 +11862,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserPreferencesResponseValidationError{}
+} = DeleteUsergroupRequestValidationError{}
 
-// Validate checks the field values on ListBookmarksRequest with the rules
+// Validate checks the field values on DeleteUsergroupResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBookmarksRequest) Validate() error {
+func (m *DeleteUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBookmarksRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteUsergroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBookmarksRequestMultiError, or nil if none found.
-func (m *ListBookmarksRequest) ValidateAll() error {
+// DeleteUsergroupResponseMultiError, or nil if none found.
+func (m *DeleteUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBookmarksRequest) validate(all bool) error {
+func (m *DeleteUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
-
-	// no validation rules for ResourceKind
-
-	// no validation rules for ResourceName
-
 	if len(errors) > 0 {
-		return ListBookmarksRequestMultiError(errors)
+		return DeleteUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksRequestMultiError is an error wrapping multiple validation
-// errors returned by ListBookmarksRequest.ValidateAll() if the designated
+// DeleteUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteUsergroupResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListBookmarksRequestMultiError []error
+type DeleteUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksRequestMultiError) Error() string {
+func (m DeleteUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11900,11 +11908,11 @@ func (m ListBookmarksRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
+func (m DeleteUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// ListBookmarksRequestValidationError is the validation error returned by
-// ListBookmarksRequest.Validate if the designated constraints aren't met.
-type ListBookmarksRequestValidationError struct {
+// DeleteUsergroupResponseValidationError is the validation error returned by
+// DeleteUsergroupResponse.Validate if the designated constraints aren't met.
+type DeleteUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11912,24 +11920,24 @@ type ListBookmarksRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksRequestValidationError) Field() string { return e.field }
+func (e DeleteUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
+func (e DeleteUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
+func (e DeleteUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
+func (e DeleteUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksRequestValidationError) ErrorName() string {
-	return "ListBookmarksRequestValidationError"
+func (e DeleteUsergroupResponseValidationError) ErrorName() string {
+	return "DeleteUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksRequestValidationError) Error() string {
+func (e DeleteUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11941,14 +11949,14 @@ func (e ListBookmarksRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksRequest.%s: %s%s",
+		"invalid %sDeleteUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksRequestValidationError{}
+var _ error = DeleteUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11956,78 +11964,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksRequestValidationError{}
+} = DeleteUsergroupResponseValidationError{}
 
-// Validate checks the field values on ListBookmarksResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBookmarksResponse) Validate() error {
+// Validate checks the field values on ListOrganizationMemberUsergroupsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListOrganizationMemberUsergroupsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBookmarksResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListBookmarksResponseMultiError, or nil if none found.
-func (m *ListBookmarksResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// ListOrganizationMemberUsergroupsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// ListOrganizationMemberUsergroupsRequestMultiError, or nil if none found.
+func (m *ListOrganizationMemberUsergroupsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBookmarksResponse) validate(all bool) error {
+func (m *ListOrganizationMemberUsergroupsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetBookmarks() {
-		_, _ = idx, item
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListOrganizationMemberUsergroupsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBookmarksResponseValidationError{
-						field:  fmt.Sprintf("Bookmarks[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBookmarksResponseValidationError{
-						field:  fmt.Sprintf("Bookmarks[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListOrganizationMemberUsergroupsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListBookmarksResponseValidationError{
-					field:  fmt.Sprintf("Bookmarks[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
 
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return ListBookmarksResponseMultiError(errors)
+		return ListOrganizationMemberUsergroupsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksResponseMultiError is an error wrapping multiple validation
-// errors returned by ListBookmarksResponse.ValidateAll() if the designated
+// ListOrganizationMemberUsergroupsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// ListOrganizationMemberUsergroupsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListBookmarksResponseMultiError []error
+type ListOrganizationMemberUsergroupsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksResponseMultiError) Error() string {
+func (m ListOrganizationMemberUsergroupsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12036,11 +12041,12 @@ func (m ListBookmarksResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
+func (m ListOrganizationMemberUsergroupsRequestMultiError) AllErrors() []error { return m }
 
-// ListBookmarksResponseValidationError is the validation error returned by
-// ListBookmarksResponse.Validate if the designated constraints aren't met.
-type ListBookmarksResponseValidationError struct {
+// ListOrganizationMemberUsergroupsRequestValidationError is the validation
+// error returned by ListOrganizationMemberUsergroupsRequest.Validate if the
+// designated constraints aren't met.
+type ListOrganizationMemberUsergroupsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12048,24 +12054,24 @@ type ListBookmarksResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksResponseValidationError) Field() string { return e.field }
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksResponseValidationError) ErrorName() string {
-	return "ListBookmarksResponseValidationError"
+func (e ListOrganizationMemberUsergroupsRequestValidationError) ErrorName() string {
+	return "ListOrganizationMemberUsergroupsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksResponseValidationError) Error() string {
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12077,14 +12083,14 @@ func (e ListBookmarksResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksResponse.%s: %s%s",
+		"invalid %sListOrganizationMemberUsergroupsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksResponseValidationError{}
+var _ error = ListOrganizationMemberUsergroupsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12092,46 +12098,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksResponseValidationError{}
+} = ListOrganizationMemberUsergroupsRequestValidationError{}
 
-// Validate checks the field values on GetBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBookmarkRequest) Validate() error {
+// Validate checks the field values on ListOrganizationMemberUsergroupsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListOrganizationMemberUsergroupsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetBookmarkRequestMultiError, or nil if none found.
-func (m *GetBookmarkRequest) ValidateAll() error {
+// ValidateAll checks the field values on
+// ListOrganizationMemberUsergroupsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// ListOrganizationMemberUsergroupsResponseMultiError, or nil if none found.
+func (m *ListOrganizationMemberUsergroupsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBookmarkRequest) validate(all bool) error {
+func (m *ListOrganizationMemberUsergroupsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrganizationMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrganizationMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrganizationMemberUsergroupsResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return GetBookmarkRequestMultiError(errors)
+		return ListOrganizationMemberUsergroupsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBookmarkRequestMultiError is an error wrapping multiple validation errors
-// returned by GetBookmarkRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetBookmarkRequestMultiError []error
+// ListOrganizationMemberUsergroupsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// ListOrganizationMemberUsergroupsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListOrganizationMemberUsergroupsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBookmarkRequestMultiError) Error() string {
+func (m ListOrganizationMemberUsergroupsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12140,11 +12183,12 @@ func (m GetBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m ListOrganizationMemberUsergroupsResponseMultiError) AllErrors() []error { return m }
 
-// GetBookmarkRequestValidationError is the validation error returned by
-// GetBookmarkRequest.Validate if the designated constraints aren't met.
-type GetBookmarkRequestValidationError struct {
+// ListOrganizationMemberUsergroupsResponseValidationError is the validation
+// error returned by ListOrganizationMemberUsergroupsResponse.Validate if the
+// designated constraints aren't met.
+type ListOrganizationMemberUsergroupsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12152,24 +12196,24 @@ type GetBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBookmarkRequestValidationError) Field() string { return e.field }
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBookmarkRequestValidationError) Key() bool { return e.key }
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBookmarkRequestValidationError) ErrorName() string {
-	return "GetBookmarkRequestValidationError"
+func (e ListOrganizationMemberUsergroupsResponseValidationError) ErrorName() string {
+	return "ListOrganizationMemberUsergroupsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBookmarkRequestValidationError) Error() string {
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12181,14 +12225,14 @@ func (e GetBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBookmarkRequest.%s: %s%s",
+		"invalid %sListOrganizationMemberUsergroupsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBookmarkRequestValidationError{}
+var _ error = ListOrganizationMemberUsergroupsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12196,73 +12240,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBookmarkRequestValidationError{}
+} = ListOrganizationMemberUsergroupsResponseValidationError{}
 
-// Validate checks the field values on GetBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBookmarkResponse) Validate() error {
+// Validate checks the field values on AddOrganizationMemberUsergroupRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AddOrganizationMemberUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetBookmarkResponseMultiError, or nil if none found.
-func (m *GetBookmarkResponse) ValidateAll() error {
+// ValidateAll checks the field values on AddOrganizationMemberUsergroupRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AddOrganizationMemberUsergroupRequestMultiError, or nil if none found.
+func (m *AddOrganizationMemberUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBookmarkResponse) validate(all bool) error {
+func (m *AddOrganizationMemberUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBookmark()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := AddOrganizationMemberUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetBookmarkResponseValidationError{
-				field:  "Bookmark",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := AddOrganizationMemberUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := AddOrganizationMemberUsergroupRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetBookmarkResponseMultiError(errors)
+		return AddOrganizationMemberUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by GetBookmarkResponse.ValidateAll() if the designated
+// AddOrganizationMemberUsergroupRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// AddOrganizationMemberUsergroupRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetBookmarkResponseMultiError []error
+type AddOrganizationMemberUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBookmarkResponseMultiError) Error() string {
+func (m AddOrganizationMemberUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12271,11 +12321,12 @@ func (m GetBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m AddOrganizationMemberUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// GetBookmarkResponseValidationError is the validation error returned by
-// GetBookmarkResponse.Validate if the designated constraints aren't met.
-type GetBookmarkResponseValidationError struct {
+// AddOrganizationMemberUsergroupRequestValidationError is the validation error
+// returned by AddOrganizationMemberUsergroupRequest.Validate if the
+// designated constraints aren't met.
+type AddOrganizationMemberUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12283,24 +12334,24 @@ type GetBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBookmarkResponseValidationError) Field() string { return e.field }
+func (e AddOrganizationMemberUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e AddOrganizationMemberUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e AddOrganizationMemberUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBookmarkResponseValidationError) Key() bool { return e.key }
+func (e AddOrganizationMemberUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBookmarkResponseValidationError) ErrorName() string {
-	return "GetBookmarkResponseValidationError"
+func (e AddOrganizationMemberUsergroupRequestValidationError) ErrorName() string {
+	return "AddOrganizationMemberUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBookmarkResponseValidationError) Error() string {
+func (e AddOrganizationMemberUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12312,14 +12363,14 @@ func (e GetBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBookmarkResponse.%s: %s%s",
+		"invalid %sAddOrganizationMemberUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBookmarkResponseValidationError{}
+var _ error = AddOrganizationMemberUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12327,60 +12378,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBookmarkResponseValidationError{}
+} = AddOrganizationMemberUsergroupRequestValidationError{}
 
-// Validate checks the field values on CreateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBookmarkRequest) Validate() error {
+// Validate checks the field values on AddOrganizationMemberUsergroupResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AddOrganizationMemberUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateBookmarkRequestMultiError, or nil if none found.
-func (m *CreateBookmarkRequest) ValidateAll() error {
+// ValidateAll checks the field values on
+// AddOrganizationMemberUsergroupResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// AddOrganizationMemberUsergroupResponseMultiError, or nil if none found.
+func (m *AddOrganizationMemberUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBookmarkRequest) validate(all bool) error {
+func (m *AddOrganizationMemberUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DisplayName
-
-	// no validation rules for Description
-
-	// no validation rules for Data
-
-	// no validation rules for ResourceKind
-
-	// no validation rules for ResourceName
-
-	// no validation rules for ProjectId
-
-	// no validation rules for Default
-
-	// no validation rules for Shared
-
 	if len(errors) > 0 {
-		return CreateBookmarkRequestMultiError(errors)
+		return AddOrganizationMemberUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateBookmarkRequest.ValidateAll() if the designated
+// AddOrganizationMemberUsergroupResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// AddOrganizationMemberUsergroupResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateBookmarkRequestMultiError []error
+type AddOrganizationMemberUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBookmarkRequestMultiError) Error() string {
+func (m AddOrganizationMemberUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12389,11 +12427,12 @@ func (m CreateBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m AddOrganizationMemberUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// CreateBookmarkRequestValidationError is the validation error returned by
-// CreateBookmarkRequest.Validate if the designated constraints aren't met.
-type CreateBookmarkRequestValidationError struct {
+// AddOrganizationMemberUsergroupResponseValidationError is the validation
+// error returned by AddOrganizationMemberUsergroupResponse.Validate if the
+// designated constraints aren't met.
+type AddOrganizationMemberUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12401,24 +12440,24 @@ type CreateBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBookmarkRequestValidationError) Field() string { return e.field }
+func (e AddOrganizationMemberUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e AddOrganizationMemberUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e AddOrganizationMemberUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBookmarkRequestValidationError) Key() bool { return e.key }
+func (e AddOrganizationMemberUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBookmarkRequestValidationError) ErrorName() string {
-	return "CreateBookmarkRequestValidationError"
+func (e AddOrganizationMemberUsergroupResponseValidationError) ErrorName() string {
+	return "AddOrganizationMemberUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBookmarkRequestValidationError) Error() string {
+func (e AddOrganizationMemberUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12430,14 +12469,14 @@ func (e CreateBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBookmarkRequest.%s: %s%s",
+		"invalid %sAddOrganizationMemberUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBookmarkRequestValidationError{}
+var _ error = AddOrganizationMemberUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12445,73 +12484,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBookmarkRequestValidationError{}
+} = AddOrganizationMemberUsergroupResponseValidationError{}
 
-// Validate checks the field values on CreateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBookmarkResponse) Validate() error {
+// Validate checks the field values on
+// SetOrganizationMemberUsergroupRoleRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetOrganizationMemberUsergroupRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateBookmarkResponseMultiError, or nil if none found.
-func (m *CreateBookmarkResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// SetOrganizationMemberUsergroupRoleRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// SetOrganizationMemberUsergroupRoleRequestMultiError, or nil if none found.
+func (m *SetOrganizationMemberUsergroupRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBookmarkResponse) validate(all bool) error {
+func (m *SetOrganizationMemberUsergroupRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBookmark()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SetOrganizationMemberUsergroupRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateBookmarkResponseValidationError{
-				field:  "Bookmark",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := SetOrganizationMemberUsergroupRoleRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetOrganizationMemberUsergroupRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreateBookmarkResponseMultiError(errors)
+		return SetOrganizationMemberUsergroupRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateBookmarkResponse.ValidateAll() if the designated
+// SetOrganizationMemberUsergroupRoleRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// SetOrganizationMemberUsergroupRoleRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateBookmarkResponseMultiError []error
+type SetOrganizationMemberUsergroupRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBookmarkResponseMultiError) Error() string {
+func (m SetOrganizationMemberUsergroupRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12520,11 +12566,12 @@ func (m CreateBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m SetOrganizationMemberUsergroupRoleRequestMultiError) AllErrors() []error { return m }
 
-// CreateBookmarkResponseValidationError is the validation error returned by
-// CreateBookmarkResponse.Validate if the designated constraints aren't met.
-type CreateBookmarkResponseValidationError struct {
+// SetOrganizationMemberUsergroupRoleRequestValidationError is the validation
+// error returned by SetOrganizationMemberUsergroupRoleRequest.Validate if the
+// designated constraints aren't met.
+type SetOrganizationMemberUsergroupRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12532,24 +12579,24 @@ type CreateBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBookmarkResponseValidationError) Field() string { return e.field }
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBookmarkResponseValidationError) Key() bool { return e.key }
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBookmarkResponseValidationError) ErrorName() string {
-	return "CreateBookmarkResponseValidationError"
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) ErrorName() string {
+	return "SetOrganizationMemberUsergroupRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBookmarkResponseValidationError) Error() string {
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12561,14 +12608,14 @@ func (e CreateBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBookmarkResponse.%s: %s%s",
+		"invalid %sSetOrganizationMemberUsergroupRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBookmarkResponseValidationError{}
+var _ error = SetOrganizationMemberUsergroupRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12576,56 +12623,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBookmarkResponseValidationError{}
+} = SetOrganizationMemberUsergroupRoleRequestValidationError{}
 
-// Validate checks the field values on UpdateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookmarkRequest) Validate() error {
+// Validate checks the field values on
+// SetOrganizationMemberUsergroupRoleResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetOrganizationMemberUsergroupRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateBookmarkRequestMultiError, or nil if none found.
-func (m *UpdateBookmarkRequest) ValidateAll() error {
+// ValidateAll checks the field values on
+// SetOrganizationMemberUsergroupRoleResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// SetOrganizationMemberUsergroupRoleResponseMultiError, or nil if none found.
+func (m *SetOrganizationMemberUsergroupRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookmarkRequest) validate(all bool) error {
+func (m *SetOrganizationMemberUsergroupRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
-
-	// no validation rules for DisplayName
-
-	// no validation rules for Description
-
-	// no validation rules for Data
-
-	// no validation rules for Default
-
-	// no validation rules for Shared
-
 	if len(errors) > 0 {
-		return UpdateBookmarkRequestMultiError(errors)
+		return SetOrganizationMemberUsergroupRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateBookmarkRequest.ValidateAll() if the designated
+// SetOrganizationMemberUsergroupRoleResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// SetOrganizationMemberUsergroupRoleResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateBookmarkRequestMultiError []error
+type SetOrganizationMemberUsergroupRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookmarkRequestMultiError) Error() string {
+func (m SetOrganizationMemberUsergroupRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12634,11 +12672,12 @@ func (m UpdateBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m SetOrganizationMemberUsergroupRoleResponseMultiError) AllErrors() []error { return m }
 
-// UpdateBookmarkRequestValidationError is the validation error returned by
-// UpdateBookmarkRequest.Validate if the designated constraints aren't met.
-type UpdateBookmarkRequestValidationError struct {
+// SetOrganizationMemberUsergroupRoleResponseValidationError is the validation
+// error returned by SetOrganizationMemberUsergroupRoleResponse.Validate if
+// the designated constraints aren't met.
+type SetOrganizationMemberUsergroupRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12646,24 +12685,24 @@ type UpdateBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookmarkRequestValidationError) Field() string { return e.field }
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookmarkRequestValidationError) Key() bool { return e.key }
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookmarkRequestValidationError) ErrorName() string {
-	return "UpdateBookmarkRequestValidationError"
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) ErrorName() string {
+	return "SetOrganizationMemberUsergroupRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookmarkRequestValidationError) Error() string {
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12675,14 +12714,14 @@ func (e UpdateBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookmarkRequest.%s: %s%s",
+		"invalid %sSetOrganizationMemberUsergroupRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookmarkRequestValidationError{}
+var _ error = SetOrganizationMemberUsergroupRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12690,44 +12729,69 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookmarkRequestValidationError{}
+} = SetOrganizationMemberUsergroupRoleResponseValidationError{}
 
-// Validate checks the field values on UpdateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookmarkResponse) Validate() error {
+// Validate checks the field values on RemoveOrganizationMemberUsergroupRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *RemoveOrganizationMemberUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateBookmarkResponseMultiError, or nil if none found.
-func (m *UpdateBookmarkResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// RemoveOrganizationMemberUsergroupRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// RemoveOrganizationMemberUsergroupRequestMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookmarkResponse) validate(all bool) error {
+func (m *RemoveOrganizationMemberUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveOrganizationMemberUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RemoveOrganizationMemberUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return UpdateBookmarkResponseMultiError(errors)
+		return RemoveOrganizationMemberUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateBookmarkResponse.ValidateAll() if the designated
+// RemoveOrganizationMemberUsergroupRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// RemoveOrganizationMemberUsergroupRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateBookmarkResponseMultiError []error
+type RemoveOrganizationMemberUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookmarkResponseMultiError) Error() string {
+func (m RemoveOrganizationMemberUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12736,11 +12800,12 @@ func (m UpdateBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m RemoveOrganizationMemberUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// UpdateBookmarkResponseValidationError is the validation error returned by
-// UpdateBookmarkResponse.Validate if the designated constraints aren't met.
-type UpdateBookmarkResponseValidationError struct {
+// RemoveOrganizationMemberUsergroupRequestValidationError is the validation
+// error returned by RemoveOrganizationMemberUsergroupRequest.Validate if the
+// designated constraints aren't met.
+type RemoveOrganizationMemberUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12748,24 +12813,24 @@ type UpdateBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookmarkResponseValidationError) Field() string { return e.field }
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookmarkResponseValidationError) Key() bool { return e.key }
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookmarkResponseValidationError) ErrorName() string {
-	return "UpdateBookmarkResponseValidationError"
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookmarkResponseValidationError) Error() string {
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12777,14 +12842,14 @@ func (e UpdateBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookmarkResponse.%s: %s%s",
+		"invalid %sRemoveOrganizationMemberUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookmarkResponseValidationError{}
+var _ error = RemoveOrganizationMemberUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12792,46 +12857,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookmarkResponseValidationError{}
+} = RemoveOrganizationMemberUsergroupRequestValidationError{}
 
-// Validate checks the field values on RemoveBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveBookmarkRequest) Validate() error {
+// Validate checks the field values on
+// RemoveOrganizationMemberUsergroupResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RemoveOrganizationMemberUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RemoveBookmarkRequestMultiError, or nil if none found.
-func (m *RemoveBookmarkRequest) ValidateAll() error {
+// ValidateAll checks the field values on
+// RemoveOrganizationMemberUsergroupResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// RemoveOrganizationMemberUsergroupResponseMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveBookmarkRequest) validate(all bool) error {
+func (m *RemoveOrganizationMemberUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
-
 	if len(errors) > 0 {
-		return RemoveBookmarkRequestMultiError(errors)
+		return RemoveOrganizationMemberUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
+// RemoveOrganizationMemberUsergroupResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// RemoveOrganizationMemberUsergroupResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveBookmarkRequestMultiError []error
+type RemoveOrganizationMemberUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveBookmarkRequestMultiError) Error() string {
+func (m RemoveOrganizationMemberUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12840,11 +12906,12 @@ func (m RemoveBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m RemoveOrganizationMemberUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// RemoveBookmarkRequestValidationError is the validation error returned by
-// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
-type RemoveBookmarkRequestValidationError struct {
+// RemoveOrganizationMemberUsergroupResponseValidationError is the validation
+// error returned by RemoveOrganizationMemberUsergroupResponse.Validate if the
+// designated constraints aren't met.
+type RemoveOrganizationMemberUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12852,24 +12919,24 @@ type RemoveBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveBookmarkRequestValidationError) ErrorName() string {
-	return "RemoveBookmarkRequestValidationError"
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveBookmarkRequestValidationError) Error() string {
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12881,14 +12948,14 @@ func (e RemoveBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveBookmarkRequest.%s: %s%s",
+		"invalid %sRemoveOrganizationMemberUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveBookmarkRequestValidationError{}
+var _ error = RemoveOrganizationMemberUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12896,135 +12963,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveBookmarkRequestValidationError{}
+} = RemoveOrganizationMemberUsergroupResponseValidationError{}
 
-// Validate checks the field values on RemoveBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveBookmarkResponse) Validate() error {
+// Validate checks the field values on ListProjectMemberUsergroupsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListProjectMemberUsergroupsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RemoveBookmarkResponseMultiError, or nil if none found.
-func (m *RemoveBookmarkResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectMemberUsergroupsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectMemberUsergroupsRequestMultiError, or nil if none found.
+func (m *ListProjectMemberUsergroupsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveBookmarkResponse) validate(all bool) error {
+func (m *ListProjectMemberUsergroupsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return RemoveBookmarkResponseMultiError(errors)
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectMemberUsergroupsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
-
-// RemoveBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by RemoveBookmarkResponse.ValidateAll() if the designated
-// constraints aren't met.
-type RemoveBookmarkResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m RemoveBookmarkResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m RemoveBookmarkResponseMultiError) AllErrors() []error { return m }
-
-// RemoveBookmarkResponseValidationError is the validation error returned by
-// RemoveBookmarkResponse.Validate if the designated constraints aren't met.
-type RemoveBookmarkResponseValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e RemoveBookmarkResponseValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e RemoveBookmarkResponseValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e RemoveBookmarkResponseValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e RemoveBookmarkResponseValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e RemoveBookmarkResponseValidationError) ErrorName() string {
-	return "RemoveBookmarkResponseValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e RemoveBookmarkResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sRemoveBookmarkResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = RemoveBookmarkResponseValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = RemoveBookmarkResponseValidationError{}
-
-// Validate checks the field values on SearchUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchUsersRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on SearchUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SearchUsersRequestMultiError, or nil if none found.
-func (m *SearchUsersRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *SearchUsersRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if utf8.RuneCountInString(m.GetEmailPattern()) < 1 {
-		err := SearchUsersRequestValidationError{
-			field:  "EmailPattern",
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectMemberUsergroupsRequestValidationError{
+			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -13036,7 +13013,7 @@ func (m *SearchUsersRequest) validate(all bool) error {
 	if m.GetPageSize() != 0 {
 
 		if m.GetPageSize() > 1000 {
-			err := SearchUsersRequestValidationError{
+			err := ListProjectMemberUsergroupsRequestValidationError{
 				field:  "PageSize",
 				reason: "value must be less than or equal to 1000",
 			}
@@ -13051,19 +13028,20 @@ func (m *SearchUsersRequest) validate(all bool) error {
 	// no validation rules for PageToken
 
 	if len(errors) > 0 {
-		return SearchUsersRequestMultiError(errors)
+		return ListProjectMemberUsergroupsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchUsersRequestMultiError is an error wrapping multiple validation errors
-// returned by SearchUsersRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SearchUsersRequestMultiError []error
+// ListProjectMemberUsergroupsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListProjectMemberUsergroupsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchUsersRequestMultiError) Error() string {
+func (m ListProjectMemberUsergroupsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13072,11 +13050,12 @@ func (m SearchUsersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
+func (m ListProjectMemberUsergroupsRequestMultiError) AllErrors() []error { return m }
 
-// SearchUsersRequestValidationError is the validation error returned by
-// SearchUsersRequest.Validate if the designated constraints aren't met.
-type SearchUsersRequestValidationError struct {
+// ListProjectMemberUsergroupsRequestValidationError is the validation error
+// returned by ListProjectMemberUsergroupsRequest.Validate if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13084,24 +13063,24 @@ type SearchUsersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchUsersRequestValidationError) Field() string { return e.field }
+func (e ListProjectMemberUsergroupsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
+func (e ListProjectMemberUsergroupsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectMemberUsergroupsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchUsersRequestValidationError) Key() bool { return e.key }
+func (e ListProjectMemberUsergroupsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchUsersRequestValidationError) ErrorName() string {
-	return "SearchUsersRequestValidationError"
+func (e ListProjectMemberUsergroupsRequestValidationError) ErrorName() string {
+	return "ListProjectMemberUsergroupsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchUsersRequestValidationError) Error() string {
+func (e ListProjectMemberUsergroupsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13113,14 +13092,14 @@ func (e SearchUsersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchUsersRequest.%s: %s%s",
+		"invalid %sListProjectMemberUsergroupsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchUsersRequestValidationError{}
+var _ error = ListProjectMemberUsergroupsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13128,47 +13107,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchUsersRequestValidationError{}
+} = ListProjectMemberUsergroupsRequestValidationError{}
 
-// Validate checks the field values on SearchUsersResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchUsersResponse) Validate() error {
+// Validate checks the field values on ListProjectMemberUsergroupsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListProjectMemberUsergroupsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchUsersResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SearchUsersResponseMultiError, or nil if none found.
-func (m *SearchUsersResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectMemberUsergroupsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectMemberUsergroupsResponseMultiError, or nil if none found.
+func (m *ListProjectMemberUsergroupsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchUsersResponse) validate(all bool) error {
+func (m *ListProjectMemberUsergroupsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
+	for idx, item := range m.GetMembers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SearchUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, ListProjectMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, SearchUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, ListProjectMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -13176,8 +13156,8 @@ func (m *SearchUsersResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return SearchUsersResponseValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
+				return ListProjectMemberUsergroupsResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -13189,19 +13169,20 @@ func (m *SearchUsersResponse) validate(all bool) error {
 	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return SearchUsersResponseMultiError(errors)
+		return ListProjectMemberUsergroupsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchUsersResponseMultiError is an error wrapping multiple validation
-// errors returned by SearchUsersResponse.ValidateAll() if the designated
+// ListProjectMemberUsergroupsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListProjectMemberUsergroupsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SearchUsersResponseMultiError []error
+type ListProjectMemberUsergroupsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchUsersResponseMultiError) Error() string {
+func (m ListProjectMemberUsergroupsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13210,11 +13191,12 @@ func (m SearchUsersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectMemberUsergroupsResponseMultiError) AllErrors() []error { return m }
 
-// SearchUsersResponseValidationError is the validation error returned by
-// SearchUsersResponse.Validate if the designated constraints aren't met.
-type SearchUsersResponseValidationError struct {
+// ListProjectMemberUsergroupsResponseValidationError is the validation error
+// returned by ListProjectMemberUsergroupsResponse.Validate if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13222,24 +13204,24 @@ type SearchUsersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchUsersResponseValidationError) Field() string { return e.field }
+func (e ListProjectMemberUsergroupsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectMemberUsergroupsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectMemberUsergroupsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchUsersResponseValidationError) Key() bool { return e.key }
+func (e ListProjectMemberUsergroupsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchUsersResponseValidationError) ErrorName() string {
-	return "SearchUsersResponseValidationError"
+func (e ListProjectMemberUsergroupsResponseValidationError) ErrorName() string {
+	return "ListProjectMemberUsergroupsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchUsersResponseValidationError) Error() string {
+func (e ListProjectMemberUsergroupsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13251,14 +13233,14 @@ func (e SearchUsersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchUsersResponse.%s: %s%s",
+		"invalid %sListProjectMemberUsergroupsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchUsersResponseValidationError{}
+var _ error = ListProjectMemberUsergroupsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13266,44 +13248,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchUsersResponseValidationError{}
+} = ListProjectMemberUsergroupsResponseValidationError{}
 
-// Validate checks the field values on RevokeCurrentAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeCurrentAuthTokenRequest) Validate() error {
+// Validate checks the field values on AddProjectMemberUsergroupRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddProjectMemberUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeCurrentAuthTokenRequest with
+// ValidateAll checks the field values on AddProjectMemberUsergroupRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RevokeCurrentAuthTokenRequestMultiError, or nil if none found.
-func (m *RevokeCurrentAuthTokenRequest) ValidateAll() error {
+// AddProjectMemberUsergroupRequestMultiError, or nil if none found.
+func (m *AddProjectMemberUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
+func (m *AddProjectMemberUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := AddProjectMemberUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := AddProjectMemberUsergroupRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := AddProjectMemberUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := AddProjectMemberUsergroupRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return RevokeCurrentAuthTokenRequestMultiError(errors)
+		return AddProjectMemberUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeCurrentAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeCurrentAuthTokenRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeCurrentAuthTokenRequestMultiError []error
+// AddProjectMemberUsergroupRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AddProjectMemberUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectMemberUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
+func (m AddProjectMemberUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13312,12 +13340,12 @@ func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeCurrentAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m AddProjectMemberUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// RevokeCurrentAuthTokenRequestValidationError is the validation error
-// returned by RevokeCurrentAuthTokenRequest.Validate if the designated
+// AddProjectMemberUsergroupRequestValidationError is the validation error
+// returned by AddProjectMemberUsergroupRequest.Validate if the designated
 // constraints aren't met.
-type RevokeCurrentAuthTokenRequestValidationError struct {
+type AddProjectMemberUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13325,24 +13353,24 @@ type RevokeCurrentAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Field() string { return e.field }
+func (e AddProjectMemberUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e AddProjectMemberUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e AddProjectMemberUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e AddProjectMemberUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeCurrentAuthTokenRequestValidationError) ErrorName() string {
-	return "RevokeCurrentAuthTokenRequestValidationError"
+func (e AddProjectMemberUsergroupRequestValidationError) ErrorName() string {
+	return "AddProjectMemberUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
+func (e AddProjectMemberUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13354,14 +13382,14 @@ func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeCurrentAuthTokenRequest.%s: %s%s",
+		"invalid %sAddProjectMemberUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeCurrentAuthTokenRequestValidationError{}
+var _ error = AddProjectMemberUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13369,46 +13397,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeCurrentAuthTokenRequestValidationError{}
+} = AddProjectMemberUsergroupRequestValidationError{}
 
-// Validate checks the field values on RevokeCurrentAuthTokenResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeCurrentAuthTokenResponse) Validate() error {
+// Validate checks the field values on AddProjectMemberUsergroupResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddProjectMemberUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeCurrentAuthTokenResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeCurrentAuthTokenResponseMultiError, or nil if none found.
-func (m *RevokeCurrentAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on AddProjectMemberUsergroupResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AddProjectMemberUsergroupResponseMultiError, or nil if none found.
+func (m *AddProjectMemberUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeCurrentAuthTokenResponse) validate(all bool) error {
+func (m *AddProjectMemberUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TokenId
-
 	if len(errors) > 0 {
-		return RevokeCurrentAuthTokenResponseMultiError(errors)
+		return AddProjectMemberUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeCurrentAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeCurrentAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeCurrentAuthTokenResponseMultiError []error
+// AddProjectMemberUsergroupResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// AddProjectMemberUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectMemberUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
+func (m AddProjectMemberUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13417,12 +13445,12 @@ func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeCurrentAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m AddProjectMemberUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// RevokeCurrentAuthTokenResponseValidationError is the validation error
-// returned by RevokeCurrentAuthTokenResponse.Validate if the designated
+// AddProjectMemberUsergroupResponseValidationError is the validation error
+// returned by AddProjectMemberUsergroupResponse.Validate if the designated
 // constraints aren't met.
-type RevokeCurrentAuthTokenResponseValidationError struct {
+type AddProjectMemberUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13430,24 +13458,24 @@ type RevokeCurrentAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Field() string { return e.field }
+func (e AddProjectMemberUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e AddProjectMemberUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e AddProjectMemberUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e AddProjectMemberUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeCurrentAuthTokenResponseValidationError) ErrorName() string {
-	return "RevokeCurrentAuthTokenResponseValidationError"
+func (e AddProjectMemberUsergroupResponseValidationError) ErrorName() string {
+	return "AddProjectMemberUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
+func (e AddProjectMemberUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13459,14 +13487,14 @@ func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeCurrentAuthTokenResponse.%s: %s%s",
+		"invalid %sAddProjectMemberUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeCurrentAuthTokenResponseValidationError{}
+var _ error = AddProjectMemberUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13474,36 +13502,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeCurrentAuthTokenResponseValidationError{}
+} = AddProjectMemberUsergroupResponseValidationError{}
 
-// Validate checks the field values on IssueRepresentativeAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *IssueRepresentativeAuthTokenRequest) Validate() error {
+// Validate checks the field values on SetProjectMemberUsergroupRoleRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetProjectMemberUsergroupRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueRepresentativeAuthTokenRequest
+// ValidateAll checks the field values on SetProjectMemberUsergroupRoleRequest
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// IssueRepresentativeAuthTokenRequestMultiError, or nil if none found.
-func (m *IssueRepresentativeAuthTokenRequest) ValidateAll() error {
+// SetProjectMemberUsergroupRoleRequestMultiError, or nil if none found.
+func (m *SetProjectMemberUsergroupRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
+func (m *SetProjectMemberUsergroupRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = IssueRepresentativeAuthTokenRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -13511,73 +13538,54 @@ func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for TtlMinutes
-
-	if len(errors) > 0 {
-		return IssueRepresentativeAuthTokenRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *IssueRepresentativeAuthTokenRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
 		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
 		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
-
-func (m *IssueRepresentativeAuthTokenRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return SetProjectMemberUsergroupRoleRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// IssueRepresentativeAuthTokenRequestMultiError is an error wrapping multiple
+// SetProjectMemberUsergroupRoleRequestMultiError is an error wrapping multiple
 // validation errors returned by
-// IssueRepresentativeAuthTokenRequest.ValidateAll() if the designated
+// SetProjectMemberUsergroupRoleRequest.ValidateAll() if the designated
 // constraints aren't met.
-type IssueRepresentativeAuthTokenRequestMultiError []error
+type SetProjectMemberUsergroupRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
+func (m SetProjectMemberUsergroupRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13586,12 +13594,12 @@ func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueRepresentativeAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m SetProjectMemberUsergroupRoleRequestMultiError) AllErrors() []error { return m }
 
-// IssueRepresentativeAuthTokenRequestValidationError is the validation error
-// returned by IssueRepresentativeAuthTokenRequest.Validate if the designated
+// SetProjectMemberUsergroupRoleRequestValidationError is the validation error
+// returned by SetProjectMemberUsergroupRoleRequest.Validate if the designated
 // constraints aren't met.
-type IssueRepresentativeAuthTokenRequestValidationError struct {
+type SetProjectMemberUsergroupRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13599,24 +13607,24 @@ type IssueRepresentativeAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Field() string { return e.field }
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueRepresentativeAuthTokenRequestValidationError) ErrorName() string {
-	return "IssueRepresentativeAuthTokenRequestValidationError"
+func (e SetProjectMemberUsergroupRoleRequestValidationError) ErrorName() string {
+	return "SetProjectMemberUsergroupRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13628,14 +13636,14 @@ func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueRepresentativeAuthTokenRequest.%s: %s%s",
+		"invalid %sSetProjectMemberUsergroupRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueRepresentativeAuthTokenRequestValidationError{}
+var _ error = SetProjectMemberUsergroupRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13643,48 +13651,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueRepresentativeAuthTokenRequestValidationError{}
+} = SetProjectMemberUsergroupRoleRequestValidationError{}
 
-// Validate checks the field values on IssueRepresentativeAuthTokenResponse
+// Validate checks the field values on SetProjectMemberUsergroupRoleResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *IssueRepresentativeAuthTokenResponse) Validate() error {
+func (m *SetProjectMemberUsergroupRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueRepresentativeAuthTokenResponse
+// ValidateAll checks the field values on SetProjectMemberUsergroupRoleResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// IssueRepresentativeAuthTokenResponseMultiError, or nil if none found.
-func (m *IssueRepresentativeAuthTokenResponse) ValidateAll() error {
+// SetProjectMemberUsergroupRoleResponseMultiError, or nil if none found.
+func (m *SetProjectMemberUsergroupRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueRepresentativeAuthTokenResponse) validate(all bool) error {
+func (m *SetProjectMemberUsergroupRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
-
 	if len(errors) > 0 {
-		return IssueRepresentativeAuthTokenResponseMultiError(errors)
+		return SetProjectMemberUsergroupRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueRepresentativeAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// IssueRepresentativeAuthTokenResponse.ValidateAll() if the designated
+// SetProjectMemberUsergroupRoleResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// SetProjectMemberUsergroupRoleResponse.ValidateAll() if the designated
 // constraints aren't met.
-type IssueRepresentativeAuthTokenResponseMultiError []error
+type SetProjectMemberUsergroupRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
+func (m SetProjectMemberUsergroupRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13693,12 +13699,12 @@ func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueRepresentativeAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m SetProjectMemberUsergroupRoleResponseMultiError) AllErrors() []error { return m }
 
-// IssueRepresentativeAuthTokenResponseValidationError is the validation error
-// returned by IssueRepresentativeAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenResponseValidationError struct {
+// SetProjectMemberUsergroupRoleResponseValidationError is the validation error
+// returned by SetProjectMemberUsergroupRoleResponse.Validate if the
+// designated constraints aren't met.
+type SetProjectMemberUsergroupRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13706,24 +13712,24 @@ type IssueRepresentativeAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Field() string { return e.field }
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueRepresentativeAuthTokenResponseValidationError) ErrorName() string {
-	return "IssueRepresentativeAuthTokenResponseValidationError"
+func (e SetProjectMemberUsergroupRoleResponseValidationError) ErrorName() string {
+	return "SetProjectMemberUsergroupRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13735,14 +13741,14 @@ func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueRepresentativeAuthTokenResponse.%s: %s%s",
+		"invalid %sSetProjectMemberUsergroupRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueRepresentativeAuthTokenResponseValidationError{}
+var _ error = SetProjectMemberUsergroupRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13750,46 +13756,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueRepresentativeAuthTokenResponseValidationError{}
+} = SetProjectMemberUsergroupRoleResponseValidationError{}
 
-// Validate checks the field values on RevokeServiceAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeServiceAuthTokenRequest) Validate() error {
+// Validate checks the field values on RemoveProjectMemberUsergroupRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveProjectMemberUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeServiceAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeServiceAuthTokenRequestMultiError, or nil if none found.
-func (m *RevokeServiceAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveProjectMemberUsergroupRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveProjectMemberUsergroupRequestMultiError, or nil if none found.
+func (m *RemoveProjectMemberUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeServiceAuthTokenRequest) validate(all bool) error {
+func (m *RemoveProjectMemberUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TokenId
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveProjectMemberUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := RemoveProjectMemberUsergroupRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RemoveProjectMemberUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return RevokeServiceAuthTokenRequestMultiError(errors)
+		return RemoveProjectMemberUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeServiceAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeServiceAuthTokenRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeServiceAuthTokenRequestMultiError []error
+// RemoveProjectMemberUsergroupRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveProjectMemberUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveProjectMemberUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
+func (m RemoveProjectMemberUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13798,12 +13837,12 @@ func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m RemoveProjectMemberUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// RevokeServiceAuthTokenRequestValidationError is the validation error
-// returned by RevokeServiceAuthTokenRequest.Validate if the designated
+// RemoveProjectMemberUsergroupRequestValidationError is the validation error
+// returned by RemoveProjectMemberUsergroupRequest.Validate if the designated
 // constraints aren't met.
-type RevokeServiceAuthTokenRequestValidationError struct {
+type RemoveProjectMemberUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13811,24 +13850,24 @@ type RevokeServiceAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeServiceAuthTokenRequestValidationError) Field() string { return e.field }
+func (e RemoveProjectMemberUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e RemoveProjectMemberUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e RemoveProjectMemberUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeServiceAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e RemoveProjectMemberUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeServiceAuthTokenRequestValidationError) ErrorName() string {
-	return "RevokeServiceAuthTokenRequestValidationError"
+func (e RemoveProjectMemberUsergroupRequestValidationError) ErrorName() string {
+	return "RemoveProjectMemberUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
+func (e RemoveProjectMemberUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13840,14 +13879,14 @@ func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeServiceAuthTokenRequest.%s: %s%s",
+		"invalid %sRemoveProjectMemberUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeServiceAuthTokenRequestValidationError{}
+var _ error = RemoveProjectMemberUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13855,24 +13894,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeServiceAuthTokenRequestValidationError{}
+} = RemoveProjectMemberUsergroupRequestValidationError{}
 
-// Validate checks the field values on RevokeServiceAuthTokenResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeServiceAuthTokenResponse) Validate() error {
+// Validate checks the field values on RemoveProjectMemberUsergroupResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *RemoveProjectMemberUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeServiceAuthTokenResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeServiceAuthTokenResponseMultiError, or nil if none found.
-func (m *RevokeServiceAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemoveProjectMemberUsergroupResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveProjectMemberUsergroupResponseMultiError, or nil if none found.
+func (m *RemoveProjectMemberUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeServiceAuthTokenResponse) validate(all bool) error {
+func (m *RemoveProjectMemberUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13880,19 +13920,20 @@ func (m *RevokeServiceAuthTokenResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return RevokeServiceAuthTokenResponseMultiError(errors)
+		return RemoveProjectMemberUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeServiceAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeServiceAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeServiceAuthTokenResponseMultiError []error
+// RemoveProjectMemberUsergroupResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveProjectMemberUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveProjectMemberUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
+func (m RemoveProjectMemberUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13901,12 +13942,12 @@ func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m RemoveProjectMemberUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// RevokeServiceAuthTokenResponseValidationError is the validation error
-// returned by RevokeServiceAuthTokenResponse.Validate if the designated
+// RemoveProjectMemberUsergroupResponseValidationError is the validation error
+// returned by RemoveProjectMemberUsergroupResponse.Validate if the designated
 // constraints aren't met.
-type RevokeServiceAuthTokenResponseValidationError struct {
+type RemoveProjectMemberUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13914,24 +13955,24 @@ type RevokeServiceAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeServiceAuthTokenResponseValidationError) Field() string { return e.field }
+func (e RemoveProjectMemberUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e RemoveProjectMemberUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e RemoveProjectMemberUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeServiceAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e RemoveProjectMemberUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeServiceAuthTokenResponseValidationError) ErrorName() string {
-	return "RevokeServiceAuthTokenResponseValidationError"
+func (e RemoveProjectMemberUsergroupResponseValidationError) ErrorName() string {
+	return "RemoveProjectMemberUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
+func (e RemoveProjectMemberUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13943,14 +13984,14 @@ func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeServiceAuthTokenResponse.%s: %s%s",
+		"invalid %sRemoveProjectMemberUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeServiceAuthTokenResponseValidationError{}
+var _ error = RemoveProjectMemberUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13958,33 +13999,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeServiceAuthTokenResponseValidationError{}
+} = RemoveProjectMemberUsergroupResponseValidationError{}
 
-// Validate checks the field values on IssueServiceAuthTokenRequest with the
+// Validate checks the field values on ListUsergroupMemberUsersRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueServiceAuthTokenRequest) Validate() error {
+func (m *ListUsergroupMemberUsersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueServiceAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// IssueServiceAuthTokenRequestMultiError, or nil if none found.
-func (m *IssueServiceAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListUsergroupMemberUsersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListUsergroupMemberUsersRequestMultiError, or nil if none found.
+func (m *ListUsergroupMemberUsersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
+func (m *ListUsergroupMemberUsersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := IssueServiceAuthTokenRequestValidationError{
-			field:  "OrganizationName",
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListUsergroupMemberUsersRequestValidationError{
+			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -13993,22 +14034,48 @@ func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ServiceName
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := ListUsergroupMemberUsersRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListUsergroupMemberUsersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
 
 	if len(errors) > 0 {
-		return IssueServiceAuthTokenRequestMultiError(errors)
+		return ListUsergroupMemberUsersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueServiceAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by IssueServiceAuthTokenRequest.ValidateAll() if
-// the designated constraints aren't met.
-type IssueServiceAuthTokenRequestMultiError []error
+// ListUsergroupMemberUsersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListUsergroupMemberUsersRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListUsergroupMemberUsersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueServiceAuthTokenRequestMultiError) Error() string {
+func (m ListUsergroupMemberUsersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14017,12 +14084,12 @@ func (m IssueServiceAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m ListUsergroupMemberUsersRequestMultiError) AllErrors() []error { return m }
 
-// IssueServiceAuthTokenRequestValidationError is the validation error returned
-// by IssueServiceAuthTokenRequest.Validate if the designated constraints
-// aren't met.
-type IssueServiceAuthTokenRequestValidationError struct {
+// ListUsergroupMemberUsersRequestValidationError is the validation error
+// returned by ListUsergroupMemberUsersRequest.Validate if the designated
+// constraints aren't met.
+type ListUsergroupMemberUsersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14030,24 +14097,24 @@ type IssueServiceAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueServiceAuthTokenRequestValidationError) Field() string { return e.field }
+func (e ListUsergroupMemberUsersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e ListUsergroupMemberUsersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e ListUsergroupMemberUsersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueServiceAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e ListUsergroupMemberUsersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueServiceAuthTokenRequestValidationError) ErrorName() string {
-	return "IssueServiceAuthTokenRequestValidationError"
+func (e ListUsergroupMemberUsersRequestValidationError) ErrorName() string {
+	return "ListUsergroupMemberUsersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueServiceAuthTokenRequestValidationError) Error() string {
+func (e ListUsergroupMemberUsersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14059,14 +14126,14 @@ func (e IssueServiceAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueServiceAuthTokenRequest.%s: %s%s",
+		"invalid %sListUsergroupMemberUsersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueServiceAuthTokenRequestValidationError{}
+var _ error = ListUsergroupMemberUsersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14074,46 +14141,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueServiceAuthTokenRequestValidationError{}
+} = ListUsergroupMemberUsersRequestValidationError{}
 
-// Validate checks the field values on IssueServiceAuthTokenResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueServiceAuthTokenResponse) Validate() error {
+// Validate checks the field values on ListUsergroupMemberUsersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListUsergroupMemberUsersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueServiceAuthTokenResponse with
+// ValidateAll checks the field values on ListUsergroupMemberUsersResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// IssueServiceAuthTokenResponseMultiError, or nil if none found.
-func (m *IssueServiceAuthTokenResponse) ValidateAll() error {
+// ListUsergroupMemberUsersResponseMultiError, or nil if none found.
+func (m *ListUsergroupMemberUsersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueServiceAuthTokenResponse) validate(all bool) error {
+func (m *ListUsergroupMemberUsersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsergroupMemberUsersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsergroupMemberUsersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUsergroupMemberUsersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return IssueServiceAuthTokenResponseMultiError(errors)
+		return ListUsergroupMemberUsersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueServiceAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by IssueServiceAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type IssueServiceAuthTokenResponseMultiError []error
+// ListUsergroupMemberUsersResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListUsergroupMemberUsersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListUsergroupMemberUsersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueServiceAuthTokenResponseMultiError) Error() string {
+func (m ListUsergroupMemberUsersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14122,12 +14225,12 @@ func (m IssueServiceAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m ListUsergroupMemberUsersResponseMultiError) AllErrors() []error { return m }
 
-// IssueServiceAuthTokenResponseValidationError is the validation error
-// returned by IssueServiceAuthTokenResponse.Validate if the designated
+// ListUsergroupMemberUsersResponseValidationError is the validation error
+// returned by ListUsergroupMemberUsersResponse.Validate if the designated
 // constraints aren't met.
-type IssueServiceAuthTokenResponseValidationError struct {
+type ListUsergroupMemberUsersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14135,24 +14238,24 @@ type IssueServiceAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueServiceAuthTokenResponseValidationError) Field() string { return e.field }
+func (e ListUsergroupMemberUsersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e ListUsergroupMemberUsersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e ListUsergroupMemberUsersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueServiceAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e ListUsergroupMemberUsersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueServiceAuthTokenResponseValidationError) ErrorName() string {
-	return "IssueServiceAuthTokenResponseValidationError"
+func (e ListUsergroupMemberUsersResponseValidationError) ErrorName() string {
+	return "ListUsergroupMemberUsersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueServiceAuthTokenResponseValidationError) Error() string {
+func (e ListUsergroupMemberUsersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14164,14 +14267,14 @@ func (e IssueServiceAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueServiceAuthTokenResponse.%s: %s%s",
+		"invalid %sListUsergroupMemberUsersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueServiceAuthTokenResponseValidationError{}
+var _ error = ListUsergroupMemberUsersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14179,33 +14282,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueServiceAuthTokenResponseValidationError{}
+} = ListUsergroupMemberUsersResponseValidationError{}
 
-// Validate checks the field values on ListServiceAuthTokensRequest with the
+// Validate checks the field values on AddUsergroupMemberUserRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServiceAuthTokensRequest) Validate() error {
+func (m *AddUsergroupMemberUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServiceAuthTokensRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListServiceAuthTokensRequestMultiError, or nil if none found.
-func (m *ListServiceAuthTokensRequest) ValidateAll() error {
+// ValidateAll checks the field values on AddUsergroupMemberUserRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddUsergroupMemberUserRequestMultiError, or nil if none found.
+func (m *AddUsergroupMemberUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServiceAuthTokensRequest) validate(all bool) error {
+func (m *AddUsergroupMemberUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := ListServiceAuthTokensRequestValidationError{
-			field:  "OrganizationName",
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := AddUsergroupMemberUserRequestValidationError{
+			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -14214,22 +14317,93 @@ func (m *ListServiceAuthTokensRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ServiceName
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := AddUsergroupMemberUserRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = AddUsergroupMemberUserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ListServiceAuthTokensRequestMultiError(errors)
+		return AddUsergroupMemberUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServiceAuthTokensRequestMultiError is an error wrapping multiple
-// validation errors returned by ListServiceAuthTokensRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListServiceAuthTokensRequestMultiError []error
+func (m *AddUsergroupMemberUserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AddUsergroupMemberUserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// AddUsergroupMemberUserRequestMultiError is an error wrapping multiple
+// validation errors returned by AddUsergroupMemberUserRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AddUsergroupMemberUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServiceAuthTokensRequestMultiError) Error() string {
+func (m AddUsergroupMemberUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14238,12 +14412,12 @@ func (m ListServiceAuthTokensRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServiceAuthTokensRequestMultiError) AllErrors() []error { return m }
+func (m AddUsergroupMemberUserRequestMultiError) AllErrors() []error { return m }
 
-// ListServiceAuthTokensRequestValidationError is the validation error returned
-// by ListServiceAuthTokensRequest.Validate if the designated constraints
-// aren't met.
-type ListServiceAuthTokensRequestValidationError struct {
+// AddUsergroupMemberUserRequestValidationError is the validation error
+// returned by AddUsergroupMemberUserRequest.Validate if the designated
+// constraints aren't met.
+type AddUsergroupMemberUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14251,24 +14425,24 @@ type ListServiceAuthTokensRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServiceAuthTokensRequestValidationError) Field() string { return e.field }
+func (e AddUsergroupMemberUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServiceAuthTokensRequestValidationError) Reason() string { return e.reason }
+func (e AddUsergroupMemberUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServiceAuthTokensRequestValidationError) Cause() error { return e.cause }
+func (e AddUsergroupMemberUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServiceAuthTokensRequestValidationError) Key() bool { return e.key }
+func (e AddUsergroupMemberUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServiceAuthTokensRequestValidationError) ErrorName() string {
-	return "ListServiceAuthTokensRequestValidationError"
+func (e AddUsergroupMemberUserRequestValidationError) ErrorName() string {
+	return "AddUsergroupMemberUserRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServiceAuthTokensRequestValidationError) Error() string {
+func (e AddUsergroupMemberUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14280,14 +14454,14 @@ func (e ListServiceAuthTokensRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServiceAuthTokensRequest.%s: %s%s",
+		"invalid %sAddUsergroupMemberUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServiceAuthTokensRequestValidationError{}
+var _ error = AddUsergroupMemberUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14295,78 +14469,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServiceAuthTokensRequestValidationError{}
+} = AddUsergroupMemberUserRequestValidationError{}
 
-// Validate checks the field values on ListServiceAuthTokensResponse with the
+// Validate checks the field values on AddUsergroupMemberUserResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServiceAuthTokensResponse) Validate() error {
+func (m *AddUsergroupMemberUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServiceAuthTokensResponse with
+// ValidateAll checks the field values on AddUsergroupMemberUserResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ListServiceAuthTokensResponseMultiError, or nil if none found.
-func (m *ListServiceAuthTokensResponse) ValidateAll() error {
+// AddUsergroupMemberUserResponseMultiError, or nil if none found.
+func (m *AddUsergroupMemberUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServiceAuthTokensResponse) validate(all bool) error {
+func (m *AddUsergroupMemberUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTokens() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListServiceAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListServiceAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListServiceAuthTokensResponseValidationError{
-					field:  fmt.Sprintf("Tokens[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if len(errors) > 0 {
-		return ListServiceAuthTokensResponseMultiError(errors)
-	}
+	if len(errors) > 0 {
+		return AddUsergroupMemberUserResponseMultiError(errors)
+	}
 
 	return nil
 }
 
-// ListServiceAuthTokensResponseMultiError is an error wrapping multiple
-// validation errors returned by ListServiceAuthTokensResponse.ValidateAll()
+// AddUsergroupMemberUserResponseMultiError is an error wrapping multiple
+// validation errors returned by AddUsergroupMemberUserResponse.ValidateAll()
 // if the designated constraints aren't met.
-type ListServiceAuthTokensResponseMultiError []error
+type AddUsergroupMemberUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServiceAuthTokensResponseMultiError) Error() string {
+func (m AddUsergroupMemberUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14375,12 +14515,12 @@ func (m ListServiceAuthTokensResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServiceAuthTokensResponseMultiError) AllErrors() []error { return m }
+func (m AddUsergroupMemberUserResponseMultiError) AllErrors() []error { return m }
 
-// ListServiceAuthTokensResponseValidationError is the validation error
-// returned by ListServiceAuthTokensResponse.Validate if the designated
+// AddUsergroupMemberUserResponseValidationError is the validation error
+// returned by AddUsergroupMemberUserResponse.Validate if the designated
 // constraints aren't met.
-type ListServiceAuthTokensResponseValidationError struct {
+type AddUsergroupMemberUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14388,24 +14528,24 @@ type ListServiceAuthTokensResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServiceAuthTokensResponseValidationError) Field() string { return e.field }
+func (e AddUsergroupMemberUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServiceAuthTokensResponseValidationError) Reason() string { return e.reason }
+func (e AddUsergroupMemberUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServiceAuthTokensResponseValidationError) Cause() error { return e.cause }
+func (e AddUsergroupMemberUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServiceAuthTokensResponseValidationError) Key() bool { return e.key }
+func (e AddUsergroupMemberUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServiceAuthTokensResponseValidationError) ErrorName() string {
-	return "ListServiceAuthTokensResponseValidationError"
+func (e AddUsergroupMemberUserResponseValidationError) ErrorName() string {
+	return "AddUsergroupMemberUserResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServiceAuthTokensResponseValidationError) Error() string {
+func (e AddUsergroupMemberUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14417,14 +14557,14 @@ func (e ListServiceAuthTokensResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServiceAuthTokensResponse.%s: %s%s",
+		"invalid %sAddUsergroupMemberUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServiceAuthTokensResponseValidationError{}
+var _ error = AddUsergroupMemberUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14432,46 +14572,130 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServiceAuthTokensResponseValidationError{}
+} = AddUsergroupMemberUserResponseValidationError{}
 
-// Validate checks the field values on GetGithubRepoStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubRepoStatusRequest) Validate() error {
+// Validate checks the field values on RemoveUsergroupMemberUserRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveUsergroupMemberUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubRepoStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGithubRepoStatusRequestMultiError, or nil if none found.
-func (m *GetGithubRepoStatusRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveUsergroupMemberUserRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveUsergroupMemberUserRequestMultiError, or nil if none found.
+func (m *RemoveUsergroupMemberUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubRepoStatusRequest) validate(all bool) error {
+func (m *RemoveUsergroupMemberUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for GithubUrl
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveUsergroupMemberUserRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RemoveUsergroupMemberUserRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RemoveUsergroupMemberUserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetGithubRepoStatusRequestMultiError(errors)
+		return RemoveUsergroupMemberUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubRepoStatusRequestMultiError is an error wrapping multiple
-// validation errors returned by GetGithubRepoStatusRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubRepoStatusRequestMultiError []error
+func (m *RemoveUsergroupMemberUserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *RemoveUsergroupMemberUserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// RemoveUsergroupMemberUserRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveUsergroupMemberUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveUsergroupMemberUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubRepoStatusRequestMultiError) Error() string {
+func (m RemoveUsergroupMemberUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14480,11 +14704,12 @@ func (m GetGithubRepoStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubRepoStatusRequestMultiError) AllErrors() []error { return m }
+func (m RemoveUsergroupMemberUserRequestMultiError) AllErrors() []error { return m }
 
-// GetGithubRepoStatusRequestValidationError is the validation error returned
-// by GetGithubRepoStatusRequest.Validate if the designated constraints aren't met.
-type GetGithubRepoStatusRequestValidationError struct {
+// RemoveUsergroupMemberUserRequestValidationError is the validation error
+// returned by RemoveUsergroupMemberUserRequest.Validate if the designated
+// constraints aren't met.
+type RemoveUsergroupMemberUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14492,24 +14717,24 @@ type GetGithubRepoStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubRepoStatusRequestValidationError) Field() string { return e.field }
+func (e RemoveUsergroupMemberUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubRepoStatusRequestValidationError) Reason() string { return e.reason }
+func (e RemoveUsergroupMemberUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubRepoStatusRequestValidationError) Cause() error { return e.cause }
+func (e RemoveUsergroupMemberUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubRepoStatusRequestValidationError) Key() bool { return e.key }
+func (e RemoveUsergroupMemberUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubRepoStatusRequestValidationError) ErrorName() string {
-	return "GetGithubRepoStatusRequestValidationError"
+func (e RemoveUsergroupMemberUserRequestValidationError) ErrorName() string {
+	return "RemoveUsergroupMemberUserRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubRepoStatusRequestValidationError) Error() string {
+func (e RemoveUsergroupMemberUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14521,14 +14746,14 @@ func (e GetGithubRepoStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubRepoStatusRequest.%s: %s%s",
+		"invalid %sRemoveUsergroupMemberUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubRepoStatusRequestValidationError{}
+var _ error = RemoveUsergroupMemberUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14536,50 +14761,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubRepoStatusRequestValidationError{}
+} = RemoveUsergroupMemberUserRequestValidationError{}
 
-// Validate checks the field values on GetGithubRepoStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubRepoStatusResponse) Validate() error {
+// Validate checks the field values on RemoveUsergroupMemberUserResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveUsergroupMemberUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubRepoStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGithubRepoStatusResponseMultiError, or nil if none found.
-func (m *GetGithubRepoStatusResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemoveUsergroupMemberUserResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveUsergroupMemberUserResponseMultiError, or nil if none found.
+func (m *RemoveUsergroupMemberUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubRepoStatusResponse) validate(all bool) error {
+func (m *RemoveUsergroupMemberUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HasAccess
-
-	// no validation rules for GrantAccessUrl
-
-	// no validation rules for DefaultBranch
-
 	if len(errors) > 0 {
-		return GetGithubRepoStatusResponseMultiError(errors)
+		return RemoveUsergroupMemberUserResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubRepoStatusResponseMultiError is an error wrapping multiple
-// validation errors returned by GetGithubRepoStatusResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubRepoStatusResponseMultiError []error
+// RemoveUsergroupMemberUserResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveUsergroupMemberUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveUsergroupMemberUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubRepoStatusResponseMultiError) Error() string {
+func (m RemoveUsergroupMemberUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14588,12 +14809,12 @@ func (m GetGithubRepoStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubRepoStatusResponseMultiError) AllErrors() []error { return m }
+func (m RemoveUsergroupMemberUserResponseMultiError) AllErrors() []error { return m }
 
-// GetGithubRepoStatusResponseValidationError is the validation error returned
-// by GetGithubRepoStatusResponse.Validate if the designated constraints
-// aren't met.
-type GetGithubRepoStatusResponseValidationError struct {
+// RemoveUsergroupMemberUserResponseValidationError is the validation error
+// returned by RemoveUsergroupMemberUserResponse.Validate if the designated
+// constraints aren't met.
+type RemoveUsergroupMemberUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14601,24 +14822,24 @@ type GetGithubRepoStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubRepoStatusResponseValidationError) Field() string { return e.field }
+func (e RemoveUsergroupMemberUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubRepoStatusResponseValidationError) Reason() string { return e.reason }
+func (e RemoveUsergroupMemberUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubRepoStatusResponseValidationError) Cause() error { return e.cause }
+func (e RemoveUsergroupMemberUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubRepoStatusResponseValidationError) Key() bool { return e.key }
+func (e RemoveUsergroupMemberUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubRepoStatusResponseValidationError) ErrorName() string {
-	return "GetGithubRepoStatusResponseValidationError"
+func (e RemoveUsergroupMemberUserResponseValidationError) ErrorName() string {
+	return "RemoveUsergroupMemberUserResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubRepoStatusResponseValidationError) Error() string {
+func (e RemoveUsergroupMemberUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14630,14 +14851,14 @@ func (e GetGithubRepoStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubRepoStatusResponse.%s: %s%s",
+		"invalid %sRemoveUsergroupMemberUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubRepoStatusResponseValidationError{}
+var _ error = RemoveUsergroupMemberUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14645,44 +14866,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubRepoStatusResponseValidationError{}
+} = RemoveUsergroupMemberUserResponseValidationError{}
 
-// Validate checks the field values on GetGithubUserStatusRequest with the
+// Validate checks the field values on ListOrganizationRolesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubUserStatusRequest) Validate() error {
+func (m *ListOrganizationRolesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubUserStatusRequest with the
+// ValidateAll checks the field values on ListOrganizationRolesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetGithubUserStatusRequestMultiError, or nil if none found.
-func (m *GetGithubUserStatusRequest) ValidateAll() error {
+// ListOrganizationRolesRequestMultiError, or nil if none found.
+func (m *ListOrganizationRolesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubUserStatusRequest) validate(all bool) error {
+func (m *ListOrganizationRolesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListOrganizationRolesRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetGithubUserStatusRequestMultiError(errors)
+		return ListOrganizationRolesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubUserStatusRequestMultiError is an error wrapping multiple
-// validation errors returned by GetGithubUserStatusRequest.ValidateAll() if
+// ListOrganizationRolesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationRolesRequest.ValidateAll() if
 // the designated constraints aren't met.
-type GetGithubUserStatusRequestMultiError []error
+type ListOrganizationRolesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubUserStatusRequestMultiError) Error() string {
+func (m ListOrganizationRolesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14691,11 +14923,12 @@ func (m GetGithubUserStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubUserStatusRequestMultiError) AllErrors() []error { return m }
+func (m ListOrganizationRolesRequestMultiError) AllErrors() []error { return m }
 
-// GetGithubUserStatusRequestValidationError is the validation error returned
-// by GetGithubUserStatusRequest.Validate if the designated constraints aren't met.
-type GetGithubUserStatusRequestValidationError struct {
+// ListOrganizationRolesRequestValidationError is the validation error returned
+// by ListOrganizationRolesRequest.Validate if the designated constraints
+// aren't met.
+type ListOrganizationRolesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14703,24 +14936,24 @@ type GetGithubUserStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubUserStatusRequestValidationError) Field() string { return e.field }
+func (e ListOrganizationRolesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubUserStatusRequestValidationError) Reason() string { return e.reason }
+func (e ListOrganizationRolesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubUserStatusRequestValidationError) Cause() error { return e.cause }
+func (e ListOrganizationRolesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubUserStatusRequestValidationError) Key() bool { return e.key }
+func (e ListOrganizationRolesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubUserStatusRequestValidationError) ErrorName() string {
-	return "GetGithubUserStatusRequestValidationError"
+func (e ListOrganizationRolesRequestValidationError) ErrorName() string {
+	return "ListOrganizationRolesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubUserStatusRequestValidationError) Error() string {
+func (e ListOrganizationRolesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14732,14 +14965,14 @@ func (e GetGithubUserStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubUserStatusRequest.%s: %s%s",
+		"invalid %sListOrganizationRolesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubUserStatusRequestValidationError{}
+var _ error = ListOrganizationRolesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14747,56 +14980,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubUserStatusRequestValidationError{}
+} = ListOrganizationRolesRequestValidationError{}
 
-// Validate checks the field values on GetGithubUserStatusResponse with the
+// Validate checks the field values on ListOrganizationRolesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubUserStatusResponse) Validate() error {
+func (m *ListOrganizationRolesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubUserStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGithubUserStatusResponseMultiError, or nil if none found.
-func (m *GetGithubUserStatusResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListOrganizationRolesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListOrganizationRolesResponseMultiError, or nil if none found.
+func (m *ListOrganizationRolesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubUserStatusResponse) validate(all bool) error {
+func (m *ListOrganizationRolesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HasAccess
-
-	// no validation rules for GrantAccessUrl
-
-	// no validation rules for AccessToken
-
-	// no validation rules for Account
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
 
-	// no validation rules for UserInstallationPermission
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrganizationRolesResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrganizationRolesResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrganizationRolesResponseValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for OrganizationInstallationPermissions
+	}
 
 	if len(errors) > 0 {
-		return GetGithubUserStatusResponseMultiError(errors)
+		return ListOrganizationRolesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubUserStatusResponseMultiError is an error wrapping multiple
-// validation errors returned by GetGithubUserStatusResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubUserStatusResponseMultiError []error
+// ListOrganizationRolesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationRolesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListOrganizationRolesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubUserStatusResponseMultiError) Error() string {
+func (m ListOrganizationRolesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14805,12 +15060,12 @@ func (m GetGithubUserStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubUserStatusResponseMultiError) AllErrors() []error { return m }
+func (m ListOrganizationRolesResponseMultiError) AllErrors() []error { return m }
 
-// GetGithubUserStatusResponseValidationError is the validation error returned
-// by GetGithubUserStatusResponse.Validate if the designated constraints
-// aren't met.
-type GetGithubUserStatusResponseValidationError struct {
+// ListOrganizationRolesResponseValidationError is the validation error
+// returned by ListOrganizationRolesResponse.Validate if the designated
+// constraints aren't met.
+type ListOrganizationRolesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14818,24 +15073,24 @@ type GetGithubUserStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubUserStatusResponseValidationError) Field() string { return e.field }
+func (e ListOrganizationRolesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubUserStatusResponseValidationError) Reason() string { return e.reason }
+func (e ListOrganizationRolesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubUserStatusResponseValidationError) Cause() error { return e.cause }
+func (e ListOrganizationRolesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubUserStatusResponseValidationError) Key() bool { return e.key }
+func (e ListOrganizationRolesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubUserStatusResponseValidationError) ErrorName() string {
-	return "GetGithubUserStatusResponseValidationError"
+func (e ListOrganizationRolesResponseValidationError) ErrorName() string {
+	return "ListOrganizationRolesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubUserStatusResponseValidationError) Error() string {
+func (e ListOrganizationRolesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14847,14 +15102,14 @@ func (e GetGithubUserStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubUserStatusResponse.%s: %s%s",
+		"invalid %sListOrganizationRolesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubUserStatusResponseValidationError{}
+var _ error = ListOrganizationRolesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14862,24 +15117,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubUserStatusResponseValidationError{}
+} = ListOrganizationRolesResponseValidationError{}
 
-// Validate checks the field values on GetGitCredentialsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateOrganizationRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGitCredentialsRequest) Validate() error {
+func (m *CreateOrganizationRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGitCredentialsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGitCredentialsRequestMultiError, or nil if none found.
-func (m *GetGitCredentialsRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateOrganizationRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateOrganizationRoleRequestMultiError, or nil if none found.
+func (m *CreateOrganizationRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGitCredentialsRequest) validate(all bool) error {
+func (m *CreateOrganizationRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14887,7 +15142,7 @@ func (m *GetGitCredentialsRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := GetGitCredentialsRequestValidationError{
+		err := CreateOrganizationRoleRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -14897,9 +15152,9 @@ func (m *GetGitCredentialsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := GetGitCredentialsRequestValidationError{
-			field:  "Project",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateOrganizationRoleRequestValidationError{
+			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -14908,20 +15163,60 @@ func (m *GetGitCredentialsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetPermissions() == nil {
+		err := CreateOrganizationRoleRequestValidationError{
+			field:  "Permissions",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPermissions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOrganizationRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOrganizationRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermissions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOrganizationRoleRequestValidationError{
+				field:  "Permissions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return GetGitCredentialsRequestMultiError(errors)
+		return CreateOrganizationRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGitCredentialsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetGitCredentialsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetGitCredentialsRequestMultiError []error
+// CreateOrganizationRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateOrganizationRoleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateOrganizationRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGitCredentialsRequestMultiError) Error() string {
+func (m CreateOrganizationRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14930,11 +15225,12 @@ func (m GetGitCredentialsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGitCredentialsRequestMultiError) AllErrors() []error { return m }
+func (m CreateOrganizationRoleRequestMultiError) AllErrors() []error { return m }
 
-// GetGitCredentialsRequestValidationError is the validation error returned by
-// GetGitCredentialsRequest.Validate if the designated constraints aren't met.
-type GetGitCredentialsRequestValidationError struct {
+// CreateOrganizationRoleRequestValidationError is the validation error
+// returned by CreateOrganizationRoleRequest.Validate if the designated
+// constraints aren't met.
+type CreateOrganizationRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14942,24 +15238,24 @@ type GetGitCredentialsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGitCredentialsRequestValidationError) Field() string { return e.field }
+func (e CreateOrganizationRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGitCredentialsRequestValidationError) Reason() string { return e.reason }
+func (e CreateOrganizationRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGitCredentialsRequestValidationError) Cause() error { return e.cause }
+func (e CreateOrganizationRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGitCredentialsRequestValidationError) Key() bool { return e.key }
+func (e CreateOrganizationRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGitCredentialsRequestValidationError) ErrorName() string {
-	return "GetGitCredentialsRequestValidationError"
+func (e CreateOrganizationRoleRequestValidationError) ErrorName() string {
+	return "CreateOrganizationRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGitCredentialsRequestValidationError) Error() string {
+func (e CreateOrganizationRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14971,14 +15267,14 @@ func (e GetGitCredentialsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGitCredentialsRequest.%s: %s%s",
+		"invalid %sCreateOrganizationRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGitCredentialsRequestValidationError{}
+var _ error = CreateOrganizationRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14986,54 +15282,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGitCredentialsRequestValidationError{}
+} = CreateOrganizationRoleRequestValidationError{}
 
-// Validate checks the field values on GetGitCredentialsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateOrganizationRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGitCredentialsResponse) Validate() error {
+func (m *CreateOrganizationRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGitCredentialsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGitCredentialsResponseMultiError, or nil if none found.
-func (m *GetGitCredentialsResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateOrganizationRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateOrganizationRoleResponseMultiError, or nil if none found.
+func (m *CreateOrganizationRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGitCredentialsResponse) validate(all bool) error {
+func (m *CreateOrganizationRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RepoUrl
-
-	// no validation rules for Username
-
-	// no validation rules for Password
-
-	// no validation rules for Subpath
-
-	// no validation rules for ProdBranch
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOrganizationRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOrganizationRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOrganizationRoleResponseValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetGitCredentialsResponseMultiError(errors)
+		return CreateOrganizationRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGitCredentialsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetGitCredentialsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetGitCredentialsResponseMultiError []error
+// CreateOrganizationRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateOrganizationRoleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateOrganizationRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGitCredentialsResponseMultiError) Error() string {
+func (m CreateOrganizationRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15042,11 +15357,12 @@ func (m GetGitCredentialsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGitCredentialsResponseMultiError) AllErrors() []error { return m }
+func (m CreateOrganizationRoleResponseMultiError) AllErrors() []error { return m }
 
-// GetGitCredentialsResponseValidationError is the validation error returned by
-// GetGitCredentialsResponse.Validate if the designated constraints aren't met.
-type GetGitCredentialsResponseValidationError struct {
+// CreateOrganizationRoleResponseValidationError is the validation error
+// returned by CreateOrganizationRoleResponse.Validate if the designated
+// constraints aren't met.
+type CreateOrganizationRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15054,24 +15370,24 @@ type GetGitCredentialsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGitCredentialsResponseValidationError) Field() string { return e.field }
+func (e CreateOrganizationRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGitCredentialsResponseValidationError) Reason() string { return e.reason }
+func (e CreateOrganizationRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGitCredentialsResponseValidationError) Cause() error { return e.cause }
+func (e CreateOrganizationRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGitCredentialsResponseValidationError) Key() bool { return e.key }
+func (e CreateOrganizationRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGitCredentialsResponseValidationError) ErrorName() string {
-	return "GetGitCredentialsResponseValidationError"
+func (e CreateOrganizationRoleResponseValidationError) ErrorName() string {
+	return "CreateOrganizationRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGitCredentialsResponseValidationError) Error() string {
+func (e CreateOrganizationRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15083,14 +15399,14 @@ func (e GetGitCredentialsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGitCredentialsResponse.%s: %s%s",
+		"invalid %sCreateOrganizationRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGitCredentialsResponseValidationError{}
+var _ error = CreateOrganizationRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15098,24 +15414,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGitCredentialsResponseValidationError{}
+} = CreateOrganizationRoleResponseValidationError{}
 
-// Validate checks the field values on CreateWhitelistedDomainRequest with the
+// Validate checks the field values on UpdateOrganizationRoleRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateWhitelistedDomainRequest) Validate() error {
+func (m *UpdateOrganizationRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateWhitelistedDomainRequest with
+// ValidateAll checks the field values on UpdateOrganizationRoleRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// CreateWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *CreateWhitelistedDomainRequest) ValidateAll() error {
+// UpdateOrganizationRoleRequestMultiError, or nil if none found.
+func (m *UpdateOrganizationRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
+func (m *UpdateOrganizationRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15123,7 +15439,7 @@ func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
+		err := UpdateOrganizationRoleRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -15133,9 +15449,9 @@ func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Domain",
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := UpdateOrganizationRoleRequestValidationError{
+			field:  "Role",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -15144,31 +15460,53 @@ func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetPermissions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateOrganizationRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateOrganizationRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetPermissions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateOrganizationRoleRequestValidationError{
+				field:  "Permissions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
+	}
+
+	if m.NewName != nil {
+		// no validation rules for NewName
 	}
 
 	if len(errors) > 0 {
-		return CreateWhitelistedDomainRequestMultiError(errors)
+		return UpdateOrganizationRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateWhitelistedDomainRequestMultiError is an error wrapping multiple
-// validation errors returned by CreateWhitelistedDomainRequest.ValidateAll()
+// UpdateOrganizationRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateOrganizationRoleRequest.ValidateAll()
 // if the designated constraints aren't met.
-type CreateWhitelistedDomainRequestMultiError []error
+type UpdateOrganizationRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateWhitelistedDomainRequestMultiError) Error() string {
+func (m UpdateOrganizationRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15177,12 +15515,12 @@ func (m CreateWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m UpdateOrganizationRoleRequestMultiError) AllErrors() []error { return m }
 
-// CreateWhitelistedDomainRequestValidationError is the validation error
-// returned by CreateWhitelistedDomainRequest.Validate if the designated
+// UpdateOrganizationRoleRequestValidationError is the validation error
+// returned by UpdateOrganizationRoleRequest.Validate if the designated
 // constraints aren't met.
-type CreateWhitelistedDomainRequestValidationError struct {
+type UpdateOrganizationRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15190,24 +15528,24 @@ type CreateWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e UpdateOrganizationRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e UpdateOrganizationRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e UpdateOrganizationRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e UpdateOrganizationRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "CreateWhitelistedDomainRequestValidationError"
+func (e UpdateOrganizationRoleRequestValidationError) ErrorName() string {
+	return "UpdateOrganizationRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateWhitelistedDomainRequestValidationError) Error() string {
+func (e UpdateOrganizationRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15219,14 +15557,14 @@ func (e CreateWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sUpdateOrganizationRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateWhitelistedDomainRequestValidationError{}
+var _ error = UpdateOrganizationRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15234,44 +15572,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateWhitelistedDomainRequestValidationError{}
+} = UpdateOrganizationRoleRequestValidationError{}
 
-// Validate checks the field values on CreateWhitelistedDomainResponse with the
+// Validate checks the field values on UpdateOrganizationRoleResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateWhitelistedDomainResponse) Validate() error {
+func (m *UpdateOrganizationRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateWhitelistedDomainResponse with
+// ValidateAll checks the field values on UpdateOrganizationRoleResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// CreateWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *CreateWhitelistedDomainResponse) ValidateAll() error {
+// UpdateOrganizationRoleResponseMultiError, or nil if none found.
+func (m *UpdateOrganizationRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateWhitelistedDomainResponse) validate(all bool) error {
+func (m *UpdateOrganizationRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return CreateWhitelistedDomainResponseMultiError(errors)
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateOrganizationRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateOrganizationRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateOrganizationRoleResponseValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateOrganizationRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateWhitelistedDomainResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateWhitelistedDomainResponse.ValidateAll()
+// UpdateOrganizationRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateOrganizationRoleResponse.ValidateAll()
 // if the designated constraints aren't met.
-type CreateWhitelistedDomainResponseMultiError []error
+type UpdateOrganizationRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateWhitelistedDomainResponseMultiError) Error() string {
+func (m UpdateOrganizationRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15280,12 +15647,12 @@ func (m CreateWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m UpdateOrganizationRoleResponseMultiError) AllErrors() []error { return m }
 
-// CreateWhitelistedDomainResponseValidationError is the validation error
-// returned by CreateWhitelistedDomainResponse.Validate if the designated
+// UpdateOrganizationRoleResponseValidationError is the validation error
+// returned by UpdateOrganizationRoleResponse.Validate if the designated
 // constraints aren't met.
-type CreateWhitelistedDomainResponseValidationError struct {
+type UpdateOrganizationRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15293,24 +15660,24 @@ type CreateWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e UpdateOrganizationRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e UpdateOrganizationRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e UpdateOrganizationRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e UpdateOrganizationRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "CreateWhitelistedDomainResponseValidationError"
+func (e UpdateOrganizationRoleResponseValidationError) ErrorName() string {
+	return "UpdateOrganizationRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateWhitelistedDomainResponseValidationError) Error() string {
+func (e UpdateOrganizationRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15322,14 +15689,14 @@ func (e CreateWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sUpdateOrganizationRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateWhitelistedDomainResponseValidationError{}
+var _ error = UpdateOrganizationRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15337,24 +15704,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateWhitelistedDomainResponseValidationError{}
+} = UpdateOrganizationRoleResponseValidationError{}
 
-// Validate checks the field values on RemoveWhitelistedDomainRequest with the
+// Validate checks the field values on DeleteOrganizationRoleRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveWhitelistedDomainRequest) Validate() error {
+func (m *DeleteOrganizationRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveWhitelistedDomainRequest with
+// ValidateAll checks the field values on DeleteOrganizationRoleRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RemoveWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *RemoveWhitelistedDomainRequest) ValidateAll() error {
+// DeleteOrganizationRoleRequestMultiError, or nil if none found.
+func (m *DeleteOrganizationRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
+func (m *DeleteOrganizationRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15362,7 +15729,7 @@ func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveWhitelistedDomainRequestValidationError{
+		err := DeleteOrganizationRoleRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -15372,9 +15739,9 @@ func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := RemoveWhitelistedDomainRequestValidationError{
-			field:  "Domain",
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := DeleteOrganizationRoleRequestValidationError{
+			field:  "Role",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -15384,19 +15751,19 @@ func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RemoveWhitelistedDomainRequestMultiError(errors)
+		return DeleteOrganizationRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveWhitelistedDomainRequestMultiError is an error wrapping multiple
-// validation errors returned by RemoveWhitelistedDomainRequest.ValidateAll()
+// DeleteOrganizationRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteOrganizationRoleRequest.ValidateAll()
 // if the designated constraints aren't met.
-type RemoveWhitelistedDomainRequestMultiError []error
+type DeleteOrganizationRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
+func (m DeleteOrganizationRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15405,12 +15772,12 @@ func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m DeleteOrganizationRoleRequestMultiError) AllErrors() []error { return m }
 
-// RemoveWhitelistedDomainRequestValidationError is the validation error
-// returned by RemoveWhitelistedDomainRequest.Validate if the designated
+// DeleteOrganizationRoleRequestValidationError is the validation error
+// returned by DeleteOrganizationRoleRequest.Validate if the designated
 // constraints aren't met.
-type RemoveWhitelistedDomainRequestValidationError struct {
+type DeleteOrganizationRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15418,24 +15785,24 @@ type RemoveWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e DeleteOrganizationRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e DeleteOrganizationRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e DeleteOrganizationRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e DeleteOrganizationRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "RemoveWhitelistedDomainRequestValidationError"
+func (e DeleteOrganizationRoleRequestValidationError) ErrorName() string {
+	return "DeleteOrganizationRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
+func (e DeleteOrganizationRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15447,14 +15814,14 @@ func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sDeleteOrganizationRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveWhitelistedDomainRequestValidationError{}
+var _ error = DeleteOrganizationRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15462,24 +15829,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveWhitelistedDomainRequestValidationError{}
+} = DeleteOrganizationRoleRequestValidationError{}
 
-// Validate checks the field values on RemoveWhitelistedDomainResponse with the
+// Validate checks the field values on DeleteOrganizationRoleResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveWhitelistedDomainResponse) Validate() error {
+func (m *DeleteOrganizationRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveWhitelistedDomainResponse with
+// ValidateAll checks the field values on DeleteOrganizationRoleResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RemoveWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *RemoveWhitelistedDomainResponse) ValidateAll() error {
+// DeleteOrganizationRoleResponseMultiError, or nil if none found.
+func (m *DeleteOrganizationRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
+func (m *DeleteOrganizationRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15487,19 +15854,19 @@ func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return RemoveWhitelistedDomainResponseMultiError(errors)
+		return DeleteOrganizationRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveWhitelistedDomainResponseMultiError is an error wrapping multiple
-// validation errors returned by RemoveWhitelistedDomainResponse.ValidateAll()
+// DeleteOrganizationRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteOrganizationRoleResponse.ValidateAll()
 // if the designated constraints aren't met.
-type RemoveWhitelistedDomainResponseMultiError []error
+type DeleteOrganizationRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
+func (m DeleteOrganizationRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15508,12 +15875,12 @@ func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m DeleteOrganizationRoleResponseMultiError) AllErrors() []error { return m }
 
-// RemoveWhitelistedDomainResponseValidationError is the validation error
-// returned by RemoveWhitelistedDomainResponse.Validate if the designated
+// DeleteOrganizationRoleResponseValidationError is the validation error
+// returned by DeleteOrganizationRoleResponse.Validate if the designated
 // constraints aren't met.
-type RemoveWhitelistedDomainResponseValidationError struct {
+type DeleteOrganizationRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15521,24 +15888,24 @@ type RemoveWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e DeleteOrganizationRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e DeleteOrganizationRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e DeleteOrganizationRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e DeleteOrganizationRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "RemoveWhitelistedDomainResponseValidationError"
+func (e DeleteOrganizationRoleResponseValidationError) ErrorName() string {
+	return "DeleteOrganizationRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
+func (e DeleteOrganizationRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15550,14 +15917,14 @@ func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sDeleteOrganizationRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveWhitelistedDomainResponseValidationError{}
+var _ error = DeleteOrganizationRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15565,24 +15932,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveWhitelistedDomainResponseValidationError{}
+} = DeleteOrganizationRoleResponseValidationError{}
 
-// Validate checks the field values on ListWhitelistedDomainsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListProjectRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListWhitelistedDomainsRequest) Validate() error {
+func (m *ListProjectRolesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListWhitelistedDomainsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListWhitelistedDomainsRequestMultiError, or nil if none found.
-func (m *ListWhitelistedDomainsRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectRolesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectRolesRequestMultiError, or nil if none found.
+func (m *ListProjectRolesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
+func (m *ListProjectRolesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15590,7 +15957,7 @@ func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListWhitelistedDomainsRequestValidationError{
+		err := ListProjectRolesRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -15601,19 +15968,19 @@ func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListWhitelistedDomainsRequestMultiError(errors)
+		return ListProjectRolesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListWhitelistedDomainsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListWhitelistedDomainsRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListWhitelistedDomainsRequestMultiError []error
+// ListProjectRolesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectRolesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectRolesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListWhitelistedDomainsRequestMultiError) Error() string {
+func (m ListProjectRolesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15622,12 +15989,11 @@ func (m ListWhitelistedDomainsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
+func (m ListProjectRolesRequestMultiError) AllErrors() []error { return m }
 
-// ListWhitelistedDomainsRequestValidationError is the validation error
-// returned by ListWhitelistedDomainsRequest.Validate if the designated
-// constraints aren't met.
-type ListWhitelistedDomainsRequestValidationError struct {
+// ListProjectRolesRequestValidationError is the validation error returned by
+// ListProjectRolesRequest.Validate if the designated constraints aren't met.
+type ListProjectRolesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15635,24 +16001,24 @@ type ListWhitelistedDomainsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListWhitelistedDomainsRequestValidationError) Field() string { return e.field }
+func (e ListProjectRolesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
+func (e ListProjectRolesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectRolesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
+func (e ListProjectRolesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListWhitelistedDomainsRequestValidationError) ErrorName() string {
-	return "ListWhitelistedDomainsRequestValidationError"
+func (e ListProjectRolesRequestValidationError) ErrorName() string {
+	return "ListProjectRolesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListWhitelistedDomainsRequestValidationError) Error() string {
+func (e ListProjectRolesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15664,14 +16030,14 @@ func (e ListWhitelistedDomainsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListWhitelistedDomainsRequest.%s: %s%s",
+		"invalid %sListProjectRolesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListWhitelistedDomainsRequestValidationError{}
+var _ error = ListProjectRolesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15679,47 +16045,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListWhitelistedDomainsRequestValidationError{}
+} = ListProjectRolesRequestValidationError{}
 
-// Validate checks the field values on ListWhitelistedDomainsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListProjectRolesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListWhitelistedDomainsResponse) Validate() error {
+func (m *ListProjectRolesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListWhitelistedDomainsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListWhitelistedDomainsResponseMultiError, or nil if none found.
-func (m *ListWhitelistedDomainsResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectRolesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectRolesResponseMultiError, or nil if none found.
+func (m *ListProjectRolesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
+func (m *ListProjectRolesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDomains() {
+	for idx, item := range m.GetRoles() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
+					errors = append(errors, ListProjectRolesResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
+					errors = append(errors, ListProjectRolesResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -15727,8 +16093,8 @@ func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListWhitelistedDomainsResponseValidationError{
-					field:  fmt.Sprintf("Domains[%v]", idx),
+				return ListProjectRolesResponseValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -15738,19 +16104,19 @@ func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListWhitelistedDomainsResponseMultiError(errors)
+		return ListProjectRolesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListWhitelistedDomainsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListWhitelistedDomainsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ListWhitelistedDomainsResponseMultiError []error
+// ListProjectRolesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListProjectRolesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectRolesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListWhitelistedDomainsResponseMultiError) Error() string {
+func (m ListProjectRolesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15759,12 +16125,11 @@ func (m ListWhitelistedDomainsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectRolesResponseMultiError) AllErrors() []error { return m }
 
-// ListWhitelistedDomainsResponseValidationError is the validation error
-// returned by ListWhitelistedDomainsResponse.Validate if the designated
-// constraints aren't met.
-type ListWhitelistedDomainsResponseValidationError struct {
+// ListProjectRolesResponseValidationError is the validation error returned by
+// ListProjectRolesResponse.Validate if the designated constraints aren't met.
+type ListProjectRolesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15772,24 +16137,24 @@ type ListWhitelistedDomainsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListWhitelistedDomainsResponseValidationError) Field() string { return e.field }
+func (e ListProjectRolesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectRolesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectRolesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
+func (e ListProjectRolesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListWhitelistedDomainsResponseValidationError) ErrorName() string {
-	return "ListWhitelistedDomainsResponseValidationError"
+func (e ListProjectRolesResponseValidationError) ErrorName() string {
+	return "ListProjectRolesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListWhitelistedDomainsResponseValidationError) Error() string {
+func (e ListProjectRolesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15801,14 +16166,14 @@ func (e ListWhitelistedDomainsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListWhitelistedDomainsResponse.%s: %s%s",
+		"invalid %sListProjectRolesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListWhitelistedDomainsResponseValidationError{}
+var _ error = ListProjectRolesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15816,25 +16181,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListWhitelistedDomainsResponseValidationError{}
+} = ListProjectRolesResponseValidationError{}
 
-// Validate checks the field values on CreateProjectWhitelistedDomainRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CreateProjectWhitelistedDomainRequest) Validate() error {
+// Validate checks the field values on CreateProjectRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateProjectRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateProjectWhitelistedDomainRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// CreateProjectWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *CreateProjectWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateProjectRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateProjectRoleRequestMultiError, or nil if none found.
+func (m *CreateProjectRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProjectWhitelistedDomainRequest) validate(all bool) error {
+func (m *CreateProjectRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15842,7 +16206,7 @@ func (m *CreateProjectWhitelistedDomainRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := CreateProjectWhitelistedDomainRequestValidationError{
+		err := CreateProjectRoleRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -15852,9 +16216,9 @@ func (m *CreateProjectWhitelistedDomainRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := CreateProjectWhitelistedDomainRequestValidationError{
-			field:  "Project",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateProjectRoleRequestValidationError{
+			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -15863,10 +16227,10 @@ func (m *CreateProjectWhitelistedDomainRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := CreateProjectWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
+	if m.GetPermissions() == nil {
+		err := CreateProjectRoleRequestValidationError{
+			field:  "Permissions",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -15874,32 +16238,49 @@ func (m *CreateProjectWhitelistedDomainRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := CreateProjectWhitelistedDomainRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetPermissions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProjectRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateProjectRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetPermissions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProjectRoleRequestValidationError{
+				field:  "Permissions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreateProjectWhitelistedDomainRequestMultiError(errors)
+		return CreateProjectRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProjectWhitelistedDomainRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// CreateProjectWhitelistedDomainRequest.ValidateAll() if the designated
+// CreateProjectRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateProjectRoleRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateProjectWhitelistedDomainRequestMultiError []error
+type CreateProjectRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProjectWhitelistedDomainRequestMultiError) Error() string {
+func (m CreateProjectRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15908,12 +16289,11 @@ func (m CreateProjectWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProjectWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m CreateProjectRoleRequestMultiError) AllErrors() []error { return m }
 
-// CreateProjectWhitelistedDomainRequestValidationError is the validation error
-// returned by CreateProjectWhitelistedDomainRequest.Validate if the
-// designated constraints aren't met.
-type CreateProjectWhitelistedDomainRequestValidationError struct {
+// CreateProjectRoleRequestValidationError is the validation error returned by
+// CreateProjectRoleRequest.Validate if the designated constraints aren't met.
+type CreateProjectRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15921,24 +16301,24 @@ type CreateProjectWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProjectWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e CreateProjectRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProjectWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e CreateProjectRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProjectWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e CreateProjectRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProjectWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e CreateProjectRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProjectWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "CreateProjectWhitelistedDomainRequestValidationError"
+func (e CreateProjectRoleRequestValidationError) ErrorName() string {
+	return "CreateProjectRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateProjectWhitelistedDomainRequestValidationError) Error() string {
+func (e CreateProjectRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15950,14 +16330,14 @@ func (e CreateProjectWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProjectWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sCreateProjectRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProjectWhitelistedDomainRequestValidationError{}
+var _ error = CreateProjectRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15965,47 +16345,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProjectWhitelistedDomainRequestValidationError{}
+} = CreateProjectRoleRequestValidationError{}
 
-// Validate checks the field values on CreateProjectWhitelistedDomainResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CreateProjectWhitelistedDomainResponse) Validate() error {
+// Validate checks the field values on CreateProjectRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateProjectRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CreateProjectWhitelistedDomainResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// CreateProjectWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *CreateProjectWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateProjectRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateProjectRoleResponseMultiError, or nil if none found.
+func (m *CreateProjectRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProjectWhitelistedDomainResponse) validate(all bool) error {
+func (m *CreateProjectRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProjectRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateProjectRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProjectRoleResponseValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return CreateProjectWhitelistedDomainResponseMultiError(errors)
+		return CreateProjectRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProjectWhitelistedDomainResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// CreateProjectWhitelistedDomainResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateProjectWhitelistedDomainResponseMultiError []error
+// CreateProjectRoleResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateProjectRoleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreateProjectRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProjectWhitelistedDomainResponseMultiError) Error() string {
+func (m CreateProjectRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16014,12 +16420,11 @@ func (m CreateProjectWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProjectWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m CreateProjectRoleResponseMultiError) AllErrors() []error { return m }
 
-// CreateProjectWhitelistedDomainResponseValidationError is the validation
-// error returned by CreateProjectWhitelistedDomainResponse.Validate if the
-// designated constraints aren't met.
-type CreateProjectWhitelistedDomainResponseValidationError struct {
+// CreateProjectRoleResponseValidationError is the validation error returned by
+// CreateProjectRoleResponse.Validate if the designated constraints aren't met.
+type CreateProjectRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16027,24 +16432,24 @@ type CreateProjectWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProjectWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e CreateProjectRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProjectWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e CreateProjectRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProjectWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e CreateProjectRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProjectWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e CreateProjectRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProjectWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "CreateProjectWhitelistedDomainResponseValidationError"
+func (e CreateProjectRoleResponseValidationError) ErrorName() string {
+	return "CreateProjectRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateProjectWhitelistedDomainResponseValidationError) Error() string {
+func (e CreateProjectRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16056,14 +16461,14 @@ func (e CreateProjectWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProjectWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sCreateProjectRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProjectWhitelistedDomainResponseValidationError{}
+var _ error = CreateProjectRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16071,25 +16476,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProjectWhitelistedDomainResponseValidationError{}
+} = CreateProjectRoleResponseValidationError{}
 
-// Validate checks the field values on RemoveProjectWhitelistedDomainRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *RemoveProjectWhitelistedDomainRequest) Validate() error {
+// Validate checks the field values on UpdateProjectRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProjectRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveProjectWhitelistedDomainRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// RemoveProjectWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *RemoveProjectWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpdateProjectRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProjectRoleRequestMultiError, or nil if none found.
+func (m *UpdateProjectRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectWhitelistedDomainRequest) validate(all bool) error {
+func (m *UpdateProjectRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -16097,7 +16501,7 @@ func (m *RemoveProjectWhitelistedDomainRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveProjectWhitelistedDomainRequestValidationError{
+		err := UpdateProjectRoleRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -16107,9 +16511,9 @@ func (m *RemoveProjectWhitelistedDomainRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := RemoveProjectWhitelistedDomainRequestValidationError{
-			field:  "Project",
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := UpdateProjectRoleRequestValidationError{
+			field:  "Role",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -16118,32 +16522,53 @@ func (m *RemoveProjectWhitelistedDomainRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := RemoveProjectWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetPermissions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProjectRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProjectRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetPermissions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProjectRoleRequestValidationError{
+				field:  "Permissions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
+	}
+
+	if m.NewName != nil {
+		// no validation rules for NewName
 	}
 
 	if len(errors) > 0 {
-		return RemoveProjectWhitelistedDomainRequestMultiError(errors)
+		return UpdateProjectRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveProjectWhitelistedDomainRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// RemoveProjectWhitelistedDomainRequest.ValidateAll() if the designated
+// UpdateProjectRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProjectRoleRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveProjectWhitelistedDomainRequestMultiError []error
+type UpdateProjectRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectWhitelistedDomainRequestMultiError) Error() string {
+func (m UpdateProjectRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16152,12 +16577,11 @@ func (m RemoveProjectWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m UpdateProjectRoleRequestMultiError) AllErrors() []error { return m }
 
-// RemoveProjectWhitelistedDomainRequestValidationError is the validation error
-// returned by RemoveProjectWhitelistedDomainRequest.Validate if the
-// designated constraints aren't met.
-type RemoveProjectWhitelistedDomainRequestValidationError struct {
+// UpdateProjectRoleRequestValidationError is the validation error returned by
+// UpdateProjectRoleRequest.Validate if the designated constraints aren't met.
+type UpdateProjectRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16165,24 +16589,24 @@ type RemoveProjectWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e UpdateProjectRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e UpdateProjectRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e UpdateProjectRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e UpdateProjectRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "RemoveProjectWhitelistedDomainRequestValidationError"
+func (e UpdateProjectRoleRequestValidationError) ErrorName() string {
+	return "UpdateProjectRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Error() string {
+func (e UpdateProjectRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16194,14 +16618,14 @@ func (e RemoveProjectWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sUpdateProjectRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectWhitelistedDomainRequestValidationError{}
+var _ error = UpdateProjectRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16209,47 +16633,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectWhitelistedDomainRequestValidationError{}
+} = UpdateProjectRoleRequestValidationError{}
 
-// Validate checks the field values on RemoveProjectWhitelistedDomainResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *RemoveProjectWhitelistedDomainResponse) Validate() error {
+// Validate checks the field values on UpdateProjectRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProjectRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// RemoveProjectWhitelistedDomainResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// RemoveProjectWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *RemoveProjectWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on UpdateProjectRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProjectRoleResponseMultiError, or nil if none found.
+func (m *UpdateProjectRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectWhitelistedDomainResponse) validate(all bool) error {
+func (m *UpdateProjectRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProjectRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProjectRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProjectRoleResponseValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return RemoveProjectWhitelistedDomainResponseMultiError(errors)
+		return UpdateProjectRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveProjectWhitelistedDomainResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// RemoveProjectWhitelistedDomainResponse.ValidateAll() if the designated
-// constraints aren't met.
-type RemoveProjectWhitelistedDomainResponseMultiError []error
+// UpdateProjectRoleResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateProjectRoleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateProjectRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectWhitelistedDomainResponseMultiError) Error() string {
+func (m UpdateProjectRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16258,12 +16708,11 @@ func (m RemoveProjectWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m UpdateProjectRoleResponseMultiError) AllErrors() []error { return m }
 
-// RemoveProjectWhitelistedDomainResponseValidationError is the validation
-// error returned by RemoveProjectWhitelistedDomainResponse.Validate if the
-// designated constraints aren't met.
-type RemoveProjectWhitelistedDomainResponseValidationError struct {
+// UpdateProjectRoleResponseValidationError is the validation error returned by
+// UpdateProjectRoleResponse.Validate if the designated constraints aren't met.
+type UpdateProjectRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16271,24 +16720,24 @@ type RemoveProjectWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e UpdateProjectRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e UpdateProjectRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e UpdateProjectRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e UpdateProjectRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "RemoveProjectWhitelistedDomainResponseValidationError"
+func (e UpdateProjectRoleResponseValidationError) ErrorName() string {
+	return "UpdateProjectRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Error() string {
+func (e UpdateProjectRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16300,14 +16749,14 @@ func (e RemoveProjectWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sUpdateProjectRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectWhitelistedDomainResponseValidationError{}
+var _ error = UpdateProjectRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16315,25 +16764,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectWhitelistedDomainResponseValidationError{}
+} = UpdateProjectRoleResponseValidationError{}
 
-// Validate checks the field values on ListProjectWhitelistedDomainsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *ListProjectWhitelistedDomainsRequest) Validate() error {
+// Validate checks the field values on DeleteProjectRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProjectRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectWhitelistedDomainsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ListProjectWhitelistedDomainsRequestMultiError, or nil if none found.
-func (m *ListProjectWhitelistedDomainsRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteProjectRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProjectRoleRequestMultiError, or nil if none found.
+func (m *DeleteProjectRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectWhitelistedDomainsRequest) validate(all bool) error {
+func (m *DeleteProjectRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -16341,7 +16789,7 @@ func (m *ListProjectWhitelistedDomainsRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListProjectWhitelistedDomainsRequestValidationError{
+		err := DeleteProjectRoleRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -16351,9 +16799,9 @@ func (m *ListProjectWhitelistedDomainsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := ListProjectWhitelistedDomainsRequestValidationError{
-			field:  "Project",
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := DeleteProjectRoleRequestValidationError{
+			field:  "Role",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -16363,20 +16811,19 @@ func (m *ListProjectWhitelistedDomainsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListProjectWhitelistedDomainsRequestMultiError(errors)
+		return DeleteProjectRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectWhitelistedDomainsRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// ListProjectWhitelistedDomainsRequest.ValidateAll() if the designated
+// DeleteProjectRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteProjectRoleRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListProjectWhitelistedDomainsRequestMultiError []error
+type DeleteProjectRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectWhitelistedDomainsRequestMultiError) Error() string {
+func (m DeleteProjectRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16385,12 +16832,11 @@ func (m ListProjectWhitelistedDomainsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
+func (m DeleteProjectRoleRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectWhitelistedDomainsRequestValidationError is the validation error
-// returned by ListProjectWhitelistedDomainsRequest.Validate if the designated
-// constraints aren't met.
-type ListProjectWhitelistedDomainsRequestValidationError struct {
+// DeleteProjectRoleRequestValidationError is the validation error returned by
+// DeleteProjectRoleRequest.Validate if the designated constraints aren't met.
+type DeleteProjectRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16398,24 +16844,24 @@ type ListProjectWhitelistedDomainsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectWhitelistedDomainsRequestValidationError) Field() string { return e.field }
+func (e DeleteProjectRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
+func (e DeleteProjectRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
+func (e DeleteProjectRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
+func (e DeleteProjectRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectWhitelistedDomainsRequestValidationError) ErrorName() string {
-	return "ListProjectWhitelistedDomainsRequestValidationError"
+func (e DeleteProjectRoleRequestValidationError) ErrorName() string {
+	return "DeleteProjectRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectWhitelistedDomainsRequestValidationError) Error() string {
+func (e DeleteProjectRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16427,14 +16873,14 @@ func (e ListProjectWhitelistedDomainsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectWhitelistedDomainsRequest.%s: %s%s",
+		"invalid %sDeleteProjectRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectWhitelistedDomainsRequestValidationError{}
+var _ error = DeleteProjectRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16442,80 +16888,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectWhitelistedDomainsRequestValidationError{}
+} = DeleteProjectRoleRequestValidationError{}
 
-// Validate checks the field values on ListProjectWhitelistedDomainsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *ListProjectWhitelistedDomainsResponse) Validate() error {
+// Validate checks the field values on DeleteProjectRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProjectRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectWhitelistedDomainsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ListProjectWhitelistedDomainsResponseMultiError, or nil if none found.
-func (m *ListProjectWhitelistedDomainsResponse) ValidateAll() error {
+// ValidateAll checks the field values on DeleteProjectRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProjectRoleResponseMultiError, or nil if none found.
+func (m *DeleteProjectRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectWhitelistedDomainsResponse) validate(all bool) error {
+func (m *DeleteProjectRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDomains() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListProjectWhitelistedDomainsResponseValidationError{
-					field:  fmt.Sprintf("Domains[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if len(errors) > 0 {
-		return ListProjectWhitelistedDomainsResponseMultiError(errors)
-	}
+	if len(errors) > 0 {
+		return DeleteProjectRoleResponseMultiError(errors)
+	}
 
 	return nil
 }
 
-// ListProjectWhitelistedDomainsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// ListProjectWhitelistedDomainsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListProjectWhitelistedDomainsResponseMultiError []error
+// DeleteProjectRoleResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteProjectRoleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteProjectRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectWhitelistedDomainsResponseMultiError) Error() string {
+func (m DeleteProjectRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16524,12 +16934,11 @@ func (m ListProjectWhitelistedDomainsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
+func (m DeleteProjectRoleResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectWhitelistedDomainsResponseValidationError is the validation error
-// returned by ListProjectWhitelistedDomainsResponse.Validate if the
-// designated constraints aren't met.
-type ListProjectWhitelistedDomainsResponseValidationError struct {
+// DeleteProjectRoleResponseValidationError is the validation error returned by
+// DeleteProjectRoleResponse.Validate if the designated constraints aren't met.
+type DeleteProjectRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16537,24 +16946,24 @@ type ListProjectWhitelistedDomainsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectWhitelistedDomainsResponseValidationError) Field() string { return e.field }
+func (e DeleteProjectRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
+func (e DeleteProjectRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
+func (e DeleteProjectRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
+func (e DeleteProjectRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectWhitelistedDomainsResponseValidationError) ErrorName() string {
-	return "ListProjectWhitelistedDomainsResponseValidationError"
+func (e DeleteProjectRoleResponseValidationError) ErrorName() string {
+	return "DeleteProjectRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectWhitelistedDomainsResponseValidationError) Error() string {
+func (e DeleteProjectRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16566,14 +16975,14 @@ func (e ListProjectWhitelistedDomainsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectWhitelistedDomainsResponse.%s: %s%s",
+		"invalid %sDeleteProjectRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectWhitelistedDomainsResponseValidationError{}
+var _ error = DeleteProjectRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16581,48 +16990,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectWhitelistedDomainsResponseValidationError{}
+} = DeleteProjectRoleResponseValidationError{}
 
-// Validate checks the field values on GetRepoMetaRequest with the rules
+// Validate checks the field values on GetCurrentUserRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRepoMetaRequest) Validate() error {
+func (m *GetCurrentUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRepoMetaRequest with the rules
+// ValidateAll checks the field values on GetCurrentUserRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRepoMetaRequestMultiError, or nil if none found.
-func (m *GetRepoMetaRequest) ValidateAll() error {
+// GetCurrentUserRequestMultiError, or nil if none found.
+func (m *GetCurrentUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRepoMetaRequest) validate(all bool) error {
+func (m *GetCurrentUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
-
-	// no validation rules for Branch
-
 	if len(errors) > 0 {
-		return GetRepoMetaRequestMultiError(errors)
+		return GetCurrentUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRepoMetaRequestMultiError is an error wrapping multiple validation errors
-// returned by GetRepoMetaRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetRepoMetaRequestMultiError []error
+// GetCurrentUserRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCurrentUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCurrentUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRepoMetaRequestMultiError) Error() string {
+func (m GetCurrentUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16631,11 +17036,11 @@ func (m GetRepoMetaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRepoMetaRequestMultiError) AllErrors() []error { return m }
+func (m GetCurrentUserRequestMultiError) AllErrors() []error { return m }
 
-// GetRepoMetaRequestValidationError is the validation error returned by
-// GetRepoMetaRequest.Validate if the designated constraints aren't met.
-type GetRepoMetaRequestValidationError struct {
+// GetCurrentUserRequestValidationError is the validation error returned by
+// GetCurrentUserRequest.Validate if the designated constraints aren't met.
+type GetCurrentUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16643,24 +17048,24 @@ type GetRepoMetaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRepoMetaRequestValidationError) Field() string { return e.field }
+func (e GetCurrentUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRepoMetaRequestValidationError) Reason() string { return e.reason }
+func (e GetCurrentUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRepoMetaRequestValidationError) Cause() error { return e.cause }
+func (e GetCurrentUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRepoMetaRequestValidationError) Key() bool { return e.key }
+func (e GetCurrentUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRepoMetaRequestValidationError) ErrorName() string {
-	return "GetRepoMetaRequestValidationError"
+func (e GetCurrentUserRequestValidationError) ErrorName() string {
+	return "GetCurrentUserRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRepoMetaRequestValidationError) Error() string {
+func (e GetCurrentUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16672,14 +17077,14 @@ func (e GetRepoMetaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRepoMetaRequest.%s: %s%s",
+		"invalid %sGetCurrentUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRepoMetaRequestValidationError{}
+var _ error = GetCurrentUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16687,77 +17092,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRepoMetaRequestValidationError{}
+} = GetCurrentUserRequestValidationError{}
 
-// Validate checks the field values on GetRepoMetaResponse with the rules
+// Validate checks the field values on GetCurrentUserResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRepoMetaResponse) Validate() error {
+func (m *GetCurrentUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRepoMetaResponse with the rules
+// ValidateAll checks the field values on GetCurrentUserResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRepoMetaResponseMultiError, or nil if none found.
-func (m *GetRepoMetaResponse) ValidateAll() error {
+// GetCurrentUserResponseMultiError, or nil if none found.
+func (m *GetCurrentUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRepoMetaResponse) validate(all bool) error {
+func (m *GetCurrentUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for GitUrl
-
 	if all {
-		switch v := interface{}(m.GetGitUrlExpiresOn()).(type) {
+		switch v := interface{}(m.GetUser()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetRepoMetaResponseValidationError{
-					field:  "GitUrlExpiresOn",
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "User",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetRepoMetaResponseValidationError{
-					field:  "GitUrlExpiresOn",
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "User",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetGitUrlExpiresOn()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetRepoMetaResponseValidationError{
-				field:  "GitUrlExpiresOn",
+			return GetCurrentUserResponseValidationError{
+				field:  "User",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for GitSubpath
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCurrentUserResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetRepoMetaResponseMultiError(errors)
+		return GetCurrentUserResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRepoMetaResponseMultiError is an error wrapping multiple validation
-// errors returned by GetRepoMetaResponse.ValidateAll() if the designated
+// GetCurrentUserResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCurrentUserResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetRepoMetaResponseMultiError []error
+type GetCurrentUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRepoMetaResponseMultiError) Error() string {
+func (m GetCurrentUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16766,11 +17196,11 @@ func (m GetRepoMetaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRepoMetaResponseMultiError) AllErrors() []error { return m }
+func (m GetCurrentUserResponseMultiError) AllErrors() []error { return m }
 
-// GetRepoMetaResponseValidationError is the validation error returned by
-// GetRepoMetaResponse.Validate if the designated constraints aren't met.
-type GetRepoMetaResponseValidationError struct {
+// GetCurrentUserResponseValidationError is the validation error returned by
+// GetCurrentUserResponse.Validate if the designated constraints aren't met.
+type GetCurrentUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16778,24 +17208,24 @@ type GetRepoMetaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRepoMetaResponseValidationError) Field() string { return e.field }
+func (e GetCurrentUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRepoMetaResponseValidationError) Reason() string { return e.reason }
+func (e GetCurrentUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRepoMetaResponseValidationError) Cause() error { return e.cause }
+func (e GetCurrentUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRepoMetaResponseValidationError) Key() bool { return e.key }
+func (e GetCurrentUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRepoMetaResponseValidationError) ErrorName() string {
-	return "GetRepoMetaResponseValidationError"
+func (e GetCurrentUserResponseValidationError) ErrorName() string {
+	return "GetCurrentUserResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRepoMetaResponseValidationError) Error() string {
+func (e GetCurrentUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16807,14 +17237,14 @@ func (e GetRepoMetaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRepoMetaResponse.%s: %s%s",
+		"invalid %sGetCurrentUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRepoMetaResponseValidationError{}
+var _ error = GetCurrentUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16822,65 +17252,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRepoMetaResponseValidationError{}
+} = GetCurrentUserResponseValidationError{}
 
-// Validate checks the field values on PullVirtualRepoRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PullVirtualRepoRequest) Validate() error {
+// Validate checks the field values on GetUserRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PullVirtualRepoRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PullVirtualRepoRequestMultiError, or nil if none found.
-func (m *PullVirtualRepoRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserRequestMultiError,
+// or nil if none found.
+func (m *GetUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PullVirtualRepoRequest) validate(all bool) error {
+func (m *GetUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
-
-	// no validation rules for Branch
-
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 100 {
-			err := PullVirtualRepoRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 100",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = GetUserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return PullVirtualRepoRequestMultiError(errors)
+		return GetUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PullVirtualRepoRequestMultiError is an error wrapping multiple validation
-// errors returned by PullVirtualRepoRequest.ValidateAll() if the designated
-// constraints aren't met.
-type PullVirtualRepoRequestMultiError []error
-
+func (m *GetUserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *GetUserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// GetUserRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserRequestMultiError []error
+
 // Error returns a concatenation of all the error messages it wraps.
-func (m PullVirtualRepoRequestMultiError) Error() string {
+func (m GetUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16889,11 +17360,11 @@ func (m PullVirtualRepoRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PullVirtualRepoRequestMultiError) AllErrors() []error { return m }
+func (m GetUserRequestMultiError) AllErrors() []error { return m }
 
-// PullVirtualRepoRequestValidationError is the validation error returned by
-// PullVirtualRepoRequest.Validate if the designated constraints aren't met.
-type PullVirtualRepoRequestValidationError struct {
+// GetUserRequestValidationError is the validation error returned by
+// GetUserRequest.Validate if the designated constraints aren't met.
+type GetUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16901,24 +17372,22 @@ type PullVirtualRepoRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PullVirtualRepoRequestValidationError) Field() string { return e.field }
+func (e GetUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PullVirtualRepoRequestValidationError) Reason() string { return e.reason }
+func (e GetUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PullVirtualRepoRequestValidationError) Cause() error { return e.cause }
+func (e GetUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PullVirtualRepoRequestValidationError) Key() bool { return e.key }
+func (e GetUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PullVirtualRepoRequestValidationError) ErrorName() string {
-	return "PullVirtualRepoRequestValidationError"
-}
+func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PullVirtualRepoRequestValidationError) Error() string {
+func (e GetUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16930,14 +17399,14 @@ func (e PullVirtualRepoRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPullVirtualRepoRequest.%s: %s%s",
+		"invalid %sGetUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PullVirtualRepoRequestValidationError{}
+var _ error = GetUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16945,80 +17414,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PullVirtualRepoRequestValidationError{}
+} = GetUserRequestValidationError{}
 
-// Validate checks the field values on PullVirtualRepoResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PullVirtualRepoResponse) Validate() error {
+// Validate checks the field values on GetUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PullVirtualRepoResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PullVirtualRepoResponseMultiError, or nil if none found.
-func (m *PullVirtualRepoResponse) ValidateAll() error {
+// GetUserResponseMultiError, or nil if none found.
+func (m *GetUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PullVirtualRepoResponse) validate(all bool) error {
+func (m *GetUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetFiles() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PullVirtualRepoResponseValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PullVirtualRepoResponseValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return PullVirtualRepoResponseValidationError{
-					field:  fmt.Sprintf("Files[%v]", idx),
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return PullVirtualRepoResponseMultiError(errors)
+		return GetUserResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PullVirtualRepoResponseMultiError is an error wrapping multiple validation
-// errors returned by PullVirtualRepoResponse.ValidateAll() if the designated
-// constraints aren't met.
-type PullVirtualRepoResponseMultiError []error
+// GetUserResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PullVirtualRepoResponseMultiError) Error() string {
+func (m GetUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17027,11 +17489,11 @@ func (m PullVirtualRepoResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PullVirtualRepoResponseMultiError) AllErrors() []error { return m }
+func (m GetUserResponseMultiError) AllErrors() []error { return m }
 
-// PullVirtualRepoResponseValidationError is the validation error returned by
-// PullVirtualRepoResponse.Validate if the designated constraints aren't met.
-type PullVirtualRepoResponseValidationError struct {
+// GetUserResponseValidationError is the validation error returned by
+// GetUserResponse.Validate if the designated constraints aren't met.
+type GetUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17039,24 +17501,22 @@ type PullVirtualRepoResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PullVirtualRepoResponseValidationError) Field() string { return e.field }
+func (e GetUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PullVirtualRepoResponseValidationError) Reason() string { return e.reason }
+func (e GetUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PullVirtualRepoResponseValidationError) Cause() error { return e.cause }
+func (e GetUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PullVirtualRepoResponseValidationError) Key() bool { return e.key }
+func (e GetUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PullVirtualRepoResponseValidationError) ErrorName() string {
-	return "PullVirtualRepoResponseValidationError"
-}
+func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PullVirtualRepoResponseValidationError) Error() string {
+func (e GetUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17068,14 +17528,14 @@ func (e PullVirtualRepoResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPullVirtualRepoResponse.%s: %s%s",
+		"invalid %sGetUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PullVirtualRepoResponseValidationError{}
+var _ error = GetUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17083,81 +17543,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PullVirtualRepoResponseValidationError{}
+} = GetUserResponseValidationError{}
 
-// Validate checks the field values on GetReportMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReportMetaRequest) Validate() error {
+// Validate checks the field values on UserPreferences with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserPreferences) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReportMetaRequest with the rules
+// ValidateAll checks the field values on UserPreferences with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReportMetaRequestMultiError, or nil if none found.
-func (m *GetReportMetaRequest) ValidateAll() error {
+// UserPreferencesMultiError, or nil if none found.
+func (m *UserPreferences) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReportMetaRequest) validate(all bool) error {
+func (m *UserPreferences) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
-
-	// no validation rules for Branch
-
-	// no validation rules for Report
-
-	// no validation rules for Annotations
-
-	if all {
-		switch v := interface{}(m.GetExecutionTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetReportMetaRequestValidationError{
-					field:  "ExecutionTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetReportMetaRequestValidationError{
-					field:  "ExecutionTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetExecutionTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetReportMetaRequestValidationError{
-				field:  "ExecutionTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if m.TimeZone != nil {
+		// no validation rules for TimeZone
 	}
 
 	if len(errors) > 0 {
-		return GetReportMetaRequestMultiError(errors)
+		return UserPreferencesMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReportMetaRequestMultiError is an error wrapping multiple validation
-// errors returned by GetReportMetaRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetReportMetaRequestMultiError []error
+// UserPreferencesMultiError is an error wrapping multiple validation errors
+// returned by UserPreferences.ValidateAll() if the designated constraints
+// aren't met.
+type UserPreferencesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReportMetaRequestMultiError) Error() string {
+func (m UserPreferencesMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17166,11 +17593,11 @@ func (m GetReportMetaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReportMetaRequestMultiError) AllErrors() []error { return m }
+func (m UserPreferencesMultiError) AllErrors() []error { return m }
 
-// GetReportMetaRequestValidationError is the validation error returned by
-// GetReportMetaRequest.Validate if the designated constraints aren't met.
-type GetReportMetaRequestValidationError struct {
+// UserPreferencesValidationError is the validation error returned by
+// UserPreferences.Validate if the designated constraints aren't met.
+type UserPreferencesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17178,24 +17605,22 @@ type GetReportMetaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReportMetaRequestValidationError) Field() string { return e.field }
+func (e UserPreferencesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReportMetaRequestValidationError) Reason() string { return e.reason }
+func (e UserPreferencesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReportMetaRequestValidationError) Cause() error { return e.cause }
+func (e UserPreferencesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReportMetaRequestValidationError) Key() bool { return e.key }
+func (e UserPreferencesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReportMetaRequestValidationError) ErrorName() string {
-	return "GetReportMetaRequestValidationError"
-}
+func (e UserPreferencesValidationError) ErrorName() string { return "UserPreferencesValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetReportMetaRequestValidationError) Error() string {
+func (e UserPreferencesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17207,14 +17632,14 @@ func (e GetReportMetaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReportMetaRequest.%s: %s%s",
+		"invalid %sUserPreferences.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReportMetaRequestValidationError{}
+var _ error = UserPreferencesValidationError{}
 
 var _ interface {
 	Field() string
@@ -17222,50 +17647,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReportMetaRequestValidationError{}
+} = UserPreferencesValidationError{}
 
-// Validate checks the field values on GetReportMetaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateUserPreferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReportMetaResponse) Validate() error {
+func (m *UpdateUserPreferencesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReportMetaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateUserPreferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReportMetaResponseMultiError, or nil if none found.
-func (m *GetReportMetaResponse) ValidateAll() error {
+// UpdateUserPreferencesRequestMultiError, or nil if none found.
+func (m *UpdateUserPreferencesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReportMetaResponse) validate(all bool) error {
+func (m *UpdateUserPreferencesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OpenUrl
-
-	// no validation rules for ExportUrl
-
-	// no validation rules for EditUrl
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserPreferencesRequestValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetReportMetaResponseMultiError(errors)
+		return UpdateUserPreferencesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReportMetaResponseMultiError is an error wrapping multiple validation
-// errors returned by GetReportMetaResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetReportMetaResponseMultiError []error
+// UpdateUserPreferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPreferencesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateUserPreferencesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReportMetaResponseMultiError) Error() string {
+func (m UpdateUserPreferencesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17274,11 +17722,12 @@ func (m GetReportMetaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReportMetaResponseMultiError) AllErrors() []error { return m }
+func (m UpdateUserPreferencesRequestMultiError) AllErrors() []error { return m }
 
-// GetReportMetaResponseValidationError is the validation error returned by
-// GetReportMetaResponse.Validate if the designated constraints aren't met.
-type GetReportMetaResponseValidationError struct {
+// UpdateUserPreferencesRequestValidationError is the validation error returned
+// by UpdateUserPreferencesRequest.Validate if the designated constraints
+// aren't met.
+type UpdateUserPreferencesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17286,24 +17735,24 @@ type GetReportMetaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReportMetaResponseValidationError) Field() string { return e.field }
+func (e UpdateUserPreferencesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReportMetaResponseValidationError) Reason() string { return e.reason }
+func (e UpdateUserPreferencesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReportMetaResponseValidationError) Cause() error { return e.cause }
+func (e UpdateUserPreferencesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReportMetaResponseValidationError) Key() bool { return e.key }
+func (e UpdateUserPreferencesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReportMetaResponseValidationError) ErrorName() string {
-	return "GetReportMetaResponseValidationError"
+func (e UpdateUserPreferencesRequestValidationError) ErrorName() string {
+	return "UpdateUserPreferencesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReportMetaResponseValidationError) Error() string {
+func (e UpdateUserPreferencesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17315,14 +17764,14 @@ func (e GetReportMetaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReportMetaResponse.%s: %s%s",
+		"invalid %sUpdateUserPreferencesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReportMetaResponseValidationError{}
+var _ error = UpdateUserPreferencesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17330,81 +17779,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReportMetaResponseValidationError{}
+} = UpdateUserPreferencesRequestValidationError{}
 
-// Validate checks the field values on GetAlertMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateUserPreferencesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertMetaRequest) Validate() error {
+func (m *UpdateUserPreferencesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAlertMetaRequestMultiError, or nil if none found.
-func (m *GetAlertMetaRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpdateUserPreferencesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateUserPreferencesResponseMultiError, or nil if none found.
+func (m *UpdateUserPreferencesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAlertMetaRequest) validate(all bool) error {
+func (m *UpdateUserPreferencesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
-
-	// no validation rules for Branch
-
-	// no validation rules for Alert
-
-	// no validation rules for Annotations
-
-	switch v := m.QueryFor.(type) {
-	case *GetAlertMetaRequest_QueryForUserId:
-		if v == nil {
-			err := GetAlertMetaRequestValidationError{
-				field:  "QueryFor",
-				reason: "oneof value cannot be a typed-nil",
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for QueryForUserId
-	case *GetAlertMetaRequest_QueryForUserEmail:
-		if v == nil {
-			err := GetAlertMetaRequestValidationError{
-				field:  "QueryFor",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserPreferencesResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for QueryForUserEmail
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return GetAlertMetaRequestMultiError(errors)
+		return UpdateUserPreferencesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertMetaRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAlertMetaRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetAlertMetaRequestMultiError []error
+// UpdateUserPreferencesResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPreferencesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateUserPreferencesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertMetaRequestMultiError) Error() string {
+func (m UpdateUserPreferencesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17413,11 +17854,12 @@ func (m GetAlertMetaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertMetaRequestMultiError) AllErrors() []error { return m }
+func (m UpdateUserPreferencesResponseMultiError) AllErrors() []error { return m }
 
-// GetAlertMetaRequestValidationError is the validation error returned by
-// GetAlertMetaRequest.Validate if the designated constraints aren't met.
-type GetAlertMetaRequestValidationError struct {
+// UpdateUserPreferencesResponseValidationError is the validation error
+// returned by UpdateUserPreferencesResponse.Validate if the designated
+// constraints aren't met.
+type UpdateUserPreferencesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17425,24 +17867,24 @@ type GetAlertMetaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertMetaRequestValidationError) Field() string { return e.field }
+func (e UpdateUserPreferencesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertMetaRequestValidationError) Reason() string { return e.reason }
+func (e UpdateUserPreferencesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertMetaRequestValidationError) Cause() error { return e.cause }
+func (e UpdateUserPreferencesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertMetaRequestValidationError) Key() bool { return e.key }
+func (e UpdateUserPreferencesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertMetaRequestValidationError) ErrorName() string {
-	return "GetAlertMetaRequestValidationError"
+func (e UpdateUserPreferencesResponseValidationError) ErrorName() string {
+	return "UpdateUserPreferencesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAlertMetaRequestValidationError) Error() string {
+func (e UpdateUserPreferencesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17454,14 +17896,14 @@ func (e GetAlertMetaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertMetaRequest.%s: %s%s",
+		"invalid %sUpdateUserPreferencesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertMetaRequestValidationError{}
+var _ error = UpdateUserPreferencesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17469,77 +17911,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertMetaRequestValidationError{}
+} = UpdateUserPreferencesResponseValidationError{}
 
-// Validate checks the field values on GetAlertMetaResponse with the rules
+// Validate checks the field values on ListBookmarksRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertMetaResponse) Validate() error {
+func (m *ListBookmarksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertMetaResponse with the rules
+// ValidateAll checks the field values on ListBookmarksRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAlertMetaResponseMultiError, or nil if none found.
-func (m *GetAlertMetaResponse) ValidateAll() error {
+// ListBookmarksRequestMultiError, or nil if none found.
+func (m *ListBookmarksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAlertMetaResponse) validate(all bool) error {
+func (m *ListBookmarksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OpenUrl
+	// no validation rules for ProjectId
 
-	// no validation rules for EditUrl
+	// no validation rules for ResourceKind
 
-	if all {
-		switch v := interface{}(m.GetQueryForAttributes()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetAlertMetaResponseValidationError{
-					field:  "QueryForAttributes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetAlertMetaResponseValidationError{
-					field:  "QueryForAttributes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQueryForAttributes()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetAlertMetaResponseValidationError{
-				field:  "QueryForAttributes",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ResourceName
 
 	if len(errors) > 0 {
-		return GetAlertMetaResponseMultiError(errors)
+		return ListBookmarksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertMetaResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAlertMetaResponse.ValidateAll() if the designated
+// ListBookmarksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetAlertMetaResponseMultiError []error
+type ListBookmarksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertMetaResponseMultiError) Error() string {
+func (m ListBookmarksRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17548,11 +17963,11 @@ func (m GetAlertMetaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertMetaResponseMultiError) AllErrors() []error { return m }
+func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
 
-// GetAlertMetaResponseValidationError is the validation error returned by
-// GetAlertMetaResponse.Validate if the designated constraints aren't met.
-type GetAlertMetaResponseValidationError struct {
+// ListBookmarksRequestValidationError is the validation error returned by
+// ListBookmarksRequest.Validate if the designated constraints aren't met.
+type ListBookmarksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17560,24 +17975,24 @@ type GetAlertMetaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertMetaResponseValidationError) Field() string { return e.field }
+func (e ListBookmarksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertMetaResponseValidationError) Reason() string { return e.reason }
+func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertMetaResponseValidationError) Cause() error { return e.cause }
+func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertMetaResponseValidationError) Key() bool { return e.key }
+func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertMetaResponseValidationError) ErrorName() string {
-	return "GetAlertMetaResponseValidationError"
+func (e ListBookmarksRequestValidationError) ErrorName() string {
+	return "ListBookmarksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAlertMetaResponseValidationError) Error() string {
+func (e ListBookmarksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17589,14 +18004,14 @@ func (e GetAlertMetaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertMetaResponse.%s: %s%s",
+		"invalid %sListBookmarksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertMetaResponseValidationError{}
+var _ error = ListBookmarksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17604,88 +18019,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertMetaResponseValidationError{}
+} = ListBookmarksRequestValidationError{}
 
-// Validate checks the field values on CreateReportRequest with the rules
+// Validate checks the field values on ListBookmarksResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReportRequest) Validate() error {
+func (m *ListBookmarksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReportRequest with the rules
+// ValidateAll checks the field values on ListBookmarksResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReportRequestMultiError, or nil if none found.
-func (m *CreateReportRequest) ValidateAll() error {
+// ListBookmarksResponseMultiError, or nil if none found.
+func (m *ListBookmarksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReportRequest) validate(all bool) error {
+func (m *ListBookmarksResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	if m.GetOptions() == nil {
-		err := CreateReportRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetBookmarks() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateReportRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateReportRequestValidationError{
-					field:  "Options",
+				return ListBookmarksResponseValidationError{
+					field:  fmt.Sprintf("Bookmarks[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateReportRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateReportRequestMultiError(errors)
+		return ListBookmarksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReportRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateReportRequest.ValidateAll() if the designated
+// ListBookmarksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateReportRequestMultiError []error
+type ListBookmarksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReportRequestMultiError) Error() string {
+func (m ListBookmarksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17694,11 +18099,11 @@ func (m CreateReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReportRequestMultiError) AllErrors() []error { return m }
+func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
 
-// CreateReportRequestValidationError is the validation error returned by
-// CreateReportRequest.Validate if the designated constraints aren't met.
-type CreateReportRequestValidationError struct {
+// ListBookmarksResponseValidationError is the validation error returned by
+// ListBookmarksResponse.Validate if the designated constraints aren't met.
+type ListBookmarksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17706,24 +18111,24 @@ type CreateReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReportRequestValidationError) Field() string { return e.field }
+func (e ListBookmarksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReportRequestValidationError) Reason() string { return e.reason }
+func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReportRequestValidationError) Cause() error { return e.cause }
+func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReportRequestValidationError) Key() bool { return e.key }
+func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReportRequestValidationError) ErrorName() string {
-	return "CreateReportRequestValidationError"
+func (e ListBookmarksResponseValidationError) ErrorName() string {
+	return "ListBookmarksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReportRequestValidationError) Error() string {
+func (e ListBookmarksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17735,14 +18140,14 @@ func (e CreateReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReportRequest.%s: %s%s",
+		"invalid %sListBookmarksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReportRequestValidationError{}
+var _ error = ListBookmarksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17750,46 +18155,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReportRequestValidationError{}
+} = ListBookmarksResponseValidationError{}
 
-// Validate checks the field values on CreateReportResponse with the rules
+// Validate checks the field values on GetBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReportResponse) Validate() error {
+func (m *GetBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReportResponse with the rules
+// ValidateAll checks the field values on GetBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReportResponseMultiError, or nil if none found.
-func (m *CreateReportResponse) ValidateAll() error {
+// GetBookmarkRequestMultiError, or nil if none found.
+func (m *GetBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReportResponse) validate(all bool) error {
+func (m *GetBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for BookmarkId
 
 	if len(errors) > 0 {
-		return CreateReportResponseMultiError(errors)
+		return GetBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReportResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateReportResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateReportResponseMultiError []error
+// GetBookmarkRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBookmarkRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReportResponseMultiError) Error() string {
+func (m GetBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17798,11 +18203,11 @@ func (m CreateReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReportResponseMultiError) AllErrors() []error { return m }
+func (m GetBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// CreateReportResponseValidationError is the validation error returned by
-// CreateReportResponse.Validate if the designated constraints aren't met.
-type CreateReportResponseValidationError struct {
+// GetBookmarkRequestValidationError is the validation error returned by
+// GetBookmarkRequest.Validate if the designated constraints aren't met.
+type GetBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17810,24 +18215,24 @@ type CreateReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReportResponseValidationError) Field() string { return e.field }
+func (e GetBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReportResponseValidationError) Reason() string { return e.reason }
+func (e GetBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReportResponseValidationError) Cause() error { return e.cause }
+func (e GetBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReportResponseValidationError) Key() bool { return e.key }
+func (e GetBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReportResponseValidationError) ErrorName() string {
-	return "CreateReportResponseValidationError"
+func (e GetBookmarkRequestValidationError) ErrorName() string {
+	return "GetBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReportResponseValidationError) Error() string {
+func (e GetBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17839,14 +18244,14 @@ func (e CreateReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReportResponse.%s: %s%s",
+		"invalid %sGetBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReportResponseValidationError{}
+var _ error = GetBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17854,70 +18259,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReportResponseValidationError{}
+} = GetBookmarkRequestValidationError{}
 
-// Validate checks the field values on EditReportRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *EditReportRequest) Validate() error {
+// Validate checks the field values on GetBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EditReportRequest with the rules
+// ValidateAll checks the field values on GetBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EditReportRequestMultiError, or nil if none found.
-func (m *EditReportRequest) ValidateAll() error {
+// GetBookmarkResponseMultiError, or nil if none found.
+func (m *GetBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EditReportRequest) validate(all bool) error {
+func (m *GetBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	// no validation rules for Name
-
-	if m.GetOptions() == nil {
-		err := EditReportRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
+		switch v := interface{}(m.GetBookmark()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, EditReportRequestValidationError{
-					field:  "Options",
+				errors = append(errors, GetBookmarkResponseValidationError{
+					field:  "Bookmark",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, EditReportRequestValidationError{
-					field:  "Options",
+				errors = append(errors, GetBookmarkResponseValidationError{
+					field:  "Bookmark",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return EditReportRequestValidationError{
-				field:  "Options",
+			return GetBookmarkResponseValidationError{
+				field:  "Bookmark",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -17925,19 +18313,19 @@ func (m *EditReportRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return EditReportRequestMultiError(errors)
+		return GetBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditReportRequestMultiError is an error wrapping multiple validation errors
-// returned by EditReportRequest.ValidateAll() if the designated constraints
-// aren't met.
-type EditReportRequestMultiError []error
+// GetBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditReportRequestMultiError) Error() string {
+func (m GetBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17946,11 +18334,11 @@ func (m EditReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditReportRequestMultiError) AllErrors() []error { return m }
+func (m GetBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// EditReportRequestValidationError is the validation error returned by
-// EditReportRequest.Validate if the designated constraints aren't met.
-type EditReportRequestValidationError struct {
+// GetBookmarkResponseValidationError is the validation error returned by
+// GetBookmarkResponse.Validate if the designated constraints aren't met.
+type GetBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17958,24 +18346,24 @@ type EditReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditReportRequestValidationError) Field() string { return e.field }
+func (e GetBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditReportRequestValidationError) Reason() string { return e.reason }
+func (e GetBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditReportRequestValidationError) Cause() error { return e.cause }
+func (e GetBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditReportRequestValidationError) Key() bool { return e.key }
+func (e GetBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditReportRequestValidationError) ErrorName() string {
-	return "EditReportRequestValidationError"
+func (e GetBookmarkResponseValidationError) ErrorName() string {
+	return "GetBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EditReportRequestValidationError) Error() string {
+func (e GetBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17987,14 +18375,14 @@ func (e EditReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditReportRequest.%s: %s%s",
+		"invalid %sGetBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditReportRequestValidationError{}
+var _ error = GetBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18002,44 +18390,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditReportRequestValidationError{}
+} = GetBookmarkResponseValidationError{}
 
-// Validate checks the field values on EditReportResponse with the rules
+// Validate checks the field values on CreateBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EditReportResponse) Validate() error {
+func (m *CreateBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EditReportResponse with the rules
+// ValidateAll checks the field values on CreateBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EditReportResponseMultiError, or nil if none found.
-func (m *EditReportResponse) ValidateAll() error {
+// CreateBookmarkRequestMultiError, or nil if none found.
+func (m *CreateBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EditReportResponse) validate(all bool) error {
+func (m *CreateBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for Data
+
+	// no validation rules for ResourceKind
+
+	// no validation rules for ResourceName
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Default
+
+	// no validation rules for Shared
+
 	if len(errors) > 0 {
-		return EditReportResponseMultiError(errors)
+		return CreateBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditReportResponseMultiError is an error wrapping multiple validation errors
-// returned by EditReportResponse.ValidateAll() if the designated constraints
-// aren't met.
-type EditReportResponseMultiError []error
+// CreateBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditReportResponseMultiError) Error() string {
+func (m CreateBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18048,11 +18452,11 @@ func (m EditReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditReportResponseMultiError) AllErrors() []error { return m }
+func (m CreateBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// EditReportResponseValidationError is the validation error returned by
-// EditReportResponse.Validate if the designated constraints aren't met.
-type EditReportResponseValidationError struct {
+// CreateBookmarkRequestValidationError is the validation error returned by
+// CreateBookmarkRequest.Validate if the designated constraints aren't met.
+type CreateBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18060,24 +18464,24 @@ type EditReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditReportResponseValidationError) Field() string { return e.field }
+func (e CreateBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditReportResponseValidationError) Reason() string { return e.reason }
+func (e CreateBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditReportResponseValidationError) Cause() error { return e.cause }
+func (e CreateBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditReportResponseValidationError) Key() bool { return e.key }
+func (e CreateBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditReportResponseValidationError) ErrorName() string {
-	return "EditReportResponseValidationError"
+func (e CreateBookmarkRequestValidationError) ErrorName() string {
+	return "CreateBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EditReportResponseValidationError) Error() string {
+func (e CreateBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18089,14 +18493,14 @@ func (e EditReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditReportResponse.%s: %s%s",
+		"invalid %sCreateBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditReportResponseValidationError{}
+var _ error = CreateBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18104,50 +18508,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditReportResponseValidationError{}
+} = CreateBookmarkRequestValidationError{}
 
-// Validate checks the field values on UnsubscribeReportRequest with the rules
+// Validate checks the field values on CreateBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnsubscribeReportRequest) Validate() error {
+func (m *CreateBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnsubscribeReportRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UnsubscribeReportRequestMultiError, or nil if none found.
-func (m *UnsubscribeReportRequest) ValidateAll() error {
+// CreateBookmarkResponseMultiError, or nil if none found.
+func (m *CreateBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnsubscribeReportRequest) validate(all bool) error {
+func (m *CreateBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	// no validation rules for Name
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return UnsubscribeReportRequestMultiError(errors)
+		return CreateBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnsubscribeReportRequestMultiError is an error wrapping multiple validation
-// errors returned by UnsubscribeReportRequest.ValidateAll() if the designated
+// CreateBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateBookmarkResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UnsubscribeReportRequestMultiError []error
+type CreateBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnsubscribeReportRequestMultiError) Error() string {
+func (m CreateBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18156,11 +18583,11 @@ func (m UnsubscribeReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnsubscribeReportRequestMultiError) AllErrors() []error { return m }
+func (m CreateBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// UnsubscribeReportRequestValidationError is the validation error returned by
-// UnsubscribeReportRequest.Validate if the designated constraints aren't met.
-type UnsubscribeReportRequestValidationError struct {
+// CreateBookmarkResponseValidationError is the validation error returned by
+// CreateBookmarkResponse.Validate if the designated constraints aren't met.
+type CreateBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18168,24 +18595,24 @@ type UnsubscribeReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnsubscribeReportRequestValidationError) Field() string { return e.field }
+func (e CreateBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnsubscribeReportRequestValidationError) Reason() string { return e.reason }
+func (e CreateBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnsubscribeReportRequestValidationError) Cause() error { return e.cause }
+func (e CreateBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnsubscribeReportRequestValidationError) Key() bool { return e.key }
+func (e CreateBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnsubscribeReportRequestValidationError) ErrorName() string {
-	return "UnsubscribeReportRequestValidationError"
+func (e CreateBookmarkResponseValidationError) ErrorName() string {
+	return "CreateBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnsubscribeReportRequestValidationError) Error() string {
+func (e CreateBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18197,14 +18624,14 @@ func (e UnsubscribeReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnsubscribeReportRequest.%s: %s%s",
+		"invalid %sCreateBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnsubscribeReportRequestValidationError{}
+var _ error = CreateBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18212,44 +18639,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnsubscribeReportRequestValidationError{}
+} = CreateBookmarkResponseValidationError{}
 
-// Validate checks the field values on UnsubscribeReportResponse with the rules
+// Validate checks the field values on UpdateBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnsubscribeReportResponse) Validate() error {
+func (m *UpdateBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnsubscribeReportResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UnsubscribeReportResponseMultiError, or nil if none found.
-func (m *UnsubscribeReportResponse) ValidateAll() error {
+// UpdateBookmarkRequestMultiError, or nil if none found.
+func (m *UpdateBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnsubscribeReportResponse) validate(all bool) error {
+func (m *UpdateBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for BookmarkId
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for Data
+
+	// no validation rules for Default
+
+	// no validation rules for Shared
+
 	if len(errors) > 0 {
-		return UnsubscribeReportResponseMultiError(errors)
+		return UpdateBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnsubscribeReportResponseMultiError is an error wrapping multiple validation
-// errors returned by UnsubscribeReportResponse.ValidateAll() if the
-// designated constraints aren't met.
-type UnsubscribeReportResponseMultiError []error
+// UpdateBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnsubscribeReportResponseMultiError) Error() string {
+func (m UpdateBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18258,11 +18697,11 @@ func (m UnsubscribeReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnsubscribeReportResponseMultiError) AllErrors() []error { return m }
+func (m UpdateBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// UnsubscribeReportResponseValidationError is the validation error returned by
-// UnsubscribeReportResponse.Validate if the designated constraints aren't met.
-type UnsubscribeReportResponseValidationError struct {
+// UpdateBookmarkRequestValidationError is the validation error returned by
+// UpdateBookmarkRequest.Validate if the designated constraints aren't met.
+type UpdateBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18270,24 +18709,24 @@ type UnsubscribeReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnsubscribeReportResponseValidationError) Field() string { return e.field }
+func (e UpdateBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnsubscribeReportResponseValidationError) Reason() string { return e.reason }
+func (e UpdateBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnsubscribeReportResponseValidationError) Cause() error { return e.cause }
+func (e UpdateBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnsubscribeReportResponseValidationError) Key() bool { return e.key }
+func (e UpdateBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnsubscribeReportResponseValidationError) ErrorName() string {
-	return "UnsubscribeReportResponseValidationError"
+func (e UpdateBookmarkRequestValidationError) ErrorName() string {
+	return "UpdateBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnsubscribeReportResponseValidationError) Error() string {
+func (e UpdateBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18299,14 +18738,14 @@ func (e UnsubscribeReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnsubscribeReportResponse.%s: %s%s",
+		"invalid %sUpdateBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnsubscribeReportResponseValidationError{}
+var _ error = UpdateBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18314,50 +18753,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnsubscribeReportResponseValidationError{}
+} = UpdateBookmarkRequestValidationError{}
 
-// Validate checks the field values on DeleteReportRequest with the rules
+// Validate checks the field values on UpdateBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReportRequest) Validate() error {
+func (m *UpdateBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReportRequest with the rules
+// ValidateAll checks the field values on UpdateBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteReportRequestMultiError, or nil if none found.
-func (m *DeleteReportRequest) ValidateAll() error {
+// UpdateBookmarkResponseMultiError, or nil if none found.
+func (m *UpdateBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReportRequest) validate(all bool) error {
+func (m *UpdateBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	// no validation rules for Name
-
 	if len(errors) > 0 {
-		return DeleteReportRequestMultiError(errors)
+		return UpdateBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReportRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteReportRequest.ValidateAll() if the designated
+// UpdateBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookmarkResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteReportRequestMultiError []error
+type UpdateBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReportRequestMultiError) Error() string {
+func (m UpdateBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18366,11 +18799,11 @@ func (m DeleteReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReportRequestMultiError) AllErrors() []error { return m }
+func (m UpdateBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// DeleteReportRequestValidationError is the validation error returned by
-// DeleteReportRequest.Validate if the designated constraints aren't met.
-type DeleteReportRequestValidationError struct {
+// UpdateBookmarkResponseValidationError is the validation error returned by
+// UpdateBookmarkResponse.Validate if the designated constraints aren't met.
+type UpdateBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18378,24 +18811,24 @@ type DeleteReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReportRequestValidationError) Field() string { return e.field }
+func (e UpdateBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReportRequestValidationError) Reason() string { return e.reason }
+func (e UpdateBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReportRequestValidationError) Cause() error { return e.cause }
+func (e UpdateBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReportRequestValidationError) Key() bool { return e.key }
+func (e UpdateBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReportRequestValidationError) ErrorName() string {
-	return "DeleteReportRequestValidationError"
+func (e UpdateBookmarkResponseValidationError) ErrorName() string {
+	return "UpdateBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteReportRequestValidationError) Error() string {
+func (e UpdateBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18407,14 +18840,14 @@ func (e DeleteReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReportRequest.%s: %s%s",
+		"invalid %sUpdateBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReportRequestValidationError{}
+var _ error = UpdateBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18422,44 +18855,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReportRequestValidationError{}
+} = UpdateBookmarkResponseValidationError{}
 
-// Validate checks the field values on DeleteReportResponse with the rules
+// Validate checks the field values on RemoveBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReportResponse) Validate() error {
+func (m *RemoveBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReportResponse with the rules
+// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteReportResponseMultiError, or nil if none found.
-func (m *DeleteReportResponse) ValidateAll() error {
+// RemoveBookmarkRequestMultiError, or nil if none found.
+func (m *RemoveBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReportResponse) validate(all bool) error {
+func (m *RemoveBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for BookmarkId
+
 	if len(errors) > 0 {
-		return DeleteReportResponseMultiError(errors)
+		return RemoveBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReportResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteReportResponse.ValidateAll() if the designated
+// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteReportResponseMultiError []error
+type RemoveBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReportResponseMultiError) Error() string {
+func (m RemoveBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18468,11 +18903,11 @@ func (m DeleteReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReportResponseMultiError) AllErrors() []error { return m }
+func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// DeleteReportResponseValidationError is the validation error returned by
-// DeleteReportResponse.Validate if the designated constraints aren't met.
-type DeleteReportResponseValidationError struct {
+// RemoveBookmarkRequestValidationError is the validation error returned by
+// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
+type RemoveBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18480,24 +18915,24 @@ type DeleteReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReportResponseValidationError) Field() string { return e.field }
+func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReportResponseValidationError) Reason() string { return e.reason }
+func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReportResponseValidationError) Cause() error { return e.cause }
+func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReportResponseValidationError) Key() bool { return e.key }
+func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReportResponseValidationError) ErrorName() string {
-	return "DeleteReportResponseValidationError"
+func (e RemoveBookmarkRequestValidationError) ErrorName() string {
+	return "RemoveBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteReportResponseValidationError) Error() string {
+func (e RemoveBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18509,14 +18944,14 @@ func (e DeleteReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReportResponse.%s: %s%s",
+		"invalid %sRemoveBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReportResponseValidationError{}
+var _ error = RemoveBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18524,50 +18959,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReportResponseValidationError{}
+} = RemoveBookmarkRequestValidationError{}
 
-// Validate checks the field values on TriggerReportRequest with the rules
+// Validate checks the field values on RemoveBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerReportRequest) Validate() error {
+func (m *RemoveBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerReportRequest with the rules
+// ValidateAll checks the field values on RemoveBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerReportRequestMultiError, or nil if none found.
-func (m *TriggerReportRequest) ValidateAll() error {
+// RemoveBookmarkResponseMultiError, or nil if none found.
+func (m *RemoveBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerReportRequest) validate(all bool) error {
+func (m *RemoveBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	// no validation rules for Name
-
 	if len(errors) > 0 {
-		return TriggerReportRequestMultiError(errors)
+		return RemoveBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerReportRequestMultiError is an error wrapping multiple validation
-// errors returned by TriggerReportRequest.ValidateAll() if the designated
+// RemoveBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkResponse.ValidateAll() if the designated
 // constraints aren't met.
-type TriggerReportRequestMultiError []error
+type RemoveBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerReportRequestMultiError) Error() string {
+func (m RemoveBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18576,11 +19005,11 @@ func (m TriggerReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerReportRequestMultiError) AllErrors() []error { return m }
+func (m RemoveBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// TriggerReportRequestValidationError is the validation error returned by
-// TriggerReportRequest.Validate if the designated constraints aren't met.
-type TriggerReportRequestValidationError struct {
+// RemoveBookmarkResponseValidationError is the validation error returned by
+// RemoveBookmarkResponse.Validate if the designated constraints aren't met.
+type RemoveBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18588,24 +19017,24 @@ type TriggerReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerReportRequestValidationError) Field() string { return e.field }
+func (e RemoveBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerReportRequestValidationError) Reason() string { return e.reason }
+func (e RemoveBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerReportRequestValidationError) Cause() error { return e.cause }
+func (e RemoveBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerReportRequestValidationError) Key() bool { return e.key }
+func (e RemoveBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerReportRequestValidationError) ErrorName() string {
-	return "TriggerReportRequestValidationError"
+func (e RemoveBookmarkResponseValidationError) ErrorName() string {
+	return "RemoveBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerReportRequestValidationError) Error() string {
+func (e RemoveBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18617,14 +19046,14 @@ func (e TriggerReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerReportRequest.%s: %s%s",
+		"invalid %sRemoveBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerReportRequestValidationError{}
+var _ error = RemoveBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18632,44 +19061,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerReportRequestValidationError{}
+} = RemoveBookmarkResponseValidationError{}
 
-// Validate checks the field values on TriggerReportResponse with the rules
+// Validate checks the field values on SearchUsersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerReportResponse) Validate() error {
+func (m *SearchUsersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerReportResponse with the rules
+// ValidateAll checks the field values on SearchUsersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerReportResponseMultiError, or nil if none found.
-func (m *TriggerReportResponse) ValidateAll() error {
+// SearchUsersRequestMultiError, or nil if none found.
+func (m *SearchUsersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerReportResponse) validate(all bool) error {
+func (m *SearchUsersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetEmailPattern()) < 1 {
+		err := SearchUsersRequestValidationError{
+			field:  "EmailPattern",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := SearchUsersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return TriggerReportResponseMultiError(errors)
+		return SearchUsersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerReportResponseMultiError is an error wrapping multiple validation
-// errors returned by TriggerReportResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TriggerReportResponseMultiError []error
+// SearchUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchUsersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerReportResponseMultiError) Error() string {
+func (m SearchUsersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18678,11 +19135,11 @@ func (m TriggerReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerReportResponseMultiError) AllErrors() []error { return m }
+func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
 
-// TriggerReportResponseValidationError is the validation error returned by
-// TriggerReportResponse.Validate if the designated constraints aren't met.
-type TriggerReportResponseValidationError struct {
+// SearchUsersRequestValidationError is the validation error returned by
+// SearchUsersRequest.Validate if the designated constraints aren't met.
+type SearchUsersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18690,24 +19147,24 @@ type TriggerReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerReportResponseValidationError) Field() string { return e.field }
+func (e SearchUsersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerReportResponseValidationError) Reason() string { return e.reason }
+func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerReportResponseValidationError) Cause() error { return e.cause }
+func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerReportResponseValidationError) Key() bool { return e.key }
+func (e SearchUsersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerReportResponseValidationError) ErrorName() string {
-	return "TriggerReportResponseValidationError"
+func (e SearchUsersRequestValidationError) ErrorName() string {
+	return "SearchUsersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerReportResponseValidationError) Error() string {
+func (e SearchUsersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18719,14 +19176,14 @@ func (e TriggerReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerReportResponse.%s: %s%s",
+		"invalid %sSearchUsersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerReportResponseValidationError{}
+var _ error = SearchUsersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18734,88 +19191,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerReportResponseValidationError{}
+} = SearchUsersRequestValidationError{}
 
-// Validate checks the field values on GenerateReportYAMLRequest with the rules
+// Validate checks the field values on SearchUsersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateReportYAMLRequest) Validate() error {
+func (m *SearchUsersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateReportYAMLRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SearchUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateReportYAMLRequestMultiError, or nil if none found.
-func (m *GenerateReportYAMLRequest) ValidateAll() error {
+// SearchUsersResponseMultiError, or nil if none found.
+func (m *SearchUsersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateReportYAMLRequest) validate(all bool) error {
+func (m *SearchUsersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	if m.GetOptions() == nil {
-		err := GenerateReportYAMLRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GenerateReportYAMLRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GenerateReportYAMLRequestValidationError{
-					field:  "Options",
+				return SearchUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GenerateReportYAMLRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return GenerateReportYAMLRequestMultiError(errors)
+		return SearchUsersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateReportYAMLRequestMultiError is an error wrapping multiple validation
-// errors returned by GenerateReportYAMLRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GenerateReportYAMLRequestMultiError []error
+// SearchUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchUsersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchUsersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateReportYAMLRequestMultiError) Error() string {
+func (m SearchUsersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18824,11 +19273,11 @@ func (m GenerateReportYAMLRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateReportYAMLRequestMultiError) AllErrors() []error { return m }
+func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
 
-// GenerateReportYAMLRequestValidationError is the validation error returned by
-// GenerateReportYAMLRequest.Validate if the designated constraints aren't met.
-type GenerateReportYAMLRequestValidationError struct {
+// SearchUsersResponseValidationError is the validation error returned by
+// SearchUsersResponse.Validate if the designated constraints aren't met.
+type SearchUsersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18836,24 +19285,24 @@ type GenerateReportYAMLRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateReportYAMLRequestValidationError) Field() string { return e.field }
+func (e SearchUsersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateReportYAMLRequestValidationError) Reason() string { return e.reason }
+func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateReportYAMLRequestValidationError) Cause() error { return e.cause }
+func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateReportYAMLRequestValidationError) Key() bool { return e.key }
+func (e SearchUsersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateReportYAMLRequestValidationError) ErrorName() string {
-	return "GenerateReportYAMLRequestValidationError"
+func (e SearchUsersResponseValidationError) ErrorName() string {
+	return "SearchUsersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateReportYAMLRequestValidationError) Error() string {
+func (e SearchUsersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18865,14 +19314,14 @@ func (e GenerateReportYAMLRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateReportYAMLRequest.%s: %s%s",
+		"invalid %sSearchUsersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateReportYAMLRequestValidationError{}
+var _ error = SearchUsersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18880,46 +19329,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateReportYAMLRequestValidationError{}
+} = SearchUsersResponseValidationError{}
 
-// Validate checks the field values on GenerateReportYAMLResponse with the
+// Validate checks the field values on RevokeCurrentAuthTokenRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateReportYAMLResponse) Validate() error {
+func (m *RevokeCurrentAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateReportYAMLResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateReportYAMLResponseMultiError, or nil if none found.
-func (m *GenerateReportYAMLResponse) ValidateAll() error {
+// ValidateAll checks the field values on RevokeCurrentAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeCurrentAuthTokenRequestMultiError, or nil if none found.
+func (m *RevokeCurrentAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateReportYAMLResponse) validate(all bool) error {
+func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Yaml
-
 	if len(errors) > 0 {
-		return GenerateReportYAMLResponseMultiError(errors)
+		return RevokeCurrentAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateReportYAMLResponseMultiError is an error wrapping multiple
-// validation errors returned by GenerateReportYAMLResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GenerateReportYAMLResponseMultiError []error
+// RevokeCurrentAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeCurrentAuthTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeCurrentAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateReportYAMLResponseMultiError) Error() string {
+func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18928,11 +19375,12 @@ func (m GenerateReportYAMLResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateReportYAMLResponseMultiError) AllErrors() []error { return m }
+func (m RevokeCurrentAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// GenerateReportYAMLResponseValidationError is the validation error returned
-// by GenerateReportYAMLResponse.Validate if the designated constraints aren't met.
-type GenerateReportYAMLResponseValidationError struct {
+// RevokeCurrentAuthTokenRequestValidationError is the validation error
+// returned by RevokeCurrentAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type RevokeCurrentAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18940,24 +19388,24 @@ type GenerateReportYAMLResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateReportYAMLResponseValidationError) Field() string { return e.field }
+func (e RevokeCurrentAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateReportYAMLResponseValidationError) Reason() string { return e.reason }
+func (e RevokeCurrentAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateReportYAMLResponseValidationError) Cause() error { return e.cause }
+func (e RevokeCurrentAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateReportYAMLResponseValidationError) Key() bool { return e.key }
+func (e RevokeCurrentAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateReportYAMLResponseValidationError) ErrorName() string {
-	return "GenerateReportYAMLResponseValidationError"
+func (e RevokeCurrentAuthTokenRequestValidationError) ErrorName() string {
+	return "RevokeCurrentAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateReportYAMLResponseValidationError) Error() string {
+func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18969,14 +19417,14 @@ func (e GenerateReportYAMLResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateReportYAMLResponse.%s: %s%s",
+		"invalid %sRevokeCurrentAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateReportYAMLResponseValidationError{}
+var _ error = RevokeCurrentAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18984,88 +19432,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateReportYAMLResponseValidationError{}
+} = RevokeCurrentAuthTokenRequestValidationError{}
 
-// Validate checks the field values on CreateAlertRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeCurrentAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAlertRequest) Validate() error {
+func (m *RevokeCurrentAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAlertRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateAlertRequestMultiError, or nil if none found.
-func (m *CreateAlertRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeCurrentAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeCurrentAuthTokenResponseMultiError, or nil if none found.
+func (m *RevokeCurrentAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAlertRequest) validate(all bool) error {
+func (m *RevokeCurrentAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	if m.GetOptions() == nil {
-		err := CreateAlertRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateAlertRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateAlertRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateAlertRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for TokenId
 
 	if len(errors) > 0 {
-		return CreateAlertRequestMultiError(errors)
+		return RevokeCurrentAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAlertRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateAlertRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateAlertRequestMultiError []error
+// RevokeCurrentAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeCurrentAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeCurrentAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAlertRequestMultiError) Error() string {
+func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19074,11 +19480,12 @@ func (m CreateAlertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAlertRequestMultiError) AllErrors() []error { return m }
+func (m RevokeCurrentAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// CreateAlertRequestValidationError is the validation error returned by
-// CreateAlertRequest.Validate if the designated constraints aren't met.
-type CreateAlertRequestValidationError struct {
+// RevokeCurrentAuthTokenResponseValidationError is the validation error
+// returned by RevokeCurrentAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type RevokeCurrentAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19086,24 +19493,24 @@ type CreateAlertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAlertRequestValidationError) Field() string { return e.field }
+func (e RevokeCurrentAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAlertRequestValidationError) Reason() string { return e.reason }
+func (e RevokeCurrentAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAlertRequestValidationError) Cause() error { return e.cause }
+func (e RevokeCurrentAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAlertRequestValidationError) Key() bool { return e.key }
+func (e RevokeCurrentAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAlertRequestValidationError) ErrorName() string {
-	return "CreateAlertRequestValidationError"
+func (e RevokeCurrentAuthTokenResponseValidationError) ErrorName() string {
+	return "RevokeCurrentAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAlertRequestValidationError) Error() string {
+func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19115,14 +19522,14 @@ func (e CreateAlertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAlertRequest.%s: %s%s",
+		"invalid %sRevokeCurrentAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAlertRequestValidationError{}
+var _ error = RevokeCurrentAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19130,46 +19537,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAlertRequestValidationError{}
+} = RevokeCurrentAuthTokenResponseValidationError{}
 
-// Validate checks the field values on CreateAlertResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAlertResponse) Validate() error {
+// Validate checks the field values on IssueRepresentativeAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *IssueRepresentativeAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAlertResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateAlertResponseMultiError, or nil if none found.
-func (m *CreateAlertResponse) ValidateAll() error {
+// ValidateAll checks the field values on IssueRepresentativeAuthTokenRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// IssueRepresentativeAuthTokenRequestMultiError, or nil if none found.
+func (m *IssueRepresentativeAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAlertResponse) validate(all bool) error {
+func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = IssueRepresentativeAuthTokenRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TtlMinutes
 
 	if len(errors) > 0 {
-		return CreateAlertResponseMultiError(errors)
+		return IssueRepresentativeAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAlertResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateAlertResponse.ValidateAll() if the designated
+func (m *IssueRepresentativeAuthTokenRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *IssueRepresentativeAuthTokenRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// IssueRepresentativeAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// IssueRepresentativeAuthTokenRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateAlertResponseMultiError []error
+type IssueRepresentativeAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAlertResponseMultiError) Error() string {
+func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19178,11 +19649,12 @@ func (m CreateAlertResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAlertResponseMultiError) AllErrors() []error { return m }
+func (m IssueRepresentativeAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// CreateAlertResponseValidationError is the validation error returned by
-// CreateAlertResponse.Validate if the designated constraints aren't met.
-type CreateAlertResponseValidationError struct {
+// IssueRepresentativeAuthTokenRequestValidationError is the validation error
+// returned by IssueRepresentativeAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type IssueRepresentativeAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19190,24 +19662,24 @@ type CreateAlertResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAlertResponseValidationError) Field() string { return e.field }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAlertResponseValidationError) Reason() string { return e.reason }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAlertResponseValidationError) Cause() error { return e.cause }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAlertResponseValidationError) Key() bool { return e.key }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAlertResponseValidationError) ErrorName() string {
-	return "CreateAlertResponseValidationError"
+func (e IssueRepresentativeAuthTokenRequestValidationError) ErrorName() string {
+	return "IssueRepresentativeAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAlertResponseValidationError) Error() string {
+func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19219,14 +19691,14 @@ func (e CreateAlertResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAlertResponse.%s: %s%s",
+		"invalid %sIssueRepresentativeAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAlertResponseValidationError{}
+var _ error = IssueRepresentativeAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -19234,90 +19706,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAlertResponseValidationError{}
+} = IssueRepresentativeAuthTokenRequestValidationError{}
 
-// Validate checks the field values on EditAlertRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *EditAlertRequest) Validate() error {
+// Validate checks the field values on IssueRepresentativeAuthTokenResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *IssueRepresentativeAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EditAlertRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EditAlertRequestMultiError, or nil if none found.
-func (m *EditAlertRequest) ValidateAll() error {
+// ValidateAll checks the field values on IssueRepresentativeAuthTokenResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// IssueRepresentativeAuthTokenResponseMultiError, or nil if none found.
+func (m *IssueRepresentativeAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EditAlertRequest) validate(all bool) error {
+func (m *IssueRepresentativeAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	// no validation rules for Name
-
-	if m.GetOptions() == nil {
-		err := EditAlertRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, EditAlertRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, EditAlertRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return EditAlertRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Token
 
 	if len(errors) > 0 {
-		return EditAlertRequestMultiError(errors)
+		return IssueRepresentativeAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditAlertRequestMultiError is an error wrapping multiple validation errors
-// returned by EditAlertRequest.ValidateAll() if the designated constraints
-// aren't met.
-type EditAlertRequestMultiError []error
+// IssueRepresentativeAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// IssueRepresentativeAuthTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type IssueRepresentativeAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditAlertRequestMultiError) Error() string {
+func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19326,11 +19756,12 @@ func (m EditAlertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditAlertRequestMultiError) AllErrors() []error { return m }
+func (m IssueRepresentativeAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// EditAlertRequestValidationError is the validation error returned by
-// EditAlertRequest.Validate if the designated constraints aren't met.
-type EditAlertRequestValidationError struct {
+// IssueRepresentativeAuthTokenResponseValidationError is the validation error
+// returned by IssueRepresentativeAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type IssueRepresentativeAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19338,22 +19769,24 @@ type EditAlertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditAlertRequestValidationError) Field() string { return e.field }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditAlertRequestValidationError) Reason() string { return e.reason }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditAlertRequestValidationError) Cause() error { return e.cause }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditAlertRequestValidationError) Key() bool { return e.key }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditAlertRequestValidationError) ErrorName() string { return "EditAlertRequestValidationError" }
+func (e IssueRepresentativeAuthTokenResponseValidationError) ErrorName() string {
+	return "IssueRepresentativeAuthTokenResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e EditAlertRequestValidationError) Error() string {
+func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19365,14 +19798,14 @@ func (e EditAlertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditAlertRequest.%s: %s%s",
+		"invalid %sIssueRepresentativeAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditAlertRequestValidationError{}
+var _ error = IssueRepresentativeAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19380,44 +19813,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditAlertRequestValidationError{}
+} = IssueRepresentativeAuthTokenResponseValidationError{}
 
-// Validate checks the field values on EditAlertResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *EditAlertResponse) Validate() error {
+// Validate checks the field values on RevokeServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeServiceAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EditAlertResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EditAlertResponseMultiError, or nil if none found.
-func (m *EditAlertResponse) ValidateAll() error {
+// ValidateAll checks the field values on RevokeServiceAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeServiceAuthTokenRequestMultiError, or nil if none found.
+func (m *RevokeServiceAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EditAlertResponse) validate(all bool) error {
+func (m *RevokeServiceAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for TokenId
+
 	if len(errors) > 0 {
-		return EditAlertResponseMultiError(errors)
+		return RevokeServiceAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditAlertResponseMultiError is an error wrapping multiple validation errors
-// returned by EditAlertResponse.ValidateAll() if the designated constraints
-// aren't met.
-type EditAlertResponseMultiError []error
+// RevokeServiceAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAuthTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeServiceAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditAlertResponseMultiError) Error() string {
+func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19426,11 +19861,12 @@ func (m EditAlertResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditAlertResponseMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// EditAlertResponseValidationError is the validation error returned by
-// EditAlertResponse.Validate if the designated constraints aren't met.
-type EditAlertResponseValidationError struct {
+// RevokeServiceAuthTokenRequestValidationError is the validation error
+// returned by RevokeServiceAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type RevokeServiceAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19438,24 +19874,24 @@ type EditAlertResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditAlertResponseValidationError) Field() string { return e.field }
+func (e RevokeServiceAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditAlertResponseValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditAlertResponseValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditAlertResponseValidationError) Key() bool { return e.key }
+func (e RevokeServiceAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditAlertResponseValidationError) ErrorName() string {
-	return "EditAlertResponseValidationError"
+func (e RevokeServiceAuthTokenRequestValidationError) ErrorName() string {
+	return "RevokeServiceAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EditAlertResponseValidationError) Error() string {
+func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19467,14 +19903,14 @@ func (e EditAlertResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditAlertResponse.%s: %s%s",
+		"invalid %sRevokeServiceAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditAlertResponseValidationError{}
+var _ error = RevokeServiceAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -19482,50 +19918,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditAlertResponseValidationError{}
+} = RevokeServiceAuthTokenRequestValidationError{}
 
-// Validate checks the field values on UnsubscribeAlertRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeServiceAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnsubscribeAlertRequest) Validate() error {
+func (m *RevokeServiceAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnsubscribeAlertRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UnsubscribeAlertRequestMultiError, or nil if none found.
-func (m *UnsubscribeAlertRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeServiceAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeServiceAuthTokenResponseMultiError, or nil if none found.
+func (m *RevokeServiceAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnsubscribeAlertRequest) validate(all bool) error {
+func (m *RevokeServiceAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	// no validation rules for Name
-
 	if len(errors) > 0 {
-		return UnsubscribeAlertRequestMultiError(errors)
+		return RevokeServiceAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnsubscribeAlertRequestMultiError is an error wrapping multiple validation
-// errors returned by UnsubscribeAlertRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UnsubscribeAlertRequestMultiError []error
+// RevokeServiceAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeServiceAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnsubscribeAlertRequestMultiError) Error() string {
+func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19534,11 +19964,12 @@ func (m UnsubscribeAlertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnsubscribeAlertRequestMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// UnsubscribeAlertRequestValidationError is the validation error returned by
-// UnsubscribeAlertRequest.Validate if the designated constraints aren't met.
-type UnsubscribeAlertRequestValidationError struct {
+// RevokeServiceAuthTokenResponseValidationError is the validation error
+// returned by RevokeServiceAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type RevokeServiceAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19546,24 +19977,24 @@ type UnsubscribeAlertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnsubscribeAlertRequestValidationError) Field() string { return e.field }
+func (e RevokeServiceAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnsubscribeAlertRequestValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnsubscribeAlertRequestValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnsubscribeAlertRequestValidationError) Key() bool { return e.key }
+func (e RevokeServiceAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnsubscribeAlertRequestValidationError) ErrorName() string {
-	return "UnsubscribeAlertRequestValidationError"
+func (e RevokeServiceAuthTokenResponseValidationError) ErrorName() string {
+	return "RevokeServiceAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnsubscribeAlertRequestValidationError) Error() string {
+func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19575,14 +20006,14 @@ func (e UnsubscribeAlertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnsubscribeAlertRequest.%s: %s%s",
+		"invalid %sRevokeServiceAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnsubscribeAlertRequestValidationError{}
+var _ error = RevokeServiceAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19590,44 +20021,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnsubscribeAlertRequestValidationError{}
+} = RevokeServiceAuthTokenResponseValidationError{}
 
-// Validate checks the field values on UnsubscribeAlertResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on IssueServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnsubscribeAlertResponse) Validate() error {
+func (m *IssueServiceAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnsubscribeAlertResponse with the
+// ValidateAll checks the field values on IssueServiceAuthTokenRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UnsubscribeAlertResponseMultiError, or nil if none found.
-func (m *UnsubscribeAlertResponse) ValidateAll() error {
+// IssueServiceAuthTokenRequestMultiError, or nil if none found.
+func (m *IssueServiceAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnsubscribeAlertResponse) validate(all bool) error {
+func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := IssueServiceAuthTokenRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ServiceName
+
 	if len(errors) > 0 {
-		return UnsubscribeAlertResponseMultiError(errors)
+		return IssueServiceAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnsubscribeAlertResponseMultiError is an error wrapping multiple validation
-// errors returned by UnsubscribeAlertResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UnsubscribeAlertResponseMultiError []error
+// IssueServiceAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by IssueServiceAuthTokenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type IssueServiceAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnsubscribeAlertResponseMultiError) Error() string {
+func (m IssueServiceAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19636,11 +20080,12 @@ func (m UnsubscribeAlertResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnsubscribeAlertResponseMultiError) AllErrors() []error { return m }
+func (m IssueServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// UnsubscribeAlertResponseValidationError is the validation error returned by
-// UnsubscribeAlertResponse.Validate if the designated constraints aren't met.
-type UnsubscribeAlertResponseValidationError struct {
+// IssueServiceAuthTokenRequestValidationError is the validation error returned
+// by IssueServiceAuthTokenRequest.Validate if the designated constraints
+// aren't met.
+type IssueServiceAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19648,24 +20093,24 @@ type UnsubscribeAlertResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnsubscribeAlertResponseValidationError) Field() string { return e.field }
+func (e IssueServiceAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnsubscribeAlertResponseValidationError) Reason() string { return e.reason }
+func (e IssueServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnsubscribeAlertResponseValidationError) Cause() error { return e.cause }
+func (e IssueServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnsubscribeAlertResponseValidationError) Key() bool { return e.key }
+func (e IssueServiceAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnsubscribeAlertResponseValidationError) ErrorName() string {
-	return "UnsubscribeAlertResponseValidationError"
+func (e IssueServiceAuthTokenRequestValidationError) ErrorName() string {
+	return "IssueServiceAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnsubscribeAlertResponseValidationError) Error() string {
+func (e IssueServiceAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19677,14 +20122,14 @@ func (e UnsubscribeAlertResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnsubscribeAlertResponse.%s: %s%s",
+		"invalid %sIssueServiceAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnsubscribeAlertResponseValidationError{}
+var _ error = IssueServiceAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -19692,50 +20137,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnsubscribeAlertResponseValidationError{}
+} = IssueServiceAuthTokenRequestValidationError{}
 
-// Validate checks the field values on DeleteAlertRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on IssueServiceAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteAlertRequest) Validate() error {
+func (m *IssueServiceAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteAlertRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteAlertRequestMultiError, or nil if none found.
-func (m *DeleteAlertRequest) ValidateAll() error {
+// ValidateAll checks the field values on IssueServiceAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// IssueServiceAuthTokenResponseMultiError, or nil if none found.
+func (m *IssueServiceAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteAlertRequest) validate(all bool) error {
+func (m *IssueServiceAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	// no validation rules for Name
+	// no validation rules for Token
 
 	if len(errors) > 0 {
-		return DeleteAlertRequestMultiError(errors)
+		return IssueServiceAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteAlertRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteAlertRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteAlertRequestMultiError []error
+// IssueServiceAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by IssueServiceAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type IssueServiceAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteAlertRequestMultiError) Error() string {
+func (m IssueServiceAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19744,11 +20185,12 @@ func (m DeleteAlertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteAlertRequestMultiError) AllErrors() []error { return m }
+func (m IssueServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// DeleteAlertRequestValidationError is the validation error returned by
-// DeleteAlertRequest.Validate if the designated constraints aren't met.
-type DeleteAlertRequestValidationError struct {
+// IssueServiceAuthTokenResponseValidationError is the validation error
+// returned by IssueServiceAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type IssueServiceAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19756,24 +20198,24 @@ type DeleteAlertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAlertRequestValidationError) Field() string { return e.field }
+func (e IssueServiceAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAlertRequestValidationError) Reason() string { return e.reason }
+func (e IssueServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAlertRequestValidationError) Cause() error { return e.cause }
+func (e IssueServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAlertRequestValidationError) Key() bool { return e.key }
+func (e IssueServiceAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAlertRequestValidationError) ErrorName() string {
-	return "DeleteAlertRequestValidationError"
+func (e IssueServiceAuthTokenResponseValidationError) ErrorName() string {
+	return "IssueServiceAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteAlertRequestValidationError) Error() string {
+func (e IssueServiceAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19785,14 +20227,14 @@ func (e DeleteAlertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAlertRequest.%s: %s%s",
+		"invalid %sIssueServiceAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAlertRequestValidationError{}
+var _ error = IssueServiceAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19800,44 +20242,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAlertRequestValidationError{}
+} = IssueServiceAuthTokenResponseValidationError{}
 
-// Validate checks the field values on DeleteAlertResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListServiceAuthTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteAlertResponse) Validate() error {
+func (m *ListServiceAuthTokensRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteAlertResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListServiceAuthTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteAlertResponseMultiError, or nil if none found.
-func (m *DeleteAlertResponse) ValidateAll() error {
+// ListServiceAuthTokensRequestMultiError, or nil if none found.
+func (m *ListServiceAuthTokensRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteAlertResponse) validate(all bool) error {
+func (m *ListServiceAuthTokensRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := ListServiceAuthTokensRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ServiceName
+
 	if len(errors) > 0 {
-		return DeleteAlertResponseMultiError(errors)
+		return ListServiceAuthTokensRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteAlertResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteAlertResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteAlertResponseMultiError []error
+// ListServiceAuthTokensRequestMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAuthTokensRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListServiceAuthTokensRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteAlertResponseMultiError) Error() string {
+func (m ListServiceAuthTokensRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19846,11 +20301,12 @@ func (m DeleteAlertResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteAlertResponseMultiError) AllErrors() []error { return m }
+func (m ListServiceAuthTokensRequestMultiError) AllErrors() []error { return m }
 
-// DeleteAlertResponseValidationError is the validation error returned by
-// DeleteAlertResponse.Validate if the designated constraints aren't met.
-type DeleteAlertResponseValidationError struct {
+// ListServiceAuthTokensRequestValidationError is the validation error returned
+// by ListServiceAuthTokensRequest.Validate if the designated constraints
+// aren't met.
+type ListServiceAuthTokensRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19858,24 +20314,24 @@ type DeleteAlertResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAlertResponseValidationError) Field() string { return e.field }
+func (e ListServiceAuthTokensRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAlertResponseValidationError) Reason() string { return e.reason }
+func (e ListServiceAuthTokensRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAlertResponseValidationError) Cause() error { return e.cause }
+func (e ListServiceAuthTokensRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAlertResponseValidationError) Key() bool { return e.key }
+func (e ListServiceAuthTokensRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAlertResponseValidationError) ErrorName() string {
-	return "DeleteAlertResponseValidationError"
+func (e ListServiceAuthTokensRequestValidationError) ErrorName() string {
+	return "ListServiceAuthTokensRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteAlertResponseValidationError) Error() string {
+func (e ListServiceAuthTokensRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19887,14 +20343,14 @@ func (e DeleteAlertResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAlertResponse.%s: %s%s",
+		"invalid %sListServiceAuthTokensRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAlertResponseValidationError{}
+var _ error = ListServiceAuthTokensRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -19902,88 +20358,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAlertResponseValidationError{}
+} = ListServiceAuthTokensRequestValidationError{}
 
-// Validate checks the field values on GenerateAlertYAMLRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListServiceAuthTokensResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateAlertYAMLRequest) Validate() error {
+func (m *ListServiceAuthTokensResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateAlertYAMLRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateAlertYAMLRequestMultiError, or nil if none found.
-func (m *GenerateAlertYAMLRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListServiceAuthTokensResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListServiceAuthTokensResponseMultiError, or nil if none found.
+func (m *ListServiceAuthTokensResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateAlertYAMLRequest) validate(all bool) error {
+func (m *ListServiceAuthTokensResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	if m.GetOptions() == nil {
-		err := GenerateAlertYAMLRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetTokens() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GenerateAlertYAMLRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListServiceAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListServiceAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GenerateAlertYAMLRequestValidationError{
-					field:  "Options",
+				return ListServiceAuthTokensResponseValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GenerateAlertYAMLRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GenerateAlertYAMLRequestMultiError(errors)
+		return ListServiceAuthTokensResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateAlertYAMLRequestMultiError is an error wrapping multiple validation
-// errors returned by GenerateAlertYAMLRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateAlertYAMLRequestMultiError []error
+// ListServiceAuthTokensResponseMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAuthTokensResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListServiceAuthTokensResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateAlertYAMLRequestMultiError) Error() string {
+func (m ListServiceAuthTokensResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19992,11 +20438,12 @@ func (m GenerateAlertYAMLRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateAlertYAMLRequestMultiError) AllErrors() []error { return m }
+func (m ListServiceAuthTokensResponseMultiError) AllErrors() []error { return m }
 
-// GenerateAlertYAMLRequestValidationError is the validation error returned by
-// GenerateAlertYAMLRequest.Validate if the designated constraints aren't met.
-type GenerateAlertYAMLRequestValidationError struct {
+// ListServiceAuthTokensResponseValidationError is the validation error
+// returned by ListServiceAuthTokensResponse.Validate if the designated
+// constraints aren't met.
+type ListServiceAuthTokensResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20004,24 +20451,24 @@ type GenerateAlertYAMLRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateAlertYAMLRequestValidationError) Field() string { return e.field }
+func (e ListServiceAuthTokensResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateAlertYAMLRequestValidationError) Reason() string { return e.reason }
+func (e ListServiceAuthTokensResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateAlertYAMLRequestValidationError) Cause() error { return e.cause }
+func (e ListServiceAuthTokensResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateAlertYAMLRequestValidationError) Key() bool { return e.key }
+func (e ListServiceAuthTokensResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateAlertYAMLRequestValidationError) ErrorName() string {
-	return "GenerateAlertYAMLRequestValidationError"
+func (e ListServiceAuthTokensResponseValidationError) ErrorName() string {
+	return "ListServiceAuthTokensResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateAlertYAMLRequestValidationError) Error() string {
+func (e ListServiceAuthTokensResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20033,14 +20480,14 @@ func (e GenerateAlertYAMLRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateAlertYAMLRequest.%s: %s%s",
+		"invalid %sListServiceAuthTokensResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateAlertYAMLRequestValidationError{}
+var _ error = ListServiceAuthTokensResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -20048,46 +20495,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateAlertYAMLRequestValidationError{}
+} = ListServiceAuthTokensResponseValidationError{}
 
-// Validate checks the field values on GenerateAlertYAMLResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetGithubRepoStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateAlertYAMLResponse) Validate() error {
+func (m *GetGithubRepoStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateAlertYAMLResponse with the
+// ValidateAll checks the field values on GetGithubRepoStatusRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateAlertYAMLResponseMultiError, or nil if none found.
-func (m *GenerateAlertYAMLResponse) ValidateAll() error {
+// GetGithubRepoStatusRequestMultiError, or nil if none found.
+func (m *GetGithubRepoStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateAlertYAMLResponse) validate(all bool) error {
+func (m *GetGithubRepoStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Yaml
+	// no validation rules for GithubUrl
 
 	if len(errors) > 0 {
-		return GenerateAlertYAMLResponseMultiError(errors)
+		return GetGithubRepoStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateAlertYAMLResponseMultiError is an error wrapping multiple validation
-// errors returned by GenerateAlertYAMLResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GenerateAlertYAMLResponseMultiError []error
+// GetGithubRepoStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetGithubRepoStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubRepoStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateAlertYAMLResponseMultiError) Error() string {
+func (m GetGithubRepoStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20096,11 +20543,11 @@ func (m GenerateAlertYAMLResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateAlertYAMLResponseMultiError) AllErrors() []error { return m }
+func (m GetGithubRepoStatusRequestMultiError) AllErrors() []error { return m }
 
-// GenerateAlertYAMLResponseValidationError is the validation error returned by
-// GenerateAlertYAMLResponse.Validate if the designated constraints aren't met.
-type GenerateAlertYAMLResponseValidationError struct {
+// GetGithubRepoStatusRequestValidationError is the validation error returned
+// by GetGithubRepoStatusRequest.Validate if the designated constraints aren't met.
+type GetGithubRepoStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20108,24 +20555,24 @@ type GenerateAlertYAMLResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateAlertYAMLResponseValidationError) Field() string { return e.field }
+func (e GetGithubRepoStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateAlertYAMLResponseValidationError) Reason() string { return e.reason }
+func (e GetGithubRepoStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateAlertYAMLResponseValidationError) Cause() error { return e.cause }
+func (e GetGithubRepoStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateAlertYAMLResponseValidationError) Key() bool { return e.key }
+func (e GetGithubRepoStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateAlertYAMLResponseValidationError) ErrorName() string {
-	return "GenerateAlertYAMLResponseValidationError"
+func (e GetGithubRepoStatusRequestValidationError) ErrorName() string {
+	return "GetGithubRepoStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateAlertYAMLResponseValidationError) Error() string {
+func (e GetGithubRepoStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20137,14 +20584,14 @@ func (e GenerateAlertYAMLResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateAlertYAMLResponse.%s: %s%s",
+		"invalid %sGetGithubRepoStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateAlertYAMLResponseValidationError{}
+var _ error = GetGithubRepoStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -20152,50 +20599,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateAlertYAMLResponseValidationError{}
+} = GetGithubRepoStatusRequestValidationError{}
 
-// Validate checks the field values on GetAlertYAMLRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetGithubRepoStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertYAMLRequest) Validate() error {
+func (m *GetGithubRepoStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertYAMLRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetGithubRepoStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAlertYAMLRequestMultiError, or nil if none found.
-func (m *GetAlertYAMLRequest) ValidateAll() error {
+// GetGithubRepoStatusResponseMultiError, or nil if none found.
+func (m *GetGithubRepoStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAlertYAMLRequest) validate(all bool) error {
+func (m *GetGithubRepoStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
+	// no validation rules for HasAccess
 
-	// no validation rules for Project
+	// no validation rules for GrantAccessUrl
 
-	// no validation rules for Name
+	// no validation rules for DefaultBranch
 
 	if len(errors) > 0 {
-		return GetAlertYAMLRequestMultiError(errors)
+		return GetGithubRepoStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertYAMLRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAlertYAMLRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetAlertYAMLRequestMultiError []error
+// GetGithubRepoStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by GetGithubRepoStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubRepoStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertYAMLRequestMultiError) Error() string {
+func (m GetGithubRepoStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20204,11 +20651,12 @@ func (m GetAlertYAMLRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertYAMLRequestMultiError) AllErrors() []error { return m }
+func (m GetGithubRepoStatusResponseMultiError) AllErrors() []error { return m }
 
-// GetAlertYAMLRequestValidationError is the validation error returned by
-// GetAlertYAMLRequest.Validate if the designated constraints aren't met.
-type GetAlertYAMLRequestValidationError struct {
+// GetGithubRepoStatusResponseValidationError is the validation error returned
+// by GetGithubRepoStatusResponse.Validate if the designated constraints
+// aren't met.
+type GetGithubRepoStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20216,24 +20664,24 @@ type GetAlertYAMLRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertYAMLRequestValidationError) Field() string { return e.field }
+func (e GetGithubRepoStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertYAMLRequestValidationError) Reason() string { return e.reason }
+func (e GetGithubRepoStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertYAMLRequestValidationError) Cause() error { return e.cause }
+func (e GetGithubRepoStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertYAMLRequestValidationError) Key() bool { return e.key }
+func (e GetGithubRepoStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertYAMLRequestValidationError) ErrorName() string {
-	return "GetAlertYAMLRequestValidationError"
+func (e GetGithubRepoStatusResponseValidationError) ErrorName() string {
+	return "GetGithubRepoStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAlertYAMLRequestValidationError) Error() string {
+func (e GetGithubRepoStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20245,14 +20693,14 @@ func (e GetAlertYAMLRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertYAMLRequest.%s: %s%s",
+		"invalid %sGetGithubRepoStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertYAMLRequestValidationError{}
+var _ error = GetGithubRepoStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -20260,46 +20708,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertYAMLRequestValidationError{}
+} = GetGithubRepoStatusResponseValidationError{}
 
-// Validate checks the field values on GetAlertYAMLResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetGithubUserStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertYAMLResponse) Validate() error {
+func (m *GetGithubUserStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertYAMLResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetGithubUserStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAlertYAMLResponseMultiError, or nil if none found.
-func (m *GetAlertYAMLResponse) ValidateAll() error {
+// GetGithubUserStatusRequestMultiError, or nil if none found.
+func (m *GetGithubUserStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAlertYAMLResponse) validate(all bool) error {
+func (m *GetGithubUserStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Yaml
-
 	if len(errors) > 0 {
-		return GetAlertYAMLResponseMultiError(errors)
+		return GetGithubUserStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertYAMLResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAlertYAMLResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetAlertYAMLResponseMultiError []error
+// GetGithubUserStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetGithubUserStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubUserStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertYAMLResponseMultiError) Error() string {
+func (m GetGithubUserStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20308,11 +20754,11 @@ func (m GetAlertYAMLResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertYAMLResponseMultiError) AllErrors() []error { return m }
+func (m GetGithubUserStatusRequestMultiError) AllErrors() []error { return m }
 
-// GetAlertYAMLResponseValidationError is the validation error returned by
-// GetAlertYAMLResponse.Validate if the designated constraints aren't met.
-type GetAlertYAMLResponseValidationError struct {
+// GetGithubUserStatusRequestValidationError is the validation error returned
+// by GetGithubUserStatusRequest.Validate if the designated constraints aren't met.
+type GetGithubUserStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20320,24 +20766,24 @@ type GetAlertYAMLResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertYAMLResponseValidationError) Field() string { return e.field }
+func (e GetGithubUserStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertYAMLResponseValidationError) Reason() string { return e.reason }
+func (e GetGithubUserStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertYAMLResponseValidationError) Cause() error { return e.cause }
+func (e GetGithubUserStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertYAMLResponseValidationError) Key() bool { return e.key }
+func (e GetGithubUserStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertYAMLResponseValidationError) ErrorName() string {
-	return "GetAlertYAMLResponseValidationError"
+func (e GetGithubUserStatusRequestValidationError) ErrorName() string {
+	return "GetGithubUserStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAlertYAMLResponseValidationError) Error() string {
+func (e GetGithubUserStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20349,14 +20795,14 @@ func (e GetAlertYAMLResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertYAMLResponse.%s: %s%s",
+		"invalid %sGetGithubUserStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertYAMLResponseValidationError{}
+var _ error = GetGithubUserStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -20364,77 +20810,6807 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertYAMLResponseValidationError{}
+} = GetGithubUserStatusRequestValidationError{}
 
-// Validate checks the field values on TelemetryRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TelemetryRequest) Validate() error {
+// Validate checks the field values on GetGithubUserStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetGithubUserStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TelemetryRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetGithubUserStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TelemetryRequestMultiError, or nil if none found.
-func (m *TelemetryRequest) ValidateAll() error {
+// GetGithubUserStatusResponseMultiError, or nil if none found.
+func (m *GetGithubUserStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TelemetryRequest) validate(all bool) error {
+func (m *GetGithubUserStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for HasAccess
 
-	// no validation rules for Value
+	// no validation rules for GrantAccessUrl
 
-	if all {
-		switch v := interface{}(m.GetEvent()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TelemetryRequestValidationError{
-					field:  "Event",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TelemetryRequestValidationError{
-					field:  "Event",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TelemetryRequestValidationError{
-				field:  "Event",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for AccessToken
+
+	// no validation rules for Account
+
+	// no validation rules for UserInstallationPermission
+
+	// no validation rules for OrganizationInstallationPermissions
+
+	if len(errors) > 0 {
+		return GetGithubUserStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetGithubUserStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by GetGithubUserStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubUserStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetGithubUserStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetGithubUserStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetGithubUserStatusResponseValidationError is the validation error returned
+// by GetGithubUserStatusResponse.Validate if the designated constraints
+// aren't met.
+type GetGithubUserStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGithubUserStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGithubUserStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGithubUserStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGithubUserStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGithubUserStatusResponseValidationError) ErrorName() string {
+	return "GetGithubUserStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGithubUserStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGithubUserStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGithubUserStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGithubUserStatusResponseValidationError{}
+
+// Validate checks the field values on GetGitCredentialsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetGitCredentialsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetGitCredentialsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGitCredentialsRequestMultiError, or nil if none found.
+func (m *GetGitCredentialsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetGitCredentialsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := GetGitCredentialsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := GetGitCredentialsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetGitCredentialsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetGitCredentialsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetGitCredentialsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetGitCredentialsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetGitCredentialsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetGitCredentialsRequestMultiError) AllErrors() []error { return m }
+
+// GetGitCredentialsRequestValidationError is the validation error returned by
+// GetGitCredentialsRequest.Validate if the designated constraints aren't met.
+type GetGitCredentialsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGitCredentialsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGitCredentialsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGitCredentialsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGitCredentialsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGitCredentialsRequestValidationError) ErrorName() string {
+	return "GetGitCredentialsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGitCredentialsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGitCredentialsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGitCredentialsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGitCredentialsRequestValidationError{}
+
+// Validate checks the field values on GetGitCredentialsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetGitCredentialsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetGitCredentialsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGitCredentialsResponseMultiError, or nil if none found.
+func (m *GetGitCredentialsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetGitCredentialsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RepoUrl
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for Subpath
+
+	// no validation rules for ProdBranch
+
+	if len(errors) > 0 {
+		return GetGitCredentialsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetGitCredentialsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetGitCredentialsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetGitCredentialsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetGitCredentialsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetGitCredentialsResponseMultiError) AllErrors() []error { return m }
+
+// GetGitCredentialsResponseValidationError is the validation error returned by
+// GetGitCredentialsResponse.Validate if the designated constraints aren't met.
+type GetGitCredentialsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGitCredentialsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGitCredentialsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGitCredentialsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGitCredentialsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGitCredentialsResponseValidationError) ErrorName() string {
+	return "GetGitCredentialsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGitCredentialsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGitCredentialsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGitCredentialsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGitCredentialsResponseValidationError{}
+
+// Validate checks the field values on CreateWhitelistedDomainRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWhitelistedDomainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWhitelistedDomainRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *CreateWhitelistedDomainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateWhitelistedDomainRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWhitelistedDomainRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateWhitelistedDomainRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateWhitelistedDomainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWhitelistedDomainRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+
+// CreateWhitelistedDomainRequestValidationError is the validation error
+// returned by CreateWhitelistedDomainRequest.Validate if the designated
+// constraints aren't met.
+type CreateWhitelistedDomainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWhitelistedDomainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "CreateWhitelistedDomainRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWhitelistedDomainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWhitelistedDomainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWhitelistedDomainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWhitelistedDomainRequestValidationError{}
+
+// Validate checks the field values on CreateWhitelistedDomainResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWhitelistedDomainResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWhitelistedDomainResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *CreateWhitelistedDomainResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWhitelistedDomainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateWhitelistedDomainResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWhitelistedDomainResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateWhitelistedDomainResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateWhitelistedDomainResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWhitelistedDomainResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+
+// CreateWhitelistedDomainResponseValidationError is the validation error
+// returned by CreateWhitelistedDomainResponse.Validate if the designated
+// constraints aren't met.
+type CreateWhitelistedDomainResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWhitelistedDomainResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "CreateWhitelistedDomainResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWhitelistedDomainResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWhitelistedDomainResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWhitelistedDomainResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWhitelistedDomainResponseValidationError{}
+
+// Validate checks the field values on RemoveWhitelistedDomainRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveWhitelistedDomainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveWhitelistedDomainRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *RemoveWhitelistedDomainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveWhitelistedDomainRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := RemoveWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveWhitelistedDomainRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveWhitelistedDomainRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveWhitelistedDomainRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveWhitelistedDomainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+
+// RemoveWhitelistedDomainRequestValidationError is the validation error
+// returned by RemoveWhitelistedDomainRequest.Validate if the designated
+// constraints aren't met.
+type RemoveWhitelistedDomainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveWhitelistedDomainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "RemoveWhitelistedDomainRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveWhitelistedDomainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveWhitelistedDomainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveWhitelistedDomainRequestValidationError{}
+
+// Validate checks the field values on RemoveWhitelistedDomainResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveWhitelistedDomainResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveWhitelistedDomainResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *RemoveWhitelistedDomainResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveWhitelistedDomainResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveWhitelistedDomainResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveWhitelistedDomainResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveWhitelistedDomainResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+
+// RemoveWhitelistedDomainResponseValidationError is the validation error
+// returned by RemoveWhitelistedDomainResponse.Validate if the designated
+// constraints aren't met.
+type RemoveWhitelistedDomainResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveWhitelistedDomainResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "RemoveWhitelistedDomainResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveWhitelistedDomainResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveWhitelistedDomainResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveWhitelistedDomainResponseValidationError{}
+
+// Validate checks the field values on ListWhitelistedDomainsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWhitelistedDomainsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWhitelistedDomainsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWhitelistedDomainsRequestMultiError, or nil if none found.
+func (m *ListWhitelistedDomainsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListWhitelistedDomainsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListWhitelistedDomainsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWhitelistedDomainsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWhitelistedDomainsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListWhitelistedDomainsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWhitelistedDomainsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
+
+// ListWhitelistedDomainsRequestValidationError is the validation error
+// returned by ListWhitelistedDomainsRequest.Validate if the designated
+// constraints aren't met.
+type ListWhitelistedDomainsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWhitelistedDomainsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWhitelistedDomainsRequestValidationError) ErrorName() string {
+	return "ListWhitelistedDomainsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWhitelistedDomainsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWhitelistedDomainsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWhitelistedDomainsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWhitelistedDomainsRequestValidationError{}
+
+// Validate checks the field values on ListWhitelistedDomainsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWhitelistedDomainsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWhitelistedDomainsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWhitelistedDomainsResponseMultiError, or nil if none found.
+func (m *ListWhitelistedDomainsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDomains() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWhitelistedDomainsResponseValidationError{
+					field:  fmt.Sprintf("Domains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWhitelistedDomainsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWhitelistedDomainsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWhitelistedDomainsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListWhitelistedDomainsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWhitelistedDomainsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
+
+// ListWhitelistedDomainsResponseValidationError is the validation error
+// returned by ListWhitelistedDomainsResponse.Validate if the designated
+// constraints aren't met.
+type ListWhitelistedDomainsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWhitelistedDomainsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWhitelistedDomainsResponseValidationError) ErrorName() string {
+	return "ListWhitelistedDomainsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWhitelistedDomainsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWhitelistedDomainsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWhitelistedDomainsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWhitelistedDomainsResponseValidationError{}
+
+// Validate checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListAuditEventsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Project
+
+	// no validation rules for Actor
+
+	// no validation rules for Action
+
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListAuditEventsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListAuditEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsRequestValidationError{}
+
+// Validate checks the field values on ListAuditEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsResponseMultiError, or nil if none found.
+func (m *ListAuditEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListAuditEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsResponseValidationError) ErrorName() string {
+	return "ListAuditEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsResponseValidationError{}
+
+// Validate checks the field values on CreateProjectWhitelistedDomainRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateProjectWhitelistedDomainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateProjectWhitelistedDomainRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateProjectWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *CreateProjectWhitelistedDomainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateProjectWhitelistedDomainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateProjectWhitelistedDomainRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := CreateProjectWhitelistedDomainRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := CreateProjectWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := CreateProjectWhitelistedDomainRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateProjectWhitelistedDomainRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateProjectWhitelistedDomainRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateProjectWhitelistedDomainRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProjectWhitelistedDomainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateProjectWhitelistedDomainRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateProjectWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+
+// CreateProjectWhitelistedDomainRequestValidationError is the validation error
+// returned by CreateProjectWhitelistedDomainRequest.Validate if the
+// designated constraints aren't met.
+type CreateProjectWhitelistedDomainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateProjectWhitelistedDomainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateProjectWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateProjectWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateProjectWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateProjectWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "CreateProjectWhitelistedDomainRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateProjectWhitelistedDomainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateProjectWhitelistedDomainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateProjectWhitelistedDomainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateProjectWhitelistedDomainRequestValidationError{}
+
+// Validate checks the field values on CreateProjectWhitelistedDomainResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateProjectWhitelistedDomainResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CreateProjectWhitelistedDomainResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// CreateProjectWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *CreateProjectWhitelistedDomainResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateProjectWhitelistedDomainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateProjectWhitelistedDomainResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateProjectWhitelistedDomainResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateProjectWhitelistedDomainResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProjectWhitelistedDomainResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateProjectWhitelistedDomainResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateProjectWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+
+// CreateProjectWhitelistedDomainResponseValidationError is the validation
+// error returned by CreateProjectWhitelistedDomainResponse.Validate if the
+// designated constraints aren't met.
+type CreateProjectWhitelistedDomainResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateProjectWhitelistedDomainResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateProjectWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateProjectWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateProjectWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateProjectWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "CreateProjectWhitelistedDomainResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateProjectWhitelistedDomainResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateProjectWhitelistedDomainResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateProjectWhitelistedDomainResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateProjectWhitelistedDomainResponseValidationError{}
+
+// Validate checks the field values on RemoveProjectWhitelistedDomainRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *RemoveProjectWhitelistedDomainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveProjectWhitelistedDomainRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveProjectWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *RemoveProjectWhitelistedDomainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveProjectWhitelistedDomainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveProjectWhitelistedDomainRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := RemoveProjectWhitelistedDomainRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := RemoveProjectWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveProjectWhitelistedDomainRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveProjectWhitelistedDomainRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// RemoveProjectWhitelistedDomainRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveProjectWhitelistedDomainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveProjectWhitelistedDomainRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveProjectWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+
+// RemoveProjectWhitelistedDomainRequestValidationError is the validation error
+// returned by RemoveProjectWhitelistedDomainRequest.Validate if the
+// designated constraints aren't met.
+type RemoveProjectWhitelistedDomainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveProjectWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "RemoveProjectWhitelistedDomainRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveProjectWhitelistedDomainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveProjectWhitelistedDomainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveProjectWhitelistedDomainRequestValidationError{}
+
+// Validate checks the field values on RemoveProjectWhitelistedDomainResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *RemoveProjectWhitelistedDomainResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// RemoveProjectWhitelistedDomainResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// RemoveProjectWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *RemoveProjectWhitelistedDomainResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveProjectWhitelistedDomainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveProjectWhitelistedDomainResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveProjectWhitelistedDomainResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// RemoveProjectWhitelistedDomainResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveProjectWhitelistedDomainResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveProjectWhitelistedDomainResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveProjectWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+
+// RemoveProjectWhitelistedDomainResponseValidationError is the validation
+// error returned by RemoveProjectWhitelistedDomainResponse.Validate if the
+// designated constraints aren't met.
+type RemoveProjectWhitelistedDomainResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveProjectWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "RemoveProjectWhitelistedDomainResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveProjectWhitelistedDomainResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveProjectWhitelistedDomainResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveProjectWhitelistedDomainResponseValidationError{}
+
+// Validate checks the field values on ListProjectWhitelistedDomainsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListProjectWhitelistedDomainsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectWhitelistedDomainsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectWhitelistedDomainsRequestMultiError, or nil if none found.
+func (m *ListProjectWhitelistedDomainsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectWhitelistedDomainsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectWhitelistedDomainsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectWhitelistedDomainsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListProjectWhitelistedDomainsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectWhitelistedDomainsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListProjectWhitelistedDomainsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectWhitelistedDomainsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectWhitelistedDomainsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
+
+// ListProjectWhitelistedDomainsRequestValidationError is the validation error
+// returned by ListProjectWhitelistedDomainsRequest.Validate if the designated
+// constraints aren't met.
+type ListProjectWhitelistedDomainsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectWhitelistedDomainsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectWhitelistedDomainsRequestValidationError) ErrorName() string {
+	return "ListProjectWhitelistedDomainsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectWhitelistedDomainsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectWhitelistedDomainsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectWhitelistedDomainsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectWhitelistedDomainsRequestValidationError{}
+
+// Validate checks the field values on ListProjectWhitelistedDomainsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListProjectWhitelistedDomainsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectWhitelistedDomainsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectWhitelistedDomainsResponseMultiError, or nil if none found.
+func (m *ListProjectWhitelistedDomainsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectWhitelistedDomainsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDomains() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectWhitelistedDomainsResponseValidationError{
+					field:  fmt.Sprintf("Domains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListProjectWhitelistedDomainsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectWhitelistedDomainsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// ListProjectWhitelistedDomainsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectWhitelistedDomainsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectWhitelistedDomainsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
+
+// ListProjectWhitelistedDomainsResponseValidationError is the validation error
+// returned by ListProjectWhitelistedDomainsResponse.Validate if the
+// designated constraints aren't met.
+type ListProjectWhitelistedDomainsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectWhitelistedDomainsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectWhitelistedDomainsResponseValidationError) ErrorName() string {
+	return "ListProjectWhitelistedDomainsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectWhitelistedDomainsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectWhitelistedDomainsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectWhitelistedDomainsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectWhitelistedDomainsResponseValidationError{}
+
+// Validate checks the field values on GetRepoMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRepoMetaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRepoMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRepoMetaRequestMultiError, or nil if none found.
+func (m *GetRepoMetaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRepoMetaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Branch
+
+	if len(errors) > 0 {
+		return GetRepoMetaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRepoMetaRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRepoMetaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRepoMetaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRepoMetaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRepoMetaRequestMultiError) AllErrors() []error { return m }
+
+// GetRepoMetaRequestValidationError is the validation error returned by
+// GetRepoMetaRequest.Validate if the designated constraints aren't met.
+type GetRepoMetaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRepoMetaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRepoMetaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRepoMetaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRepoMetaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRepoMetaRequestValidationError) ErrorName() string {
+	return "GetRepoMetaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRepoMetaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRepoMetaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRepoMetaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRepoMetaRequestValidationError{}
+
+// Validate checks the field values on GetRepoMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRepoMetaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRepoMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRepoMetaResponseMultiError, or nil if none found.
+func (m *GetRepoMetaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRepoMetaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GitUrl
+
+	if all {
+		switch v := interface{}(m.GetGitUrlExpiresOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRepoMetaResponseValidationError{
+					field:  "GitUrlExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRepoMetaResponseValidationError{
+					field:  "GitUrlExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGitUrlExpiresOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRepoMetaResponseValidationError{
+				field:  "GitUrlExpiresOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GitSubpath
+
+	if len(errors) > 0 {
+		return GetRepoMetaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRepoMetaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRepoMetaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRepoMetaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRepoMetaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRepoMetaResponseMultiError) AllErrors() []error { return m }
+
+// GetRepoMetaResponseValidationError is the validation error returned by
+// GetRepoMetaResponse.Validate if the designated constraints aren't met.
+type GetRepoMetaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRepoMetaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRepoMetaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRepoMetaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRepoMetaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRepoMetaResponseValidationError) ErrorName() string {
+	return "GetRepoMetaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRepoMetaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRepoMetaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRepoMetaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRepoMetaResponseValidationError{}
+
+// Validate checks the field values on PullVirtualRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PullVirtualRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PullVirtualRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PullVirtualRepoRequestMultiError, or nil if none found.
+func (m *PullVirtualRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PullVirtualRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Branch
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 100 {
+			err := PullVirtualRepoRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 100",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return PullVirtualRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PullVirtualRepoRequestMultiError is an error wrapping multiple validation
+// errors returned by PullVirtualRepoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PullVirtualRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PullVirtualRepoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PullVirtualRepoRequestMultiError) AllErrors() []error { return m }
+
+// PullVirtualRepoRequestValidationError is the validation error returned by
+// PullVirtualRepoRequest.Validate if the designated constraints aren't met.
+type PullVirtualRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PullVirtualRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PullVirtualRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PullVirtualRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PullVirtualRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PullVirtualRepoRequestValidationError) ErrorName() string {
+	return "PullVirtualRepoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PullVirtualRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPullVirtualRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PullVirtualRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PullVirtualRepoRequestValidationError{}
+
+// Validate checks the field values on PullVirtualRepoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PullVirtualRepoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PullVirtualRepoResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PullVirtualRepoResponseMultiError, or nil if none found.
+func (m *PullVirtualRepoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PullVirtualRepoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PullVirtualRepoResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PullVirtualRepoResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PullVirtualRepoResponseValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return PullVirtualRepoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PullVirtualRepoResponseMultiError is an error wrapping multiple validation
+// errors returned by PullVirtualRepoResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PullVirtualRepoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PullVirtualRepoResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PullVirtualRepoResponseMultiError) AllErrors() []error { return m }
+
+// PullVirtualRepoResponseValidationError is the validation error returned by
+// PullVirtualRepoResponse.Validate if the designated constraints aren't met.
+type PullVirtualRepoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PullVirtualRepoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PullVirtualRepoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PullVirtualRepoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PullVirtualRepoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PullVirtualRepoResponseValidationError) ErrorName() string {
+	return "PullVirtualRepoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PullVirtualRepoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPullVirtualRepoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PullVirtualRepoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PullVirtualRepoResponseValidationError{}
+
+// Validate checks the field values on GetReportMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReportMetaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReportMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReportMetaRequestMultiError, or nil if none found.
+func (m *GetReportMetaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReportMetaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Branch
+
+	// no validation rules for Report
+
+	// no validation rules for Annotations
+
+	if all {
+		switch v := interface{}(m.GetExecutionTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReportMetaRequestValidationError{
+					field:  "ExecutionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReportMetaRequestValidationError{
+					field:  "ExecutionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExecutionTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReportMetaRequestValidationError{
+				field:  "ExecutionTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReportMetaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReportMetaRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReportMetaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetReportMetaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReportMetaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReportMetaRequestMultiError) AllErrors() []error { return m }
+
+// GetReportMetaRequestValidationError is the validation error returned by
+// GetReportMetaRequest.Validate if the designated constraints aren't met.
+type GetReportMetaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReportMetaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReportMetaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReportMetaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReportMetaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReportMetaRequestValidationError) ErrorName() string {
+	return "GetReportMetaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReportMetaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReportMetaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReportMetaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReportMetaRequestValidationError{}
+
+// Validate checks the field values on GetReportMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReportMetaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReportMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReportMetaResponseMultiError, or nil if none found.
+func (m *GetReportMetaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReportMetaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OpenUrl
+
+	// no validation rules for ExportUrl
+
+	// no validation rules for EditUrl
+
+	if all {
+		switch v := interface{}(m.GetQueryForAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReportMetaResponseValidationError{
+					field:  "QueryForAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReportMetaResponseValidationError{
+					field:  "QueryForAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueryForAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReportMetaResponseValidationError{
+				field:  "QueryForAttributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReportMetaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReportMetaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetReportMetaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetReportMetaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReportMetaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReportMetaResponseMultiError) AllErrors() []error { return m }
+
+// GetReportMetaResponseValidationError is the validation error returned by
+// GetReportMetaResponse.Validate if the designated constraints aren't met.
+type GetReportMetaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReportMetaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReportMetaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReportMetaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReportMetaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReportMetaResponseValidationError) ErrorName() string {
+	return "GetReportMetaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReportMetaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReportMetaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReportMetaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReportMetaResponseValidationError{}
+
+// Validate checks the field values on GetAlertMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAlertMetaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAlertMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAlertMetaRequestMultiError, or nil if none found.
+func (m *GetAlertMetaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAlertMetaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Branch
+
+	// no validation rules for Alert
+
+	// no validation rules for Annotations
+
+	switch v := m.QueryFor.(type) {
+	case *GetAlertMetaRequest_QueryForUserId:
+		if v == nil {
+			err := GetAlertMetaRequestValidationError{
+				field:  "QueryFor",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for QueryForUserId
+	case *GetAlertMetaRequest_QueryForUserEmail:
+		if v == nil {
+			err := GetAlertMetaRequestValidationError{
+				field:  "QueryFor",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for QueryForUserEmail
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return GetAlertMetaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAlertMetaRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAlertMetaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAlertMetaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAlertMetaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAlertMetaRequestMultiError) AllErrors() []error { return m }
+
+// GetAlertMetaRequestValidationError is the validation error returned by
+// GetAlertMetaRequest.Validate if the designated constraints aren't met.
+type GetAlertMetaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAlertMetaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAlertMetaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAlertMetaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAlertMetaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAlertMetaRequestValidationError) ErrorName() string {
+	return "GetAlertMetaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAlertMetaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAlertMetaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAlertMetaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAlertMetaRequestValidationError{}
+
+// Validate checks the field values on GetAlertMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAlertMetaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAlertMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAlertMetaResponseMultiError, or nil if none found.
+func (m *GetAlertMetaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAlertMetaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OpenUrl
+
+	// no validation rules for EditUrl
+
+	if all {
+		switch v := interface{}(m.GetQueryForAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAlertMetaResponseValidationError{
+					field:  "QueryForAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAlertMetaResponseValidationError{
+					field:  "QueryForAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueryForAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAlertMetaResponseValidationError{
+				field:  "QueryForAttributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAlertMetaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAlertMetaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAlertMetaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAlertMetaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAlertMetaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAlertMetaResponseMultiError) AllErrors() []error { return m }
+
+// GetAlertMetaResponseValidationError is the validation error returned by
+// GetAlertMetaResponse.Validate if the designated constraints aren't met.
+type GetAlertMetaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAlertMetaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAlertMetaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAlertMetaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAlertMetaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAlertMetaResponseValidationError) ErrorName() string {
+	return "GetAlertMetaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAlertMetaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAlertMetaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAlertMetaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAlertMetaResponseValidationError{}
+
+// Validate checks the field values on CreateReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReportRequestMultiError, or nil if none found.
+func (m *CreateReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	if m.GetOptions() == nil {
+		err := CreateReportRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReportRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReportRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReportRequestMultiError) AllErrors() []error { return m }
+
+// CreateReportRequestValidationError is the validation error returned by
+// CreateReportRequest.Validate if the designated constraints aren't met.
+type CreateReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReportRequestValidationError) ErrorName() string {
+	return "CreateReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReportRequestValidationError{}
+
+// Validate checks the field values on CreateReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReportResponseMultiError, or nil if none found.
+func (m *CreateReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return CreateReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReportResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReportResponseMultiError) AllErrors() []error { return m }
+
+// CreateReportResponseValidationError is the validation error returned by
+// CreateReportResponse.Validate if the designated constraints aren't met.
+type CreateReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReportResponseValidationError) ErrorName() string {
+	return "CreateReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReportResponseValidationError{}
+
+// Validate checks the field values on EditReportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EditReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditReportRequestMultiError, or nil if none found.
+func (m *EditReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if m.GetOptions() == nil {
+		err := EditReportRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditReportRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EditReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditReportRequestMultiError is an error wrapping multiple validation errors
+// returned by EditReportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EditReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditReportRequestMultiError) AllErrors() []error { return m }
+
+// EditReportRequestValidationError is the validation error returned by
+// EditReportRequest.Validate if the designated constraints aren't met.
+type EditReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditReportRequestValidationError) ErrorName() string {
+	return "EditReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditReportRequestValidationError{}
+
+// Validate checks the field values on EditReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditReportResponseMultiError, or nil if none found.
+func (m *EditReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return EditReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditReportResponseMultiError is an error wrapping multiple validation errors
+// returned by EditReportResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EditReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditReportResponseMultiError) AllErrors() []error { return m }
+
+// EditReportResponseValidationError is the validation error returned by
+// EditReportResponse.Validate if the designated constraints aren't met.
+type EditReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditReportResponseValidationError) ErrorName() string {
+	return "EditReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditReportResponseValidationError{}
+
+// Validate checks the field values on UnsubscribeReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsubscribeReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsubscribeReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsubscribeReportRequestMultiError, or nil if none found.
+func (m *UnsubscribeReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsubscribeReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return UnsubscribeReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsubscribeReportRequestMultiError is an error wrapping multiple validation
+// errors returned by UnsubscribeReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnsubscribeReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsubscribeReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsubscribeReportRequestMultiError) AllErrors() []error { return m }
+
+// UnsubscribeReportRequestValidationError is the validation error returned by
+// UnsubscribeReportRequest.Validate if the designated constraints aren't met.
+type UnsubscribeReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsubscribeReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsubscribeReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsubscribeReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsubscribeReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsubscribeReportRequestValidationError) ErrorName() string {
+	return "UnsubscribeReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsubscribeReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsubscribeReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsubscribeReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsubscribeReportRequestValidationError{}
+
+// Validate checks the field values on UnsubscribeReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsubscribeReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsubscribeReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsubscribeReportResponseMultiError, or nil if none found.
+func (m *UnsubscribeReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsubscribeReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnsubscribeReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsubscribeReportResponseMultiError is an error wrapping multiple validation
+// errors returned by UnsubscribeReportResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UnsubscribeReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsubscribeReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsubscribeReportResponseMultiError) AllErrors() []error { return m }
+
+// UnsubscribeReportResponseValidationError is the validation error returned by
+// UnsubscribeReportResponse.Validate if the designated constraints aren't met.
+type UnsubscribeReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsubscribeReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsubscribeReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsubscribeReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsubscribeReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsubscribeReportResponseValidationError) ErrorName() string {
+	return "UnsubscribeReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsubscribeReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsubscribeReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsubscribeReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsubscribeReportResponseValidationError{}
+
+// Validate checks the field values on DeleteReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReportRequestMultiError, or nil if none found.
+func (m *DeleteReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeleteReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReportRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReportRequestMultiError) AllErrors() []error { return m }
+
+// DeleteReportRequestValidationError is the validation error returned by
+// DeleteReportRequest.Validate if the designated constraints aren't met.
+type DeleteReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReportRequestValidationError) ErrorName() string {
+	return "DeleteReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReportRequestValidationError{}
+
+// Validate checks the field values on DeleteReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReportResponseMultiError, or nil if none found.
+func (m *DeleteReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReportResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReportResponseMultiError) AllErrors() []error { return m }
+
+// DeleteReportResponseValidationError is the validation error returned by
+// DeleteReportResponse.Validate if the designated constraints aren't met.
+type DeleteReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReportResponseValidationError) ErrorName() string {
+	return "DeleteReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReportResponseValidationError{}
+
+// Validate checks the field values on TriggerReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerReportRequestMultiError, or nil if none found.
+func (m *TriggerReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return TriggerReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerReportRequestMultiError is an error wrapping multiple validation
+// errors returned by TriggerReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerReportRequestMultiError) AllErrors() []error { return m }
+
+// TriggerReportRequestValidationError is the validation error returned by
+// TriggerReportRequest.Validate if the designated constraints aren't met.
+type TriggerReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerReportRequestValidationError) ErrorName() string {
+	return "TriggerReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerReportRequestValidationError{}
+
+// Validate checks the field values on TriggerReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerReportResponseMultiError, or nil if none found.
+func (m *TriggerReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TriggerReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerReportResponseMultiError is an error wrapping multiple validation
+// errors returned by TriggerReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerReportResponseMultiError) AllErrors() []error { return m }
+
+// TriggerReportResponseValidationError is the validation error returned by
+// TriggerReportResponse.Validate if the designated constraints aren't met.
+type TriggerReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerReportResponseValidationError) ErrorName() string {
+	return "TriggerReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerReportResponseValidationError{}
+
+// Validate checks the field values on GenerateReportYAMLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateReportYAMLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateReportYAMLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateReportYAMLRequestMultiError, or nil if none found.
+func (m *GenerateReportYAMLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateReportYAMLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	if m.GetOptions() == nil {
+		err := GenerateReportYAMLRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateReportYAMLRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateReportYAMLRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateReportYAMLRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GenerateReportYAMLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateReportYAMLRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateReportYAMLRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GenerateReportYAMLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateReportYAMLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateReportYAMLRequestMultiError) AllErrors() []error { return m }
+
+// GenerateReportYAMLRequestValidationError is the validation error returned by
+// GenerateReportYAMLRequest.Validate if the designated constraints aren't met.
+type GenerateReportYAMLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateReportYAMLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateReportYAMLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateReportYAMLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateReportYAMLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateReportYAMLRequestValidationError) ErrorName() string {
+	return "GenerateReportYAMLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateReportYAMLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateReportYAMLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateReportYAMLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateReportYAMLRequestValidationError{}
+
+// Validate checks the field values on GenerateReportYAMLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateReportYAMLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateReportYAMLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateReportYAMLResponseMultiError, or nil if none found.
+func (m *GenerateReportYAMLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateReportYAMLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Yaml
+
+	if len(errors) > 0 {
+		return GenerateReportYAMLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateReportYAMLResponseMultiError is an error wrapping multiple
+// validation errors returned by GenerateReportYAMLResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GenerateReportYAMLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateReportYAMLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateReportYAMLResponseMultiError) AllErrors() []error { return m }
+
+// GenerateReportYAMLResponseValidationError is the validation error returned
+// by GenerateReportYAMLResponse.Validate if the designated constraints aren't met.
+type GenerateReportYAMLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateReportYAMLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateReportYAMLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateReportYAMLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateReportYAMLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateReportYAMLResponseValidationError) ErrorName() string {
+	return "GenerateReportYAMLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateReportYAMLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateReportYAMLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateReportYAMLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateReportYAMLResponseValidationError{}
+
+// Validate checks the field values on CreateAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAlertRequestMultiError, or nil if none found.
+func (m *CreateAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	if m.GetOptions() == nil {
+		err := CreateAlertRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAlertRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAlertRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAlertRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateAlertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAlertRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAlertRequestMultiError) AllErrors() []error { return m }
+
+// CreateAlertRequestValidationError is the validation error returned by
+// CreateAlertRequest.Validate if the designated constraints aren't met.
+type CreateAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAlertRequestValidationError) ErrorName() string {
+	return "CreateAlertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAlertRequestValidationError{}
+
+// Validate checks the field values on CreateAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAlertResponseMultiError, or nil if none found.
+func (m *CreateAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return CreateAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAlertResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAlertResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAlertResponseMultiError) AllErrors() []error { return m }
+
+// CreateAlertResponseValidationError is the validation error returned by
+// CreateAlertResponse.Validate if the designated constraints aren't met.
+type CreateAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAlertResponseValidationError) ErrorName() string {
+	return "CreateAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAlertResponseValidationError{}
+
+// Validate checks the field values on EditAlertRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EditAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditAlertRequestMultiError, or nil if none found.
+func (m *EditAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if m.GetOptions() == nil {
+		err := EditAlertRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditAlertRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditAlertRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditAlertRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EditAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by EditAlertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EditAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditAlertRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditAlertRequestMultiError) AllErrors() []error { return m }
+
+// EditAlertRequestValidationError is the validation error returned by
+// EditAlertRequest.Validate if the designated constraints aren't met.
+type EditAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditAlertRequestValidationError) ErrorName() string { return "EditAlertRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EditAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditAlertRequestValidationError{}
+
+// Validate checks the field values on EditAlertResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EditAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditAlertResponseMultiError, or nil if none found.
+func (m *EditAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return EditAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditAlertResponseMultiError is an error wrapping multiple validation errors
+// returned by EditAlertResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EditAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditAlertResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditAlertResponseMultiError) AllErrors() []error { return m }
+
+// EditAlertResponseValidationError is the validation error returned by
+// EditAlertResponse.Validate if the designated constraints aren't met.
+type EditAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditAlertResponseValidationError) ErrorName() string {
+	return "EditAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditAlertResponseValidationError{}
+
+// Validate checks the field values on UnsubscribeAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsubscribeAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsubscribeAlertRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsubscribeAlertRequestMultiError, or nil if none found.
+func (m *UnsubscribeAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsubscribeAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return UnsubscribeAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsubscribeAlertRequestMultiError is an error wrapping multiple validation
+// errors returned by UnsubscribeAlertRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnsubscribeAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsubscribeAlertRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsubscribeAlertRequestMultiError) AllErrors() []error { return m }
+
+// UnsubscribeAlertRequestValidationError is the validation error returned by
+// UnsubscribeAlertRequest.Validate if the designated constraints aren't met.
+type UnsubscribeAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsubscribeAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsubscribeAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsubscribeAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsubscribeAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsubscribeAlertRequestValidationError) ErrorName() string {
+	return "UnsubscribeAlertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsubscribeAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsubscribeAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsubscribeAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsubscribeAlertRequestValidationError{}
+
+// Validate checks the field values on UnsubscribeAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsubscribeAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsubscribeAlertResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsubscribeAlertResponseMultiError, or nil if none found.
+func (m *UnsubscribeAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsubscribeAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnsubscribeAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsubscribeAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by UnsubscribeAlertResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnsubscribeAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsubscribeAlertResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsubscribeAlertResponseMultiError) AllErrors() []error { return m }
+
+// UnsubscribeAlertResponseValidationError is the validation error returned by
+// UnsubscribeAlertResponse.Validate if the designated constraints aren't met.
+type UnsubscribeAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsubscribeAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsubscribeAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsubscribeAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsubscribeAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsubscribeAlertResponseValidationError) ErrorName() string {
+	return "UnsubscribeAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsubscribeAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsubscribeAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsubscribeAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsubscribeAlertResponseValidationError{}
+
+// Validate checks the field values on DeleteAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAlertRequestMultiError, or nil if none found.
+func (m *DeleteAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeleteAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteAlertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAlertRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAlertRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAlertRequestValidationError is the validation error returned by
+// DeleteAlertRequest.Validate if the designated constraints aren't met.
+type DeleteAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAlertRequestValidationError) ErrorName() string {
+	return "DeleteAlertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAlertRequestValidationError{}
+
+// Validate checks the field values on DeleteAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAlertResponseMultiError, or nil if none found.
+func (m *DeleteAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAlertResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAlertResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAlertResponseMultiError) AllErrors() []error { return m }
+
+// DeleteAlertResponseValidationError is the validation error returned by
+// DeleteAlertResponse.Validate if the designated constraints aren't met.
+type DeleteAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAlertResponseValidationError) ErrorName() string {
+	return "DeleteAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAlertResponseValidationError{}
+
+// Validate checks the field values on GenerateAlertYAMLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateAlertYAMLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateAlertYAMLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateAlertYAMLRequestMultiError, or nil if none found.
+func (m *GenerateAlertYAMLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateAlertYAMLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	if m.GetOptions() == nil {
+		err := GenerateAlertYAMLRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateAlertYAMLRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateAlertYAMLRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateAlertYAMLRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GenerateAlertYAMLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateAlertYAMLRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateAlertYAMLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateAlertYAMLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateAlertYAMLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateAlertYAMLRequestMultiError) AllErrors() []error { return m }
+
+// GenerateAlertYAMLRequestValidationError is the validation error returned by
+// GenerateAlertYAMLRequest.Validate if the designated constraints aren't met.
+type GenerateAlertYAMLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateAlertYAMLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateAlertYAMLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateAlertYAMLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateAlertYAMLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateAlertYAMLRequestValidationError) ErrorName() string {
+	return "GenerateAlertYAMLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateAlertYAMLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateAlertYAMLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateAlertYAMLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateAlertYAMLRequestValidationError{}
+
+// Validate checks the field values on GenerateAlertYAMLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateAlertYAMLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateAlertYAMLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateAlertYAMLResponseMultiError, or nil if none found.
+func (m *GenerateAlertYAMLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateAlertYAMLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Yaml
+
+	if len(errors) > 0 {
+		return GenerateAlertYAMLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateAlertYAMLResponseMultiError is an error wrapping multiple validation
+// errors returned by GenerateAlertYAMLResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GenerateAlertYAMLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateAlertYAMLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateAlertYAMLResponseMultiError) AllErrors() []error { return m }
+
+// GenerateAlertYAMLResponseValidationError is the validation error returned by
+// GenerateAlertYAMLResponse.Validate if the designated constraints aren't met.
+type GenerateAlertYAMLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateAlertYAMLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateAlertYAMLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateAlertYAMLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateAlertYAMLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateAlertYAMLResponseValidationError) ErrorName() string {
+	return "GenerateAlertYAMLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateAlertYAMLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateAlertYAMLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateAlertYAMLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateAlertYAMLResponseValidationError{}
+
+// Validate checks the field values on GetAlertYAMLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAlertYAMLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAlertYAMLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAlertYAMLRequestMultiError, or nil if none found.
+func (m *GetAlertYAMLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAlertYAMLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetAlertYAMLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAlertYAMLRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAlertYAMLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAlertYAMLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAlertYAMLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAlertYAMLRequestMultiError) AllErrors() []error { return m }
+
+// GetAlertYAMLRequestValidationError is the validation error returned by
+// GetAlertYAMLRequest.Validate if the designated constraints aren't met.
+type GetAlertYAMLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAlertYAMLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAlertYAMLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAlertYAMLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAlertYAMLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAlertYAMLRequestValidationError) ErrorName() string {
+	return "GetAlertYAMLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAlertYAMLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAlertYAMLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAlertYAMLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAlertYAMLRequestValidationError{}
+
+// Validate checks the field values on GetAlertYAMLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAlertYAMLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAlertYAMLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAlertYAMLResponseMultiError, or nil if none found.
+func (m *GetAlertYAMLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAlertYAMLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Yaml
+
+	if len(errors) > 0 {
+		return GetAlertYAMLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAlertYAMLResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAlertYAMLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAlertYAMLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAlertYAMLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAlertYAMLResponseMultiError) AllErrors() []error { return m }
+
+// GetAlertYAMLResponseValidationError is the validation error returned by
+// GetAlertYAMLResponse.Validate if the designated constraints aren't met.
+type GetAlertYAMLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAlertYAMLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAlertYAMLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAlertYAMLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAlertYAMLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAlertYAMLResponseValidationError) ErrorName() string {
+	return "GetAlertYAMLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAlertYAMLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAlertYAMLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAlertYAMLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAlertYAMLResponseValidationError{}
+
+// Validate checks the field values on TelemetryRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TelemetryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TelemetryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TelemetryRequestMultiError, or nil if none found.
+func (m *TelemetryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TelemetryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Value
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TelemetryRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TelemetryRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TelemetryRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TelemetryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TelemetryRequestMultiError is an error wrapping multiple validation errors
+// returned by TelemetryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TelemetryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TelemetryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TelemetryRequestMultiError) AllErrors() []error { return m }
+
+// TelemetryRequestValidationError is the validation error returned by
+// TelemetryRequest.Validate if the designated constraints aren't met.
+type TelemetryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TelemetryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TelemetryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TelemetryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TelemetryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TelemetryRequestValidationError) ErrorName() string { return "TelemetryRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TelemetryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTelemetryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TelemetryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TelemetryRequestValidationError{}
+
+// Validate checks the field values on TelemetryResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TelemetryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TelemetryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TelemetryResponseMultiError, or nil if none found.
+func (m *TelemetryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TelemetryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TelemetryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TelemetryResponseMultiError is an error wrapping multiple validation errors
+// returned by TelemetryResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TelemetryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TelemetryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TelemetryResponseMultiError) AllErrors() []error { return m }
+
+// TelemetryResponseValidationError is the validation error returned by
+// TelemetryResponse.Validate if the designated constraints aren't met.
+type TelemetryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TelemetryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TelemetryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TelemetryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TelemetryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TelemetryResponseValidationError) ErrorName() string {
+	return "TelemetryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TelemetryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTelemetryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TelemetryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TelemetryResponseValidationError{}
+
+// Validate checks the field values on User with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *User) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on User with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UserMultiError, or nil if none found.
+func (m *User) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *User) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Email
+
+	// no validation rules for DisplayName
+
+	// no validation rules for PhotoUrl
+
+	if all {
+		switch v := interface{}(m.GetQuotas()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "Quotas",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserMultiError is an error wrapping multiple validation errors returned by
+// User.ValidateAll() if the designated constraints aren't met.
+type UserMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserMultiError) AllErrors() []error { return m }
+
+// UserValidationError is the validation error returned by User.Validate if the
+// designated constraints aren't met.
+type UserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUser.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserValidationError{}
+
+// Validate checks the field values on Service with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Service) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Service with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ServiceMultiError, or nil if none found.
+func (m *Service) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Service) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OrgId
+
+	// no validation rules for OrgName
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ServiceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceMultiError is an error wrapping multiple validation errors returned
+// by Service.ValidateAll() if the designated constraints aren't met.
+type ServiceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceMultiError) AllErrors() []error { return m }
+
+// ServiceValidationError is the validation error returned by Service.Validate
+// if the designated constraints aren't met.
+type ServiceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServiceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sService.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceValidationError{}
+
+// Validate checks the field values on Organization with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Organization) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Organization with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrganizationMultiError, or
+// nil if none found.
+func (m *Organization) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Organization) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetQuotas()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "Quotas",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrganizationMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationMultiError is an error wrapping multiple validation errors
+// returned by Organization.ValidateAll() if the designated constraints aren't met.
+type OrganizationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrganizationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrganizationMultiError) AllErrors() []error { return m }
+
+// OrganizationValidationError is the validation error returned by
+// Organization.Validate if the designated constraints aren't met.
+type OrganizationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationValidationError) ErrorName() string { return "OrganizationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrganizationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganization.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationValidationError{}
+
+// Validate checks the field values on UserQuotas with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserQuotas) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserQuotas with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserQuotasMultiError, or
+// nil if none found.
+func (m *UserQuotas) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserQuotas) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for SingleuserOrgs
+
 	if len(errors) > 0 {
-		return TelemetryRequestMultiError(errors)
+		return UserQuotasMultiError(errors)
 	}
 
 	return nil
 }
 
-// TelemetryRequestMultiError is an error wrapping multiple validation errors
-// returned by TelemetryRequest.ValidateAll() if the designated constraints
-// aren't met.
-type TelemetryRequestMultiError []error
+// UserQuotasMultiError is an error wrapping multiple validation errors
+// returned by UserQuotas.ValidateAll() if the designated constraints aren't met.
+type UserQuotasMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TelemetryRequestMultiError) Error() string {
+func (m UserQuotasMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20443,11 +27619,11 @@ func (m TelemetryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TelemetryRequestMultiError) AllErrors() []error { return m }
+func (m UserQuotasMultiError) AllErrors() []error { return m }
 
-// TelemetryRequestValidationError is the validation error returned by
-// TelemetryRequest.Validate if the designated constraints aren't met.
-type TelemetryRequestValidationError struct {
+// UserQuotasValidationError is the validation error returned by
+// UserQuotas.Validate if the designated constraints aren't met.
+type UserQuotasValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20455,22 +27631,22 @@ type TelemetryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TelemetryRequestValidationError) Field() string { return e.field }
+func (e UserQuotasValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TelemetryRequestValidationError) Reason() string { return e.reason }
+func (e UserQuotasValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TelemetryRequestValidationError) Cause() error { return e.cause }
+func (e UserQuotasValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TelemetryRequestValidationError) Key() bool { return e.key }
+func (e UserQuotasValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TelemetryRequestValidationError) ErrorName() string { return "TelemetryRequestValidationError" }
+func (e UserQuotasValidationError) ErrorName() string { return "UserQuotasValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TelemetryRequestValidationError) Error() string {
+func (e UserQuotasValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20482,14 +27658,14 @@ func (e TelemetryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTelemetryRequest.%s: %s%s",
+		"invalid %sUserQuotas.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TelemetryRequestValidationError{}
+var _ error = UserQuotasValidationError{}
 
 var _ interface {
 	Field() string
@@ -20497,44 +27673,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TelemetryRequestValidationError{}
+} = UserQuotasValidationError{}
 
-// Validate checks the field values on TelemetryResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TelemetryResponse) Validate() error {
+// Validate checks the field values on OrganizationQuotas with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationQuotas) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TelemetryResponse with the rules
+// ValidateAll checks the field values on OrganizationQuotas with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TelemetryResponseMultiError, or nil if none found.
-func (m *TelemetryResponse) ValidateAll() error {
+// OrganizationQuotasMultiError, or nil if none found.
+func (m *OrganizationQuotas) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TelemetryResponse) validate(all bool) error {
+func (m *OrganizationQuotas) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Projects
+
+	// no validation rules for Deployments
+
+	// no validation rules for SlotsTotal
+
+	// no validation rules for SlotsPerDeployment
+
+	// no validation rules for OutstandingInvites
+
 	if len(errors) > 0 {
-		return TelemetryResponseMultiError(errors)
+		return OrganizationQuotasMultiError(errors)
 	}
 
 	return nil
 }
 
-// TelemetryResponseMultiError is an error wrapping multiple validation errors
-// returned by TelemetryResponse.ValidateAll() if the designated constraints
+// OrganizationQuotasMultiError is an error wrapping multiple validation errors
+// returned by OrganizationQuotas.ValidateAll() if the designated constraints
 // aren't met.
-type TelemetryResponseMultiError []error
+type OrganizationQuotasMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TelemetryResponseMultiError) Error() string {
+func (m OrganizationQuotasMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20543,11 +27729,11 @@ func (m TelemetryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TelemetryResponseMultiError) AllErrors() []error { return m }
+func (m OrganizationQuotasMultiError) AllErrors() []error { return m }
 
-// TelemetryResponseValidationError is the validation error returned by
-// TelemetryResponse.Validate if the designated constraints aren't met.
-type TelemetryResponseValidationError struct {
+// OrganizationQuotasValidationError is the validation error returned by
+// OrganizationQuotas.Validate if the designated constraints aren't met.
+type OrganizationQuotasValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20555,24 +27741,24 @@ type TelemetryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TelemetryResponseValidationError) Field() string { return e.field }
+func (e OrganizationQuotasValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TelemetryResponseValidationError) Reason() string { return e.reason }
+func (e OrganizationQuotasValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TelemetryResponseValidationError) Cause() error { return e.cause }
+func (e OrganizationQuotasValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TelemetryResponseValidationError) Key() bool { return e.key }
+func (e OrganizationQuotasValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TelemetryResponseValidationError) ErrorName() string {
-	return "TelemetryResponseValidationError"
+func (e OrganizationQuotasValidationError) ErrorName() string {
+	return "OrganizationQuotasValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TelemetryResponseValidationError) Error() string {
+func (e OrganizationQuotasValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20584,14 +27770,14 @@ func (e TelemetryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTelemetryResponse.%s: %s%s",
+		"invalid %sOrganizationQuotas.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TelemetryResponseValidationError{}
+var _ error = OrganizationQuotasValidationError{}
 
 var _ interface {
 	Field() string
@@ -20599,23 +27785,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TelemetryResponseValidationError{}
+} = OrganizationQuotasValidationError{}
 
-// Validate checks the field values on User with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *User) Validate() error {
+// Validate checks the field values on Project with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Project) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on User with the rules defined in the
+// ValidateAll checks the field values on Project with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in UserMultiError, or nil if none found.
-func (m *User) ValidateAll() error {
+// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
+func (m *Project) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *User) validate(all bool) error {
+func (m *Project) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -20624,46 +27810,49 @@ func (m *User) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Email
+	// no validation rules for Name
 
-	// no validation rules for DisplayName
+	// no validation rules for OrgId
 
-	// no validation rules for PhotoUrl
+	// no validation rules for OrgName
 
-	if all {
-		switch v := interface{}(m.GetQuotas()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UserValidationError{
-				field:  "Quotas",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Description
+
+	// no validation rules for Public
+
+	// no validation rules for CreatedByUserId
+
+	// no validation rules for Provisioner
+
+	// no validation rules for GithubUrl
+
+	// no validation rules for Subpath
+
+	// no validation rules for ProdBranch
+
+	// no validation rules for ProdOlapDriver
+
+	// no validation rules for ProdOlapDsn
+
+	// no validation rules for ProdSlots
+
+	// no validation rules for ProdDeploymentId
+
+	// no validation rules for FrontendUrl
+
+	// no validation rules for ProdTtlSeconds
+
+	// no validation rules for Annotations
+
+	// no validation rules for ProdVersion
+
+	// no validation rules for PreviewDeployments
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20671,7 +27860,7 @@ func (m *User) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20680,7 +27869,7 @@ func (m *User) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UserValidationError{
+			return ProjectValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -20692,7 +27881,7 @@ func (m *User) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20700,7 +27889,7 @@ func (m *User) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20709,7 +27898,7 @@ func (m *User) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UserValidationError{
+			return ProjectValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -20718,18 +27907,18 @@ func (m *User) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UserMultiError(errors)
+		return ProjectMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserMultiError is an error wrapping multiple validation errors returned by
-// User.ValidateAll() if the designated constraints aren't met.
-type UserMultiError []error
+// ProjectMultiError is an error wrapping multiple validation errors returned
+// by Project.ValidateAll() if the designated constraints aren't met.
+type ProjectMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserMultiError) Error() string {
+func (m ProjectMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20738,11 +27927,11 @@ func (m UserMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserMultiError) AllErrors() []error { return m }
+func (m ProjectMultiError) AllErrors() []error { return m }
 
-// UserValidationError is the validation error returned by User.Validate if the
-// designated constraints aren't met.
-type UserValidationError struct {
+// ProjectValidationError is the validation error returned by Project.Validate
+// if the designated constraints aren't met.
+type ProjectValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20750,22 +27939,22 @@ type UserValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserValidationError) Field() string { return e.field }
+func (e ProjectValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserValidationError) Reason() string { return e.reason }
+func (e ProjectValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserValidationError) Cause() error { return e.cause }
+func (e ProjectValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserValidationError) Key() bool { return e.key }
+func (e ProjectValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserValidationError) Error() string {
+func (e ProjectValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20777,14 +27966,14 @@ func (e UserValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUser.%s: %s%s",
+		"invalid %sProject.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserValidationError{}
+var _ error = ProjectValidationError{}
 
 var _ interface {
 	Field() string
@@ -20792,23 +27981,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserValidationError{}
+} = ProjectValidationError{}
 
-// Validate checks the field values on Service with the rules defined in the
+// Validate checks the field values on Deployment with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Service) Validate() error {
+func (m *Deployment) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Service with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ServiceMultiError, or nil if none found.
-func (m *Service) ValidateAll() error {
+// ValidateAll checks the field values on Deployment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeploymentMultiError, or
+// nil if none found.
+func (m *Deployment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Service) validate(all bool) error {
+func (m *Deployment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -20817,17 +28007,25 @@ func (m *Service) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Name
+	// no validation rules for ProjectId
 
-	// no validation rules for OrgId
+	// no validation rules for Slots
 
-	// no validation rules for OrgName
+	// no validation rules for Branch
+
+	// no validation rules for RuntimeHost
+
+	// no validation rules for RuntimeInstanceId
+
+	// no validation rules for Status
+
+	// no validation rules for StatusMessage
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ServiceValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20835,7 +28033,7 @@ func (m *Service) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ServiceValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20844,7 +28042,7 @@ func (m *Service) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ServiceValidationError{
+			return DeploymentValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -20856,7 +28054,7 @@ func (m *Service) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ServiceValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20864,7 +28062,7 @@ func (m *Service) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ServiceValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20873,7 +28071,7 @@ func (m *Service) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ServiceValidationError{
+			return DeploymentValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -20881,19 +28079,50 @@ func (m *Service) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for PullRequest
+
+	if all {
+		switch v := interface{}(m.GetExpiresOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeploymentValidationError{
+					field:  "ExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeploymentValidationError{
+					field:  "ExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeploymentValidationError{
+				field:  "ExpiresOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ServiceMultiError(errors)
+		return DeploymentMultiError(errors)
 	}
 
 	return nil
 }
 
-// ServiceMultiError is an error wrapping multiple validation errors returned
-// by Service.ValidateAll() if the designated constraints aren't met.
-type ServiceMultiError []error
+// DeploymentMultiError is an error wrapping multiple validation errors
+// returned by Deployment.ValidateAll() if the designated constraints aren't met.
+type DeploymentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ServiceMultiError) Error() string {
+func (m DeploymentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20902,11 +28131,11 @@ func (m ServiceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ServiceMultiError) AllErrors() []error { return m }
+func (m DeploymentMultiError) AllErrors() []error { return m }
 
-// ServiceValidationError is the validation error returned by Service.Validate
-// if the designated constraints aren't met.
-type ServiceValidationError struct {
+// DeploymentValidationError is the validation error returned by
+// Deployment.Validate if the designated constraints aren't met.
+type DeploymentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20914,22 +28143,22 @@ type ServiceValidationError struct {
 }
 
 // Field function returns field value.
-func (e ServiceValidationError) Field() string { return e.field }
+func (e DeploymentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ServiceValidationError) Reason() string { return e.reason }
+func (e DeploymentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ServiceValidationError) Cause() error { return e.cause }
+func (e DeploymentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ServiceValidationError) Key() bool { return e.key }
+func (e DeploymentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
+func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ServiceValidationError) Error() string {
+func (e DeploymentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20941,14 +28170,14 @@ func (e ServiceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sService.%s: %s%s",
+		"invalid %sDeployment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ServiceValidationError{}
+var _ error = DeploymentValidationError{}
 
 var _ interface {
 	Field() string
@@ -20956,136 +28185,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ServiceValidationError{}
+} = DeploymentValidationError{}
 
-// Validate checks the field values on Organization with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Organization) Validate() error {
+// Validate checks the field values on OrganizationPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationPermissions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Organization with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in OrganizationMultiError, or
-// nil if none found.
-func (m *Organization) ValidateAll() error {
+// ValidateAll checks the field values on OrganizationPermissions with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationPermissionsMultiError, or nil if none found.
+func (m *OrganizationPermissions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Organization) validate(all bool) error {
+func (m *OrganizationPermissions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for ReadOrg
 
-	// no validation rules for Name
+	// no validation rules for ManageOrg
 
-	// no validation rules for Description
+	// no validation rules for ReadProjects
 
-	if all {
-		switch v := interface{}(m.GetQuotas()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
-				field:  "Quotas",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CreateProjects
 
-	if all {
-		switch v := interface{}(m.GetCreatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
-				field:  "CreatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ManageProjects
 
-	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
-				field:  "UpdatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ReadOrgMembers
+
+	// no validation rules for ManageOrgMembers
 
 	if len(errors) > 0 {
-		return OrganizationMultiError(errors)
+		return OrganizationPermissionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrganizationMultiError is an error wrapping multiple validation errors
-// returned by Organization.ValidateAll() if the designated constraints aren't met.
-type OrganizationMultiError []error
+// OrganizationPermissionsMultiError is an error wrapping multiple validation
+// errors returned by OrganizationPermissions.ValidateAll() if the designated
+// constraints aren't met.
+type OrganizationPermissionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationMultiError) Error() string {
+func (m OrganizationPermissionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21094,11 +28245,11 @@ func (m OrganizationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrganizationMultiError) AllErrors() []error { return m }
+func (m OrganizationPermissionsMultiError) AllErrors() []error { return m }
 
-// OrganizationValidationError is the validation error returned by
-// Organization.Validate if the designated constraints aren't met.
-type OrganizationValidationError struct {
+// OrganizationPermissionsValidationError is the validation error returned by
+// OrganizationPermissions.Validate if the designated constraints aren't met.
+type OrganizationPermissionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21106,22 +28257,24 @@ type OrganizationValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrganizationValidationError) Field() string { return e.field }
+func (e OrganizationPermissionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrganizationValidationError) Reason() string { return e.reason }
+func (e OrganizationPermissionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrganizationValidationError) Cause() error { return e.cause }
+func (e OrganizationPermissionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrganizationValidationError) Key() bool { return e.key }
+func (e OrganizationPermissionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrganizationValidationError) ErrorName() string { return "OrganizationValidationError" }
+func (e OrganizationPermissionsValidationError) ErrorName() string {
+	return "OrganizationPermissionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e OrganizationValidationError) Error() string {
+func (e OrganizationPermissionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21133,14 +28286,14 @@ func (e OrganizationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrganization.%s: %s%s",
+		"invalid %sOrganizationPermissions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrganizationValidationError{}
+var _ error = OrganizationPermissionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -21148,45 +28301,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrganizationValidationError{}
+} = OrganizationPermissionsValidationError{}
 
-// Validate checks the field values on UserQuotas with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *UserQuotas) Validate() error {
+// Validate checks the field values on ProjectPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProjectPermissions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserQuotas with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UserQuotasMultiError, or
-// nil if none found.
-func (m *UserQuotas) ValidateAll() error {
+// ValidateAll checks the field values on ProjectPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProjectPermissionsMultiError, or nil if none found.
+func (m *ProjectPermissions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserQuotas) validate(all bool) error {
+func (m *ProjectPermissions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SingleuserOrgs
+	// no validation rules for ReadProject
+
+	// no validation rules for ManageProject
+
+	// no validation rules for ReadProd
+
+	// no validation rules for ReadProdStatus
+
+	// no validation rules for ManageProd
+
+	// no validation rules for ReadDev
+
+	// no validation rules for ReadDevStatus
+
+	// no validation rules for ManageDev
+
+	// no validation rules for ReadProjectMembers
+
+	// no validation rules for ManageProjectMembers
+
+	// no validation rules for CreateReports
+
+	// no validation rules for ManageReports
+
+	// no validation rules for CreateAlerts
+
+	// no validation rules for ManageAlerts
 
 	if len(errors) > 0 {
-		return UserQuotasMultiError(errors)
+		return ProjectPermissionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserQuotasMultiError is an error wrapping multiple validation errors
-// returned by UserQuotas.ValidateAll() if the designated constraints aren't met.
-type UserQuotasMultiError []error
+// ProjectPermissionsMultiError is an error wrapping multiple validation errors
+// returned by ProjectPermissions.ValidateAll() if the designated constraints
+// aren't met.
+type ProjectPermissionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserQuotasMultiError) Error() string {
+func (m ProjectPermissionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21195,11 +28375,11 @@ func (m UserQuotasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserQuotasMultiError) AllErrors() []error { return m }
+func (m ProjectPermissionsMultiError) AllErrors() []error { return m }
 
-// UserQuotasValidationError is the validation error returned by
-// UserQuotas.Validate if the designated constraints aren't met.
-type UserQuotasValidationError struct {
+// ProjectPermissionsValidationError is the validation error returned by
+// ProjectPermissions.Validate if the designated constraints aren't met.
+type ProjectPermissionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21207,22 +28387,24 @@ type UserQuotasValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserQuotasValidationError) Field() string { return e.field }
+func (e ProjectPermissionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserQuotasValidationError) Reason() string { return e.reason }
+func (e ProjectPermissionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserQuotasValidationError) Cause() error { return e.cause }
+func (e ProjectPermissionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserQuotasValidationError) Key() bool { return e.key }
+func (e ProjectPermissionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserQuotasValidationError) ErrorName() string { return "UserQuotasValidationError" }
+func (e ProjectPermissionsValidationError) ErrorName() string {
+	return "ProjectPermissionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserQuotasValidationError) Error() string {
+func (e ProjectPermissionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21234,14 +28416,14 @@ func (e UserQuotasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserQuotas.%s: %s%s",
+		"invalid %sProjectPermissions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserQuotasValidationError{}
+var _ error = ProjectPermissionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -21249,54 +28431,137 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserQuotasValidationError{}
+} = ProjectPermissionsValidationError{}
 
-// Validate checks the field values on OrganizationQuotas with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OrganizationQuotas) Validate() error {
+// Validate checks the field values on OrganizationRole with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationRole) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrganizationQuotas with the rules
+// ValidateAll checks the field values on OrganizationRole with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OrganizationQuotasMultiError, or nil if none found.
-func (m *OrganizationQuotas) ValidateAll() error {
+// OrganizationRoleMultiError, or nil if none found.
+func (m *OrganizationRole) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrganizationQuotas) validate(all bool) error {
+func (m *OrganizationRole) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Projects
+	// no validation rules for Id
 
-	// no validation rules for Deployments
+	// no validation rules for Name
 
-	// no validation rules for SlotsTotal
+	// no validation rules for Builtin
 
-	// no validation rules for SlotsPerDeployment
+	if all {
+		switch v := interface{}(m.GetPermissions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationRoleValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationRoleValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermissions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationRoleValidationError{
+				field:  "Permissions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for OutstandingInvites
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationRoleValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationRoleValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationRoleValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationRoleValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationRoleValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationRoleValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return OrganizationQuotasMultiError(errors)
+		return OrganizationRoleMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrganizationQuotasMultiError is an error wrapping multiple validation errors
-// returned by OrganizationQuotas.ValidateAll() if the designated constraints
+// OrganizationRoleMultiError is an error wrapping multiple validation errors
+// returned by OrganizationRole.ValidateAll() if the designated constraints
 // aren't met.
-type OrganizationQuotasMultiError []error
+type OrganizationRoleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationQuotasMultiError) Error() string {
+func (m OrganizationRoleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21305,11 +28570,11 @@ func (m OrganizationQuotasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrganizationQuotasMultiError) AllErrors() []error { return m }
+func (m OrganizationRoleMultiError) AllErrors() []error { return m }
 
-// OrganizationQuotasValidationError is the validation error returned by
-// OrganizationQuotas.Validate if the designated constraints aren't met.
-type OrganizationQuotasValidationError struct {
+// OrganizationRoleValidationError is the validation error returned by
+// OrganizationRole.Validate if the designated constraints aren't met.
+type OrganizationRoleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21317,24 +28582,22 @@ type OrganizationQuotasValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrganizationQuotasValidationError) Field() string { return e.field }
+func (e OrganizationRoleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrganizationQuotasValidationError) Reason() string { return e.reason }
+func (e OrganizationRoleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrganizationQuotasValidationError) Cause() error { return e.cause }
+func (e OrganizationRoleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrganizationQuotasValidationError) Key() bool { return e.key }
+func (e OrganizationRoleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrganizationQuotasValidationError) ErrorName() string {
-	return "OrganizationQuotasValidationError"
-}
+func (e OrganizationRoleValidationError) ErrorName() string { return "OrganizationRoleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrganizationQuotasValidationError) Error() string {
+func (e OrganizationRoleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21346,14 +28609,14 @@ func (e OrganizationQuotasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrganizationQuotas.%s: %s%s",
+		"invalid %sOrganizationRole.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrganizationQuotasValidationError{}
+var _ error = OrganizationRoleValidationError{}
 
 var _ interface {
 	Field() string
@@ -21361,23 +28624,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrganizationQuotasValidationError{}
+} = OrganizationRoleValidationError{}
 
-// Validate checks the field values on Project with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ProjectRole with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Project) Validate() error {
+func (m *ProjectRole) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Project with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
-func (m *Project) ValidateAll() error {
+// ValidateAll checks the field values on ProjectRole with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProjectRoleMultiError, or
+// nil if none found.
+func (m *ProjectRole) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Project) validate(all bool) error {
+func (m *ProjectRole) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -21388,45 +28652,42 @@ func (m *Project) validate(all bool) error {
 
 	// no validation rules for Name
 
-	// no validation rules for OrgId
-
-	// no validation rules for OrgName
-
-	// no validation rules for Description
-
-	// no validation rules for Public
-
-	// no validation rules for CreatedByUserId
-
-	// no validation rules for Provisioner
-
-	// no validation rules for GithubUrl
-
-	// no validation rules for Subpath
-
-	// no validation rules for ProdBranch
-
-	// no validation rules for ProdOlapDriver
-
-	// no validation rules for ProdOlapDsn
-
-	// no validation rules for ProdSlots
-
-	// no validation rules for ProdDeploymentId
-
-	// no validation rules for FrontendUrl
-
-	// no validation rules for ProdTtlSeconds
-
-	// no validation rules for Annotations
+	// no validation rules for Builtin
 
-	// no validation rules for ProdVersion
+	if all {
+		switch v := interface{}(m.GetPermissions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectRoleValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectRoleValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermissions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectRoleValidationError{
+				field:  "Permissions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
+				errors = append(errors, ProjectRoleValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21434,7 +28695,7 @@ func (m *Project) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
+				errors = append(errors, ProjectRoleValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21443,7 +28704,7 @@ func (m *Project) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
+			return ProjectRoleValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -21455,7 +28716,7 @@ func (m *Project) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
+				errors = append(errors, ProjectRoleValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21463,7 +28724,7 @@ func (m *Project) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
+				errors = append(errors, ProjectRoleValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21472,7 +28733,7 @@ func (m *Project) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
+			return ProjectRoleValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -21481,18 +28742,18 @@ func (m *Project) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ProjectMultiError(errors)
+		return ProjectRoleMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectMultiError is an error wrapping multiple validation errors returned
-// by Project.ValidateAll() if the designated constraints aren't met.
-type ProjectMultiError []error
+// ProjectRoleMultiError is an error wrapping multiple validation errors
+// returned by ProjectRole.ValidateAll() if the designated constraints aren't met.
+type ProjectRoleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectMultiError) Error() string {
+func (m ProjectRoleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21501,11 +28762,11 @@ func (m ProjectMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectMultiError) AllErrors() []error { return m }
+func (m ProjectRoleMultiError) AllErrors() []error { return m }
 
-// ProjectValidationError is the validation error returned by Project.Validate
-// if the designated constraints aren't met.
-type ProjectValidationError struct {
+// ProjectRoleValidationError is the validation error returned by
+// ProjectRole.Validate if the designated constraints aren't met.
+type ProjectRoleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21513,22 +28774,22 @@ type ProjectValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectValidationError) Field() string { return e.field }
+func (e ProjectRoleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectValidationError) Reason() string { return e.reason }
+func (e ProjectRoleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectValidationError) Cause() error { return e.cause }
+func (e ProjectRoleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectValidationError) Key() bool { return e.key }
+func (e ProjectRoleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
+func (e ProjectRoleValidationError) ErrorName() string { return "ProjectRoleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ProjectValidationError) Error() string {
+func (e ProjectRoleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21540,14 +28801,14 @@ func (e ProjectValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProject.%s: %s%s",
+		"invalid %sProjectRole.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectValidationError{}
+var _ error = ProjectRoleValidationError{}
 
 var _ interface {
 	Field() string
@@ -21555,51 +28816,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectValidationError{}
+} = ProjectRoleValidationError{}
 
-// Validate checks the field values on Deployment with the rules defined in the
+// Validate checks the field values on Member with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Deployment) Validate() error {
+func (m *Member) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Deployment with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeploymentMultiError, or
-// nil if none found.
-func (m *Deployment) ValidateAll() error {
+// ValidateAll checks the field values on Member with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MemberMultiError, or nil if none found.
+func (m *Member) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Deployment) validate(all bool) error {
+func (m *Member) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for ProjectId
-
-	// no validation rules for Slots
-
-	// no validation rules for Branch
-
-	// no validation rules for RuntimeHost
+	// no validation rules for UserId
 
-	// no validation rules for RuntimeInstanceId
+	// no validation rules for UserEmail
 
-	// no validation rules for Status
+	// no validation rules for UserName
 
-	// no validation rules for StatusMessage
+	// no validation rules for RoleName
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21607,7 +28859,7 @@ func (m *Deployment) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21616,7 +28868,7 @@ func (m *Deployment) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeploymentValidationError{
+			return MemberValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -21628,7 +28880,7 @@ func (m *Deployment) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21636,7 +28888,7 @@ func (m *Deployment) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21645,7 +28897,7 @@ func (m *Deployment) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeploymentValidationError{
+			return MemberValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -21654,18 +28906,18 @@ func (m *Deployment) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeploymentMultiError(errors)
+		return MemberMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeploymentMultiError is an error wrapping multiple validation errors
-// returned by Deployment.ValidateAll() if the designated constraints aren't met.
-type DeploymentMultiError []error
+// MemberMultiError is an error wrapping multiple validation errors returned by
+// Member.ValidateAll() if the designated constraints aren't met.
+type MemberMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeploymentMultiError) Error() string {
+func (m MemberMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21674,11 +28926,11 @@ func (m DeploymentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeploymentMultiError) AllErrors() []error { return m }
+func (m MemberMultiError) AllErrors() []error { return m }
 
-// DeploymentValidationError is the validation error returned by
-// Deployment.Validate if the designated constraints aren't met.
-type DeploymentValidationError struct {
+// MemberValidationError is the validation error returned by Member.Validate if
+// the designated constraints aren't met.
+type MemberValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21686,22 +28938,22 @@ type DeploymentValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeploymentValidationError) Field() string { return e.field }
+func (e MemberValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeploymentValidationError) Reason() string { return e.reason }
+func (e MemberValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeploymentValidationError) Cause() error { return e.cause }
+func (e MemberValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeploymentValidationError) Key() bool { return e.key }
+func (e MemberValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
+func (e MemberValidationError) ErrorName() string { return "MemberValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeploymentValidationError) Error() string {
+func (e MemberValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21713,14 +28965,14 @@ func (e DeploymentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeployment.%s: %s%s",
+		"invalid %sMember.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeploymentValidationError{}
+var _ error = MemberValidationError{}
 
 var _ interface {
 	Field() string
@@ -21728,58 +28980,107 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeploymentValidationError{}
+} = MemberValidationError{}
 
-// Validate checks the field values on OrganizationPermissions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OrganizationPermissions) Validate() error {
+// Validate checks the field values on Usergroup with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Usergroup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrganizationPermissions with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// OrganizationPermissionsMultiError, or nil if none found.
-func (m *OrganizationPermissions) ValidateAll() error {
+// ValidateAll checks the field values on Usergroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UsergroupMultiError, or nil
+// if none found.
+func (m *Usergroup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrganizationPermissions) validate(all bool) error {
+func (m *Usergroup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReadOrg
-
-	// no validation rules for ManageOrg
-
-	// no validation rules for ReadProjects
+	// no validation rules for GroupId
 
-	// no validation rules for CreateProjects
+	// no validation rules for GroupName
 
-	// no validation rules for ManageProjects
+	// no validation rules for Managed
 
-	// no validation rules for ReadOrgMembers
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UsergroupValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UsergroupValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UsergroupValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for ManageOrgMembers
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UsergroupValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UsergroupValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UsergroupValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return OrganizationPermissionsMultiError(errors)
+		return UsergroupMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrganizationPermissionsMultiError is an error wrapping multiple validation
-// errors returned by OrganizationPermissions.ValidateAll() if the designated
-// constraints aren't met.
-type OrganizationPermissionsMultiError []error
+// UsergroupMultiError is an error wrapping multiple validation errors returned
+// by Usergroup.ValidateAll() if the designated constraints aren't met.
+type UsergroupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationPermissionsMultiError) Error() string {
+func (m UsergroupMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21788,11 +29089,11 @@ func (m OrganizationPermissionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrganizationPermissionsMultiError) AllErrors() []error { return m }
+func (m UsergroupMultiError) AllErrors() []error { return m }
 
-// OrganizationPermissionsValidationError is the validation error returned by
-// OrganizationPermissions.Validate if the designated constraints aren't met.
-type OrganizationPermissionsValidationError struct {
+// UsergroupValidationError is the validation error returned by
+// Usergroup.Validate if the designated constraints aren't met.
+type UsergroupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21800,24 +29101,22 @@ type OrganizationPermissionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrganizationPermissionsValidationError) Field() string { return e.field }
+func (e UsergroupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrganizationPermissionsValidationError) Reason() string { return e.reason }
+func (e UsergroupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrganizationPermissionsValidationError) Cause() error { return e.cause }
+func (e UsergroupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrganizationPermissionsValidationError) Key() bool { return e.key }
+func (e UsergroupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrganizationPermissionsValidationError) ErrorName() string {
-	return "OrganizationPermissionsValidationError"
-}
+func (e UsergroupValidationError) ErrorName() string { return "UsergroupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrganizationPermissionsValidationError) Error() string {
+func (e UsergroupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21829,14 +29128,14 @@ func (e OrganizationPermissionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrganizationPermissions.%s: %s%s",
+		"invalid %sUsergroup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrganizationPermissionsValidationError{}
+var _ error = UsergroupValidationError{}
 
 var _ interface {
 	Field() string
@@ -21844,72 +29143,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrganizationPermissionsValidationError{}
+} = UsergroupValidationError{}
 
-// Validate checks the field values on ProjectPermissions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProjectPermissions) Validate() error {
+// Validate checks the field values on MemberUsergroup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MemberUsergroup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProjectPermissions with the rules
+// ValidateAll checks the field values on MemberUsergroup with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ProjectPermissionsMultiError, or nil if none found.
-func (m *ProjectPermissions) ValidateAll() error {
+// MemberUsergroupMultiError, or nil if none found.
+func (m *MemberUsergroup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProjectPermissions) validate(all bool) error {
+func (m *MemberUsergroup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReadProject
-
-	// no validation rules for ManageProject
-
-	// no validation rules for ReadProd
-
-	// no validation rules for ReadProdStatus
-
-	// no validation rules for ManageProd
-
-	// no validation rules for ReadDev
-
-	// no validation rules for ReadDevStatus
-
-	// no validation rules for ManageDev
-
-	// no validation rules for ReadProjectMembers
+	// no validation rules for GroupId
 
-	// no validation rules for ManageProjectMembers
+	// no validation rules for GroupName
 
-	// no validation rules for CreateReports
+	// no validation rules for RoleName
 
-	// no validation rules for ManageReports
+	// no validation rules for Managed
 
-	// no validation rules for CreateAlerts
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberUsergroupValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberUsergroupValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberUsergroupValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for ManageAlerts
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberUsergroupValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberUsergroupValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberUsergroupValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ProjectPermissionsMultiError(errors)
+		return MemberUsergroupMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectPermissionsMultiError is an error wrapping multiple validation errors
-// returned by ProjectPermissions.ValidateAll() if the designated constraints
+// MemberUsergroupMultiError is an error wrapping multiple validation errors
+// returned by MemberUsergroup.ValidateAll() if the designated constraints
 // aren't met.
-type ProjectPermissionsMultiError []error
+type MemberUsergroupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectPermissionsMultiError) Error() string {
+func (m MemberUsergroupMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21918,11 +29255,11 @@ func (m ProjectPermissionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectPermissionsMultiError) AllErrors() []error { return m }
+func (m MemberUsergroupMultiError) AllErrors() []error { return m }
 
-// ProjectPermissionsValidationError is the validation error returned by
-// ProjectPermissions.Validate if the designated constraints aren't met.
-type ProjectPermissionsValidationError struct {
+// MemberUsergroupValidationError is the validation error returned by
+// MemberUsergroup.Validate if the designated constraints aren't met.
+type MemberUsergroupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21930,24 +29267,22 @@ type ProjectPermissionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectPermissionsValidationError) Field() string { return e.field }
+func (e MemberUsergroupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectPermissionsValidationError) Reason() string { return e.reason }
+func (e MemberUsergroupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectPermissionsValidationError) Cause() error { return e.cause }
+func (e MemberUsergroupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectPermissionsValidationError) Key() bool { return e.key }
+func (e MemberUsergroupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectPermissionsValidationError) ErrorName() string {
-	return "ProjectPermissionsValidationError"
-}
+func (e MemberUsergroupValidationError) ErrorName() string { return "MemberUsergroupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ProjectPermissionsValidationError) Error() string {
+func (e MemberUsergroupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21959,14 +29294,14 @@ func (e ProjectPermissionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProjectPermissions.%s: %s%s",
+		"invalid %sMemberUsergroup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectPermissionsValidationError{}
+var _ error = MemberUsergroupValidationError{}
 
 var _ interface {
 	Field() string
@@ -21974,23 +29309,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectPermissionsValidationError{}
+} = MemberUsergroupValidationError{}
 
-// Validate checks the field values on Member with the rules defined in the
+// Validate checks the field values on MemberUser with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Member) Validate() error {
+func (m *MemberUser) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Member with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in MemberMultiError, or nil if none found.
-func (m *Member) ValidateAll() error {
+// ValidateAll checks the field values on MemberUser with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MemberUserMultiError, or
+// nil if none found.
+func (m *MemberUser) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Member) validate(all bool) error {
+func (m *MemberUser) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -22003,13 +29339,11 @@ func (m *Member) validate(all bool) error {
 
 	// no validation rules for UserName
 
-	// no validation rules for RoleName
-
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MemberValidationError{
+				errors = append(errors, MemberUserValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -22017,7 +29351,7 @@ func (m *Member) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MemberValidationError{
+				errors = append(errors, MemberUserValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -22026,7 +29360,7 @@ func (m *Member) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MemberValidationError{
+			return MemberUserValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -22034,48 +29368,19 @@ func (m *Member) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MemberValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MemberValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MemberValidationError{
-				field:  "UpdatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return MemberMultiError(errors)
+		return MemberUserMultiError(errors)
 	}
 
 	return nil
 }
 
-// MemberMultiError is an error wrapping multiple validation errors returned by
-// Member.ValidateAll() if the designated constraints aren't met.
-type MemberMultiError []error
+// MemberUserMultiError is an error wrapping multiple validation errors
+// returned by MemberUser.ValidateAll() if the designated constraints aren't met.
+type MemberUserMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MemberMultiError) Error() string {
+func (m MemberUserMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -22084,11 +29389,11 @@ func (m MemberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MemberMultiError) AllErrors() []error { return m }
+func (m MemberUserMultiError) AllErrors() []error { return m }
 
-// MemberValidationError is the validation error returned by Member.Validate if
-// the designated constraints aren't met.
-type MemberValidationError struct {
+// MemberUserValidationError is the validation error returned by
+// MemberUser.Validate if the designated constraints aren't met.
+type MemberUserValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -22096,22 +29401,22 @@ type MemberValidationError struct {
 }
 
 // Field function returns field value.
-func (e MemberValidationError) Field() string { return e.field }
+func (e MemberUserValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MemberValidationError) Reason() string { return e.reason }
+func (e MemberUserValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MemberValidationError) Cause() error { return e.cause }
+func (e MemberUserValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MemberValidationError) Key() bool { return e.key }
+func (e MemberUserValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MemberValidationError) ErrorName() string { return "MemberValidationError" }
+func (e MemberUserValidationError) ErrorName() string { return "MemberUserValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MemberValidationError) Error() string {
+func (e MemberUserValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -22123,14 +29428,14 @@ func (e MemberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMember.%s: %s%s",
+		"invalid %sMemberUser.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MemberValidationError{}
+var _ error = MemberUserValidationError{}
 
 var _ interface {
 	Field() string
@@ -22138,7 +29443,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MemberValidationError{}
+} = MemberUserValidationError{}
 
 // Validate checks the field values on UserInvite with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -22351,6 +29656,181 @@ var _ interface {
 	ErrorName() string
 } = WhitelistedDomainValidationError{}
 
+// Validate checks the field values on AuditEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProjectId
+
+	// no validation rules for ProjectName
+
+	// no validation rules for ActorType
+
+	// no validation rules for ActorId
+
+	// no validation rules for ActorName
+
+	// no validation rules for ActorSuperuser
+
+	// no validation rules for Action
+
+	// no validation rules for Target
+
+	if all {
+		switch v := interface{}(m.GetDetails()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Details",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Details",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDetails()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "Details",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuditEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEventMultiError) AllErrors() []error { return m }
+
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEventValidationError{}
+
 // Validate checks the field values on Bookmark with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -636,6 +636,243 @@ var _ interface {
 	ErrorName() string
 } = QueryBatchResponseValidationError{}
 
+// Validate checks the field values on StreamAPIRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StreamAPIRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamAPIRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamAPIRequestMultiError, or nil if none found.
+func (m *StreamAPIRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamAPIRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetArgs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamAPIRequestValidationError{
+					field:  "Args",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamAPIRequestValidationError{
+					field:  "Args",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArgs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamAPIRequestValidationError{
+				field:  "Args",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StreamAPIRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamAPIRequestMultiError is an error wrapping multiple validation errors
+// returned by StreamAPIRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StreamAPIRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamAPIRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamAPIRequestMultiError) AllErrors() []error { return m }
+
+// StreamAPIRequestValidationError is the validation error returned by
+// StreamAPIRequest.Validate if the designated constraints aren't met.
+type StreamAPIRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamAPIRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamAPIRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamAPIRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamAPIRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamAPIRequestValidationError) ErrorName() string { return "StreamAPIRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StreamAPIRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamAPIRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamAPIRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamAPIRequestValidationError{}
+
+// Validate checks the field values on StreamAPIResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StreamAPIResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamAPIResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamAPIResponseMultiError, or nil if none found.
+func (m *StreamAPIResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamAPIResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return StreamAPIResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamAPIResponseMultiError is an error wrapping multiple validation errors
+// returned by StreamAPIResponse.ValidateAll() if the designated constraints
+// aren't met.
+type StreamAPIResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamAPIResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamAPIResponseMultiError) AllErrors() []error { return m }
+
+// StreamAPIResponseValidationError is the validation error returned by
+// StreamAPIResponse.Validate if the designated constraints aren't met.
+type StreamAPIResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamAPIResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamAPIResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamAPIResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamAPIResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamAPIResponseValidationError) ErrorName() string {
+	return "StreamAPIResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamAPIResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamAPIResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamAPIResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamAPIResponseValidationError{}
+
 // Validate checks the field values on ExportRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion8
 const (
 	QueryService_Query_FullMethodName                       = "/rill.runtime.v1.QueryService/Query"
 	QueryService_QueryBatch_FullMethodName                  = "/rill.runtime.v1.QueryService/QueryBatch"
+	QueryService_StreamAPI_FullMethodName                   = "/rill.runtime.v1.QueryService/StreamAPI"
 	QueryService_Export_FullMethodName                      = "/rill.runtime.v1.QueryService/Export"
 	QueryService_MetricsViewAggregation_FullMethodName      = "/rill.runtime.v1.QueryService/MetricsViewAggregation"
 	QueryService_MetricsViewToplist_FullMethodName          = "/rill.runtime.v1.QueryService/MetricsViewToplist"
@@ -53,6 +54,9 @@ type QueryServiceClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 	// Batch request with different queries
 	QueryBatch(ctx context.Context, in *QueryBatchRequest, opts ...grpc.CallOption) (QueryService_QueryBatchClient, error)
+	// StreamAPI resolves a custom API and streams the result in the Arrow IPC streaming format.
+	// Concatenating the data of all the response messages produces a valid Arrow IPC stream.
+	StreamAPI(ctx context.Context, in *StreamAPIRequest, opts ...grpc.CallOption) (QueryService_StreamAPIClient, error)
 	// Export builds a URL to download the results of a query as a file.
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
 	// MetricsViewAggregation is a generic API for running group-by/pivot queries against a metrics view.
@@ -184,6 +188,39 @@ func (x *queryServiceQueryBatchClient) Recv() (*QueryBatchResponse, error) {
 	return m, nil
 }
 
+func (c *queryServiceClient) StreamAPI(ctx context.Context, in *StreamAPIRequest, opts ...grpc.CallOption) (QueryService_StreamAPIClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QueryService_ServiceDesc.Streams[1], QueryService_StreamAPI_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryServiceStreamAPIClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type QueryService_StreamAPIClient interface {
+	Recv() (*StreamAPIResponse, error)
+	grpc.ClientStream
+}
+
+type queryServiceStreamAPIClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryServiceStreamAPIClient) Recv() (*StreamAPIResponse, error) {
+	m := new(StreamAPIResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExportResponse)
@@ -412,6 +449,9 @@ type QueryServiceServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
 	// Batch request with different queries
 	QueryBatch(*QueryBatchRequest, QueryService_QueryBatchServer) error
+	// StreamAPI resolves a custom API and streams the result in the Arrow IPC streaming format.
+	// Concatenating the data of all the response messages produces a valid Arrow IPC stream.
+	StreamAPI(*StreamAPIRequest, QueryService_StreamAPIServer) error
 	// Export builds a URL to download the results of a query as a file.
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
 	// MetricsViewAggregation is a generic API for running group-by/pivot queries against a metrics view.
@@ -503,6 +543,9 @@ func (UnimplementedQueryServiceServer) Query(context.Context, *QueryRequest) (*Q
 func (UnimplementedQueryServiceServer) QueryBatch(*QueryBatchRequest, QueryService_QueryBatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method QueryBatch not implemented")
 }
+func (UnimplementedQueryServiceServer) StreamAPI(*StreamAPIRequest, QueryService_StreamAPIServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAPI not implemented")
+}
 func (UnimplementedQueryServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
@@ -621,6 +664,27 @@ func (x *queryServiceQueryBatchServer) Send(m *QueryBatchResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _QueryService_StreamAPI_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAPIRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServiceServer).StreamAPI(m, &queryServiceStreamAPIServer{ServerStream: stream})
+}
+
+type QueryService_StreamAPIServer interface {
+	Send(*StreamAPIResponse) error
+	grpc.ServerStream
+}
+
+type queryServiceStreamAPIServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryServiceStreamAPIServer) Send(m *StreamAPIResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _QueryService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportRequest)
 	if err := dec(in); err != nil {
@@ -1123,6 +1187,11 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _QueryService_QueryBatch_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamAPI",
+			Handler:       _QueryService_StreamAPI_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rill/runtime/v1/queries.proto",
 }
@@ -2058,6 +2058,66 @@ func (m *SourceState) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Streaming
+
+	if all {
+		switch v := interface{}(m.GetIncrementalState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SourceStateValidationError{
+					field:  "IncrementalState",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SourceStateValidationError{
+					field:  "IncrementalState",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIncrementalState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SourceStateValidationError{
+				field:  "IncrementalState",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetProgress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SourceStateValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SourceStateValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProgress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SourceStateValidationError{
+				field:  "Progress",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return SourceStateMultiError(errors)
 	}
@@ -2135,6 +2195,178 @@ var _ interface {
 	ErrorName() string
 } = SourceStateValidationError{}
 
+// Validate checks the field values on ExecutionProgress with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExecutionProgress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecutionProgress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExecutionProgressMultiError, or nil if none found.
+func (m *ExecutionProgress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecutionProgress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CurrentBytes
+
+	// no validation rules for TargetBytes
+
+	// no validation rules for CurrentFiles
+
+	// no validation rules for TargetFiles
+
+	// no validation rules for CurrentRecords
+
+	// no validation rules for TargetRecords
+
+	if all {
+		switch v := interface{}(m.GetStartedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExecutionProgressValidationError{
+					field:  "StartedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExecutionProgressValidationError{
+					field:  "StartedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExecutionProgressValidationError{
+				field:  "StartedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExecutionProgressValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExecutionProgressValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExecutionProgressValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExecutionProgressMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecutionProgressMultiError is an error wrapping multiple validation errors
+// returned by ExecutionProgress.ValidateAll() if the designated constraints
+// aren't met.
+type ExecutionProgressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecutionProgressMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecutionProgressMultiError) AllErrors() []error { return m }
+
+// ExecutionProgressValidationError is the validation error returned by
+// ExecutionProgress.Validate if the designated constraints aren't met.
+type ExecutionProgressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecutionProgressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecutionProgressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecutionProgressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecutionProgressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecutionProgressValidationError) ErrorName() string {
+	return "ExecutionProgressValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExecutionProgressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecutionProgress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecutionProgressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecutionProgressValidationError{}
+
 // Validate checks the field values on ModelV2 with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2666,6 +2898,35 @@ func (m *ModelState) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetProgress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProgress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelStateValidationError{
+				field:  "Progress",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ModelStateMultiError(errors)
 	}
@@ -3923,6 +4184,12 @@ func (m *ReportSpec) validate(all bool) error {
 
 	// no validation rules for IntervalsCheckUnclosed
 
+	// no validation rules for ExportAttach
+
+	// no validation rules for ExportAttachMaxBytes
+
+	// no validation rules for PreviewRows
+
 	if len(errors) > 0 {
 		return ReportSpecMultiError(errors)
 	}
@@ -4655,6 +4922,8 @@ func (m *AlertSpec) validate(all bool) error {
 
 	// no validation rules for Annotations
 
+	// no validation rules for PreviewRows
+
 	switch v := m.QueryFor.(type) {
 	case *AlertSpec_QueryForUserId:
 		if v == nil {
@@ -9537,6 +9806,35 @@ func (m *MetricsViewSpec_DimensionV2) validate(all bool) error {
 
 	// no validation rules for Unnest
 
+	if all {
+		switch v := interface{}(m.GetBucket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSpec_DimensionV2ValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSpec_DimensionV2ValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBucket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSpec_DimensionV2ValidationError{
+				field:  "Bucket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return MetricsViewSpec_DimensionV2MultiError(errors)
 	}
@@ -9618,6 +9916,117 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewSpec_DimensionV2ValidationError{}
 
+// Validate checks the field values on MetricsViewSpec_DimensionBucket with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec_DimensionBucket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_DimensionBucket with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_DimensionBucketMultiError, or nil if none found.
+func (m *MetricsViewSpec_DimensionBucket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_DimensionBucket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityDimension
+
+	// no validation rules for Measure
+
+	// no validation rules for Where
+
+	// no validation rules for Quantiles
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_DimensionBucketMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_DimensionBucketMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewSpec_DimensionBucket.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewSpec_DimensionBucketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpec_DimensionBucketMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpec_DimensionBucketMultiError) AllErrors() []error { return m }
+
+// MetricsViewSpec_DimensionBucketValidationError is the validation error
+// returned by MetricsViewSpec_DimensionBucket.Validate if the designated
+// constraints aren't met.
+type MetricsViewSpec_DimensionBucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpec_DimensionBucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpec_DimensionBucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpec_DimensionBucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpec_DimensionBucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpec_DimensionBucketValidationError) ErrorName() string {
+	return "MetricsViewSpec_DimensionBucketValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpec_DimensionBucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec_DimensionBucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpec_DimensionBucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpec_DimensionBucketValidationError{}
+
 // Validate checks the field values on MetricsViewSpec_DimensionSelector with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
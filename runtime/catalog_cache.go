@@ -352,6 +352,22 @@ func (c *catalogCache) updateState(name *runtimev1.ResourceName, from *runtimev1
 	return nil
 }
 
+// updateProgress updates the state of a resource without bumping its state version.
+func (c *catalogCache) updateProgress(name *runtimev1.ResourceName, from *runtimev1.Resource) error {
+	r, err := c.get(name, true, false)
+	if err != nil {
+		return err
+	}
+	err = c.ctrl.reconciler(name.Kind).AssignState(from, r)
+	if err != nil {
+		return err
+	}
+	r.Meta.Version++
+	c.dirty[nameStr(r.Meta.Name)] = r.Meta.Name
+	c.addEvent(r.Meta.Name, r, runtimev1.ResourceEvent_RESOURCE_EVENT_WRITE)
+	return nil
+}
+
 // updateError updates the reconcile_error field of a resource.
 func (c *catalogCache) updateError(name *runtimev1.ResourceName, reconcileErr error) error {
 	r, err := c.get(name, true, false)
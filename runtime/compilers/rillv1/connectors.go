@@ -183,7 +183,11 @@ func (a *connectorAnalyzer) analyzeResourceNotifiers(r *Resource, notifiers []*r
 	for _, n := range notifiers {
 		anonAccess := false
 		var decodeErr error
-		if n.Connector == "slack" {
+		switch n.Connector {
+		case "email":
+			// Email notifier falls back to the runtime's default email configuration if SMTP is not configured
+			anonAccess = true
+		case "slack":
 			// Slack notifier can be used anonymously if no users and no channels are specified (only webhooks)
 			props, err := slack.DecodeProps(n.Properties.AsMap())
 			decodeErr = err
@@ -192,6 +196,9 @@ func (a *connectorAnalyzer) analyzeResourceNotifiers(r *Resource, notifiers []*r
 					anonAccess = true
 				}
 			}
+		case "webhook":
+			// Webhook notifier can be used anonymously since the signing secret is optional
+			anonAccess = true
 		}
 		a.trackConnector(n.Connector, r, anonAccess)
 		if decodeErr != nil {
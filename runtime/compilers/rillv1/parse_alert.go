@@ -10,6 +10,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -36,6 +37,7 @@ type AlertYAML struct {
 			Attributes map[string]any `yaml:"attributes"`
 		} `yaml:"for"`
 	} `yaml:"query"`
+	PreviewRows   uint   `yaml:"preview_rows"`
 	OnRecover     *bool  `yaml:"on_recover"`
 	OnFail        *bool  `yaml:"on_fail"`
 	OnError       *bool  `yaml:"on_error"`
@@ -50,6 +52,11 @@ type AlertYAML struct {
 			Channels []string `yaml:"channels"`
 			Webhooks []string `yaml:"webhooks"`
 		} `yaml:"slack"`
+		Webhook struct {
+			URLs     []string          `yaml:"urls"`
+			Headers  map[string]string `yaml:"headers"`
+			Template string            `yaml:"template"`
+		} `yaml:"webhook"`
 	} `yaml:"notify"`
 	Annotations map[string]string `yaml:"annotations"`
 	// Backwards compatibility
@@ -198,6 +205,17 @@ func (p *Parser) parseAlert(node *Node) error {
 				return fmt.Errorf("invalid recipient email address %q", email)
 			}
 		}
+		// Validate webhook settings
+		if len(tmp.Notify.Webhook.URLs) > 0 || tmp.Notify.Webhook.Template != "" || len(tmp.Notify.Webhook.Headers) > 0 {
+			props := &webhook.NotifierProperties{
+				URLs:     tmp.Notify.Webhook.URLs,
+				Headers:  tmp.Notify.Webhook.Headers,
+				Template: tmp.Notify.Webhook.Template,
+			}
+			if err := props.Validate(); err != nil {
+				return fmt.Errorf(`invalid property "notify.webhook": %w`, err)
+			}
+		}
 		// Validate renotify_after
 		if tmp.RenotifyAfter != "" {
 			renotifyAfter, err = parseDuration(tmp.RenotifyAfter)
@@ -207,6 +225,11 @@ func (p *Parser) parseAlert(node *Node) error {
 		}
 	}
 
+	// Validate preview rows
+	if tmp.PreviewRows > maxPreviewRows {
+		return fmt.Errorf(`invalid value %d for property "preview_rows": must be at most %d`, tmp.PreviewRows, maxPreviewRows)
+	}
+
 	// Track alert
 	r, err := p.insertResource(ResourceKindAlert, node.Name, node.Paths, node.Refs...)
 	if err != nil {
@@ -227,6 +250,7 @@ func (p *Parser) parseAlert(node *Node) error {
 	}
 	r.AlertSpec.QueryName = tmp.Query.Name
 	r.AlertSpec.QueryArgsJson = tmp.Query.ArgsJSON
+	r.AlertSpec.PreviewRows = uint32(tmp.PreviewRows)
 
 	// Note: have already validated that at most one of the cases match
 	if queryForUserID != "" {
@@ -311,6 +335,17 @@ func (p *Parser) parseAlert(node *Node) error {
 				Properties: props,
 			})
 		}
+		// Webhook settings
+		if len(tmp.Notify.Webhook.URLs) > 0 {
+			props, err := structpb.NewStruct(webhook.EncodeProps(tmp.Notify.Webhook.URLs, tmp.Notify.Webhook.Headers, tmp.Notify.Webhook.Template))
+			if err != nil {
+				return err
+			}
+			r.AlertSpec.Notifiers = append(r.AlertSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  "webhook",
+				Properties: props,
+			})
+		}
 	}
 
 	r.AlertSpec.Annotations = tmp.Annotations
@@ -41,6 +41,14 @@ type MetricsViewYAML struct {
 		Description string
 		Ignore      bool `yaml:"ignore"`
 		Unnest      bool
+		Bucket      *struct {
+			Entity    string
+			Measure   string
+			Where     string
+			Bins      []float64
+			Quantiles uint32
+			Labels    []string
+		}
 	}
 	DefaultDimensions []string `yaml:"default_dimensions"`
 	Measures          []*struct {
@@ -365,7 +373,18 @@ func (p *Parser) parseMetricsView(node *Node) error {
 			}
 		}
 
-		if (dim.Column == "" && dim.Expression == "") || (dim.Column != "" && dim.Expression != "") {
+		if dim.Bucket != nil {
+			if dim.Column != "" || dim.Expression != "" {
+				return fmt.Errorf("column or expression cannot be set for bucket dimension: %q", dim.Name)
+			}
+			if dim.Unnest {
+				return fmt.Errorf("cannot unnest bucket dimension: %q", dim.Name)
+			}
+			err := validateDimensionBucket(dim.Bucket.Bins, dim.Bucket.Quantiles, dim.Bucket.Labels)
+			if err != nil {
+				return fmt.Errorf("invalid bucket for dimension %q: %w", dim.Name, err)
+			}
+		} else if (dim.Column == "" && dim.Expression == "") || (dim.Column != "" && dim.Expression != "") {
 			return fmt.Errorf("exactly one of column or expression should be set for dimension: %q", dim.Name)
 		}
 
@@ -376,6 +395,26 @@ func (p *Parser) parseMetricsView(node *Node) error {
 		names[lower] = nameIsDimension
 	}
 
+	// Validate bucket entities now that all dimensions have been seen
+	bucketDims := make(map[string]bool)
+	for _, dim := range tmp.Dimensions {
+		if dim != nil && !dim.Ignore && dim.Bucket != nil {
+			bucketDims[strings.ToLower(dim.Name)] = true
+		}
+	}
+	for _, dim := range tmp.Dimensions {
+		if dim == nil || dim.Ignore || dim.Bucket == nil {
+			continue
+		}
+		entity := strings.ToLower(dim.Bucket.Entity)
+		if typ, ok := names[entity]; !ok || typ != nameIsDimension || entity == strings.ToLower(tmp.TimeDimension) {
+			return fmt.Errorf("entity dimension %q for bucket dimension %q not found", dim.Bucket.Entity, dim.Name)
+		}
+		if bucketDims[entity] {
+			return fmt.Errorf("entity dimension %q for bucket dimension %q cannot itself be a bucket dimension", dim.Bucket.Entity, dim.Name)
+		}
+	}
+
 	for _, dimension := range tmp.DefaultDimensions {
 		if v, ok := names[strings.ToLower(dimension)]; !ok || v != nameIsDimension {
 			return fmt.Errorf(`dimension %q referenced in "default_dimensions" not found`, dimension)
@@ -528,6 +567,26 @@ func (p *Parser) parseMetricsView(node *Node) error {
 		}
 	}
 
+	// Validate bucket measures now that all measures have been seen
+	for _, dim := range tmp.Dimensions {
+		if dim == nil || dim.Ignore || dim.Bucket == nil {
+			continue
+		}
+		var found bool
+		for _, m := range measures {
+			if strings.EqualFold(m.Name, dim.Bucket.Measure) {
+				if m.Type != runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE || m.Window != nil {
+					return fmt.Errorf("measure %q for bucket dimension %q must be a simple measure", dim.Bucket.Measure, dim.Name)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("measure %q for bucket dimension %q not found", dim.Bucket.Measure, dim.Name)
+		}
+	}
+
 	for _, measure := range tmp.DefaultMeasures {
 		if v, ok := names[strings.ToLower(measure)]; !ok || v != nameIsMeasure {
 			return fmt.Errorf(`measure %q referenced in "default_dimensions" not found`, measure)
@@ -686,6 +745,18 @@ func (p *Parser) parseMetricsView(node *Node) error {
 			continue
 		}
 
+		var bucket *runtimev1.MetricsViewSpec_DimensionBucket
+		if dim.Bucket != nil {
+			bucket = &runtimev1.MetricsViewSpec_DimensionBucket{
+				EntityDimension: dim.Bucket.Entity,
+				Measure:         dim.Bucket.Measure,
+				Where:           dim.Bucket.Where,
+				Bins:            dim.Bucket.Bins,
+				Quantiles:       dim.Bucket.Quantiles,
+				Labels:          dim.Bucket.Labels,
+			}
+		}
+
 		spec.Dimensions = append(spec.Dimensions, &runtimev1.MetricsViewSpec_DimensionV2{
 			Name:        dim.Name,
 			Column:      dim.Column,
@@ -693,6 +764,7 @@ func (p *Parser) parseMetricsView(node *Node) error {
 			Label:       dim.Label,
 			Description: dim.Description,
 			Unnest:      dim.Unnest,
+			Bucket:      bucket,
 		})
 	}
 	spec.DefaultDimensions = tmp.DefaultDimensions
@@ -779,6 +851,32 @@ func parseTimeGrain(s string) (runtimev1.TimeGrain, error) {
 	}
 }
 
+// validateDimensionBucket validates the bucketing config of a bucket dimension:
+// a) that exactly one of bins or quantiles is set,
+// b) that bins are strictly ascending,
+// c) that there is exactly one label per bucket if labels are set.
+func validateDimensionBucket(bins []float64, quantiles uint32, labels []string) error {
+	if (len(bins) == 0) == (quantiles == 0) {
+		return fmt.Errorf(`exactly one of "bins" or "quantiles" must be set`)
+	}
+
+	n := int(quantiles)
+	if len(bins) > 0 {
+		for i := 1; i < len(bins); i++ {
+			if bins[i] <= bins[i-1] {
+				return fmt.Errorf(`"bins" must be in strictly ascending order`)
+			}
+		}
+		n = len(bins) + 1
+	}
+
+	if len(labels) > 0 && len(labels) != n {
+		return fmt.Errorf(`expected %d "labels", got %d`, n, len(labels))
+	}
+
+	return nil
+}
+
 // validateISO8601 is a wrapper around duration.ParseISO8601 with additional validation:
 // a) that the duration does not have seconds granularity,
 // b) if onlyStandard is true, that the duration does not use any of the Rill-specific extensions (such as year-to-date).
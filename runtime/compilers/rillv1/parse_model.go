@@ -23,7 +23,8 @@ type ModelYAML struct {
 		Connector  string         `yaml:"connector"`
 		Properties map[string]any `yaml:",inline" mapstructure:",remain"`
 	} `yaml:"output"`
-	Materialize *bool `yaml:"materialize"`
+	Materialize *bool  `yaml:"materialize"`
+	PartitionBy string `yaml:"partition_by"`
 }
 
 // parseModel parses a model definition and adds the resulting resource to p.Resources.
@@ -104,6 +105,17 @@ func (p *Parser) parseModel(node *Node) error {
 		outputProps["materialize"] = *tmp.Materialize
 	}
 
+	// The partition expression can be specified outside of the output properties
+	if tmp.PartitionBy != "" {
+		if outputProps == nil {
+			outputProps = map[string]any{}
+		}
+		if _, ok := outputProps["partition_by"]; ok {
+			return errors.New(`"partition_by" can not be specified both on the model and in "output"`)
+		}
+		outputProps["partition_by"] = tmp.PartitionBy
+	}
+
 	// Validate output details
 	var outputPropsPB *structpb.Struct
 	if len(outputProps) > 0 {
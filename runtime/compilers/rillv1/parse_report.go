@@ -8,8 +8,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/c2h5oh/datasize"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -32,10 +34,13 @@ type ReportYAML struct {
 		ArgsJSON string         `yaml:"args_json"`
 	} `yaml:"query"`
 	Export struct {
-		Format string `yaml:"format"`
-		Limit  uint   `yaml:"limit"`
+		Format        string `yaml:"format"`
+		Limit         uint   `yaml:"limit"`
+		Attach        bool   `yaml:"attach"`
+		AttachMaxSize string `yaml:"attach_max_size"`
 	} `yaml:"export"`
-	Email struct {
+	PreviewRows uint `yaml:"preview_rows"`
+	Email       struct {
 		Recipients []string `yaml:"recipients"`
 	} `yaml:"email"`
 	Notify struct {
@@ -47,6 +52,11 @@ type ReportYAML struct {
 			Channels []string `yaml:"channels"`
 			Webhooks []string `yaml:"webhooks"`
 		} `yaml:"slack"`
+		Webhook struct {
+			URLs     []string          `yaml:"urls"`
+			Headers  map[string]string `yaml:"headers"`
+			Template string            `yaml:"template"`
+		} `yaml:"webhook"`
 	} `yaml:"notify"`
 	Annotations map[string]string `yaml:"annotations"`
 }
@@ -136,6 +146,22 @@ func (p *Parser) parseReport(node *Node) error {
 		return fmt.Errorf(`missing required property "export.format"`)
 	}
 
+	// Parse max attachment size
+	var attachMaxBytes uint64
+	if tmp.Export.AttachMaxSize != "" {
+		var size datasize.ByteSize
+		err := size.UnmarshalText([]byte(tmp.Export.AttachMaxSize))
+		if err != nil || size == 0 {
+			return fmt.Errorf(`invalid value %q for property "export.attach_max_size"`, tmp.Export.AttachMaxSize)
+		}
+		attachMaxBytes = size.Bytes()
+	}
+
+	// Validate preview rows
+	if tmp.PreviewRows > maxPreviewRows {
+		return fmt.Errorf(`invalid value %d for property "preview_rows": must be at most %d`, tmp.PreviewRows, maxPreviewRows)
+	}
+
 	if len(tmp.Email.Recipients) > 0 && len(tmp.Notify.Email.Recipients) > 0 {
 		return errors.New(`cannot set both "email.recipients" and "notify.email.recipients"`)
 	}
@@ -153,7 +179,7 @@ func (p *Parser) parseReport(node *Node) error {
 		}
 	} else {
 		if len(tmp.Notify.Email.Recipients) == 0 && len(tmp.Notify.Slack.Channels) == 0 &&
-			len(tmp.Notify.Slack.Users) == 0 && len(tmp.Notify.Slack.Webhooks) == 0 {
+			len(tmp.Notify.Slack.Users) == 0 && len(tmp.Notify.Slack.Webhooks) == 0 && len(tmp.Notify.Webhook.URLs) == 0 {
 			return fmt.Errorf(`missing notification recipients`)
 		}
 		for _, email := range tmp.Notify.Email.Recipients {
@@ -168,6 +194,17 @@ func (p *Parser) parseReport(node *Node) error {
 				return fmt.Errorf("invalid recipient email address %q", email)
 			}
 		}
+		// Validate webhook settings
+		if len(tmp.Notify.Webhook.URLs) > 0 || tmp.Notify.Webhook.Template != "" || len(tmp.Notify.Webhook.Headers) > 0 {
+			props := &webhook.NotifierProperties{
+				URLs:     tmp.Notify.Webhook.URLs,
+				Headers:  tmp.Notify.Webhook.Headers,
+				Template: tmp.Notify.Webhook.Template,
+			}
+			if err := props.Validate(); err != nil {
+				return fmt.Errorf(`invalid property "notify.webhook": %w`, err)
+			}
+		}
 	}
 
 	// Track report
@@ -192,6 +229,9 @@ func (p *Parser) parseReport(node *Node) error {
 	r.ReportSpec.QueryArgsJson = tmp.Query.ArgsJSON
 	r.ReportSpec.ExportLimit = uint64(tmp.Export.Limit)
 	r.ReportSpec.ExportFormat = exportFormat
+	r.ReportSpec.ExportAttach = tmp.Export.Attach
+	r.ReportSpec.ExportAttachMaxBytes = attachMaxBytes
+	r.ReportSpec.PreviewRows = uint32(tmp.PreviewRows)
 
 	if isLegacySyntax {
 		// Backwards compatibility
@@ -233,6 +273,17 @@ func (p *Parser) parseReport(node *Node) error {
 				Properties: props,
 			})
 		}
+		// Webhook settings
+		if len(tmp.Notify.Webhook.URLs) > 0 {
+			props, err := structpb.NewStruct(webhook.EncodeProps(tmp.Notify.Webhook.URLs, tmp.Notify.Webhook.Headers, tmp.Notify.Webhook.Template))
+			if err != nil {
+				return err
+			}
+			r.ReportSpec.Notifiers = append(r.ReportSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  "webhook",
+				Properties: props,
+			})
+		}
 	}
 
 	r.ReportSpec.Annotations = tmp.Annotations
@@ -240,6 +291,10 @@ func (p *Parser) parseReport(node *Node) error {
 	return nil
 }
 
+// maxPreviewRows is the max number of rows that can be included as a preview table in report and alert notifications.
+// It is kept low since notifications are not suited for large tables (e.g. Slack limits the size of messages).
+const maxPreviewRows = 100
+
 func parseExportFormat(s string) (runtimev1.ExportFormat, error) {
 	switch strings.ToLower(s) {
 	case "":
@@ -982,6 +982,59 @@ SELECT * FROM t2
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestModelPartitionBy(t *testing.T) {
+	ctx := context.Background()
+
+	files := map[string]string{
+		`rill.yaml`: ``,
+		// Model with partition_by on the model
+		`models/m1.yaml`: `
+type: model
+incremental: true
+partition_by: date_trunc('day', ts)
+sql: SELECT now() AS ts
+output:
+  incremental_strategy: partition_overwrite
+`,
+		// Model with partition_by both on the model and in the output
+		`models/m2.yaml`: `
+type: model
+incremental: true
+partition_by: date_trunc('day', ts)
+sql: SELECT now() AS ts
+output:
+  partition_by: ts
+`,
+	}
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/models/m1.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				RefreshSchedule:  &runtimev1.Schedule{RefUpdate: true},
+				Incremental:      true,
+				InputConnector:   "duckdb",
+				InputProperties:  must(structpb.NewStruct(map[string]any{"sql": "SELECT now() AS ts"})),
+				OutputConnector:  "duckdb",
+				OutputProperties: must(structpb.NewStruct(map[string]any{"incremental_strategy": "partition_overwrite", "partition_by": "date_trunc('day', ts)"})),
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `"partition_by" can not be specified both on the model and in "output"`,
+			FilePath: "/models/m2.yaml",
+		},
+	}
+
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func TestProjectDashboardDefaults(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1182,7 +1235,10 @@ query:
 export:
   format: csv
   limit: 10000
+  attach: true
+  attach_max_size: 5MB
 
+preview_rows: 5
 
 notify:
   email:
@@ -1193,6 +1249,11 @@ notify:
       - reports
     users:
       - user_2@example.com
+  webhook:
+    urls:
+      - https://example.com/hook
+    headers:
+      Authorization: Bearer token
 
 annotations:
   foo: bar
@@ -1234,13 +1295,17 @@ annotations:
 					Cron:      "0 * * * *",
 					TimeZone:  "America/Los_Angeles",
 				},
-				QueryName:     "MetricsViewToplist",
-				QueryArgsJson: `{"metrics_view":"mv1"}`,
-				ExportFormat:  runtimev1.ExportFormat_EXPORT_FORMAT_CSV,
-				ExportLimit:   10000,
+				QueryName:            "MetricsViewToplist",
+				QueryArgsJson:        `{"metrics_view":"mv1"}`,
+				ExportFormat:         runtimev1.ExportFormat_EXPORT_FORMAT_CSV,
+				ExportLimit:          10000,
+				ExportAttach:         true,
+				ExportAttachMaxBytes: 5 * 1024 * 1024,
+				PreviewRows:          5,
 				Notifiers: []*runtimev1.Notifier{
 					{Connector: "email", Properties: must(structpb.NewStruct(map[string]any{"recipients": []any{"user_1@example.com"}}))},
 					{Connector: "slack", Properties: must(structpb.NewStruct(map[string]any{"users": []any{"user_2@example.com"}, "channels": []any{"reports"}, "webhooks": []any{}}))},
+					{Connector: "webhook", Properties: must(structpb.NewStruct(map[string]any{"urls": []any{"https://example.com/hook"}, "headers": map[string]any{"Authorization": "Bearer token"}}))},
 				},
 				Annotations:          map[string]string{"foo": "bar"},
 				WatermarkInherit:     true,
@@ -1285,6 +1350,8 @@ query:
   for:
     user_email: benjamin@example.com
 
+preview_rows: 10
+
 on_recover: true
 renotify: true
 renotify_after: 24h
@@ -1326,6 +1393,7 @@ annotations:
 				QueryName:            "MetricsViewToplist",
 				QueryArgsJson:        `{"metrics_view":"mv1"}`,
 				QueryFor:             &runtimev1.AlertSpec_QueryForUserEmail{QueryForUserEmail: "benjamin@example.com"},
+				PreviewRows:          10,
 				NotifyOnRecover:      true,
 				NotifyOnFail:         true,
 				Renotify:             true,
@@ -1744,6 +1812,122 @@ measures:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestBucketDimensions(t *testing.T) {
+	files := map[string]string{
+		// rill.yaml
+		`rill.yaml`: ``,
+		// dashboard d1
+		`dashboards/d1.yaml`: `
+table: t1
+dimensions:
+  - column: customer
+  - name: spend_tier
+    bucket:
+      entity: customer
+      measure: revenue
+      bins: [100, 1000]
+      labels: [low, mid, high]
+  - name: spend_quartile
+    bucket:
+      entity: customer
+      measure: revenue
+      where: country = 'US'
+      quantiles: 4
+measures:
+  - name: revenue
+    expression: sum(amount)
+`,
+		// dashboard d2
+		`dashboards/d2.yaml`: `
+table: t1
+dimensions:
+  - column: customer
+  - name: spend_tier
+    bucket:
+      entity: customer
+      measure: revenue
+      bins: [1000, 100]
+measures:
+  - name: revenue
+    expression: sum(amount)
+`,
+		// dashboard d3
+		`dashboards/d3.yaml`: `
+table: t1
+dimensions:
+  - column: customer
+  - name: spend_tier
+    bucket:
+      entity: customer
+      measure: revenue_share
+      quantiles: 4
+measures:
+  - name: revenue
+    expression: sum(amount)
+  - name: revenue_share
+    expression: revenue / 100
+    requires: [revenue]
+`,
+	}
+
+	resources := []*Resource{
+		// dashboard d1
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Connector: "duckdb",
+				Table:     "t1",
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+					{Name: "customer", Column: "customer"},
+					{
+						Name: "spend_tier",
+						Bucket: &runtimev1.MetricsViewSpec_DimensionBucket{
+							EntityDimension: "customer",
+							Measure:         "revenue",
+							Bins:            []float64{100, 1000},
+							Labels:          []string{"low", "mid", "high"},
+						},
+					},
+					{
+						Name: "spend_quartile",
+						Bucket: &runtimev1.MetricsViewSpec_DimensionBucket{
+							EntityDimension: "customer",
+							Measure:         "revenue",
+							Where:           "country = 'US'",
+							Quantiles:       4,
+						},
+					},
+				},
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{
+						Name:       "revenue",
+						Expression: "sum(amount)",
+						Type:       runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE,
+					},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `"bins" must be in strictly ascending order`,
+			FilePath: "/dashboards/d2.yaml",
+		},
+		{
+			Message:  `must be a simple measure`,
+			FilePath: "/dashboards/d3.yaml",
+		},
+	}
+
+	ctx := context.Background()
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func requireResourcesAndErrors(t testing.TB, p *Parser, wantResources []*Resource, wantErrors []*runtimev1.ParseError) {
 	// Check errors
 	// NOTE: Assumes there's at most one parse error per file path
@@ -39,7 +39,19 @@ func (r *Runtime) AcquireHandle(ctx context.Context, instanceID, connector strin
 		// So we take this moment to make sure the ctx gets checked for cancellation at least every once in a while.
 		return nil, nil, ctx.Err()
 	}
-	return r.getConnection(ctx, instanceID, cfg.Driver, cfg.Resolve())
+	resolved := cfg.Resolve()
+
+	// Email connectors without an SMTP server of their own send with the runtime's SMTP server, which is configured as a system connector.
+	// It's acquired as a system handle so its credentials never become part of the instance's connector config.
+	if smtpHost, _ := resolved["smtp_host"].(string); cfg.Driver == "email" && smtpHost == "" {
+		for _, c := range r.opts.SystemConnectors {
+			if c.Type == "email" {
+				return r.AcquireSystemHandle(ctx, c.Name)
+			}
+		}
+	}
+
+	return r.getConnection(ctx, instanceID, cfg.Driver, resolved)
 }
 
 func (r *Runtime) Repo(ctx context.Context, instanceID string) (drivers.RepoStore, func(), error) {
@@ -40,3 +40,41 @@ variables:
 	require.True(t, config["aws_access_key_id"].(string) == "us-east-1")
 	require.True(t, config["allow_host_access"].(bool))
 }
+
+func TestEmailConnectorConfig(t *testing.T) {
+	ctx := context.Background()
+
+	// Without SMTP config, the email connector uses the runtime's email system connector, whose config isn't part of the instance's config
+	rt, id := testruntime.NewInstance(t)
+	cfg, err := rt.ConnectorConfig(ctx, id, "email")
+	require.NoError(t, err)
+	require.NotContains(t, cfg.Resolve(), "smtp_password")
+	require.NotContains(t, cfg.Resolve(), "from_email")
+	handle, release, err := rt.AcquireHandle(ctx, id, "email")
+	require.NoError(t, err)
+	release()
+	system, release, err := rt.AcquireSystemHandle(ctx, "email")
+	require.NoError(t, err)
+	release()
+	require.Same(t, system, handle)
+
+	// SMTP config of the instance takes precedence over the runtime's email system connector
+	smtp := testruntime.NewSMTPServer(t)
+	vars := smtp.Variables()
+	vars["connector.email.from_email"] = "alerts@example.com"
+	rt, id = testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files:     map[string]string{"rill.yaml": ""},
+		Variables: vars,
+	})
+	cfg, err = rt.ConnectorConfig(ctx, id, "email")
+	require.NoError(t, err)
+	require.Equal(t, "alerts@example.com", cfg.Resolve()["from_email"])
+	require.Equal(t, vars["connector.email.smtp_host"], cfg.Resolve()["smtp_host"])
+	handle, release, err = rt.AcquireHandle(ctx, id, "email")
+	require.NoError(t, err)
+	release()
+	system, release, err = rt.AcquireSystemHandle(ctx, "email")
+	require.NoError(t, err)
+	release()
+	require.NotSame(t, system, handle)
+}
@@ -618,6 +618,24 @@ func (c *Controller) UpdateState(ctx context.Context, name *runtimev1.ResourceNa
 	return nil
 }
 
+// UpdateProgress updates a resource's state like UpdateState, but without bumping its state version.
+// It should be used for informational fields that change frequently during reconciliation, such as execution progress,
+// since bumping the state version invalidates caches and causes dependants to re-execute.
+// Like UpdateState, it can only be called from within the resource's reconciler and succeeds even if ctx is cancelled.
+func (c *Controller) UpdateProgress(ctx context.Context, name *runtimev1.ResourceName, r *runtimev1.Resource) error {
+	if err := c.checkRunning(); err != nil {
+		return err
+	}
+	c.lock(ctx, false)
+	defer c.unlock(ctx, false)
+
+	if !c.isReconcilerForResource(ctx, name) {
+		return fmt.Errorf("can't update resource progress from outside of reconciler")
+	}
+
+	return c.catalog.updateProgress(name, r)
+}
+
 // UpdateError updates a resource's error.
 // Unlike UpdateMeta and UpdateSpec, it does not cancel or enqueue reconciliation for the resource.
 func (c *Controller) UpdateError(ctx context.Context, name *runtimev1.ResourceName, reconcileErr error) error {
@@ -387,6 +387,36 @@ refresh:
 	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 2)
 }
 
+func TestSourceProgress(t *testing.T) {
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/data/foo.csv": `a,b,c,d,e
+1,2,3,4,5
+1,2,3,4,5
+1,2,3,4,5
+`,
+		"/sources/foo.yaml": `
+connector: local_file
+path: data/foo.csv
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+	r, err := ctrl.Get(context.Background(), &runtimev1.ResourceName{Kind: runtime.ResourceKindSource, Name: "foo"}, false)
+	require.NoError(t, err)
+
+	// The final progress is retained after the ingestion has completed
+	p := r.GetSource().State.Progress
+	require.NotNil(t, p)
+	require.Greater(t, p.TargetBytes, int64(0))
+	require.Equal(t, p.TargetBytes, p.CurrentBytes)
+	require.NotNil(t, p.StartedOn)
+	require.False(t, p.UpdatedOn.AsTime().Before(p.StartedOn.AsTime()))
+}
+
 func TestSourceAndModelNameCollission(t *testing.T) {
 	// Add source for a file
 	rt, id := testruntime.NewInstance(t)
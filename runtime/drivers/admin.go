@@ -11,9 +11,10 @@ type AdminService interface {
 }
 
 type ReportMetadata struct {
-	OpenURL   string
-	ExportURL string
-	EditURL   string
+	OpenURL            string
+	ExportURL          string
+	EditURL            string
+	QueryForAttributes map[string]any
 }
 
 type AlertMetadata struct {
@@ -21,11 +21,17 @@ func (h *Handle) GetReportMetadata(ctx context.Context, reportName string, annot
 		return nil, err
 	}
 
-	return &drivers.ReportMetadata{
+	meta := &drivers.ReportMetadata{
 		OpenURL:   res.OpenUrl,
 		ExportURL: res.ExportUrl,
 		EditURL:   res.EditUrl,
-	}, nil
+	}
+
+	if res.QueryForAttributes != nil {
+		meta.QueryForAttributes = res.QueryForAttributes.AsMap()
+	}
+
+	return meta, nil
 }
 
 func (h *Handle) GetAlertMetadata(ctx context.Context, alertName string, annotations map[string]string, queryForUserID, queryForUserEmail string) (*drivers.AlertMetadata, error) {
@@ -0,0 +1,445 @@
+package drivers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/jsonval"
+)
+
+// DefaultArrowBatchSize is the number of rows per record batch when converting a row-based Result to Arrow.
+const DefaultArrowBatchSize = 10000
+
+// NewArrowResult creates a Result backed by an Arrow record reader.
+// It is used by drivers that can return query results natively in Arrow format (see Statement.Arrow).
+// If schema is nil, it is derived from the reader's Arrow schema.
+// The Result takes ownership of the reader and releases it when closed.
+func NewArrowResult(rdr array.RecordReader, schema *runtimev1.StructType) *Result {
+	if schema == nil {
+		schema = ArrowSchemaToStructType(rdr.Schema())
+	}
+	return &Result{Schema: schema, arrow: rdr}
+}
+
+// ArrowRecordReader returns the result's data as a stream of Arrow record batches.
+// If the driver returned the result natively in Arrow format, its record batches are returned as-is.
+// Otherwise, the rows are converted to record batches of up to batchSize rows.
+// The cap set by SetCap is enforced in both cases.
+//
+// The returned reader is owned by the Result and must not be used after the Result is closed.
+func (r *Result) ArrowRecordReader(batchSize int) (array.RecordReader, error) {
+	if r.arrow != nil {
+		if r.cap > 0 {
+			return &cappedRecordReader{RecordReader: r.arrow, cap: r.cap}, nil
+		}
+		return r.arrow, nil
+	}
+
+	if r.Rows == nil {
+		return nil, fmt.Errorf("result does not have any rows")
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultArrowBatchSize
+	}
+
+	schema := StructTypeToArrowSchema(r.Schema)
+	return &rowsRecordReader{
+		refCount:  1,
+		res:       r,
+		schema:    schema,
+		builder:   array.NewRecordBuilder(memory.DefaultAllocator, schema),
+		batchSize: batchSize,
+	}, nil
+}
+
+// StructTypeToArrowSchema converts a runtime schema to an Arrow schema.
+// Types that don't have a direct Arrow equivalent are mapped to strings (for complex types, the values will be JSON encoded).
+// Large numeric types are mapped to float64, like they are when serializing results to JSON.
+func StructTypeToArrowSchema(t *runtimev1.StructType) *arrow.Schema {
+	fields := make([]arrow.Field, len(t.GetFields()))
+	for i, f := range t.GetFields() {
+		fields[i] = arrow.Field{
+			Name:     f.Name,
+			Type:     typeToArrow(f.Type),
+			Nullable: true,
+		}
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+func typeToArrow(t *runtimev1.Type) arrow.DataType {
+	switch t.GetCode() {
+	case runtimev1.Type_CODE_BOOL:
+		return arrow.FixedWidthTypes.Boolean
+	case runtimev1.Type_CODE_INT8:
+		return arrow.PrimitiveTypes.Int8
+	case runtimev1.Type_CODE_INT16:
+		return arrow.PrimitiveTypes.Int16
+	case runtimev1.Type_CODE_INT32:
+		return arrow.PrimitiveTypes.Int32
+	case runtimev1.Type_CODE_INT64:
+		return arrow.PrimitiveTypes.Int64
+	case runtimev1.Type_CODE_UINT8:
+		return arrow.PrimitiveTypes.Uint8
+	case runtimev1.Type_CODE_UINT16:
+		return arrow.PrimitiveTypes.Uint16
+	case runtimev1.Type_CODE_UINT32:
+		return arrow.PrimitiveTypes.Uint32
+	case runtimev1.Type_CODE_UINT64:
+		return arrow.PrimitiveTypes.Uint64
+	case runtimev1.Type_CODE_FLOAT32:
+		return arrow.PrimitiveTypes.Float32
+	case runtimev1.Type_CODE_FLOAT64, runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256, runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256, runtimev1.Type_CODE_DECIMAL:
+		return arrow.PrimitiveTypes.Float64
+	case runtimev1.Type_CODE_TIMESTAMP:
+		return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}
+	case runtimev1.Type_CODE_DATE:
+		return arrow.FixedWidthTypes.Date32
+	case runtimev1.Type_CODE_TIME:
+		return arrow.FixedWidthTypes.Time64us
+	case runtimev1.Type_CODE_BYTES:
+		return arrow.BinaryTypes.Binary
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+// ArrowSchemaToStructType converts an Arrow schema to a runtime schema.
+func ArrowSchemaToStructType(s *arrow.Schema) *runtimev1.StructType {
+	fields := make([]*runtimev1.StructType_Field, len(s.Fields()))
+	for i, f := range s.Fields() {
+		fields[i] = &runtimev1.StructType_Field{
+			Name: f.Name,
+			Type: arrowToType(f.Type, f.Nullable),
+		}
+	}
+	return &runtimev1.StructType{Fields: fields}
+}
+
+func arrowToType(dt arrow.DataType, nullable bool) *runtimev1.Type {
+	t := &runtimev1.Type{Nullable: nullable}
+	switch dt := dt.(type) {
+	case *arrow.BooleanType:
+		t.Code = runtimev1.Type_CODE_BOOL
+	case *arrow.Int8Type:
+		t.Code = runtimev1.Type_CODE_INT8
+	case *arrow.Int16Type:
+		t.Code = runtimev1.Type_CODE_INT16
+	case *arrow.Int32Type:
+		t.Code = runtimev1.Type_CODE_INT32
+	case *arrow.Int64Type:
+		t.Code = runtimev1.Type_CODE_INT64
+	case *arrow.Uint8Type:
+		t.Code = runtimev1.Type_CODE_UINT8
+	case *arrow.Uint16Type:
+		t.Code = runtimev1.Type_CODE_UINT16
+	case *arrow.Uint32Type:
+		t.Code = runtimev1.Type_CODE_UINT32
+	case *arrow.Uint64Type:
+		t.Code = runtimev1.Type_CODE_UINT64
+	case *arrow.Float16Type, *arrow.Float32Type:
+		t.Code = runtimev1.Type_CODE_FLOAT32
+	case *arrow.Float64Type:
+		t.Code = runtimev1.Type_CODE_FLOAT64
+	case *arrow.Decimal128Type, *arrow.Decimal256Type:
+		t.Code = runtimev1.Type_CODE_DECIMAL
+	case *arrow.TimestampType:
+		t.Code = runtimev1.Type_CODE_TIMESTAMP
+	case *arrow.Date32Type, *arrow.Date64Type:
+		t.Code = runtimev1.Type_CODE_DATE
+	case *arrow.Time32Type, *arrow.Time64Type:
+		t.Code = runtimev1.Type_CODE_TIME
+	case *arrow.StringType, *arrow.LargeStringType:
+		t.Code = runtimev1.Type_CODE_STRING
+	case *arrow.BinaryType, *arrow.LargeBinaryType, *arrow.FixedSizeBinaryType:
+		t.Code = runtimev1.Type_CODE_BYTES
+	case *arrow.ListType:
+		t.Code = runtimev1.Type_CODE_ARRAY
+		t.ArrayElementType = arrowToType(dt.Elem(), dt.ElemField().Nullable)
+	case *arrow.FixedSizeListType:
+		t.Code = runtimev1.Type_CODE_ARRAY
+		t.ArrayElementType = arrowToType(dt.Elem(), dt.ElemField().Nullable)
+	case *arrow.StructType:
+		t.Code = runtimev1.Type_CODE_STRUCT
+		t.StructType = ArrowSchemaToStructType(arrow.NewSchema(dt.Fields(), nil))
+	case *arrow.MapType:
+		t.Code = runtimev1.Type_CODE_MAP
+		t.MapType = &runtimev1.MapType{
+			KeyType:   arrowToType(dt.KeyType(), false),
+			ValueType: arrowToType(dt.ItemType(), dt.ItemField().Nullable),
+		}
+	default:
+		t.Code = runtimev1.Type_CODE_UNSPECIFIED
+	}
+	return t
+}
+
+// rowsRecordReader implements array.RecordReader by converting the rows of a Result to record batches.
+type rowsRecordReader struct {
+	refCount  int64
+	res       *Result
+	schema    *arrow.Schema
+	builder   *array.RecordBuilder
+	batchSize int
+	rec       arrow.Record
+	err       error
+	done      bool
+}
+
+var _ array.RecordReader = (*rowsRecordReader)(nil)
+
+func (r *rowsRecordReader) Retain() {
+	atomic.AddInt64(&r.refCount, 1)
+}
+
+func (r *rowsRecordReader) Release() {
+	if atomic.AddInt64(&r.refCount, -1) == 0 {
+		if r.rec != nil {
+			r.rec.Release()
+			r.rec = nil
+		}
+		r.builder.Release()
+	}
+}
+
+func (r *rowsRecordReader) Schema() *arrow.Schema {
+	return r.schema
+}
+
+func (r *rowsRecordReader) Record() arrow.Record {
+	return r.rec
+}
+
+func (r *rowsRecordReader) Err() error {
+	return r.err
+}
+
+func (r *rowsRecordReader) Next() bool {
+	if r.rec != nil {
+		r.rec.Release()
+		r.rec = nil
+	}
+	if r.done || r.err != nil {
+		return false
+	}
+
+	fields := r.res.Schema.GetFields()
+	n := 0
+	for n < r.batchSize {
+		if !r.res.Next() {
+			r.done = true
+			r.err = r.res.Err()
+			break
+		}
+
+		vals, err := r.res.SliceScan()
+		if err != nil {
+			r.err = err
+			break
+		}
+
+		for i, v := range vals {
+			var t *runtimev1.Type
+			if i < len(fields) {
+				t = fields[i].Type
+			}
+			v, err = jsonval.ToValue(v, t)
+			if err != nil {
+				r.err = err
+				break
+			}
+			if err := appendArrowValue(r.builder.Field(i), v); err != nil {
+				r.err = fmt.Errorf("column %q: %w", r.schema.Field(i).Name, err)
+				break
+			}
+		}
+		if r.err != nil {
+			break
+		}
+		n++
+	}
+
+	if r.err != nil || n == 0 {
+		// Discard any partially built batch
+		r.builder.NewRecord().Release()
+		return false
+	}
+
+	r.rec = r.builder.NewRecord()
+	return true
+}
+
+// appendArrowValue appends a value returned from jsonval.ToValue to an Arrow builder.
+func appendArrowValue(b array.Builder, v any) error {
+	if v == nil {
+		b.AppendNull()
+		return nil
+	}
+
+	switch b := b.(type) {
+	case *array.BooleanBuilder:
+		if v, ok := v.(bool); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Int8Builder:
+		if v, ok := toInt64(v); ok {
+			b.Append(int8(v))
+			return nil
+		}
+	case *array.Int16Builder:
+		if v, ok := toInt64(v); ok {
+			b.Append(int16(v))
+			return nil
+		}
+	case *array.Int32Builder:
+		if v, ok := toInt64(v); ok {
+			b.Append(int32(v))
+			return nil
+		}
+	case *array.Int64Builder:
+		if v, ok := toInt64(v); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Uint8Builder:
+		if v, ok := toInt64(v); ok {
+			b.Append(uint8(v))
+			return nil
+		}
+	case *array.Uint16Builder:
+		if v, ok := toInt64(v); ok {
+			b.Append(uint16(v))
+			return nil
+		}
+	case *array.Uint32Builder:
+		if v, ok := toInt64(v); ok {
+			b.Append(uint32(v))
+			return nil
+		}
+	case *array.Uint64Builder:
+		if v, ok := v.(uint64); ok {
+			b.Append(v)
+			return nil
+		}
+		if v, ok := toInt64(v); ok {
+			b.Append(uint64(v))
+			return nil
+		}
+	case *array.Float32Builder:
+		if v, ok := toFloat64(v); ok {
+			b.Append(float32(v))
+			return nil
+		}
+	case *array.Float64Builder:
+		if v, ok := toFloat64(v); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.TimestampBuilder:
+		if v, ok := v.(time.Time); ok {
+			b.AppendTime(v)
+			return nil
+		}
+	case *array.Date32Builder:
+		if v, ok := v.(time.Time); ok {
+			b.Append(arrow.Date32FromTime(v))
+			return nil
+		}
+	case *array.Time64Builder:
+		if v, ok := v.(time.Time); ok {
+			midnight := time.Date(v.Year(), v.Month(), v.Day(), 0, 0, 0, 0, v.Location())
+			b.Append(arrow.Time64(v.Sub(midnight).Microseconds()))
+			return nil
+		}
+	case *array.BinaryBuilder:
+		if v, ok := v.([]byte); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.StringBuilder:
+		switch v := v.(type) {
+		case string:
+			b.Append(v)
+		case []byte:
+			b.Append(string(v))
+		case fmt.Stringer:
+			b.Append(v.String())
+		default:
+			data, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			b.Append(string(data))
+		}
+		return nil
+	}
+
+	// Fall back to parsing string values (e.g. for dates that jsonval formats as strings)
+	if s, ok := v.(string); ok {
+		return b.AppendValueFromString(s)
+	}
+	return fmt.Errorf("cannot convert value of type %T to Arrow type %s", v, b.Type())
+}
+
+func toInt64(v any) (int64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return v, true
+	case uint64:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func toFloat64(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// cappedRecordReader wraps an array.RecordReader and returns an error if it returns more than cap rows.
+type cappedRecordReader struct {
+	array.RecordReader
+	cap  int64
+	rows int64
+	err  error
+}
+
+func (r *cappedRecordReader) Next() bool {
+	if r.err != nil || !r.RecordReader.Next() {
+		return false
+	}
+	r.rows += r.RecordReader.Record().NumRows()
+	if r.rows > r.cap {
+		r.err = fmt.Errorf("result cap exceeded: returned more than %d rows", r.cap)
+		return false
+	}
+	return true
+}
+
+func (r *cappedRecordReader) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.RecordReader.Err()
+}
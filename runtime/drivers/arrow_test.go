@@ -0,0 +1,103 @@
+package drivers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/marcboeker/go-duckdb"
+)
+
+func TestArrowSchema(t *testing.T) {
+	st := &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+		{Name: "a", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT32, Nullable: true}},
+		{Name: "b", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING, Nullable: true}},
+		{Name: "c", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP, Nullable: true}},
+		{Name: "d", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_DECIMAL, Nullable: true}},
+	}}
+
+	schema := drivers.StructTypeToArrowSchema(st)
+	require.Equal(t, arrow.INT32, schema.Field(0).Type.ID())
+	require.Equal(t, arrow.STRING, schema.Field(1).Type.ID())
+	require.Equal(t, arrow.TIMESTAMP, schema.Field(2).Type.ID())
+	require.Equal(t, arrow.FLOAT64, schema.Field(3).Type.ID())
+
+	res := drivers.ArrowSchemaToStructType(schema)
+	require.Equal(t, runtimev1.Type_CODE_INT32, res.Fields[0].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_STRING, res.Fields[1].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_TIMESTAMP, res.Fields[2].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_FLOAT64, res.Fields[3].Type.Code)
+}
+
+func TestResultArrowRecordReader(t *testing.T) {
+	res := arrowTestResult(t)
+	defer res.Close()
+
+	rdr, err := res.ArrowRecordReader(2)
+	require.NoError(t, err)
+
+	var batches []arrow.Record
+	for rdr.Next() {
+		rec := rdr.Record()
+		rec.Retain()
+		batches = append(batches, rec)
+	}
+	require.NoError(t, rdr.Err())
+	require.Len(t, batches, 3)
+	defer func() {
+		for _, rec := range batches {
+			rec.Release()
+		}
+	}()
+
+	first := batches[0]
+	require.Equal(t, int64(2), first.NumRows())
+	require.Equal(t, int64(1), first.Column(0).(*array.Int64).Value(1))
+	require.Equal(t, "x1", first.Column(1).(*array.String).Value(1))
+	require.True(t, first.Column(2).IsNull(0))
+	require.Equal(t, 0.5, first.Column(2).(*array.Float64).Value(1))
+	ts := first.Column(3).(*array.Timestamp).Value(0).ToTime(arrow.Microsecond)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), ts)
+	require.Equal(t, int64(1), batches[2].NumRows())
+}
+
+func TestResultArrowRecordReaderCap(t *testing.T) {
+	res := arrowTestResult(t)
+	defer res.Close()
+	res.SetCap(3)
+
+	rdr, err := res.ArrowRecordReader(10)
+	require.NoError(t, err)
+	require.False(t, rdr.Next())
+	require.ErrorContains(t, rdr.Err(), "result cap exceeded")
+}
+
+// arrowTestResult returns a row-based Result with five rows.
+func arrowTestResult(t *testing.T) *drivers.Result {
+	db, err := sqlx.Open("duckdb", "")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	rows, err := db.Queryx(`
+		SELECT
+			range AS i,
+			'x' || range AS s,
+			CASE WHEN range > 0 THEN range / 2 END AS f,
+			TIMESTAMP '2024-01-01' + INTERVAL (range) HOUR AS t
+		FROM range(5)
+	`)
+	require.NoError(t, err)
+
+	return &drivers.Result{Rows: rows, Schema: &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+		{Name: "i", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}},
+		{Name: "s", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+		{Name: "f", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64, Nullable: true}},
+		{Name: "t", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP}},
+	}}}
+}
@@ -141,6 +141,8 @@ type Connection struct {
 
 var _ drivers.Handle = &Connection{}
 
+var _ drivers.IncrementalObjectStore = &Connection{}
+
 // Driver implements drivers.Connection.
 func (c *Connection) Driver() string {
 	return "azure"
@@ -149,7 +151,7 @@ func (c *Connection) Driver() string {
 // Config implements drivers.Connection.
 func (c *Connection) Config() map[string]any {
 	m := make(map[string]any, 0)
-	_ = mapstructure.Decode(c.config, m)
+	_ = mapstructure.Decode(c.config, &m)
 	return m
 }
 
@@ -208,11 +210,6 @@ func (c *Connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecu
 	return nil, false
 }
 
-// AsModelManager implements drivers.Handle.
-func (c *Connection) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
-	return nil, false
-}
-
 // AsTransporter implements drivers.Connection.
 func (c *Connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
 	return nil, false
@@ -234,10 +231,30 @@ func (c *Connection) AsNotifier(properties map[string]any) (drivers.Notifier, er
 
 // DownloadFiles returns a file iterator over objects stored in azure blob storage.
 func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any) (drivers.FileIterator, error) {
+	return c.downloadFiles(ctx, props, nil)
+}
+
+// DownloadNewFiles implements drivers.IncrementalObjectStore.
+func (c *Connection) DownloadNewFiles(ctx context.Context, props, state map[string]any) (drivers.FileIterator, *drivers.IncrementalDownload, error) {
+	inc, err := rillblob.NewIncrementalFiles(state)
+	if err != nil {
+		return nil, nil, err
+	}
+	it, err := c.downloadFiles(ctx, props, inc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return it, &drivers.IncrementalDownload{State: inc.State(), Append: !inc.Full()}, nil
+}
+
+func (c *Connection) downloadFiles(ctx context.Context, props map[string]any, inc *rillblob.IncrementalFiles) (drivers.FileIterator, error) {
 	conf, err := parseSourceProperties(props)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
+	if inc != nil && !conf.Incremental {
+		return nil, fmt.Errorf("property 'incremental' is not enabled")
+	}
 
 	client, err := c.getClient(conf)
 	if err != nil {
@@ -271,6 +288,8 @@ func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any) (d
 		ExtractPolicy:         conf.extractPolicy,
 		BatchSizeBytes:        int64(batchSize.Bytes()),
 		KeepFilesUntilClose:   conf.BatchSize == "-1",
+		Table:                 conf.table,
+		Incremental:           inc,
 	}
 
 	iter, err := rillblob.NewIterator(ctx, bucketObj, opts, c.logger)
@@ -317,8 +336,10 @@ type sourceProperties struct {
 	GlobMaxObjectsListed  int64          `mapstructure:"glob.max_objects_listed"`
 	GlobPageSize          int            `mapstructure:"glob.page_size"`
 	BatchSize             string         `mapstructure:"batch_size"`
+	Incremental           bool           `mapstructure:"incremental"`
 	url                   *globutil.URL
 	extractPolicy         *rillblob.ExtractPolicy
+	table                 *rillblob.TableOptions
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
@@ -339,6 +360,16 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 	}
 
 	conf.url = bucketURL
+
+	conf.table, err = rillblob.ParseTableOptions(props)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.Incremental && (conf.extractPolicy != nil || conf.table != nil) {
+		return nil, fmt.Errorf("property 'incremental' can't be combined with 'extract' or a table format")
+	}
+
 	return conf, nil
 }
 
@@ -0,0 +1,57 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	rillblob "github.com/rilldata/rill/runtime/drivers/blob"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/azureblob"
+)
+
+// AsModelManager implements drivers.Handle.
+func (c *Connection) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
+	return rillblob.NewModelManager(c.openBucketAt), true
+}
+
+// UploadFiles implements drivers.ObjectStore.
+func (c *Connection) UploadFiles(ctx context.Context, localDir string, props map[string]any) error {
+	return rillblob.UploadFiles(ctx, c.openBucketAt, localDir, props)
+}
+
+// ReplaceFiles implements drivers.ObjectStore.
+func (c *Connection) ReplaceFiles(ctx context.Context, localDir string, props map[string]any) error {
+	return rillblob.ReplaceFiles(ctx, c.openBucketAt, localDir, props)
+}
+
+// DeleteFiles implements drivers.ObjectStore.
+func (c *Connection) DeleteFiles(ctx context.Context, props map[string]any) error {
+	return rillblob.DeleteFiles(ctx, c.openBucketAt, props)
+}
+
+// ResolveTable implements drivers.ObjectStore.
+func (c *Connection) ResolveTable(ctx context.Context, props map[string]any) (*drivers.ObjectStoreTable, error) {
+	return rillblob.ResolveTableFiles(ctx, c.openBucketAt, props)
+}
+
+// openBucketAt implements rillblob.BucketOpener.
+func (c *Connection) openBucketAt(ctx context.Context, props map[string]any) (*blob.Bucket, string, error) {
+	conf, err := parseSourceProperties(props)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	client, err := c.getClient(conf)
+	if err != nil {
+		return nil, "", err
+	}
+
+	bucket, err := azureblob.OpenBucket(ctx, client, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open container %q, %w", conf.url.Host, err)
+	}
+
+	return bucket, strings.TrimSuffix(conf.url.Path, "/"), nil
+}
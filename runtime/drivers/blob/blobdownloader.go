@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"strings"
@@ -57,6 +58,10 @@ type Options struct {
 	Format string
 	// TempDir where temporary files should be stored
 	TempDir string
+	// Table resolves the data files of an Iceberg or Delta table at GlobPattern instead of matching files by the pattern
+	Table *TableOptions
+	// Incremental skips objects that were ingested before and records the matched objects
+	Incremental *IncrementalFiles
 }
 
 // sets defaults if not set by user
@@ -102,6 +107,10 @@ type blobIterator struct {
 	tempDir   string
 	lastBatch []string
 
+	// partitionColumns and partitionValues are set for tables with partition columns that are not stored in the data files
+	partitionColumns []drivers.TablePartitionColumn
+	partitionValues  map[string]map[string]*string
+
 	ctx         context.Context
 	cancel      func()
 	batchCh     chan []string       // Channel for batches of downloaded files (buffers up to BatchSizeBytes)
@@ -109,7 +118,7 @@ type blobIterator struct {
 	downloadErr error
 }
 
-var _ drivers.FileIterator = &blobIterator{}
+var _ drivers.TableFileIterator = &blobIterator{}
 
 // NewIterator returns an iterator for downloading objects matching a glob pattern and extract policy.
 // The downloaded objects will be stored in a temporary directory with the same file hierarchy as in the bucket, enabling parsing of hive partitioning on the downloaded files.
@@ -118,6 +127,13 @@ var _ drivers.FileIterator = &blobIterator{}
 func NewIterator(ctx context.Context, bucket *blob.Bucket, opts Options, l *zap.Logger) (drivers.FileIterator, error) {
 	opts.validate()
 
+	// Refreshes of incremental sources append to or replace the existing table.
+	// Returning the files in a single batch ensures they are ingested at once, so a failed ingestion doesn't leave partially ingested files behind.
+	if opts.Incremental != nil && !opts.Incremental.Initial() {
+		opts.BatchSizeBytes = math.MaxInt64
+		opts.KeepFilesUntilClose = true
+	}
+
 	tempDir, err := os.MkdirTemp(opts.TempDir, "blob_ingestion")
 	if err != nil {
 		return nil, err
@@ -243,6 +259,21 @@ func (it *blobIterator) Next() ([]string, error) {
 	return result, nil
 }
 
+// PartitionColumns implements drivers.TableFileIterator.
+func (it *blobIterator) PartitionColumns() []drivers.TablePartitionColumn {
+	return it.partitionColumns
+}
+
+// PartitionValues implements drivers.TableFileIterator.
+// Files are downloaded to the same path relative to the temporary directory as their key in the bucket.
+func (it *blobIterator) PartitionValues(file string) map[string]*string {
+	rel, err := filepath.Rel(it.tempDir, file)
+	if err != nil {
+		return nil
+	}
+	return it.partitionValues[filepath.ToSlash(rel)]
+}
+
 func (it *blobIterator) Format() string {
 	return it.opts.Format
 }
@@ -258,6 +289,13 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 		return nil, err
 	}
 
+	if it.opts.Table != nil {
+		return it.planTable(planner)
+	}
+	if it.opts.Incremental != nil {
+		it.opts.Incremental.reset()
+	}
+
 	listOpts, ok := listOptions(it.opts.GlobPattern)
 	if !ok {
 		it.logger.Debug("glob pattern corresponds to single object", zap.String("glob", it.opts.GlobPattern))
@@ -270,6 +308,15 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 			size = attr.Size
 		}
 
+		if it.opts.Incremental != nil {
+			if attr == nil {
+				return nil, fmt.Errorf("failed to fetch attributes of %q, which are required for incremental ingestion: %w", it.opts.GlobPattern, err)
+			}
+			if !it.opts.Incremental.observe(it.opts.GlobPattern, attr.MD5, attr.ModTime, attr.Size) && !it.opts.Incremental.Full() {
+				return nil, nil
+			}
+		}
+
 		planner.add(&blob.ListObject{Key: it.opts.GlobPattern, Size: size})
 		if err := it.opts.validateLimits(size, 1, 1); err != nil {
 			return nil, err
@@ -277,6 +324,8 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 		return planner.items(), nil
 	}
 	it.logger.Debug("planner started", zap.String("glob", it.opts.GlobPattern), zap.String("prefix", listOpts.Prefix), observability.ZapCtx(it.ctx))
+	// Objects of incremental sources that were ingested before, which are only planned if all objects must be ingested again
+	var ingested []*blob.ListObject
 	token := blob.FirstPageToken
 	for token != nil && !planner.done() {
 		objs, nextToken, err := it.bucket.ListPage(it.ctx, token, it.opts.GlobPageSize, listOpts)
@@ -288,6 +337,11 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 		fetched += int64(len(objs))
 		for _, obj := range objs {
 			if matched, _ := doublestar.Match(it.opts.GlobPattern, obj.Key); matched {
+				// Objects that were already ingested don't count towards the limits
+				if it.opts.Incremental != nil && !it.opts.Incremental.observeObject(obj) {
+					ingested = append(ingested, obj)
+					continue
+				}
 				size += obj.Size
 				matchCount++
 				if !planner.add(obj) {
@@ -300,8 +354,21 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 		}
 	}
 
+	// Appending wouldn't reflect changed or removed objects, so all objects are ingested again
+	if len(ingested) > 0 && it.opts.Incremental.Full() {
+		it.logger.Debug("previously ingested objects changed, planning all objects", zap.String("glob", it.opts.GlobPattern), observability.ZapCtx(it.ctx))
+		for _, obj := range ingested {
+			size += obj.Size
+			matchCount++
+			planner.add(obj)
+		}
+		if err := it.opts.validateLimits(size, matchCount, fetched); err != nil {
+			return nil, err
+		}
+	}
+
 	items := planner.items()
-	if len(items) == 0 {
+	if len(items) == 0 && (it.opts.Incremental == nil || it.opts.Incremental.Full()) {
 		return nil, fmt.Errorf("no files found for glob pattern %q", it.opts.GlobPattern)
 	}
 
@@ -311,6 +378,38 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 	return items, nil
 }
 
+// planTable plans the data files of the table at the glob pattern.
+func (it *blobIterator) planTable(planner *planner) ([]*objectWithPlan, error) {
+	if _, ok := listOptions(it.opts.GlobPattern); ok {
+		return nil, fmt.Errorf("the path of a %s table can't be a glob pattern", it.opts.Table.Format)
+	}
+
+	table, err := ResolveTable(it.ctx, it.bucket, it.opts.GlobPattern, it.opts.Table)
+	if err != nil {
+		return nil, err
+	}
+	if len(table.Files) == 0 {
+		return nil, fmt.Errorf("no data files found for %s table %q", it.opts.Table.Format, it.opts.GlobPattern)
+	}
+	it.partitionColumns = table.PartitionColumns
+	it.partitionValues = table.PartitionValues
+
+	var size int64
+	for _, f := range table.Files {
+		size += f.Size
+		if !planner.add(f) {
+			break
+		}
+	}
+	if err := it.opts.validateLimits(size, len(table.Files), int64(len(table.Files))); err != nil {
+		return nil, err
+	}
+
+	it.logger.Debug("planned table files", zap.String("format", it.opts.Table.Format), zap.String("path", it.opts.GlobPattern), zap.Int64("version", table.Version),
+		zap.Int("files", len(table.Files)), zap.Int64("bytes_matched", size), observability.ZapCtx(it.ctx))
+	return planner.items(), nil
+}
+
 func (it *blobIterator) downloadFiles() {
 	// Ensure the downloadsCh is closed when the function returns.
 	// This unblocks waiting calls to Next() or Close().
@@ -470,6 +569,14 @@ func (it *prefetchedIterator) Format() string {
 	return it.underlying.Format()
 }
 
+func (it *prefetchedIterator) PartitionColumns() []drivers.TablePartitionColumn {
+	return it.underlying.PartitionColumns()
+}
+
+func (it *prefetchedIterator) PartitionValues(file string) map[string]*string {
+	return it.underlying.PartitionValues(file)
+}
+
 // downloadResult represents a successfully downloaded file
 type downloadResult struct {
 	path  string
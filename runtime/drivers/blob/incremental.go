@@ -0,0 +1,108 @@
+package blob
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"gocloud.dev/blob"
+)
+
+// IncrementalFiles tracks the objects ingested by incremental sources.
+// When set on Options, the iterator skips objects that were ingested before.
+// If an ingested object has changed or been removed since, appending wouldn't reflect it, so the iterator returns all objects instead (see Full).
+type IncrementalFiles struct {
+	prev map[string]FileVersion
+	next map[string]FileVersion
+	// changed is true if an object of the previous ingestion has changed since
+	changed bool
+}
+
+// FileVersion identifies the version of an ingested object.
+type FileVersion struct {
+	// ETag is the object's MD5 hash if the object store provides it.
+	ETag string `mapstructure:"etag"`
+	// LastModified is the object's last modified time in RFC3339 format.
+	LastModified string `mapstructure:"last_modified"`
+	Size         int64  `mapstructure:"size"`
+}
+
+type incrementalState struct {
+	Files map[string]FileVersion `mapstructure:"files"`
+}
+
+// NewIncrementalFiles parses the state of a previous incremental ingestion.
+// The state is nil on the first ingestion, in which case all objects are ingested.
+func NewIncrementalFiles(state map[string]any) (*IncrementalFiles, error) {
+	s := &incrementalState{}
+	if err := mapstructure.WeakDecode(state, s); err != nil {
+		return nil, fmt.Errorf("invalid incremental state: %w", err)
+	}
+	return &IncrementalFiles{prev: s.Files}, nil
+}
+
+// Initial returns true if no objects have been ingested before.
+func (f *IncrementalFiles) Initial() bool {
+	return f.prev == nil
+}
+
+// Full returns true if the planned objects include all matched objects and should replace the previously ingested data.
+// This is the case for the first ingestion and when an object of the previous ingestion has changed or been removed.
+func (f *IncrementalFiles) Full() bool {
+	if f.prev == nil || f.changed {
+		return true
+	}
+	for k := range f.prev {
+		if _, ok := f.next[k]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// State returns the state to persist after the planned objects have been ingested.
+// It contains all objects matched by the last plan, including those that were skipped.
+func (f *IncrementalFiles) State() map[string]any {
+	files := make(map[string]any, len(f.next))
+	for k, v := range f.next {
+		files[k] = map[string]any{
+			"etag":          v.ETag,
+			"last_modified": v.LastModified,
+			"size":          v.Size,
+		}
+	}
+	return map[string]any{"files": files}
+}
+
+// reset clears the objects matched by a previous plan.
+func (f *IncrementalFiles) reset() {
+	f.next = make(map[string]FileVersion)
+	f.changed = false
+}
+
+// observe records a matched object and returns true if it is new since the previous ingestion.
+// If the object was ingested before but has changed since, it returns false and Full will return true.
+func (f *IncrementalFiles) observe(key string, md5 []byte, modTime time.Time, size int64) bool {
+	v := FileVersion{ETag: hex.EncodeToString(md5), Size: size}
+	if !modTime.IsZero() {
+		v.LastModified = modTime.UTC().Format(time.RFC3339Nano)
+	}
+	f.next[key] = v
+
+	prev, ok := f.prev[key]
+	if !ok {
+		return true
+	}
+	if v.ETag != "" && prev.ETag != "" {
+		f.changed = f.changed || v.ETag != prev.ETag || v.Size != prev.Size
+	} else {
+		f.changed = f.changed || v.LastModified != prev.LastModified || v.Size != prev.Size
+	}
+	return false
+}
+
+// observeObject is observe for listed objects.
+func (f *IncrementalFiles) observeObject(obj *blob.ListObject) bool {
+	return f.observe(obj.Key, obj.MD5, obj.ModTime, obj.Size)
+}
@@ -0,0 +1,88 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gocloud.dev/blob/fileblob"
+)
+
+func TestIncrementalIterator(t *testing.T) {
+	ctx := context.Background()
+	// The iterator closes the bucket, so the bucket is reopened for each download
+	dir := t.TempDir()
+	bucket, err := fileblob.OpenBucket(dir, nil)
+	require.NoError(t, err)
+	defer bucket.Close()
+	require.NoError(t, bucket.WriteAll(ctx, "2024/01/a.csv", []byte("a"), nil))
+	require.NoError(t, bucket.WriteAll(ctx, "2024/01/b.csv", []byte("b"), nil))
+
+	download := func(state map[string]any) ([]string, bool, map[string]any) {
+		inc, err := NewIncrementalFiles(state)
+		require.NoError(t, err)
+		b, err := fileblob.OpenBucket(dir, nil)
+		require.NoError(t, err)
+		it, err := NewIterator(ctx, b, Options{GlobPattern: "2024/**/*.csv", Incremental: inc}, zap.NewNop())
+		require.NoError(t, err)
+		defer it.Close()
+
+		var keys []string
+		for {
+			files, err := it.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			for _, f := range files {
+				keys = append(keys, filepath.Base(f))
+			}
+		}
+		sort.Strings(keys)
+		return keys, inc.Full(), inc.State()
+	}
+
+	// The first ingestion downloads all files
+	keys, full, state := download(nil)
+	require.Equal(t, []string{"a.csv", "b.csv"}, keys)
+	require.True(t, full)
+	require.Len(t, state["files"], 2)
+
+	// Nothing changed
+	keys, full, state = download(state)
+	require.Empty(t, keys)
+	require.False(t, full)
+	require.Len(t, state["files"], 2)
+
+	// Only new files are downloaded
+	require.NoError(t, bucket.WriteAll(ctx, "2024/02/c.csv", []byte("c"), nil))
+	keys, full, state = download(state)
+	require.Equal(t, []string{"c.csv"}, keys)
+	require.False(t, full)
+	require.Len(t, state["files"], 3)
+
+	// All files are downloaded if an ingested file changed
+	require.NoError(t, bucket.WriteAll(ctx, "2024/01/b.csv", []byte("bb"), nil))
+	require.NoError(t, bucket.WriteAll(ctx, "2024/02/d.csv", []byte("d"), nil))
+	keys, full, state = download(state)
+	require.Equal(t, []string{"a.csv", "b.csv", "c.csv", "d.csv"}, keys)
+	require.True(t, full)
+	require.Len(t, state["files"], 4)
+
+	// All remaining files are downloaded if an ingested file was removed
+	require.NoError(t, bucket.Delete(ctx, "2024/01/a.csv"))
+	keys, full, state = download(state)
+	require.Equal(t, []string{"b.csv", "c.csv", "d.csv"}, keys)
+	require.True(t, full)
+	require.Len(t, state["files"], 3)
+
+	// The state doesn't retain the removed file
+	keys, full, _ = download(state)
+	require.Empty(t, keys)
+	require.False(t, full)
+}
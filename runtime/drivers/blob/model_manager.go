@@ -0,0 +1,342 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"gocloud.dev/blob"
+)
+
+// ModelFileNamePrefix is the name prefix of the files that models write to an object store.
+// The path of a model may also contain files that were not written by the model, so only files with this prefix are replaced.
+const ModelFileNamePrefix = "rill_"
+
+// BucketOpener opens the bucket of the object store path in props.
+// It returns the bucket and the path's object key prefix.
+type BucketOpener func(ctx context.Context, props map[string]any) (*blob.Bucket, string, error)
+
+// ModelOutputProperties are the output properties of models that write to an object store.
+type ModelOutputProperties struct {
+	// Path is the object store path to write to, such as s3://bucket/prefix.
+	// If it ends with a slash, the model's name is appended to it.
+	Path                string                      `mapstructure:"path"`
+	Format              string                      `mapstructure:"format"`
+	PartitionBy         []string                    `mapstructure:"partition_by"`
+	IncrementalStrategy drivers.IncrementalStrategy `mapstructure:"incremental_strategy"`
+	// Region and Endpoint only apply to S3.
+	Region   string `mapstructure:"region"`
+	Endpoint string `mapstructure:"endpoint"`
+	// Account only applies to Azure.
+	Account string `mapstructure:"account"`
+}
+
+func (p *ModelOutputProperties) Validate() error {
+	if p.Path == "" {
+		return fmt.Errorf("missing property 'path'")
+	}
+	if strings.ContainsAny(p.Path, "*?[{") {
+		return fmt.Errorf("property 'path' must not contain glob patterns")
+	}
+
+	if p.Format == "" {
+		p.Format = "parquet"
+	}
+
+	switch p.IncrementalStrategy {
+	case drivers.IncrementalStrategyUnspecified:
+		p.IncrementalStrategy = drivers.IncrementalStrategyAppend
+	case drivers.IncrementalStrategyAppend:
+	case drivers.IncrementalStrategyPartitionOverwrite:
+		if len(p.PartitionBy) == 0 {
+			return fmt.Errorf(`must specify a "partition_by" when "incremental_strategy" is %q`, p.IncrementalStrategy)
+		}
+	default:
+		return fmt.Errorf("invalid incremental strategy %q", p.IncrementalStrategy)
+	}
+
+	return nil
+}
+
+// ResolvedPath returns the path that the model's files are written to.
+func (p *ModelOutputProperties) ResolvedPath(modelName string) (string, bool) {
+	if strings.HasSuffix(p.Path, "/") {
+		return p.Path + modelName, true
+	}
+	return p.Path, false
+}
+
+// ModelResultProperties are the result properties of models that write to an object store.
+type ModelResultProperties struct {
+	Path          string   `mapstructure:"path"`
+	Format        string   `mapstructure:"format"`
+	PartitionBy   []string `mapstructure:"partition_by"`
+	Region        string   `mapstructure:"region,omitempty"`
+	Endpoint      string   `mapstructure:"endpoint,omitempty"`
+	Account       string   `mapstructure:"account,omitempty"`
+	UsedModelName bool     `mapstructure:"used_model_name"`
+}
+
+// ModelManager implements drivers.ModelManager for models that write files to an object store.
+// The model's result is the set of objects under the result path.
+type ModelManager struct {
+	open BucketOpener
+}
+
+var _ drivers.ModelManager = &ModelManager{}
+
+func NewModelManager(open BucketOpener) *ModelManager {
+	return &ModelManager{open: open}
+}
+
+func (m *ModelManager) Rename(ctx context.Context, res *drivers.ModelResult, newName string, env *drivers.ModelEnv) (*drivers.ModelResult, error) {
+	resProps := &ModelResultProperties{}
+	if err := mapstructure.WeakDecode(res.Properties, resProps); err != nil {
+		return nil, fmt.Errorf("failed to parse previous result properties: %w", err)
+	}
+
+	if !resProps.UsedModelName {
+		return res, nil
+	}
+
+	bucket, prefix, err := m.open(ctx, res.Properties)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+
+	// Object stores don't support renames, so we copy the objects to the new prefix and delete the old ones.
+	newPrefix := path.Join(path.Dir(prefix), newName)
+	if err := deletePrefix(ctx, bucket, newPrefix); err != nil {
+		return nil, fmt.Errorf("failed to clear %q: %w", newPrefix, err)
+	}
+	if err := copyPrefix(ctx, bucket, prefix, newPrefix); err != nil {
+		return nil, fmt.Errorf("failed to copy files to %q: %w", newPrefix, err)
+	}
+	if err := deletePrefix(ctx, bucket, prefix); err != nil {
+		return nil, fmt.Errorf("failed to delete files in %q: %w", prefix, err)
+	}
+
+	resProps.Path = strings.TrimSuffix(resProps.Path, path.Base(prefix)) + newName
+	resPropsMap := map[string]interface{}{}
+	err = mapstructure.WeakDecode(resProps, &resPropsMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result properties: %w", err)
+	}
+
+	return &drivers.ModelResult{
+		Connector:  res.Connector,
+		Properties: resPropsMap,
+	}, nil
+}
+
+func (m *ModelManager) Exists(ctx context.Context, res *drivers.ModelResult) (bool, error) {
+	bucket, prefix, err := m.open(ctx, res.Properties)
+	if err != nil {
+		return false, err
+	}
+	defer bucket.Close()
+
+	it := bucket.List(&blob.ListOptions{Prefix: dirPrefix(prefix)})
+	_, err = it.Next(ctx)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *ModelManager) Delete(ctx context.Context, res *drivers.ModelResult) error {
+	resProps := &ModelResultProperties{}
+	if err := mapstructure.WeakDecode(res.Properties, resProps); err != nil {
+		return fmt.Errorf("failed to parse result properties: %w", err)
+	}
+
+	// Only delete the files under a prefix derived from the model's name.
+	// An explicit path is owned by the user and may contain files that were not written by the model.
+	if !resProps.UsedModelName {
+		return nil
+	}
+
+	bucket, prefix, err := m.open(ctx, res.Properties)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	return deletePrefix(ctx, bucket, prefix)
+}
+
+// UploadFiles uploads the files in localDir to the object store path in props, preserving their paths relative to localDir.
+func UploadFiles(ctx context.Context, open BucketOpener, localDir string, props map[string]any) error {
+	bucket, prefix, err := open(ctx, props)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	return uploadDir(ctx, bucket, prefix, localDir)
+}
+
+// uploadDir uploads the files in localDir to prefix in bucket, preserving their paths relative to localDir.
+func uploadDir(ctx context.Context, bucket *blob.Bucket, prefix, localDir string) error {
+	return filepath.WalkDir(localDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(localDir, p)
+		if err != nil {
+			return err
+		}
+		key := path.Join(prefix, filepath.ToSlash(rel))
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		contentType := mime.TypeByExtension(filepath.Ext(p))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		err = bucket.Upload(ctx, key, f, &blob.WriterOptions{ContentType: contentType})
+		if err != nil {
+			return fmt.Errorf("failed to upload %q: %w", key, err)
+		}
+		return nil
+	})
+}
+
+// ReplaceFiles replaces the objects written by models under the object store path in props with the files in localDir.
+// Only objects with names that start with ModelFileNamePrefix are removed, so other files under the path are kept.
+// It uploads the files to a staging prefix next to the path before swapping them in,
+// so a failed upload leaves the previous objects untouched.
+func ReplaceFiles(ctx context.Context, open BucketOpener, localDir string, props map[string]any) error {
+	bucket, prefix, err := open(ctx, props)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	staging := fmt.Sprintf("%s__rill_staging_%s", prefix, uuid.NewString())
+	defer func() {
+		// Use a fresh context since the staged files should be cleaned up even if ctx was cancelled
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		_ = deletePrefix(ctx, bucket, staging)
+	}()
+
+	err = uploadDir(ctx, bucket, staging, localDir)
+	if err != nil {
+		return err
+	}
+
+	// Object stores don't support renames, so we swap by deleting the previous objects and copying the staged objects into place.
+	if err := deleteModelFiles(ctx, bucket, prefix); err != nil {
+		return fmt.Errorf("failed to delete previous files in %q: %w", prefix, err)
+	}
+	if err := copyPrefix(ctx, bucket, staging, prefix); err != nil {
+		return fmt.Errorf("failed to copy files to %q: %w", prefix, err)
+	}
+	return nil
+}
+
+// DeleteFiles deletes all objects under the object store path in props.
+func DeleteFiles(ctx context.Context, open BucketOpener, props map[string]any) error {
+	bucket, prefix, err := open(ctx, props)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	return deletePrefix(ctx, bucket, prefix)
+}
+
+func copyPrefix(ctx context.Context, bucket *blob.Bucket, from, to string) error {
+	from = dirPrefix(from)
+	to = dirPrefix(to)
+	it := bucket.List(&blob.ListOptions{Prefix: from})
+	for {
+		obj, err := it.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		err = bucket.Copy(ctx, to+strings.TrimPrefix(obj.Key, from), obj.Key, nil)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func deletePrefix(ctx context.Context, bucket *blob.Bucket, prefix string) error {
+	if prefix == "" {
+		return fmt.Errorf("refusing to delete all objects in the bucket")
+	}
+	it := bucket.List(&blob.ListOptions{Prefix: dirPrefix(prefix)})
+	for {
+		obj, err := it.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		err = bucket.Delete(ctx, obj.Key)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// deleteModelFiles deletes the objects under prefix that have names starting with ModelFileNamePrefix.
+func deleteModelFiles(ctx context.Context, bucket *blob.Bucket, prefix string) error {
+	if prefix == "" {
+		return fmt.Errorf("refusing to delete all objects in the bucket")
+	}
+	it := bucket.List(&blob.ListOptions{Prefix: dirPrefix(prefix)})
+	for {
+		obj, err := it.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if !strings.HasPrefix(path.Base(obj.Key), ModelFileNamePrefix) {
+			continue
+		}
+		err = bucket.Delete(ctx, obj.Key)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// dirPrefix returns the prefix with a trailing slash, so that the prefix "foo" doesn't match objects under "foobar/".
+func dirPrefix(prefix string) string {
+	if prefix == "" || strings.HasSuffix(prefix, "/") {
+		return prefix
+	}
+	return prefix + "/"
+}
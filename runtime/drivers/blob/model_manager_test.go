@@ -0,0 +1,113 @@
+package blob
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/fileblob"
+)
+
+func TestModelManager(t *testing.T) {
+	ctx := context.Background()
+	bucketDir := t.TempDir()
+	bucket, err := fileblob.OpenBucket(bucketDir, nil)
+	require.NoError(t, err)
+	defer bucket.Close()
+
+	open := func(ctx context.Context, props map[string]any) (*blob.Bucket, string, error) {
+		p, _ := props["path"].(string)
+		b, err := fileblob.OpenBucket(bucketDir, nil)
+		return b, strings.TrimSuffix(strings.TrimPrefix(p, "file://bucket/"), "/"), err
+	}
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "year=2024"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "year=2024", "data_0.parquet"), []byte("a"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data_1.parquet"), []byte("b"), 0o644))
+
+	require.NoError(t, UploadFiles(ctx, open, dir, map[string]any{"path": "file://bucket/out/orders"}))
+	require.NoError(t, bucket.WriteAll(ctx, "out/orders_other/data.parquet", []byte("c"), nil))
+
+	m := NewModelManager(open)
+	res := &drivers.ModelResult{Connector: "file", Properties: map[string]any{"path": "file://bucket/out/orders", "format": "parquet", "used_model_name": true}}
+	exists, err := m.Exists(ctx, res)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// Rename moves the objects to the new prefix
+	res, err = m.Rename(ctx, res, "sales", nil)
+	require.NoError(t, err)
+	require.Equal(t, "file://bucket/out/sales", res.Properties["path"])
+	data, err := bucket.ReadAll(ctx, "out/sales/year=2024/data_0.parquet")
+	require.NoError(t, err)
+	require.Equal(t, "a", string(data))
+	ok, err := bucket.Exists(ctx, "out/orders/data_1.parquet")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Delete removes the prefix, but not objects with a common name prefix
+	require.NoError(t, m.Delete(ctx, res))
+	exists, err = m.Exists(ctx, res)
+	require.NoError(t, err)
+	require.False(t, exists)
+	ok, err = bucket.Exists(ctx, "out/orders_other/data.parquet")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Results with an explicit path are not moved on rename or deleted
+	res = &drivers.ModelResult{Properties: map[string]any{"path": "file://bucket/out/orders_other"}}
+	renamed, err := m.Rename(ctx, res, "other", nil)
+	require.NoError(t, err)
+	require.Equal(t, res, renamed)
+	require.NoError(t, m.Delete(ctx, res))
+	ok, err = bucket.Exists(ctx, "out/orders_other/data.parquet")
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestReplaceFiles(t *testing.T) {
+	ctx := context.Background()
+	bucketDir := t.TempDir()
+	bucket, err := fileblob.OpenBucket(bucketDir, nil)
+	require.NoError(t, err)
+	defer bucket.Close()
+
+	open := func(ctx context.Context, props map[string]any) (*blob.Bucket, string, error) {
+		p, _ := props["path"].(string)
+		b, err := fileblob.OpenBucket(bucketDir, nil)
+		return b, strings.TrimSuffix(strings.TrimPrefix(p, "file://bucket/"), "/"), err
+	}
+	props := map[string]any{"path": "file://bucket/out/orders"}
+
+	require.NoError(t, bucket.WriteAll(ctx, "out/orders/rill_old.parquet", []byte("a"), nil))
+	require.NoError(t, bucket.WriteAll(ctx, "out/orders/user.parquet", []byte("u"), nil))
+
+	// A failed upload leaves the previous files in place
+	err = ReplaceFiles(ctx, open, filepath.Join(t.TempDir(), "missing"), props)
+	require.Error(t, err)
+	data, err := bucket.ReadAll(ctx, "out/orders/rill_old.parquet")
+	require.NoError(t, err)
+	require.Equal(t, "a", string(data))
+
+	// A successful upload replaces the files written by models, keeps other files and cleans up the staged files
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "rill_new.parquet"), []byte("b"), 0o644))
+	require.NoError(t, ReplaceFiles(ctx, open, dir, props))
+
+	var keys []string
+	it := bucket.List(&blob.ListOptions{Prefix: "out/"})
+	for {
+		obj, err := it.Next(ctx)
+		if err != nil {
+			break
+		}
+		keys = append(keys, obj.Key)
+	}
+	require.Equal(t, []string{"out/orders/rill_new.parquet", "out/orders/user.parquet"}, keys)
+}
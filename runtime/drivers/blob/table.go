@@ -0,0 +1,192 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"gocloud.dev/blob"
+)
+
+// Table formats that are read by resolving the data files of a table snapshot instead of matching files by path.
+const (
+	TableFormatIceberg = "iceberg"
+	TableFormatDelta   = "delta"
+)
+
+// IsTableFormat returns true if format is a table format.
+func IsTableFormat(format string) bool {
+	return format == TableFormatIceberg || format == TableFormatDelta
+}
+
+// TableOptions configures how a table's data files are resolved.
+type TableOptions struct {
+	// Format is TableFormatIceberg or TableFormatDelta.
+	Format string
+	// SnapshotID pins an Iceberg table to a snapshot. If nil, the current snapshot is used.
+	SnapshotID *int64
+	// Version pins a Delta table to a version. If nil, the latest version is used.
+	Version *int64
+	// PartitionFilter only includes files in partitions where the partition column's value is one of the given values.
+	PartitionFilter map[string][]string
+}
+
+type tableProperties struct {
+	Format          string         `mapstructure:"format"`
+	SnapshotID      *int64         `mapstructure:"snapshot_id"`
+	Version         *int64         `mapstructure:"version"`
+	PartitionFilter map[string]any `mapstructure:"partition_filter"`
+}
+
+// ParseTableOptions parses table options from source properties.
+// It returns nil if the properties don't have a table format.
+func ParseTableOptions(props map[string]any) (*TableOptions, error) {
+	p := &tableProperties{}
+	if err := mapstructure.WeakDecode(props, p); err != nil {
+		return nil, err
+	}
+	if !IsTableFormat(p.Format) {
+		if p.SnapshotID != nil || p.Version != nil || p.PartitionFilter != nil {
+			return nil, fmt.Errorf("properties 'snapshot_id', 'version' and 'partition_filter' require format %q or %q", TableFormatIceberg, TableFormatDelta)
+		}
+		return nil, nil
+	}
+	if p.Format == TableFormatIceberg && p.Version != nil {
+		return nil, fmt.Errorf("property 'version' is not supported for Iceberg tables, use 'snapshot_id'")
+	}
+	if p.Format == TableFormatDelta && p.SnapshotID != nil {
+		return nil, fmt.Errorf("property 'snapshot_id' is not supported for Delta tables, use 'version'")
+	}
+
+	opts := &TableOptions{
+		Format:     p.Format,
+		SnapshotID: p.SnapshotID,
+		Version:    p.Version,
+	}
+	if len(p.PartitionFilter) > 0 {
+		opts.PartitionFilter = make(map[string][]string, len(p.PartitionFilter))
+		for col, v := range p.PartitionFilter {
+			switch v := v.(type) {
+			case []any:
+				for _, item := range v {
+					opts.PartitionFilter[col] = append(opts.PartitionFilter[col], fmt.Sprint(item))
+				}
+			default:
+				opts.PartitionFilter[col] = []string{fmt.Sprint(v)}
+			}
+		}
+	}
+	return opts, nil
+}
+
+// Table is a resolved snapshot of an Iceberg or Delta table.
+type Table struct {
+	// Files are the live data files of the snapshot that match the partition filter.
+	Files []*blob.ListObject
+	// PartitionColumns are columns that are not stored in the data files. Only Delta tables have such columns.
+	PartitionColumns []drivers.TablePartitionColumn
+	// PartitionValues are the values of the partition columns for each file key. A nil value is a null partition value.
+	PartitionValues map[string]map[string]*string
+	// Version is the resolved Iceberg snapshot ID or Delta version.
+	Version int64
+}
+
+// ResolveTable resolves the data files of the table stored at the root path in the bucket.
+// Only Parquet data files are supported. Tables with row-level deletes (Iceberg delete files or Delta deletion vectors) are not supported.
+func ResolveTable(ctx context.Context, bucket *blob.Bucket, root string, opts *TableOptions) (*Table, error) {
+	root = strings.TrimSuffix(root, "/")
+	switch opts.Format {
+	case TableFormatIceberg:
+		return resolveIcebergTable(ctx, bucket, root, opts)
+	case TableFormatDelta:
+		return resolveDeltaTable(ctx, bucket, root, opts)
+	default:
+		return nil, fmt.Errorf("unsupported table format %q", opts.Format)
+	}
+}
+
+// ResolveTableFiles resolves the data files of the table at the object store path in props.
+// It implements drivers.ObjectStore.ResolveTable for object stores backed by a bucket.
+func ResolveTableFiles(ctx context.Context, open BucketOpener, props map[string]any) (*drivers.ObjectStoreTable, error) {
+	opts, err := ParseTableOptions(props)
+	if err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, fmt.Errorf("property 'format' must be %q or %q", TableFormatIceberg, TableFormatDelta)
+	}
+
+	bucket, root, err := open(ctx, props)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+
+	table, err := ResolveTable(ctx, bucket, root, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &drivers.ObjectStoreTable{
+		Keys:             make([]string, len(table.Files)),
+		PartitionColumns: table.PartitionColumns,
+		PartitionValues:  table.PartitionValues,
+		Version:          table.Version,
+	}
+	for i, f := range table.Files {
+		res.Keys[i] = f.Key
+	}
+	return res, nil
+}
+
+// matchPartitionFilter returns true if the partition values match the filter. A nil value is a null partition value.
+// Values for columns that are not in the partition values (for example because of partition evolution) always match.
+func matchPartitionFilter(filter map[string][]string, values map[string]*string) bool {
+	for col, allowed := range filter {
+		v, ok := values[col]
+		if !ok {
+			continue
+		}
+		match := false
+		for _, a := range allowed {
+			if v == nil && a == "<nil>" || v != nil && *v == a {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
+// validatePartitionFilter returns an error if the filter references columns that are not partition columns.
+func validatePartitionFilter(filter map[string][]string, columns []string) error {
+	for col := range filter {
+		found := false
+		for _, c := range columns {
+			if c == col {
+				found = true
+				break
+			}
+		}
+		if !found {
+			sorted := append([]string(nil), columns...)
+			sort.Strings(sorted)
+			return fmt.Errorf("partition filter column %q is not a partition column of the table (partition columns: %v)", col, sorted)
+		}
+	}
+	return nil
+}
+
+// tableKey joins a table's root path and a path relative to it.
+func tableKey(root, p string) string {
+	if root == "" {
+		return p
+	}
+	return root + "/" + p
+}
@@ -0,0 +1,401 @@
+package blob
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet/file"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+	"github.com/rilldata/rill/runtime/drivers"
+	"gocloud.dev/blob"
+)
+
+type deltaAction struct {
+	Add      *deltaAdd      `json:"add"`
+	Remove   *deltaAdd      `json:"remove"`
+	MetaData *deltaMetadata `json:"metaData"`
+}
+
+type deltaMetadata struct {
+	PartitionColumns []string `json:"partitionColumns"`
+	// SchemaString is the table schema as a JSON-encoded struct type.
+	SchemaString string `json:"schemaString"`
+}
+
+type deltaAdd struct {
+	Path            string             `json:"path"`
+	PartitionValues map[string]*string `json:"partitionValues"`
+	Size            int64              `json:"size"`
+	DeletionVector  json.RawMessage    `json:"deletionVector"`
+}
+
+func (a *deltaAdd) hasDeletionVector() bool {
+	return len(a.DeletionVector) > 0 && string(a.DeletionVector) != "null"
+}
+
+// deltaLog is the commits and checkpoints in a Delta table's _delta_log directory.
+type deltaLog struct {
+	commits     map[int64]string
+	checkpoints map[int64][]string
+	// checkpointParts is the number of parts of multi-part checkpoints
+	checkpointParts map[int64]int
+}
+
+// resolveDeltaTable replays the table's transaction log from the latest checkpoint to find the version's live data files.
+func resolveDeltaTable(ctx context.Context, bucket *blob.Bucket, root string, opts *TableOptions) (*Table, error) {
+	log, err := listDeltaLog(ctx, bucket, root)
+	if err != nil {
+		return nil, err
+	}
+	if len(log.commits) == 0 && len(log.checkpoints) == 0 {
+		return nil, fmt.Errorf("no delta log found in %q", tableKey(root, "_delta_log/"))
+	}
+
+	// Find the version
+	var latest int64 = -1
+	for v := range log.commits {
+		latest = max(latest, v)
+	}
+	for v := range log.checkpoints {
+		latest = max(latest, v)
+	}
+	version := latest
+	if opts.Version != nil {
+		version = *opts.Version
+		if version > latest || version < 0 {
+			return nil, fmt.Errorf("version %d not found in delta table %q (latest version is %d)", version, root, latest)
+		}
+	}
+
+	// Find the latest complete checkpoint at or before the version
+	var checkpoint int64 = -1
+	for v, keys := range log.checkpoints {
+		if v <= version && v > checkpoint && len(keys) == log.checkpointParts[v] {
+			checkpoint = v
+		}
+	}
+
+	files := make(map[string]*deltaAdd)
+	var meta *deltaMetadata
+	if checkpoint >= 0 {
+		meta, err = readDeltaCheckpoint(ctx, bucket, log.checkpoints[checkpoint], files)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read delta checkpoint %d: %w", checkpoint, err)
+		}
+	}
+
+	for v := checkpoint + 1; v <= version; v++ {
+		key, ok := log.commits[v]
+		if !ok {
+			return nil, fmt.Errorf("commit %d is missing from the delta log of %q, it may have been removed by log cleanup", v, root)
+		}
+		data, err := bucket.ReadAll(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		s := bufio.NewScanner(bytes.NewReader(data))
+		s.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+		for s.Scan() {
+			line := bytes.TrimSpace(s.Bytes())
+			if len(line) == 0 {
+				continue
+			}
+			action := &deltaAction{}
+			if err := json.Unmarshal(line, action); err != nil {
+				return nil, fmt.Errorf("failed to parse delta commit %d: %w", v, err)
+			}
+			switch {
+			case action.Add != nil:
+				files[action.Add.Path] = action.Add
+			case action.Remove != nil:
+				delete(files, action.Remove.Path)
+			case action.MetaData != nil:
+				meta = action.MetaData
+			}
+		}
+		if err := s.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	partitionColumns, err := deltaPartitionColumns(meta)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metadata in delta table %q: %w", root, err)
+	}
+	names := make([]string, len(partitionColumns))
+	for i, c := range partitionColumns {
+		names[i] = c.Name
+	}
+	if err := validatePartitionFilter(opts.PartitionFilter, names); err != nil {
+		return nil, err
+	}
+
+	table := &Table{PartitionColumns: partitionColumns, PartitionValues: make(map[string]map[string]*string), Version: version}
+	for _, add := range files {
+		if add.hasDeletionVector() {
+			return nil, fmt.Errorf("delta table %q has deletion vectors, which are not supported", root)
+		}
+		if !matchPartitionFilter(opts.PartitionFilter, add.PartitionValues) {
+			continue
+		}
+		key, err := deltaFileKey(root, add.Path)
+		if err != nil {
+			return nil, err
+		}
+		table.Files = append(table.Files, &blob.ListObject{Key: key, Size: add.Size})
+		if len(partitionColumns) > 0 {
+			table.PartitionValues[key] = add.PartitionValues
+		}
+	}
+	// Map iteration order is random, so sort for a deterministic order
+	sort.Slice(table.Files, func(i, j int) bool { return table.Files[i].Key < table.Files[j].Key })
+	return table, nil
+}
+
+// listDeltaLog lists the commit and checkpoint files in a table's log.
+func listDeltaLog(ctx context.Context, bucket *blob.Bucket, root string) (*deltaLog, error) {
+	log := &deltaLog{
+		commits:         make(map[int64]string),
+		checkpoints:     make(map[int64][]string),
+		checkpointParts: make(map[int64]int),
+	}
+	it := bucket.List(&blob.ListOptions{Prefix: tableKey(root, "_delta_log/")})
+	for {
+		obj, err := it.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		// Commits are named "<version>.json" and checkpoints "<version>.checkpoint.parquet" or "<version>.checkpoint.<part>.<parts>.parquet"
+		name := path.Base(obj.Key)
+		prefix, rest, _ := strings.Cut(name, ".")
+		v, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			continue
+		}
+		switch {
+		case rest == "json":
+			log.commits[v] = obj.Key
+		case rest == "checkpoint.parquet":
+			log.checkpoints[v] = []string{obj.Key}
+			log.checkpointParts[v] = 1
+		case strings.HasPrefix(rest, "checkpoint.") && strings.HasSuffix(rest, ".parquet"):
+			parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(rest, "checkpoint."), ".parquet"), ".")
+			if len(parts) != 2 {
+				continue // V2 checkpoints are not supported, so the log is replayed from an earlier checkpoint
+			}
+			n, err := strconv.Atoi(parts[1])
+			if err != nil {
+				continue
+			}
+			log.checkpoints[v] = append(log.checkpoints[v], obj.Key)
+			log.checkpointParts[v] = n
+		}
+	}
+	return log, nil
+}
+
+// deltaPartitionColumns returns the partition columns and their types from the table's metadata.
+func deltaPartitionColumns(meta *deltaMetadata) ([]drivers.TablePartitionColumn, error) {
+	if meta == nil || len(meta.PartitionColumns) == 0 {
+		return nil, nil
+	}
+
+	// Partition columns always have primitive types, which are encoded as strings
+	types := make(map[string]string)
+	if meta.SchemaString != "" {
+		schema := &struct {
+			Fields []struct {
+				Name string          `json:"name"`
+				Type json.RawMessage `json:"type"`
+			} `json:"fields"`
+		}{}
+		if err := json.Unmarshal([]byte(meta.SchemaString), schema); err != nil {
+			return nil, fmt.Errorf("failed to parse schema: %w", err)
+		}
+		for _, f := range schema.Fields {
+			var typ string
+			if json.Unmarshal(f.Type, &typ) == nil {
+				types[f.Name] = typ
+			}
+		}
+	}
+
+	res := make([]drivers.TablePartitionColumn, len(meta.PartitionColumns))
+	for i, c := range meta.PartitionColumns {
+		typ, ok := types[c]
+		if !ok {
+			typ = "string"
+		}
+		res[i] = drivers.TablePartitionColumn{Name: c, Type: typ}
+	}
+	return res, nil
+}
+
+// readDeltaCheckpoint adds the files in a checkpoint to files and returns the table's metadata.
+func readDeltaCheckpoint(ctx context.Context, bucket *blob.Bucket, keys []string, files map[string]*deltaAdd) (*deltaMetadata, error) {
+	var meta *deltaMetadata
+	for _, key := range keys {
+		data, err := bucket.ReadAll(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		pf, err := file.NewParquetReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+		if err != nil {
+			pf.Close()
+			return nil, err
+		}
+		tbl, err := fr.ReadTable(ctx)
+		pf.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for i, field := range tbl.Schema().Fields() {
+			for _, chunk := range tbl.Column(i).Data().Chunks() {
+				s, ok := chunk.(*array.Struct)
+				if !ok {
+					continue
+				}
+				switch field.Name {
+				case "add":
+					err = readDeltaCheckpointAdds(s, files)
+				case "metaData":
+					if m, ok := readDeltaCheckpointMetadata(s); ok {
+						meta = m
+					}
+				}
+				if err != nil {
+					tbl.Release()
+					return nil, err
+				}
+			}
+		}
+		tbl.Release()
+	}
+	return meta, nil
+}
+
+func readDeltaCheckpointAdds(s *array.Struct, files map[string]*deltaAdd) error {
+	typ := s.DataType().(*arrow.StructType)
+	pathIdx, ok := typ.FieldIdx("path")
+	if !ok {
+		return errors.New("checkpoint has no add.path column")
+	}
+	paths, ok := s.Field(pathIdx).(*array.String)
+	if !ok {
+		return errors.New("checkpoint has an invalid add.path column")
+	}
+	var sizes *array.Int64
+	if idx, ok := typ.FieldIdx("size"); ok {
+		sizes, _ = s.Field(idx).(*array.Int64)
+	}
+	var partitionValues *array.Map
+	if idx, ok := typ.FieldIdx("partitionValues"); ok {
+		partitionValues, _ = s.Field(idx).(*array.Map)
+	}
+	var deletionVectors arrow.Array
+	if idx, ok := typ.FieldIdx("deletionVector"); ok {
+		deletionVectors = s.Field(idx)
+	}
+
+	for i := 0; i < s.Len(); i++ {
+		if s.IsNull(i) || paths.IsNull(i) {
+			continue
+		}
+		add := &deltaAdd{Path: paths.Value(i)}
+		if sizes != nil {
+			add.Size = sizes.Value(i)
+		}
+		if deletionVectors != nil && !deletionVectors.IsNull(i) {
+			add.DeletionVector = json.RawMessage("{}")
+		}
+		if partitionValues != nil && !partitionValues.IsNull(i) {
+			keys, _ := partitionValues.Keys().(*array.String)
+			items, _ := partitionValues.Items().(*array.String)
+			if keys == nil || items == nil {
+				return errors.New("checkpoint has an invalid add.partitionValues column")
+			}
+			start, end := partitionValues.ValueOffsets(i)
+			add.PartitionValues = make(map[string]*string, end-start)
+			for j := int(start); j < int(end); j++ {
+				if items.IsNull(j) {
+					add.PartitionValues[keys.Value(j)] = nil
+					continue
+				}
+				v := items.Value(j)
+				add.PartitionValues[keys.Value(j)] = &v
+			}
+		}
+		files[add.Path] = add
+	}
+	return nil
+}
+
+func readDeltaCheckpointMetadata(s *array.Struct) (*deltaMetadata, bool) {
+	typ := s.DataType().(*arrow.StructType)
+	idx, ok := typ.FieldIdx("partitionColumns")
+	if !ok {
+		return nil, false
+	}
+	list, ok := s.Field(idx).(*array.List)
+	if !ok {
+		return nil, false
+	}
+	values, ok := list.ListValues().(*array.String)
+	if !ok {
+		return nil, false
+	}
+	var schemas *array.String
+	if idx, ok := typ.FieldIdx("schemaString"); ok {
+		schemas, _ = s.Field(idx).(*array.String)
+	}
+	for i := 0; i < s.Len(); i++ {
+		if s.IsNull(i) || list.IsNull(i) {
+			continue
+		}
+		start, end := list.ValueOffsets(i)
+		meta := &deltaMetadata{PartitionColumns: make([]string, 0, end-start)}
+		for j := int(start); j < int(end); j++ {
+			meta.PartitionColumns = append(meta.PartitionColumns, values.Value(j))
+		}
+		if schemas != nil && !schemas.IsNull(i) {
+			meta.SchemaString = schemas.Value(i)
+		}
+		return meta, true
+	}
+	return nil, false
+}
+
+// deltaFileKey returns the bucket key of a file referenced in the Delta log.
+// Paths are URL-encoded and either relative to the table root or absolute URIs.
+func deltaFileKey(root, p string) (string, error) {
+	u, err := url.Parse(p)
+	if err != nil {
+		return "", fmt.Errorf("invalid delta file path %q: %w", p, err)
+	}
+	if u.Scheme != "" {
+		return strings.TrimPrefix(u.Path, "/"), nil
+	}
+	return tableKey(root, u.Path), nil
+}
@@ -0,0 +1,378 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+type icebergMetadata struct {
+	FormatVersion     int                `json:"format-version"`
+	CurrentSnapshotID *int64             `json:"current-snapshot-id"`
+	Snapshots         []icebergSnapshot  `json:"snapshots"`
+	PartitionSpecs    []icebergPartition `json:"partition-specs"`
+	// PartitionSpec is the only partition spec in old v1 tables.
+	PartitionSpec []icebergPartitionField `json:"partition-spec"`
+	Schemas       []icebergSchema         `json:"schemas"`
+	// Schema is the only schema in old v1 tables.
+	Schema *icebergSchema `json:"schema"`
+}
+
+type icebergSnapshot struct {
+	SnapshotID   int64  `json:"snapshot-id"`
+	ManifestList string `json:"manifest-list"`
+	// Manifests is used instead of ManifestList in old v1 tables.
+	Manifests []string `json:"manifests"`
+}
+
+type icebergPartition struct {
+	Fields []icebergPartitionField `json:"fields"`
+}
+
+type icebergPartitionField struct {
+	Name      string `json:"name"`
+	SourceID  int    `json:"source-id"`
+	Transform string `json:"transform"`
+}
+
+type icebergSchema struct {
+	Fields []struct {
+		ID   int             `json:"id"`
+		Name string          `json:"name"`
+		Type json.RawMessage `json:"type"`
+	} `json:"fields"`
+}
+
+// Iceberg manifest entry statuses and content types
+const (
+	icebergStatusDeleted = 2
+	icebergContentData   = 0
+)
+
+// resolveIcebergTable reads the table's metadata file, snapshot manifest list and manifests to find the snapshot's live data files.
+// The root may be the table's directory or the path of a metadata file.
+func resolveIcebergTable(ctx context.Context, bucket *blob.Bucket, root string, opts *TableOptions) (*Table, error) {
+	metadataKey, err := icebergMetadataKey(ctx, bucket, root)
+	if err != nil {
+		return nil, err
+	}
+	data, err := bucket.ReadAll(ctx, metadataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read iceberg metadata %q: %w", metadataKey, err)
+	}
+	meta := &icebergMetadata{}
+	if err := json.Unmarshal(data, meta); err != nil {
+		return nil, fmt.Errorf("failed to parse iceberg metadata %q: %w", metadataKey, err)
+	}
+
+	partitionTypes := icebergPartitionTypes(meta)
+	partitionColumns := make([]string, 0, len(partitionTypes))
+	for name := range partitionTypes {
+		partitionColumns = append(partitionColumns, name)
+	}
+	if err := validatePartitionFilter(opts.PartitionFilter, partitionColumns); err != nil {
+		return nil, err
+	}
+	filter, err := icebergPartitionFilter(opts.PartitionFilter, partitionTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotID := meta.CurrentSnapshotID
+	if opts.SnapshotID != nil {
+		snapshotID = opts.SnapshotID
+	}
+	if snapshotID == nil || *snapshotID == -1 {
+		return nil, fmt.Errorf("iceberg table %q has no snapshots", root)
+	}
+	var snapshot *icebergSnapshot
+	for i := range meta.Snapshots {
+		if meta.Snapshots[i].SnapshotID == *snapshotID {
+			snapshot = &meta.Snapshots[i]
+			break
+		}
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot %d not found in iceberg table %q", *snapshotID, root)
+	}
+
+	manifests := snapshot.Manifests
+	if snapshot.ManifestList != "" {
+		entries, err := readAvroRecords(ctx, bucket, icebergFileKey(snapshot.ManifestList))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest list: %w", err)
+		}
+		manifests = nil
+		for _, e := range entries {
+			p, _ := e["manifest_path"].(string)
+			manifests = append(manifests, p)
+		}
+	}
+
+	table := &Table{Version: *snapshotID}
+	for _, m := range manifests {
+		entries, err := readAvroRecords(ctx, bucket, icebergFileKey(m))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest %q: %w", m, err)
+		}
+		for _, e := range entries {
+			if status, _ := e["status"].(int32); status == icebergStatusDeleted {
+				continue
+			}
+			df, _ := e["data_file"].(map[string]any)
+			if df == nil {
+				return nil, fmt.Errorf("invalid manifest %q: missing data_file", m)
+			}
+			if content, ok := df["content"].(int32); ok && content != icebergContentData {
+				return nil, fmt.Errorf("iceberg table %q has delete files, which are not supported", root)
+			}
+			filePath, _ := df["file_path"].(string)
+			if format, _ := df["file_format"].(string); !strings.EqualFold(format, "parquet") {
+				return nil, fmt.Errorf("iceberg data file %q has unsupported format %q, only parquet is supported", filePath, format)
+			}
+
+			if len(filter) > 0 {
+				partition, _ := df["partition"].(map[string]any)
+				if !matchPartitionFilter(filter, icebergPartitionValues(partition, partitionTypes)) {
+					continue
+				}
+			}
+
+			size, _ := df["file_size_in_bytes"].(int64)
+			table.Files = append(table.Files, &blob.ListObject{Key: icebergFileKey(filePath), Size: size})
+		}
+	}
+	return table, nil
+}
+
+// icebergMetadataKey finds the current metadata file of a table.
+// It uses the version hint written by Hadoop catalogs if present, and otherwise the metadata file with the highest version.
+func icebergMetadataKey(ctx context.Context, bucket *blob.Bucket, root string) (string, error) {
+	if strings.HasSuffix(root, ".metadata.json") {
+		return root, nil
+	}
+
+	hint, err := bucket.ReadAll(ctx, tableKey(root, "metadata/version-hint.text"))
+	if err == nil {
+		v := strings.TrimSpace(string(hint))
+		if _, err := strconv.Atoi(v); err == nil {
+			return tableKey(root, fmt.Sprintf("metadata/v%s.metadata.json", v)), nil
+		}
+		return tableKey(root, "metadata/"+v), nil
+	}
+	if gcerrors.Code(err) != gcerrors.NotFound {
+		return "", err
+	}
+
+	var latestKey string
+	latestVersion := -1
+	it := bucket.List(&blob.ListOptions{Prefix: tableKey(root, "metadata/")})
+	for {
+		obj, err := it.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return "", err
+		}
+		name := path.Base(obj.Key)
+		if !strings.HasSuffix(name, ".metadata.json") {
+			continue
+		}
+		// Metadata files are named "v<version>.metadata.json" or "<version>-<uuid>.metadata.json"
+		v := strings.TrimSuffix(name, ".metadata.json")
+		v = strings.TrimPrefix(v, "v")
+		v, _, _ = strings.Cut(v, "-")
+		version, err := strconv.Atoi(v)
+		if err != nil {
+			continue
+		}
+		if version > latestVersion {
+			latestVersion = version
+			latestKey = obj.Key
+		}
+	}
+	if latestKey == "" {
+		return "", fmt.Errorf("no iceberg metadata found in %q", tableKey(root, "metadata/"))
+	}
+	return latestKey, nil
+}
+
+// icebergFileKey returns the bucket key of a file referenced in Iceberg metadata.
+// Iceberg references files by absolute location, such as "s3://bucket/path/to/file".
+func icebergFileKey(location string) string {
+	_, rest, ok := strings.Cut(location, "://")
+	if !ok {
+		return strings.TrimPrefix(location, "/")
+	}
+	_, key, _ := strings.Cut(rest, "/")
+	return key
+}
+
+// icebergPartitionTypes returns the Iceberg type of each partition field's values.
+// The type is empty for fields that have integer values that aren't dates, such as the results of the hour, month, year and bucket transforms.
+func icebergPartitionTypes(meta *icebergMetadata) map[string]string {
+	// Field IDs are never reused, so the source fields of all partition specs can be found in the union of the schemas
+	sourceTypes := make(map[int]string)
+	schemas := meta.Schemas
+	if meta.Schema != nil {
+		schemas = append(schemas, *meta.Schema)
+	}
+	for _, schema := range schemas {
+		for _, f := range schema.Fields {
+			var typ string
+			if json.Unmarshal(f.Type, &typ) == nil {
+				sourceTypes[f.ID] = typ
+			}
+		}
+	}
+
+	fields := meta.PartitionSpec
+	for _, spec := range meta.PartitionSpecs {
+		fields = append(fields, spec.Fields...)
+	}
+	res := make(map[string]string, len(fields))
+	for _, f := range fields {
+		switch {
+		case f.Transform == "identity" || strings.HasPrefix(f.Transform, "truncate"):
+			res[f.Name] = sourceTypes[f.SourceID]
+		case f.Transform == "day":
+			res[f.Name] = "date"
+		default:
+			res[f.Name] = ""
+		}
+	}
+	return res
+}
+
+// icebergTimestampLayout is the layout that timestamp partition values and filter values are normalized to.
+const icebergTimestampLayout = "2006-01-02 15:04:05.999999999"
+
+// icebergPartitionFilter normalizes the values of date and timestamp partition fields in the filter, so they can be compared to the values from icebergPartitionValues.
+func icebergPartitionFilter(filter map[string][]string, types map[string]string) (map[string][]string, error) {
+	res := make(map[string][]string, len(filter))
+	for col, values := range filter {
+		typ := types[col]
+		res[col] = make([]string, len(values))
+		for i, v := range values {
+			res[col][i] = v
+			if v == "<nil>" {
+				continue
+			}
+			switch typ {
+			case "date":
+				t, err := time.Parse(time.DateOnly, v)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value %q for date partition column %q, expected YYYY-MM-DD", v, col)
+				}
+				res[col][i] = t.Format(time.DateOnly)
+			case "timestamp", "timestamptz", "timestamp_ns", "timestamptz_ns":
+				t, err := parseIcebergTimestamp(v)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value %q for timestamp partition column %q", v, col)
+				}
+				res[col][i] = t.Format(icebergTimestampLayout)
+			}
+		}
+	}
+	return res, nil
+}
+
+// parseIcebergTimestamp parses a timestamp filter value. Values without a time zone are in UTC.
+func parseIcebergTimestamp(v string) (time.Time, error) {
+	var err error
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05Z07:00", "2006-01-02 15:04:05", time.DateOnly} {
+		var t time.Time
+		t, err = time.Parse(layout, v)
+		if err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// icebergPartitionValues converts a manifest entry's partition record to strings.
+// Date and timestamp values are formatted like the normalized values of icebergPartitionFilter.
+func icebergPartitionValues(partition map[string]any, types map[string]string) map[string]*string {
+	res := make(map[string]*string, len(partition))
+	for k, v := range partition {
+		// Optional values are unions, which are decoded as a map with a single entry
+		if m, ok := v.(map[string]any); ok {
+			for _, inner := range m {
+				v = inner
+			}
+		}
+		if v == nil {
+			res[k] = nil
+			continue
+		}
+		s := icebergPartitionValue(v, types[k])
+		res[k] = &s
+	}
+	return res
+}
+
+// icebergPartitionValue formats a partition value of the given Iceberg type.
+// Values with a date or timestamp logical type are decoded as a time.Time, and otherwise as days or micro/nanoseconds since the epoch.
+func icebergPartitionValue(v any, typ string) string {
+	switch typ {
+	case "date":
+		switch v := v.(type) {
+		case time.Time:
+			return v.UTC().Format(time.DateOnly)
+		case int32:
+			return time.Unix(0, 0).UTC().AddDate(0, 0, int(v)).Format(time.DateOnly)
+		}
+	case "timestamp", "timestamptz":
+		switch v := v.(type) {
+		case time.Time:
+			return v.UTC().Format(icebergTimestampLayout)
+		case int64:
+			return time.UnixMicro(v).UTC().Format(icebergTimestampLayout)
+		}
+	case "timestamp_ns", "timestamptz_ns":
+		switch v := v.(type) {
+		case time.Time:
+			return v.UTC().Format(icebergTimestampLayout)
+		case int64:
+			return time.Unix(0, v).UTC().Format(icebergTimestampLayout)
+		}
+	}
+	return fmt.Sprint(v)
+}
+
+// readAvroRecords reads all records in an Avro object container file.
+func readAvroRecords(ctx context.Context, bucket *blob.Bucket, key string) ([]map[string]any, error) {
+	data, err := bucket.ReadAll(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	r, err := goavro.NewOCFReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	var res []map[string]any
+	for r.Scan() {
+		v, err := r.Read()
+		if err != nil {
+			return nil, err
+		}
+		rec, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected avro record type %T", v)
+		}
+		res = append(res, rec)
+	}
+	return res, r.Err()
+}
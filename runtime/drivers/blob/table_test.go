@@ -0,0 +1,354 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+	"github.com/linkedin/goavro/v2"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/memblob"
+)
+
+func TestParseTableOptions(t *testing.T) {
+	opts, err := ParseTableOptions(map[string]any{"path": "s3://bucket/data.csv", "format": "csv"})
+	require.NoError(t, err)
+	require.Nil(t, opts)
+
+	opts, err = ParseTableOptions(map[string]any{"format": "delta", "version": "3", "partition_filter": map[string]any{"day": []any{"2024-01-01", "2024-01-02"}, "hour": 1}})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), *opts.Version)
+	require.Equal(t, map[string][]string{"day": {"2024-01-01", "2024-01-02"}, "hour": {"1"}}, opts.PartitionFilter)
+
+	_, err = ParseTableOptions(map[string]any{"format": "delta", "snapshot_id": 1})
+	require.ErrorContains(t, err, "use 'version'")
+	_, err = ParseTableOptions(map[string]any{"format": "iceberg", "version": 1})
+	require.ErrorContains(t, err, "use 'snapshot_id'")
+	_, err = ParseTableOptions(map[string]any{"format": "parquet", "version": 1})
+	require.ErrorContains(t, err, "require format")
+}
+
+func TestResolveDeltaTable(t *testing.T) {
+	ctx := context.Background()
+	bucket := prepareDeltaTable(t)
+
+	tests := []struct {
+		name    string
+		opts    *TableOptions
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "latest version",
+			opts: &TableOptions{Format: TableFormatDelta},
+			want: []string{"tbl/day=2024-01-01/b.parquet", "tbl/day=2024-01-02/c.parquet", "tbl/day=2024-01-03/d.parquet"},
+		},
+		{
+			name: "pinned version before checkpoint",
+			opts: &TableOptions{Format: TableFormatDelta, Version: ptr(int64(0))},
+			want: []string{"tbl/day=2024-01-01/a.parquet"},
+		},
+		{
+			name: "pinned version at checkpoint",
+			opts: &TableOptions{Format: TableFormatDelta, Version: ptr(int64(2))},
+			want: []string{"tbl/day=2024-01-01/b.parquet", "tbl/day=2024-01-02/c.parquet"},
+		},
+		{
+			name: "partition filter",
+			opts: &TableOptions{Format: TableFormatDelta, PartitionFilter: map[string][]string{"day": {"2024-01-01", "2024-01-03"}}},
+			want: []string{"tbl/day=2024-01-01/b.parquet", "tbl/day=2024-01-03/d.parquet"},
+		},
+		{
+			name:    "unknown partition column",
+			opts:    &TableOptions{Format: TableFormatDelta, PartitionFilter: map[string][]string{"month": {"1"}}},
+			wantErr: "not a partition column",
+		},
+		{
+			name:    "version not found",
+			opts:    &TableOptions{Format: TableFormatDelta, Version: ptr(int64(4))},
+			wantErr: "version 4 not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := ResolveTable(ctx, bucket, "tbl/", tt.opts)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []drivers.TablePartitionColumn{{Name: "day", Type: "date"}}, table.PartitionColumns)
+			require.Equal(t, tt.want, tableKeys(table))
+			for _, k := range tt.want {
+				// The files' partition values are read from the log
+				day := strings.Split(strings.Split(k, "=")[1], "/")[0]
+				require.Equal(t, day, *table.PartitionValues[k]["day"])
+			}
+		})
+	}
+}
+
+func TestResolveIcebergTable(t *testing.T) {
+	ctx := context.Background()
+	bucket := prepareIcebergTable(t)
+
+	tests := []struct {
+		name    string
+		root    string
+		opts    *TableOptions
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "current snapshot",
+			root: "tbl",
+			opts: &TableOptions{Format: TableFormatIceberg},
+			want: []string{"tbl/data/day=2024-01-02/b.parquet", "tbl/data/day=2024-01-03/c.parquet"},
+		},
+		{
+			name: "pinned snapshot",
+			root: "tbl",
+			opts: &TableOptions{Format: TableFormatIceberg, SnapshotID: ptr(int64(100))},
+			want: []string{"tbl/data/day=2024-01-01/a.parquet", "tbl/data/day=2024-01-02/b.parquet"},
+		},
+		{
+			name: "metadata file path",
+			root: "tbl/metadata/00001-abc.metadata.json",
+			opts: &TableOptions{Format: TableFormatIceberg},
+			want: []string{"tbl/data/day=2024-01-02/b.parquet", "tbl/data/day=2024-01-03/c.parquet"},
+		},
+		{
+			name: "partition filter",
+			root: "tbl",
+			opts: &TableOptions{Format: TableFormatIceberg, PartitionFilter: map[string][]string{"day": {"2024-01-03"}}},
+			want: []string{"tbl/data/day=2024-01-03/c.parquet"},
+		},
+		{
+			name:    "invalid date in partition filter",
+			root:    "tbl",
+			opts:    &TableOptions{Format: TableFormatIceberg, PartitionFilter: map[string][]string{"day": {"2024-01"}}},
+			wantErr: "invalid value",
+		},
+		{
+			name:    "snapshot not found",
+			root:    "tbl",
+			opts:    &TableOptions{Format: TableFormatIceberg, SnapshotID: ptr(int64(300))},
+			wantErr: "snapshot 300 not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := ResolveTable(ctx, bucket, tt.root, tt.opts)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, tableKeys(table))
+		})
+	}
+}
+
+func TestIcebergPartitionTimestamp(t *testing.T) {
+	types := map[string]string{"ts": "timestamptz", "day": "date", "bucket": ""}
+	filter, err := icebergPartitionFilter(map[string][]string{"ts": {"2024-01-01T10:00:00+02:00", "2024-01-02 00:00:00.5"}, "bucket": {"3"}}, types)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{"ts": {"2024-01-01 08:00:00", "2024-01-02 00:00:00.5"}, "bucket": {"3"}}, filter)
+
+	ts := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	require.Equal(t, "2024-01-01 08:00:00", icebergPartitionValue(ts, "timestamptz"))
+	require.Equal(t, "2024-01-01 08:00:00", icebergPartitionValue(ts.UnixMicro(), "timestamptz"))
+	require.Equal(t, "2024-01-01", icebergPartitionValue(int32(19723), "date"))
+	require.Equal(t, "3", icebergPartitionValue(int32(3), ""))
+
+	_, err = icebergPartitionFilter(map[string][]string{"ts": {"yesterday"}}, types)
+	require.ErrorContains(t, err, "invalid value")
+}
+
+func TestIteratorTable(t *testing.T) {
+	ctx := context.Background()
+	bucket := prepareDeltaTable(t)
+
+	it, err := NewIterator(ctx, bucket, Options{GlobPattern: "tbl", Table: &TableOptions{Format: TableFormatDelta}, KeepFilesUntilClose: true}, zap.NewNop())
+	require.NoError(t, err)
+	defer it.Close()
+
+	files, err := it.Next()
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	tableIt, ok := it.(drivers.TableFileIterator)
+	require.True(t, ok)
+	require.Equal(t, []drivers.TablePartitionColumn{{Name: "day", Type: "date"}}, tableIt.PartitionColumns())
+	for _, f := range files {
+		require.Contains(t, f, "/tbl/day=")
+		day := strings.Split(strings.Split(f, "/tbl/day=")[1], "/")[0]
+		require.Equal(t, day, *tableIt.PartitionValues(f)["day"])
+	}
+
+	_, err = NewIterator(ctx, bucket, Options{GlobPattern: "tbl/*", Table: &TableOptions{Format: TableFormatDelta}}, zap.NewNop())
+	require.ErrorContains(t, err, "can't be a glob pattern")
+}
+
+// prepareDeltaTable creates a Delta table with a checkpoint at version 2 and a later commit.
+func prepareDeltaTable(t *testing.T) *blob.Bucket {
+	ctx := context.Background()
+	bucket, err := blob.OpenBucket(ctx, "mem://")
+	require.NoError(t, err)
+	t.Cleanup(func() { bucket.Close() })
+
+	files := map[string]string{
+		"tbl/day=2024-01-01/a.parquet": "a",
+		"tbl/day=2024-01-01/b.parquet": "bb",
+		"tbl/day=2024-01-02/c.parquet": "ccc",
+		"tbl/day=2024-01-03/d.parquet": "dddd",
+		"tbl/_delta_log/00000000000000000000.json": `{"protocol":{"minReaderVersion":1,"minWriterVersion":2}}
+{"metaData":{"id":"1","partitionColumns":["day"],"schemaString":"{\"type\":\"struct\",\"fields\":[{\"name\":\"id\",\"type\":\"long\"},{\"name\":\"day\",\"type\":\"date\"}]}"}}
+{"add":{"path":"day=2024-01-01/a.parquet","partitionValues":{"day":"2024-01-01"},"size":1}}`,
+		"tbl/_delta_log/00000000000000000001.json": `{"remove":{"path":"day=2024-01-01/a.parquet"}}
+{"add":{"path":"day=2024-01-01/b.parquet","partitionValues":{"day":"2024-01-01"},"size":2}}`,
+		"tbl/_delta_log/00000000000000000002.json": `{"add":{"path":"day%3D2024-01-02/c.parquet","partitionValues":{"day":"2024-01-02"},"size":3}}`,
+		"tbl/_delta_log/00000000000000000003.json": `{"add":{"path":"day=2024-01-03/d.parquet","partitionValues":{"day":"2024-01-03"},"size":4}}`,
+	}
+	for k, v := range files {
+		require.NoError(t, bucket.WriteAll(ctx, k, []byte(v), nil))
+	}
+	require.NoError(t, bucket.WriteAll(ctx, "tbl/_delta_log/00000000000000000002.checkpoint.parquet", deltaCheckpoint(t, map[string]string{
+		"day=2024-01-01/b.parquet":   "2024-01-01",
+		"day%3D2024-01-02/c.parquet": "2024-01-02",
+	}), nil))
+	// Commits before the checkpoint may be removed by log cleanup
+	require.NoError(t, bucket.Delete(ctx, "tbl/_delta_log/00000000000000000001.json"))
+	return bucket
+}
+
+// deltaCheckpoint writes a checkpoint parquet file with metadata and add actions for the files.
+func deltaCheckpoint(t *testing.T, files map[string]string) []byte {
+	addType := arrow.StructOf(
+		arrow.Field{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true},
+		arrow.Field{Name: "partitionValues", Type: arrow.MapOf(arrow.BinaryTypes.String, arrow.BinaryTypes.String), Nullable: true},
+		arrow.Field{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+	)
+	metaDataType := arrow.StructOf(
+		arrow.Field{Name: "partitionColumns", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+		arrow.Field{Name: "schemaString", Type: arrow.BinaryTypes.String, Nullable: true},
+	)
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "add", Type: addType, Nullable: true},
+		{Name: "metaData", Type: metaDataType, Nullable: true},
+	}, nil)
+
+	b := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer b.Release()
+	add := b.Field(0).(*array.StructBuilder)
+	metaData := b.Field(1).(*array.StructBuilder)
+
+	// The first row has the metadata action
+	add.AppendNull()
+	metaData.Append(true)
+	cols := metaData.FieldBuilder(0).(*array.ListBuilder)
+	cols.Append(true)
+	cols.ValueBuilder().(*array.StringBuilder).Append("day")
+	metaData.FieldBuilder(1).(*array.StringBuilder).Append(`{"type":"struct","fields":[{"name":"id","type":"long"},{"name":"day","type":"date"}]}`)
+
+	for p, day := range files {
+		add.Append(true)
+		add.FieldBuilder(0).(*array.StringBuilder).Append(p)
+		pv := add.FieldBuilder(1).(*array.MapBuilder)
+		pv.Append(true)
+		pv.KeyBuilder().(*array.StringBuilder).Append("day")
+		pv.ItemBuilder().(*array.StringBuilder).Append(day)
+		add.FieldBuilder(2).(*array.Int64Builder).Append(int64(len(p)))
+		metaData.AppendNull()
+	}
+
+	rec := b.NewRecord()
+	defer rec.Release()
+	tbl := array.NewTableFromRecords(schema, []arrow.Record{rec})
+	defer tbl.Release()
+
+	var buf bytes.Buffer
+	require.NoError(t, pqarrow.WriteTable(tbl, &buf, tbl.NumRows(), nil, pqarrow.DefaultWriterProps()))
+	return buf.Bytes()
+}
+
+const icebergManifestListSchema = `{"type":"record","name":"manifest_file","fields":[{"name":"manifest_path","type":"string"}]}`
+
+const icebergManifestSchema = `{"type":"record","name":"manifest_entry","fields":[
+	{"name":"status","type":"int"},
+	{"name":"data_file","type":{"type":"record","name":"r2","fields":[
+		{"name":"content","type":"int"},
+		{"name":"file_path","type":"string"},
+		{"name":"file_format","type":"string"},
+		{"name":"partition","type":{"type":"record","name":"r102","fields":[{"name":"day","type":["null",{"type":"int","logicalType":"date"}]}]}},
+		{"name":"file_size_in_bytes","type":"long"}
+	]}}
+]}`
+
+// prepareIcebergTable creates an Iceberg table with two snapshots that is partitioned by the day of a timestamp.
+// Snapshot 100 has files a and b, and snapshot 200 deletes a and adds c.
+func prepareIcebergTable(t *testing.T) *blob.Bucket {
+	ctx := context.Background()
+	bucket, err := blob.OpenBucket(ctx, "mem://")
+	require.NoError(t, err)
+	t.Cleanup(func() { bucket.Close() })
+
+	entry := func(status int32, day, name string) map[string]any {
+		date, err := time.Parse(time.DateOnly, day)
+		require.NoError(t, err)
+		return map[string]any{
+			"status": status,
+			"data_file": map[string]any{
+				"content":            int32(0),
+				"file_path":          "s3://bucket/tbl/data/day=" + day + "/" + name,
+				"file_format":        "PARQUET",
+				"partition":          map[string]any{"day": goavro.Union("int.date", date)},
+				"file_size_in_bytes": int64(10),
+			},
+		}
+	}
+	writeAvro(t, bucket, "tbl/metadata/m1.avro", icebergManifestSchema, entry(1, "2024-01-01", "a.parquet"), entry(1, "2024-01-02", "b.parquet"))
+	writeAvro(t, bucket, "tbl/metadata/m2.avro", icebergManifestSchema, entry(2, "2024-01-01", "a.parquet"), entry(0, "2024-01-02", "b.parquet"), entry(1, "2024-01-03", "c.parquet"))
+	writeAvro(t, bucket, "tbl/metadata/snap-100.avro", icebergManifestListSchema, map[string]any{"manifest_path": "s3://bucket/tbl/metadata/m1.avro"})
+	writeAvro(t, bucket, "tbl/metadata/snap-200.avro", icebergManifestListSchema, map[string]any{"manifest_path": "s3://bucket/tbl/metadata/m2.avro"})
+
+	metadata := func(current int64, snapshots ...int64) string {
+		var snaps []string
+		for _, id := range snapshots {
+			snaps = append(snaps, strings.ReplaceAll(`{"snapshot-id":ID,"manifest-list":"s3://bucket/tbl/metadata/snap-ID.avro"}`, "ID", strconv.FormatInt(id, 10)))
+		}
+		return `{"format-version":2,"current-snapshot-id":` + strconv.FormatInt(current, 10) + `,"snapshots":[` + strings.Join(snaps, ",") + `],"schemas":[{"schema-id":0,"fields":[{"id":1,"name":"ts","type":"timestamptz"}]}],"partition-specs":[{"fields":[{"name":"day","source-id":1,"transform":"day"}]}]}`
+	}
+	require.NoError(t, bucket.WriteAll(ctx, "tbl/metadata/00000-xyz.metadata.json", []byte(metadata(100, 100)), nil))
+	require.NoError(t, bucket.WriteAll(ctx, "tbl/metadata/00001-abc.metadata.json", []byte(metadata(200, 100, 200)), nil))
+	return bucket
+}
+
+func writeAvro(t *testing.T, bucket *blob.Bucket, key, schema string, records ...any) {
+	var buf bytes.Buffer
+	w, err := goavro.NewOCFWriter(goavro.OCFConfig{W: &buf, Schema: schema})
+	require.NoError(t, err)
+	require.NoError(t, w.Append(records))
+	require.NoError(t, bucket.WriteAll(context.Background(), key, buf.Bytes(), nil))
+}
+
+func tableKeys(table *Table) []string {
+	keys := make([]string, len(table.Files))
+	for i, f := range table.Files {
+		keys[i] = f.Key
+	}
+	return keys
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
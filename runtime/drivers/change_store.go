@@ -0,0 +1,146 @@
+package drivers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// ChangeStore is implemented by SQL stores that support change data capture (CDC) for a table.
+// Transporters detect it with a type assertion on the source handle and use it when CaptureChanges returns true for the source properties.
+type ChangeStore interface {
+	// StartChanges prepares change capture for the table in props (for example by creating a replication slot) and returns the current position in the change log.
+	// It must be called before the initial snapshot of the table is queried with the SQLStore.
+	// Changes that are already included in the snapshot may be passed to ConsumeChanges, so they must be applied idempotently.
+	StartChanges(ctx context.Context, props map[string]any) (map[string]any, error)
+	// ConsumeChanges passes the changes after the position in state to fn in batches.
+	// It returns when it has consumed the changes that were in the change log when it was called.
+	ConsumeChanges(ctx context.Context, props, state map[string]any, fn func(*ChangeBatch) error) error
+	// StopChanges releases the resources created by StartChanges for the position in state (for example by dropping the replication slot).
+	// It's called when the changes will no longer be consumed, such as when the source is deleted or re-ingested from a new snapshot.
+	StopChanges(ctx context.Context, props, state map[string]any) error
+}
+
+// ChangeBatch is a batch of changes consumed from a ChangeStore.
+type ChangeBatch struct {
+	// Path is a local file containing the changes as newline-delimited JSON.
+	// Each line is an object with the changed row's columns, ChangeOperationColumn and ChangeSequenceColumn.
+	// Values are encoded with encoding/json, so binary values are base64 strings, and arrays and other nested values are JSON strings.
+	// Deletes may only contain the key columns. It is empty if the batch only advances the position.
+	Path string
+	// Key is the names of the table's key columns.
+	Key []string
+	// Records is the number of changes in the batch.
+	Records int64
+	// Bytes is the size of the batch's file.
+	Bytes int64
+	// State is the position in the change log after the batch, which can be passed to ConsumeChanges to resume consumption.
+	State map[string]any
+}
+
+const (
+	// ChangeOperationColumn is the field in ChangeBatch records that holds the type of change.
+	ChangeOperationColumn = "_rill_cdc_op"
+	// ChangeSequenceColumn is the field in ChangeBatch records that holds the order of the change within the batch.
+	ChangeSequenceColumn = "_rill_cdc_seq"
+)
+
+// ChangeOperation is the type of a change in a ChangeBatch.
+type ChangeOperation string
+
+const (
+	ChangeOperationInsert ChangeOperation = "insert"
+	ChangeOperationUpdate ChangeOperation = "update"
+	ChangeOperationDelete ChangeOperation = "delete"
+)
+
+// CaptureChanges returns true if the source properties enable change data capture.
+func CaptureChanges(props map[string]any) bool {
+	var cdc bool
+	_ = mapstructure.WeakDecode(props["cdc"], &cdc)
+	return cdc
+}
+
+// ChangeBatchWriter writes changes to the file of a ChangeBatch.
+// It's a helper for implementations of ChangeStore.
+type ChangeBatchWriter struct {
+	file    *os.File
+	buf     *bufio.Writer
+	records int64
+}
+
+// Write adds a change to the batch.
+func (w *ChangeBatchWriter) Write(op ChangeOperation, row map[string]any) error {
+	if w.file == nil {
+		f, err := os.CreateTemp("", "changes_*.ndjson")
+		if err != nil {
+			return err
+		}
+		w.file = f
+		w.buf = bufio.NewWriter(f)
+	}
+
+	row[ChangeOperationColumn] = op
+	row[ChangeSequenceColumn] = w.records
+	data, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+	_, err = w.buf.Write(data)
+	if err != nil {
+		return err
+	}
+	err = w.buf.WriteByte('\n')
+	if err != nil {
+		return err
+	}
+	w.records++
+	return nil
+}
+
+// Records returns the number of changes in the batch.
+func (w *ChangeBatchWriter) Records() int64 {
+	return w.records
+}
+
+// Flush passes the batch to fn and starts a new batch.
+// If no changes were written, fn is still called with an empty Path to advance the position.
+func (w *ChangeBatchWriter) Flush(key []string, state map[string]any, fn func(*ChangeBatch) error) error {
+	if w.file == nil {
+		return fn(&ChangeBatch{Key: key, State: state})
+	}
+	defer w.Close()
+
+	err := w.buf.Flush()
+	if err != nil {
+		return err
+	}
+	info, err := w.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	return fn(&ChangeBatch{
+		Path:    w.file.Name(),
+		Key:     key,
+		Records: w.records,
+		Bytes:   info.Size(),
+		State:   state,
+	})
+}
+
+// Close removes the current batch's file.
+func (w *ChangeBatchWriter) Close() {
+	if w.file == nil {
+		return
+	}
+	name := w.file.Name()
+	_ = w.file.Close()
+	_ = os.Remove(name)
+	w.file = nil
+	w.buf = nil
+	w.records = 0
+}
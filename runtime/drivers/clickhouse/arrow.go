@@ -0,0 +1,104 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// newArrowClient returns an HTTP client for executeArrow that uses the TLS settings and timeouts of the parsed DSN.
+// It mirrors the transport that clickhouse-go configures for its HTTP protocol.
+func newArrowClient(opts *clickhouse.Options) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			DialContext:           (&net.Dialer{Timeout: opts.DialTimeout}).DialContext,
+			MaxIdleConnsPerHost:   maxOpenConnections,
+			IdleConnTimeout:       opts.ConnMaxLifetime,
+			ResponseHeaderTimeout: opts.ReadTimeout,
+			TLSClientConfig:       opts.TLS,
+		},
+	}
+}
+
+// canExecuteArrow returns true if the statement can be executed with executeArrow.
+// ClickHouse only returns Arrow over its HTTP interface, so this requires the connection to use the HTTP protocol.
+// Statements with args or executed inside WithConnection fall back to converting rows.
+func (c *connection) canExecuteArrow(ctx context.Context, stmt *drivers.Statement) bool {
+	return stmt.Arrow && len(stmt.Args) == 0 && c.opts != nil && c.opts.Protocol == clickhouse.HTTP && len(c.opts.Addr) > 0 && connFromContext(ctx) == nil
+}
+
+// executeArrow runs a query using ClickHouse's HTTP interface and streams the response in the ArrowStream format.
+// The release func is called when the returned result is closed (or immediately if an error is returned).
+func (c *connection) executeArrow(ctx context.Context, stmt *drivers.Statement, release func()) (*drivers.Result, error) {
+	var cancelFunc context.CancelFunc
+	if stmt.ExecutionTimeout != 0 {
+		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
+	}
+	cleanup := func() {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		release()
+	}
+
+	scheme := "http"
+	if c.opts.TLS != nil {
+		scheme = "https"
+	}
+	u := &url.URL{Scheme: scheme, Host: c.opts.Addr[0], Path: c.opts.HttpUrlPath}
+	qry := u.Query()
+	qry.Set("default_format", "ArrowStream")
+	if c.opts.Auth.Database != "" {
+		qry.Set("database", c.opts.Auth.Database)
+	}
+	u.RawQuery = qry.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), strings.NewReader(stmt.Query))
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+	if c.opts.Auth.Username != "" {
+		req.Header.Set("X-ClickHouse-User", c.opts.Auth.Username)
+		req.Header.Set("X-ClickHouse-Key", c.opts.Auth.Password)
+	}
+	for k, v := range c.opts.HttpHeaders {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.arrowClient.Do(req)
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		_ = resp.Body.Close()
+		cleanup()
+		return nil, fmt.Errorf("clickhouse: %s", strings.TrimSpace(string(msg)))
+	}
+
+	rdr, err := ipc.NewReader(resp.Body)
+	if err != nil {
+		_ = resp.Body.Close()
+		cleanup()
+		return nil, err
+	}
+
+	res := drivers.NewArrowResult(rdr, nil)
+	res.SetCleanupFunc(func() error {
+		err := resp.Body.Close()
+		cleanup()
+		return err
+	})
+	return res, nil
+}
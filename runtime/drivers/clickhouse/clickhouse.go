@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 
+	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/jmoiron/sqlx"
 	"github.com/mitchellh/mapstructure"
 	"github.com/rilldata/rill/runtime/drivers"
@@ -13,9 +15,6 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/priorityqueue"
 	"go.uber.org/zap"
 	"golang.org/x/sync/semaphore"
-
-	// import clickhouse driver
-	_ "github.com/ClickHouse/clickhouse-go/v2"
 )
 
 func init() {
@@ -206,12 +205,20 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 		return nil, fmt.Errorf("clickhouse version must be 22.7 or higher")
 	}
 
+	// The parsed DSN is used to stream Arrow results over the HTTP interface (see executeArrow)
+	opts, err := clickhouse.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
 	conn := &connection{
-		db:      db,
-		config:  conf,
-		logger:  logger,
-		metaSem: semaphore.NewWeighted(1),
-		olapSem: priorityqueue.NewSemaphore(maxOpenConnections - 1),
+		db:          db,
+		opts:        opts,
+		arrowClient: newArrowClient(opts),
+		config:      conf,
+		logger:      logger,
+		metaSem:     semaphore.NewWeighted(1),
+		olapSem:     priorityqueue.NewSemaphore(maxOpenConnections - 1),
 	}
 	return conn, nil
 }
@@ -229,11 +236,14 @@ func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any
 }
 
 type connection struct {
-	db         *sqlx.DB
-	config     *configProperties
-	logger     *zap.Logger
-	activity   *activity.Client
-	instanceID string
+	db   *sqlx.DB
+	opts *clickhouse.Options
+	// arrowClient is the HTTP client used by executeArrow
+	arrowClient *http.Client
+	config      *configProperties
+	logger      *zap.Logger
+	activity    *activity.Client
+	instanceID  string
 
 	// logic around this copied from duckDB driver
 	// This driver may issue both OLAP and "meta" queries (like catalog info) against DuckDB.
@@ -311,9 +321,16 @@ func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
 
 // AsModelExecutor implements drivers.Handle.
 func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
-	if opts.InputHandle == c && opts.OutputHandle == c {
+	if opts.OutputHandle != c {
+		return nil, false
+	}
+	if opts.InputHandle == c {
 		return &selfToSelfExecutor{c, opts}, true
 	}
+	switch opts.InputHandle.Driver() {
+	case "s3", "gcs", "azure":
+		return &objectStoreToSelfExecutor{c, opts}, true
+	}
 	return nil, false
 }
 
@@ -326,6 +343,9 @@ func (c *connection) AsModelManager(instanceID string) (drivers.ModelManager, bo
 func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
 	olap, _ := to.(*connection)
 	if c == to {
+		if store, ok := from.(drivers.StreamStore); ok {
+			return NewStreamTransporter(store, olap, c.logger), true
+		}
 		switch from.Driver() {
 		case "s3":
 			return NewS3Transporter(from, olap, c.logger), true
@@ -0,0 +1,398 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	rillblob "github.com/rilldata/rill/runtime/drivers/blob"
+	"github.com/rilldata/rill/runtime/pkg/globutil"
+)
+
+// objectStoreToSelfExecutor executes models that read files from S3, GCS or Azure directly in ClickHouse.
+// It uses ClickHouse's s3(), gcs() and azureBlobStorage() table functions with credentials taken from the input connector.
+// Alternatively, the credentials can be taken from a named collection configured in ClickHouse, which keeps them out of ClickHouse's query log.
+type objectStoreToSelfExecutor struct {
+	c    *connection
+	opts *drivers.ModelExecutorOptions
+}
+
+var _ drivers.ModelExecutor = &objectStoreToSelfExecutor{}
+
+type objectStoreInputProperties struct {
+	Path string `mapstructure:"path"`
+	URI  string `mapstructure:"uri"`
+	// Format is the file format. If not set, ClickHouse infers it from the file extension.
+	Format  string         `mapstructure:"format"`
+	Extract map[string]any `mapstructure:"extract"`
+	// Endpoint and Region only apply to S3.
+	Endpoint string `mapstructure:"endpoint"`
+	Region   string `mapstructure:"region"`
+	// Account only applies to Azure.
+	Account string `mapstructure:"account"`
+	// NamedCollection is a named collection in ClickHouse that holds the credentials for reading the files.
+	// If set, it's used instead of the credentials of the input connector.
+	NamedCollection string `mapstructure:"named_collection"`
+	url             *globutil.URL
+	extractPolicy   *rillblob.ExtractPolicy
+}
+
+func (p *objectStoreInputProperties) Validate(driver string) error {
+	// Backwards compatibility for "uri" renamed to "path"
+	if p.URI != "" {
+		p.Path = p.URI
+	}
+	if p.Path == "" {
+		return fmt.Errorf("missing property 'path'")
+	}
+	if !doublestar.ValidatePattern(p.Path) {
+		return fmt.Errorf("glob pattern %q is invalid", p.Path)
+	}
+
+	var err error
+	p.url, err = globutil.ParseBucketURL(p.Path)
+	if err != nil {
+		return fmt.Errorf("failed to parse path %q: %w", p.Path, err)
+	}
+	scheme := driver
+	if driver == "gcs" {
+		scheme = "gs"
+	}
+	if p.url.Scheme != scheme {
+		return fmt.Errorf("invalid scheme %q in path %q", p.url.Scheme, p.Path)
+	}
+	if p.NamedCollection != "" && !namedCollectionRegex.MatchString(p.NamedCollection) {
+		return fmt.Errorf("invalid named collection %q", p.NamedCollection)
+	}
+
+	p.extractPolicy, err = rillblob.ParseExtractPolicy(p.Extract)
+	if err != nil {
+		return fmt.Errorf("failed to parse extract config: %w", err)
+	}
+	if p.extractPolicy != nil && rillblob.IsTableFormat(p.Format) {
+		return fmt.Errorf("extract policies are not supported for %s tables", p.Format)
+	}
+	if p.extractPolicy != nil && p.extractPolicy.RowsStrategy != rillblob.ExtractPolicyStrategyUnspecified {
+		return fmt.Errorf("extract policies for rows are not supported when reading files directly into ClickHouse")
+	}
+
+	return nil
+}
+
+// namedCollectionRegex matches the names of named collections that can be used unquoted in a table function.
+var namedCollectionRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+type s3InputConfig struct {
+	AccessKeyID     string `mapstructure:"aws_access_key_id"`
+	SecretAccessKey string `mapstructure:"aws_secret_access_key"`
+	SessionToken    string `mapstructure:"aws_access_token"`
+}
+
+type gcsInputConfig struct {
+	KeyID  string `mapstructure:"key_id"`
+	Secret string `mapstructure:"secret"`
+}
+
+type azureInputConfig struct {
+	Account          string `mapstructure:"azure_storage_account"`
+	Key              string `mapstructure:"azure_storage_key"`
+	SASToken         string `mapstructure:"azure_storage_sas_token"`
+	ConnectionString string `mapstructure:"azure_storage_connection_string"`
+}
+
+func (e *objectStoreToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult, error) {
+	inputProps := &objectStoreInputProperties{}
+	if err := mapstructure.WeakDecode(e.opts.InputProperties, inputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+	if err := inputProps.Validate(e.opts.InputHandle.Driver()); err != nil {
+		return nil, fmt.Errorf("invalid input properties: %w", err)
+	}
+
+	var sql string
+	var err error
+	if rillblob.IsTableFormat(inputProps.Format) {
+		sql, err = e.tableSQL(ctx, inputProps)
+	} else {
+		sql, err = e.filesSQL(ctx, inputProps)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Reading files on every query would be slow, so we materialize unless explicitly configured otherwise.
+	outputProps := make(map[string]any, len(e.opts.OutputProperties)+1)
+	for k, v := range e.opts.OutputProperties {
+		outputProps[k] = v
+	}
+	if _, ok := outputProps["materialize"]; !ok {
+		outputProps["materialize"] = true
+	}
+
+	// Delegate to the self executor, which handles staging, incremental strategies and result properties.
+	opts := *e.opts
+	opts.InputHandle = e.c
+	opts.InputConnector = e.opts.OutputConnector
+	opts.InputProperties = map[string]any{"sql": sql}
+	opts.OutputProperties = outputProps
+	executor := &selfToSelfExecutor{c: e.c, opts: &opts}
+	return executor.Execute(ctx)
+}
+
+// filesSQL returns a query that reads the files matched by the input properties.
+func (e *objectStoreToSelfExecutor) filesSQL(ctx context.Context, props *objectStoreInputProperties) (string, error) {
+	tableFunc, err := e.tableFunction(props, clickHouseFormat(props.Format))
+	if err != nil {
+		return "", err
+	}
+
+	sql := "SELECT * FROM " + tableFunc
+	if props.extractPolicy != nil && props.extractPolicy.FilesStrategy != rillblob.ExtractPolicyStrategyUnspecified {
+		// The "One" format reads a single row per file without reading the file contents
+		listFunc, err := e.tableFunction(props, "One")
+		if err != nil {
+			return "", err
+		}
+		paths, err := e.selectPaths(ctx, listFunc, props.extractPolicy)
+		if err != nil {
+			return "", err
+		}
+		if len(paths) == 0 {
+			return "", fmt.Errorf("no files found for path %q", props.Path)
+		}
+		sql += pathFilter(paths)
+	}
+	return sql, nil
+}
+
+// tableSQL returns a query that reads the live data files of the Iceberg or Delta table at the input path.
+// The files are resolved by the input connector and read with a glob over their common directory that is filtered by path.
+func (e *objectStoreToSelfExecutor) tableSQL(ctx context.Context, props *objectStoreInputProperties) (string, error) {
+	store, ok := e.opts.InputHandle.AsObjectStore()
+	if !ok {
+		return "", fmt.Errorf("input connector %q is not an object store", e.opts.InputConnector)
+	}
+	table, err := store.ResolveTable(ctx, e.opts.InputProperties)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s table %q: %w", props.Format, props.Path, err)
+	}
+	if len(table.Keys) == 0 {
+		return "", fmt.Errorf("no data files found for %s table %q", props.Format, props.Path)
+	}
+
+	u := *props.url
+	u.Path = tableGlob(table.Keys)
+	globProps := *props
+	globProps.url = &u
+	tableFunc, err := e.tableFunction(&globProps, "Parquet")
+	if err != nil {
+		return "", err
+	}
+
+	paths := make([]string, len(table.Keys))
+	for i, k := range table.Keys {
+		paths[i] = props.url.Host + "/" + k
+	}
+
+	// Partition columns of Delta tables are not stored in the data files, so their values in the Delta log are mapped from the file paths
+	cols := []string{"*"}
+	for _, c := range table.PartitionColumns {
+		var sb strings.Builder
+		sb.WriteString("CASE _path")
+		for i, k := range table.Keys {
+			v := "NULL"
+			if p := table.PartitionValues[k][c.Name]; p != nil {
+				v = sqlString(*p)
+			}
+			fmt.Fprintf(&sb, " WHEN %s THEN %s", sqlString(paths[i]), v)
+		}
+		fmt.Fprintf(&sb, " END AS %s", safeSQLName(c.Name))
+		cols = append(cols, sb.String())
+	}
+	return fmt.Sprintf("SELECT %s FROM %s%s", strings.Join(cols, ", "), tableFunc, pathFilter(paths)), nil
+}
+
+// tableGlob returns a glob that matches all the keys.
+func tableGlob(keys []string) string {
+	if len(keys) == 1 {
+		return keys[0]
+	}
+	dir := path.Dir(keys[0])
+	for _, k := range keys[1:] {
+		for dir != "." && !strings.HasPrefix(k, dir+"/") {
+			dir = path.Dir(dir)
+		}
+	}
+	if dir == "." {
+		return "**"
+	}
+	return dir + "/**"
+}
+
+// pathFilter returns a WHERE clause that only reads the files with the given _path values.
+func pathFilter(paths []string) string {
+	quoted := make([]string, len(paths))
+	for i, p := range paths {
+		quoted[i] = sqlString(p)
+	}
+	return fmt.Sprintf(" WHERE _path IN (%s)", strings.Join(quoted, ", "))
+}
+
+// tableFunction returns a ClickHouse table function that reads the files matched by the input properties.
+// If a named collection is set, the other arguments are passed as overrides of its keys.
+// Otherwise, the credentials of the input connector are passed as arguments.
+func (e *objectStoreToSelfExecutor) tableFunction(props *objectStoreInputProperties, format string) (string, error) {
+	cfg := e.opts.InputHandle.Config()
+	useConnector := props.NamedCollection == ""
+
+	var name string
+	var args [][2]string
+	switch e.opts.InputHandle.Driver() {
+	case "s3":
+		var url string
+		switch {
+		case props.Endpoint != "":
+			url = fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(props.Endpoint, "/"), props.url.Host, props.url.Path)
+		case props.Region != "":
+			url = fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", props.url.Host, props.Region, props.url.Path)
+		default:
+			url = fmt.Sprintf("https://%s.s3.amazonaws.com/%s", props.url.Host, props.url.Path)
+		}
+		name = "s3"
+		args = [][2]string{{"url", url}}
+
+		conf := &s3InputConfig{}
+		if err := mapstructure.WeakDecode(cfg, conf); err != nil {
+			return "", fmt.Errorf("failed to parse s3 config: %w", err)
+		}
+		if useConnector && conf.AccessKeyID != "" {
+			args = append(args, [2]string{"access_key_id", conf.AccessKeyID}, [2]string{"secret_access_key", conf.SecretAccessKey})
+			if conf.SessionToken != "" {
+				args = append(args, [2]string{"session_token", conf.SessionToken})
+			}
+		}
+		args = append(args, [2]string{"format", format})
+	case "gcs":
+		url := fmt.Sprintf("https://storage.googleapis.com/%s/%s", props.url.Host, props.url.Path)
+		name = "gcs"
+		args = [][2]string{{"url", url}}
+
+		conf := &gcsInputConfig{}
+		if err := mapstructure.WeakDecode(cfg, conf); err != nil {
+			return "", fmt.Errorf("failed to parse gcs config: %w", err)
+		}
+		if useConnector && conf.KeyID != "" {
+			args = append(args, [2]string{"access_key_id", conf.KeyID}, [2]string{"secret_access_key", conf.Secret})
+		}
+		args = append(args, [2]string{"format", format})
+	case "azure":
+		name = "azureBlobStorage"
+		location := [][2]string{{"container", props.url.Host}, {"blob_path", props.url.Path}}
+		if !useConnector {
+			// The named collection holds the storage account URL or connection string
+			args = append(location, [2]string{"format", format})
+			break
+		}
+
+		conf := &azureInputConfig{}
+		if err := mapstructure.WeakDecode(cfg, conf); err != nil {
+			return "", fmt.Errorf("failed to parse azure config: %w", err)
+		}
+		account := props.Account
+		if account == "" {
+			account = conf.Account
+		}
+		url := fmt.Sprintf("https://%s.blob.core.windows.net", account)
+
+		switch {
+		case conf.ConnectionString != "":
+			args = append([][2]string{{"connection_string", conf.ConnectionString}}, location...)
+		case account == "":
+			return "", fmt.Errorf("azure storage account is not configured: set 'azure_storage_account' on the connector or 'account' on the model")
+		case conf.Key != "":
+			args = append([][2]string{{"storage_account_url", url}}, location...)
+			args = append(args, [2]string{"account_name", account}, [2]string{"account_key", conf.Key})
+		case conf.SASToken != "":
+			connStr := fmt.Sprintf("BlobEndpoint=%s/;SharedAccessSignature=%s", url, strings.TrimPrefix(conf.SASToken, "?"))
+			args = append([][2]string{{"connection_string", connStr}}, location...)
+		default:
+			args = append([][2]string{{"storage_account_url", url}}, location...)
+		}
+		args = append(args, [2]string{"format", format})
+	default:
+		return "", fmt.Errorf("unsupported input connector %q", e.opts.InputHandle.Driver())
+	}
+
+	var vals []string
+	if props.NamedCollection != "" {
+		vals = append(vals, props.NamedCollection)
+		for _, a := range args {
+			vals = append(vals, fmt.Sprintf("%s = %s", a[0], sqlString(a[1])))
+		}
+	} else {
+		for _, a := range args {
+			vals = append(vals, sqlString(a[1]))
+		}
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(vals, ", ")), nil
+}
+
+// selectPaths applies a files extract policy by listing the matched files in ClickHouse.
+// Files are ordered by path, which matches the listing order of the object stores.
+func (e *objectStoreToSelfExecutor) selectPaths(ctx context.Context, listFunc string, policy *rillblob.ExtractPolicy) ([]string, error) {
+	order := "ASC"
+	if policy.FilesStrategy == rillblob.ExtractPolicyStrategyTail {
+		order = "DESC"
+	}
+
+	olap, ok := e.c.AsOLAP(e.c.instanceID)
+	if !ok {
+		return nil, fmt.Errorf("output connector is not OLAP")
+	}
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT DISTINCT _path FROM %s ORDER BY _path %s LIMIT %d", listFunc, order, policy.FilesLimit),
+		Priority: e.opts.Priority,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var p string
+		if err := rows.Scan(&p); err != nil {
+			return nil, err
+		}
+		paths = append(paths, p)
+	}
+	return paths, rows.Err()
+}
+
+// clickHouseFormat maps a Rill file format to a ClickHouse input format.
+// Unknown values are passed through, so native ClickHouse format names can also be used.
+func clickHouseFormat(format string) string {
+	switch strings.ToLower(strings.TrimPrefix(format, ".")) {
+	case "":
+		return "auto"
+	case "parquet":
+		return "Parquet"
+	case "csv":
+		return "CSVWithNames"
+	case "tsv":
+		return "TSVWithNames"
+	case "json", "ndjson", "jsonl":
+		return "JSONEachRow"
+	default:
+		return format
+	}
+}
+
+func sqlString(s string) string {
+	return "'" + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), "'", `\'`) + "'"
+}
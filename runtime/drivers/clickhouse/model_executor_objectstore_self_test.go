@@ -0,0 +1,204 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	_ "github.com/rilldata/rill/runtime/drivers/azure"
+	_ "github.com/rilldata/rill/runtime/drivers/gcs"
+	_ "github.com/rilldata/rill/runtime/drivers/s3"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/clickhouse"
+	"github.com/testcontainers/testcontainers-go/network"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.uber.org/zap"
+)
+
+func TestObjectStoreTableFunction(t *testing.T) {
+	s3, err := drivers.Open("s3", "default", map[string]any{"aws_access_key_id": "key", "aws_secret_access_key": "se'cret"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+
+	e := &objectStoreToSelfExecutor{opts: &drivers.ModelExecutorOptions{InputHandle: s3}}
+	props := &objectStoreInputProperties{Path: "s3://bucket/year=*/**/*.parquet"}
+	require.NoError(t, props.Validate("s3"))
+
+	fn, err := e.tableFunction(props, clickHouseFormat(props.Format))
+	require.NoError(t, err)
+	require.Equal(t, `s3('https://bucket.s3.amazonaws.com/year=*/**/*.parquet', 'key', 'se\'cret', 'auto')`, fn)
+
+	// A named collection overrides the connector's credentials
+
+	props = &objectStoreInputProperties{Path: "s3://bucket/data.csv", Endpoint: "http://localhost:9000/", Format: "csv", NamedCollection: "minio"}
+	require.NoError(t, props.Validate("s3"))
+	fn, err = e.tableFunction(props, clickHouseFormat(props.Format))
+	require.NoError(t, err)
+	require.Equal(t, `s3(minio, url = 'http://localhost:9000/bucket/data.csv', format = 'CSVWithNames')`, fn)
+
+	props = &objectStoreInputProperties{Path: "s3://bucket/data.csv", NamedCollection: "minio'); DROP TABLE x; --"}
+	require.ErrorContains(t, props.Validate("s3"), "invalid named collection")
+
+	azure, err := drivers.Open("azure", "default", map[string]any{"azure_storage_account": "acc", "azure_storage_key": "secret"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	e = &objectStoreToSelfExecutor{opts: &drivers.ModelExecutorOptions{InputHandle: azure}}
+	props = &objectStoreInputProperties{Path: "azure://container/data/*.parquet"}
+	require.NoError(t, props.Validate("azure"))
+	fn, err = e.tableFunction(props, "Parquet")
+	require.NoError(t, err)
+	require.Equal(t, `azureBlobStorage('https://acc.blob.core.windows.net', 'container', 'data/*.parquet', 'acc', 'secret', 'Parquet')`, fn)
+	props.NamedCollection = "azure_creds"
+	fn, err = e.tableFunction(props, "Parquet")
+	require.NoError(t, err)
+	require.Equal(t, `azureBlobStorage(azure_creds, container = 'container', blob_path = 'data/*.parquet', format = 'Parquet')`, fn)
+
+	azure, err = drivers.Open("azure", "default", map[string]any{"azure_storage_account": "acc", "azure_storage_sas_token": "?sv=1&sig=abc"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	e = &objectStoreToSelfExecutor{opts: &drivers.ModelExecutorOptions{InputHandle: azure}}
+	props = &objectStoreInputProperties{Path: "azure://container/data.csv"}
+	require.NoError(t, props.Validate("azure"))
+	fn, err = e.tableFunction(props, "CSVWithNames")
+	require.NoError(t, err)
+	require.Equal(t, `azureBlobStorage('BlobEndpoint=https://acc.blob.core.windows.net/;SharedAccessSignature=sv=1&sig=abc', 'container', 'data.csv', 'CSVWithNames')`, fn)
+
+	gcs, err := drivers.Open("gcs", "default", map[string]any{"key_id": "hmac", "secret": "hmac_secret"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	e = &objectStoreToSelfExecutor{opts: &drivers.ModelExecutorOptions{InputHandle: gcs}}
+	props = &objectStoreInputProperties{Path: "gs://bucket/data.parquet"}
+	require.NoError(t, props.Validate("gcs"))
+	fn, err = e.tableFunction(props, "Parquet")
+	require.NoError(t, err)
+	require.Equal(t, `gcs('https://storage.googleapis.com/bucket/data.parquet', 'hmac', 'hmac_secret', 'Parquet')`, fn)
+
+	props = &objectStoreInputProperties{Path: "gs://bucket/data.csv"}
+	require.ErrorContains(t, props.Validate("s3"), "invalid scheme")
+
+	props = &objectStoreInputProperties{Path: "s3://bucket/*.parquet", Extract: map[string]any{"rows": map[string]any{"strategy": "head", "size": "1KB"}}}
+	require.ErrorContains(t, props.Validate("s3"), "not supported")
+
+	props = &objectStoreInputProperties{Path: "s3://bucket/tbl", Format: "delta", Extract: map[string]any{"files": map[string]any{"strategy": "head", "size": "1"}}}
+	require.ErrorContains(t, props.Validate("s3"), "not supported for delta tables")
+
+	require.Equal(t, "tbl/day=1/a.parquet", tableGlob([]string{"tbl/day=1/a.parquet"}))
+	require.Equal(t, "tbl/**", tableGlob([]string{"tbl/day=1/a.parquet", "tbl/day=2/b.parquet"}))
+	require.Equal(t, "**", tableGlob([]string{"a.parquet", "tbl/b.parquet"}))
+}
+
+func TestObjectStoreToSelfExecutor(t *testing.T) {
+	if testing.Short() {
+		t.Skip("clickhouse: skipping test in short mode")
+	}
+
+	ctx := context.Background()
+	nw, err := network.New(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, nw.Remove(ctx))
+	})
+
+	// MinIO serves top-level directories in its data directory as buckets
+	minioContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "minio/minio:latest",
+			Entrypoint:   []string{"sh", "-c", "mkdir -p /data/test && minio server /data"},
+			Env:          map[string]string{"MINIO_ROOT_USER": "minioadmin", "MINIO_ROOT_PASSWORD": "minioadmin"},
+			ExposedPorts: []string{"9000/tcp"},
+			Networks:     []string{nw.Name},
+			NetworkAliases: map[string][]string{
+				nw.Name: {"minio"},
+			},
+			WaitingFor: wait.ForHTTP("/minio/health/ready").WithPort("9000/tcp"),
+		},
+		Started: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, minioContainer.Terminate(ctx))
+	})
+
+	clickHouseContainer, err := clickhouse.RunContainer(ctx,
+		testcontainers.WithImage("clickhouse/clickhouse-server:latest"),
+		clickhouse.WithUsername("clickhouse"),
+		clickhouse.WithPassword("clickhouse"),
+		clickhouse.WithConfigFile("testdata/clickhouse-named-collections.xml"),
+		network.WithNetwork([]string{"clickhouse"}, nw),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, clickHouseContainer.Terminate(ctx))
+	})
+
+	host, err := clickHouseContainer.Host(ctx)
+	require.NoError(t, err)
+	port, err := clickHouseContainer.MappedPort(ctx, "9000/tcp")
+	require.NoError(t, err)
+
+	conn, err := driver{}.Open("default", map[string]any{"dsn": fmt.Sprintf("clickhouse://clickhouse:clickhouse@%v:%v", host, port.Port())}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	olap, _ := conn.AsOLAP("")
+
+	// Write some Parquet files to MinIO
+	for i := 0; i < 3; i++ {
+		err = olap.Exec(ctx, &drivers.Statement{
+			Query: fmt.Sprintf("INSERT INTO FUNCTION s3('http://minio:9000/test/data/part_%d.parquet', 'minioadmin', 'minioadmin', 'Parquet') SELECT number AS id, %d AS part FROM numbers(10)", i, i),
+		})
+		require.NoError(t, err)
+	}
+
+	s3, err := drivers.Open("s3", "default", map[string]any{"aws_access_key_id": "minioadmin", "aws_secret_access_key": "minioadmin"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+
+	execute := func(inputProps map[string]any, incrementalRun bool) *drivers.ModelResult {
+		opts := &drivers.ModelExecutorOptions{
+			Env:              &drivers.ModelEnv{StageChanges: true},
+			ModelName:        "model",
+			InputHandle:      s3,
+			InputConnector:   "s3",
+			InputProperties:  inputProps,
+			OutputHandle:     conn,
+			OutputConnector:  "clickhouse",
+			OutputProperties: map[string]any{},
+			Incremental:      incrementalRun,
+			IncrementalRun:   incrementalRun,
+		}
+		e, ok := conn.AsModelExecutor("default", opts)
+		require.True(t, ok)
+		res, err := e.Execute(ctx)
+		require.NoError(t, err)
+		return res
+	}
+	count := func(table string) int {
+		rows, err := olap.Execute(ctx, &drivers.Statement{Query: fmt.Sprintf("SELECT count(*) FROM %s", safeSQLName(table))})
+		require.NoError(t, err)
+		defer rows.Close()
+		var n int
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(&n))
+		return n
+	}
+
+	// Glob over all files with the connector's credentials
+	res := execute(map[string]any{"path": "s3://test/data/*.parquet", "endpoint": "http://minio:9000"}, false)
+	require.Equal(t, "model", res.Table)
+	require.Equal(t, 30, count(res.Table))
+
+	// Glob over all files with a named collection
+	res = execute(map[string]any{"path": "s3://test/data/*.parquet", "endpoint": "http://minio:9000", "named_collection": "minio"}, false)
+	require.Equal(t, "model", res.Table)
+	require.Equal(t, 30, count(res.Table))
+
+	// Files extract policy
+	res = execute(map[string]any{
+		"path":             "s3://test/data/*.parquet",
+		"endpoint":         "http://minio:9000",
+		"named_collection": "minio",
+		"extract":          map[string]any{"files": map[string]any{"strategy": "tail", "size": "2"}},
+	}, false)
+	require.Equal(t, 20, count(res.Table))
+
+	// Incremental runs append
+	res = execute(map[string]any{"path": "s3://test/data/part_0.parquet", "endpoint": "http://minio:9000", "named_collection": "minio"}, true)
+	require.Equal(t, 30, count(res.Table))
+}
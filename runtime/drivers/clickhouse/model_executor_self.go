@@ -13,11 +13,9 @@ type selfToSelfExecutor struct {
 	opts *drivers.ModelExecutorOptions
 }
 
-func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult, error) {
-	if e.opts.Incremental {
-		return nil, fmt.Errorf("clickhouse: incremental models are not supported")
-	}
+var _ drivers.ModelExecutor = &selfToSelfExecutor{}
 
+func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult, error) {
 	olap, ok := e.c.AsOLAP(e.c.instanceID)
 	if !ok {
 		return nil, fmt.Errorf("output connector is not OLAP")
@@ -52,30 +50,44 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult,
 
 	asView := !materialize
 	tableName := outputProps.Table
-	stagingTableName := tableName
-	if e.opts.Env.StageChanges {
-		stagingTableName = stagingTableNameFor(tableName)
-	}
 
-	// Drop the staging view/table if it exists.
-	// NOTE: This intentionally drops the end table if not staging changes.
-	if t, err := olap.InformationSchema().Lookup(ctx, "", "", stagingTableName); err == nil {
-		_ = olap.DropTable(ctx, stagingTableName, t.View)
-	}
+	var partitions []string
+	if !e.opts.IncrementalRun {
+		// Prepare for ingesting into the staging view/table.
+		// NOTE: This intentionally drops the end table if not staging changes.
+		stagingTableName := tableName
+		if e.opts.Env.StageChanges {
+			stagingTableName = stagingTableNameFor(tableName)
+		}
+		if t, err := olap.InformationSchema().Lookup(ctx, "", "", stagingTableName); err == nil {
+			_ = olap.DropTable(ctx, stagingTableName, t.View)
+		}
 
-	// Create the table
-	err := olap.CreateTableAsSelect(ctx, stagingTableName, asView, inputProps.SQL)
-	if err != nil {
-		_ = olap.DropTable(ctx, stagingTableName, asView)
-		return nil, fmt.Errorf("failed to create model: %w", err)
-	}
+		// Create the table
+		err := e.c.createTableAsSelect(ctx, stagingTableName, asView, inputProps.SQL, outputProps.tableEngineClause())
+		if err != nil {
+			_ = olap.DropTable(ctx, stagingTableName, asView)
+			return nil, fmt.Errorf("failed to create model: %w", err)
+		}
 
-	// Rename the staging table to the final table name
-	if stagingTableName != tableName {
-		err = olapForceRenameTable(ctx, olap, stagingTableName, asView, tableName)
+		// Rename the staging table to the final table name
+		if stagingTableName != tableName {
+			err = olapForceRenameTable(ctx, olap, stagingTableName, asView, tableName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to rename staged model: %w", err)
+			}
+		}
+	} else {
+		// Insert into the table
+		res, err := olap.InsertTableAsSelect(ctx, tableName, inputProps.SQL, &drivers.InsertTableOptions{
+			Strategy:    outputProps.IncrementalStrategy,
+			UniqueKey:   outputProps.UniqueKey,
+			PartitionBy: outputProps.PartitionBy,
+		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to rename staged model: %w", err)
+			return nil, fmt.Errorf("failed to incrementally insert into table: %w", err)
 		}
+		partitions = res.Partitions
 	}
 
 	// Build result props
@@ -85,7 +97,7 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult,
 		UsedModelName: usedModelName,
 	}
 	resultPropsMap := map[string]interface{}{}
-	err = mapstructure.WeakDecode(resultProps, &resultPropsMap)
+	err := mapstructure.WeakDecode(resultProps, &resultPropsMap)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode result properties: %w", err)
 	}
@@ -95,5 +107,6 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult,
 		Connector:  e.opts.OutputConnector,
 		Properties: resultPropsMap,
 		Table:      tableName,
+		Partitions: partitions,
 	}, nil
 }
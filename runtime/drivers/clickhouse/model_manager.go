@@ -21,14 +21,76 @@ func (p *ModelInputProperties) Validate() error {
 }
 
 type ModelOutputProperties struct {
-	Table       string `mapstructure:"table"`
-	Materialize *bool  `mapstructure:"materialize"`
+	Table               string                      `mapstructure:"table"`
+	Materialize         *bool                       `mapstructure:"materialize"`
+	UniqueKey           []string                    `mapstructure:"unique_key"`
+	IncrementalStrategy drivers.IncrementalStrategy `mapstructure:"incremental_strategy"`
+	// Engine is the table engine to use for materialized models. Defaults to MergeTree.
+	Engine string `mapstructure:"engine"`
+	// OrderBy is the ORDER BY clause of the table. Defaults to the unique key, or tuple() if there is no unique key.
+	OrderBy string `mapstructure:"order_by"`
+	// PartitionBy is the PARTITION BY clause of the table. It is required for the partition_overwrite incremental strategy.
+	PartitionBy string `mapstructure:"partition_by"`
 }
 
 func (p *ModelOutputProperties) Validate(opts *drivers.ModelExecutorOptions) error {
+	if opts.Incremental {
+		if p.Materialize != nil && !*p.Materialize {
+			return fmt.Errorf("incremental models must be materialized")
+		}
+		p.Materialize = boolPtr(true)
+	}
+
+	switch p.IncrementalStrategy {
+	case drivers.IncrementalStrategyUnspecified, drivers.IncrementalStrategyAppend, drivers.IncrementalStrategyMerge, drivers.IncrementalStrategyPartitionOverwrite:
+	default:
+		return fmt.Errorf("invalid incremental strategy %q", p.IncrementalStrategy)
+	}
+
+	if p.IncrementalStrategy == drivers.IncrementalStrategyMerge && len(p.UniqueKey) == 0 {
+		return fmt.Errorf(`must specify a "unique_key" when "incremental_strategy" is %q`, p.IncrementalStrategy)
+	}
+
+	if p.IncrementalStrategy == drivers.IncrementalStrategyPartitionOverwrite && p.PartitionBy == "" {
+		return fmt.Errorf(`must specify a "partition_by" when "incremental_strategy" is %q`, p.IncrementalStrategy)
+	}
+
+	if p.IncrementalStrategy == drivers.IncrementalStrategyUnspecified {
+		if len(p.UniqueKey) == 0 {
+			p.IncrementalStrategy = drivers.IncrementalStrategyAppend
+		} else {
+			p.IncrementalStrategy = drivers.IncrementalStrategyMerge
+		}
+	}
+
+	if p.Engine == "" {
+		p.Engine = "MergeTree"
+	}
+
+	if p.OrderBy == "" {
+		if len(p.UniqueKey) > 0 {
+			keys := make([]string, len(p.UniqueKey))
+			for i, k := range p.UniqueKey {
+				keys[i] = safeSQLName(k)
+			}
+			p.OrderBy = "(" + strings.Join(keys, ", ") + ")"
+		} else {
+			p.OrderBy = "tuple()"
+		}
+	}
+
 	return nil
 }
 
+// tableEngineClause returns the ENGINE clause (including ORDER BY and PARTITION BY) for creating a table for the model.
+func (p *ModelOutputProperties) tableEngineClause() string {
+	clause := fmt.Sprintf("ENGINE = %s ORDER BY %s", p.Engine, p.OrderBy)
+	if p.PartitionBy != "" {
+		clause += fmt.Sprintf(" PARTITION BY %s", p.PartitionBy)
+	}
+	return clause
+}
+
 type ModelResultProperties struct {
 	Table         string `mapstructure:"table"`
 	View          bool   `mapstructure:"view"`
@@ -98,6 +160,10 @@ func (c *connection) Delete(ctx context.Context, res *drivers.ModelResult) error
 	return olap.DropTable(ctx, table.Name, table.View)
 }
 
+func boolPtr(b bool) *bool {
+	return &b
+}
+
 // stagingTableName returns a stable temporary table name for a destination table.
 // By using a stable temporary table name, we can ensure proper garbage collection without managing additional state.
 func stagingTableNameFor(table string) string {
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
@@ -149,6 +150,18 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 		}
 	}()
 
+	// Stream the result over HTTP if the caller wants it in Arrow format
+	if c.canExecuteArrow(ctx, stmt) {
+		err := c.olapSem.Acquire(ctx, stmt.Priority)
+		acquiredTime = time.Now()
+		if err != nil {
+			return nil, err
+		}
+		acquired = true
+
+		return c.executeArrow(ctx, stmt, c.olapSem.Release)
+	}
+
 	// Acquire connection
 	conn, release, err := c.acquireOLAPConn(ctx, stmt.Priority)
 	acquiredTime = time.Now()
@@ -207,6 +220,11 @@ func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName
 
 // CreateTableAsSelect implements drivers.OLAPStore.
 func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string) error {
+	return c.createTableAsSelect(ctx, name, view, sql, "ENGINE = MergeTree ORDER BY tuple()")
+}
+
+// createTableAsSelect creates a table or view from a SELECT query. The engineClause is used when creating a table.
+func (c *connection) createTableAsSelect(ctx context.Context, name string, view bool, sql, engineClause string) error {
 	if view {
 		return c.Exec(ctx, &drivers.Statement{
 			Query:    fmt.Sprintf("CREATE OR REPLACE VIEW %s AS %s", safeSQLName(name), sql),
@@ -214,14 +232,233 @@ func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view
 		})
 	}
 	return c.Exec(ctx, &drivers.Statement{
-		Query:    fmt.Sprintf("CREATE OR REPLACE TABLE %s ENGINE = MergeTree ORDER BY tuple() AS %s", safeSQLName(name), sql),
+		Query:    fmt.Sprintf("CREATE OR REPLACE TABLE %s %s AS %s", safeSQLName(name), engineClause, sql),
 		Priority: 100,
 	})
 }
 
 // InsertTableAsSelect implements drivers.OLAPStore.
-func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
-	return fmt.Errorf("clickhouse: data transformation not yet supported")
+// The ByName and InPlace options are ignored since ClickHouse always inserts by position into the existing table.
+// The PartitionBy option is also ignored since partitions are determined by the table's PARTITION BY clause.
+func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, opts *drivers.InsertTableOptions) (*drivers.InsertTableResult, error) {
+	c.logger.Debug("insert table", zap.String("name", name), zap.String("strategy", string(opts.Strategy)), zap.Strings("uniqueKey", opts.UniqueKey))
+
+	switch opts.Strategy {
+	case drivers.IncrementalStrategyUnspecified, drivers.IncrementalStrategyAppend:
+		err := c.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("INSERT INTO %s %s", safeSQLName(name), sql),
+			Priority: 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &drivers.InsertTableResult{}, nil
+	case drivers.IncrementalStrategyMerge:
+		err := c.insertMerge(ctx, name, sql, opts.UniqueKey)
+		if err != nil {
+			return nil, err
+		}
+		return &drivers.InsertTableResult{}, nil
+	case drivers.IncrementalStrategyPartitionOverwrite:
+		partitions, err := c.insertPartitionOverwrite(ctx, name, sql)
+		if err != nil {
+			return nil, err
+		}
+		return &drivers.InsertTableResult{Partitions: partitions}, nil
+	default:
+		return nil, fmt.Errorf("incremental insert strategy %q not supported", opts.Strategy)
+	}
+}
+
+// insertMerge inserts the output of sql into the table, replacing existing rows with the same unique key.
+// For tables with a ReplacingMergeTree engine whose sorting key is exactly the unique key, it relies on the engine to deduplicate rows.
+// Otherwise, it stages the new data, deletes existing rows with matching keys, and then inserts the staged data.
+func (c *connection) insertMerge(ctx context.Context, name, sql string, uniqueKey []string) error {
+	if len(uniqueKey) == 0 {
+		return fmt.Errorf("merge strategy requires a unique key")
+	}
+
+	engine, sortingKey, err := c.tableEngine(ctx, name)
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(engine, "Replacing") && sortingKeyEquals(sortingKey, uniqueKey) {
+		return c.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("INSERT INTO %s %s", safeSQLName(name), sql),
+			Priority: 1,
+		})
+	}
+
+	// Stage the new data in a table with the same structure as the target table
+	tmp := "__rill_tmp_insert_" + strings.ReplaceAll(uuid.New().String(), "-", "")
+	err = c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("CREATE TABLE %s AS %s", safeSQLName(tmp), safeSQLName(name)),
+		Priority: 1,
+	})
+	if err != nil {
+		return err
+	}
+	defer c.dropStagingTable(tmp)
+
+	err = c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("INSERT INTO %s %s", safeSQLName(tmp), sql),
+		Priority: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Delete the rows from the target table where the unique key is present in the staged data.
+	// We wait for the mutation to complete before inserting to avoid deleting the new rows.
+	keys := make([]string, len(uniqueKey))
+	for i, k := range uniqueKey {
+		keys[i] = safeSQLName(k)
+	}
+	keyTuple := "(" + strings.Join(keys, ", ") + ")"
+	err = c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("ALTER TABLE %s DELETE WHERE %s IN (SELECT %s FROM %s) SETTINGS mutations_sync = 2", safeSQLName(name), keyTuple, strings.Join(keys, ", "), safeSQLName(tmp)),
+		Priority: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Insert the staged data into the target table
+	return c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("INSERT INTO %s SELECT * FROM %s", safeSQLName(name), safeSQLName(tmp)),
+		Priority: 1,
+	})
+}
+
+// insertPartitionOverwrite inserts the output of sql into the table, atomically replacing the partitions present in the new data.
+// It stages the new data in a table with the same structure (and partition key) as the target table, and then uses REPLACE PARTITION for each staged partition.
+// It returns the partitions that were replaced.
+func (c *connection) insertPartitionOverwrite(ctx context.Context, name, sql string) ([]string, error) {
+	tmp := "__rill_tmp_insert_" + strings.ReplaceAll(uuid.New().String(), "-", "")
+	err := c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("CREATE TABLE %s AS %s", safeSQLName(tmp), safeSQLName(name)),
+		Priority: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer c.dropStagingTable(tmp)
+
+	err = c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("INSERT INTO %s %s", safeSQLName(tmp), sql),
+		Priority: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	partitions, err := c.tablePartitions(ctx, tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(partitions))
+	for i, p := range partitions {
+		err = c.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("ALTER TABLE %s REPLACE PARTITION ID '%s' FROM %s", safeSQLName(name), strings.ReplaceAll(p.id, "'", "''"), safeSQLName(tmp)),
+			Priority: 1,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to replace partition %q: %w", p.name, err)
+		}
+		names[i] = p.name
+	}
+
+	return names, nil
+}
+
+// tableEngine returns the engine and sorting key of a table in the current database.
+func (c *connection) tableEngine(ctx context.Context, name string) (string, string, error) {
+	res, err := c.Execute(ctx, &drivers.Statement{
+		Query:    "SELECT engine, sorting_key FROM system.tables WHERE database = currentDatabase() AND name = ?",
+		Args:     []any{name},
+		Priority: 1,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	defer res.Close()
+
+	var engine, sortingKey string
+	if res.Next() {
+		if err := res.Scan(&engine, &sortingKey); err != nil {
+			return "", "", err
+		}
+	}
+	if err := res.Err(); err != nil {
+		return "", "", err
+	}
+	if engine == "" {
+		return "", "", fmt.Errorf("table %q not found", name)
+	}
+	return engine, sortingKey, nil
+}
+
+// sortingKeyEquals returns true if the sorting key reported by system.tables consists of exactly the columns in uniqueKey (in any order).
+// Sorting keys that contain expressions never match since the engine would deduplicate on the expression and not the columns.
+func sortingKeyEquals(sortingKey string, uniqueKey []string) bool {
+	cols := strings.Split(sortingKey, ",")
+	if len(cols) != len(uniqueKey) {
+		return false
+	}
+	remaining := make(map[string]bool, len(uniqueKey))
+	for _, k := range uniqueKey {
+		remaining[k] = true
+	}
+	for _, col := range cols {
+		col = strings.Trim(strings.TrimSpace(col), "`\"")
+		if !remaining[col] {
+			return false
+		}
+		delete(remaining, col)
+	}
+	return len(remaining) == 0
+}
+
+// tablePartition identifies a partition of a table.
+type tablePartition struct {
+	id   string
+	name string
+}
+
+// tablePartitions returns the active partitions of a table in the current database.
+func (c *connection) tablePartitions(ctx context.Context, name string) ([]tablePartition, error) {
+	res, err := c.Execute(ctx, &drivers.Statement{
+		Query:    "SELECT partition_id, any(partition) FROM system.parts WHERE database = currentDatabase() AND table = ? AND active GROUP BY partition_id ORDER BY partition_id",
+		Args:     []any{name},
+		Priority: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	var partitions []tablePartition
+	for res.Next() {
+		var p tablePartition
+		if err := res.Scan(&p.id, &p.name); err != nil {
+			return nil, err
+		}
+		partitions = append(partitions, p)
+	}
+	return partitions, res.Err()
+}
+
+// dropStagingTable drops a staging table created during an incremental insert.
+// It uses a background context to ensure cleanup happens even if the insert was cancelled.
+func (c *connection) dropStagingTable(name string) {
+	err := c.Exec(context.Background(), &drivers.Statement{
+		Query:    fmt.Sprintf("DROP TABLE IF EXISTS %s", safeSQLName(name)),
+		Priority: 100,
+	})
+	if err != nil {
+		c.logger.Error("clickhouse: failed to drop staging table", zap.String("name", name), zap.Error(err))
+	}
 }
 
 // DropTable implements drivers.OLAPStore.
@@ -0,0 +1,168 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/clickhouse"
+	"go.uber.org/zap"
+)
+
+func TestInsertTableAsSelect(t *testing.T) {
+	if testing.Short() {
+		t.Skip("clickhouse: skipping test in short mode")
+	}
+
+	ctx := context.Background()
+	clickHouseContainer, err := clickhouse.RunContainer(ctx,
+		testcontainers.WithImage("clickhouse/clickhouse-server:latest"),
+		clickhouse.WithUsername("clickhouse"),
+		clickhouse.WithPassword("clickhouse"),
+		clickhouse.WithConfigFile("../../testruntime/testdata/clickhouse-config.xml"),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := clickHouseContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	host, err := clickHouseContainer.Host(ctx)
+	require.NoError(t, err)
+	port, err := clickHouseContainer.MappedPort(ctx, "9000/tcp")
+	require.NoError(t, err)
+
+	conn, err := driver{}.Open("default", map[string]any{"dsn": fmt.Sprintf("clickhouse://clickhouse:clickhouse@%v:%v", host, port.Port())}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	olap, ok := conn.AsOLAP("")
+	require.True(t, ok)
+
+	t.Run("testInsertMerge", func(t *testing.T) { testInsertMerge(t, olap) })
+	t.Run("testInsertMergeReplacing", func(t *testing.T) { testInsertMergeReplacing(t, olap) })
+	t.Run("testInsertMergeReplacingOtherSortingKey", func(t *testing.T) { testInsertMergeReplacingOtherSortingKey(t, olap) })
+	t.Run("testInsertMergeWithoutUniqueKey", func(t *testing.T) { testInsertMergeWithoutUniqueKey(t, olap) })
+	t.Run("testInsertPartitionOverwrite", func(t *testing.T) { testInsertPartitionOverwrite(t, olap) })
+}
+
+func testInsertMerge(t *testing.T, olap drivers.OLAPStore) {
+	ctx := context.Background()
+	err := olap.Exec(ctx, &drivers.Statement{
+		Query: "CREATE TABLE merge_tbl(id INTEGER, val VARCHAR) ENGINE = MergeTree ORDER BY id",
+	})
+	require.NoError(t, err)
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query: "INSERT INTO merge_tbl VALUES (1, 'a'), (2, 'b'), (3, 'c')",
+	})
+	require.NoError(t, err)
+
+	_, err = olap.InsertTableAsSelect(ctx, "merge_tbl", "SELECT * FROM VALUES('id INTEGER, val VARCHAR', (2, 'bb'), (4, 'd'))", &drivers.InsertTableOptions{
+		Strategy:  drivers.IncrementalStrategyMerge,
+		UniqueKey: []string{"id"},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"1:a", "2:bb", "3:c", "4:d"}, queryRows(t, olap, "SELECT id, val FROM merge_tbl ORDER BY id"))
+
+	// The staging table is dropped
+	require.Equal(t, []string{"staging:0"}, queryRows(t, olap, "SELECT 'staging', count() FROM system.tables WHERE database = currentDatabase() AND name LIKE '__rill_tmp_insert_%'"))
+}
+
+func testInsertMergeReplacing(t *testing.T, olap drivers.OLAPStore) {
+	ctx := context.Background()
+	err := olap.Exec(ctx, &drivers.Statement{
+		Query: "CREATE TABLE replacing_tbl(id INTEGER, val VARCHAR) ENGINE = ReplacingMergeTree ORDER BY id",
+	})
+	require.NoError(t, err)
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query: "INSERT INTO replacing_tbl VALUES (1, 'a'), (2, 'b')",
+	})
+	require.NoError(t, err)
+
+	_, err = olap.InsertTableAsSelect(ctx, "replacing_tbl", "SELECT * FROM VALUES('id INTEGER, val VARCHAR', (2, 'bb'), (3, 'c'))", &drivers.InsertTableOptions{
+		Strategy:  drivers.IncrementalStrategyMerge,
+		UniqueKey: []string{"id"},
+	})
+	require.NoError(t, err)
+
+	// The engine deduplicates rows with the same sorting key, keeping the last inserted row
+	require.Equal(t, []string{"1:a", "2:bb", "3:c"}, queryRows(t, olap, "SELECT id, val FROM replacing_tbl FINAL ORDER BY id"))
+}
+
+func testInsertMergeReplacingOtherSortingKey(t *testing.T, olap drivers.OLAPStore) {
+	ctx := context.Background()
+	err := olap.Exec(ctx, &drivers.Statement{
+		Query: "CREATE TABLE replacing_other_tbl(id INTEGER, val VARCHAR) ENGINE = ReplacingMergeTree ORDER BY (id, val)",
+	})
+	require.NoError(t, err)
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query: "INSERT INTO replacing_other_tbl VALUES (1, 'a'), (2, 'b')",
+	})
+	require.NoError(t, err)
+
+	_, err = olap.InsertTableAsSelect(ctx, "replacing_other_tbl", "SELECT * FROM VALUES('id INTEGER, val VARCHAR', (2, 'bb'), (3, 'c'))", &drivers.InsertTableOptions{
+		Strategy:  drivers.IncrementalStrategyMerge,
+		UniqueKey: []string{"id"},
+	})
+	require.NoError(t, err)
+
+	// The engine wouldn't deduplicate (2, 'b') and (2, 'bb') since the sorting key differs from the unique key, so the old row must be deleted
+	require.Equal(t, []string{"1:a", "2:bb", "3:c"}, queryRows(t, olap, "SELECT id, val FROM replacing_other_tbl FINAL ORDER BY id"))
+}
+
+func testInsertMergeWithoutUniqueKey(t *testing.T, olap drivers.OLAPStore) {
+	_, err := olap.InsertTableAsSelect(context.Background(), "merge_tbl", "SELECT 5, 'e'", &drivers.InsertTableOptions{
+		Strategy: drivers.IncrementalStrategyMerge,
+	})
+	require.ErrorContains(t, err, "requires a unique key")
+}
+
+func TestSortingKeyEquals(t *testing.T) {
+	require.True(t, sortingKeyEquals("id", []string{"id"}))
+	require.True(t, sortingKeyEquals("id, `user id`", []string{"user id", "id"}))
+	require.False(t, sortingKeyEquals("id, val", []string{"id"}))
+	require.False(t, sortingKeyEquals("id", []string{"id", "val"}))
+	require.False(t, sortingKeyEquals("toDate(ts)", []string{"ts"}))
+	require.False(t, sortingKeyEquals("", []string{"id"}))
+	require.False(t, sortingKeyEquals("id, id", []string{"id", "val"}))
+}
+
+func testInsertPartitionOverwrite(t *testing.T, olap drivers.OLAPStore) {
+	ctx := context.Background()
+	err := olap.Exec(ctx, &drivers.Statement{
+		Query: "CREATE TABLE partitioned_tbl(day UInt32, val INTEGER) ENGINE = MergeTree PARTITION BY day ORDER BY tuple()",
+	})
+	require.NoError(t, err)
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query: "INSERT INTO partitioned_tbl VALUES (20240101, 1), (20240101, 2), (20240102, 3), (20240103, 4)",
+	})
+	require.NoError(t, err)
+
+	res, err := olap.InsertTableAsSelect(ctx, "partitioned_tbl", "SELECT * FROM VALUES('day UInt32, val INTEGER', (20240102, 30), (20240102, 31), (20240104, 5))", &drivers.InsertTableOptions{
+		Strategy: drivers.IncrementalStrategyPartitionOverwrite,
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"20240102", "20240104"}, res.Partitions)
+
+	// Partitions absent from the new data are untouched
+	require.Equal(t, []string{"20240101:1", "20240101:2", "20240102:30", "20240102:31", "20240103:4", "20240104:5"}, queryRows(t, olap, "SELECT day, val FROM partitioned_tbl ORDER BY day, val"))
+}
+
+// queryRows runs a query with two result columns and returns its rows formatted as "col1:col2".
+func queryRows(t *testing.T, olap drivers.OLAPStore, query string) []string {
+	res, err := olap.Execute(context.Background(), &drivers.Statement{Query: query})
+	require.NoError(t, err)
+	defer res.Close()
+
+	var rows []string
+	for res.Next() {
+		var a, b any
+		require.NoError(t, res.Scan(&a, &b))
+		rows = append(rows, fmt.Sprintf("%v:%v", a, b))
+	}
+	require.NoError(t, res.Err())
+	return rows
+}
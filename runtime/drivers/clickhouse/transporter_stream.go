@@ -0,0 +1,102 @@
+package clickhouse
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+// streamSchemaSampleSize is the max number of bytes of a batch used to infer the table's schema.
+// It's inlined in the CREATE TABLE query, so it must be smaller than ClickHouse's max_query_size (256 KiB by default).
+const streamSchemaSampleSize = 128 * 1024
+
+type streamTransporter struct {
+	from   drivers.StreamStore
+	to     *connection
+	logger *zap.Logger
+}
+
+var _ drivers.Transporter = &streamTransporter{}
+
+func NewStreamTransporter(from drivers.StreamStore, olap *connection, logger *zap.Logger) drivers.Transporter {
+	return &streamTransporter{
+		from:   from,
+		to:     olap,
+		logger: logger,
+	}
+}
+
+// Transfer consumes the stream in batches and inserts each batch into the table.
+// If opts.State is nil, the table is replaced and its schema is inferred from the first batch.
+// Fields that are not in the table's schema are skipped.
+func (t *streamTransporter) Transfer(ctx context.Context, srcProps, sinkProps map[string]any, opts *drivers.TransferOptions) error {
+	sinkCfg, err := parseSinkProperties(sinkProps)
+	if err != nil {
+		return err
+	}
+
+	t.logger = t.logger.With(zap.String("source", sinkCfg.Table))
+
+	tableExists := false
+	if opts.State != nil {
+		_, err := t.to.InformationSchema().Lookup(ctx, "", "", sinkCfg.Table)
+		if err != nil && !errors.Is(err, drivers.ErrNotFound) {
+			return fmt.Errorf("failed to look up table: %w", err)
+		}
+		tableExists = err == nil
+	}
+
+	return t.from.ConsumeBatches(ctx, srcProps, opts.State, func(b *drivers.StreamBatch) error {
+		if b.Path != "" {
+			data, err := os.ReadFile(b.Path)
+			if err != nil {
+				return err
+			}
+
+			if !tableExists {
+				err = t.to.CreateTableAsSelect(ctx, sinkCfg.Table, false, fmt.Sprintf("SELECT * FROM format(JSONEachRow, %s) LIMIT 0", sqlString(schemaSample(data))))
+				if err != nil {
+					return fmt.Errorf("failed to create table: %w", err)
+				}
+				tableExists = true
+			}
+
+			// Inline data is streamed to the server, so it's not limited by max_query_size
+			err = t.to.Exec(ctx, &drivers.Statement{
+				Query:    fmt.Sprintf("INSERT INTO %s SETTINGS input_format_skip_unknown_fields = 1 FORMAT JSONEachRow\n%s", safeSQLName(sinkCfg.Table), data),
+				Priority: 1,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to insert batch: %w", err)
+			}
+			opts.Progress.Observe(b.Records, drivers.ProgressUnitRecord)
+		}
+
+		if opts.SetState != nil {
+			opts.SetState(b.State)
+		}
+		return nil
+	})
+}
+
+// schemaSample returns the leading complete lines of newline-delimited JSON data that fit within streamSchemaSampleSize.
+func schemaSample(data []byte) string {
+	var buf bytes.Buffer
+	s := bufio.NewScanner(bytes.NewReader(data))
+	s.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for s.Scan() {
+		line := s.Bytes()
+		if buf.Len() > 0 && buf.Len()+len(line)+1 > streamSchemaSampleSize {
+			break
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
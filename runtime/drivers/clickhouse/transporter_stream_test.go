@@ -0,0 +1,108 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/clickhouse"
+	"go.uber.org/zap"
+)
+
+func TestStreamTransporter(t *testing.T) {
+	if testing.Short() {
+		t.Skip("clickhouse: skipping test in short mode")
+	}
+
+	ctx := context.Background()
+	clickHouseContainer, err := clickhouse.RunContainer(ctx,
+		testcontainers.WithImage("clickhouse/clickhouse-server:latest"),
+		clickhouse.WithUsername("clickhouse"),
+		clickhouse.WithPassword("clickhouse"),
+		clickhouse.WithConfigFile("../../testruntime/testdata/clickhouse-config.xml"),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := clickHouseContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	host, err := clickHouseContainer.Host(ctx)
+	require.NoError(t, err)
+	port, err := clickHouseContainer.MappedPort(ctx, "9000/tcp")
+	require.NoError(t, err)
+
+	conn, err := driver{}.Open("default", map[string]any{"dsn": fmt.Sprintf("clickhouse://clickhouse:clickhouse@%v:%v", host, port.Port())}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	olap, _ := conn.AsOLAP("")
+
+	stream := &mockStreamStore{dir: t.TempDir()}
+	tr := NewStreamTransporter(stream, conn.(*connection), zap.NewNop())
+
+	// Without state, the table is created from the first batch
+	stream.batches = [][]string{{`{"id":1}`, `{"id":2}`}, {`{"id":3}`}}
+	err = tr.Transfer(ctx, nil, map[string]any{"table": "events"}, &drivers.TransferOptions{Progress: drivers.NoOpProgress{}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"3:0"}, queryRows(t, olap, "SELECT count(), 0 FROM events"))
+
+	// With state, new batches are appended to the existing table
+	stream.batches = [][]string{{`{"id":4}`}}
+	err = tr.Transfer(ctx, nil, map[string]any{"table": "events"}, &drivers.TransferOptions{Progress: drivers.NoOpProgress{}, State: map[string]any{"offset": 3}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"4:0"}, queryRows(t, olap, "SELECT count(), 0 FROM events"))
+
+	// With state but a missing table, the table is created
+	stream.batches = [][]string{{`{"id":5}`}}
+	err = tr.Transfer(ctx, nil, map[string]any{"table": "missing"}, &drivers.TransferOptions{Progress: drivers.NoOpProgress{}, State: map[string]any{"offset": 4}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1:0"}, queryRows(t, olap, "SELECT count(), 0 FROM missing"))
+
+	// If the table lookup fails, nothing is consumed
+	stream.batches = [][]string{{`{"id":6}`}}
+	stream.calls = 0
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = tr.Transfer(cctx, nil, map[string]any{"table": "events"}, &drivers.TransferOptions{Progress: drivers.NoOpProgress{}, State: map[string]any{"offset": 4}})
+	require.Error(t, err)
+	require.Equal(t, 0, stream.calls)
+	require.Equal(t, []string{"4:0"}, queryRows(t, olap, "SELECT count(), 0 FROM events"))
+}
+
+// mockStreamStore is a drivers.StreamStore that passes pre-defined batches of records to fn.
+type mockStreamStore struct {
+	dir     string
+	batches [][]string
+	calls   int
+}
+
+func (m *mockStreamStore) ConsumeBatches(ctx context.Context, props, state map[string]any, fn func(*drivers.StreamBatch) error) error {
+	m.calls++
+	for i, records := range m.batches {
+		path := filepath.Join(m.dir, fmt.Sprintf("batch_%d.ndjson", i))
+		var data []byte
+		for _, r := range records {
+			data = append(data, r...)
+			data = append(data, '\n')
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return err
+		}
+		err := fn(&drivers.StreamBatch{
+			Path:    path,
+			Records: int64(len(records)),
+			Bytes:   int64(len(data)),
+			State:   map[string]any{"batch": i},
+		})
+		_ = os.Remove(path)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
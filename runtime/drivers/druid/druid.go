@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/benbjohnson/clock"
 	"github.com/jmoiron/sqlx"
 	"github.com/mitchellh/mapstructure"
 	"github.com/rilldata/rill/runtime/drivers"
@@ -194,8 +195,10 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 
 	conn := &connection{
 		db:     db,
+		dsn:    dsn,
 		config: conf,
 		logger: logger,
+		clock:  clock.New(),
 	}
 	return conn, nil
 }
@@ -213,9 +216,13 @@ func (d *driver) TertiarySourceConnectors(ctx context.Context, src map[string]an
 }
 
 type connection struct {
-	db     *sqlx.DB
+	db *sqlx.DB
+	// dsn is the normalized SQL endpoint. Its host is also used for Druid's other HTTP APIs (see apiURL).
+	dsn    string
 	config *configProperties
 	logger *zap.Logger
+	// clock is used to poll the status of ingestion tasks. It's mocked in tests.
+	clock clock.Clock
 }
 
 // Driver implements drivers.Connection.
@@ -282,12 +289,15 @@ func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
 
 // AsModelExecutor implements drivers.Handle.
 func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	if opts.InputHandle == c && opts.OutputHandle == c {
+		return &selfToSelfExecutor{c, opts}, true
+	}
 	return nil, false
 }
 
 // AsModelManager implements drivers.Handle.
 func (c *connection) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
-	return nil, false
+	return c, true
 }
 
 // AsTransporter implements drivers.Connection.
@@ -0,0 +1,142 @@
+package druid
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+type selfToSelfExecutor struct {
+	c    *connection
+	opts *drivers.ModelExecutorOptions
+}
+
+var _ drivers.ModelExecutor = &selfToSelfExecutor{}
+
+func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult, error) {
+	inputProps := &ModelInputProperties{}
+	if err := mapstructure.WeakDecode(e.opts.InputProperties, inputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+	if err := inputProps.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid input properties: %w", err)
+	}
+
+	outputProps := &ModelOutputProperties{}
+	if err := mapstructure.WeakDecode(e.opts.OutputProperties, outputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse output properties: %w", err)
+	}
+	if err := outputProps.Validate(e.opts); err != nil {
+		return nil, fmt.Errorf("invalid output properties: %w", err)
+	}
+
+	usedModelName := false
+	if outputProps.Table == "" {
+		outputProps.Table = e.opts.ModelName
+		usedModelName = true
+	}
+	tableName := outputProps.Table
+
+	progress := e.opts.Progress
+	if progress == nil {
+		progress = drivers.NoOpProgress{}
+	}
+
+	// Build the ingestion statement.
+	// NOTE: We don't stage changes for Druid since REPLACE atomically swaps the segments of the datasource when the task completes.
+	var query string
+	var partitions []string
+	if !e.opts.IncrementalRun {
+		query = replaceAllSQL(tableName, inputProps.SQL, outputProps.PartitionedBy, outputProps.ClusteredBy)
+	} else {
+		switch outputProps.IncrementalStrategy {
+		case drivers.IncrementalStrategyAppend:
+			query = insertSQL(tableName, inputProps.SQL, outputProps.PartitionedBy, outputProps.ClusteredBy)
+		case drivers.IncrementalStrategyPartitionOverwrite:
+			start, end, ok, err := e.timeChunks(ctx, inputProps.SQL, outputProps.PartitionedBy)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve time chunks to replace: %w", err)
+			}
+			if ok {
+				where := fmt.Sprintf("__time >= TIMESTAMP '%s' AND __time < TIMESTAMP '%s'", start.Format(time.DateTime), end.Format(time.DateTime))
+				query = replaceWhereSQL(tableName, where, inputProps.SQL, outputProps.PartitionedBy, outputProps.ClusteredBy)
+				partitions = []string{fmt.Sprintf("%s/%s", start.Format(time.RFC3339), end.Format(time.RFC3339))}
+			}
+		default:
+			return nil, fmt.Errorf("unsupported incremental strategy %q", outputProps.IncrementalStrategy)
+		}
+	}
+
+	// Run the ingestion task. The query is empty if there's no new data to replace.
+	if query != "" {
+		err := e.c.runMSQTask(ctx, query, outputProps.Context, progress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ingest model: %w", err)
+		}
+	}
+
+	// Build result props
+	resultProps := &ModelResultProperties{
+		Table:         tableName,
+		PartitionedBy: outputProps.PartitionedBy,
+		ClusteredBy:   outputProps.ClusteredBy,
+		Context:       outputProps.Context,
+		UsedModelName: usedModelName,
+	}
+	resultPropsMap := map[string]interface{}{}
+	err := mapstructure.WeakDecode(resultProps, &resultPropsMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result properties: %w", err)
+	}
+
+	// Done
+	return &drivers.ModelResult{
+		Connector:  e.opts.OutputConnector,
+		Properties: resultPropsMap,
+		Table:      tableName,
+		Partitions: partitions,
+	}, nil
+}
+
+// timeChunks returns the interval of the time chunks (at the given segment granularity) that contain the rows returned by the query.
+// It returns false if the query returns no rows.
+func (e *selfToSelfExecutor) timeChunks(ctx context.Context, query, partitionedBy string) (time.Time, time.Time, bool, error) {
+	period := granularityPeriods[partitionedBy]
+	res, err := e.c.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT COUNT(*), TIME_FLOOR(MIN(__time), '%s'), TIME_SHIFT(TIME_FLOOR(MAX(__time), '%s'), '%s', 1) FROM (%s)", period, period, period, query),
+		Priority: e.opts.Priority,
+	})
+	if err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	defer res.Close()
+
+	// Druid returns sentinel values instead of NULL for MIN/MAX of empty inputs, so we check the count.
+	var count int64
+	var minTime, maxTime sql.NullString
+	if res.Next() {
+		if err := res.Scan(&count, &minTime, &maxTime); err != nil {
+			return time.Time{}, time.Time{}, false, err
+		}
+	}
+	if err := res.Err(); err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	if count == 0 || !minTime.Valid || !maxTime.Valid {
+		return time.Time{}, time.Time{}, false, nil
+	}
+
+	start, err := time.Parse(time.RFC3339, minTime.String)
+	if err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	end, err := time.Parse(time.RFC3339, maxTime.String)
+	if err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	return start.UTC(), end.UTC(), true, nil
+}
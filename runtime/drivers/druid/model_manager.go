@@ -0,0 +1,169 @@
+package druid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+type ModelInputProperties struct {
+	SQL string `mapstructure:"sql"`
+}
+
+func (p *ModelInputProperties) Validate() error {
+	if p.SQL == "" {
+		return fmt.Errorf("missing property 'sql'")
+	}
+	return nil
+}
+
+type ModelOutputProperties struct {
+	// Table is the name of the datasource to write to. Defaults to the model name.
+	Table               string                      `mapstructure:"table"`
+	IncrementalStrategy drivers.IncrementalStrategy `mapstructure:"incremental_strategy"`
+	// PartitionedBy is the segment granularity of the datasource. Defaults to DAY.
+	PartitionedBy string `mapstructure:"partitioned_by"`
+	// ClusteredBy lists columns to secondary partition segments by.
+	ClusteredBy []string `mapstructure:"clustered_by"`
+	// Context is passed through as the query context of the ingestion task (for example to set maxNumTasks).
+	Context map[string]any `mapstructure:"context"`
+}
+
+func (p *ModelOutputProperties) Validate(opts *drivers.ModelExecutorOptions) error {
+	switch p.IncrementalStrategy {
+	case drivers.IncrementalStrategyUnspecified:
+		p.IncrementalStrategy = drivers.IncrementalStrategyAppend
+	case drivers.IncrementalStrategyAppend, drivers.IncrementalStrategyPartitionOverwrite:
+	default:
+		return fmt.Errorf("invalid incremental strategy %q: druid supports %q and %q", p.IncrementalStrategy, drivers.IncrementalStrategyAppend, drivers.IncrementalStrategyPartitionOverwrite)
+	}
+
+	if p.PartitionedBy == "" {
+		p.PartitionedBy = "DAY"
+	}
+	p.PartitionedBy = strings.ToUpper(p.PartitionedBy)
+	if _, ok := granularityPeriods[p.PartitionedBy]; !ok {
+		return fmt.Errorf("invalid value %q for 'partitioned_by': must be one of HOUR, DAY, MONTH, YEAR or ALL", p.PartitionedBy)
+	}
+
+	if p.IncrementalStrategy == drivers.IncrementalStrategyPartitionOverwrite && p.PartitionedBy == "ALL" {
+		return fmt.Errorf(`cannot use "partitioned_by: ALL" when "incremental_strategy" is %q`, p.IncrementalStrategy)
+	}
+
+	return nil
+}
+
+// granularityPeriods maps the supported segment granularities to ISO 8601 periods.
+var granularityPeriods = map[string]string{
+	"HOUR":  "PT1H",
+	"DAY":   "P1D",
+	"MONTH": "P1M",
+	"YEAR":  "P1Y",
+	"ALL":   "",
+}
+
+type ModelResultProperties struct {
+	Table         string `mapstructure:"table"`
+	PartitionedBy string `mapstructure:"partitioned_by"`
+	// ClusteredBy and Context are retained so the datasource can be re-ingested with the same settings when it's renamed.
+	ClusteredBy   []string       `mapstructure:"clustered_by"`
+	Context       map[string]any `mapstructure:"context"`
+	UsedModelName bool           `mapstructure:"used_model_name"`
+}
+
+func (c *connection) Rename(ctx context.Context, res *drivers.ModelResult, newName string, env *drivers.ModelEnv) (*drivers.ModelResult, error) {
+	resProps := &ModelResultProperties{}
+	if err := mapstructure.WeakDecode(res.Properties, resProps); err != nil {
+		return nil, fmt.Errorf("failed to parse previous result properties: %w", err)
+	}
+
+	if !resProps.UsedModelName {
+		return res, nil
+	}
+
+	// Druid does not support renaming datasources, so we copy the data to the new datasource and drop the old one.
+	if resProps.PartitionedBy == "" {
+		resProps.PartitionedBy = "DAY"
+	}
+	sql := fmt.Sprintf("SELECT * FROM %s", safeName(resProps.Table))
+	err := c.runMSQTask(ctx, replaceAllSQL(newName, sql, resProps.PartitionedBy, resProps.ClusteredBy), resProps.Context, drivers.NoOpProgress{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename model: %w", err)
+	}
+
+	err = c.dropDatasource(ctx, resProps.Table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to drop renamed model: %w", err)
+	}
+
+	resProps.Table = newName
+	resPropsMap := map[string]interface{}{}
+	err = mapstructure.WeakDecode(resProps, &resPropsMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result properties: %w", err)
+	}
+
+	return &drivers.ModelResult{
+		Connector:  res.Connector,
+		Properties: resPropsMap,
+		Table:      newName,
+	}, nil
+}
+
+func (c *connection) Exists(ctx context.Context, res *drivers.ModelResult) (bool, error) {
+	_, err := c.InformationSchema().Lookup(ctx, "", "", res.Table)
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *connection) Delete(ctx context.Context, res *drivers.ModelResult) error {
+	return c.dropDatasource(ctx, res.Table)
+}
+
+// dropDatasource marks all segments of a datasource as unused, which removes it from queries.
+// The segments are permanently deleted by Druid's kill tasks (if enabled in the cluster).
+func (c *connection) dropDatasource(ctx context.Context, name string) error {
+	return c.sendAPIRequest(ctx, http.MethodDelete, fmt.Sprintf("/druid/coordinator/v1/datasources/%s", url.PathEscape(name)), nil, nil)
+}
+
+// replaceAllSQL builds an MSQ statement that replaces all data in the datasource with the result of sql.
+func replaceAllSQL(table, sql, partitionedBy string, clusteredBy []string) string {
+	return fmt.Sprintf("REPLACE INTO %s OVERWRITE ALL\n%s\n%s", safeName(table), sql, partitionClause(partitionedBy, clusteredBy))
+}
+
+// insertSQL builds an MSQ statement that appends the result of sql to the datasource.
+func insertSQL(table, sql, partitionedBy string, clusteredBy []string) string {
+	return fmt.Sprintf("INSERT INTO %s\n%s\n%s", safeName(table), sql, partitionClause(partitionedBy, clusteredBy))
+}
+
+// replaceWhereSQL builds an MSQ statement that replaces the time chunks matching the where clause with the result of sql.
+func replaceWhereSQL(table, where, sql, partitionedBy string, clusteredBy []string) string {
+	return fmt.Sprintf("REPLACE INTO %s OVERWRITE WHERE %s\n%s\n%s", safeName(table), where, sql, partitionClause(partitionedBy, clusteredBy))
+}
+
+func partitionClause(partitionedBy string, clusteredBy []string) string {
+	clause := "PARTITIONED BY " + partitionedBy
+	if len(clusteredBy) > 0 {
+		cols := make([]string, len(clusteredBy))
+		for i, col := range clusteredBy {
+			cols[i] = safeName(col)
+		}
+		clause += " CLUSTERED BY " + strings.Join(cols, ", ")
+	}
+	return clause
+}
+
+func safeName(name string) string {
+	return drivers.DialectDruid.EscapeIdentifier(name)
+}
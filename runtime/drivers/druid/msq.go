@@ -0,0 +1,201 @@
+package druid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+const (
+	msqPollInterval    = 2 * time.Second
+	msqShutdownTimeout = 10 * time.Second
+)
+
+// msqTaskStatus is the response of the overlord's task status API.
+type msqTaskStatus struct {
+	Task   string `json:"task"`
+	Status struct {
+		Status   string `json:"status"`
+		ErrorMsg string `json:"errorMsg"`
+	} `json:"status"`
+}
+
+// msqTaskReport is the subset of an MSQ task report that we use for progress reporting.
+type msqTaskReport struct {
+	MultiStageQuery struct {
+		Payload struct {
+			// Counters are keyed by stage number and then by worker number.
+			Counters map[string]map[string]struct {
+				SegmentGenerationProgress *struct {
+					RowsPushed int64 `json:"rowsPushed"`
+				} `json:"segmentGenerationProgress"`
+			} `json:"counters"`
+		} `json:"payload"`
+	} `json:"multiStageQuery"`
+}
+
+// rowsPushed returns the total number of rows that have been pushed to deep storage across all stages and workers.
+func (r *msqTaskReport) rowsPushed() int64 {
+	var n int64
+	for _, workers := range r.MultiStageQuery.Payload.Counters {
+		for _, w := range workers {
+			if w.SegmentGenerationProgress != nil {
+				n += w.SegmentGenerationProgress.RowsPushed
+			}
+		}
+	}
+	return n
+}
+
+// runMSQTask submits a SQL-based ingestion (MSQ) task to Druid and waits for it to complete.
+// It reports the number of rows pushed to the provided progress.
+// If ctx is cancelled before the task completes, the task is shut down.
+func (c *connection) runMSQTask(ctx context.Context, query string, queryContext map[string]any, progress drivers.Progress) error {
+	if c.config.LogQueries {
+		c.logger.Info("druid ingestion query", zap.String("sql", query))
+	}
+
+	taskContext := map[string]any{
+		// Wait for the new segments to be loaded on historicals so they are queryable once the task completes.
+		"waitUntilSegmentsLoad": true,
+	}
+	for k, v := range queryContext {
+		taskContext[k] = v
+	}
+
+	ticker := c.clock.Ticker(msqPollInterval)
+	defer ticker.Stop()
+
+	var submitRes struct {
+		TaskID string `json:"taskId"`
+	}
+	err := c.sendAPIRequest(ctx, http.MethodPost, "/druid/v2/sql/task", map[string]any{"query": query, "context": taskContext}, &submitRes)
+	if err != nil {
+		return fmt.Errorf("failed to submit ingestion task: %w", err)
+	}
+	taskID := submitRes.TaskID
+
+	var observed int64
+	for {
+		select {
+		case <-ctx.Done():
+			c.shutdownMSQTask(taskID)
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		// Report progress. Reports may not be available during the first few polls, so errors are ignored.
+		var report msqTaskReport
+		err = c.sendAPIRequest(ctx, http.MethodGet, fmt.Sprintf("/druid/indexer/v1/task/%s/reports", url.PathEscape(taskID)), nil, &report)
+		if err == nil {
+			if n := report.rowsPushed(); n > observed {
+				progress.Observe(n-observed, drivers.ProgressUnitRecord)
+				observed = n
+			}
+		}
+
+		var status msqTaskStatus
+		err = c.sendAPIRequest(ctx, http.MethodGet, fmt.Sprintf("/druid/indexer/v1/task/%s/status", url.PathEscape(taskID)), nil, &status)
+		if err != nil {
+			if ctx.Err() != nil {
+				continue
+			}
+			return fmt.Errorf("failed to get status of ingestion task %q: %w", taskID, err)
+		}
+
+		switch status.Status.Status {
+		case "SUCCESS":
+			return nil
+		case "FAILED":
+			return fmt.Errorf("ingestion task %q failed: %s", taskID, status.Status.ErrorMsg)
+		}
+	}
+}
+
+// shutdownMSQTask asks Druid to stop a running task.
+// It uses a separate context since it's usually called after the caller's context has been cancelled.
+func (c *connection) shutdownMSQTask(taskID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), msqShutdownTimeout)
+	defer cancel()
+
+	err := c.sendAPIRequest(ctx, http.MethodPost, fmt.Sprintf("/druid/indexer/v1/task/%s/shutdown", url.PathEscape(taskID)), nil, nil)
+	if err != nil {
+		c.logger.Warn("failed to shut down druid ingestion task", zap.String("task_id", taskID), zap.Error(err))
+	}
+}
+
+// sendAPIRequest sends a request to Druid's HTTP API through the router.
+// The body (if any) is encoded as JSON, and the response is decoded into out (if not nil).
+func (c *connection) sendAPIRequest(ctx context.Context, method, path string, body, out any) error {
+	reqURL, err := c.apiURL(path)
+	if err != nil {
+		return err
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		var errRes struct {
+			ErrorMessage string `json:"errorMessage"`
+		}
+		if json.Unmarshal(resBody, &errRes) == nil && errRes.ErrorMessage != "" {
+			return fmt.Errorf("druid request failed with status %d: %s", res.StatusCode, errRes.ErrorMessage)
+		}
+		return fmt.Errorf("druid request failed with status: %d", res.StatusCode)
+	}
+
+	if out != nil && len(resBody) > 0 {
+		return json.Unmarshal(resBody, out)
+	}
+	return nil
+}
+
+// apiURL returns the URL for the given path on the Druid router.
+// The path must already be escaped, so callers should escape datasource names and task IDs with url.PathEscape.
+// The router is the host of the SQL endpoint configured in the DSN. Credentials in the DSN are passed through as basic auth.
+func (c *connection) apiURL(path string) (string, error) {
+	u, err := url.Parse(c.dsn)
+	if err != nil {
+		return "", err
+	}
+	unescaped, err := url.PathUnescape(path)
+	if err != nil {
+		return "", err
+	}
+	u.Path = unescaped
+	u.RawPath = path
+	u.RawQuery = ""
+	return u.String(), nil
+}
@@ -0,0 +1,243 @@
+package druid
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestMSQStatements(t *testing.T) {
+	require.Equal(t, "REPLACE INTO \"tbl\" OVERWRITE ALL\nSELECT 1\nPARTITIONED BY DAY", replaceAllSQL("tbl", "SELECT 1", "DAY", nil))
+	require.Equal(t, "INSERT INTO \"tbl\"\nSELECT 1\nPARTITIONED BY HOUR CLUSTERED BY \"a\", \"b\"", insertSQL("tbl", "SELECT 1", "HOUR", []string{"a", "b"}))
+	require.Equal(t, "REPLACE INTO \"tbl\" OVERWRITE WHERE __time >= TIMESTAMP '2024-01-01 00:00:00'\nSELECT 1\nPARTITIONED BY DAY", replaceWhereSQL("tbl", "__time >= TIMESTAMP '2024-01-01 00:00:00'", "SELECT 1", "DAY", nil))
+}
+
+func TestModelOutputPropertiesValidate(t *testing.T) {
+	opts := &drivers.ModelExecutorOptions{Incremental: true}
+
+	p := &ModelOutputProperties{}
+	require.NoError(t, p.Validate(opts))
+	require.Equal(t, "DAY", p.PartitionedBy)
+	require.Equal(t, drivers.IncrementalStrategyAppend, p.IncrementalStrategy)
+
+	p = &ModelOutputProperties{PartitionedBy: "month", IncrementalStrategy: drivers.IncrementalStrategyPartitionOverwrite}
+	require.NoError(t, p.Validate(opts))
+	require.Equal(t, "MONTH", p.PartitionedBy)
+
+	p = &ModelOutputProperties{IncrementalStrategy: drivers.IncrementalStrategyMerge}
+	require.Error(t, p.Validate(opts))
+
+	p = &ModelOutputProperties{PartitionedBy: "ALL", IncrementalStrategy: drivers.IncrementalStrategyPartitionOverwrite}
+	require.Error(t, p.Validate(opts))
+
+	p = &ModelOutputProperties{PartitionedBy: "WEEKLY"}
+	require.Error(t, p.Validate(opts))
+}
+
+func TestRunMSQTask(t *testing.T) {
+	api := newMockTaskAPI(t)
+	mock := clock.NewMock()
+	c := &connection{dsn: api.srv.URL + "/druid/v2/sql", config: &configProperties{}, logger: zap.NewNop(), clock: mock}
+
+	run := func(ctx context.Context, query string, queryContext map[string]any, p drivers.Progress) <-chan error {
+		errCh := make(chan error, 1)
+		go func() { errCh <- c.runMSQTask(ctx, query, queryContext, p) }()
+		// The poll ticker is created before the task is submitted
+		<-api.submittedCh
+		return errCh
+	}
+
+	// Task succeeds after reporting progress
+	api.setStatus("RUNNING", 50)
+	p := &testProgress{}
+	errCh := run(context.Background(), "REPLACE INTO foo OVERWRITE ALL SELECT 1 PARTITIONED BY DAY", map[string]any{"maxNumTasks": 2}, p)
+	mock.Add(msqPollInterval)
+	require.Equal(t, "RUNNING", <-api.polledCh)
+	require.Equal(t, int64(50), p.get())
+	api.setStatus("SUCCESS", 100)
+	mock.Add(msqPollInterval)
+	require.Equal(t, "SUCCESS", <-api.polledCh)
+	require.NoError(t, <-errCh)
+	require.Equal(t, int64(100), p.get())
+	submitted := api.getSubmitted()
+	require.Equal(t, "REPLACE INTO foo OVERWRITE ALL SELECT 1 PARTITIONED BY DAY", submitted["query"])
+	require.Equal(t, true, submitted["context"].(map[string]any)["waitUntilSegmentsLoad"])
+	require.Equal(t, 2.0, submitted["context"].(map[string]any)["maxNumTasks"])
+
+	// Task fails
+	api.setStatus("FAILED", 0)
+	errCh = run(context.Background(), "SELECT 1", nil, drivers.NoOpProgress{})
+	mock.Add(msqPollInterval)
+	require.Equal(t, "FAILED", <-api.polledCh)
+	require.ErrorContains(t, <-errCh, "boom")
+
+	// Task is shut down when the ctx is cancelled
+	api.setStatus("RUNNING", 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh = run(ctx, "SELECT 1", nil, drivers.NoOpProgress{})
+	mock.Add(msqPollInterval)
+	require.Equal(t, "RUNNING", <-api.polledCh)
+	cancel()
+	require.ErrorIs(t, <-errCh, context.Canceled)
+	require.True(t, api.isShutdown())
+}
+
+func TestRename(t *testing.T) {
+	api := newMockTaskAPI(t)
+	mock := clock.NewMock()
+	c := &connection{dsn: api.srv.URL + "/druid/v2/sql", config: &configProperties{}, logger: zap.NewNop(), clock: mock}
+
+	res := &drivers.ModelResult{
+		Connector: "druid",
+		Properties: map[string]any{
+			"table":           "old/model",
+			"partitioned_by":  "HOUR",
+			"clustered_by":    []string{"a"},
+			"context":         map[string]any{"maxNumTasks": 2},
+			"used_model_name": true,
+		},
+		Table: "old/model",
+	}
+
+	api.setStatus("SUCCESS", 0)
+	type result struct {
+		res *drivers.ModelResult
+		err error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		res, err := c.Rename(context.Background(), res, "new", nil)
+		resCh <- result{res, err}
+	}()
+	<-api.submittedCh
+	mock.Add(msqPollInterval)
+	require.Equal(t, "SUCCESS", <-api.polledCh)
+	r := <-resCh
+	require.NoError(t, r.err)
+
+	// The data is copied with the same partitioning, clustering and query context
+	submitted := api.getSubmitted()
+	require.Equal(t, "REPLACE INTO \"new\" OVERWRITE ALL\nSELECT * FROM \"old/model\"\nPARTITIONED BY HOUR CLUSTERED BY \"a\"", submitted["query"])
+	require.Equal(t, 2.0, submitted["context"].(map[string]any)["maxNumTasks"])
+	require.Equal(t, "new", r.res.Table)
+	require.Equal(t, []string{"a"}, r.res.Properties["clustered_by"])
+
+	// The old datasource is dropped with its name escaped in the URL
+	require.Equal(t, "/druid/coordinator/v1/datasources/old%2Fmodel", api.getDropped())
+}
+
+type mockTaskAPI struct {
+	srv *httptest.Server
+	// submittedCh receives a value when a task is submitted
+	submittedCh chan struct{}
+	// polledCh receives the status returned for each status request
+	polledCh chan string
+
+	mu        sync.Mutex
+	status    string
+	rows      int64
+	submitted map[string]any
+	shutdown  bool
+	dropped   string
+}
+
+func newMockTaskAPI(t *testing.T) *mockTaskAPI {
+	api := &mockTaskAPI{
+		submittedCh: make(chan struct{}, 10),
+		polledCh:    make(chan string, 10),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /druid/v2/sql/task", func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		api.submitted = map[string]any{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&api.submitted))
+		_, _ = w.Write([]byte(`{"taskId":"query-1","state":"RUNNING"}`))
+		api.submittedCh <- struct{}{}
+	})
+	mux.HandleFunc("GET /druid/indexer/v1/task/query-1/status", func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]any{"task": "query-1", "status": map[string]any{"status": api.status, "errorMsg": "boom"}})
+		api.polledCh <- api.status
+	})
+	mux.HandleFunc("GET /druid/indexer/v1/task/query-1/reports", func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]any{"multiStageQuery": map[string]any{"payload": map[string]any{"counters": map[string]any{
+			"1": map[string]any{
+				"0": map[string]any{"segmentGenerationProgress": map[string]any{"rowsPushed": api.rows / 2}},
+				"1": map[string]any{"segmentGenerationProgress": map[string]any{"rowsPushed": api.rows - api.rows/2}},
+			},
+		}}}})
+	})
+	mux.HandleFunc("POST /druid/indexer/v1/task/query-1/shutdown", func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		api.shutdown = true
+	})
+
+	mux.HandleFunc("DELETE /druid/coordinator/v1/datasources/", func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		api.dropped = r.URL.EscapedPath()
+	})
+
+	api.srv = httptest.NewServer(mux)
+	t.Cleanup(api.srv.Close)
+	return api
+}
+
+func (a *mockTaskAPI) setStatus(status string, rows int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.status = status
+	a.rows = rows
+}
+
+func (a *mockTaskAPI) getSubmitted() map[string]any {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.submitted
+}
+
+func (a *mockTaskAPI) isShutdown() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.shutdown
+}
+
+func (a *mockTaskAPI) getDropped() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.dropped
+}
+
+type testProgress struct {
+	mu       sync.Mutex
+	observed int64
+}
+
+func (p *testProgress) Target(val int64, unit drivers.ProgressUnit) {}
+
+func (p *testProgress) Observe(val int64, unit drivers.ProgressUnit) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.observed += val
+}
+
+func (p *testProgress) get() int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.observed
+}
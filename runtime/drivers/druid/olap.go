@@ -31,18 +31,33 @@ func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName
 }
 
 // CreateTableAsSelect implements drivers.OLAPStore.
+// It runs a SQL-based ingestion task that replaces the datasource with the result of sql, partitioned by day.
 func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string) error {
-	return fmt.Errorf("druid: data transformation not yet supported")
+	if view {
+		return fmt.Errorf("druid: views are not supported")
+	}
+	return c.runMSQTask(ctx, replaceAllSQL(name, sql, "DAY", nil), nil, drivers.NoOpProgress{})
 }
 
 // InsertTableAsSelect implements drivers.OLAPStore.
-func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
-	return fmt.Errorf("druid: data transformation not yet supported")
+// It runs a SQL-based ingestion task that appends the result of sql to the datasource, partitioned by day.
+func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, opts *drivers.InsertTableOptions) (*drivers.InsertTableResult, error) {
+	if opts.Strategy != drivers.IncrementalStrategyUnspecified && opts.Strategy != drivers.IncrementalStrategyAppend {
+		return nil, fmt.Errorf("druid: incremental strategy %q is not supported", opts.Strategy)
+	}
+	err := c.runMSQTask(ctx, insertSQL(name, sql, "DAY", nil), nil, drivers.NoOpProgress{})
+	if err != nil {
+		return nil, err
+	}
+	return &drivers.InsertTableResult{}, nil
 }
 
 // DropTable implements drivers.OLAPStore.
 func (c *connection) DropTable(ctx context.Context, name string, view bool) error {
-	return fmt.Errorf("druid: data transformation not yet supported")
+	if view {
+		return fmt.Errorf("druid: views are not supported")
+	}
+	return c.dropDatasource(ctx, name)
 }
 
 // RenameTable implements drivers.OLAPStore.
@@ -388,6 +388,9 @@ func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecu
 				return &selfToFileExecutor{c, opts}, true
 			}
 		}
+		if objectStore, ok := opts.OutputHandle.AsObjectStore(); ok {
+			return &selfToObjectStoreExecutor{c, objectStore, opts}, true
+		}
 	}
 	return nil, false
 }
@@ -404,6 +407,9 @@ func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter
 		if from == to {
 			return NewDuckDBToDuckDB(olap, c.logger), true
 		}
+		if store, ok := from.(drivers.StreamStore); ok {
+			return NewStreamStoreToDuckDB(store, olap, c.logger), true
+		}
 		if from.Driver() == "motherduck" {
 			return NewMotherduckToDuckDB(from, olap, c.logger), true
 		}
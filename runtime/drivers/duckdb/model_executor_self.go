@@ -51,6 +51,7 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult,
 	asView := !materialize
 	tableName := outputProps.Table
 
+	var partitions []string
 	if !e.opts.IncrementalRun {
 		// Prepare for ingesting into the staging view/table.
 		// NOTE: This intentionally drops the end table if not staging changes.
@@ -78,10 +79,15 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult,
 		}
 	} else {
 		// Insert into the table
-		err := olap.InsertTableAsSelect(ctx, tableName, inputProps.SQL, false, false, outputProps.IncrementalStrategy, outputProps.UniqueKey)
+		res, err := olap.InsertTableAsSelect(ctx, tableName, inputProps.SQL, &drivers.InsertTableOptions{
+			Strategy:    outputProps.IncrementalStrategy,
+			UniqueKey:   outputProps.UniqueKey,
+			PartitionBy: outputProps.PartitionBy,
+		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to incrementally insert into table: %w", err)
 		}
+		partitions = res.Partitions
 	}
 
 	// Build result props
@@ -101,5 +107,6 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult,
 		Connector:  e.opts.OutputConnector,
 		Properties: resultPropsMap,
 		Table:      tableName,
+		Partitions: partitions,
 	}, nil
 }
@@ -0,0 +1,185 @@
+package duckdb
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	rillblob "github.com/rilldata/rill/runtime/drivers/blob"
+)
+
+// selfToObjectStoreExecutor writes the result of a DuckDB model to an object store (S3, GCS or Azure).
+// It exports the result to a local directory using COPY (optionally with PARTITION_BY) and then uploads the files using the output connector.
+type selfToObjectStoreExecutor struct {
+	c           *connection
+	objectStore drivers.ObjectStore
+	opts        *drivers.ModelExecutorOptions
+}
+
+var _ drivers.ModelExecutor = &selfToObjectStoreExecutor{}
+
+func (e *selfToObjectStoreExecutor) Execute(ctx context.Context) (*drivers.ModelResult, error) {
+	olap, ok := e.c.AsOLAP(e.c.instanceID)
+	if !ok {
+		return nil, fmt.Errorf("output connector is not OLAP")
+	}
+
+	inputProps := &ModelInputProperties{}
+	if err := mapstructure.WeakDecode(e.opts.InputProperties, inputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+	if err := inputProps.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid input properties: %w", err)
+	}
+
+	outputProps := &rillblob.ModelOutputProperties{}
+	if err := mapstructure.WeakDecode(e.opts.OutputProperties, outputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse output properties: %w", err)
+	}
+	if err := outputProps.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid output properties: %w", err)
+	}
+	if !supportsExportFormat(outputProps.Format) {
+		return nil, fmt.Errorf("unsupported output format %q", outputProps.Format)
+	}
+
+	resolvedPath, usedModelName := outputProps.ResolvedPath(e.opts.ModelName)
+
+	// Export the result to a local directory
+	dir, err := os.MkdirTemp("", "duckdb_export")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	sql, err := partitionedExportSQL(inputProps.SQL, dir, outputProps.Format, outputProps.PartitionBy)
+	if err != nil {
+		return nil, err
+	}
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query:    sql,
+		Args:     inputProps.Args,
+		Priority: e.opts.Priority,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	// Upload the files, replacing the data that is being replaced.
+	// NOTE: Incremental uploads are not atomic, so readers may observe a partially written result.
+	var partitions []string
+	if !e.opts.IncrementalRun {
+		err = e.objectStore.ReplaceFiles(ctx, dir, withPath(e.opts.OutputProperties, resolvedPath))
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload files: %w", err)
+		}
+	} else {
+		partitions, err = exportedPartitions(dir)
+		if err != nil {
+			return nil, err
+		}
+		if outputProps.IncrementalStrategy == drivers.IncrementalStrategyPartitionOverwrite {
+			for _, p := range partitions {
+				// The path is a URL, so it can't be joined with path.Join, which would remove the scheme's double slash
+				err = e.objectStore.ReplaceFiles(ctx, filepath.Join(dir, filepath.FromSlash(p)), withPath(e.opts.OutputProperties, resolvedPath+"/"+p))
+				if err != nil {
+					return nil, fmt.Errorf("failed to replace files in partition %q: %w", p, err)
+				}
+			}
+		} else {
+			err = e.objectStore.UploadFiles(ctx, dir, withPath(e.opts.OutputProperties, resolvedPath))
+			if err != nil {
+				return nil, fmt.Errorf("failed to upload files: %w", err)
+			}
+		}
+	}
+
+	// Build result props
+	resultProps := &rillblob.ModelResultProperties{
+		Path:          resolvedPath,
+		Format:        outputProps.Format,
+		PartitionBy:   outputProps.PartitionBy,
+		Region:        outputProps.Region,
+		Endpoint:      outputProps.Endpoint,
+		Account:       outputProps.Account,
+		UsedModelName: usedModelName,
+	}
+	resultPropsMap := map[string]interface{}{}
+	err = mapstructure.WeakDecode(resultProps, &resultPropsMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result properties: %w", err)
+	}
+	return &drivers.ModelResult{
+		Connector:  e.opts.OutputConnector,
+		Properties: resultPropsMap,
+		Partitions: partitions,
+	}, nil
+}
+
+// partitionedExportSQL returns a COPY statement that exports the query's result to files in dir.
+// The files have unique names, so exports to the same prefix (such as incremental runs) don't overwrite each other.
+// The names start with rillblob.ModelFileNamePrefix, which marks them as written by the model.
+func partitionedExportSQL(qry, dir, format string, partitionBy []string) (string, error) {
+	var opts string
+	switch format {
+	case "parquet":
+		opts = "FORMAT PARQUET"
+	case "csv":
+		opts = "FORMAT CSV, HEADER true"
+	case "json":
+		opts = "FORMAT JSON"
+	default:
+		return "", fmt.Errorf("duckdb: unsupported export format %q", format)
+	}
+
+	if len(partitionBy) == 0 {
+		target := filepath.Join(dir, fmt.Sprintf("%sdata_%s.%s", rillblob.ModelFileNamePrefix, uuid.NewString(), format))
+		return fmt.Sprintf("COPY (%s\n) TO %s (%s)", qry, safeSQLString(target), opts), nil
+	}
+
+	cols := make([]string, len(partitionBy))
+	for i, c := range partitionBy {
+		cols[i] = safeSQLName(c)
+	}
+	return fmt.Sprintf("COPY (%s\n) TO %s (%s, PARTITION_BY (%s), FILENAME_PATTERN '%sdata_{uuid}')", qry, safeSQLString(dir), opts, strings.Join(cols, ", "), rillblob.ModelFileNamePrefix), nil
+}
+
+// exportedPartitions returns the relative paths of the directories in dir that contain files, such as "year=2024/month=1".
+func exportedPartitions(dir string) ([]string, error) {
+	seen := make(map[string]bool)
+	var res []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, filepath.Dir(p))
+		if err != nil {
+			return err
+		}
+		if rel == "." || seen[rel] {
+			return nil
+		}
+		seen[rel] = true
+		res = append(res, filepath.ToSlash(rel))
+		return nil
+	})
+	return res, err
+}
+
+func withPath(props map[string]any, p string) map[string]any {
+	res := make(map[string]any, len(props))
+	for k, v := range props {
+		res[k] = v
+	}
+	res["path"] = p
+	return res
+}
@@ -0,0 +1,137 @@
+package duckdb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	rillblob "github.com/rilldata/rill/runtime/drivers/blob"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/fileblob"
+)
+
+// fileObjectStore is an object store backed by a local directory.
+type fileObjectStore struct {
+	dir string
+}
+
+func (s *fileObjectStore) open(ctx context.Context, props map[string]any) (*blob.Bucket, string, error) {
+	p, _ := props["path"].(string)
+	b, err := fileblob.OpenBucket(s.dir, nil)
+	return b, strings.TrimSuffix(strings.TrimPrefix(p, "s3://bucket/"), "/"), err
+}
+
+func (s *fileObjectStore) DownloadFiles(ctx context.Context, props map[string]any) (drivers.FileIterator, error) {
+	return nil, nil
+}
+
+func (s *fileObjectStore) UploadFiles(ctx context.Context, localDir string, props map[string]any) error {
+	return rillblob.UploadFiles(ctx, s.open, localDir, props)
+}
+
+func (s *fileObjectStore) ReplaceFiles(ctx context.Context, localDir string, props map[string]any) error {
+	return rillblob.ReplaceFiles(ctx, s.open, localDir, props)
+}
+
+func (s *fileObjectStore) DeleteFiles(ctx context.Context, props map[string]any) error {
+	return rillblob.DeleteFiles(ctx, s.open, props)
+}
+
+func (s *fileObjectStore) ResolveTable(ctx context.Context, props map[string]any) (*drivers.ObjectStoreTable, error) {
+	return rillblob.ResolveTableFiles(ctx, s.open, props)
+}
+
+func TestSelfToObjectStoreExecutor(t *testing.T) {
+	ctx := context.Background()
+	handle, err := Driver{}.Open("default", map[string]any{"data_dir": t.TempDir()}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer handle.Close()
+	c := handle.(*connection)
+
+	store := &fileObjectStore{dir: t.TempDir()}
+	bucket, err := fileblob.OpenBucket(store.dir, nil)
+	require.NoError(t, err)
+	defer bucket.Close()
+
+	listKeys := func() []string {
+		var keys []string
+		it := bucket.List(&blob.ListOptions{Prefix: "out/"})
+		for {
+			obj, err := it.Next(ctx)
+			if err != nil {
+				break
+			}
+			keys = append(keys, obj.Key)
+		}
+		return keys
+	}
+
+	execute := func(sql string, incrementalRun bool) *drivers.ModelResult {
+		e := &selfToObjectStoreExecutor{c: c, objectStore: store, opts: &drivers.ModelExecutorOptions{
+			ModelName:       "orders",
+			InputHandle:     c,
+			InputProperties: map[string]any{"sql": sql},
+			OutputConnector: "s3",
+			OutputProperties: map[string]any{
+				"path":                 "s3://bucket/out/",
+				"partition_by":         []string{"country"},
+				"incremental_strategy": "partition_overwrite",
+			},
+			Incremental:    true,
+			IncrementalRun: incrementalRun,
+		}}
+		res, err := e.Execute(ctx)
+		require.NoError(t, err)
+		return res
+	}
+
+	// DuckDB may write multiple files per partition, so files are grouped by partition directory
+	partitionKeys := func(keys []string, partition string) []string {
+		var res []string
+		for _, k := range keys {
+			if strings.HasPrefix(k, "out/orders/"+partition+"/") {
+				res = append(res, k)
+			}
+		}
+		return res
+	}
+
+	res := execute("SELECT * FROM (VALUES ('dk', 1), ('us', 2), ('us', 3)) t(country, n)", false)
+	require.Equal(t, "s3://bucket/out/orders", res.Properties["path"])
+	require.Equal(t, true, res.Properties["used_model_name"])
+	keys := listKeys()
+	dk := partitionKeys(keys, "country=dk")
+	us := partitionKeys(keys, "country=us")
+	require.NotEmpty(t, dk)
+	require.NotEmpty(t, us)
+	require.Len(t, keys, len(dk)+len(us))
+	require.True(t, strings.HasPrefix(dk[0], "out/orders/country=dk/rill_data_"))
+	require.True(t, strings.HasSuffix(dk[0], ".parquet"))
+
+	// Files that were not written by the model are never removed
+	require.NoError(t, bucket.WriteAll(ctx, "out/orders/country=us/notes.txt", []byte("keep"), nil))
+
+	// Overwrites the "us" partition and keeps the "dk" partition
+	res = execute("SELECT 'us' AS country, 4 AS n", true)
+	require.Equal(t, []string{"country=us"}, res.Partitions)
+	newKeys := listKeys()
+	require.Equal(t, dk, partitionKeys(newKeys, "country=dk"))
+	newUS := partitionKeys(newKeys, "country=us")
+	require.Len(t, newUS, 2)
+	require.Contains(t, newUS, "out/orders/country=us/notes.txt")
+	for _, k := range newUS {
+		require.NotContains(t, us, k)
+	}
+	require.Len(t, newKeys, len(dk)+2)
+
+	// A full run replaces all files written by the model
+	execute("SELECT 'se' AS country, 5 AS n", false)
+	keys = listKeys()
+	require.Len(t, keys, 2)
+	require.True(t, strings.HasPrefix(keys[0], "out/orders/country=se/"))
+	require.Equal(t, "out/orders/country=us/notes.txt", keys[1])
+}
@@ -36,6 +36,10 @@ func (e *sqlStoreToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelRes
 	if err := outputProps.Validate(e.opts); err != nil {
 		return nil, fmt.Errorf("invalid output properties: %w", err)
 	}
+	if outputProps.IncrementalStrategy == drivers.IncrementalStrategyPartitionOverwrite {
+		// The data is inserted in batches, so a partition spanning multiple batches would be overwritten by each batch.
+		return nil, fmt.Errorf("incremental strategy %q is not supported for models that read from %q", outputProps.IncrementalStrategy, e.opts.InputConnector)
+	}
 
 	usedModelName := false
 	if outputProps.Table == "" {
@@ -132,7 +136,11 @@ func (e *sqlStoreToSelfExecutor) queryAndInsert(ctx context.Context, olap driver
 		qry := fmt.Sprintf("SELECT * FROM %s", from)
 
 		if !create && e.opts.IncrementalRun {
-			err := olap.InsertTableAsSelect(ctx, outputTable, qry, false, true, outputProps.IncrementalStrategy, outputProps.UniqueKey)
+			_, err := olap.InsertTableAsSelect(ctx, outputTable, qry, &drivers.InsertTableOptions{
+				InPlace:   true,
+				Strategy:  outputProps.IncrementalStrategy,
+				UniqueKey: outputProps.UniqueKey,
+			})
 			if err != nil {
 				return fmt.Errorf("failed to incrementally insert into table: %w", err)
 			}
@@ -140,7 +148,10 @@ func (e *sqlStoreToSelfExecutor) queryAndInsert(ctx context.Context, olap driver
 		}
 
 		if !create {
-			err := olap.InsertTableAsSelect(ctx, outputTable, qry, false, true, drivers.IncrementalStrategyAppend, nil)
+			_, err := olap.InsertTableAsSelect(ctx, outputTable, qry, &drivers.InsertTableOptions{
+				InPlace:  true,
+				Strategy: drivers.IncrementalStrategyAppend,
+			})
 			if err != nil {
 				return fmt.Errorf("failed to insert into table: %w", err)
 			}
@@ -26,6 +26,8 @@ type ModelOutputProperties struct {
 	Materialize         *bool                       `mapstructure:"materialize"`
 	UniqueKey           []string                    `mapstructure:"unique_key"`
 	IncrementalStrategy drivers.IncrementalStrategy `mapstructure:"incremental_strategy"`
+	// PartitionBy is a SQL expression that identifies the partition of a row. It is required for the partition_overwrite incremental strategy.
+	PartitionBy string `mapstructure:"partition_by"`
 }
 
 func (p *ModelOutputProperties) Validate(opts *drivers.ModelExecutorOptions) error {
@@ -44,7 +46,7 @@ func (p *ModelOutputProperties) Validate(opts *drivers.ModelExecutorOptions) err
 	}
 
 	switch p.IncrementalStrategy {
-	case drivers.IncrementalStrategyUnspecified, drivers.IncrementalStrategyAppend, drivers.IncrementalStrategyMerge:
+	case drivers.IncrementalStrategyUnspecified, drivers.IncrementalStrategyAppend, drivers.IncrementalStrategyMerge, drivers.IncrementalStrategyPartitionOverwrite:
 	default:
 		return fmt.Errorf("invalid incremental strategy %q", p.IncrementalStrategy)
 	}
@@ -53,6 +55,10 @@ func (p *ModelOutputProperties) Validate(opts *drivers.ModelExecutorOptions) err
 		return fmt.Errorf(`must specify a "unique_key" when "incremental_strategy" is %q`, p.IncrementalStrategy)
 	}
 
+	if p.IncrementalStrategy == drivers.IncrementalStrategyPartitionOverwrite && p.PartitionBy == "" {
+		return fmt.Errorf(`must specify a "partition_by" when "incremental_strategy" is %q`, p.IncrementalStrategy)
+	}
+
 	if p.IncrementalStrategy == drivers.IncrementalStrategyUnspecified {
 		if len(p.UniqueKey) == 0 {
 			p.IncrementalStrategy = drivers.IncrementalStrategyAppend
@@ -3,6 +3,7 @@ package duckdb
 import (
 	"context"
 	dbsql "database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"io"
@@ -13,8 +14,10 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/apache/arrow/go/v14/arrow/array"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/marcboeker/go-duckdb"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/observability"
@@ -145,6 +148,40 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
 	}
 
+	// Use DuckDB's Arrow interface if the caller wants the result in Arrow format
+	if stmt.Arrow {
+		var rdr array.RecordReader
+		err = rawConn(conn.Conn, func(driverConn driver.Conn) error {
+			a, err := duckdb.NewArrowFromConn(driverConn)
+			if err != nil {
+				return err
+			}
+			rdr, err = a.QueryContext(ctx, stmt.Query, stmt.Args...)
+			return err
+		})
+		if err != nil {
+			if cancelFunc != nil {
+				cancelFunc()
+			}
+
+			// err must be checked before release
+			err = c.checkErr(err)
+			_ = release()
+			return nil, err
+		}
+
+		res = drivers.NewArrowResult(rdr, nil)
+		res.SetCleanupFunc(func() error {
+			if cancelFunc != nil {
+				cancelFunc()
+			}
+
+			return release()
+		})
+
+		return res, nil
+	}
+
 	rows, err := conn.QueryxContext(ctx, stmt.Query, stmt.Args...)
 	if err != nil {
 		if cancelFunc != nil {
@@ -356,28 +393,29 @@ func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view
 }
 
 // InsertTableAsSelect implements drivers.OLAPStore.
-func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
-	c.logger.Debug("insert table", zap.String("name", name), zap.Bool("byName", byName), zap.String("strategy", string(strategy)), zap.Strings("uniqueKey", uniqueKey))
+func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, opts *drivers.InsertTableOptions) (*drivers.InsertTableResult, error) {
+	c.logger.Debug("insert table", zap.String("name", name), zap.Bool("byName", opts.ByName), zap.String("strategy", string(opts.Strategy)), zap.Strings("uniqueKey", opts.UniqueKey), zap.String("partitionBy", opts.PartitionBy))
 
 	if !c.config.ExtTableStorage {
-		return c.execIncrementalInsert(ctx, safeSQLName(name), sql, byName, strategy, uniqueKey)
+		return c.execIncrementalInsert(ctx, safeSQLName(name), sql, opts)
 	}
 
-	if inPlace {
+	if opts.InPlace {
 		version, exist, err := c.tableVersion(name)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if !exist {
-			return fmt.Errorf("insert: table %q does not exist", name)
+			return nil, fmt.Errorf("insert: table %q does not exist", name)
 		}
 
 		db := dbName(name, version)
 		safeName := fmt.Sprintf("%s.default", safeSQLName(db))
 
-		return c.execIncrementalInsert(ctx, safeName, sql, byName, strategy, uniqueKey)
+		return c.execIncrementalInsert(ctx, safeName, sql, opts)
 	}
 
+	var res *drivers.InsertTableResult
 	var cleanupFunc func()
 	err := c.WithConnection(ctx, 1, true, false, func(ctx, ensuredCtx context.Context, _ *dbsql.Conn) error {
 		// Get current table version
@@ -410,7 +448,7 @@ func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string,
 
 		// Execute the insert
 		safeName := fmt.Sprintf("%s.default", safeSQLName(newDB))
-		err = c.execIncrementalInsert(ctx, safeName, sql, byName, strategy, uniqueKey)
+		res, err = c.execIncrementalInsert(ctx, safeName, sql, opts)
 		if err != nil {
 			cleanupFunc = func() { c.detachAndRemoveFile(newDB, newDBFile) }
 			return fmt.Errorf("insert: create %q.default table failed: %w", newDB, err)
@@ -444,7 +482,10 @@ func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string,
 	if cleanupFunc != nil {
 		cleanupFunc()
 	}
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // DropTable implements drivers.OLAPStore.
@@ -609,21 +650,25 @@ func (c *connection) RenameTable(ctx context.Context, oldName, newName string, v
 	return err
 }
 
-func (c *connection) execIncrementalInsert(ctx context.Context, safeName, sql string, byName bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
+func (c *connection) execIncrementalInsert(ctx context.Context, safeName, sql string, opts *drivers.InsertTableOptions) (*drivers.InsertTableResult, error) {
 	var byNameClause string
-	if byName {
+	if opts.ByName {
 		byNameClause = "BY NAME"
 	}
 
-	if strategy == drivers.IncrementalStrategyAppend {
-		return c.execWithLimits(ctx, &drivers.Statement{
+	if opts.Strategy == drivers.IncrementalStrategyAppend {
+		err := c.execWithLimits(ctx, &drivers.Statement{
 			Query:       fmt.Sprintf("INSERT INTO %s %s (%s\n)", safeName, byNameClause, sql),
 			Priority:    1,
 			LongRunning: true,
 		})
+		if err != nil {
+			return nil, err
+		}
+		return &drivers.InsertTableResult{}, nil
 	}
 
-	if strategy == drivers.IncrementalStrategyMerge {
+	if opts.Strategy == drivers.IncrementalStrategyMerge {
 		// Create a temporary table with the new data
 		tmp := uuid.New().String()
 		err := c.execWithLimits(ctx, &drivers.Statement{
@@ -632,12 +677,12 @@ func (c *connection) execIncrementalInsert(ctx context.Context, safeName, sql st
 			LongRunning: true,
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Drop the rows from the target table where the unique key is present in the temporary table
 		where := ""
-		for i, key := range uniqueKey {
+		for i, key := range opts.UniqueKey {
 			key = safeSQLName(key)
 			if i != 0 {
 				where += " AND "
@@ -650,18 +695,121 @@ func (c *connection) execIncrementalInsert(ctx context.Context, safeName, sql st
 			LongRunning: true,
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Insert the new data into the target table
-		return c.execWithLimits(ctx, &drivers.Statement{
-			Query:       fmt.Sprintf("INSERT INTO %s %s SELECT * FROM %s", safeName, byNameClause, safeSQLName(tmp)),
+		selectSQL := fmt.Sprintf("SELECT * FROM %s", safeSQLName(tmp))
+		if opts.DeleteMarker != "" {
+			marker := safeSQLName(opts.DeleteMarker)
+			selectSQL = fmt.Sprintf("SELECT * EXCLUDE (%s) FROM %s WHERE NOT %s", marker, safeSQLName(tmp), marker)
+		}
+		err = c.execWithLimits(ctx, &drivers.Statement{
+			Query:       fmt.Sprintf("INSERT INTO %s %s %s", safeName, byNameClause, selectSQL),
+			Priority:    1,
+			LongRunning: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &drivers.InsertTableResult{}, nil
+	}
+
+	if opts.Strategy == drivers.IncrementalStrategyPartitionOverwrite {
+		if opts.PartitionBy == "" {
+			return nil, fmt.Errorf("partition_overwrite strategy requires a partition expression")
+		}
+
+		// The temporary table and the transaction must use the same connection.
+		if connFromContext(ctx) != nil {
+			return c.execPartitionOverwrite(ctx, safeName, sql, byNameClause, opts.PartitionBy)
+		}
+		var res *drivers.InsertTableResult
+		err := c.WithConnection(ctx, 1, true, false, func(ctx, ensuredCtx context.Context, _ *dbsql.Conn) error {
+			var err error
+			res, err = c.execPartitionOverwrite(ctx, safeName, sql, byNameClause, opts.PartitionBy)
+			return err
+		})
+		return res, err
+	}
+
+	return nil, fmt.Errorf("incremental insert strategy %q not supported", opts.Strategy)
+}
+
+// execPartitionOverwrite replaces the partitions of the target table that are present in the output of sql.
+// The partitions are deleted and re-inserted inside a transaction, so readers never observe a partially overwritten partition.
+// It must be called with a connection in the ctx (see WithConnection).
+func (c *connection) execPartitionOverwrite(ctx context.Context, safeName, sql, byNameClause, partitionBy string) (*drivers.InsertTableResult, error) {
+	// Cleanup statements must run on the same connection even if ctx is cancelled
+	ensuredCtx := contextWithConn(context.Background(), connFromContext(ctx))
+
+	// Create a temporary table with the new data
+	tmp := safeSQLName(uuid.New().String())
+	err := c.execWithLimits(ctx, &drivers.Statement{
+		Query:       fmt.Sprintf("CREATE TEMPORARY TABLE %s AS (%s\n)", tmp, sql),
+		Priority:    1,
+		LongRunning: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = c.Exec(ensuredCtx, &drivers.Statement{Query: fmt.Sprintf("DROP TABLE IF EXISTS %s", tmp), Priority: 1})
+	}()
+
+	// Find the partitions present in the new data
+	rows, err := c.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT DISTINCT CAST((%s) AS VARCHAR) AS p FROM %s ORDER BY p NULLS FIRST", partitionBy, tmp),
+		Priority: 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve partitions: %w", err)
+	}
+	var partitions []string
+	for rows.Next() {
+		var p *string
+		if err := rows.Scan(&p); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		if p == nil {
+			partitions = append(partitions, "NULL")
+		} else {
+			partitions = append(partitions, *p)
+		}
+	}
+	err = rows.Err()
+	_ = rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete the overwritten partitions and insert the new data in a single transaction
+	err = c.Exec(ctx, &drivers.Statement{Query: "BEGIN TRANSACTION", Priority: 1})
+	if err != nil {
+		return nil, err
+	}
+	err = c.execWithLimits(ctx, &drivers.Statement{
+		Query:       fmt.Sprintf("DELETE FROM %s WHERE (%s) IN (SELECT DISTINCT (%s) FROM %s) OR ((%s) IS NULL AND EXISTS (SELECT 1 FROM %s WHERE (%s) IS NULL))", safeName, partitionBy, partitionBy, tmp, partitionBy, tmp, partitionBy),
+		Priority:    1,
+		LongRunning: true,
+	})
+	if err == nil {
+		err = c.execWithLimits(ctx, &drivers.Statement{
+			Query:       fmt.Sprintf("INSERT INTO %s %s SELECT * FROM %s", safeName, byNameClause, tmp),
 			Priority:    1,
 			LongRunning: true,
 		})
 	}
+	if err == nil {
+		err = c.Exec(ctx, &drivers.Statement{Query: "COMMIT", Priority: 1})
+	}
+	if err != nil {
+		_ = c.Exec(ensuredCtx, &drivers.Statement{Query: "ROLLBACK", Priority: 1})
+		return nil, err
+	}
 
-	return fmt.Errorf("incremental insert strategy %q not supported", strategy)
+	return &drivers.InsertTableResult{Partitions: partitions}, nil
 }
 
 func (c *connection) dropAndReplace(ctx context.Context, oldName, newName string, view bool) error {
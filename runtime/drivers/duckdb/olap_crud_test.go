@@ -183,10 +183,10 @@ func Test_connection_InsertTableAsSelect(t *testing.T) {
 	err = c.CreateTableAsSelect(context.Background(), "test-insert", false, "select 1")
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test-insert", "select 2", false, true, drivers.IncrementalStrategyAppend, nil)
+	_, err = c.InsertTableAsSelect(context.Background(), "test-insert", "select 2", &drivers.InsertTableOptions{InPlace: true, Strategy: drivers.IncrementalStrategyAppend})
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test-insert", "select 3", true, true, drivers.IncrementalStrategyAppend, nil)
+	_, err = c.InsertTableAsSelect(context.Background(), "test-insert", "select 3", &drivers.InsertTableOptions{ByName: true, InPlace: true, Strategy: drivers.IncrementalStrategyAppend})
 	require.Error(t, err)
 
 	res, err := c.Execute(context.Background(), &drivers.Statement{Query: "SELECT count(*) FROM 'test-insert'"})
@@ -198,6 +198,83 @@ func Test_connection_InsertTableAsSelect(t *testing.T) {
 	require.NoError(t, res.Close())
 }
 
+func Test_connection_InsertTableAsSelect_PartitionOverwrite(t *testing.T) {
+	for _, extTableStorage := range []bool{false, true} {
+		t.Run(fmt.Sprintf("external_table_storage=%v", extTableStorage), func(t *testing.T) {
+			temp := t.TempDir()
+
+			dbPath := filepath.Join(temp, "view.db")
+			handle, err := Driver{}.Open("default", map[string]any{"path": dbPath, "external_table_storage": extTableStorage}, activity.NewNoopClient(), zap.NewNop())
+			require.NoError(t, err)
+			c := handle.(*connection)
+			require.NoError(t, c.Migrate(context.Background()))
+			c.AsOLAP("default")
+
+			err = c.CreateTableAsSelect(context.Background(), "test-partition", false, "SELECT * FROM (VALUES ('a', 1), ('a', 2), ('b', 3), (NULL, 4)) t(p, v)")
+			require.NoError(t, err)
+
+			// Overwrite partition "a" and the NULL partition, and add partition "c"
+			res, err := c.InsertTableAsSelect(context.Background(), "test-partition", "SELECT * FROM (VALUES ('a', 10), ('c', 30), (NULL, 40)) t(p, v)", &drivers.InsertTableOptions{
+				InPlace:     true,
+				Strategy:    drivers.IncrementalStrategyPartitionOverwrite,
+				PartitionBy: "p",
+			})
+			require.NoError(t, err)
+			require.Equal(t, []string{"NULL", "a", "c"}, res.Partitions)
+
+			rows, err := c.Execute(context.Background(), &drivers.Statement{Query: "SELECT v FROM 'test-partition' ORDER BY v"})
+			require.NoError(t, err)
+			var vals []int
+			for rows.Next() {
+				var v int
+				require.NoError(t, rows.Scan(&v))
+				vals = append(vals, v)
+			}
+			require.NoError(t, rows.Close())
+			require.Equal(t, []int{3, 10, 30, 40}, vals)
+		})
+	}
+}
+
+func Test_connection_InsertTableAsSelect_MergeDeleteMarker(t *testing.T) {
+	for _, extTableStorage := range []bool{false, true} {
+		t.Run(fmt.Sprintf("external_table_storage=%v", extTableStorage), func(t *testing.T) {
+			temp := t.TempDir()
+
+			dbPath := filepath.Join(temp, "view.db")
+			handle, err := Driver{}.Open("default", map[string]any{"path": dbPath, "external_table_storage": extTableStorage}, activity.NewNoopClient(), zap.NewNop())
+			require.NoError(t, err)
+			c := handle.(*connection)
+			require.NoError(t, c.Migrate(context.Background()))
+			c.AsOLAP("default")
+
+			err = c.CreateTableAsSelect(context.Background(), "test-merge", false, "SELECT * FROM (VALUES (1, 'a'), (2, 'b'), (3, 'c')) t(k, v)")
+			require.NoError(t, err)
+
+			// Update key 1, delete key 2 and insert key 4. Deleting a missing key is a no-op.
+			_, err = c.InsertTableAsSelect(context.Background(), "test-merge", "SELECT * FROM (VALUES (1, 'x', false), (2, NULL, true), (4, 'd', false), (5, NULL, true)) t(k, v, deleted)", &drivers.InsertTableOptions{
+				ByName:       true,
+				InPlace:      true,
+				Strategy:     drivers.IncrementalStrategyMerge,
+				UniqueKey:    []string{"k"},
+				DeleteMarker: "deleted",
+			})
+			require.NoError(t, err)
+
+			rows, err := c.Execute(context.Background(), &drivers.Statement{Query: "SELECT k || v FROM 'test-merge' ORDER BY k"})
+			require.NoError(t, err)
+			var vals []string
+			for rows.Next() {
+				var v string
+				require.NoError(t, rows.Scan(&v))
+				vals = append(vals, v)
+			}
+			require.NoError(t, rows.Close())
+			require.Equal(t, []string{"1x", "3c", "4d"}, vals)
+		})
+	}
+}
+
 func Test_connection_RenameTable(t *testing.T) {
 	temp := t.TempDir()
 	os.Mkdir(temp, fs.ModePerm)
@@ -375,12 +452,12 @@ func Test_connection_InsertTableAsSelectLimits(t *testing.T) {
 	err = c.CreateTableAsSelect(context.Background(), "test-insert", false, "SELECT * from read_parquet('../../../web-local/tests/data/AdBids.parquet')")
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test-insert", "SELECT * from read_parquet('../../../web-local/tests/data/AdBids.parquet')", false, true, drivers.IncrementalStrategyAppend, nil)
+	_, err = c.InsertTableAsSelect(context.Background(), "test-insert", "SELECT * from read_parquet('../../../web-local/tests/data/AdBids.parquet')", &drivers.InsertTableOptions{InPlace: true, Strategy: drivers.IncrementalStrategyAppend})
 	if err != nil {
 		require.ErrorIs(t, err, drivers.ErrStorageLimitExceeded)
 	}
 
-	err = c.InsertTableAsSelect(context.Background(), "test-insert", "SELECT * from read_parquet('../../../web-local/tests/data/AdBids.parquet')", false, true, drivers.IncrementalStrategyAppend, nil)
+	_, err = c.InsertTableAsSelect(context.Background(), "test-insert", "SELECT * from read_parquet('../../../web-local/tests/data/AdBids.parquet')", &drivers.InsertTableOptions{InPlace: true, Strategy: drivers.IncrementalStrategyAppend})
 	require.ErrorIs(t, err, drivers.ErrStorageLimitExceeded)
 }
 
@@ -398,16 +475,16 @@ func Test_connection_CastEnum(t *testing.T) {
 	err = c.CreateTableAsSelect(context.Background(), "test", false, "SELECT 1 AS id, 'bglr' AS city, 'IND' AS country")
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 2, 'mUm', 'IND'", false, true, drivers.IncrementalStrategyAppend, nil)
+	_, err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 2, 'mUm', 'IND'", &drivers.InsertTableOptions{InPlace: true, Strategy: drivers.IncrementalStrategyAppend})
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 3, 'Perth', 'Aus'", false, true, drivers.IncrementalStrategyAppend, nil)
+	_, err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 3, 'Perth', 'Aus'", &drivers.InsertTableOptions{InPlace: true, Strategy: drivers.IncrementalStrategyAppend})
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 3, null, 'Aus'", false, true, drivers.IncrementalStrategyAppend, nil)
+	_, err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 3, null, 'Aus'", &drivers.InsertTableOptions{InPlace: true, Strategy: drivers.IncrementalStrategyAppend})
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 3, 'bglr', null", false, true, drivers.IncrementalStrategyAppend, nil)
+	_, err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 3, 'bglr', null", &drivers.InsertTableOptions{InPlace: true, Strategy: drivers.IncrementalStrategyAppend})
 	require.NoError(t, err)
 
 	err = c.convertToEnum(context.Background(), "test", []string{"city", "country"})
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +36,30 @@ func TestQuery(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestQueryArrow(t *testing.T) {
+	conn := prepareConn(t)
+	olap, _ := conn.AsOLAP("")
+
+	res, err := olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT bar, baz FROM foo ORDER BY baz", Arrow: true})
+	require.NoError(t, err)
+	require.Len(t, res.Schema.Fields, 2)
+	require.Equal(t, runtimev1.Type_CODE_STRING, res.Schema.Fields[0].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_INT32, res.Schema.Fields[1].Type.Code)
+
+	rdr, err := res.ArrowRecordReader(0)
+	require.NoError(t, err)
+	var count int64
+	for rdr.Next() {
+		count += rdr.Record().NumRows()
+	}
+	require.NoError(t, rdr.Err())
+	require.Equal(t, int64(4), count)
+	require.NoError(t, res.Close())
+
+	err = conn.Close()
+	require.NoError(t, err)
+}
+
 func TestPriorityQueue(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/rilldata/rill/runtime/drivers"
+	rillblob "github.com/rilldata/rill/runtime/drivers/blob"
 	"github.com/rilldata/rill/runtime/pkg/duckdbsql"
 	"github.com/rilldata/rill/runtime/pkg/fileutil"
 	"github.com/rilldata/rill/runtime/pkg/observability"
@@ -45,7 +47,21 @@ func (t *objectStoreToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps
 		return err
 	}
 
-	iterator, err := t.from.DownloadFiles(ctx, srcProps)
+	// Incremental sources only download the files that are new since the previous ingestion and append them to the table
+	var iterator drivers.FileIterator
+	var download *drivers.IncrementalDownload
+	if srcCfg.Incremental {
+		store, ok := t.from.(drivers.IncrementalObjectStore)
+		if !ok {
+			return errors.New("the source connector does not support incremental ingestion")
+		}
+		if srcCfg.SQL != "" {
+			return errors.New("property 'incremental' can't be combined with 'sql'")
+		}
+		iterator, download, err = store.DownloadNewFiles(ctx, srcProps, opts.State)
+	} else {
+		iterator, err = t.from.DownloadFiles(ctx, srcProps)
+	}
 	if err != nil {
 		return err
 	}
@@ -62,19 +78,39 @@ func (t *objectStoreToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps
 	}
 
 	opts.Progress.Target(size, drivers.ProgressUnitByte)
-	appendToTable := false
+	appendToTable := download != nil && download.Append
 	var format string
-	if srcCfg.Format != "" {
+	if rillblob.IsTableFormat(srcCfg.Format) {
+		// The iterator only returns the table's live data files, which are parquet files.
+		// The schema of a table's files may differ after schema evolution, so they are unified by name.
+		format = ".parquet"
+		if _, ok := srcCfg.DuckDB["union_by_name"]; !ok {
+			srcCfg.DuckDB["union_by_name"] = true
+		}
+		// Partition columns that are not stored in the data files are added from the table's metadata instead of the file paths.
+		if _, ok := srcCfg.DuckDB["hive_partitioning"]; !ok {
+			srcCfg.DuckDB["hive_partitioning"] = false
+		}
+	} else if srcCfg.Format != "" {
 		format = fmt.Sprintf(".%s", srcCfg.Format)
 	}
 
+	reader := func(files []string) (string, error) {
+		return sourceReader(files, format, srcCfg.DuckDB)
+	}
+	if tableIt, ok := iterator.(drivers.TableFileIterator); ok && len(tableIt.PartitionColumns()) > 0 {
+		reader = func(files []string) (string, error) {
+			return partitionedSourceReader(tableIt, files, srcCfg.DuckDB)
+		}
+	}
+
 	if srcCfg.AllowSchemaRelaxation {
 		// set union_by_name to unify the schema of the files
 		srcCfg.DuckDB["union_by_name"] = true
 	}
 
 	a := newAppender(t.to, sinkCfg, srcCfg.AllowSchemaRelaxation, t.logger, func(files []string) (string, error) {
-		from, err := sourceReader(files, format, srcCfg.DuckDB)
+		from, err := reader(files)
 		if err != nil {
 			return "", err
 		}
@@ -101,7 +137,7 @@ func (t *objectStoreToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps
 				return err
 			}
 		} else {
-			from, err := sourceReader(files, format, srcCfg.DuckDB)
+			from, err := reader(files)
 			if err != nil {
 				return err
 			}
@@ -120,7 +156,14 @@ func (t *objectStoreToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps
 	// convert to enum
 	if len(srcCfg.CastToENUM) > 0 {
 		conn, _ := t.to.(*connection)
-		return conn.convertToEnum(ctx, sinkCfg.Table, srcCfg.CastToENUM)
+		err = conn.convertToEnum(ctx, sinkCfg.Table, srcCfg.CastToENUM)
+		if err != nil {
+			return err
+		}
+	}
+
+	if download != nil && opts.SetState != nil {
+		opts.SetState(download.State)
 	}
 	return nil
 }
@@ -189,6 +232,73 @@ func (t *objectStoreToDuckDB) ingestDuckDBSQL(ctx context.Context, originalSQL s
 	return nil
 }
 
+// partitionedSourceReader reads the parquet data files of a table with partition columns that are not stored in the files.
+// The partition values of each file are added as constant columns, and files with the same values are read together.
+func partitionedSourceReader(it drivers.TableFileIterator, files []string, props map[string]any) (string, error) {
+	cols := it.PartitionColumns()
+	var keys []string
+	groups := make(map[string][]string)
+	for _, f := range files {
+		values := it.PartitionValues(f)
+		exprs := make([]string, len(cols))
+		for i, c := range cols {
+			exprs[i] = fmt.Sprintf("%s AS %s", partitionValueExpr(values[c.Name], c.Type), safeName(c.Name))
+		}
+		key := strings.Join(exprs, ", ")
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], f)
+	}
+
+	selects := make([]string, len(keys))
+	for i, key := range keys {
+		from, err := sourceReader(groups[key], ".parquet", props)
+		if err != nil {
+			return "", err
+		}
+		selects[i] = fmt.Sprintf("SELECT *, %s FROM %s", key, from)
+	}
+	return fmt.Sprintf("(%s)", strings.Join(selects, " UNION ALL BY NAME ")), nil
+}
+
+var deltaDecimalRegex = regexp.MustCompile(`^decimal\((\d+),\s*(\d+)\)$`)
+
+// partitionValueExpr returns a DuckDB expression for a Delta partition value, which is serialized as a string in the Delta log.
+func partitionValueExpr(v *string, deltaType string) string {
+	typ := "VARCHAR"
+	switch deltaType {
+	case "long":
+		typ = "BIGINT"
+	case "integer":
+		typ = "INTEGER"
+	case "short":
+		typ = "SMALLINT"
+	case "byte":
+		typ = "TINYINT"
+	case "float":
+		typ = "FLOAT"
+	case "double":
+		typ = "DOUBLE"
+	case "boolean":
+		typ = "BOOLEAN"
+	case "date":
+		typ = "DATE"
+	case "timestamp":
+		typ = "TIMESTAMPTZ"
+	case "timestamp_ntz":
+		typ = "TIMESTAMP"
+	default:
+		if m := deltaDecimalRegex.FindStringSubmatch(deltaType); m != nil {
+			typ = fmt.Sprintf("DECIMAL(%s,%s)", m[1], m[2])
+		}
+	}
+	if v == nil {
+		return fmt.Sprintf("CAST(NULL AS %s)", typ)
+	}
+	return fmt.Sprintf("CAST('%s' AS %s)", strings.ReplaceAll(*v, "'", "''"), typ)
+}
+
 type appender struct {
 	to                    drivers.OLAPStore
 	sink                  *sinkProperties
@@ -215,7 +325,11 @@ func (a *appender) appendData(ctx context.Context, files []string) error {
 		return err
 	}
 
-	err = a.to.InsertTableAsSelect(ctx, a.sink.Table, sql, a.allowSchemaRelaxation, true, drivers.IncrementalStrategyAppend, nil)
+	_, err = a.to.InsertTableAsSelect(ctx, a.sink.Table, sql, &drivers.InsertTableOptions{
+		ByName:   a.allowSchemaRelaxation,
+		InPlace:  true,
+		Strategy: drivers.IncrementalStrategyAppend,
+	})
 	if err == nil || !a.allowSchemaRelaxation || !containsAny(err.Error(), []string{"binder error", "conversion error"}) {
 		return err
 	}
@@ -226,7 +340,12 @@ func (a *appender) appendData(ctx context.Context, files []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to update schema %w", err)
 	}
-	return a.to.InsertTableAsSelect(ctx, a.sink.Table, sql, true, true, drivers.IncrementalStrategyAppend, nil)
+	_, err = a.to.InsertTableAsSelect(ctx, a.sink.Table, sql, &drivers.InsertTableOptions{
+		ByName:   true,
+		InPlace:  true,
+		Strategy: drivers.IncrementalStrategyAppend,
+	})
+	return err
 }
 
 // updateSchema updates the schema of the table in case new file adds a new column or
@@ -3,6 +3,7 @@ package duckdb
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"testing"
 
 	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
@@ -90,3 +91,113 @@ func allDataTypesTest(t *testing.T, db *sql.DB, dbURL string) {
 	require.NoError(t, res.Close())
 	require.NoError(t, to.Close())
 }
+
+func TestTransferChanges(t *testing.T) {
+	pg := pgtestcontainer.NewWithSettings(t, "wal_level=logical")
+	defer pg.Terminate(t)
+
+	ctx := context.Background()
+	db, err := sql.Open("pgx", pg.DatabaseURL)
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = db.ExecContext(ctx, `
+		CREATE TABLE orders (id integer PRIMARY KEY, status text, amount numeric, tags text[], payload bytea);
+		INSERT INTO orders VALUES (1, 'new', 10.5, '{a}', '\x00'), (2, 'new', 20, NULL, NULL), (3, 'new', 30, NULL, NULL);
+	`)
+	require.NoError(t, err)
+
+	handle, err := drivers.Open("postgres", "default", map[string]any{"database_url": pg.DatabaseURL}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	sqlStore, _ := handle.AsSQLStore()
+	to, err := drivers.Open("duckdb", "default", map[string]any{"dsn": ":memory:"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer to.Close()
+	olap, _ := to.AsOLAP("")
+
+	srcProps := map[string]any{"table": "orders", "cdc": true}
+	var state map[string]any
+	transfer := func() {
+		tr := NewSQLStoreToDuckDB(sqlStore, olap, zap.NewNop())
+		err := tr.Transfer(ctx, srcProps, map[string]any{"table": "sink"}, &drivers.TransferOptions{
+			Progress: drivers.NoOpProgress{},
+			State:    state,
+			SetState: func(s map[string]any) { state = s },
+		})
+		require.NoError(t, err)
+	}
+	rows := func() []string {
+		res, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT id || ':' || status FROM sink ORDER BY id"})
+		require.NoError(t, err)
+		defer res.Close()
+		var rows []string
+		for res.Next() {
+			var row string
+			require.NoError(t, res.Scan(&row))
+			rows = append(rows, row)
+		}
+		return rows
+	}
+
+	// Snapshot
+	transfer()
+	require.NotNil(t, state)
+	require.Regexp(t, `^rill_orders_[0-9a-f]{12}$`, state["slot"])
+	require.Equal(t, []string{"1:new", "2:new", "3:new"}, rows())
+
+	// Changes, including several changes to the same row in one batch
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO orders VALUES (4, 'new', 40, '{a,"b c"}', '\x01ff');
+		UPDATE orders SET status = 'shipped' WHERE id = 1;
+		UPDATE orders SET status = 'delivered' WHERE id = 1;
+		DELETE FROM orders WHERE id = 2;
+		UPDATE orders SET id = 5 WHERE id = 3;
+	`)
+	require.NoError(t, err)
+	transfer()
+	require.Equal(t, []string{"1:delivered", "4:new", "5:new"}, rows())
+
+	// Arrays and binary values are ingested like in the snapshot
+	res, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT id, CAST(tags AS VARCHAR), hex(payload) FROM sink WHERE id IN (1, 4) ORDER BY id"})
+	require.NoError(t, err)
+	var values []string
+	for res.Next() {
+		var id int
+		var tags, payload string
+		require.NoError(t, res.Scan(&id, &tags, &payload))
+		values = append(values, fmt.Sprintf("%d:%s:%s", id, tags, payload))
+	}
+	require.NoError(t, res.Close())
+	require.Equal(t, []string{`1:["a"]:00`, `4:["a","b c"]:01FF`}, values)
+
+	// No changes
+	transfer()
+	require.Equal(t, []string{"1:delivered", "4:new", "5:new"}, rows())
+
+	// Stopping drops the replication slot and the publication
+	require.NoError(t, handle.(drivers.ChangeStore).StopChanges(ctx, srcProps, state))
+	var slots, publications int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM pg_replication_slots").Scan(&slots))
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM pg_publication").Scan(&publications))
+	require.Equal(t, 0, slots)
+	require.Equal(t, 0, publications)
+
+	// A slot that already exists is not dropped or reused
+	_, err = db.ExecContext(ctx, "SELECT pg_create_logical_replication_slot('external_slot', 'pgoutput')")
+	require.NoError(t, err)
+	_, err = handle.(drivers.ChangeStore).StartChanges(ctx, map[string]any{"table": "orders", "cdc": true, "slot_name": "external_slot"})
+	require.ErrorContains(t, err, "already exists")
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM pg_replication_slots WHERE slot_name = 'external_slot'").Scan(&slots))
+	require.Equal(t, 1, slots)
+
+	// A publication that already exists is not dropped
+	_, err = db.ExecContext(ctx, "CREATE PUBLICATION external_pub FOR TABLE orders")
+	require.NoError(t, err)
+	props := map[string]any{"table": "orders", "cdc": true, "publication": "external_pub"}
+	state, err = handle.(drivers.ChangeStore).StartChanges(ctx, props)
+	require.NoError(t, err)
+	require.NoError(t, handle.(drivers.ChangeStore).StopChanges(ctx, props, state))
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM pg_replication_slots WHERE slot_name <> 'external_slot'").Scan(&slots))
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM pg_publication WHERE pubname = 'external_pub'").Scan(&publications))
+	require.Equal(t, 0, slots)
+	require.Equal(t, 1, publications)
+}
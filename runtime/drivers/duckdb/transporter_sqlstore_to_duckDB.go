@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/marcboeker/go-duckdb"
@@ -23,6 +24,9 @@ const (
 	_batchSize                 = 10000
 )
 
+// changeDeleteMarker is the column that marks deleted rows when applying changes from a drivers.ChangeStore.
+const changeDeleteMarker = "_rill_cdc_delete"
+
 type sqlStoreToDuckDB struct {
 	to     drivers.OLAPStore
 	from   drivers.SQLStore
@@ -47,6 +51,10 @@ func (s *sqlStoreToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps map
 
 	s.logger = s.logger.With(zap.String("source", sinkCfg.Table))
 
+	if from, ok := s.from.(drivers.ChangeStore); ok && drivers.CaptureChanges(srcProps) {
+		return s.transferChanges(ctx, from, srcProps, sinkCfg.Table, opts)
+	}
+
 	rowIter, err := s.from.Query(ctx, srcProps)
 	if err != nil {
 		if !errors.Is(err, drivers.ErrNotImplemented) {
@@ -106,7 +114,10 @@ func (s *sqlStoreToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps map
 			err = s.to.CreateTableAsSelect(ctx, sinkCfg.Table, false, fmt.Sprintf("SELECT * FROM %s", from))
 			create = false
 		} else {
-			err = s.to.InsertTableAsSelect(ctx, sinkCfg.Table, fmt.Sprintf("SELECT * FROM %s", from), false, true, drivers.IncrementalStrategyAppend, nil)
+			_, err = s.to.InsertTableAsSelect(ctx, sinkCfg.Table, fmt.Sprintf("SELECT * FROM %s", from), &drivers.InsertTableOptions{
+				InPlace:  true,
+				Strategy: drivers.IncrementalStrategyAppend,
+			})
 		}
 		if err != nil {
 			return err
@@ -115,6 +126,130 @@ func (s *sqlStoreToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps map
 	return nil
 }
 
+// transferChanges ingests a snapshot of the source table and then applies the changes captured after it.
+// If opts.State is set and the table exists, only the changes after opts.State are applied.
+func (s *sqlStoreToDuckDB) transferChanges(ctx context.Context, from drivers.ChangeStore, srcProps map[string]any, table string, opts *drivers.TransferOptions) error {
+	state := opts.State
+	if state != nil {
+		if _, err := s.to.InformationSchema().Lookup(ctx, "", "", table); err != nil {
+			state = nil
+		}
+	}
+
+	if state == nil {
+		// Changes are captured from before the snapshot is queried, so changes already in the snapshot are applied again
+		var err error
+		state, err = from.StartChanges(ctx, srcProps)
+		if err != nil {
+			return err
+		}
+
+		err = s.transferSnapshot(ctx, srcProps, table, opts.Progress)
+		if err != nil {
+			// The changes will be captured from a new position when the snapshot is retried.
+			// Using a new ctx since the snapshot may have failed because ctx was cancelled.
+			stopCtx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+			defer cancel()
+			if stopErr := from.StopChanges(stopCtx, srcProps, state); stopErr != nil {
+				s.logger.Warn("failed to stop capturing changes", zap.Error(stopErr), observability.ZapCtx(ctx))
+			}
+			return err
+		}
+		if opts.SetState != nil {
+			opts.SetState(state)
+		}
+	}
+
+	return from.ConsumeChanges(ctx, srcProps, state, func(b *drivers.ChangeBatch) error {
+		if b.Path != "" {
+			st := time.Now()
+			if err := s.applyChanges(ctx, table, b); err != nil {
+				return err
+			}
+			s.logger.Debug("applied changes", zap.Int64("records", b.Records), zap.Int64("bytes_ingested", b.Bytes), zap.Duration("duration", time.Since(st)), observability.ZapCtx(ctx))
+			opts.Progress.Observe(b.Records, drivers.ProgressUnitRecord)
+		}
+
+		if opts.SetState != nil {
+			opts.SetState(b.State)
+		}
+		return nil
+	})
+}
+
+// transferSnapshot ingests the result of querying the source into the table.
+func (s *sqlStoreToDuckDB) transferSnapshot(ctx context.Context, srcProps map[string]any, table string, p drivers.Progress) error {
+	rowIter, err := s.from.Query(ctx, srcProps)
+	if err != nil {
+		return err
+	}
+	err = s.transferFromRowIterator(ctx, rowIter, table, p)
+	if closeErr := rowIter.Close(); closeErr != nil {
+		s.logger.Error("error in closing row iterator", zap.Error(closeErr))
+	}
+	return err
+}
+
+// applyChanges merges a batch of changes into the table.
+// Values are read as text and cast to the types of the table's columns, except for binary values which are base64 encoded.
+// Only the last change for each key is applied.
+func (s *sqlStoreToDuckDB) applyChanges(ctx context.Context, table string, b *drivers.ChangeBatch) error {
+	res, err := s.to.Execute(ctx, &drivers.Statement{
+		Query:    "SELECT column_name, data_type FROM information_schema.columns WHERE table_catalog = current_database() AND table_schema = 'main' AND lower(table_name) = lower(?) ORDER BY ordinal_position",
+		Args:     []any{table},
+		Priority: 1,
+	})
+	if err != nil {
+		return err
+	}
+	var readCols, selectCols []string
+	for res.Next() {
+		var name, typ string
+		if err := res.Scan(&name, &typ); err != nil {
+			res.Close()
+			return err
+		}
+		readCols = append(readCols, fmt.Sprintf("%s: 'VARCHAR'", safeSQLString(name)))
+		if typ == "BLOB" {
+			selectCols = append(selectCols, fmt.Sprintf("from_base64(%s) AS %s", safeSQLName(name), safeSQLName(name)))
+		} else {
+			selectCols = append(selectCols, fmt.Sprintf("CAST(%s AS %s) AS %s", safeSQLName(name), typ, safeSQLName(name)))
+		}
+	}
+	err = res.Err()
+	res.Close()
+	if err != nil {
+		return err
+	}
+	if len(selectCols) == 0 {
+		return fmt.Errorf("table %q not found", table)
+	}
+
+	readCols = append(readCols, fmt.Sprintf("%s: 'VARCHAR'", safeSQLString(drivers.ChangeOperationColumn)), fmt.Sprintf("%s: 'BIGINT'", safeSQLString(drivers.ChangeSequenceColumn)))
+	selectCols = append(selectCols, fmt.Sprintf("%s = %s AS %s", safeSQLName(drivers.ChangeOperationColumn), safeSQLString(string(drivers.ChangeOperationDelete)), safeSQLName(changeDeleteMarker)))
+	keys := make([]string, len(b.Key))
+	for i, k := range b.Key {
+		keys[i] = safeSQLName(k)
+	}
+
+	qry := fmt.Sprintf(
+		"SELECT %s FROM read_json(%s, format='newline_delimited', columns={%s}) QUALIFY row_number() OVER (PARTITION BY %s ORDER BY %s DESC) = 1",
+		strings.Join(selectCols, ", "),
+		safeSQLString(b.Path),
+		strings.Join(readCols, ", "),
+		strings.Join(keys, ", "),
+		safeSQLName(drivers.ChangeSequenceColumn),
+	)
+	_, err = s.to.InsertTableAsSelect(ctx, table, qry, &drivers.InsertTableOptions{
+		ByName:       true,
+		InPlace:      true,
+		Strategy:     drivers.IncrementalStrategyMerge,
+		UniqueKey:    b.Key,
+		DeleteMarker: changeDeleteMarker,
+	})
+	return err
+}
+
 func (s *sqlStoreToDuckDB) transferFromRowIterator(ctx context.Context, iter drivers.RowIterator, table string, p drivers.Progress) error {
 	schema, err := iter.Schema(ctx)
 	if err != nil {
@@ -0,0 +1,86 @@
+package duckdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+type streamStoreToDuckDB struct {
+	to     drivers.OLAPStore
+	from   drivers.StreamStore
+	logger *zap.Logger
+}
+
+var _ drivers.Transporter = &streamStoreToDuckDB{}
+
+func NewStreamStoreToDuckDB(from drivers.StreamStore, to drivers.OLAPStore, logger *zap.Logger) drivers.Transporter {
+	return &streamStoreToDuckDB{
+		to:     to,
+		from:   from,
+		logger: logger,
+	}
+}
+
+// Transfer consumes the stream in batches and appends each batch to the table.
+// If opts.State is nil, the table is replaced with the first batch. Otherwise consumption resumes from opts.State.
+func (t *streamStoreToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps map[string]any, opts *drivers.TransferOptions) error {
+	sinkCfg, err := parseSinkProperties(sinkProps)
+	if err != nil {
+		return err
+	}
+
+	t.logger = t.logger.With(zap.String("source", sinkCfg.Table))
+
+	appendToTable := false
+	if opts.State != nil {
+		_, err := t.to.InformationSchema().Lookup(ctx, "", "", sinkCfg.Table)
+		if err != nil && !errors.Is(err, drivers.ErrNotFound) {
+			return fmt.Errorf("failed to look up table: %w", err)
+		}
+		appendToTable = err == nil
+	}
+
+	// Messages may have different fields, so new columns are added as they appear
+	a := newAppender(t.to, sinkCfg, true, t.logger, func(files []string) (string, error) {
+		from, err := sourceReader(files, ".ndjson", map[string]any{})
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("SELECT * FROM %s", from), nil
+	})
+
+	return t.from.ConsumeBatches(ctx, srcProps, opts.State, func(b *drivers.StreamBatch) error {
+		if b.Path != "" {
+			st := time.Now()
+			if appendToTable {
+				if err := a.appendData(ctx, []string{b.Path}); err != nil {
+					return err
+				}
+			} else {
+				from, err := sourceReader([]string{b.Path}, ".ndjson", map[string]any{})
+				if err != nil {
+					return err
+				}
+
+				err = t.to.CreateTableAsSelect(ctx, sinkCfg.Table, false, fmt.Sprintf("SELECT * FROM %s", from))
+				if err != nil {
+					return err
+				}
+				appendToTable = true
+			}
+			t.logger.Debug("ingested batch", zap.Int64("records", b.Records), zap.Int64("bytes_ingested", b.Bytes), zap.Duration("duration", time.Since(st)), observability.ZapCtx(ctx))
+			opts.Progress.Observe(b.Records, drivers.ProgressUnitRecord)
+		}
+
+		if opts.SetState != nil {
+			opts.SetState(b.State)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,99 @@
+package duckdb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	activity "github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestStreamStoreToDuckDBTransfer(t *testing.T) {
+	conn, err := Driver{}.Open("default", map[string]any{"dsn": ":memory:"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	olap, ok := conn.AsOLAP("")
+	require.True(t, ok)
+
+	stream := &mockStreamStore{dir: t.TempDir()}
+	tr := NewStreamStoreToDuckDB(stream, olap, zap.NewNop())
+	ctx := context.Background()
+
+	// Without state, the table is created from the first batch
+	stream.batches = [][]string{{`{"id":1}`, `{"id":2}`}, {`{"id":3}`}}
+	err = tr.Transfer(ctx, nil, map[string]any{"table": "events"}, &drivers.TransferOptions{Progress: drivers.NoOpProgress{}})
+	require.NoError(t, err)
+	require.Equal(t, 3, countRows(t, olap, "events"))
+
+	// With state, new batches are appended to the existing table
+	stream.batches = [][]string{{`{"id":4}`}}
+	err = tr.Transfer(ctx, nil, map[string]any{"table": "events"}, &drivers.TransferOptions{Progress: drivers.NoOpProgress{}, State: map[string]any{"offset": 3}})
+	require.NoError(t, err)
+	require.Equal(t, 4, countRows(t, olap, "events"))
+
+	// With state but a missing table, the table is created
+	stream.batches = [][]string{{`{"id":5}`}}
+	err = tr.Transfer(ctx, nil, map[string]any{"table": "missing"}, &drivers.TransferOptions{Progress: drivers.NoOpProgress{}, State: map[string]any{"offset": 4}})
+	require.NoError(t, err)
+	require.Equal(t, 1, countRows(t, olap, "missing"))
+
+	// If the table lookup fails, nothing is consumed
+	stream.batches = [][]string{{`{"id":6}`}}
+	stream.calls = 0
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = tr.Transfer(cctx, nil, map[string]any{"table": "events"}, &drivers.TransferOptions{Progress: drivers.NoOpProgress{}, State: map[string]any{"offset": 4}})
+	require.Error(t, err)
+	require.Equal(t, 0, stream.calls)
+	require.Equal(t, 4, countRows(t, olap, "events"))
+}
+
+func countRows(t *testing.T, olap drivers.OLAPStore, table string) int {
+	rows, err := olap.Execute(context.Background(), &drivers.Statement{Query: fmt.Sprintf("SELECT COUNT(*) FROM %s", table)})
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var count int
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Scan(&count))
+	return count
+}
+
+// mockStreamStore is a drivers.StreamStore that passes pre-defined batches of records to fn.
+type mockStreamStore struct {
+	dir     string
+	batches [][]string
+	calls   int
+}
+
+func (m *mockStreamStore) ConsumeBatches(ctx context.Context, props, state map[string]any, fn func(*drivers.StreamBatch) error) error {
+	m.calls++
+	for i, records := range m.batches {
+		path := filepath.Join(m.dir, fmt.Sprintf("batch_%d.ndjson", i))
+		var data []byte
+		for _, r := range records {
+			data = append(data, r...)
+			data = append(data, '\n')
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return err
+		}
+		err := fn(&drivers.StreamBatch{
+			Path:    path,
+			Records: int64(len(records)),
+			Bytes:   int64(len(data)),
+			State:   map[string]any{"batch": i},
+		})
+		_ = os.Remove(path)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -9,10 +9,12 @@ import (
 	"testing"
 
 	"github.com/rilldata/rill/runtime/drivers"
+	rillblob "github.com/rilldata/rill/runtime/drivers/blob"
 	"github.com/rilldata/rill/runtime/drivers/duckdb"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"gocloud.dev/blob/fileblob"
 )
 
 type mockObjectStore struct {
@@ -23,6 +25,22 @@ func (m *mockObjectStore) DownloadFiles(ctx context.Context, srcProps map[string
 	return m.mockIterator, nil
 }
 
+func (m *mockObjectStore) UploadFiles(ctx context.Context, localDir string, props map[string]any) error {
+	return nil
+}
+
+func (m *mockObjectStore) ReplaceFiles(ctx context.Context, localDir string, props map[string]any) error {
+	return nil
+}
+
+func (m *mockObjectStore) DeleteFiles(ctx context.Context, props map[string]any) error {
+	return nil
+}
+
+func (m *mockObjectStore) ResolveTable(ctx context.Context, props map[string]any) (*drivers.ObjectStoreTable, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 type mockIterator struct {
 	batches [][]string
 	index   int
@@ -439,6 +457,51 @@ func TestIterativeParquetIngestionWithVariableSchema(t *testing.T) {
 	}
 }
 
+type mockTableIterator struct {
+	mockIterator
+	partitionValues map[string]map[string]*string
+}
+
+func (m *mockTableIterator) PartitionColumns() []drivers.TablePartitionColumn {
+	return []drivers.TablePartitionColumn{{Name: "day", Type: "date"}}
+}
+
+func (m *mockTableIterator) PartitionValues(file string) map[string]*string {
+	return m.partitionValues[file]
+}
+
+var _ drivers.TableFileIterator = &mockTableIterator{}
+
+func TestDeltaPartitionColumnsIngestion(t *testing.T) {
+	file1 := filepath.Join("../../testruntime/testdata/variable-schema", "data.parquet")
+	file2 := filepath.Join("../../testruntime/testdata/variable-schema", "data1.parquet")
+	day := "2024-01-01"
+
+	// The partition values come from the Delta log, not from the file paths
+	mockConnector := &mockObjectStore{mockIterator: &mockTableIterator{
+		mockIterator: mockIterator{batches: [][]string{{file1, file2}}},
+		partitionValues: map[string]map[string]*string{
+			file1: {"day": &day},
+			file2: {"day": nil},
+		},
+	}}
+	olap := runOLAPStore(t)
+	ctx := context.Background()
+	tr := duckdb.NewObjectStoreToDuckDB(mockConnector, olap, zap.NewNop())
+
+	err := tr.Transfer(ctx, map[string]any{"path": "s3://bucket/tbl", "format": "delta"}, map[string]any{"table": "tbl"}, mockTransferOptions())
+	require.NoError(t, err)
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT count(*) FILTER (WHERE day = DATE '2024-01-01'), count(*) FILTER (WHERE day IS NULL) FROM tbl"})
+	require.NoError(t, err)
+	var withDay, withoutDay int
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Scan(&withDay, &withoutDay))
+	require.NoError(t, rows.Close())
+	require.Equal(t, 2, withDay)
+	require.Equal(t, 2, withoutDay)
+}
+
 func TestIterativeJSONIngestionWithVariableSchema(t *testing.T) {
 	tempDir := t.TempDir()
 	file1 := filepath.Join(tempDir, "data1.ndjson")
@@ -587,6 +650,72 @@ func TestIterativeJSONIngestionWithVariableSchema(t *testing.T) {
 	}
 }
 
+// incrementalObjectStore is an object store backed by a local directory that supports incremental downloads of CSV files.
+type incrementalObjectStore struct {
+	mockObjectStore
+	dir string
+}
+
+func (s *incrementalObjectStore) DownloadNewFiles(ctx context.Context, srcProps, state map[string]any) (drivers.FileIterator, *drivers.IncrementalDownload, error) {
+	inc, err := rillblob.NewIncrementalFiles(state)
+	if err != nil {
+		return nil, nil, err
+	}
+	bucket, err := fileblob.OpenBucket(s.dir, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	it, err := rillblob.NewIterator(ctx, bucket, rillblob.Options{GlobPattern: "*.csv", Incremental: inc}, zap.NewNop())
+	if err != nil {
+		return nil, nil, err
+	}
+	return it, &drivers.IncrementalDownload{State: inc.State(), Append: !inc.Full()}, nil
+}
+
+func TestIncrementalObjectStoreIngestion(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data1.csv"), []byte("id,city\n1,bglr\n2,mum\n"), 0o644))
+
+	olap := runOLAPStore(t)
+	tr := duckdb.NewObjectStoreToDuckDB(&incrementalObjectStore{dir: dir}, olap, zap.NewNop())
+	src := map[string]any{"incremental": true}
+
+	var state map[string]any
+	transfer := func() int {
+		opts := mockTransferOptions()
+		opts.State = state
+		opts.SetState = func(s map[string]any) { state = s }
+		require.NoError(t, tr.Transfer(ctx, src, map[string]any{"table": "incremental"}, opts))
+
+		var count int
+		rows, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT count(*) FROM incremental"})
+		require.NoError(t, err)
+		defer rows.Close()
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(&count))
+		return count
+	}
+
+	require.Equal(t, 2, transfer())
+	require.NotNil(t, state)
+
+	// Only new files are appended
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data2.csv"), []byte("id,city\n3,bglr\n"), 0o644))
+	require.Equal(t, 3, transfer())
+
+	// Nothing is appended if no files changed
+	require.Equal(t, 3, transfer())
+
+	// The table is replaced if an ingested file changed
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data1.csv"), []byte("id,city\n1,bglr\n"), 0o644))
+	require.Equal(t, 2, transfer())
+
+	// The table is replaced if an ingested file was removed
+	require.NoError(t, os.Remove(filepath.Join(dir, "data2.csv")))
+	require.Equal(t, 1, transfer())
+}
+
 func runOLAPStore(t *testing.T) drivers.OLAPStore {
 	conn, err := drivers.Open("duckdb", "default", map[string]any{"dsn": ":memory:?access_mode=read_write"}, activity.NewNoopClient(), zap.NewNop())
 	require.NoError(t, err)
@@ -65,6 +65,7 @@ type fileSourceProperties struct {
 	AllowSchemaRelaxation bool           `mapstructure:"allow_schema_relaxation"`
 	BatchSize             string         `mapstructure:"batch_size"`
 	CastToENUM            []string       `mapstructure:"cast_to_enum"`
+	Incremental           bool           `mapstructure:"incremental"`
 
 	// Backwards compatibility
 	HivePartitioning            *bool  `mapstructure:"hive_partitioning"`
@@ -106,6 +107,11 @@ func parseFileSourceProperties(props map[string]any) (*fileSourceProperties, err
 			return nil, fmt.Errorf("if any of `columns`,`types`,`dtypes` is set `allow_schema_relaxation` must be disabled")
 		}
 	}
+
+	// Appending to enum columns fails for new values
+	if cfg.Incremental && len(cfg.CastToENUM) > 0 {
+		return nil, fmt.Errorf("can't set `incremental` and `cast_to_enum` at the same time")
+	}
 	return cfg, nil
 }
 
@@ -0,0 +1,221 @@
+package email
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"go.uber.org/zap"
+)
+
+var spec = drivers.Spec{
+	DisplayName: "Email",
+	Description: "Email Notifier",
+	ConfigProperties: []*drivers.PropertySpec{
+		{
+			Key:         "smtp_host",
+			Type:        drivers.StringPropertyType,
+			Description: "SMTP server host. If not set, emails are sent with the runtime's SMTP server, or logged if the runtime has none.",
+		},
+		{
+			Key:         "smtp_port",
+			Type:        drivers.NumberPropertyType,
+			Description: "SMTP server port",
+			Default:     "587",
+		},
+		{
+			Key:         "smtp_username",
+			Type:        drivers.StringPropertyType,
+			Description: "SMTP username",
+		},
+		{
+			Key:         "smtp_password",
+			Type:        drivers.StringPropertyType,
+			Description: "SMTP password",
+			Secret:      true,
+		},
+		{
+			Key:         "smtp_tls",
+			Type:        drivers.BooleanPropertyType,
+			Description: "Connect to the SMTP server over TLS instead of STARTTLS",
+		},
+		{
+			Key:         "from_email",
+			Type:        drivers.StringPropertyType,
+			Description: "Sender email address",
+		},
+		{
+			Key:         "from_name",
+			Type:        drivers.StringPropertyType,
+			Description: "Sender name",
+		},
+		{
+			Key:         "bcc",
+			Type:        drivers.StringPropertyType,
+			Description: "Email address to BCC on all emails",
+		},
+	},
+	ImplementsNotifier: true,
+}
+
+func init() {
+	drivers.Register("email", driver{})
+	drivers.RegisterAsConnector("email", driver{})
+}
+
+type driver struct{}
+
+func (d driver) Spec() drivers.Spec {
+	return spec
+}
+
+func (d driver) Open(instanceID string, config map[string]any, client *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	conf := &configProperties{}
+	err := mapstructure.WeakDecode(config, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &handle{
+		config: conf,
+		logger: logger,
+	}
+
+	// Instances without an SMTP server of their own use the runtime's email system connector (see runtime.AcquireHandle).
+	// Without an SMTP server, emails are only logged, which is useful for local development.
+	if conf.SMTPHost == "" {
+		sender, err := email.NewConsoleSender(logger, conf.FromEmail, conf.FromName)
+		if err != nil {
+			return nil, err
+		}
+		h.client = email.New(sender)
+	} else {
+		port := conf.SMTPPort
+		if port == 0 {
+			port = 587
+		}
+		sender, err := email.NewSMTPSender(&email.SMTPOptions{
+			SMTPHost:     conf.SMTPHost,
+			SMTPPort:     port,
+			SMTPUsername: conf.SMTPUsername,
+			SMTPPassword: conf.SMTPPassword,
+			SMTPTLS:      conf.SMTPTLS,
+			FromEmail:    conf.FromEmail,
+			FromName:     conf.FromName,
+			BCC:          conf.BCC,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("invalid SMTP config: %w", err)
+		}
+		h.client = email.New(sender)
+	}
+
+	return h, nil
+}
+
+func (d driver) Drop(config map[string]any, logger *zap.Logger) error {
+	return nil
+}
+
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, props map[string]any, logger *zap.Logger) (bool, error) {
+	return false, fmt.Errorf("not implemented")
+}
+
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+type handle struct {
+	config *configProperties
+	logger *zap.Logger
+	client *email.Client
+}
+
+var _ drivers.Handle = &handle{}
+
+func (h *handle) Driver() string {
+	return "email"
+}
+
+func (h *handle) Config() map[string]any {
+	return map[string]any{}
+}
+
+func (h *handle) Migrate(ctx context.Context) error {
+	return nil
+}
+
+func (h *handle) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+func (h *handle) Close() error {
+	return nil
+}
+
+func (h *handle) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return nil, false
+}
+
+func (h *handle) AsAI(instanceID string) (drivers.AIService, bool) {
+	return nil, false
+}
+
+func (h *handle) AsSQLStore() (drivers.SQLStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	return nil, false
+}
+
+// AsModelManager implements drivers.Handle.
+func (h *handle) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
+	return nil, false
+}
+
+func (h *handle) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+func (h *handle) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
+	return newNotifier(h.client, properties)
+}
+
+type configProperties struct {
+	SMTPHost     string `mapstructure:"smtp_host"`
+	SMTPPort     int    `mapstructure:"smtp_port"`
+	SMTPUsername string `mapstructure:"smtp_username"`
+	SMTPPassword string `mapstructure:"smtp_password"`
+	SMTPTLS      bool   `mapstructure:"smtp_tls"`
+	FromEmail    string `mapstructure:"from_email"`
+	FromName     string `mapstructure:"from_name"`
+	BCC          string `mapstructure:"bcc"`
+}
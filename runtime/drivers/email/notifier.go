@@ -0,0 +1,88 @@
+package email
+
+import (
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+)
+
+type notifier struct {
+	client *email.Client
+	props  *NotifierProperties
+}
+
+type NotifierProperties struct {
+	Recipients []string `mapstructure:"recipients"`
+}
+
+func newNotifier(client *email.Client, propsMap map[string]any) (*notifier, error) {
+	props, err := DecodeProps(propsMap)
+	if err != nil {
+		return nil, err
+	}
+	return &notifier{
+		client: client,
+		props:  props,
+	}, nil
+}
+
+func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
+	for _, recipient := range n.props.Recipients {
+		msg := *s
+		msg.ToEmail = recipient
+		msg.ToName = ""
+		err := n.client.SendAlertStatus(&msg)
+		if err != nil {
+			return fmt.Errorf("failed to send email to %q: %w", recipient, err)
+		}
+	}
+	return nil
+}
+
+func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
+	var attachment *email.Attachment
+	if s.Attachment != nil {
+		attachment = &email.Attachment{
+			Filename:    s.Attachment.Filename,
+			ContentType: s.Attachment.ContentType,
+			Data:        s.Attachment.Data,
+		}
+	}
+
+	for _, recipient := range n.props.Recipients {
+		err := n.client.SendScheduledReport(&email.ScheduledReport{
+			ToEmail:        recipient,
+			ToName:         "",
+			Title:          s.Title,
+			ReportTime:     s.ReportTime,
+			DownloadFormat: s.DownloadFormat,
+			OpenLink:       s.OpenLink,
+			DownloadLink:   s.DownloadLink,
+			EditLink:       s.EditLink,
+			Attachment:     attachment,
+			Preview:        s.Preview,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to send email to %q: %w", recipient, err)
+		}
+	}
+	return nil
+}
+
+func EncodeProps(recipients []string) map[string]any {
+	return map[string]any{
+		"recipients": pbutil.ToSliceAny(recipients),
+	}
+}
+
+func DecodeProps(propsMap map[string]any) (*NotifierProperties, error) {
+	props := &NotifierProperties{}
+	err := mapstructure.WeakDecode(propsMap, props)
+	if err != nil {
+		return nil, err
+	}
+	return props, nil
+}
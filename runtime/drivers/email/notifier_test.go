@@ -0,0 +1,84 @@
+package email
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestSendAlertStatus(t *testing.T) {
+	sender := email.NewTestSender().(*email.TestSender)
+	h := &handle{config: &configProperties{}, logger: zap.NewNop(), client: email.New(sender)}
+
+	n, err := h.AsNotifier(EncodeProps([]string{"a@example.com", "b@example.com"}))
+	require.NoError(t, err)
+
+	err = n.SendAlertStatus(&drivers.AlertStatus{
+		Title:         "My alert",
+		ExecutionTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		OpenLink:      "https://example.com/open",
+		EditLink:      "https://example.com/edit",
+	})
+	require.NoError(t, err)
+	require.Len(t, sender.Emails, 2)
+	require.Equal(t, "a@example.com", sender.Emails[0].ToEmail)
+	require.Equal(t, "b@example.com", sender.Emails[1].ToEmail)
+}
+
+func TestSendScheduledReport(t *testing.T) {
+	sender := email.NewTestSender().(*email.TestSender)
+	h := &handle{config: &configProperties{}, logger: zap.NewNop(), client: email.New(sender)}
+
+	n, err := h.AsNotifier(EncodeProps([]string{"a@example.com"}))
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{
+		Title:          "Weekly revenue",
+		ReportTime:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		DownloadFormat: "CSV",
+		OpenLink:       "https://example.com/open",
+		DownloadLink:   "https://example.com/download",
+		EditLink:       "https://example.com/edit",
+	})
+	require.NoError(t, err)
+	require.Len(t, sender.Emails, 1)
+	require.Equal(t, "a@example.com", sender.Emails[0].ToEmail)
+	require.Contains(t, sender.Emails[0].Subject, "Weekly revenue")
+}
+
+func TestOpenWithoutSMTP(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	conn, err := driver{}.Open("default", map[string]any{"from_email": "alerts@example.com"}, nil, zap.New(core))
+	require.NoError(t, err)
+
+	// Emails are logged instead of sent
+	n, err := conn.AsNotifier(EncodeProps([]string{"a@example.com"}))
+	require.NoError(t, err)
+	err = n.SendScheduledReport(&drivers.ScheduledReport{Title: "Weekly revenue", ReportTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.Len())
+}
+
+func TestOpenSMTP(t *testing.T) {
+	_, err := driver{}.Open("default", map[string]any{"smtp_host": "smtp.example.com", "smtp_username": "user"}, nil, zap.NewNop())
+	require.ErrorContains(t, err, "invalid SMTP config")
+
+	conn, err := driver{}.Open("default", map[string]any{
+		"smtp_host":     "smtp.example.com",
+		"smtp_port":     "465",
+		"smtp_username": "user",
+		"smtp_password": "pass",
+		"smtp_tls":      "true",
+		"from_email":    "alerts@example.com",
+	}, nil, zap.NewNop())
+	require.NoError(t, err)
+	_, err = conn.AsNotifier(EncodeProps([]string{"a@example.com"}))
+	require.NoError(t, err)
+}
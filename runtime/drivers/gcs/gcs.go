@@ -39,6 +39,20 @@ var spec = drivers.Spec{
 			Type: drivers.FilePropertyType,
 			Hint: "Enter path of file to load from.",
 		},
+		{
+			Key:         "key_id",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "HMAC key ID",
+			Description: "HMAC key ID for S3-compatible access, such as reading files directly from ClickHouse.",
+			Secret:      true,
+		},
+		{
+			Key:         "secret",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "HMAC secret",
+			Description: "HMAC secret for S3-compatible access, such as reading files directly from ClickHouse.",
+			Secret:      true,
+		},
 	},
 	SourceProperties: []*drivers.PropertySpec{
 		{
@@ -65,7 +79,10 @@ var spec = drivers.Spec{
 type driver struct{}
 
 type configProperties struct {
-	SecretJSON      string `mapstructure:"google_application_credentials"`
+	SecretJSON string `mapstructure:"google_application_credentials"`
+	// KeyID and Secret are HMAC keys. They are not used by the GCS connector itself, but by OLAP engines that read from GCS directly.
+	KeyID           string `mapstructure:"key_id"`
+	Secret          string `mapstructure:"secret"`
 	AllowHostAccess bool   `mapstructure:"allow_host_access"`
 }
 
@@ -119,8 +136,10 @@ type sourceProperties struct {
 	GlobMaxObjectsListed  int64          `mapstructure:"glob.max_objects_listed"`
 	GlobPageSize          int            `mapstructure:"glob.page_size"`
 	BatchSize             string         `mapstructure:"batch_size"`
+	Incremental           bool           `mapstructure:"incremental"`
 	url                   *globutil.URL
 	extractPolicy         *rillblob.ExtractPolicy
+	table                 *rillblob.TableOptions
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
@@ -156,6 +175,15 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 		return nil, fmt.Errorf("failed to parse extract config: %w", err)
 	}
 
+	conf.table, err = rillblob.ParseTableOptions(props)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.Incremental && (conf.extractPolicy != nil || conf.table != nil) {
+		return nil, fmt.Errorf("property 'incremental' can't be combined with 'extract' or a table format")
+	}
+
 	return conf, nil
 }
 
@@ -166,6 +194,8 @@ type Connection struct {
 
 var _ drivers.Handle = &Connection{}
 
+var _ drivers.IncrementalObjectStore = &Connection{}
+
 // Driver implements drivers.Connection.
 func (c *Connection) Driver() string {
 	return "gcs"
@@ -174,7 +204,7 @@ func (c *Connection) Driver() string {
 // Config implements drivers.Connection.
 func (c *Connection) Config() map[string]any {
 	m := make(map[string]any, 0)
-	_ = mapstructure.Decode(c.config, m)
+	_ = mapstructure.Decode(c.config, &m)
 	return m
 }
 
@@ -233,11 +263,6 @@ func (c *Connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecu
 	return nil, false
 }
 
-// AsModelManager implements drivers.Handle.
-func (c *Connection) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
-	return nil, false
-}
-
 // AsTransporter implements drivers.Connection.
 func (c *Connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
 	return nil, false
@@ -261,10 +286,30 @@ func (c *Connection) AsNotifier(properties map[string]any) (drivers.Notifier, er
 // The credential json is read from config google_application_credentials.
 // Additionally in case `allow_host_credentials` is true it looks for "Application Default Credentials" as well
 func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any) (drivers.FileIterator, error) {
+	return c.downloadFiles(ctx, props, nil)
+}
+
+// DownloadNewFiles implements drivers.IncrementalObjectStore.
+func (c *Connection) DownloadNewFiles(ctx context.Context, props, state map[string]any) (drivers.FileIterator, *drivers.IncrementalDownload, error) {
+	inc, err := rillblob.NewIncrementalFiles(state)
+	if err != nil {
+		return nil, nil, err
+	}
+	it, err := c.downloadFiles(ctx, props, inc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return it, &drivers.IncrementalDownload{State: inc.State(), Append: !inc.Full()}, nil
+}
+
+func (c *Connection) downloadFiles(ctx context.Context, props map[string]any, inc *rillblob.IncrementalFiles) (drivers.FileIterator, error) {
 	conf, err := parseSourceProperties(props)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
+	if inc != nil && !conf.Incremental {
+		return nil, fmt.Errorf("property 'incremental' is not enabled")
+	}
 
 	client, err := c.createClient(ctx)
 	if err != nil {
@@ -295,6 +340,8 @@ func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any) (d
 		ExtractPolicy:         conf.extractPolicy,
 		BatchSizeBytes:        int64(batchSize.Bytes()),
 		KeepFilesUntilClose:   conf.BatchSize == "-1",
+		Table:                 conf.table,
+		Incremental:           inc,
 	}
 
 	iter, err := rillblob.NewIterator(ctx, bucketObj, opts, c.logger)
@@ -0,0 +1,292 @@
+package kafka
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultBatchSize = 10000
+	metadataTimeout  = 10 * time.Second
+	pollTimeout      = 100 * time.Millisecond
+)
+
+// streamState is the state persisted between calls to ConsumeBatches.
+type streamState struct {
+	// Offsets maps partition IDs to the offset of the next message to consume.
+	Offsets map[string]int64 `mapstructure:"offsets"`
+}
+
+// ConsumeBatches implements drivers.StreamStore.
+// It consumes the messages that were in the topic when it was called, starting at the offsets in state.
+func (c *connection) ConsumeBatches(ctx context.Context, props, state map[string]any, fn func(*drivers.StreamBatch) error) error {
+	srcProps, err := parseSourceProperties(props)
+	if err != nil {
+		return err
+	}
+
+	prevState := &streamState{}
+	if state != nil {
+		err = mapstructure.WeakDecode(state, prevState)
+		if err != nil {
+			return fmt.Errorf("failed to parse previous state: %w", err)
+		}
+	}
+
+	dec, err := c.newDecoder(srcProps.Format)
+	if err != nil {
+		return err
+	}
+
+	consumer, err := kafka.NewConsumer(c.consumerConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	// Resolve the range of offsets to consume in each partition
+	md, err := consumer.GetMetadata(&srcProps.Topic, false, int(metadataTimeout.Milliseconds()))
+	if err != nil {
+		return fmt.Errorf("failed to get metadata for topic %q: %w", srcProps.Topic, err)
+	}
+	topic, ok := md.Topics[srcProps.Topic]
+	if !ok {
+		return fmt.Errorf("topic %q not found", srcProps.Topic)
+	}
+	if topic.Error.Code() != kafka.ErrNoError {
+		return fmt.Errorf("failed to get metadata for topic %q: %w", srcProps.Topic, topic.Error)
+	}
+
+	offsets := make(map[int32]int64, len(topic.Partitions))
+	ends := make(map[int32]int64, len(topic.Partitions))
+	var assignment []kafka.TopicPartition
+	for _, p := range topic.Partitions {
+		low, high, err := consumer.QueryWatermarkOffsets(srcProps.Topic, p.ID, int(metadataTimeout.Milliseconds()))
+		if err != nil {
+			return fmt.Errorf("failed to get offsets of partition %d: %w", p.ID, err)
+		}
+
+		start, ok := prevState.Offsets[strconv.Itoa(int(p.ID))]
+		switch {
+		case !ok && srcProps.StartOffset == "latest":
+			start = high
+		case !ok:
+			start = low
+		case start < low:
+			c.logger.Warn("kafka: messages were deleted before they were consumed", zap.String("topic", srcProps.Topic), zap.Int32("partition", p.ID), zap.Int64("offset", start), zap.Int64("low", low))
+			start = low
+		}
+
+		offsets[p.ID] = start
+		if start < high {
+			ends[p.ID] = high
+			assignment = append(assignment, kafka.TopicPartition{Topic: &srcProps.Topic, Partition: p.ID, Offset: kafka.Offset(start)})
+		}
+	}
+
+	b := &batchWriter{offsets: offsets}
+	defer b.close()
+
+	if len(assignment) > 0 {
+		err = consumer.Assign(assignment)
+		if err != nil {
+			return fmt.Errorf("failed to assign partitions: %w", err)
+		}
+	}
+
+	for len(ends) > 0 {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		switch e := consumer.Poll(int(pollTimeout.Milliseconds())).(type) {
+		case *kafka.Message:
+			if e.TopicPartition.Error != nil {
+				return e.TopicPartition.Error
+			}
+			p := e.TopicPartition.Partition
+			end, ok := ends[p]
+			if !ok || int64(e.TopicPartition.Offset) >= end {
+				continue
+			}
+
+			err = b.write(ctx, dec, e, srcProps.IncludeMetadata)
+			if err != nil {
+				return fmt.Errorf("failed to decode message at offset %d in partition %d: %w", e.TopicPartition.Offset, p, err)
+			}
+			if int64(e.TopicPartition.Offset)+1 >= end {
+				delete(ends, p)
+			}
+
+			if b.records >= int64(srcProps.BatchSize) {
+				err = b.flush(fn)
+				if err != nil {
+					return err
+				}
+			}
+		case kafka.Error:
+			if e.IsFatal() {
+				return e
+			}
+			c.logger.Warn("kafka: consumer error", zap.String("topic", srcProps.Topic), zap.Error(e))
+		case nil:
+			// The end offsets may not be reachable if the last messages are transaction markers or were compacted away.
+			// So when no messages are received, we check the consumer's position instead.
+			positions, err := consumer.Position(assignment)
+			if err != nil {
+				return fmt.Errorf("failed to get consumer position: %w", err)
+			}
+			for _, tp := range positions {
+				if end, ok := ends[tp.Partition]; ok && tp.Offset >= 0 && int64(tp.Offset) >= end {
+					b.offsets[tp.Partition] = end
+					delete(ends, tp.Partition)
+				}
+			}
+		}
+	}
+
+	err = b.flush(fn)
+	if err != nil {
+		return err
+	}
+
+	// Pass the final position even if there were no new messages, so that it's persisted (for example for a start_offset of "latest")
+	if !b.flushed {
+		return fn(&drivers.StreamBatch{State: b.state()})
+	}
+	return nil
+}
+
+// consumerConfig returns the config for consumers.
+// Partitions are assigned manually and offsets are persisted in the source's state, so it doesn't use consumer groups or commit offsets.
+func (c *connection) consumerConfig() *kafka.ConfigMap {
+	conf := &kafka.ConfigMap{
+		"bootstrap.servers":        c.config.Brokers,
+		"group.id":                 "rill",
+		"enable.auto.commit":       false,
+		"enable.auto.offset.store": false,
+		"enable.partition.eof":     false,
+		"isolation.level":          "read_committed",
+	}
+	if c.config.SecurityProtocol != "" {
+		_ = conf.SetKey("security.protocol", c.config.SecurityProtocol)
+	}
+	if c.config.SASLMechanism != "" {
+		_ = conf.SetKey("sasl.mechanisms", c.config.SASLMechanism)
+	}
+	if c.config.SASLUsername != "" {
+		_ = conf.SetKey("sasl.username", c.config.SASLUsername)
+		_ = conf.SetKey("sasl.password", c.config.SASLPassword)
+	}
+	return conf
+}
+
+// batchWriter writes decoded messages to a newline-delimited JSON file.
+type batchWriter struct {
+	offsets map[int32]int64
+	file    *os.File
+	buf     *bufio.Writer
+	records int64
+	flushed bool
+}
+
+func (b *batchWriter) write(ctx context.Context, dec decoder, msg *kafka.Message, includeMetadata bool) error {
+	p := msg.TopicPartition.Partition
+	b.offsets[p] = int64(msg.TopicPartition.Offset) + 1
+
+	// Skip tombstones
+	if msg.Value == nil {
+		return nil
+	}
+
+	obj, err := dec.decode(ctx, msg.Value)
+	if err != nil {
+		return err
+	}
+	if includeMetadata {
+		obj, err = addMetadata(obj, map[string]any{
+			"_kafka_partition": p,
+			"_kafka_offset":    int64(msg.TopicPartition.Offset),
+			"_kafka_timestamp": msg.Timestamp.UTC().Format(time.RFC3339Nano),
+			"_kafka_key":       string(msg.Key),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if b.file == nil {
+		b.file, err = os.CreateTemp("", "kafka_*.ndjson")
+		if err != nil {
+			return err
+		}
+		b.buf = bufio.NewWriter(b.file)
+	}
+
+	_, err = b.buf.Write(obj)
+	if err != nil {
+		return err
+	}
+	err = b.buf.WriteByte('\n')
+	if err != nil {
+		return err
+	}
+	b.records++
+	return nil
+}
+
+// flush passes the current batch to fn. If no messages were written, fn is not called.
+func (b *batchWriter) flush(fn func(*drivers.StreamBatch) error) error {
+	if b.file == nil {
+		return nil
+	}
+	defer b.close()
+
+	err := b.buf.Flush()
+	if err != nil {
+		return err
+	}
+	info, err := b.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	b.flushed = true
+	return fn(&drivers.StreamBatch{
+		Path:    b.file.Name(),
+		Records: b.records,
+		Bytes:   info.Size(),
+		State:   b.state(),
+	})
+}
+
+// state returns the position after the messages written so far.
+func (b *batchWriter) state() map[string]any {
+	offsets := make(map[string]any, len(b.offsets))
+	for p, o := range b.offsets {
+		offsets[strconv.Itoa(int(p))] = o
+	}
+	return map[string]any{"offsets": offsets}
+}
+
+// close removes the current batch's file.
+func (b *batchWriter) close() {
+	if b.file == nil {
+		return
+	}
+	name := b.file.Name()
+	_ = b.file.Close()
+	_ = os.Remove(name)
+	b.file = nil
+	b.buf = nil
+	b.records = 0
+}
@@ -0,0 +1,107 @@
+package kafka
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/redpanda"
+	"go.uber.org/zap"
+)
+
+func TestConsumeBatches(t *testing.T) {
+	if testing.Short() {
+		t.Skip("kafka: skipping test in short mode")
+	}
+
+	ctx := context.Background()
+	container, err := redpanda.RunContainer(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+	brokers, err := container.KafkaSeedBroker(ctx)
+	require.NoError(t, err)
+
+	// Create a topic with two partitions
+	admin, err := kafka.NewAdminClient(&kafka.ConfigMap{"bootstrap.servers": brokers})
+	require.NoError(t, err)
+	defer admin.Close()
+	res, err := admin.CreateTopics(ctx, []kafka.TopicSpecification{{Topic: "events", NumPartitions: 2, ReplicationFactor: 1}})
+	require.NoError(t, err)
+	require.Equal(t, kafka.ErrNoError, res[0].Error.Code())
+
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": brokers})
+	require.NoError(t, err)
+	defer producer.Close()
+	produce := func(from, to int) {
+		topic := "events"
+		for i := from; i < to; i++ {
+			err := producer.Produce(&kafka.Message{
+				TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: int32(i % 2)},
+				Value:          []byte(fmt.Sprintf(`{"id": %d}`, i)),
+			}, nil)
+			require.NoError(t, err)
+		}
+		require.Zero(t, producer.Flush(10000))
+	}
+
+	handle, err := drivers.Open("kafka", "default", map[string]any{"brokers": brokers}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer handle.Close()
+	store := handle.(drivers.StreamStore)
+
+	consume := func(props, state map[string]any) (int, []int64, map[string]any) {
+		var records int
+		var batches []int64
+		err := store.ConsumeBatches(ctx, props, state, func(b *drivers.StreamBatch) error {
+			state = b.State
+			if b.Path == "" {
+				return nil
+			}
+			batches = append(batches, b.Records)
+			f, err := os.Open(b.Path)
+			require.NoError(t, err)
+			defer f.Close()
+			s := bufio.NewScanner(f)
+			for s.Scan() {
+				records++
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		return records, batches, state
+	}
+
+	// Starting at the latest offset only records the position
+	produce(0, 4)
+	records, _, latestState := consume(map[string]any{"topic": "events", "start_offset": "latest"}, nil)
+	require.Equal(t, 0, records)
+	require.Equal(t, map[string]any{"offsets": map[string]any{"0": int64(2), "1": int64(2)}}, latestState)
+
+	// Consumes all messages in batches
+	props := map[string]any{"topic": "events", "batch_size": 3}
+	records, batches, state := consume(props, nil)
+	require.Equal(t, 4, records)
+	require.Equal(t, []int64{3, 1}, batches)
+
+	// Resumes from the previous state
+	produce(4, 7)
+	records, _, state = consume(props, state)
+	require.Equal(t, 3, records)
+	require.Equal(t, map[string]any{"offsets": map[string]any{"0": int64(4), "1": int64(3)}}, state)
+
+	records, _, _ = consume(props, latestState)
+	require.Equal(t, 3, records)
+
+	// No new messages
+	records, batches, _ = consume(props, state)
+	require.Equal(t, 0, records)
+	require.Empty(t, batches)
+}
@@ -0,0 +1,242 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// decoder converts message values to JSON objects.
+type decoder interface {
+	decode(ctx context.Context, value []byte) ([]byte, error)
+}
+
+func (c *connection) newDecoder(format string) (decoder, error) {
+	switch format {
+	case "json":
+		return jsonDecoder{}, nil
+	case "avro":
+		r, err := c.schemaRegistry()
+		if err != nil {
+			return nil, err
+		}
+		return &avroDecoder{registry: r, codecs: make(map[int]*goavro.Codec)}, nil
+	case "protobuf":
+		r, err := c.schemaRegistry()
+		if err != nil {
+			return nil, err
+		}
+		return &protobufDecoder{registry: r, files: make(map[int]protoreflect.FileDescriptor)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// jsonDecoder decodes JSON messages. Values that are not JSON objects are wrapped in an object with a single "value" field.
+type jsonDecoder struct{}
+
+func (jsonDecoder) decode(ctx context.Context, value []byte) ([]byte, error) {
+	// Skip the header of messages written with a schema registry serializer (JSON documents never start with a zero byte)
+	if len(value) >= 5 && value[0] == 0 {
+		value = value[5:]
+	}
+
+	// Compact the value since it's written as newline-delimited JSON
+	buf := &bytes.Buffer{}
+	err := json.Compact(buf, value)
+	if err != nil {
+		return nil, fmt.Errorf("message is not valid JSON: %w", err)
+	}
+	return wrapValue(buf.Bytes())
+}
+
+// avroDecoder decodes Avro messages in the Confluent wire format.
+type avroDecoder struct {
+	registry *schemaRegistry
+	codecs   map[int]*goavro.Codec
+}
+
+func (d *avroDecoder) decode(ctx context.Context, value []byte) ([]byte, error) {
+	id, payload, err := parseWireFormat(value)
+	if err != nil {
+		return nil, err
+	}
+
+	codec, ok := d.codecs[id]
+	if !ok {
+		s, err := d.registry.schemaByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if len(s.References) > 0 {
+			return nil, fmt.Errorf("avro schema %d has references, which are not supported", id)
+		}
+		codec, err = goavro.NewCodecForStandardJSONFull(s.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse avro schema %d: %w", id, err)
+		}
+		d.codecs[id] = codec
+	}
+
+	native, _, err := codec.NativeFromBinary(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode avro message: %w", err)
+	}
+	res, err := codec.TextualFromNative(nil, native)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert avro message to JSON: %w", err)
+	}
+	return wrapValue(res)
+}
+
+// protobufDecoder decodes Protobuf messages in the Confluent wire format.
+type protobufDecoder struct {
+	registry *schemaRegistry
+	files    map[int]protoreflect.FileDescriptor
+}
+
+func (d *protobufDecoder) decode(ctx context.Context, value []byte) ([]byte, error) {
+	id, payload, err := parseWireFormat(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// The payload starts with the indexes of the message type in the schema (for example [1, 0] is the first nested message of the second message).
+	// The count and indexes are zigzag varints. A single zero count is shorthand for [0].
+	count, n := binary.Varint(payload)
+	if n <= 0 || count < 0 {
+		return nil, errors.New("invalid protobuf message indexes")
+	}
+	payload = payload[n:]
+	indexes := []int64{0}
+	if count > 0 {
+		indexes = make([]int64, count)
+		for i := range indexes {
+			indexes[i], n = binary.Varint(payload)
+			if n <= 0 {
+				return nil, errors.New("invalid protobuf message indexes")
+			}
+			payload = payload[n:]
+		}
+	}
+
+	fd, ok := d.files[id]
+	if !ok {
+		fd, err = d.compile(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		d.files[id] = fd
+	}
+
+	md, err := messageDescriptor(fd, indexes)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := dynamicpb.NewMessage(md)
+	err = proto.Unmarshal(payload, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode protobuf message: %w", err)
+	}
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+}
+
+// compile compiles the Protobuf schema with the given ID and the schemas it references.
+func (d *protobufDecoder) compile(ctx context.Context, id int) (protoreflect.FileDescriptor, error) {
+	s, err := d.registry.schemaByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	const name = "__schema.proto"
+	files := map[string]string{name: s.Schema}
+	err = d.resolveReferences(ctx, s.References, files)
+	if err != nil {
+		return nil, err
+	}
+
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(files),
+		}),
+	}
+	res, err := compiler.Compile(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile protobuf schema %d: %w", id, err)
+	}
+	return res[0], nil
+}
+
+func (d *protobufDecoder) resolveReferences(ctx context.Context, refs []registryReference, files map[string]string) error {
+	for _, ref := range refs {
+		if _, ok := files[ref.Name]; ok {
+			continue
+		}
+		s, err := d.registry.schemaByReference(ctx, ref)
+		if err != nil {
+			return err
+		}
+		files[ref.Name] = s.Schema
+		err = d.resolveReferences(ctx, s.References, files)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// messageDescriptor returns the message at the given path of indexes in fd.
+func messageDescriptor(fd protoreflect.FileDescriptor, indexes []int64) (protoreflect.MessageDescriptor, error) {
+	msgs := fd.Messages()
+	var md protoreflect.MessageDescriptor
+	for _, idx := range indexes {
+		if idx >= int64(msgs.Len()) {
+			return nil, fmt.Errorf("protobuf message index %d out of range", idx)
+		}
+		md = msgs.Get(int(idx))
+		msgs = md.Messages()
+	}
+	return md, nil
+}
+
+// parseWireFormat parses the Confluent wire format, which is a zero magic byte followed by a 4-byte schema ID and the payload.
+func parseWireFormat(value []byte) (int, []byte, error) {
+	if len(value) < 5 || value[0] != 0 {
+		return 0, nil, errors.New("message is not in the schema registry wire format")
+	}
+	return int(binary.BigEndian.Uint32(value[1:5])), value[5:], nil
+}
+
+// wrapValue wraps JSON values that are not objects in an object with a single "value" field.
+func wrapValue(value []byte) ([]byte, error) {
+	if len(value) > 0 && value[0] == '{' {
+		return value, nil
+	}
+	return json.Marshal(map[string]json.RawMessage{"value": value})
+}
+
+// addMetadata adds fields to a JSON object.
+func addMetadata(obj []byte, fields map[string]any) ([]byte, error) {
+	m := make(map[string]any)
+	dec := json.NewDecoder(bytes.NewReader(obj))
+	dec.UseNumber()
+	err := dec.Decode(&m)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range fields {
+		m[k] = v
+	}
+	return json.Marshal(m)
+}
@@ -0,0 +1,157 @@
+package kafka
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testAvroSchema = `{
+	"type": "record",
+	"name": "Event",
+	"fields": [
+		{"name": "name", "type": "string"},
+		{"name": "count", "type": "long"},
+		{"name": "tag", "type": ["null", "string"], "default": null}
+	]
+}`
+
+const testProtoSchema = `syntax = "proto3";
+package test;
+import "common.proto";
+message Event {
+	string name = 1;
+	int64 count = 2;
+	common.Tag tag = 3;
+}
+message Other {
+	message Nested {
+		string value = 1;
+	}
+}`
+
+const testProtoCommonSchema = `syntax = "proto3";
+package common;
+message Tag {
+	string value = 1;
+}`
+
+func newTestRegistry(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var res any
+		switch r.URL.Path {
+		case "/schemas/ids/1":
+			res = map[string]any{"schema": testAvroSchema}
+		case "/schemas/ids/2":
+			res = map[string]any{
+				"schemaType": "PROTOBUF",
+				"schema":     testProtoSchema,
+				"references": []map[string]any{{"name": "common.proto", "subject": "common", "version": 1}},
+			}
+		case "/subjects/common/versions/1":
+			res = map[string]any{"schemaType": "PROTOBUF", "schema": testProtoCommonSchema}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestConnection(t *testing.T, registryURL string) *connection {
+	return &connection{
+		config: &configProperties{SchemaRegistryURL: registryURL},
+		logger: zap.NewNop(),
+	}
+}
+
+func wireFormat(id int, payload ...[]byte) []byte {
+	res := []byte{0, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(res[1:], uint32(id))
+	for _, p := range payload {
+		res = append(res, p...)
+	}
+	return res
+}
+
+func TestJSONDecoder(t *testing.T) {
+	ctx := context.Background()
+	dec, err := newTestConnection(t, "").newDecoder("json")
+	require.NoError(t, err)
+
+	res, err := dec.decode(ctx, []byte("{\n  \"name\": \"a\",\n  \"count\": 5\n}"))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name": "a", "count": 5}`, string(res))
+	require.NotContains(t, string(res), "\n")
+
+	res, err = dec.decode(ctx, []byte(`42`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"value": 42}`, string(res))
+
+	res, err = dec.decode(ctx, wireFormat(3, []byte(`{"name": "b"}`)))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name": "b"}`, string(res))
+
+	_, err = dec.decode(ctx, []byte(`not json`))
+	require.Error(t, err)
+}
+
+func TestAvroDecoder(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestRegistry(t)
+	dec, err := newTestConnection(t, srv.URL).newDecoder("avro")
+	require.NoError(t, err)
+
+	codec, err := goavro.NewCodec(testAvroSchema)
+	require.NoError(t, err)
+	payload, err := codec.BinaryFromNative(nil, map[string]any{"name": "a", "count": int64(5), "tag": goavro.Union("string", "x")})
+	require.NoError(t, err)
+
+	res, err := dec.decode(ctx, wireFormat(1, payload))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name": "a", "count": 5, "tag": "x"}`, string(res))
+
+	_, err = dec.decode(ctx, payload)
+	require.ErrorContains(t, err, "wire format")
+
+	_, err = newTestConnection(t, "").newDecoder("avro")
+	require.ErrorContains(t, err, "schema_registry_url")
+}
+
+func TestProtobufDecoder(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestRegistry(t)
+	dec, err := newTestConnection(t, srv.URL).newDecoder("protobuf")
+	require.NoError(t, err)
+
+	// Event{name: "a", count: 5, tag: {value: "x"}} with the message indexes shorthand for the first message
+	event := []byte{0x0a, 0x01, 'a', 0x10, 0x05, 0x1a, 0x03, 0x0a, 0x01, 'x'}
+	res, err := dec.decode(ctx, wireFormat(2, []byte{0x00}, event))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name": "a", "count": "5", "tag": {"value": "x"}}`, string(res))
+
+	// Other.Nested{value: "y"} with the message indexes [1, 0]
+	nested := []byte{0x0a, 0x01, 'y'}
+	res, err = dec.decode(ctx, wireFormat(2, []byte{0x04, 0x02, 0x00}, nested))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"value": "y"}`, string(res))
+
+	// Out of range message index
+	_, err = dec.decode(ctx, wireFormat(2, []byte{0x02, 0x06}, nested))
+	require.ErrorContains(t, err, "out of range")
+}
+
+func TestAddMetadata(t *testing.T) {
+	res, err := addMetadata([]byte(`{"id": 12345678901234567890}`), map[string]any{"_kafka_offset": int64(3)})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id": 12345678901234567890, "_kafka_offset": 3}`, string(res))
+}
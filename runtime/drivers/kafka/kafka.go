@@ -0,0 +1,297 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"go.uber.org/zap"
+)
+
+func init() {
+	drivers.Register("kafka", driver{})
+	drivers.RegisterAsConnector("kafka", driver{})
+}
+
+var spec = drivers.Spec{
+	DisplayName: "Kafka",
+	Description: "Consume messages from a Kafka topic.",
+	DocsURL:     "https://docs.rilldata.com/reference/connectors/kafka",
+	ConfigProperties: []*drivers.PropertySpec{
+		{
+			Key:         "brokers",
+			Type:        drivers.StringPropertyType,
+			Required:    true,
+			DisplayName: "Brokers",
+			Description: "Comma-separated list of Kafka brokers.",
+			Placeholder: "localhost:9092",
+		},
+		{
+			Key:         "security_protocol",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Security protocol",
+			Description: "One of plaintext, ssl, sasl_plaintext or sasl_ssl.",
+			Default:     "plaintext",
+		},
+		{
+			Key:         "sasl_mechanism",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "SASL mechanism",
+			Description: "One of PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512.",
+		},
+		{
+			Key:    "sasl_username",
+			Secret: true,
+		},
+		{
+			Key:    "sasl_password",
+			Secret: true,
+		},
+		{
+			Key:         "schema_registry_url",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Schema registry URL",
+			Description: "URL of the Confluent-compatible schema registry. Required for Avro and Protobuf messages.",
+		},
+		{
+			Key:    "schema_registry_username",
+			Secret: true,
+		},
+		{
+			Key:    "schema_registry_password",
+			Secret: true,
+		},
+	},
+	SourceProperties: []*drivers.PropertySpec{
+		{
+			Key:         "topic",
+			Type:        drivers.StringPropertyType,
+			Required:    true,
+			DisplayName: "Topic",
+			Description: "Name of the topic to consume.",
+		},
+		{
+			Key:         "format",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Format",
+			Description: "Format of the message values. One of json, avro or protobuf.",
+			Default:     "json",
+		},
+		{
+			Key:         "start_offset",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Start offset",
+			Description: "Where to start consuming on the first ingestion. One of earliest or latest.",
+			Default:     "earliest",
+		},
+	},
+}
+
+type driver struct{}
+
+func (d driver) Open(instanceID string, config map[string]any, client *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	if instanceID == "" {
+		return nil, errors.New("kafka driver can't be shared")
+	}
+
+	conf := &configProperties{}
+	err := mapstructure.WeakDecode(config, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &connection{
+		config: conf,
+		logger: logger,
+	}
+	return conn, nil
+}
+
+func (d driver) Spec() drivers.Spec {
+	return spec
+}
+
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, src map[string]any, logger *zap.Logger) (bool, error) {
+	return false, nil
+}
+
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, nil
+}
+
+type configProperties struct {
+	Brokers                string `mapstructure:"brokers"`
+	SecurityProtocol       string `mapstructure:"security_protocol"`
+	SASLMechanism          string `mapstructure:"sasl_mechanism"`
+	SASLUsername           string `mapstructure:"sasl_username"`
+	SASLPassword           string `mapstructure:"sasl_password"`
+	SchemaRegistryURL      string `mapstructure:"schema_registry_url"`
+	SchemaRegistryUsername string `mapstructure:"schema_registry_username"`
+	SchemaRegistryPassword string `mapstructure:"schema_registry_password"`
+}
+
+type sourceProperties struct {
+	Topic       string `mapstructure:"topic"`
+	Format      string `mapstructure:"format"`
+	StartOffset string `mapstructure:"start_offset"`
+	// BatchSize is the max number of messages in a batch.
+	BatchSize int `mapstructure:"batch_size"`
+	// IncludeMetadata adds the message's partition, offset, timestamp and key to each record.
+	IncludeMetadata bool `mapstructure:"include_metadata"`
+}
+
+func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
+	conf := &sourceProperties{}
+	err := mapstructure.WeakDecode(props, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.Topic == "" {
+		return nil, fmt.Errorf("missing property 'topic'")
+	}
+
+	switch conf.Format {
+	case "":
+		conf.Format = "json"
+	case "json", "avro", "protobuf":
+	default:
+		return nil, fmt.Errorf("invalid format %q (expected json, avro or protobuf)", conf.Format)
+	}
+
+	switch conf.StartOffset {
+	case "":
+		conf.StartOffset = "earliest"
+	case "earliest", "latest":
+	default:
+		return nil, fmt.Errorf("invalid start_offset %q (expected earliest or latest)", conf.StartOffset)
+	}
+
+	if conf.BatchSize < 0 {
+		return nil, fmt.Errorf("invalid batch_size %d", conf.BatchSize)
+	}
+	if conf.BatchSize == 0 {
+		conf.BatchSize = defaultBatchSize
+	}
+
+	return conf, nil
+}
+
+type connection struct {
+	config *configProperties
+	logger *zap.Logger
+
+	registryOnce sync.Once
+	registry     *schemaRegistry
+}
+
+var _ drivers.Handle = &connection{}
+
+var _ drivers.StreamStore = &connection{}
+
+// Driver implements drivers.Connection.
+func (c *connection) Driver() string {
+	return "kafka"
+}
+
+// Config implements drivers.Connection.
+func (c *connection) Config() map[string]any {
+	m := make(map[string]any, 0)
+	_ = mapstructure.Decode(c.config, &m)
+	return m
+}
+
+// Close implements drivers.Connection.
+func (c *connection) Close() error {
+	return nil
+}
+
+// AsRegistry implements drivers.Connection.
+func (c *connection) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// AsCatalogStore implements drivers.Connection.
+func (c *connection) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// AsRepoStore implements drivers.Connection.
+func (c *connection) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+// AsAdmin implements drivers.Handle.
+func (c *connection) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return nil, false
+}
+
+// AsOLAP implements drivers.Connection.
+func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+// AsAI implements drivers.Handle.
+func (c *connection) AsAI(instanceID string) (drivers.AIService, bool) {
+	return nil, false
+}
+
+// Migrate implements drivers.Connection.
+func (c *connection) Migrate(ctx context.Context) (err error) {
+	return nil
+}
+
+// MigrationStatus implements drivers.Connection.
+func (c *connection) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+// AsObjectStore implements drivers.Connection.
+func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+// AsModelExecutor implements drivers.Handle.
+func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	return nil, false
+}
+
+// AsModelManager implements drivers.Handle.
+func (c *connection) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
+	return nil, false
+}
+
+// AsTransporter implements drivers.Connection.
+func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+// AsFileStore implements drivers.Connection.
+func (c *connection) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsSQLStore implements drivers.Connection.
+func (c *connection) AsSQLStore() (drivers.SQLStore, bool) {
+	return nil, false
+}
+
+// AsNotifier implements drivers.Connection.
+func (c *connection) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
+	return nil, drivers.ErrNotNotifier
+}
+
+// schemaRegistry returns a client for the configured schema registry.
+func (c *connection) schemaRegistry() (*schemaRegistry, error) {
+	if c.config.SchemaRegistryURL == "" {
+		return nil, fmt.Errorf("the connector's 'schema_registry_url' must be configured to decode Avro or Protobuf messages")
+	}
+	c.registryOnce.Do(func() {
+		c.registry = newSchemaRegistry(c.config.SchemaRegistryURL, c.config.SchemaRegistryUsername, c.config.SchemaRegistryPassword)
+	})
+	return c.registry, nil
+}
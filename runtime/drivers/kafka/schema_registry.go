@@ -0,0 +1,103 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// schemaRegistry is a minimal client for the Confluent schema registry REST API.
+// Schemas are immutable, so they are cached indefinitely.
+type schemaRegistry struct {
+	url      string
+	username string
+	password string
+	client   *http.Client
+
+	mu      sync.Mutex
+	schemas map[int]*registrySchema
+}
+
+// registrySchema is a schema returned by the schema registry.
+type registrySchema struct {
+	Schema     string              `json:"schema"`
+	SchemaType string              `json:"schemaType"`
+	References []registryReference `json:"references"`
+}
+
+// registryReference is a reference to another schema, such as an imported Protobuf file.
+type registryReference struct {
+	Name    string `json:"name"`
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+}
+
+func newSchemaRegistry(u, username, password string) *schemaRegistry {
+	return &schemaRegistry{
+		url:      strings.TrimSuffix(u, "/"),
+		username: username,
+		password: password,
+		client:   http.DefaultClient,
+		schemas:  make(map[int]*registrySchema),
+	}
+}
+
+// schemaByID returns the schema with the given ID.
+func (r *schemaRegistry) schemaByID(ctx context.Context, id int) (*registrySchema, error) {
+	r.mu.Lock()
+	s, ok := r.schemas[id]
+	r.mu.Unlock()
+	if ok {
+		return s, nil
+	}
+
+	s = &registrySchema{}
+	err := r.get(ctx, fmt.Sprintf("/schemas/ids/%d", id), s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema %d: %w", id, err)
+	}
+
+	r.mu.Lock()
+	r.schemas[id] = s
+	r.mu.Unlock()
+	return s, nil
+}
+
+// schemaByReference returns the schema referenced by ref.
+func (r *schemaRegistry) schemaByReference(ctx context.Context, ref registryReference) (*registrySchema, error) {
+	s := &registrySchema{}
+	err := r.get(ctx, fmt.Sprintf("/subjects/%s/versions/%d", url.PathEscape(ref.Subject), ref.Version), s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema %q version %d: %w", ref.Subject, ref.Version, err)
+	}
+	return s, nil
+}
+
+func (r *schemaRegistry) get(ctx context.Context, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url+path, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.schemaregistry.v1+json")
+	if r.username != "" {
+		req.SetBasicAuth(r.username, r.password)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("schema registry returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -24,6 +24,8 @@ type ModelResult struct {
 	Connector  string
 	Properties map[string]any
 	Table      string
+	// Partitions lists the partitions overwritten by an incremental run that used the partition_overwrite strategy.
+	Partitions []string
 }
 
 type ModelExecutorOptions struct {
@@ -39,4 +41,6 @@ type ModelExecutorOptions struct {
 	Incremental      bool
 	IncrementalRun   bool
 	PreviousResult   *ModelResult
+	// Progress receives progress updates from executors that support it. It may be nil.
+	Progress Progress
 }
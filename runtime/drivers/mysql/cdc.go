@@ -0,0 +1,373 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+
+	gomysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/go-sql-driver/mysql"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/siddontang/go-log/log"
+)
+
+// defaultCDCBatchSize is the default number of changes in a batch passed to the transporter.
+// Batches only end at transaction boundaries, so they may contain more changes.
+const defaultCDCBatchSize = 10000
+
+var _ drivers.ChangeStore = &connection{}
+
+// binlogPosition is the state of change data capture.
+type binlogPosition struct {
+	File string `mapstructure:"file"`
+	Pos  uint32 `mapstructure:"pos"`
+}
+
+func (p binlogPosition) compare(other binlogPosition) int {
+	if p.File != other.File {
+		return strings.Compare(p.File, other.File)
+	}
+	if p.Pos < other.Pos {
+		return -1
+	}
+	if p.Pos > other.Pos {
+		return 1
+	}
+	return 0
+}
+
+func (p binlogPosition) state() map[string]any {
+	return map[string]any{"file": p.File, "pos": int64(p.Pos)}
+}
+
+// StartChanges implements drivers.ChangeStore.
+// It validates the server's binlog settings and returns the current binlog position.
+func (c *connection) StartChanges(ctx context.Context, props map[string]any) (map[string]any, error) {
+	srcProps, err := parseSourceProperties(props)
+	if err != nil {
+		return nil, err
+	}
+	if !srcProps.CDC {
+		return nil, fmt.Errorf("property 'cdc' is not enabled")
+	}
+
+	db, _, err := c.openCDC(srcProps)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var format, image string
+	err = db.QueryRowContext(ctx, "SELECT @@binlog_format, @@binlog_row_image").Scan(&format, &image)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(format, "ROW") || !strings.EqualFold(image, "FULL") {
+		return nil, fmt.Errorf("change data capture requires 'binlog_format=ROW' and 'binlog_row_image=FULL', got %q and %q", format, image)
+	}
+
+	pos, err := masterPosition(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return pos.state(), nil
+}
+
+// ConsumeChanges implements drivers.ChangeStore.
+// It reads row events for the table from the binlog until it reaches the binlog position at the time of the call.
+func (c *connection) ConsumeChanges(ctx context.Context, props, state map[string]any, fn func(*drivers.ChangeBatch) error) error {
+	srcProps, err := parseSourceProperties(props)
+	if err != nil {
+		return err
+	}
+	if !srcProps.CDC {
+		return fmt.Errorf("property 'cdc' is not enabled")
+	}
+
+	var pos binlogPosition
+	err = mapstructure.WeakDecode(state, &pos)
+	if err != nil || pos.File == "" {
+		return fmt.Errorf("invalid replication state %v", state)
+	}
+
+	db, conf, err := c.openCDC(srcProps)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	schema, table := splitTableName(srcProps.Table, conf.DBName)
+	columns, err := tableColumns(ctx, db, schema, table)
+	if err != nil {
+		return err
+	}
+
+	target, err := masterPosition(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	var w drivers.ChangeBatchWriter
+	defer w.Close()
+	if pos.compare(target) >= 0 {
+		return w.Flush(columns.key, pos.state(), fn)
+	}
+
+	host, portStr, err := net.SplitHostPort(conf.Addr)
+	if err != nil {
+		return err
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return err
+	}
+	serverID := srcProps.ServerID
+	if serverID == 0 {
+		// The server ID must be unique among the server's replicas
+		serverID = 1_000_000 + rand.Uint32()%1_000_000 //nolint:gosec // not used for security
+	}
+	syncer := replication.NewBinlogSyncer(replication.BinlogSyncerConfig{
+		ServerID:   serverID,
+		Flavor:     gomysql.MySQLFlavor,
+		Host:       host,
+		Port:       uint16(port),
+		User:       conf.User,
+		Password:   conf.Passwd,
+		TLSConfig:  conf.TLS,
+		UseDecimal: true,
+		Logger:     log.NewDefault(&log.NullHandler{}),
+	})
+	defer syncer.Close()
+
+	streamer, err := syncer.StartSync(gomysql.Position{Name: pos.File, Pos: pos.Pos})
+	if err != nil {
+		return fmt.Errorf("failed to start binlog replication: %w", err)
+	}
+
+	inTx := false
+	for {
+		ev, err := streamer.GetEvent(ctx)
+		if err != nil {
+			return err
+		}
+
+		switch e := ev.Event.(type) {
+		case *replication.RotateEvent:
+			pos = binlogPosition{File: string(e.NextLogName), Pos: uint32(e.Position)}
+			continue
+		case *replication.QueryEvent:
+			q := strings.ToUpper(strings.TrimSpace(string(e.Query)))
+			if q == "BEGIN" {
+				inTx = true
+				continue
+			}
+			inTx = false
+		case *replication.XIDEvent:
+			inTx = false
+		case *replication.RowsEvent:
+			if string(e.Table.Schema) != schema || string(e.Table.Table) != table {
+				break
+			}
+			err = writeRowsEvent(&w, ev.Header.EventType, e, columns)
+			if err != nil {
+				return err
+			}
+		}
+
+		if inTx {
+			continue
+		}
+		// The header's position is the end of the event. Events replayed from the start of the file may have a smaller position.
+		if ev.Header.LogPos > pos.Pos {
+			pos.Pos = ev.Header.LogPos
+		}
+		if pos.compare(target) >= 0 {
+			return w.Flush(columns.key, pos.state(), fn)
+		}
+		if w.Records() >= int64(srcProps.BatchSize) {
+			err = w.Flush(columns.key, pos.state(), fn)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// StopChanges implements drivers.ChangeStore.
+// Reading the binlog doesn't create resources on the server, so there is nothing to release.
+func (c *connection) StopChanges(ctx context.Context, props, state map[string]any) error {
+	return nil
+}
+
+// writeRowsEvent writes the changes in a rows event to w.
+// Update events contain pairs of rows with the before and after image.
+func writeRowsEvent(w *drivers.ChangeBatchWriter, typ replication.EventType, e *replication.RowsEvent, columns *cdcColumns) error {
+	switch typ {
+	case replication.WRITE_ROWS_EVENTv0, replication.WRITE_ROWS_EVENTv1, replication.WRITE_ROWS_EVENTv2:
+		for _, r := range e.Rows {
+			if err := w.Write(drivers.ChangeOperationInsert, columns.row(r)); err != nil {
+				return err
+			}
+		}
+	case replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2:
+		for i := 0; i+1 < len(e.Rows); i += 2 {
+			before := columns.row(e.Rows[i])
+			after := columns.row(e.Rows[i+1])
+			// If the key changed, the row with the old key must be deleted
+			for _, k := range columns.key {
+				if fmt.Sprint(before[k]) != fmt.Sprint(after[k]) {
+					if err := w.Write(drivers.ChangeOperationDelete, before); err != nil {
+						return err
+					}
+					break
+				}
+			}
+			if err := w.Write(drivers.ChangeOperationUpdate, after); err != nil {
+				return err
+			}
+		}
+	case replication.DELETE_ROWS_EVENTv0, replication.DELETE_ROWS_EVENTv1, replication.DELETE_ROWS_EVENTv2:
+		for _, r := range e.Rows {
+			if err := w.Write(drivers.ChangeOperationDelete, columns.row(r)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// cdcColumns describes the columns of a table for mapping binlog rows.
+type cdcColumns struct {
+	// names of the columns in order
+	names []string
+	// key is the names of the primary key columns
+	key []string
+	// binary is true for the columns that are ingested as bytes
+	binary []bool
+}
+
+// row maps the values of a binlog row to column names.
+// The binlog represents TEXT columns like BLOB columns, so only binary columns keep []byte values.
+func (c *cdcColumns) row(values []any) map[string]any {
+	row := make(map[string]any, len(c.names))
+	for i, v := range values {
+		if i >= len(c.names) {
+			break
+		}
+		if b, ok := v.([]byte); ok && !c.binary[i] {
+			v = string(b)
+		}
+		row[c.names[i]] = v
+	}
+	return row
+}
+
+// openCDC opens a connection for reading the binlog position and table metadata.
+func (c *connection) openCDC(srcProps *sourceProperties) (*sql.DB, *mysql.Config, error) {
+	conf, err := c.parseDSN(srcProps)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := sql.Open("mysql", conf.FormatDSN())
+	if err != nil {
+		return nil, nil, err
+	}
+	return db, conf, nil
+}
+
+// masterPosition returns the current binlog position of the server.
+func masterPosition(ctx context.Context, db *sql.DB) (binlogPosition, error) {
+	rows, err := db.QueryContext(ctx, "SHOW MASTER STATUS")
+	if err != nil {
+		// SHOW MASTER STATUS was replaced in MySQL 8.4
+		rows, err = db.QueryContext(ctx, "SHOW BINARY LOG STATUS")
+		if err != nil {
+			return binlogPosition{}, err
+		}
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return binlogPosition{}, err
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return binlogPosition{}, err
+		}
+		return binlogPosition{}, errors.New("binary logging is not enabled on the server")
+	}
+
+	var pos binlogPosition
+	dests := make([]any, len(cols))
+	for i, col := range cols {
+		switch col {
+		case "File":
+			dests[i] = &pos.File
+		case "Position":
+			dests[i] = &pos.Pos
+		default:
+			dests[i] = new(sql.RawBytes)
+		}
+	}
+	err = rows.Scan(dests...)
+	if err != nil {
+		return binlogPosition{}, err
+	}
+	return pos, nil
+}
+
+// tableColumns returns the columns of the table. The table must have a primary key.
+func tableColumns(ctx context.Context, db *sql.DB, schema, table string) (*cdcColumns, error) {
+	rows, err := db.QueryContext(ctx, "SELECT COLUMN_NAME, COLUMN_KEY, DATA_TYPE FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? ORDER BY ORDINAL_POSITION", schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := &cdcColumns{}
+	for rows.Next() {
+		var name, colKey, dataType string
+		if err := rows.Scan(&name, &colKey, &dataType); err != nil {
+			return nil, err
+		}
+		res.names = append(res.names, name)
+		if colKey == "PRI" {
+			res.key = append(res.key, name)
+		}
+		// Same as the types that use byteMapper when querying the table
+		switch strings.ToUpper(dataType) {
+		case "BINARY", "VARBINARY", "TINYBLOB", "BLOB", "MEDIUMBLOB", "LONGBLOB":
+			res.binary = append(res.binary, true)
+		default:
+			res.binary = append(res.binary, false)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(res.names) == 0 {
+		return nil, fmt.Errorf("table %s.%s not found", schema, table)
+	}
+	if len(res.key) == 0 {
+		return nil, fmt.Errorf("table %s.%s has no primary key, which is required for change data capture", schema, table)
+	}
+	return res, nil
+}
+
+// splitTableName splits a table name that may be qualified with a database name.
+func splitTableName(name, defaultSchema string) (string, string) {
+	name = strings.ReplaceAll(name, "`", "")
+	if schema, table, ok := strings.Cut(name, "."); ok {
+		return schema, table
+	}
+	return defaultSchema, name
+}
@@ -0,0 +1,113 @@
+package mysql
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteRowsEvent(t *testing.T) {
+	columns := &cdcColumns{
+		names:  []string{"id", "name", "data"},
+		key:    []string{"id"},
+		binary: []bool{false, false, true},
+	}
+
+	var w drivers.ChangeBatchWriter
+	defer w.Close()
+
+	// Insert two rows
+	err := writeRowsEvent(&w, replication.WRITE_ROWS_EVENTv2, &replication.RowsEvent{Rows: [][]any{
+		{int32(1), []byte("a"), []byte{0x01}},
+		{int32(2), []byte("b"), nil},
+	}}, columns)
+	require.NoError(t, err)
+
+	// Update a row without changing its key, and a row with a changed key
+	err = writeRowsEvent(&w, replication.UPDATE_ROWS_EVENTv2, &replication.RowsEvent{Rows: [][]any{
+		{int32(1), []byte("a"), []byte{0x01}},
+		{int32(1), []byte("aa"), []byte{0x01}},
+		{int32(2), []byte("b"), nil},
+		{int32(3), []byte("b"), nil},
+	}}, columns)
+	require.NoError(t, err)
+
+	// Delete a row
+	err = writeRowsEvent(&w, replication.DELETE_ROWS_EVENTv2, &replication.RowsEvent{Rows: [][]any{
+		{int32(1), []byte("aa"), []byte{0x01}},
+	}}, columns)
+	require.NoError(t, err)
+
+	// Other events are ignored
+	err = writeRowsEvent(&w, replication.TABLE_MAP_EVENT, &replication.RowsEvent{Rows: [][]any{{int32(4), []byte("c"), nil}}}, columns)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), w.Records())
+
+	state := binlogPosition{File: "binlog.000001", Pos: 100}.state()
+	var batch *drivers.ChangeBatch
+	var lines []map[string]any
+	err = w.Flush(columns.key, state, func(b *drivers.ChangeBatch) error {
+		batch = b
+		data, err := os.ReadFile(b.Path)
+		if err != nil {
+			return err
+		}
+		for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+			var row map[string]any
+			if err := json.Unmarshal([]byte(line), &row); err != nil {
+				return err
+			}
+			lines = append(lines, row)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"id"}, batch.Key)
+	require.Equal(t, int64(6), batch.Records)
+	require.Equal(t, state, batch.State)
+
+	type change struct {
+		op   string
+		id   float64
+		name string
+		data any
+	}
+	var changes []change
+	for i, l := range lines {
+		require.Equal(t, float64(i), l[drivers.ChangeSequenceColumn])
+		changes = append(changes, change{op: l[drivers.ChangeOperationColumn].(string), id: l["id"].(float64), name: l["name"].(string), data: l["data"]})
+	}
+	// Binary values are base64 encoded, text values are strings
+	require.Equal(t, []change{
+		{op: "insert", id: 1, name: "a", data: "AQ=="},
+		{op: "insert", id: 2, name: "b", data: nil},
+		{op: "update", id: 1, name: "aa", data: "AQ=="},
+		{op: "delete", id: 2, name: "b", data: nil},
+		{op: "update", id: 3, name: "b", data: nil},
+		{op: "delete", id: 1, name: "aa", data: "AQ=="},
+	}, changes)
+}
+
+func TestBinlogPosition(t *testing.T) {
+	a := binlogPosition{File: "binlog.000001", Pos: 500}
+	require.Equal(t, 0, a.compare(a))
+	require.Equal(t, -1, a.compare(binlogPosition{File: "binlog.000001", Pos: 600}))
+	require.Equal(t, 1, a.compare(binlogPosition{File: "binlog.000001", Pos: 100}))
+	require.Equal(t, -1, a.compare(binlogPosition{File: "binlog.000002", Pos: 4}))
+	require.Equal(t, map[string]any{"file": "binlog.000001", "pos": int64(500)}, a.state())
+}
+
+func TestSplitTableName(t *testing.T) {
+	schema, table := splitTableName("orders", "shop")
+	require.Equal(t, "shop", schema)
+	require.Equal(t, "orders", table)
+
+	schema, table = splitTableName("`sales`.`orders`", "shop")
+	require.Equal(t, "sales", schema)
+	require.Equal(t, "orders", table)
+}
@@ -43,6 +43,20 @@ var spec = drivers.Spec{
 			Placeholder: "username:password@tcp(example.com:3306)/my-db",
 			Hint:        "Either set this or pass --var connector.mysql.dsn=... to rill start",
 		},
+		{
+			Key:         "cdc",
+			Type:        drivers.BooleanPropertyType,
+			DisplayName: "Change data capture",
+			Description: "Apply changes from the binlog to the table after the initial snapshot. Requires 'table'.",
+			Default:     "false",
+		},
+		{
+			Key:         "table",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Table",
+			Description: "Table to ingest instead of a query. Required for change data capture.",
+			Placeholder: "my-db.orders",
+		},
 	},
 	ImplementsSQLStore: true,
 }
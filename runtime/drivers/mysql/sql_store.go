@@ -20,16 +20,7 @@ func (c *connection) Query(ctx context.Context, props map[string]any) (drivers.R
 		return nil, err
 	}
 
-	var dsn string
-	if srcProps.DSN != "" { // get from src properties
-		dsn = srcProps.DSN
-	} else if url, ok := c.config["dsn"].(string); ok && url != "" { // get from driver configs
-		dsn = url
-	} else {
-		return nil, fmt.Errorf("the property 'dsn' is required for MySQL. Provide 'dsn' in the YAML properties or pass '--var connector.mysql.dsn=...' to 'rill start'")
-	}
-
-	conf, err := mysql.ParseDSN(dsn)
+	conf, err := c.parseDSN(srcProps)
 	if err != nil {
 		return nil, err
 	}
@@ -173,17 +164,48 @@ func (r *rowIterator) setSchema() error {
 	return nil
 }
 
+// parseDSN parses the DSN from the source properties or the driver config.
+func (c *connection) parseDSN(srcProps *sourceProperties) (*mysql.Config, error) {
+	var dsn string
+	if srcProps.DSN != "" { // get from src properties
+		dsn = srcProps.DSN
+	} else if url, ok := c.config["dsn"].(string); ok && url != "" { // get from driver configs
+		dsn = url
+	} else {
+		return nil, fmt.Errorf("the property 'dsn' is required for MySQL. Provide 'dsn' in the YAML properties or pass '--var connector.mysql.dsn=...' to 'rill start'")
+	}
+	return mysql.ParseDSN(dsn)
+}
+
 type sourceProperties struct {
-	SQL string `mapstructure:"sql"`
-	DSN string `mapstructure:"dsn"`
+	SQL       string `mapstructure:"sql"`
+	DSN       string `mapstructure:"dsn"`
+	Table     string `mapstructure:"table"`
+	CDC       bool   `mapstructure:"cdc"`
+	ServerID  uint32 `mapstructure:"server_id"`
+	BatchSize int    `mapstructure:"batch_size"`
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 	conf := &sourceProperties{}
-	err := mapstructure.Decode(props, conf)
+	err := mapstructure.WeakDecode(props, conf)
 	if err != nil {
 		return nil, err
 	}
+	if conf.CDC {
+		if conf.Table == "" {
+			return nil, fmt.Errorf("property 'table' is mandatory for connector \"mysql\" when 'cdc' is enabled")
+		}
+		if conf.SQL != "" {
+			return nil, fmt.Errorf("property 'sql' can't be used with 'cdc' for connector \"mysql\", use 'table' instead")
+		}
+		if conf.BatchSize <= 0 {
+			conf.BatchSize = defaultCDCBatchSize
+		}
+	}
+	if conf.SQL == "" && conf.Table != "" {
+		conf.SQL = fmt.Sprintf("SELECT * FROM %s", conf.Table)
+	}
 	if conf.SQL == "" {
 		return nil, fmt.Errorf("property 'sql' is mandatory for connector \"mysql\"")
 	}
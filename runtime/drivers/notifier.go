@@ -13,7 +13,7 @@ type Notifier interface {
 }
 
 type AlertStatus struct {
-	// TODO: Remove ToEmail, ToName once email notifier is created
+	// ToEmail and ToName are set by the email notifier for each recipient
 	ToEmail        string
 	ToName         string
 	Title          string
@@ -24,6 +24,8 @@ type AlertStatus struct {
 	ExecutionError string
 	OpenLink       string
 	EditLink       string
+	// Preview contains the top rows of the alert's query result. It is nil if no preview was requested (or it could not be computed).
+	Preview *Preview
 }
 
 type ScheduledReport struct {
@@ -33,4 +35,21 @@ type ScheduledReport struct {
 	OpenLink       string
 	DownloadLink   string
 	EditLink       string
+	// Attachment contains the report's exported data. It is nil if the data should not be attached (or was too large to attach).
+	Attachment *Attachment
+	// Preview contains the top rows of the report's query result. It is nil if no preview was requested (or it could not be computed).
+	Preview *Preview
+}
+
+// Attachment is a file attached to a notification.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// Preview is a small table of formatted query results included inline in a notification.
+type Preview struct {
+	Columns []string
+	Rows    [][]string
 }
@@ -5,6 +5,60 @@ import "context"
 type ObjectStore interface {
 	// DownloadFiles provides an iterator for downloading and consuming files
 	DownloadFiles(ctx context.Context, src map[string]any) (FileIterator, error)
+	// UploadFiles uploads the files in a local directory to the path in props, preserving their paths relative to the directory.
+	UploadFiles(ctx context.Context, localDir string, props map[string]any) error
+	// ReplaceFiles is like UploadFiles, but replaces the files previously written by models under the path in props.
+	// Files that were not written by a model (see blob.ModelFileNamePrefix) are kept.
+	// The previous files are only removed once the new files have been uploaded to a staging path.
+	ReplaceFiles(ctx context.Context, localDir string, props map[string]any) error
+	// DeleteFiles deletes all files under the path in props.
+	DeleteFiles(ctx context.Context, props map[string]any) error
+	// ResolveTable resolves the live data files of the Iceberg or Delta table at the path in props.
+	ResolveTable(ctx context.Context, props map[string]any) (*ObjectStoreTable, error)
+}
+
+// IncrementalObjectStore is implemented by object stores that can download only the files that are new since a previous download.
+type IncrementalObjectStore interface {
+	// DownloadNewFiles is like DownloadFiles, but skips the files recorded in state, which is nil for the first download.
+	// If a recorded file has changed or been removed since, it downloads all files instead.
+	DownloadNewFiles(ctx context.Context, src, state map[string]any) (FileIterator, *IncrementalDownload, error)
+}
+
+// IncrementalDownload describes the files returned by IncrementalObjectStore.DownloadNewFiles.
+type IncrementalDownload struct {
+	// State to persist once all the downloaded files have been ingested.
+	State map[string]any
+	// Append is true if the downloaded files are new and should be appended to the previously ingested data.
+	// It's false if all files were downloaded and should replace the previously ingested data.
+	Append bool
+}
+
+// ObjectStoreTable is a resolved snapshot of an Iceberg or Delta table in an object store.
+type ObjectStoreTable struct {
+	// Keys are the object keys of the table's live data files in the path's bucket.
+	Keys []string
+	// PartitionColumns are columns that are not stored in the data files. Only Delta tables have such columns.
+	PartitionColumns []TablePartitionColumn
+	// PartitionValues are the values of the partition columns for each key. A nil value is a null partition value.
+	PartitionValues map[string]map[string]*string
+	// Version is the resolved Iceberg snapshot ID or Delta version.
+	Version int64
+}
+
+// TablePartitionColumn is a partition column of a table that is not stored in the data files.
+type TablePartitionColumn struct {
+	Name string
+	// Type is the column's Delta primitive type, such as "string", "date" or "decimal(10,2)".
+	Type string
+}
+
+// TableFileIterator is implemented by FileIterators that download the data files of an Iceberg or Delta table.
+type TableFileIterator interface {
+	FileIterator
+	// PartitionColumns returns the table's partition columns that are not stored in the data files.
+	PartitionColumns() []TablePartitionColumn
+	// PartitionValues returns the values of the partition columns for a downloaded file. A nil value is a null partition value.
+	PartitionValues(file string) map[string]*string
 }
 
 // FileIterator provides ways to iteratively download files from external sources
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/apache/arrow/go/v14/arrow/array"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -36,7 +37,7 @@ type OLAPStore interface {
 	EstimateSize() (int64, bool)
 
 	CreateTableAsSelect(ctx context.Context, name string, view bool, sql string) error
-	InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy IncrementalStrategy, uniqueKey []string) error
+	InsertTableAsSelect(ctx context.Context, name, sql string, opts *InsertTableOptions) (*InsertTableResult, error)
 	DropTable(ctx context.Context, name string, view bool) error
 	RenameTable(ctx context.Context, name, newName string, view bool) error
 	AddTableColumn(ctx context.Context, tableName, columnName string, typ string) error
@@ -51,15 +52,20 @@ type Statement struct {
 	Priority         int
 	LongRunning      bool
 	ExecutionTimeout time.Duration
+	// Arrow is a hint that the caller will consume the result using Result.ArrowRecordReader.
+	// Drivers that can return results natively in Arrow format may then return a Result without Rows.
+	Arrow bool
 }
 
 // Result wraps the results of query.
+// If the query was executed with Statement.Arrow, Rows may be nil and the data must be read using ArrowRecordReader.
 type Result struct {
 	*sqlx.Rows
 	Schema    *runtimev1.StructType
 	cleanupFn func() error
 	cap       int64
 	rows      int64
+	arrow     array.RecordReader
 }
 
 // SetCleanupFunc sets a function, which will be called when the Result is closed.
@@ -87,6 +93,9 @@ func (r *Result) SetCap(n int64) {
 
 // Next wraps rows.Next and enforces the cap set by SetCap.
 func (r *Result) Next() bool {
+	if r.Rows == nil {
+		return false
+	}
 	res := r.Rows.Next()
 	if !res {
 		return false
@@ -102,6 +111,9 @@ func (r *Result) Next() bool {
 
 // Err returns the error of the underlying rows.
 func (r *Result) Err() error {
+	if r.Rows == nil {
+		return nil
+	}
 	err := r.Rows.Err()
 	if err != nil {
 		return err
@@ -117,7 +129,14 @@ func (r *Result) Err() error {
 // Close wraps rows.Close and calls the Result's cleanup function (if it is set).
 // Close should be idempotent.
 func (r *Result) Close() error {
-	firstErr := r.Rows.Close()
+	var firstErr error
+	if r.Rows != nil {
+		firstErr = r.Rows.Close()
+	}
+	if r.arrow != nil {
+		r.arrow.Release()
+		r.arrow = nil
+	}
 	if r.cleanupFn != nil {
 		err := r.cleanupFn()
 		if firstErr == nil {
@@ -164,6 +183,32 @@ const (
 	IncrementalStrategyMerge       IncrementalStrategy = "merge"
 )
 
+// IncrementalStrategyPartitionOverwrite replaces the partitions present in the new data, as identified by InsertTableOptions.PartitionBy (or the table's own partitioning).
+const IncrementalStrategyPartitionOverwrite IncrementalStrategy = "partition_overwrite"
+
+// InsertTableOptions are options for OLAPStore.InsertTableAsSelect.
+type InsertTableOptions struct {
+	// ByName inserts columns by name instead of by position.
+	ByName bool
+	// InPlace inserts into the current version of the table instead of a copy (only applies to OLAPs that version tables).
+	InPlace bool
+	// Strategy is the incremental strategy to use for the insert.
+	Strategy IncrementalStrategy
+	// UniqueKey identifies the columns to merge on for IncrementalStrategyMerge.
+	UniqueKey []string
+	// PartitionBy is a SQL expression that identifies the partition of a row for IncrementalStrategyPartitionOverwrite.
+	PartitionBy string
+	// DeleteMarker is the name of a boolean column in the new data for IncrementalStrategyMerge.
+	// Rows where it's true delete the rows with the same unique key in the target table, but are not inserted. The column itself is never inserted.
+	DeleteMarker string
+}
+
+// InsertTableResult is the result of OLAPStore.InsertTableAsSelect.
+type InsertTableResult struct {
+	// Partitions lists the partitions that were overwritten. It is only set for IncrementalStrategyPartitionOverwrite.
+	Partitions []string
+}
+
 // Dialect enumerates OLAP query languages.
 type Dialect int
 
@@ -173,6 +218,7 @@ const (
 	DialectDruid
 	DialectClickHouse
 	DialectPinot
+	DialectPostgres
 )
 
 func (d Dialect) String() string {
@@ -187,6 +233,8 @@ func (d Dialect) String() string {
 		return "clickhouse"
 	case DialectPinot:
 		return "pinot"
+	case DialectPostgres:
+		return "postgres"
 	default:
 		panic("not implemented")
 	}
@@ -204,6 +252,19 @@ func (d Dialect) EscapeIdentifier(ident string) string {
 	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(ident, "\"", "\"\""))
 }
 
+// EscapeStringValue returns an escaped SQL string literal in the dialect.
+func (d Dialect) EscapeStringValue(s string) string {
+	if d == DialectClickHouse {
+		s = strings.ReplaceAll(s, "\\", "\\\\")
+	}
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", "''"))
+}
+
+// SupportsBucketDimensions returns true if the dialect supports dimensions with a bucket config.
+func (d Dialect) SupportsBucketDimensions() bool {
+	return d == DialectDuckDB || d == DialectClickHouse
+}
+
 func (d Dialect) ConvertToDateTruncSpecifier(grain runtimev1.TimeGrain) string {
 	var str string
 	switch grain {
@@ -309,6 +370,9 @@ func (d Dialect) SafeDivideExpression(numExpr, denExpr string) string {
 	switch d {
 	case DialectDruid:
 		return fmt.Sprintf("SAFE_DIVIDE(%s, CAST(%s AS DOUBLE))", numExpr, denExpr)
+	case DialectPostgres:
+		// Postgres errors on division by zero, so we divide by NULL instead (which matches the other dialects' behavior)
+		return fmt.Sprintf("(%s)/CAST(NULLIF(%s, 0) AS DOUBLE PRECISION)", numExpr, denExpr)
 	default:
 		return fmt.Sprintf("(%s)/CAST(%s AS DOUBLE)", numExpr, denExpr)
 	}
@@ -319,7 +383,7 @@ func (d Dialect) OrderByExpression(name string, desc bool) string {
 	if desc {
 		res += " DESC"
 	}
-	if d == DialectDuckDB {
+	if d == DialectDuckDB || d == DialectPostgres {
 		res += " NULLS LAST"
 	}
 	return res
@@ -433,6 +497,28 @@ func (d Dialect) DateTruncExpr(dim *runtimev1.MetricsViewSpec_DimensionV2, grain
 			return fmt.Sprintf("toTimezone(date_trunc('%s', toTimezone(%s::TIMESTAMP, '%s'))::TIMESTAMP, '%s')", specifier, expr, tz, tz), nil
 		}
 		return fmt.Sprintf("toTimezone(date_trunc('%s', toTimezone(%s::TIMESTAMP, '%s') + INTERVAL %s)::TIMESTAMP - INTERVAL %s, '%s')", specifier, expr, tz, shift, shift, tz), nil
+	case DialectPostgres:
+		var shift string
+		if grain == runtimev1.TimeGrain_TIME_GRAIN_WEEK && firstDayOfWeek > 1 {
+			offset := 8 - firstDayOfWeek
+			shift = fmt.Sprintf("'%d DAY'", offset)
+		} else if grain == runtimev1.TimeGrain_TIME_GRAIN_YEAR && firstMonthOfYear > 1 {
+			offset := 13 - firstMonthOfYear
+			shift = fmt.Sprintf("'%d MONTH'", offset)
+		}
+
+		// NOTE: The Postgres driver sets the session time zone to UTC, so casting TIMESTAMPTZ to TIMESTAMP yields UTC values.
+		if tz == "" {
+			if shift == "" {
+				return fmt.Sprintf("date_trunc('%s', %s::TIMESTAMP)", specifier, expr), nil
+			}
+			return fmt.Sprintf("date_trunc('%s', %s::TIMESTAMP + INTERVAL %s) - INTERVAL %s", specifier, expr, shift, shift), nil
+		}
+
+		if shift == "" {
+			return fmt.Sprintf("timezone('%s', date_trunc('%s', timezone('%s', %s::TIMESTAMPTZ)))::TIMESTAMP", tz, specifier, tz, expr), nil
+		}
+		return fmt.Sprintf("timezone('%s', date_trunc('%s', timezone('%s', %s::TIMESTAMPTZ) + INTERVAL %s) - INTERVAL %s)::TIMESTAMP", tz, specifier, tz, expr, shift, shift), nil
 	case DialectPinot:
 		// TODO: Handle tz instead of ignoring it.
 		// TODO: Handle firstDayOfWeek and firstMonthOfYear. NOTE: We currently error when configuring these for Pinot in runtime/validate.go.
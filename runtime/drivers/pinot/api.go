@@ -0,0 +1,192 @@
+package pinot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// errNotFound is returned by the controller API helpers when the controller responds with 404.
+var errNotFound = errors.New("pinot: not found")
+
+// getSchema returns the schema with the given name. It returns errNotFound if the schema does not exist.
+func (c *connection) getSchema(ctx context.Context, name string) (*pinotSchema, error) {
+	var res pinotSchema
+	err := c.sendRequest(ctx, http.MethodGet, "/schemas/"+url.PathEscape(name), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// createTable creates an offline table and its schema.
+func (c *connection) createTable(ctx context.Context, schema *pinotSchema, config *pinotTableConfig) error {
+	err := c.sendRequest(ctx, http.MethodPost, "/schemas?override=true&force=true", schema, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create schema: %w", err)
+	}
+	err = c.sendRequest(ctx, http.MethodPost, "/tables", config, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create table: %w", err)
+	}
+	return nil
+}
+
+// deleteTable deletes an offline table and its schema. It does not return an error if the table does not exist.
+func (c *connection) deleteTable(ctx context.Context, name string) error {
+	err := c.sendRequest(ctx, http.MethodDelete, "/tables/"+url.PathEscape(name)+"?type=offline", nil, nil)
+	if err != nil && !errors.Is(err, errNotFound) {
+		return err
+	}
+	err = c.sendRequest(ctx, http.MethodDelete, "/schemas/"+url.PathEscape(name), nil, nil)
+	if err != nil && !errors.Is(err, errNotFound) {
+		return err
+	}
+	return nil
+}
+
+// listSegments returns the names of the segments of an offline table.
+func (c *connection) listSegments(ctx context.Context, table string) ([]string, error) {
+	var res []map[string][]string
+	err := c.sendRequest(ctx, http.MethodGet, "/segments/"+url.PathEscape(table)+"?type=OFFLINE", nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	var segments []string
+	for _, m := range res {
+		segments = append(segments, m["OFFLINE"]...)
+	}
+	return segments, nil
+}
+
+// startReplaceSegments starts an atomic replacement of segmentsFrom with segmentsTo.
+// The new segments are not queryable until endReplaceSegments is called, at which point the old segments are removed from queries.
+func (c *connection) startReplaceSegments(ctx context.Context, table string, segmentsFrom, segmentsTo []string) (string, error) {
+	body := map[string]any{"segmentsFrom": segmentsFrom, "segmentsTo": segmentsTo}
+	var res struct {
+		SegmentLineageEntryID string `json:"segmentLineageEntryId"`
+	}
+	err := c.sendRequest(ctx, http.MethodPost, "/segments/"+url.PathEscape(offlineTableName(table))+"/startReplaceSegments?forceCleanup=true", body, &res)
+	if err != nil {
+		return "", err
+	}
+	return res.SegmentLineageEntryID, nil
+}
+
+func (c *connection) endReplaceSegments(ctx context.Context, table, lineageID string) error {
+	return c.sendRequest(ctx, http.MethodPost, "/segments/"+url.PathEscape(offlineTableName(table))+"/endReplaceSegments?segmentLineageEntryId="+url.QueryEscape(lineageID), nil, nil)
+}
+
+func (c *connection) revertReplaceSegments(ctx context.Context, table, lineageID string) error {
+	return c.sendRequest(ctx, http.MethodPost, "/segments/"+url.PathEscape(offlineTableName(table))+"/revertReplaceSegments?forceRevert=true&segmentLineageEntryId="+url.QueryEscape(lineageID), nil, nil)
+}
+
+// submitIngestionTask submits a batch ingestion job that builds a segment with the given name from the Parquet files in inputDir and pushes it to the table.
+// The job runs as a SegmentGenerationAndPushTask on Pinot's minions, which must be able to read inputDir. It returns the name of the task.
+func (c *connection) submitIngestionTask(ctx context.Context, table, segmentName, inputDir string) (string, error) {
+	body := map[string]any{
+		"taskType":  "SegmentGenerationAndPushTask",
+		"tableName": offlineTableName(table),
+		"taskName":  segmentName,
+		"taskConfigs": map[string]string{
+			"inputDirURI":               inputDir,
+			"inputFormat":               "parquet",
+			"includeFileNamePattern":    "glob:**/*.parquet",
+			"push.mode":                 "tar",
+			"segmentNameGenerator.type": "fixed",
+			"segmentNameGenerator.configs.segment.name": segmentName,
+		},
+	}
+	// The response maps the table name to the name of the scheduled task
+	var res map[string]string
+	err := c.sendRequest(ctx, http.MethodPost, "/tasks/execute", body, &res)
+	if err != nil {
+		return "", err
+	}
+	task, ok := res[offlineTableName(table)]
+	if !ok {
+		return "", fmt.Errorf("pinot: no task was scheduled for table %q", table)
+	}
+	return task, nil
+}
+
+// taskState returns the state of a minion task, such as "IN_PROGRESS", "COMPLETED" or "FAILED".
+func (c *connection) taskState(ctx context.Context, task string) (string, error) {
+	var state string
+	err := c.sendRequest(ctx, http.MethodGet, "/tasks/task/"+url.PathEscape(task)+"/state", nil, &state)
+	if err != nil {
+		return "", err
+	}
+	return state, nil
+}
+
+// deleteTask deletes a minion task. Running tasks are stopped.
+func (c *connection) deleteTask(ctx context.Context, task string) error {
+	return c.sendRequest(ctx, http.MethodDelete, "/tasks/task/"+url.PathEscape(task)+"?forceDelete=true", nil, nil)
+}
+
+// sendRequest sends a request to the controller API. The body (if any) is encoded as JSON, and the response is decoded into out (if not nil).
+func (c *connection) sendRequest(ctx context.Context, method, path string, body, out any) error {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return c.do(req, out)
+}
+
+func (c *connection) do(req *http.Request, out any) error {
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		var errResp struct {
+			Error string `json:"error"`
+		}
+		if json.Unmarshal(respBody, &errResp) == nil && errResp.Error != "" {
+			return fmt.Errorf("pinot: request failed with status %d: %s", resp.StatusCode, errResp.Error)
+		}
+		return fmt.Errorf("pinot: request failed with status %d", resp.StatusCode)
+	}
+
+	if out != nil && len(respBody) > 0 {
+		return json.Unmarshal(respBody, out)
+	}
+	return nil
+}
+
+func offlineTableName(table string) string {
+	return table + "_OFFLINE"
+}
@@ -0,0 +1,485 @@
+package pinot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+// segmentSizeBytes is the approximate size of the Parquet files exported from DuckDB. An ingestion task builds one segment per file.
+const segmentSizeBytes = 256 * 1024 * 1024
+
+// taskPollInterval is how often the state of ingestion tasks is polled.
+const taskPollInterval = 2 * time.Second
+
+type pinotTableConfig struct {
+	TableName      string `json:"tableName"`
+	TableType      string `json:"tableType"`
+	SegmentsConfig struct {
+		Replication    string `json:"replication"`
+		TimeColumnName string `json:"timeColumnName,omitempty"`
+	} `json:"segmentsConfig"`
+	Tenants          map[string]any `json:"tenants"`
+	TableIndexConfig struct {
+		LoadMode string `json:"loadMode"`
+	} `json:"tableIndexConfig"`
+	Metadata map[string]any `json:"metadata"`
+}
+
+// ingestOptions configure how data is pushed to a Pinot table.
+type ingestOptions struct {
+	// Replace atomically replaces all existing data in the table. If false, the new data is appended.
+	Replace bool
+	// TimeColumn is the primary time column of the table. If empty, the first timestamp column is used (if any).
+	TimeColumn string
+	// Replication is the number of replicas of each segment.
+	Replication int
+	// AcquireConnector acquires the connector that data files are staged in (see stageFiles).
+	AcquireConnector func(ctx context.Context, name string) (drivers.Handle, func(), error)
+}
+
+// exportedTable is a table exported to Parquet files in a local directory, ready to be pushed to Pinot.
+type exportedTable struct {
+	schema *runtimev1.StructType
+	dir    string
+}
+
+// exportForPinot exports the result of a DuckDB query to Parquet files in a new temporary directory.
+// Column types are converted to types that Pinot's Parquet reader handles consistently (see exportExpr).
+// The caller must remove the directory when done.
+func exportForPinot(ctx context.Context, olap drivers.OLAPStore, sql string, args []any, priority int) (*exportedTable, error) {
+	res, err := olap.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT * FROM (%s\n) LIMIT 0", sql),
+		Args:     args,
+		Priority: priority,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer schema: %w", err)
+	}
+	schema := res.Schema
+	if err := res.Close(); err != nil {
+		return nil, err
+	}
+	if len(schema.Fields) == 0 {
+		return nil, fmt.Errorf("the query does not return any columns")
+	}
+
+	dir, err := os.MkdirTemp("", "pinot_export")
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := make([]string, len(schema.Fields))
+	for i, f := range schema.Fields {
+		exprs[i] = exportExpr(f)
+	}
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("COPY (SELECT %s FROM (%s\n)) TO '%s' (FORMAT PARQUET, FILE_SIZE_BYTES %d)", strings.Join(exprs, ", "), sql, dir, segmentSizeBytes),
+		Args:     args,
+		Priority: priority,
+	})
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, fmt.Errorf("failed to export data: %w", err)
+	}
+
+	return &exportedTable{schema: schema, dir: dir}, nil
+}
+
+// files returns the Parquet files in the export directory.
+func (t *exportedTable) files() ([]string, error) {
+	entries, err := os.ReadDir(t.dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".parquet") {
+			files = append(files, filepath.Join(t.dir, e.Name()))
+		}
+	}
+	return files, nil
+}
+
+// exportExpr returns a DuckDB expression that converts a column to the representation expected by its Pinot field spec.
+func exportExpr(f *runtimev1.StructType_Field) string {
+	col := drivers.DialectDuckDB.EscapeIdentifier(f.Name)
+	switch f.Type.Code {
+	case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_DATE:
+		// Pinot's TIMESTAMP type is stored as epoch millis
+		return fmt.Sprintf("epoch_ms(%s::TIMESTAMP) AS %s", col, col)
+	case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256, runtimev1.Type_CODE_UINT64, runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256, runtimev1.Type_CODE_DECIMAL:
+		return fmt.Sprintf("%s::DOUBLE AS %s", col, col)
+	case runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON:
+		return fmt.Sprintf("to_json(%s)::VARCHAR AS %s", col, col)
+	case runtimev1.Type_CODE_ARRAY:
+		if isMultiValueElementType(f.Type.ArrayElementType) {
+			return col
+		}
+		return fmt.Sprintf("to_json(%s)::VARCHAR AS %s", col, col)
+	}
+	if _, ok := pinotDataType(f.Type); ok {
+		return col
+	}
+	return fmt.Sprintf("%s::VARCHAR AS %s", col, col)
+}
+
+// pinotSchemaFor infers a Pinot schema from the schema of a query result.
+// Timestamps become date time fields, floating point numbers become metrics, and all other columns become dimensions.
+func pinotSchemaFor(name string, schema *runtimev1.StructType) *pinotSchema {
+	res := &pinotSchema{
+		SchemaName:                    name,
+		EnableColumnBasedNullHandling: true,
+	}
+	for _, f := range schema.Fields {
+		spec := pinotFieldSpec{Name: f.Name}
+		typ := f.Type
+		if typ.Code == runtimev1.Type_CODE_ARRAY {
+			if isMultiValueElementType(typ.ArrayElementType) {
+				spec.SingleValueField = boolPtr(false)
+				typ = typ.ArrayElementType
+			}
+		}
+
+		dt, ok := pinotDataType(typ)
+		if !ok {
+			dt = "STRING"
+			if isJSONType(typ.Code) {
+				dt = "JSON"
+			}
+		}
+		spec.DataType = dt
+
+		switch {
+		case dt == "TIMESTAMP":
+			spec.Format = "1:MILLISECONDS:TIMESTAMP"
+			spec.Granularity = "1:MILLISECONDS"
+			res.DateTimeFieldSpecs = append(res.DateTimeFieldSpecs, spec)
+		case (dt == "FLOAT" || dt == "DOUBLE") && spec.SingleValueField == nil:
+			res.MetricFieldSpecs = append(res.MetricFieldSpecs, spec)
+		default:
+			res.DimensionFieldSpecs = append(res.DimensionFieldSpecs, spec)
+		}
+	}
+	return res
+}
+
+// pinotDataType maps a runtime type to a Pinot data type. It returns false for types that need conversion to a string or JSON.
+func pinotDataType(t *runtimev1.Type) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	switch t.Code {
+	case runtimev1.Type_CODE_BOOL:
+		return "BOOLEAN", true
+	case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16:
+		return "INT", true
+	case runtimev1.Type_CODE_INT64, runtimev1.Type_CODE_UINT32:
+		return "LONG", true
+	case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256, runtimev1.Type_CODE_UINT64, runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256, runtimev1.Type_CODE_DECIMAL, runtimev1.Type_CODE_FLOAT64:
+		return "DOUBLE", true
+	case runtimev1.Type_CODE_FLOAT32:
+		return "FLOAT", true
+	case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_DATE:
+		return "TIMESTAMP", true
+	case runtimev1.Type_CODE_STRING:
+		return "STRING", true
+	case runtimev1.Type_CODE_BYTES:
+		return "BYTES", true
+	default:
+		return "", false
+	}
+}
+
+// isMultiValueElementType returns true if arrays of the type can be stored as multi-value fields without conversion.
+func isMultiValueElementType(t *runtimev1.Type) bool {
+	if t == nil {
+		return false
+	}
+	switch t.Code {
+	case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64,
+		runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32,
+		runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64, runtimev1.Type_CODE_STRING:
+		return true
+	default:
+		return false
+	}
+}
+
+func isJSONType(code runtimev1.Type_Code) bool {
+	return code == runtimev1.Type_CODE_STRUCT || code == runtimev1.Type_CODE_MAP || code == runtimev1.Type_CODE_JSON || code == runtimev1.Type_CODE_ARRAY
+}
+
+// pinotTableConfigFor builds the config of an offline table for the given schema.
+func pinotTableConfigFor(schema *pinotSchema, opts *ingestOptions) (*pinotTableConfig, error) {
+	cfg := &pinotTableConfig{
+		TableName: schema.SchemaName,
+		TableType: "OFFLINE",
+		Tenants:   map[string]any{},
+		Metadata:  map[string]any{},
+	}
+	cfg.SegmentsConfig.Replication = fmt.Sprint(max(opts.Replication, 1))
+	cfg.TableIndexConfig.LoadMode = "MMAP"
+
+	if opts.TimeColumn != "" {
+		found := slices.ContainsFunc(schema.DateTimeFieldSpecs, func(f pinotFieldSpec) bool { return f.Name == opts.TimeColumn })
+		if !found {
+			return nil, fmt.Errorf("time column %q not found or is not a timestamp", opts.TimeColumn)
+		}
+		cfg.SegmentsConfig.TimeColumnName = opts.TimeColumn
+	} else if len(schema.DateTimeFieldSpecs) > 0 {
+		cfg.SegmentsConfig.TimeColumnName = schema.DateTimeFieldSpecs[0].Name
+	}
+
+	return cfg, nil
+}
+
+// pushTable pushes exported Parquet files as segments to a Pinot table, creating the table if it doesn't exist.
+// If opts.Replace is true, the table's existing segments are atomically swapped for the new segments.
+// If the table's schema has changed, the table is dropped and re-created (which is not atomic).
+func (c *connection) pushTable(ctx context.Context, table string, exp *exportedTable, opts *ingestOptions, progress drivers.Progress) error {
+	schema := pinotSchemaFor(table, exp.schema)
+	cfg, err := pinotTableConfigFor(schema, opts)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.getSchema(ctx, table)
+	if err != nil && !errors.Is(err, errNotFound) {
+		return fmt.Errorf("failed to get existing schema: %w", err)
+	}
+	if existing != nil && !schemasEqual(existing, schema) {
+		if !opts.Replace {
+			return fmt.Errorf("the schema of the new data does not match the schema of table %q", table)
+		}
+		c.logger.Info("pinot: schema changed, re-creating table", zap.String("table", table))
+		err = c.deleteTable(ctx, table)
+		if err != nil {
+			return fmt.Errorf("failed to drop table: %w", err)
+		}
+		existing = nil
+	}
+	if existing == nil {
+		err = c.createTable(ctx, schema, cfg)
+		if err != nil {
+			return err
+		}
+	}
+
+	files, err := exp.files()
+	if err != nil {
+		return err
+	}
+	progress.Target(int64(len(files)), drivers.ProgressUnitFile)
+
+	now := time.Now().UnixMilli()
+	segments := make([]string, len(files))
+	for i := range files {
+		segments[i] = fmt.Sprintf("%s_%d_%d", table, now, i)
+	}
+
+	dirs, cleanup, err := c.stageFiles(ctx, fmt.Sprintf("%s_%d", table, now), exp, files, segments, opts)
+	if err != nil {
+		return fmt.Errorf("failed to stage files: %w", err)
+	}
+	defer cleanup()
+
+	var lineageID string
+	if opts.Replace {
+		oldSegments, err := c.listSegments(ctx, table)
+		if err != nil {
+			return fmt.Errorf("failed to list segments: %w", err)
+		}
+		if len(oldSegments) > 0 {
+			lineageID, err = c.startReplaceSegments(ctx, table, oldSegments, segments)
+			if err != nil {
+				return fmt.Errorf("failed to start segment replacement: %w", err)
+			}
+		}
+	}
+
+	err = c.ingestStagedFiles(ctx, table, segments, dirs, progress)
+	if err != nil {
+		err = fmt.Errorf("failed to ingest segments: %w", err)
+	}
+
+	if lineageID != "" {
+		if err != nil {
+			// Use a separate ctx since ctx may have been cancelled
+			revertCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			if revertErr := c.revertReplaceSegments(revertCtx, table, lineageID); revertErr != nil {
+				c.logger.Warn("pinot: failed to revert segment replacement", zap.String("table", table), zap.Error(revertErr))
+			}
+			return err
+		}
+		err = c.endReplaceSegments(ctx, table, lineageID)
+		if err != nil {
+			return fmt.Errorf("failed to end segment replacement: %w", err)
+		}
+	}
+
+	return err
+}
+
+// stageFiles uploads the files to the staging path, so they can be read by the ingestion tasks that run on Pinot's minions.
+// Each file is uploaded to its own directory named after its segment, since an ingestion task builds a segment from all the files in a directory.
+// The files are moved out of the export directory. It returns the paths of the directories and a function that removes the staged files.
+func (c *connection) stageFiles(ctx context.Context, runID string, exp *exportedTable, files, segments []string, opts *ingestOptions) ([]string, func(), error) {
+	if c.stagingConnector == "" || c.stagingPath == "" {
+		return nil, nil, fmt.Errorf("the 'staging_connector' and 'staging_path' properties of the Pinot connector must be set to ingest data")
+	}
+	if opts.AcquireConnector == nil {
+		return nil, nil, fmt.Errorf("can't acquire staging connector %q", c.stagingConnector)
+	}
+	handle, release, err := opts.AcquireConnector(ctx, c.stagingConnector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to acquire staging connector %q: %w", c.stagingConnector, err)
+	}
+	store, ok := handle.AsObjectStore()
+	if !ok {
+		release()
+		return nil, nil, fmt.Errorf("staging connector %q is not an object store", c.stagingConnector)
+	}
+
+	localDir, err := os.MkdirTemp(exp.dir, "staging")
+	if err != nil {
+		release()
+		return nil, nil, err
+	}
+	defer os.RemoveAll(localDir)
+
+	runPath := strings.TrimSuffix(c.stagingPath, "/") + "/" + runID
+	dirs := make([]string, len(files))
+	for i, f := range files {
+		dir := filepath.Join(localDir, segments[i])
+		if err := os.Mkdir(dir, os.ModePerm); err != nil {
+			release()
+			return nil, nil, err
+		}
+		if err := os.Rename(f, filepath.Join(dir, filepath.Base(f))); err != nil {
+			release()
+			return nil, nil, err
+		}
+		dirs[i] = runPath + "/" + segments[i]
+	}
+
+	cleanup := func() {
+		defer release()
+		// Use a separate ctx since ctx may have been cancelled
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := store.DeleteFiles(ctx, map[string]any{"path": runPath}); err != nil {
+			c.logger.Warn("pinot: failed to remove staged files", zap.String("path", runPath), zap.Error(err))
+		}
+	}
+
+	err = store.UploadFiles(ctx, localDir, map[string]any{"path": runPath})
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return dirs, cleanup, nil
+}
+
+// ingestStagedFiles runs an ingestion task for each staged directory and waits for the tasks to complete.
+// If it returns an error (including when ctx is cancelled), the pending tasks are cancelled so they don't keep pushing segments.
+func (c *connection) ingestStagedFiles(ctx context.Context, table string, segments, dirs []string, progress drivers.Progress) (err error) {
+	var pending []string
+	defer func() {
+		if err != nil {
+			c.cancelIngestionTasks(pending)
+		}
+	}()
+
+	for i, dir := range dirs {
+		task, err := c.submitIngestionTask(ctx, table, segments[i], dir)
+		if err != nil {
+			return fmt.Errorf("failed to submit ingestion task: %w", err)
+		}
+		pending = append(pending, task)
+	}
+
+	ticker := time.NewTicker(taskPollInterval)
+	defer ticker.Stop()
+	for {
+		var running []string
+		for _, task := range pending {
+			state, err := c.taskState(ctx, task)
+			if err != nil {
+				return fmt.Errorf("failed to get state of ingestion task %q: %w", task, err)
+			}
+			switch state {
+			case "COMPLETED":
+				progress.Observe(1, drivers.ProgressUnitFile)
+			case "FAILED", "ABORTED", "STOPPED", "TIMED_OUT":
+				return fmt.Errorf("ingestion task %q ended with state %s", task, state)
+			default:
+				running = append(running, task)
+			}
+		}
+		pending = running
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// cancelIngestionTasks deletes the given ingestion tasks, which stops them if they are still running.
+// It uses a separate ctx since it's usually called after the caller's ctx has been cancelled.
+func (c *connection) cancelIngestionTasks(tasks []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	for _, task := range tasks {
+		err := c.deleteTask(ctx, task)
+		if err != nil && !errors.Is(err, errNotFound) {
+			c.logger.Warn("pinot: failed to cancel ingestion task", zap.String("task", task), zap.Error(err))
+		}
+	}
+}
+
+// schemasEqual returns true if the two schemas have the same fields with the same types.
+func schemasEqual(a, b *pinotSchema) bool {
+	fields := func(s *pinotSchema) map[string]string {
+		m := make(map[string]string)
+		add := func(kind string, specs []pinotFieldSpec) {
+			for _, f := range specs {
+				single := f.SingleValueField == nil || *f.SingleValueField
+				m[f.Name] = fmt.Sprintf("%s:%s:%v", kind, f.DataType, single)
+			}
+		}
+		add("dimension", s.DimensionFieldSpecs)
+		add("metric", s.MetricFieldSpecs)
+		add("datetime", s.DateTimeFieldSpecs)
+		return m
+	}
+	fa, fb := fields(a), fields(b)
+	if len(fa) != len(fb) {
+		return false
+	}
+	for k, v := range fa {
+		if fb[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,331 @@
+package pinot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var testStructType = &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+	{Name: "ts", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP}},
+	{Name: "country", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+	{Name: "clicks", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT128}},
+	{Name: "revenue", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64}},
+	{Name: "tags", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_ARRAY, ArrayElementType: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}}},
+	{Name: "props", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRUCT}},
+	{Name: "id", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_UUID}},
+}}
+
+func TestPinotSchemaFor(t *testing.T) {
+	s := pinotSchemaFor("events", testStructType)
+	require.Equal(t, "events", s.SchemaName)
+	require.True(t, s.EnableColumnBasedNullHandling)
+
+	require.Len(t, s.DateTimeFieldSpecs, 1)
+	require.Equal(t, "ts", s.DateTimeFieldSpecs[0].Name)
+	require.Equal(t, "1:MILLISECONDS:TIMESTAMP", s.DateTimeFieldSpecs[0].Format)
+
+	require.Len(t, s.MetricFieldSpecs, 2)
+	require.Equal(t, "clicks", s.MetricFieldSpecs[0].Name)
+	require.Equal(t, "DOUBLE", s.MetricFieldSpecs[0].DataType)
+
+	dims := map[string]pinotFieldSpec{}
+	for _, f := range s.DimensionFieldSpecs {
+		dims[f.Name] = f
+	}
+	require.Len(t, dims, 4)
+	require.Equal(t, "STRING", dims["tags"].DataType)
+	require.False(t, *dims["tags"].SingleValueField)
+	require.Equal(t, "JSON", dims["props"].DataType)
+	require.Equal(t, "STRING", dims["id"].DataType)
+
+	// Single value fields must not set singleValueField (Pinot defaults it to true)
+	b, err := json.Marshal(dims["country"])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"country","dataType":"STRING"}`, string(b))
+
+	cfg, err := pinotTableConfigFor(s, &ingestOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "ts", cfg.SegmentsConfig.TimeColumnName)
+	require.Equal(t, "1", cfg.SegmentsConfig.Replication)
+
+	_, err = pinotTableConfigFor(s, &ingestOptions{TimeColumn: "country"})
+	require.Error(t, err)
+}
+
+func TestExportExpr(t *testing.T) {
+	var exprs []string
+	for _, f := range testStructType.Fields {
+		exprs = append(exprs, exportExpr(f))
+	}
+	require.Equal(t, []string{
+		`epoch_ms("ts"::TIMESTAMP) AS "ts"`,
+		`"country"`,
+		`"clicks"::DOUBLE AS "clicks"`,
+		`"revenue"`,
+		`"tags"`,
+		`to_json("props")::VARCHAR AS "props"`,
+		`"id"::VARCHAR AS "id"`,
+	}, exprs)
+}
+
+func TestPushTable(t *testing.T) {
+	ctrl := newMockController(t)
+	c := &connection{baseURL: ctrl.srv.URL, logger: zap.NewNop(), stagingConnector: "s3", stagingPath: "s3://bucket/pinot/"}
+	store := &mockStagingStore{}
+
+	// Staging moves the exported files, so they are written before each push
+	dir := t.TempDir()
+	exp := &exportedTable{schema: testStructType, dir: dir}
+	pushTable := func(exp *exportedTable, opts *ingestOptions) error {
+		for _, name := range []string{"data_0.parquet", "data_1.parquet"} {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("PAR1"), 0o644))
+		}
+		opts.AcquireConnector = store.acquire
+		return c.pushTable(context.Background(), "events", exp, opts, drivers.NoOpProgress{})
+	}
+
+	// Creates the table and pushes two segments
+	err := pushTable(exp, &ingestOptions{Replace: true})
+	require.NoError(t, err)
+	require.Len(t, ctrl.segments, 2)
+	require.Equal(t, 1, ctrl.tablesCreated)
+	require.Empty(t, ctrl.replaced)
+
+	// Each file is staged in a directory named after its segment, and the staged files are removed afterwards
+	require.Len(t, store.uploaded, 2)
+	for i, seg := range ctrl.segments {
+		require.True(t, strings.HasPrefix(ctrl.inputDirs[i], "s3://bucket/pinot/events_"))
+		require.True(t, strings.HasSuffix(ctrl.inputDirs[i], "/"+seg))
+	}
+	require.Len(t, store.deleted, 1)
+
+	// Refresh atomically replaces the existing segments
+	old := append([]string{}, ctrl.segments...)
+	err = pushTable(exp, &ingestOptions{Replace: true})
+	require.NoError(t, err)
+	require.Equal(t, old, ctrl.replaced)
+	require.True(t, ctrl.replaceEnded)
+	require.Equal(t, 1, ctrl.tablesCreated)
+
+	// Changing the schema re-creates the table
+	changed := &exportedTable{schema: &runtimev1.StructType{Fields: testStructType.Fields[:2]}, dir: dir}
+	err = pushTable(changed, &ingestOptions{Replace: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, ctrl.tablesCreated)
+
+	// Appending with a different schema fails
+	err = pushTable(exp, &ingestOptions{})
+	require.ErrorContains(t, err, "does not match")
+
+	// A failed ingestion task reverts the replacement
+	ctrl.mu.Lock()
+	ctrl.taskState = "FAILED"
+	ctrl.mu.Unlock()
+	err = pushTable(changed, &ingestOptions{Replace: true})
+	require.ErrorContains(t, err, "ended with state FAILED")
+	require.True(t, ctrl.reverted)
+	require.Len(t, ctrl.getDeletedTasks(), 2)
+
+	// Ingestion requires a staging connector
+	c.stagingConnector = ""
+	err = pushTable(changed, &ingestOptions{Replace: true})
+	require.ErrorContains(t, err, "staging_connector")
+}
+
+func TestIngestStagedFilesCancelled(t *testing.T) {
+	ctrl := newMockController(t)
+	ctrl.taskState = "IN_PROGRESS"
+	c := &connection{baseURL: ctrl.srv.URL, logger: zap.NewNop()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- c.ingestStagedFiles(ctx, "events", []string{"events_1_0", "events_1_1"}, []string{"s3://bucket/events_1_0", "s3://bucket/events_1_1"}, drivers.NoOpProgress{})
+	}()
+
+	// Cancel once the tasks are running
+	require.Eventually(t, func() bool {
+		ctrl.mu.Lock()
+		defer ctrl.mu.Unlock()
+		return ctrl.polled > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	require.ErrorIs(t, <-errCh, context.Canceled)
+
+	// The running tasks are cancelled
+	require.ElementsMatch(t, []string{
+		"Task_SegmentGenerationAndPushTask_events_1_0",
+		"Task_SegmentGenerationAndPushTask_events_1_1",
+	}, ctrl.getDeletedTasks())
+}
+
+// mockStagingStore is an object store that records the files staged in it.
+type mockStagingStore struct {
+	drivers.Handle
+	drivers.ObjectStore
+
+	uploaded []string
+	deleted  []string
+}
+
+func (m *mockStagingStore) acquire(ctx context.Context, name string) (drivers.Handle, func(), error) {
+	return m, func() {}, nil
+}
+
+func (m *mockStagingStore) AsObjectStore() (drivers.ObjectStore, bool) {
+	return m, true
+}
+
+func (m *mockStagingStore) UploadFiles(ctx context.Context, localDir string, props map[string]any) error {
+	return filepath.WalkDir(localDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(localDir, path)
+		if err != nil {
+			return err
+		}
+		m.uploaded = append(m.uploaded, props["path"].(string)+"/"+filepath.ToSlash(rel))
+		return nil
+	})
+}
+
+func (m *mockStagingStore) DeleteFiles(ctx context.Context, props map[string]any) error {
+	m.deleted = append(m.deleted, props["path"].(string))
+	return nil
+}
+
+type mockController struct {
+	srv *httptest.Server
+
+	mu            sync.Mutex
+	schema        *pinotSchema
+	tablesCreated int
+	segments      []string
+	replaced      []string
+	replaceEnded  bool
+	reverted      bool
+	inputDirs     []string
+	taskState     string
+	polled        int
+	deletedTasks  []string
+}
+
+func newMockController(t *testing.T) *mockController {
+	m := &mockController{taskState: "COMPLETED"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /schemas/events", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.schema == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(m.schema)
+	})
+	mux.HandleFunc("POST /schemas", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.schema = &pinotSchema{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(m.schema))
+	})
+	mux.HandleFunc("POST /tables", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.tablesCreated++
+	})
+	mux.HandleFunc("DELETE /tables/events", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.segments = nil
+	})
+	mux.HandleFunc("DELETE /schemas/events", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.schema = nil
+	})
+	mux.HandleFunc("GET /segments/events", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		_ = json.NewEncoder(w).Encode([]map[string][]string{{"OFFLINE": m.segments}})
+	})
+	mux.HandleFunc("POST /segments/events_OFFLINE/startReplaceSegments", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		var body struct {
+			SegmentsFrom []string `json:"segmentsFrom"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		m.replaced = body.SegmentsFrom
+		m.replaceEnded = false
+		_, _ = w.Write([]byte(`{"segmentLineageEntryId":"lineage-1"}`))
+	})
+	mux.HandleFunc("POST /segments/events_OFFLINE/endReplaceSegments", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		require.Equal(t, "lineage-1", r.URL.Query().Get("segmentLineageEntryId"))
+		m.segments = m.segments[len(m.replaced):]
+		m.replaceEnded = true
+	})
+	mux.HandleFunc("POST /segments/events_OFFLINE/revertReplaceSegments", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		require.Equal(t, "lineage-1", r.URL.Query().Get("segmentLineageEntryId"))
+		m.segments = m.segments[:len(m.replaced)]
+		m.reverted = true
+	})
+	mux.HandleFunc("POST /tasks/execute", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		var body struct {
+			TaskType    string            `json:"taskType"`
+			TableName   string            `json:"tableName"`
+			TaskConfigs map[string]string `json:"taskConfigs"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "SegmentGenerationAndPushTask", body.TaskType)
+		require.Equal(t, "events_OFFLINE", body.TableName)
+		require.Equal(t, "parquet", body.TaskConfigs["inputFormat"])
+		segment := body.TaskConfigs["segmentNameGenerator.configs.segment.name"]
+		require.True(t, strings.HasPrefix(segment, "events_"))
+		m.segments = append(m.segments, segment)
+		m.inputDirs = append(m.inputDirs, body.TaskConfigs["inputDirURI"])
+		_ = json.NewEncoder(w).Encode(map[string]string{"events_OFFLINE": "Task_SegmentGenerationAndPushTask_" + segment})
+	})
+	mux.HandleFunc("GET /tasks/task/{task}/state", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		require.True(t, strings.HasPrefix(r.PathValue("task"), "Task_SegmentGenerationAndPushTask_events_"))
+		m.polled++
+		_ = json.NewEncoder(w).Encode(m.taskState)
+	})
+	mux.HandleFunc("DELETE /tasks/task/{task}", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		require.Equal(t, "true", r.URL.Query().Get("forceDelete"))
+		m.deletedTasks = append(m.deletedTasks, r.PathValue("task"))
+	})
+	m.srv = httptest.NewServer(mux)
+	t.Cleanup(m.srv.Close)
+	return m
+}
+
+func (m *mockController) getDeletedTasks() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.deletedTasks
+}
@@ -0,0 +1,91 @@
+package pinot
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// duckDBToSelfExecutor executes a model in DuckDB and pushes the result to a Pinot table.
+type duckDBToSelfExecutor struct {
+	c    *connection
+	from drivers.OLAPStore
+	opts *drivers.ModelExecutorOptions
+}
+
+var _ drivers.ModelExecutor = &duckDBToSelfExecutor{}
+
+func (e *duckDBToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult, error) {
+	inputProps := &ModelInputProperties{}
+	if err := mapstructure.WeakDecode(e.opts.InputProperties, inputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+	if err := inputProps.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid input properties: %w", err)
+	}
+
+	outputProps := &ModelOutputProperties{}
+	if err := mapstructure.WeakDecode(e.opts.OutputProperties, outputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse output properties: %w", err)
+	}
+	if err := outputProps.Validate(e.opts); err != nil {
+		return nil, fmt.Errorf("invalid output properties: %w", err)
+	}
+
+	usedModelName := false
+	if outputProps.Table == "" {
+		outputProps.Table = e.opts.ModelName
+		usedModelName = true
+	}
+	tableName := outputProps.Table
+
+	progress := e.opts.Progress
+	if progress == nil {
+		progress = drivers.NoOpProgress{}
+	}
+
+	// Export the model's data from DuckDB to Parquet files
+	exp, err := exportForPinot(ctx, e.from, inputProps.SQL, inputProps.Args, e.opts.Priority)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(exp.dir)
+
+	// Push the files to Pinot.
+	// NOTE: We don't stage changes for Pinot since non-incremental runs atomically swap the table's segments.
+	err = e.c.pushTable(ctx, tableName, exp, &ingestOptions{
+		Replace:     !e.opts.IncrementalRun,
+		TimeColumn:  outputProps.TimeColumn,
+		Replication: outputProps.Replication,
+		AcquireConnector: func(ctx context.Context, name string) (drivers.Handle, func(), error) {
+			if e.opts.Env == nil {
+				return nil, nil, fmt.Errorf("can't acquire connector %q outside of a model environment", name)
+			}
+			return e.opts.Env.AcquireConnector(ctx, name)
+		},
+	}, progress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to push model to pinot: %w", err)
+	}
+
+	// Build result props
+	resultProps := &ModelResultProperties{
+		Table:         tableName,
+		UsedModelName: usedModelName,
+	}
+	resultPropsMap := map[string]interface{}{}
+	err = mapstructure.WeakDecode(resultProps, &resultPropsMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result properties: %w", err)
+	}
+
+	// Done
+	return &drivers.ModelResult{
+		Connector:  e.opts.OutputConnector,
+		Properties: resultPropsMap,
+		Table:      tableName,
+	}, nil
+}
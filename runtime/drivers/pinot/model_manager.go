@@ -0,0 +1,94 @@
+package pinot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+type ModelInputProperties struct {
+	SQL  string `mapstructure:"sql"`
+	Args []any  `mapstructure:"args"`
+}
+
+func (p *ModelInputProperties) Validate() error {
+	if p.SQL == "" {
+		return fmt.Errorf("missing property 'sql'")
+	}
+	return nil
+}
+
+type ModelOutputProperties struct {
+	Table               string                      `mapstructure:"table"`
+	IncrementalStrategy drivers.IncrementalStrategy `mapstructure:"incremental_strategy"`
+	// TimeColumn is the primary time column of the table. Defaults to the first timestamp column.
+	TimeColumn string `mapstructure:"time_column"`
+	// Replication is the number of replicas of each segment. Defaults to 1.
+	Replication int `mapstructure:"replication"`
+}
+
+func (p *ModelOutputProperties) Validate(opts *drivers.ModelExecutorOptions) error {
+	switch p.IncrementalStrategy {
+	case drivers.IncrementalStrategyUnspecified, drivers.IncrementalStrategyAppend:
+	default:
+		return fmt.Errorf("invalid incremental strategy %q: pinot only supports %q", p.IncrementalStrategy, drivers.IncrementalStrategyAppend)
+	}
+	if p.Replication < 0 {
+		return fmt.Errorf("invalid value %d for 'replication'", p.Replication)
+	}
+	return nil
+}
+
+type ModelResultProperties struct {
+	Table         string `mapstructure:"table"`
+	UsedModelName bool   `mapstructure:"used_model_name"`
+}
+
+func (c *connection) Rename(ctx context.Context, res *drivers.ModelResult, newName string, env *drivers.ModelEnv) (*drivers.ModelResult, error) {
+	resProps := &ModelResultProperties{}
+	if err := mapstructure.WeakDecode(res.Properties, resProps); err != nil {
+		return nil, fmt.Errorf("failed to parse previous result properties: %w", err)
+	}
+
+	if !resProps.UsedModelName {
+		return res, nil
+	}
+
+	// Pinot does not support renaming tables, so we drop the old table.
+	// Since the new table doesn't exist yet, the model reconciler will rebuild the model under the new name.
+	err := c.deleteTable(ctx, resProps.Table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to drop renamed model: %w", err)
+	}
+
+	resProps.Table = newName
+	resPropsMap := map[string]interface{}{}
+	err = mapstructure.WeakDecode(resProps, &resPropsMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result properties: %w", err)
+	}
+
+	return &drivers.ModelResult{
+		Connector:  res.Connector,
+		Properties: resPropsMap,
+		Table:      newName,
+	}, nil
+}
+
+func (c *connection) Exists(ctx context.Context, res *drivers.ModelResult) (bool, error) {
+	_, err := c.InformationSchema().Lookup(ctx, "", "", res.Table)
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *connection) Delete(ctx context.Context, res *drivers.ModelResult) error {
+	return c.deleteTable(ctx, res.Table)
+}
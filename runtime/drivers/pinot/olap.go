@@ -30,13 +30,17 @@ func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view
 }
 
 // DropTable implements drivers.OLAPStore.
+// It deletes the offline table and its schema.
 func (c *connection) DropTable(ctx context.Context, name string, view bool) error {
-	return fmt.Errorf("pinot: data transformation not yet supported")
+	if view {
+		return fmt.Errorf("pinot: views are not supported")
+	}
+	return c.deleteTable(ctx, name)
 }
 
 // InsertTableAsSelect implements drivers.OLAPStore.
-func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
-	return fmt.Errorf("pinot: data transformation not yet supported")
+func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, opts *drivers.InsertTableOptions) (*drivers.InsertTableResult, error) {
+	return nil, fmt.Errorf("pinot: data transformation not yet supported")
 }
 
 // RenameTable implements drivers.OLAPStore.
@@ -131,6 +135,9 @@ func (i informationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, drivers.ErrNotFound
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
@@ -313,9 +320,9 @@ type pinotSchema struct {
 type pinotFieldSpec struct {
 	Name             string      `json:"name"`
 	DataType         string      `json:"dataType"`
-	SingleValueField *bool       `json:"singleValueField"`
-	NotNull          bool        `json:"notNull"`
-	DefaultNullValue interface{} `json:"defaultNullValue"`
-	Format           string      `json:"format"`      // only for timeFieldSpec
-	Granularity      string      `json:"granularity"` // only for timeFieldSpec
+	SingleValueField *bool       `json:"singleValueField,omitempty"`
+	NotNull          bool        `json:"notNull,omitempty"`
+	DefaultNullValue interface{} `json:"defaultNullValue,omitempty"`
+	Format           string      `json:"format,omitempty"`      // only for timeFieldSpec
+	Granularity      string      `json:"granularity,omitempty"` // only for timeFieldSpec
 }
@@ -65,6 +65,22 @@ var spec = drivers.Spec{
 			Required:    false,
 			Default:     "true",
 		},
+		{
+			Key:         "staging_connector",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Staging connector",
+			Description: "Object store connector that data is uploaded to before it's ingested by Pinot's minions",
+			Placeholder: "s3",
+			Required:    false,
+		},
+		{
+			Key:         "staging_path",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Staging path",
+			Description: "Path in the staging connector that Pinot's minions can read from",
+			Placeholder: "s3://bucket/pinot-staging",
+			Required:    false,
+		},
 	},
 	SourceProperties: []*drivers.PropertySpec{
 		{
@@ -124,6 +140,10 @@ type configProperties struct {
 	SSL bool `mapstructure:"ssl"`
 	// LogQueries controls whether to log the raw SQL passed to OLAP.Execute.
 	LogQueries bool `mapstructure:"log_queries"`
+	// StagingConnector is the name of an object store connector that data files are uploaded to before they're ingested.
+	StagingConnector string `mapstructure:"staging_connector"`
+	// StagingPath is the path in the staging connector that data files are uploaded to. Pinot's minions must be able to read from it.
+	StagingPath string `mapstructure:"staging_path"`
 }
 
 // Open a connection to Apache Pinot using HTTP API.
@@ -187,10 +207,13 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 	}
 
 	conn := &connection{
-		db:      db,
-		config:  config,
-		baseURL: controller,
-		headers: headers,
+		db:               db,
+		config:           config,
+		baseURL:          controller,
+		headers:          headers,
+		stagingConnector: conf.StagingConnector,
+		stagingPath:      conf.StagingPath,
+		logger:           logger,
 	}
 	return conn, nil
 }
@@ -212,6 +235,10 @@ type connection struct {
 	config  map[string]any
 	baseURL string
 	headers map[string]string
+	// stagingConnector and stagingPath configure where data files are uploaded for ingestion (see stageFiles)
+	stagingConnector string
+	stagingPath      string
+	logger           *zap.Logger
 }
 
 // Driver implements drivers.Connection.
@@ -266,15 +293,29 @@ func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
 }
 
 func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	if opts.OutputHandle == c && opts.InputHandle.Driver() == "duckdb" {
+		if olap, ok := opts.InputHandle.AsOLAP(instanceID); ok {
+			return &duckDBToSelfExecutor{c, olap, opts}, true
+		}
+	}
 	return nil, false
 }
 
 // AsModelManager implements drivers.Handle.
 func (c *connection) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
-	return nil, false
+	return c, true
 }
 
 func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	if to != c {
+		return nil, false
+	}
+	if store, ok := from.AsObjectStore(); ok {
+		return newObjectStoreToPinot(store, c), true
+	}
+	if store, ok := from.AsFileStore(); ok {
+		return newFileStoreToPinot(store, c), true
+	}
 	return nil, false
 }
 
@@ -0,0 +1,163 @@
+package pinot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"go.uber.org/zap"
+)
+
+type sinkProperties struct {
+	Table string `mapstructure:"table"`
+}
+
+type fileSourceProperties struct {
+	// Format overrides the file format inferred from the file extension.
+	Format string `mapstructure:"format"`
+}
+
+// filesToPinot is a transporter that ingests files from a file store or object store into Pinot.
+// The files are read and converted to Parquet by a temporary DuckDB database, so the same formats and type inference as for DuckDB sources apply.
+type filesToPinot struct {
+	to          *connection
+	fileStore   drivers.FileStore
+	objectStore drivers.ObjectStore
+	logger      *zap.Logger
+}
+
+var _ drivers.Transporter = &filesToPinot{}
+
+func newFileStoreToPinot(from drivers.FileStore, to *connection) drivers.Transporter {
+	return &filesToPinot{to: to, fileStore: from, logger: to.logger}
+}
+
+func newObjectStoreToPinot(from drivers.ObjectStore, to *connection) drivers.Transporter {
+	return &filesToPinot{to: to, objectStore: from, logger: to.logger}
+}
+
+func (t *filesToPinot) Transfer(ctx context.Context, srcProps, sinkProps map[string]any, opts *drivers.TransferOptions) error {
+	sinkCfg := &sinkProperties{}
+	if err := mapstructure.Decode(sinkProps, sinkCfg); err != nil {
+		return fmt.Errorf("failed to parse sink properties: %w", err)
+	}
+	srcCfg := &fileSourceProperties{}
+	if err := mapstructure.WeakDecode(srcProps, srcCfg); err != nil {
+		return fmt.Errorf("failed to parse source properties: %w", err)
+	}
+
+	t.logger = t.logger.With(zap.String("source", sinkCfg.Table))
+
+	// Open a temporary DuckDB database for staging the files
+	dir, err := os.MkdirTemp("", "pinot_stage")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	handle, err := drivers.Open("duckdb", "pinot", map[string]any{"data_dir": dir}, activity.NewNoopClient(), t.logger)
+	if err != nil {
+		return fmt.Errorf("failed to open staging database: %w", err)
+	}
+	defer handle.Close()
+	olap, _ := handle.AsOLAP("")
+
+	// Stage the files in a DuckDB table
+	staged := false
+	stage := func(paths []string) error {
+		if len(paths) == 0 {
+			return nil
+		}
+		reader, err := fileReader(paths, srcCfg.Format)
+		if err != nil {
+			return err
+		}
+		if !staged {
+			staged = true
+			return olap.CreateTableAsSelect(ctx, "staging", false, "SELECT * FROM "+reader)
+		}
+		_, err = olap.InsertTableAsSelect(ctx, "staging", "SELECT * FROM "+reader, &drivers.InsertTableOptions{ByName: true, Strategy: drivers.IncrementalStrategyAppend})
+		return err
+	}
+
+	if t.objectStore != nil {
+		it, err := t.objectStore.DownloadFiles(ctx, srcProps)
+		if err != nil {
+			return err
+		}
+		defer it.Close()
+		for {
+			paths, err := it.Next()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return err
+			}
+			if err := stage(paths); err != nil {
+				return err
+			}
+		}
+	} else {
+		paths, err := t.fileStore.FilePaths(ctx, srcProps)
+		if err != nil {
+			return err
+		}
+		if err := stage(paths); err != nil {
+			return err
+		}
+	}
+	if !staged {
+		return fmt.Errorf("no files to ingest")
+	}
+
+	// Export the staged data and push it to Pinot
+	exp, err := exportForPinot(ctx, olap, "SELECT * FROM staging", nil, 0)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(exp.dir)
+
+	return t.to.pushTable(ctx, sinkCfg.Table, exp, &ingestOptions{
+		Replace: true,
+		AcquireConnector: func(ctx context.Context, name string) (drivers.Handle, func(), error) {
+			if opts.AcquireConnector == nil {
+				return nil, nil, fmt.Errorf("can't acquire connector %q", name)
+			}
+			return opts.AcquireConnector(name)
+		},
+	}, opts.Progress)
+}
+
+// fileReader returns a DuckDB table function that reads the given local files.
+func fileReader(paths []string, format string) (string, error) {
+	if format != "" {
+		format = "." + format
+	} else {
+		format = fileutil.FullExt(paths[0])
+	}
+
+	quoted := make([]string, len(paths))
+	for i, p := range paths {
+		quoted[i] = fmt.Sprintf("'%s'", strings.ReplaceAll(p, "'", "''"))
+	}
+	list := "[" + strings.Join(quoted, ", ") + "]"
+
+	switch {
+	case strings.Contains(format, ".parquet"):
+		return fmt.Sprintf("read_parquet(%s, union_by_name=true)", list), nil
+	case strings.Contains(format, ".csv"), strings.Contains(format, ".tsv"), strings.Contains(format, ".txt"):
+		return fmt.Sprintf("read_csv_auto(%s, union_by_name=true)", list), nil
+	case strings.Contains(format, ".json"):
+		return fmt.Sprintf("read_json_auto(%s, union_by_name=true)", list), nil
+	default:
+		return "", fmt.Errorf("file type not supported: %s", format)
+	}
+}
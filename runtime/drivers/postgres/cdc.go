@@ -0,0 +1,494 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pglogrepl"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// defaultCDCBatchSize is the default number of changes in a batch passed to the transporter.
+// Batches only end at transaction boundaries, so they may contain more changes.
+const defaultCDCBatchSize = 10000
+
+// cdcReceiveTimeout is how long to wait for a message from the server before requesting a keepalive.
+const cdcReceiveTimeout = time.Second
+
+var _ drivers.ChangeStore = &connection{}
+
+// StartChanges implements drivers.ChangeStore.
+// It creates the publication for the table if it doesn't exist and creates a new replication slot.
+// Unless configured, the slot and publication get unique names, so deployments that ingest the same table don't share them.
+// The returned state contains the slot's consistent point, from which changes are streamed by ConsumeChanges,
+// and the names of the slot and the publication, which StopChanges drops if they were created here.
+func (c *connection) StartChanges(ctx context.Context, props map[string]any) (map[string]any, error) {
+	srcProps, err := parseSourceProperties(props)
+	if err != nil {
+		return nil, err
+	}
+	if !srcProps.CDC {
+		return nil, fmt.Errorf("property 'cdc' is not enabled")
+	}
+
+	dsn, err := c.dsn(srcProps)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := connectCDC(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(ctx)
+
+	name := newReplicationName(srcProps.Table)
+	slot := srcProps.SlotName
+	if slot == "" {
+		slot = name
+	}
+	publication := srcProps.Publication
+	if publication == "" {
+		publication = name
+	}
+
+	// An existing slot may be used by someone else, so it's never dropped here.
+	// Slots created by the runtime are dropped by StopChanges before changes are captured from a new snapshot.
+	var slotExists bool
+	err = conn.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_replication_slots WHERE slot_name = $1)", slot).Scan(&slotExists)
+	if err != nil {
+		return nil, err
+	}
+	if slotExists {
+		return nil, fmt.Errorf("replication slot %q already exists: drop it or set a different 'slot_name'", slot)
+	}
+
+	var publicationExists bool
+	err = conn.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_publication WHERE pubname = $1)", publication).Scan(&publicationExists)
+	if err != nil {
+		return nil, err
+	}
+	if !publicationExists {
+		_, err = conn.Exec(ctx, fmt.Sprintf("CREATE PUBLICATION %s FOR TABLE %s", pgx.Identifier{publication}.Sanitize(), tableIdentifier(srcProps.Table).Sanitize()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create publication %q: %w", publication, err)
+		}
+	}
+
+	repl, err := connectReplication(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+	defer repl.Close(ctx)
+
+	res, err := pglogrepl.CreateReplicationSlot(ctx, repl, slot, "pgoutput", pglogrepl.CreateReplicationSlotOptions{SnapshotAction: "NOEXPORT_SNAPSHOT"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create replication slot %q: %w", slot, err)
+	}
+
+	return map[string]any{
+		"lsn":              res.ConsistentPoint,
+		"slot":             slot,
+		"publication":      publication,
+		"drop_publication": !publicationExists,
+	}, nil
+}
+
+// ConsumeChanges implements drivers.ChangeStore.
+// It streams changes to the table from the replication slot until it reaches the WAL position at the time of the call.
+func (c *connection) ConsumeChanges(ctx context.Context, props, state map[string]any, fn func(*drivers.ChangeBatch) error) error {
+	srcProps, err := parseSourceProperties(props)
+	if err != nil {
+		return err
+	}
+	if !srcProps.CDC {
+		return fmt.Errorf("property 'cdc' is not enabled")
+	}
+
+	lsnStr, _ := state["lsn"].(string)
+	pos, err := pglogrepl.ParseLSN(lsnStr)
+	if err != nil {
+		return fmt.Errorf("invalid replication state %v: %w", state, err)
+	}
+	slot, _ := state["slot"].(string)
+	publication, _ := state["publication"].(string)
+	if slot == "" || publication == "" {
+		return fmt.Errorf("invalid replication state %v: missing slot or publication", state)
+	}
+
+	dsn, err := c.dsn(srcProps)
+	if err != nil {
+		return err
+	}
+
+	conn, err := connectCDC(ctx, dsn)
+	if err != nil {
+		return err
+	}
+	var relationID uint32
+	err = conn.QueryRow(ctx, "SELECT $1::regclass::oid", srcProps.Table).Scan(&relationID)
+	conn.Close(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find table %q: %w", srcProps.Table, err)
+	}
+
+	repl, err := connectReplication(ctx, dsn)
+	if err != nil {
+		return err
+	}
+	defer repl.Close(ctx)
+
+	sys, err := pglogrepl.IdentifySystem(ctx, repl)
+	if err != nil {
+		return err
+	}
+	target := sys.XLogPos
+
+	err = pglogrepl.StartReplication(ctx, repl, slot, pos, pglogrepl.StartReplicationOptions{
+		PluginArgs: []string{"proto_version '1'", fmt.Sprintf("publication_names '%s'", strings.ReplaceAll(publication, "'", "''"))},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start replication from slot %q: %w", slot, err)
+	}
+
+	r := &changeReader{
+		relationID: relationID,
+		relations:  make(map[uint32]*pglogrepl.RelationMessage),
+		typeMap:    pgtype.NewMap(),
+		mappers:    getOidToMapperMap(),
+	}
+	defer r.w.Close()
+
+	flush := func() error {
+		// The other fields of the state (such as the slot name) are retained
+		next := maps.Clone(state)
+		next["lsn"] = pos.String()
+		err := r.w.Flush(r.key, next, fn)
+		if err != nil {
+			return err
+		}
+		// Confirm the position so the server can release WAL retained for the slot
+		return pglogrepl.SendStandbyStatusUpdate(ctx, repl, pglogrepl.StandbyStatusUpdate{WALWritePosition: pos})
+	}
+
+	inTx := false
+	for {
+		recvCtx, cancel := context.WithTimeout(ctx, cdcReceiveTimeout)
+		msg, err := repl.ReceiveMessage(recvCtx)
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if pgconn.Timeout(err) {
+				err = pglogrepl.SendStandbyStatusUpdate(ctx, repl, pglogrepl.StandbyStatusUpdate{WALWritePosition: pos, ReplyRequested: true})
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+
+		switch msg := msg.(type) {
+		case *pgproto3.ErrorResponse:
+			return pgconn.ErrorResponseToPgError(msg)
+		case *pgproto3.CopyData:
+			switch msg.Data[0] {
+			case pglogrepl.PrimaryKeepaliveMessageByteID:
+				ka, err := pglogrepl.ParsePrimaryKeepaliveMessage(msg.Data[1:])
+				if err != nil {
+					return err
+				}
+				if inTx {
+					continue
+				}
+				// All changes up to the server's WAL end have been sent
+				if ka.ServerWALEnd > pos {
+					pos = ka.ServerWALEnd
+				}
+				if pos >= target {
+					return flush()
+				}
+				if ka.ReplyRequested {
+					err = pglogrepl.SendStandbyStatusUpdate(ctx, repl, pglogrepl.StandbyStatusUpdate{WALWritePosition: pos})
+					if err != nil {
+						return err
+					}
+				}
+			case pglogrepl.XLogDataByteID:
+				xld, err := pglogrepl.ParseXLogData(msg.Data[1:])
+				if err != nil {
+					return err
+				}
+				logicalMsg, err := pglogrepl.Parse(xld.WALData)
+				if err != nil {
+					return err
+				}
+				switch logicalMsg := logicalMsg.(type) {
+				case *pglogrepl.BeginMessage:
+					inTx = true
+				case *pglogrepl.CommitMessage:
+					inTx = false
+					pos = logicalMsg.TransactionEndLSN
+					if r.w.Records() >= int64(srcProps.BatchSize) {
+						err = flush()
+						if err != nil {
+							return err
+						}
+					}
+				default:
+					err = r.handle(logicalMsg)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		default:
+			return fmt.Errorf("unexpected replication message %T", msg)
+		}
+	}
+}
+
+// StopChanges implements drivers.ChangeStore.
+// It drops the replication slot in state and the publication if it was created by StartChanges.
+// Since StartChanges never reuses an existing slot, the slot in state was always created by the runtime.
+func (c *connection) StopChanges(ctx context.Context, props, state map[string]any) error {
+	// Not using parseSourceProperties since the props may no longer enable CDC
+	srcProps := &sourceProperties{}
+	err := mapstructure.WeakDecode(props, srcProps)
+	if err != nil {
+		return err
+	}
+	dsn, err := c.dsn(srcProps)
+	if err != nil {
+		return err
+	}
+
+	conn, err := connectCDC(ctx, dsn)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+
+	if slot, _ := state["slot"].(string); slot != "" {
+		_, err = conn.Exec(ctx, "SELECT pg_drop_replication_slot(slot_name) FROM pg_replication_slots WHERE slot_name = $1", slot)
+		if err != nil {
+			return fmt.Errorf("failed to drop replication slot %q: %w", slot, err)
+		}
+	}
+	if publication, _ := state["publication"].(string); publication != "" && state["drop_publication"] == true {
+		_, err = conn.Exec(ctx, fmt.Sprintf("DROP PUBLICATION IF EXISTS %s", pgx.Identifier{publication}.Sanitize()))
+		if err != nil {
+			return fmt.Errorf("failed to drop publication %q: %w", publication, err)
+		}
+	}
+	return nil
+}
+
+// changeReader converts logical replication messages for a table to changes.
+type changeReader struct {
+	relationID uint32
+	relations  map[uint32]*pglogrepl.RelationMessage
+	typeMap    *pgtype.Map
+	mappers    map[string]mapper
+	key        []string
+	w          drivers.ChangeBatchWriter
+}
+
+func (r *changeReader) handle(msg pglogrepl.Message) error {
+	switch msg := msg.(type) {
+	case *pglogrepl.RelationMessage:
+		r.relations[msg.RelationID] = msg
+		if msg.RelationID != r.relationID {
+			return nil
+		}
+		r.key = nil
+		for _, col := range msg.Columns {
+			if col.Flags == 1 {
+				r.key = append(r.key, col.Name)
+			}
+		}
+		if len(r.key) == 0 {
+			return fmt.Errorf("table %s.%s has no primary key or replica identity, which is required for change data capture", msg.Namespace, msg.RelationName)
+		}
+	case *pglogrepl.InsertMessage:
+		if msg.RelationID != r.relationID {
+			return nil
+		}
+		row, err := r.row(msg.RelationID, msg.Tuple, nil, false)
+		if err != nil {
+			return err
+		}
+		return r.w.Write(drivers.ChangeOperationInsert, row)
+	case *pglogrepl.UpdateMessage:
+		if msg.RelationID != r.relationID {
+			return nil
+		}
+		row, err := r.row(msg.RelationID, msg.NewTuple, msg.OldTuple, false)
+		if err != nil {
+			return err
+		}
+		// If the key changed, the row with the old key must be deleted
+		if msg.OldTupleType == pglogrepl.UpdateMessageTupleTypeKey || msg.OldTupleType == pglogrepl.UpdateMessageTupleTypeOld {
+			old, err := r.row(msg.RelationID, msg.OldTuple, nil, true)
+			if err != nil {
+				return err
+			}
+			err = r.w.Write(drivers.ChangeOperationDelete, old)
+			if err != nil {
+				return err
+			}
+		}
+		return r.w.Write(drivers.ChangeOperationUpdate, row)
+	case *pglogrepl.DeleteMessage:
+		if msg.RelationID != r.relationID {
+			return nil
+		}
+		row, err := r.row(msg.RelationID, msg.OldTuple, nil, true)
+		if err != nil {
+			return err
+		}
+		return r.w.Write(drivers.ChangeOperationDelete, row)
+	}
+	return nil
+}
+
+// row converts tuple data to a row with the same values as the snapshot queried with the SQLStore.
+// Unchanged TOAST values are taken from old, which is only available if the table's replica identity is FULL.
+func (r *changeReader) row(relationID uint32, tuple, old *pglogrepl.TupleData, keyOnly bool) (map[string]any, error) {
+	rel, ok := r.relations[relationID]
+	if !ok {
+		return nil, fmt.Errorf("unknown relation ID %d", relationID)
+	}
+	if tuple == nil {
+		return nil, errors.New("change has no tuple data")
+	}
+
+	row := make(map[string]any, len(tuple.Columns))
+	for i, col := range tuple.Columns {
+		if i >= len(rel.Columns) {
+			break
+		}
+		relCol := rel.Columns[i]
+		if keyOnly && relCol.Flags != 1 {
+			continue
+		}
+		if col.DataType == pglogrepl.TupleDataTypeToast {
+			if old == nil || i >= len(old.Columns) || old.Columns[i].DataType == pglogrepl.TupleDataTypeToast {
+				return nil, fmt.Errorf("unchanged TOAST value for column %q is not available, set 'REPLICA IDENTITY FULL' on table %s.%s", relCol.Name, rel.Namespace, rel.RelationName)
+			}
+			col = old.Columns[i]
+		}
+		val, err := r.value(relCol, col)
+		if err != nil {
+			return nil, err
+		}
+		row[relCol.Name] = val
+	}
+	return row, nil
+}
+
+// value decodes a column value by the column's type OID and maps it like the row iterator of the SQLStore.
+// Values that encoding/json can't represent in a way that casts to the ingested column's type are normalized.
+func (r *changeReader) value(relCol *pglogrepl.RelationMessageColumn, col *pglogrepl.TupleDataColumn) (any, error) {
+	var format int16
+	switch col.DataType {
+	case pglogrepl.TupleDataTypeNull:
+		return nil, nil
+	case pglogrepl.TupleDataTypeText:
+		format = pgtype.TextFormatCode
+	case pglogrepl.TupleDataTypeBinary:
+		format = pgtype.BinaryFormatCode
+	default:
+		return nil, fmt.Errorf("unexpected tuple data type %q for column %q", col.DataType, relCol.Name)
+	}
+
+	dt, ok := r.typeMap.TypeForOID(relCol.DataType)
+	if !ok {
+		// Types that are unknown to pgx, such as enums, are ingested as text
+		return string(col.Data), nil
+	}
+	mapper, ok := r.mappers[strings.ToLower(dt.Name)]
+	if !ok {
+		return nil, fmt.Errorf("datatype %q of column %q is not supported", dt.Name, relCol.Name)
+	}
+
+	val, err := dt.Codec.DecodeValue(r.typeMap, relCol.DataType, format, col.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode value of column %q: %w", relCol.Name, err)
+	}
+	if val == nil {
+		return nil, nil
+	}
+	val, err = mapper.value(val)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := val.(type) {
+	case [16]byte:
+		return uuid.UUID(v).String(), nil
+	case time.Time:
+		return v.UTC(), nil
+	default:
+		return val, nil
+	}
+}
+
+// connectCDC opens a connection for managing publications and looking up tables.
+func connectCDC(ctx context.Context, dsn string) (*pgx.Conn, error) {
+	config, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	// See the comment in Query
+	config.DefaultQueryExecMode = pgx.QueryExecModeSimpleProtocol
+	return pgx.ConnectConfig(ctx, config)
+}
+
+// connectReplication opens a connection that uses the logical replication protocol.
+func connectReplication(ctx context.Context, dsn string) (*pgconn.PgConn, error) {
+	config, err := pgconn.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	config.RuntimeParams["replication"] = "database"
+	return pgconn.ConnectConfig(ctx, config)
+}
+
+// newReplicationName returns a new name for the publication and replication slot for a table.
+// It ends with a random suffix since the same table may be ingested by several deployments or sources.
+// Replication slot names may only contain lower case letters, numbers and underscores, and are at most 63 characters long.
+func newReplicationName(table string) string {
+	var b strings.Builder
+	b.WriteString("rill_")
+	for _, r := range strings.ToLower(table) {
+		if b.Len() >= 40 {
+			break
+		}
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	b.WriteRune('_')
+	b.WriteString(strings.ReplaceAll(uuid.NewString(), "-", "")[:12])
+	return b.String()
+}
+
+// tableIdentifier parses a table name that may be qualified with a schema.
+func tableIdentifier(table string) pgx.Identifier {
+	return pgx.Identifier(strings.Split(table, "."))
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pglogrepl"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeReaderValue(t *testing.T) {
+	r := &changeReader{
+		typeMap: pgtype.NewMap(),
+		mappers: getOidToMapperMap(),
+	}
+
+	tests := []struct {
+		oid  uint32
+		text string
+		want any
+	}{
+		{pgtype.Int4OID, "42", int32(42)},
+		{pgtype.BoolOID, "t", true},
+		{pgtype.TextOID, "hello", "hello"},
+		{pgtype.NumericOID, "38500000000000.71", "38500000000000.71"},
+		{pgtype.ByteaOID, `\x0102ff`, []byte{0x01, 0x02, 0xff}},
+		{pgtype.Int8ArrayOID, "{1234567,7654312}", "[1234567,7654312]"},
+		{pgtype.TextArrayOID, `{a,"b c"}`, `["a","b c"]`},
+		{pgtype.UUIDOID, "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"},
+		{pgtype.TimestamptzOID, "2023-09-12 12:46:55+05:30", time.Date(2023, 9, 12, 7, 16, 55, 0, time.UTC)},
+		{pgtype.JSONBOID, `{"a": 1}`, `{"a":1}`},
+		// Enums are not known to pgx and are passed as text
+		{99999, "IND", "IND"},
+	}
+	for _, tt := range tests {
+		col := &pglogrepl.RelationMessageColumn{Name: "col", DataType: tt.oid}
+		val, err := r.value(col, &pglogrepl.TupleDataColumn{DataType: pglogrepl.TupleDataTypeText, Data: []byte(tt.text)})
+		require.NoError(t, err, tt.text)
+		require.Equal(t, tt.want, val, tt.text)
+	}
+
+	val, err := r.value(&pglogrepl.RelationMessageColumn{Name: "col", DataType: pgtype.Int4OID}, &pglogrepl.TupleDataColumn{DataType: pglogrepl.TupleDataTypeNull})
+	require.NoError(t, err)
+	require.Nil(t, val)
+}
+
+func TestNewReplicationName(t *testing.T) {
+	a := newReplicationName("public.Orders")
+	b := newReplicationName("public.Orders")
+	require.NotEqual(t, a, b)
+	require.Regexp(t, `^rill_public_orders_[0-9a-f]{12}$`, a)
+
+	long := newReplicationName(strings.Repeat("x", 100))
+	require.LessOrEqual(t, len(long), 63)
+	require.Regexp(t, `^[a-z0-9_]+$`, long)
+}
+
+func TestTableIdentifier(t *testing.T) {
+	require.Equal(t, `"public"."orders"`, tableIdentifier("public.orders").Sanitize())
+	require.Equal(t, `"orders; DROP TABLE x"`, tableIdentifier("orders; DROP TABLE x").Sanitize())
+}
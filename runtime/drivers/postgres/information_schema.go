@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+var errUnsupportedType = errors.New("encountered unsupported postgres type")
+
+type informationSchema struct {
+	c *connection
+}
+
+// InformationSchema implements drivers.OLAPStore.
+func (c *connection) InformationSchema() drivers.InformationSchema {
+	return informationSchema{c: c}
+}
+
+func (i informationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
+	db, err := i.c.acquireDB()
+	if err != nil {
+		return nil, err
+	}
+
+	// Postgres does not support cross-database queries, so we only list the schemas in the connected database.
+	q := `
+		SELECT
+			T.table_schema AS SCHEMA,
+			T.table_schema = current_schema() AS is_default_schema,
+			T.table_name AS NAME,
+			T.table_type AS TABLE_TYPE,
+			C.column_name AS COLUMN_NAME,
+			C.udt_name AS COLUMN_TYPE,
+			C.is_nullable = 'YES' AS IS_NULLABLE
+		FROM information_schema.tables T
+		JOIN information_schema.columns C ON T.table_schema = C.table_schema AND T.table_name = C.table_name
+		WHERE T.table_schema NOT IN ('information_schema', 'pg_catalog') AND T.table_schema NOT LIKE 'pg_toast%'
+		ORDER BY SCHEMA, NAME, TABLE_TYPE, C.ordinal_position
+	`
+
+	rows, err := db.QueryxContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return i.scanTables(rows)
+}
+
+func (i informationSchema) Lookup(ctx context.Context, db, schema, name string) (*drivers.Table, error) {
+	conn, err := i.c.acquireDB()
+	if err != nil {
+		return nil, err
+	}
+
+	q := `
+		SELECT
+			T.table_schema AS SCHEMA,
+			T.table_schema = current_schema() AS is_default_schema,
+			T.table_name AS NAME,
+			T.table_type AS TABLE_TYPE,
+			C.column_name AS COLUMN_NAME,
+			C.udt_name AS COLUMN_TYPE,
+			C.is_nullable = 'YES' AS IS_NULLABLE
+		FROM information_schema.tables T
+		JOIN information_schema.columns C ON T.table_schema = C.table_schema AND T.table_name = C.table_name
+		WHERE T.table_schema = coalesce($1, current_schema()) AND T.table_name = $2
+		ORDER BY SCHEMA, NAME, TABLE_TYPE, C.ordinal_position
+	`
+	var schemaArg any
+	if schema != "" {
+		schemaArg = schema
+	}
+
+	rows, err := conn.QueryxContext(ctx, q, schemaArg, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables, err := i.scanTables(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tables) == 0 {
+		return nil, drivers.ErrNotFound
+	}
+
+	return tables[0], nil
+}
+
+func (i informationSchema) scanTables(rows *sqlx.Rows) ([]*drivers.Table, error) {
+	var res []*drivers.Table
+
+	for rows.Next() {
+		var databaseSchema string
+		var isDefaultSchema bool
+		var name string
+		var tableType string
+		var columnName string
+		var columnType string
+		var nullable bool
+
+		err := rows.Scan(&databaseSchema, &isDefaultSchema, &name, &tableType, &columnName, &columnType, &nullable)
+		if err != nil {
+			return nil, err
+		}
+
+		// set t to res[len(res)-1] if it's the same table, else set t to a new table and append it
+		var t *drivers.Table
+		if len(res) > 0 {
+			t = res[len(res)-1]
+			if !(t.DatabaseSchema == databaseSchema && t.Name == name) {
+				t = nil
+			}
+		}
+		if t == nil {
+			t = &drivers.Table{
+				DatabaseSchema:          databaseSchema,
+				IsDefaultDatabaseSchema: isDefaultSchema,
+				Name:                    name,
+				View:                    tableType == "VIEW",
+				Schema:                  &runtimev1.StructType{},
+			}
+			res = append(res, t)
+		}
+
+		// parse column type
+		colType, err := databaseTypeToPB(columnType, nullable)
+		if err != nil {
+			if !errors.Is(err, errUnsupportedType) {
+				return nil, err
+			}
+			if t.UnsupportedCols == nil {
+				t.UnsupportedCols = make(map[string]string)
+			}
+			t.UnsupportedCols[columnName] = columnType
+			continue
+		}
+
+		// append column
+		t.Schema.Fields = append(t.Schema.Fields, &runtimev1.StructType_Field{
+			Name: columnName,
+			Type: colType,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// databaseTypeToPB maps a Postgres type name (as found in pg_type.typname) to a runtime type.
+// Array types are prefixed with an underscore, e.g. "_int4".
+func databaseTypeToPB(dbt string, nullable bool) (*runtimev1.Type, error) {
+	dbt = strings.ToLower(dbt)
+	t := &runtimev1.Type{Nullable: nullable}
+
+	if elem, ok := strings.CutPrefix(dbt, "_"); ok {
+		elemType, err := databaseTypeToPB(elem, true)
+		if err != nil {
+			return nil, err
+		}
+		t.Code = runtimev1.Type_CODE_ARRAY
+		t.ArrayElementType = elemType
+		return t, nil
+	}
+
+	switch dbt {
+	case "bool":
+		t.Code = runtimev1.Type_CODE_BOOL
+	case "int2":
+		t.Code = runtimev1.Type_CODE_INT16
+	case "int4":
+		t.Code = runtimev1.Type_CODE_INT32
+	case "int8":
+		t.Code = runtimev1.Type_CODE_INT64
+	case "float4":
+		t.Code = runtimev1.Type_CODE_FLOAT32
+	case "float8":
+		t.Code = runtimev1.Type_CODE_FLOAT64
+	case "numeric":
+		// NUMERIC values are converted to float64 by the connector (see olap_conn.go)
+		t.Code = runtimev1.Type_CODE_FLOAT64
+	case "text", "varchar", "bpchar", "char", "name", "citext":
+		t.Code = runtimev1.Type_CODE_STRING
+	case "date":
+		t.Code = runtimev1.Type_CODE_DATE
+	case "time", "timetz":
+		t.Code = runtimev1.Type_CODE_TIME
+	case "timestamp", "timestamptz":
+		t.Code = runtimev1.Type_CODE_TIMESTAMP
+	case "uuid":
+		t.Code = runtimev1.Type_CODE_UUID
+	case "json", "jsonb":
+		t.Code = runtimev1.Type_CODE_JSON
+	case "bytea":
+		t.Code = runtimev1.Type_CODE_BYTES
+	default:
+		return nil, errUnsupportedType
+	}
+
+	return t, nil
+}
@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+var _ drivers.OLAPStore = &connection{}
+
+// AddTableColumn implements drivers.OLAPStore.
+func (c *connection) AddTableColumn(ctx context.Context, tableName, columnName, typ string) error {
+	return fmt.Errorf("postgres: data transformation not yet supported")
+}
+
+// AlterTableColumn implements drivers.OLAPStore.
+func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName, newType string) error {
+	return fmt.Errorf("postgres: data transformation not yet supported")
+}
+
+// CreateTableAsSelect implements drivers.OLAPStore.
+func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string) error {
+	return fmt.Errorf("postgres: data transformation not yet supported")
+}
+
+// DropTable implements drivers.OLAPStore.
+func (c *connection) DropTable(ctx context.Context, name string, view bool) error {
+	return fmt.Errorf("postgres: data transformation not yet supported")
+}
+
+// InsertTableAsSelect implements drivers.OLAPStore.
+func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, opts *drivers.InsertTableOptions) (*drivers.InsertTableResult, error) {
+	return nil, fmt.Errorf("postgres: data transformation not yet supported")
+}
+
+// RenameTable implements drivers.OLAPStore.
+func (c *connection) RenameTable(ctx context.Context, name, newName string, view bool) error {
+	return fmt.Errorf("postgres: data transformation not yet supported")
+}
+
+// Dialect implements drivers.OLAPStore.
+func (c *connection) Dialect() drivers.Dialect {
+	return drivers.DialectPostgres
+}
+
+// WithConnection implements drivers.OLAPStore.
+func (c *connection) WithConnection(ctx context.Context, priority int, longRunning, tx bool, fn drivers.WithConnectionFunc) error {
+	return fmt.Errorf("postgres: WithConnection not supported")
+}
+
+// EstimateSize implements drivers.OLAPStore.
+func (c *connection) EstimateSize() (int64, bool) {
+	return 0, false
+}
+
+// Exec implements drivers.OLAPStore.
+func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
+	res, err := c.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if stmt.DryRun {
+		return nil
+	}
+	return res.Close()
+}
+
+// Execute implements drivers.OLAPStore.
+func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*drivers.Result, error) {
+	db, err := c.acquireDB()
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait for a free connection, admitting queries in order of priority.
+	// NOTE: We can't just "defer c.olapSem.Release()" because the connection is held until the result is closed.
+	err = c.olapSem.Acquire(ctx, stmt.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	// The SQL builders use "?" for placeholders, but Postgres expects "$1", "$2", etc.
+	// We only rebind when there are args since "?" is also a valid operator for JSONB in Postgres.
+	query := stmt.Query
+	if len(stmt.Args) > 0 {
+		query = db.Rebind(query)
+	}
+
+	if stmt.DryRun {
+		defer c.olapSem.Release()
+		rows, err := db.QueryxContext(ctx, "EXPLAIN "+query, stmt.Args...)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, rows.Close()
+	}
+
+	var cancelFunc context.CancelFunc
+	if stmt.ExecutionTimeout != 0 {
+		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
+	}
+
+	rows, err := db.QueryxContext(ctx, query, stmt.Args...)
+	if err != nil {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		c.olapSem.Release()
+		return nil, err
+	}
+
+	schema, err := rowsToSchema(rows)
+	if err != nil {
+		rows.Close()
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		c.olapSem.Release()
+		return nil, err
+	}
+
+	r := &drivers.Result{Rows: rows, Schema: schema}
+	r.SetCleanupFunc(func() error {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		c.olapSem.Release()
+		return nil
+	})
+
+	return r, nil
+}
+
+// acquireDB returns the connection pool used for OLAP queries, opening it on first use.
+func (c *connection) acquireDB() (*sqlx.DB, error) {
+	c.dbOnce.Do(func() {
+		dsn, ok := c.config["database_url"].(string)
+		if !ok || dsn == "" {
+			c.dbErr = fmt.Errorf("the property 'database_url' is required to use Postgres as an OLAP connector")
+			return
+		}
+
+		config, err := pgx.ParseConfig(dsn)
+		if err != nil {
+			c.dbErr = fmt.Errorf("failed to parse 'database_url': %w", err)
+			return
+		}
+		// See the comment in Query for why we disable prepared statements.
+		config.DefaultQueryExecMode = pgx.QueryExecModeSimpleProtocol
+		// The dialect's time expressions assume timestamps are returned in UTC.
+		config.RuntimeParams["timezone"] = "UTC"
+
+		db := sql.OpenDB(&connector{Connector: stdlib.GetConnector(*config)})
+		db.SetMaxOpenConns(c.poolSize)
+		c.db = sqlx.NewDb(db, "pgx")
+	})
+	return c.db, c.dbErr
+}
+
+func rowsToSchema(r *sqlx.Rows) (*runtimev1.StructType, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	cts, err := r.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]*runtimev1.StructType_Field, len(cts))
+	for i, ct := range cts {
+		nullable, ok := ct.Nullable()
+		if !ok {
+			nullable = true
+		}
+
+		t, err := databaseTypeToPB(ct.DatabaseTypeName(), nullable)
+		if err != nil {
+			// Result columns may have custom types (such as enums), which we pass through as strings.
+			t = &runtimev1.Type{Code: runtimev1.Type_CODE_STRING, Nullable: nullable}
+		}
+
+		fields[i] = &runtimev1.StructType_Field{
+			Name: ct.Name(),
+			Type: t,
+		}
+	}
+
+	return &runtimev1.StructType{Fields: fields}, nil
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	sqldriver "database/sql/driver"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// connector wraps the pgx database/sql connector to return NUMERIC values as float64 instead of strings.
+// The pgx database/sql driver returns NUMERIC as a string to avoid loss of precision,
+// but the runtime expects measures (such as SUM and AVG over integer columns, which return NUMERIC in Postgres) to be numbers.
+type connector struct {
+	sqldriver.Connector
+}
+
+func (c *connector) Connect(ctx context.Context) (sqldriver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pgConn, ok := conn.(*stdlib.Conn)
+	if !ok {
+		return conn, nil
+	}
+	return &numericConn{Conn: pgConn}, nil
+}
+
+type numericConn struct {
+	*stdlib.Conn
+}
+
+func (c *numericConn) QueryContext(ctx context.Context, query string, args []sqldriver.NamedValue) (sqldriver.Rows, error) {
+	rows, err := c.Conn.QueryContext(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+	pgRows, ok := rows.(*stdlib.Rows)
+	if !ok {
+		return rows, nil
+	}
+	return &numericRows{Rows: pgRows}, nil
+}
+
+type numericRows struct {
+	*stdlib.Rows
+	numeric []bool
+}
+
+func (r *numericRows) Next(dest []sqldriver.Value) error {
+	if err := r.Rows.Next(dest); err != nil {
+		return err
+	}
+
+	if r.numeric == nil {
+		r.numeric = make([]bool, len(dest))
+		for i := range dest {
+			r.numeric[i] = r.ColumnTypeDatabaseTypeName(i) == "NUMERIC"
+		}
+	}
+
+	for i, v := range dest {
+		if !r.numeric[i] {
+			continue
+		}
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse numeric value %q: %w", s, err)
+		}
+		dest[i] = f
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+func TestOLAP(t *testing.T) {
+	pg := pgtestcontainer.New(t)
+	defer pg.Terminate(t)
+
+	db, err := sql.Open("pgx", pg.DatabaseURL)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.ExecContext(context.Background(), `
+		CREATE TABLE events (id int4 PRIMARY KEY, name text, amount int8, price numeric, ts timestamptz NOT NULL);
+		INSERT INTO events VALUES
+			(1, 'a', 10, 1.5, '2024-01-01 23:30:00+00'),
+			(2, 'b', 20, 2.25, '2024-01-02 10:00:00+00'),
+			(3, 'a', 30, NULL, '2024-01-03 10:00:00+00');
+		CREATE VIEW events_view AS SELECT id, name FROM events;
+		CREATE SCHEMA other;
+		CREATE TABLE other.events (id int4, tags text[], loc point);
+	`)
+	require.NoError(t, err)
+
+	conn, err := driver{}.Open("default", map[string]any{"database_url": pg.DatabaseURL}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	olap, ok := conn.AsOLAP("default")
+	require.True(t, ok)
+
+	t.Run("testInformationSchemaAll", func(t *testing.T) { testInformationSchemaAll(t, olap) })
+	t.Run("testInformationSchemaLookup", func(t *testing.T) { testInformationSchemaLookup(t, olap) })
+	t.Run("testExecute", func(t *testing.T) { testExecute(t, olap) })
+	t.Run("testDateTrunc", func(t *testing.T) { testDateTrunc(t, olap) })
+}
+
+func testInformationSchemaAll(t *testing.T, olap drivers.OLAPStore) {
+	tables, err := olap.InformationSchema().All(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tables, 3)
+
+	require.Equal(t, "other", tables[0].DatabaseSchema)
+	require.Equal(t, "events", tables[0].Name)
+	require.False(t, tables[0].IsDefaultDatabaseSchema)
+	require.Len(t, tables[0].Schema.Fields, 2)
+	require.Equal(t, runtimev1.Type_CODE_ARRAY, tables[0].Schema.Fields[1].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_STRING, tables[0].Schema.Fields[1].Type.ArrayElementType.Code)
+	require.Equal(t, "point", tables[0].UnsupportedCols["loc"])
+
+	require.Equal(t, "public", tables[1].DatabaseSchema)
+	require.Equal(t, "events", tables[1].Name)
+	require.True(t, tables[1].IsDefaultDatabaseSchema)
+	require.False(t, tables[1].View)
+
+	require.Equal(t, "events_view", tables[2].Name)
+	require.True(t, tables[2].View)
+}
+
+func testInformationSchemaLookup(t *testing.T, olap drivers.OLAPStore) {
+	tbl, err := olap.InformationSchema().Lookup(context.Background(), "", "", "events")
+	require.NoError(t, err)
+	require.Equal(t, "public", tbl.DatabaseSchema)
+	require.Len(t, tbl.Schema.Fields, 5)
+	require.Equal(t, runtimev1.Type_CODE_INT32, tbl.Schema.Fields[0].Type.Code)
+	require.False(t, tbl.Schema.Fields[0].Type.Nullable)
+	require.Equal(t, runtimev1.Type_CODE_STRING, tbl.Schema.Fields[1].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_INT64, tbl.Schema.Fields[2].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_FLOAT64, tbl.Schema.Fields[3].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_TIMESTAMP, tbl.Schema.Fields[4].Type.Code)
+
+	tbl, err = olap.InformationSchema().Lookup(context.Background(), "", "other", "events")
+	require.NoError(t, err)
+	require.Equal(t, "other", tbl.DatabaseSchema)
+
+	_, err = olap.InformationSchema().Lookup(context.Background(), "", "", "missing")
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+}
+
+func testExecute(t *testing.T, olap drivers.OLAPStore) {
+	res, err := olap.Execute(context.Background(), &drivers.Statement{
+		Query: "SELECT name, SUM(amount) AS total, AVG(price) AS price FROM events WHERE amount > ? GROUP BY name ORDER BY name",
+		Args:  []any{5},
+	})
+	require.NoError(t, err)
+	defer res.Close()
+
+	require.Equal(t, runtimev1.Type_CODE_FLOAT64, res.Schema.Fields[1].Type.Code)
+
+	var rows []map[string]any
+	for res.Next() {
+		row := make(map[string]any)
+		require.NoError(t, res.MapScan(row))
+		rows = append(rows, row)
+	}
+	require.NoError(t, res.Err())
+	require.Len(t, rows, 2)
+	require.Equal(t, "a", rows[0]["name"])
+	require.Equal(t, 40.0, rows[0]["total"])
+	require.Equal(t, 1.5, rows[0]["price"])
+	require.Equal(t, 2.25, rows[1]["price"])
+
+	err = olap.Exec(context.Background(), &drivers.Statement{Query: "SELECT * FROM missing", DryRun: true})
+	require.Error(t, err)
+}
+
+func testDateTrunc(t *testing.T, olap drivers.OLAPStore) {
+	dim := &runtimev1.MetricsViewSpec_DimensionV2{Column: "ts"}
+	tests := []struct {
+		tz   string
+		want time.Time
+	}{
+		{"", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"Asia/Kolkata", time.Date(2024, 1, 1, 18, 30, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		expr, err := drivers.DialectPostgres.DateTruncExpr(dim, runtimev1.TimeGrain_TIME_GRAIN_DAY, tt.tz, 1, 1)
+		require.NoError(t, err)
+
+		res, err := olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT " + expr + " FROM events WHERE id = 1"})
+		require.NoError(t, err)
+		require.True(t, res.Next())
+		var got time.Time
+		require.NoError(t, res.Scan(&got))
+		require.NoError(t, res.Close())
+		require.Equal(t, tt.want, got.UTC())
+	}
+}
+
+func TestOpenPoolSize(t *testing.T) {
+	conn, err := driver{}.Open("default", map[string]any{"database_url": "postgres://localhost/db"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	require.Equal(t, defaultPoolSize, conn.(*connection).poolSize)
+
+	// Config values may be strings when set through variables
+	conn, err = driver{}.Open("default", map[string]any{"database_url": "postgres://localhost/db", "pool_size": "3"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	require.Equal(t, 3, conn.(*connection).poolSize)
+
+	_, err = driver{}.Open("default", map[string]any{"database_url": "postgres://localhost/db", "pool_size": 0}, activity.NewNoopClient(), zap.NewNop())
+	require.ErrorContains(t, err, "invalid 'pool_size'")
+}
@@ -24,13 +24,9 @@ func (c *connection) Query(ctx context.Context, props map[string]any) (drivers.R
 		return nil, err
 	}
 
-	var dsn string
-	if srcProps.DatabaseURL != "" { // get from src properties
-		dsn = srcProps.DatabaseURL
-	} else if url, ok := c.config["database_url"].(string); ok && url != "" { // get from driver configs
-		dsn = url
-	} else {
-		return nil, fmt.Errorf("the property 'database_url' is required for Postgres. Provide 'database_url' in the YAML properties or pass '--var connector.postgres.database_url=...' to 'rill start'")
+	dsn, err := c.dsn(srcProps)
+	if err != nil {
+		return nil, err
 	}
 
 	config, err := pgxpool.ParseConfig(dsn)
@@ -240,17 +236,47 @@ func columnTypeDatabaseTypeName(typeMap *pgtype.Map, datatypeOID uint32) string
 	return ""
 }
 
+// dsn returns the connection string from the source properties or the driver config.
+func (c *connection) dsn(srcProps *sourceProperties) (string, error) {
+	if srcProps.DatabaseURL != "" { // get from src properties
+		return srcProps.DatabaseURL, nil
+	}
+	if url, ok := c.config["database_url"].(string); ok && url != "" { // get from driver configs
+		return url, nil
+	}
+	return "", fmt.Errorf("the property 'database_url' is required for Postgres. Provide 'database_url' in the YAML properties or pass '--var connector.postgres.database_url=...' to 'rill start'")
+}
+
 type sourceProperties struct {
 	SQL         string `mapstructure:"sql"`
 	DatabaseURL string `mapstructure:"database_url"`
+	Table       string `mapstructure:"table"`
+	CDC         bool   `mapstructure:"cdc"`
+	Publication string `mapstructure:"publication"`
+	SlotName    string `mapstructure:"slot_name"`
+	BatchSize   int    `mapstructure:"batch_size"`
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 	conf := &sourceProperties{}
-	err := mapstructure.Decode(props, conf)
+	err := mapstructure.WeakDecode(props, conf)
 	if err != nil {
 		return nil, err
 	}
+	if conf.CDC {
+		if conf.Table == "" {
+			return nil, fmt.Errorf("property 'table' is mandatory for connector \"postgres\" when 'cdc' is enabled")
+		}
+		if conf.SQL != "" {
+			return nil, fmt.Errorf("property 'sql' can't be used with 'cdc' for connector \"postgres\", use 'table' instead")
+		}
+		if conf.BatchSize <= 0 {
+			conf.BatchSize = defaultCDCBatchSize
+		}
+	}
+	if conf.SQL == "" && conf.Table != "" {
+		conf.SQL = fmt.Sprintf("SELECT * FROM %s", conf.Table)
+	}
 	if conf.SQL == "" {
 		return nil, fmt.Errorf("property 'sql' is mandatory for connector \"postgres\"")
 	}
@@ -92,6 +92,9 @@ type InstanceConfig struct {
 	// AlertStreamingRefDefaultRefreshCron sets a default cron expression for refreshing alerts with streaming refs.
 	// Namely, this is used to check alerts against external tables (e.g. in Druid) where new data may be added at any time (i.e. is considered "streaming").
 	AlertsDefaultStreamingRefreshCron string `mapstructure:"rill.alerts.default_streaming_refresh_cron"`
+	// NotificationsDisabled skips sending report and alert notifications. The reports and alerts still run.
+	// It is set by Rill Cloud for preview deployments of pull requests, which must not notify the project's real recipients.
+	NotificationsDisabled bool `mapstructure:"rill.notifications.disabled"`
 }
 
 // ResolveOLAPConnector resolves the OLAP connector to default to for the instance.
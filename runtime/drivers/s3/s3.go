@@ -155,6 +155,8 @@ type Connection struct {
 
 var _ drivers.Handle = &Connection{}
 
+var _ drivers.IncrementalObjectStore = &Connection{}
+
 // Driver implements drivers.Connection.
 func (c *Connection) Driver() string {
 	return "s3"
@@ -222,11 +224,6 @@ func (c *Connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecu
 	return nil, false
 }
 
-// AsModelManager implements drivers.Handle.
-func (c *Connection) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
-	return nil, false
-}
-
 // AsTransporter implements drivers.Connection.
 func (c *Connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
 	return nil, false
@@ -258,8 +255,10 @@ type sourceProperties struct {
 	S3Endpoint            string         `mapstructure:"endpoint"`
 	Extract               map[string]any `mapstructure:"extract"`
 	BatchSize             string         `mapstructure:"batch_size"`
+	Incremental           bool           `mapstructure:"incremental"`
 	url                   *globutil.URL
 	extractPolicy         *rillblob.ExtractPolicy
+	table                 *rillblob.TableOptions
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
@@ -293,6 +292,15 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 		return nil, fmt.Errorf("failed to parse extract config: %w", err)
 	}
 
+	conf.table, err = rillblob.ParseTableOptions(props)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.Incremental && (conf.extractPolicy != nil || conf.table != nil) {
+		return nil, fmt.Errorf("property 'incremental' can't be combined with 'extract' or a table format")
+	}
+
 	return conf, nil
 }
 
@@ -305,10 +313,30 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 //
 // Additionally in case allow_host_credentials is true it looks for credentials stored on host machine as well
 func (c *Connection) DownloadFiles(ctx context.Context, src map[string]any) (drivers.FileIterator, error) {
+	return c.downloadFiles(ctx, src, nil)
+}
+
+// DownloadNewFiles implements drivers.IncrementalObjectStore.
+func (c *Connection) DownloadNewFiles(ctx context.Context, src, state map[string]any) (drivers.FileIterator, *drivers.IncrementalDownload, error) {
+	inc, err := rillblob.NewIncrementalFiles(state)
+	if err != nil {
+		return nil, nil, err
+	}
+	it, err := c.downloadFiles(ctx, src, inc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return it, &drivers.IncrementalDownload{State: inc.State(), Append: !inc.Full()}, nil
+}
+
+func (c *Connection) downloadFiles(ctx context.Context, src map[string]any, inc *rillblob.IncrementalFiles) (drivers.FileIterator, error) {
 	conf, err := parseSourceProperties(src)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
+	if inc != nil && !conf.Incremental {
+		return nil, fmt.Errorf("property 'incremental' is not enabled")
+	}
 
 	creds, err := c.getCredentials()
 	if err != nil {
@@ -339,6 +367,8 @@ func (c *Connection) DownloadFiles(ctx context.Context, src map[string]any) (dri
 		ExtractPolicy:         conf.extractPolicy,
 		BatchSizeBytes:        int64(batchSize.Bytes()),
 		KeepFilesUntilClose:   conf.BatchSize == "-1",
+		Table:                 conf.table,
+		Incremental:           inc,
 		RetainFiles:           c.config.RetainFiles,
 	}
 
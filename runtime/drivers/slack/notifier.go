@@ -48,20 +48,53 @@ func newNotifier(token string, propsMap map[string]any) (*notifier, error) {
 }
 
 func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
-	buf := new(bytes.Buffer)
-	err := n.templates.Lookup("scheduled_report.slack").Execute(buf, s)
+	data := &ScheduledReportData{
+		Title:            s.Title,
+		ReportTimeString: s.ReportTime.Format(time.RFC1123),
+		DownloadFormat:   s.DownloadFormat,
+		OpenLink:         s.OpenLink,
+		DownloadLink:     s.DownloadLink,
+		EditLink:         s.EditLink,
+		Attached:         s.Attachment != nil,
+	}
+	txt, err := n.renderScheduledReport(data)
 	if err != nil {
-		return fmt.Errorf("slack template error: %w", err)
+		return err
+	}
+	blocks, err := n.messageBlocks(txt, s.Preview)
+	if err != nil {
+		return err
 	}
-	txt := buf.String()
 
-	if err := n.sendTextToChannels(txt); err != nil {
+	if err := n.sendTextToChannels(txt, blocks, s.Attachment); err != nil {
 		return err
 	}
-	if err := n.sendTextToUsers(txt); err != nil {
+	if err := n.sendTextToUsers(txt, blocks, s.Attachment); err != nil {
 		return err
 	}
-	return n.sendTextViaWebhooks(txt)
+
+	// Webhooks don't support file uploads, so they only get the download link
+	if data.Attached && len(n.props.Webhooks) > 0 {
+		data.Attached = false
+		txt, err = n.renderScheduledReport(data)
+		if err != nil {
+			return err
+		}
+		blocks, err = n.messageBlocks(txt, s.Preview)
+		if err != nil {
+			return err
+		}
+	}
+	return n.sendTextViaWebhooks(txt, blocks)
+}
+
+func (n *notifier) renderScheduledReport(data *ScheduledReportData) (string, error) {
+	buf := new(bytes.Buffer)
+	err := n.templates.Lookup("scheduled_report.slack").Execute(buf, data)
+	if err != nil {
+		return "", fmt.Errorf("slack template error: %w", err)
+	}
+	return buf.String(), nil
 }
 
 func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
@@ -82,7 +115,7 @@ func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
 			FailRow:             s.FailRow,
 			OpenLink:            htemplate.URL(s.OpenLink),
 			EditLink:            htemplate.URL(s.EditLink),
-		})
+		}, s.Preview)
 	case runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR:
 		return n.sendAlertStatus(&AlertStatusData{
 			Title:               s.Title,
@@ -111,16 +144,16 @@ func (n *notifier) sendAlertStatus(data *AlertStatusData) error {
 	}
 	txt := buf.String()
 
-	if err := n.sendTextToChannels(txt); err != nil {
+	if err := n.sendTextToChannels(txt, nil, nil); err != nil {
 		return err
 	}
-	if err := n.sendTextToUsers(txt); err != nil {
+	if err := n.sendTextToUsers(txt, nil, nil); err != nil {
 		return err
 	}
-	return n.sendTextViaWebhooks(txt)
+	return n.sendTextViaWebhooks(txt, nil)
 }
 
-func (n *notifier) sendAlertFail(data *AlertFailData) error {
+func (n *notifier) sendAlertFail(data *AlertFailData, preview *drivers.Preview) error {
 	data.Subject = fmt.Sprintf("%s (%s)", data.Title, data.ExecutionTimeString)
 
 	buf := new(bytes.Buffer)
@@ -129,17 +162,24 @@ func (n *notifier) sendAlertFail(data *AlertFailData) error {
 		return fmt.Errorf("slack template error: %w", err)
 	}
 	txt := buf.String()
+	blocks, err := n.messageBlocks(txt, preview)
+	if err != nil {
+		return err
+	}
 
-	if err := n.sendTextToChannels(txt); err != nil {
+	if err := n.sendTextToChannels(txt, blocks, nil); err != nil {
 		return err
 	}
-	if err := n.sendTextToUsers(txt); err != nil {
+	if err := n.sendTextToUsers(txt, blocks, nil); err != nil {
 		return err
 	}
-	return n.sendTextViaWebhooks(txt)
+	return n.sendTextViaWebhooks(txt, blocks)
 }
 
-func (n *notifier) sendTextToChannels(txt string) error {
+// sendTextToChannels posts a message to each of the configured channels.
+// If blocks is not nil, the message is rendered using the blocks and txt is used as the fallback text.
+// If attachment is not nil, it is uploaded to the channel after the message.
+func (n *notifier) sendTextToChannels(txt string, blocks []slack.Block, attachment *drivers.Attachment) error {
 	if len(n.props.Channels) == 0 {
 		return nil
 	}
@@ -149,15 +189,21 @@ func (n *notifier) sendTextToChannels(txt string) error {
 	}
 
 	for _, channel := range n.props.Channels {
-		_, _, err := n.api.PostMessage(channel, slack.MsgOptionText(txt, false), slack.MsgOptionDisableLinkUnfurl())
+		channelID, _, err := n.api.PostMessage(channel, messageOptions(txt, blocks)...)
 		if err != nil {
 			return fmt.Errorf("slack api error: %w", err)
 		}
+		if err := n.uploadAttachment(channelID, attachment); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-func (n *notifier) sendTextToUsers(txt string) error {
+// sendTextToUsers sends a direct message to each of the configured users.
+// If blocks is not nil, the message is rendered using the blocks and txt is used as the fallback text.
+// If attachment is not nil, it is uploaded to the conversation after the message.
+func (n *notifier) sendTextToUsers(txt string, blocks []slack.Block, attachment *drivers.Attachment) error {
 	if len(n.props.Users) == 0 {
 		return nil
 	}
@@ -171,19 +217,43 @@ func (n *notifier) sendTextToUsers(txt string) error {
 		if err != nil {
 			return fmt.Errorf("slack api error: %w", err)
 		}
-		_, _, err = n.api.PostMessage(user.ID, slack.MsgOptionText(txt, false), slack.MsgOptionDisableLinkUnfurl())
+		channelID, _, err := n.api.PostMessage(user.ID, messageOptions(txt, blocks)...)
 		if err != nil {
 			return fmt.Errorf("slack api error: %w", err)
 		}
+		if err := n.uploadAttachment(channelID, attachment); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-func (n *notifier) sendTextViaWebhooks(txt string) error {
+// uploadAttachment uploads a file to a channel. The channel must be a channel ID (not a channel name).
+func (n *notifier) uploadAttachment(channelID string, attachment *drivers.Attachment) error {
+	if attachment == nil || len(attachment.Data) == 0 {
+		return nil
+	}
+	_, err := n.api.UploadFileV2(slack.UploadFileV2Parameters{
+		Reader:   bytes.NewReader(attachment.Data),
+		FileSize: len(attachment.Data),
+		Filename: attachment.Filename,
+		Title:    attachment.Filename,
+		Channel:  channelID,
+	})
+	if err != nil {
+		return fmt.Errorf("slack file upload error: %w", err)
+	}
+	return nil
+}
+
+func (n *notifier) sendTextViaWebhooks(txt string, blocks []slack.Block) error {
 	for _, webhook := range n.props.Webhooks {
 		payload := slack.WebhookMessage{
 			Text: txt,
 		}
+		if blocks != nil {
+			payload.Blocks = &slack.Blocks{BlockSet: blocks}
+		}
 		err := slack.PostWebhook(webhook, &payload)
 		if err != nil {
 			return fmt.Errorf("slack webhook error: %w", err)
@@ -192,6 +262,15 @@ func (n *notifier) sendTextViaWebhooks(txt string) error {
 	return nil
 }
 
+// messageOptions returns the options for posting a message with the given text and optional blocks.
+func messageOptions(txt string, blocks []slack.Block) []slack.MsgOption {
+	opts := []slack.MsgOption{slack.MsgOptionText(txt, false), slack.MsgOptionDisableLinkUnfurl()}
+	if blocks != nil {
+		opts = append(opts, slack.MsgOptionBlocks(blocks...))
+	}
+	return opts
+}
+
 func EncodeProps(users, channels, webhooks []string) map[string]any {
 	return map[string]any{
 		"users":    pbutil.ToSliceAny(users),
@@ -209,6 +288,16 @@ func DecodeProps(propsMap map[string]any) (*NotifierProperties, error) {
 	return props, nil
 }
 
+type ScheduledReportData struct {
+	Title            string
+	ReportTimeString string // Will be inferred from ReportTime
+	DownloadFormat   string
+	OpenLink         string
+	DownloadLink     string
+	EditLink         string
+	Attached         bool
+}
+
 type AlertStatusData struct {
 	Subject             string
 	Title               string
@@ -0,0 +1,101 @@
+package slack
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/slack-go/slack"
+)
+
+// sectionTextLimit is the max length of the text in a Block Kit section block.
+const sectionTextLimit = 3000
+
+type PreviewData struct {
+	Lines     []string
+	Truncated bool
+	ShownRows int
+	TotalRows int
+}
+
+// messageBlocks returns Block Kit blocks for a message consisting of the given text followed by a preview table.
+// It returns nil if there is no preview to include, in which case the message should be sent as plain text.
+func (n *notifier) messageBlocks(txt string, preview *drivers.Preview) ([]slack.Block, error) {
+	if preview == nil || len(preview.Columns) == 0 || utf8.RuneCountInString(txt) > sectionTextLimit {
+		return nil, nil
+	}
+
+	// Render the table, dropping rows until it fits in a section block
+	var table string
+	for shown := len(preview.Rows); shown >= 0; shown-- {
+		buf := new(bytes.Buffer)
+		err := n.templates.Lookup("preview.slack").Execute(buf, &PreviewData{
+			Lines:     previewLines(preview.Columns, preview.Rows[:shown]),
+			Truncated: shown < len(preview.Rows),
+			ShownRows: shown,
+			TotalRows: len(preview.Rows),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("slack template error: %w", err)
+		}
+		table = buf.String()
+		if utf8.RuneCountInString(table) <= sectionTextLimit {
+			break
+		}
+		table = ""
+	}
+	if table == "" {
+		return nil, nil
+	}
+
+	return []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, txt, false, false), nil, nil),
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, table, false, false), nil, nil),
+	}, nil
+}
+
+// previewLines renders a table as lines of text with padded columns, for display in a code block.
+func previewLines(columns []string, rows [][]string) []string {
+	widths := make([]int, len(columns))
+	for i, c := range columns {
+		widths[i] = utf8.RuneCountInString(c)
+	}
+	for _, row := range rows {
+		for i, v := range row {
+			if i < len(widths) {
+				widths[i] = max(widths[i], utf8.RuneCountInString(v))
+			}
+		}
+	}
+
+	line := func(vals []string) string {
+		var b strings.Builder
+		for i := range columns {
+			var v string
+			if i < len(vals) {
+				v = strings.ReplaceAll(vals[i], "`", "'")
+			}
+			if i > 0 {
+				b.WriteString("  ")
+			}
+			b.WriteString(v)
+			if i < len(columns)-1 {
+				b.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(v)))
+			}
+		}
+		return b.String()
+	}
+
+	separators := make([]string, len(columns))
+	for i, w := range widths {
+		separators[i] = strings.Repeat("-", w)
+	}
+
+	lines := []string{line(columns), line(separators)}
+	for _, row := range rows {
+		lines = append(lines, line(row))
+	}
+	return lines
+}
@@ -0,0 +1,46 @@
+package slack
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewLines(t *testing.T) {
+	lines := previewLines([]string{"Country", "Revenue"}, [][]string{
+		{"DK", "$1.2k"},
+		{"United States", "$800"},
+	})
+	require.Equal(t, []string{
+		"Country        Revenue",
+		"-------------  -------",
+		"DK             $1.2k",
+		"United States  $800",
+	}, lines)
+}
+
+func TestMessageBlocks(t *testing.T) {
+	n, err := newNotifier("", nil)
+	require.NoError(t, err)
+
+	blocks, err := n.messageBlocks("Hello", nil)
+	require.NoError(t, err)
+	require.Nil(t, blocks)
+
+	preview := &drivers.Preview{Columns: []string{"Country"}}
+	for i := 0; i < 500; i++ {
+		preview.Rows = append(preview.Rows, []string{fmt.Sprintf("Country %d", i)})
+	}
+	blocks, err = n.messageBlocks("Hello", preview)
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+
+	table := blocks[1].(*slack.SectionBlock).Text.Text
+	require.LessOrEqual(t, len(table), sectionTextLimit)
+	require.True(t, strings.HasPrefix(table, "```\nCountry"))
+	require.Contains(t, table, "of 500 rows._")
+}
@@ -0,0 +1,26 @@
+package drivers
+
+import "context"
+
+// StreamStore is implemented by connectors that continuously receive new data, such as message queues.
+// Data is consumed incrementally, so connectors that implement it are not exposed via an As...() function.
+// Transporters detect it with a type assertion on the source handle.
+type StreamStore interface {
+	// ConsumeBatches consumes the data that is available in the stream and passes it to fn in batches.
+	// The state is the state of a previous StreamBatch. If it is nil, consumption starts at the position configured in props.
+	// It returns when all data available when it was called has been consumed.
+	ConsumeBatches(ctx context.Context, props, state map[string]any, fn func(*StreamBatch) error) error
+}
+
+// StreamBatch is a batch of data consumed from a StreamStore.
+type StreamBatch struct {
+	// Path is a local file containing the batch's records as newline-delimited JSON.
+	// It is deleted after fn returns. It is empty if the batch only advances the position, such as when there was no new data.
+	Path string
+	// Records is the number of records in the batch.
+	Records int64
+	// Bytes is the size of the batch's file.
+	Bytes int64
+	// State is the position in the stream after the batch, which can be passed to ConsumeBatches to resume consumption.
+	State map[string]any
+}
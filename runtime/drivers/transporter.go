@@ -16,6 +16,11 @@ type TransferOptions struct {
 	RepoRoot         string
 	Progress         Progress
 	AcquireConnector func(string) (Handle, func(), error)
+	// State is the state persisted by the previous transfer, such as consumed offsets.
+	// If it is non-nil, transporters that support incremental transfers append to the existing table.
+	State map[string]any
+	// SetState is called by transporters that support incremental transfers when new state should be persisted (for example after each batch).
+	SetState func(state map[string]any)
 }
 
 // Progress is an interface for communicating progress info
@@ -0,0 +1,322 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/template"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+)
+
+const (
+	// requestTimeout is the timeout for a single delivery attempt.
+	requestTimeout = 30 * time.Second
+	// retryMax is the maximum number of retries for a delivery (in addition to the first attempt).
+	retryMax = 4
+)
+
+// Headers set on every webhook request.
+const (
+	HeaderEvent     = "X-Rill-Event"
+	HeaderTimestamp = "X-Rill-Timestamp"
+	HeaderSignature = "X-Rill-Signature"
+)
+
+type notifier struct {
+	client        *retryablehttp.Client
+	signingSecret string
+	props         *NotifierProperties
+	template      *template.Template
+}
+
+type NotifierProperties struct {
+	URLs    []string          `mapstructure:"urls"`
+	Headers map[string]string `mapstructure:"headers"`
+	// Template is an optional Go template that renders the JSON payload. It is evaluated against the default payload.
+	Template string `mapstructure:"template"`
+}
+
+func newNotifier(conf *configProperties, propsMap map[string]any) (*notifier, error) {
+	props, err := DecodeProps(propsMap)
+	if err != nil {
+		return nil, err
+	}
+	if err := props.Validate(); err != nil {
+		return nil, err
+	}
+
+	var tmpl *template.Template
+	if props.Template != "" {
+		tmpl, err = parseTemplate(props.Template)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	client := retryablehttp.NewClient()
+	client.RetryMax = retryMax
+	client.RetryWaitMin = 500 * time.Millisecond
+	client.RetryWaitMax = 10 * time.Second
+	client.HTTPClient.Timeout = requestTimeout
+	client.Logger = nil // Disable inbuilt logger
+	if !conf.AllowHostAccess {
+		transport := client.HTTPClient.Transport.(*http.Transport)
+		dialer := &net.Dialer{Timeout: requestTimeout, Control: checkPublicAddress}
+		transport.DialContext = dialer.DialContext
+		client.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+			if errors.Is(err, errPrivateAddress) {
+				return false, err
+			}
+			return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+		}
+	}
+
+	return &notifier{
+		client:        client,
+		signingSecret: conf.SigningSecret,
+		props:         props,
+		template:      tmpl,
+	}, nil
+}
+
+func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
+	payload := map[string]any{
+		"event":          "alert_status",
+		"title":          s.Title,
+		"execution_time": s.ExecutionTime.Format(time.RFC3339),
+		"status":         alertStatusString(s.Status),
+		"is_recover":     s.IsRecover,
+		"fail_row":       s.FailRow,
+		"error_message":  s.ExecutionError,
+		"open_link":      s.OpenLink,
+		"edit_link":      s.EditLink,
+	}
+	if s.Preview != nil {
+		payload["preview"] = previewPayload(s.Preview)
+	}
+	return n.send("alert_status", payload)
+}
+
+func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
+	payload := map[string]any{
+		"event":           "scheduled_report",
+		"title":           s.Title,
+		"report_time":     s.ReportTime.Format(time.RFC3339),
+		"download_format": s.DownloadFormat,
+		"open_link":       s.OpenLink,
+		"download_link":   s.DownloadLink,
+		"edit_link":       s.EditLink,
+	}
+	if s.Preview != nil {
+		payload["preview"] = previewPayload(s.Preview)
+	}
+	return n.send("scheduled_report", payload)
+}
+
+// previewPayload converts a preview table to a JSON-serializable value for the payload.
+func previewPayload(p *drivers.Preview) map[string]any {
+	return map[string]any{
+		"columns": p.Columns,
+		"rows":    p.Rows,
+	}
+}
+
+// send renders the payload and posts it to each of the configured URLs.
+// A failed delivery doesn't prevent delivery to the other URLs.
+func (n *notifier) send(event string, payload map[string]any) error {
+	body, err := n.render(payload)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, u := range n.props.URLs {
+		err := n.post(u, event, body)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", redactURL(u), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// redactURL returns the scheme and host of a URL, since webhook URLs often embed secrets in their path or query.
+func redactURL(u string) string {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return "invalid URL"
+	}
+	return parsed.Scheme + "://" + parsed.Host
+}
+
+var errPrivateAddress = errors.New("webhook URLs must not resolve to private, loopback or link-local addresses")
+
+// checkPublicAddress is a net.Dialer control function that rejects connections to non-public addresses.
+// It runs after DNS resolution for each dialed address, so it also prevents DNS rebinding to internal services.
+func checkPublicAddress(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid address %q: %w", address, err)
+	}
+	addr := addrPort.Addr().Unmap()
+	if addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsUnspecified() || addr.IsMulticast() {
+		return errPrivateAddress
+	}
+	return nil
+}
+
+// render returns the JSON body for a payload, applying the custom template if configured.
+func (n *notifier) render(payload map[string]any) ([]byte, error) {
+	if n.template == nil {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("webhook payload error: %w", err)
+		}
+		return body, nil
+	}
+
+	buf := new(bytes.Buffer)
+	err := n.template.Execute(buf, payload)
+	if err != nil {
+		return nil, fmt.Errorf("webhook template error: %w", err)
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, fmt.Errorf("webhook template error: rendered payload is not valid JSON")
+	}
+	return buf.Bytes(), nil
+}
+
+// post delivers a body to a URL, retrying with backoff on connection errors and retryable status codes.
+func (n *notifier) post(u, event string, body []byte) error {
+	req, err := retryablehttp.NewRequestWithContext(context.Background(), http.MethodPost, u, body)
+	if err != nil {
+		return fmt.Errorf("webhook request error: %w", err)
+	}
+
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range n.props.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set(HeaderEvent, event)
+	req.Header.Set(HeaderTimestamp, ts)
+	if n.signingSecret != "" {
+		req.Header.Set(HeaderSignature, Sign(n.signingSecret, ts, body))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("webhook error: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// Sign computes the signature for a webhook payload.
+// The signature is the hex-encoded HMAC-SHA256 of "<timestamp>.<body>" using the signing secret, prefixed with "sha256=".
+// Receivers can verify a request by recomputing the signature from the X-Rill-Timestamp header and the raw body.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+var templateFuncs = template.FuncMap{
+	// json encodes a value as JSON, which makes it safe to embed values in the payload template.
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	},
+}
+
+func alertStatusString(s runtimev1.AssertionStatus) string {
+	switch s {
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_PASS:
+		return "pass"
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL:
+		return "fail"
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// Validate checks that the properties contain at least one valid URL and a valid template (if any).
+func (p *NotifierProperties) Validate() error {
+	if len(p.URLs) == 0 {
+		return fmt.Errorf("webhook notifier requires at least one URL")
+	}
+	for _, u := range p.URLs {
+		parsed, err := url.Parse(u)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid webhook URL %q", u)
+		}
+	}
+	if p.Template != "" {
+		if _, err := parseTemplate(p.Template); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseTemplate(tmpl string) (*template.Template, error) {
+	t, err := template.New("payload").Funcs(templateFuncs).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook template: %w", err)
+	}
+	return t, nil
+}
+
+func EncodeProps(urls []string, headers map[string]string, tmpl string) map[string]any {
+	hdrs := make(map[string]any, len(headers))
+	for k, v := range headers {
+		hdrs[k] = v
+	}
+	props := map[string]any{
+		"urls":    pbutil.ToSliceAny(urls),
+		"headers": hdrs,
+	}
+	if tmpl != "" {
+		props["template"] = tmpl
+	}
+	return props
+}
+
+func DecodeProps(propsMap map[string]any) (*NotifierProperties, error) {
+	props := &NotifierProperties{}
+	err := mapstructure.WeakDecode(propsMap, props)
+	if err != nil {
+		return nil, err
+	}
+	return props, nil
+}
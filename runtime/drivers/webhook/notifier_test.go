@@ -0,0 +1,176 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSendAlertStatus(t *testing.T) {
+	var attempts atomic.Int32
+	var gotBody []byte
+	var gotHeader http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Fail the first attempt to exercise retries
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		gotBody, _ = io.ReadAll(r.Body)
+		gotHeader = r.Header.Clone()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier(&configProperties{SigningSecret: "secret", AllowHostAccess: true}, EncodeProps([]string{srv.URL}, map[string]string{"Authorization": "Bearer token"}, ""))
+	require.NoError(t, err)
+
+	err = n.SendAlertStatus(&drivers.AlertStatus{
+		Title:         "My alert",
+		ExecutionTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		FailRow:       map[string]any{"country": "Denmark"},
+		OpenLink:      "https://example.com/open",
+		Preview:       &drivers.Preview{Columns: []string{"Country"}, Rows: [][]string{{"Denmark"}}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), attempts.Load())
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(gotBody, &payload))
+	require.Equal(t, "alert_status", payload["event"])
+	require.Equal(t, "My alert", payload["title"])
+	require.Equal(t, "fail", payload["status"])
+	require.Equal(t, "2024-01-01T00:00:00Z", payload["execution_time"])
+	require.Equal(t, map[string]any{"country": "Denmark"}, payload["fail_row"])
+	require.Equal(t, map[string]any{"columns": []any{"Country"}, "rows": []any{[]any{"Denmark"}}}, payload["preview"])
+
+	require.Equal(t, "application/json", gotHeader.Get("Content-Type"))
+	require.Equal(t, "Bearer token", gotHeader.Get("Authorization"))
+	require.Equal(t, "alert_status", gotHeader.Get(HeaderEvent))
+	require.Equal(t, Sign("secret", gotHeader.Get(HeaderTimestamp), gotBody), gotHeader.Get(HeaderSignature))
+}
+
+func TestSendScheduledReportTemplate(t *testing.T) {
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		require.Empty(t, r.Header.Get(HeaderSignature))
+	}))
+	defer srv.Close()
+
+	tmpl := `{"summary": {{ json .title }}, "links": [{{ json .open_link }}, {{ json .download_link }}]}`
+	n, err := newNotifier(&configProperties{AllowHostAccess: true}, EncodeProps([]string{srv.URL}, nil, tmpl))
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{
+		Title:        `Weekly "revenue"`,
+		ReportTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		OpenLink:     "https://example.com/open",
+		DownloadLink: "https://example.com/download",
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"summary": "Weekly \"revenue\"", "links": ["https://example.com/open", "https://example.com/download"]}`, string(gotBody))
+}
+
+func TestSendNonRetryableError(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		http.Error(w, "bad payload", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier(&configProperties{AllowHostAccess: true}, EncodeProps([]string{srv.URL}, nil, ""))
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{Title: "Report"})
+	require.ErrorContains(t, err, "unexpected status 400: bad payload")
+	require.Equal(t, int32(1), attempts.Load())
+}
+
+func TestSendAllURLs(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		if r.URL.Path == "/fail" {
+			http.Error(w, "bad payload", http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier(&configProperties{AllowHostAccess: true}, EncodeProps([]string{srv.URL + "/fail", srv.URL + "/ok"}, nil, ""))
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{Title: "Report"})
+	require.ErrorContains(t, err, "unexpected status 400: bad payload")
+	require.NotContains(t, err.Error(), "/fail")
+	require.Equal(t, int32(2), attempts.Load())
+}
+
+func TestSendPrivateAddress(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier(&configProperties{}, EncodeProps([]string{srv.URL, "http://169.254.169.254/latest/meta-data"}, nil, ""))
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{Title: "Report"})
+	require.ErrorIs(t, err, errPrivateAddress)
+	require.Equal(t, int32(0), attempts.Load())
+}
+
+func TestOpenIgnoresProjectAllowPrivateAddresses(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+	}))
+	defer srv.Close()
+
+	h, err := driver{}.Open("default", map[string]any{"allow_private_addresses": true, "allow_host_access": false}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+
+	n, err := h.AsNotifier(EncodeProps([]string{srv.URL}, nil, ""))
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{Title: "Report"})
+	require.ErrorIs(t, err, errPrivateAddress)
+	require.Equal(t, int32(0), attempts.Load())
+}
+
+func TestCheckPublicAddress(t *testing.T) {
+	for addr, public := range map[string]bool{
+		"93.184.215.14:443":     true,
+		"[2606:4700::1111]:80":  true,
+		"127.0.0.1:80":          false,
+		"10.0.0.1:80":           false,
+		"172.16.0.1:80":         false,
+		"192.168.1.1:80":        false,
+		"169.254.169.254:80":    false,
+		"0.0.0.0:80":            false,
+		"[::1]:80":              false,
+		"[fd00::1]:80":          false,
+		"[fe80::1]:80":          false,
+		"[::ffff:127.0.0.1]:80": false,
+	} {
+		err := checkPublicAddress("tcp", addr, nil)
+		if public {
+			require.NoError(t, err, addr)
+		} else {
+			require.ErrorIs(t, err, errPrivateAddress, addr)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package metricsview
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +21,7 @@ type AST struct {
 	underlyingTable *string
 	underlyingWhere *ExprNode
 	dimFields       []FieldNode
+	bucketAliases   map[string]string
 	nextIdentifier  int
 
 	// Contextual info for building the AST
@@ -98,6 +100,11 @@ func NewAST(mv *runtimev1.MetricsViewSpec, sec *runtime.ResolvedMetricsViewSecur
 		dialect:     dialect,
 	}
 
+	// Build the underlying table expression.
+	// Joins for bucket dimensions are appended to it when they're first referenced.
+	tbl := ast.dialect.EscapeTable(mv.Database, mv.DatabaseSchema, mv.Table)
+	ast.underlyingTable = &tbl
+
 	// Build dimensions to apply against the underlying SELECT.
 	// We cache these in the AST type because when resolving expressions and adding new JOINs, we need the ability to reference these.
 	dimFields := make([]FieldNode, 0, len(ast.query.Dimensions))
@@ -107,6 +114,11 @@ func NewAST(mv *runtimev1.MetricsViewSpec, sec *runtime.ResolvedMetricsViewSecur
 			return nil, fmt.Errorf("invalid dimension %q: %w", qd.Name, err)
 		}
 
+		expr, err := ast.sqlForDimension(dim)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dimension %q: %w", qd.Name, err)
+		}
+
 		var unnestAlias string
 		if dim.Unnest {
 			unnestAlias = ast.generateIdentifier()
@@ -115,20 +127,18 @@ func NewAST(mv *runtimev1.MetricsViewSpec, sec *runtime.ResolvedMetricsViewSecur
 		dimFields = append(dimFields, FieldNode{
 			Name:        dim.Name,
 			Label:       dim.Label,
-			Expr:        ast.dialect.MetricsViewDimensionExpression(dim),
+			Expr:        expr,
 			Unnest:      dim.Unnest,
 			UnnestAlias: unnestAlias,
 		})
 	}
 	ast.dimFields = dimFields
 
-	// Build underlying SELECT
-	tbl := ast.dialect.EscapeTable(mv.Database, mv.DatabaseSchema, mv.Table)
+	// Build underlying WHERE
 	where, err := ast.buildUnderlyingWhere()
 	if err != nil {
 		return nil, err
 	}
-	ast.underlyingTable = &tbl
 	ast.underlyingWhere = where
 
 	// Build initial root node (empty query against the base select)
@@ -274,9 +284,9 @@ func (a *AST) resolveMeasure(qm Measure, visible bool) (*runtimev1.MetricsViewSp
 			return nil, err
 		}
 
-		expr := dim.Expression
-		if expr == "" {
-			expr = a.dialect.EscapeIdentifier(dim.Column)
+		expr, err := a.sqlForDimension(dim)
+		if err != nil {
+			return nil, err
 		}
 
 		return &runtimev1.MetricsViewSpec_MeasureV2{
@@ -635,7 +645,7 @@ func (a *AST) addDerivedMeasure(n *SelectNode, m *runtimev1.MetricsViewSpec_Meas
 		return err
 	}
 	if n.Group {
-		// TODO: There's a risk of expr containing a window, which can't be wrapped by ANY_VALUE. Need to fix it by wrapping with a non-grouped SELECT. Doesn't matter until we implement addDerivedMeasureWithPer.
+		// TODO: There's a risk of expr containing a window, which can't be wrapped by ANY_VALUE. Need to fix it by wrapping with a non-grouped SELECT.
 		expr = a.sqlForAnyInGroup(expr)
 	}
 
@@ -650,8 +660,100 @@ func (a *AST) addDerivedMeasure(n *SelectNode, m *runtimev1.MetricsViewSpec_Meas
 
 // addDerivedMeasureWithPer adds a measure of type derived with "per" dimensions to the given SelectNode.
 // When called, we know the measure is not present in the SelectNode, but it might be present in a sub-select.
-func (a *AST) addDerivedMeasureWithPer(_ *SelectNode, _ *runtimev1.MetricsViewSpec_MeasureV2) error {
-	return errors.New(`support for "per" not implemented`)
+//
+// The measure is computed in a separate sub-select that is grouped only by the query dimensions that are also "per" dimensions.
+// The sub-select is then left joined onto the current node on those dimensions.
+// For example, for a measure "country_revenue" with per: [country], a query for country and city will compute the measure at the country level and join it onto each city.
+// If none of the "per" dimensions are present in the query, the measure is computed across all rows and joined onto every row.
+func (a *AST) addDerivedMeasureWithPer(n *SelectNode, m *runtimev1.MetricsViewSpec_MeasureV2) error {
+	// If the current node has a comparison join, push calculation of the measure into its FromSelect and add a pass-through field in the current node.
+	// This is necessary because a node can't have both LeftJoinSelects and a JoinComparisonSelect.
+	if n.JoinComparisonSelect != nil {
+		if !a.hasMeasure(n.FromSelect, m.Name) {
+			err := a.addDerivedMeasureWithPer(n.FromSelect, m)
+			if err != nil {
+				return err
+			}
+		}
+
+		expr := a.sqlForMember(n.FromSelect.Alias, m.Name)
+		if n.Group {
+			expr = a.sqlForAnyInGroup(expr)
+		}
+
+		n.MeasureFields = append(n.MeasureFields, FieldNode{
+			Name:  m.Name,
+			Label: m.Label,
+			Expr:  expr,
+		})
+
+		return nil
+	}
+
+	// Find the query dimensions that the measure should be computed by.
+	// "Per" dimensions that are not present in the query are ignored, which means the measure is computed at a coarser granularity.
+	var perFields []FieldNode
+	for _, f := range a.dimFields {
+		for _, pd := range m.PerDimensions {
+			if a.fieldMatchesDimension(f, pd) {
+				perFields = append(perFields, f)
+				break
+			}
+		}
+	}
+
+	// The sub-select must apply the same time range as the node it's joined onto (which may be the comparison time range).
+	timeWhere := a.findUnderlyingTimeWhere(n)
+
+	// If the node targets the underlying table, we need to add a new level of nesting.
+	// This ensures n.FromSelect is set, so we can add the join.
+	if n.FromTable != nil {
+		a.wrapSelect(n, a.generateIdentifier())
+	}
+
+	// Build a sub-select grouped by the "per" dimensions and add the measure to it (without the "per" dimensions, so it's added as a regular measure).
+	perSelect := &SelectNode{
+		Alias:     a.generateIdentifier(),
+		DimFields: perFields,
+		Group:     true,
+		FromTable: a.underlyingTable,
+		Where:     a.underlyingWhere,
+		TimeWhere: timeWhere,
+	}
+
+	// If the measure doesn't reference other measures, its expression is an aggregation against the underlying table.
+	typ := runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED
+	if len(m.ReferencedMeasures) == 0 {
+		typ = runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE
+	}
+
+	err := a.addMeasureField(perSelect, &runtimev1.MetricsViewSpec_MeasureV2{
+		Name:               m.Name,
+		Expression:         m.Expression,
+		Type:               typ,
+		Window:             m.Window,
+		ReferencedMeasures: m.ReferencedMeasures,
+		Label:              m.Label,
+	})
+	if err != nil {
+		return err
+	}
+
+	n.LeftJoinSelects = append(n.LeftJoinSelects, perSelect)
+
+	// Add a pass-through field for the measure to the current node.
+	expr := a.sqlForMember(perSelect.Alias, m.Name)
+	if n.Group {
+		expr = a.sqlForAnyInGroup(expr)
+	}
+
+	n.MeasureFields = append(n.MeasureFields, FieldNode{
+		Name:  m.Name,
+		Label: m.Label,
+		Expr:  expr,
+	})
+
+	return nil
 }
 
 // addTimeComparisonMeasure adds a measure of type time comparison to the given SelectNode.
@@ -696,7 +798,7 @@ func (a *AST) addTimeComparisonMeasure(n *SelectNode, m *runtimev1.MetricsViewSp
 		return err
 	}
 	if n.Group {
-		// TODO: There's a risk of expr containing a window, which can't be wrapped by ANY_VALUE. Need to fix it by wrapping with a non-grouped SELECT. Doesn't matter until we implement addDerivedMeasureWithPer.
+		// TODO: There's a risk of expr containing a window, which can't be wrapped by ANY_VALUE. Need to fix it by wrapping with a non-grouped SELECT.
 		// TODO: Can a node with a comparison ever have Group==true?
 		expr = a.sqlForAnyInGroup(expr)
 	}
@@ -737,7 +839,8 @@ func (a *AST) addReferencedMeasuresToScope(n *SelectNode, referencedMeasures []s
 
 	// If the node targets the underlying table, we need to add a new level of nesting.
 	// This ensures n.FromSelect is set, so we can bring the referenced measures into scope.
-	if n.FromTable != nil {
+	// Similarly, if the node has "per" joins, we add a new level of nesting to avoid ambiguous references to measures in the joined sub-selects.
+	if n.FromTable != nil || len(n.LeftJoinSelects) > 0 {
 		a.wrapSelect(n, a.generateIdentifier())
 	}
 
@@ -842,35 +945,56 @@ func (a *AST) hasMeasure(n *SelectNode, name string) bool {
 // It takes computed dimensions into account, comparing against the underlying dimension name instead of the query alias.
 func (a *AST) findFieldForDimension(n *SelectNode, dim *runtimev1.MetricsViewSpec_DimensionSelector) (FieldNode, bool) {
 	for _, f := range n.DimFields {
-		// If name matches, we're done
-		if dim.Name == f.Name {
+		if a.fieldMatchesDimension(f, dim) {
 			return f, true
 		}
+	}
+	return FieldNode{}, false
+}
 
-		// Find original query dimension for the field
-		var fqd Dimension
-		for _, qd := range a.query.Dimensions {
-			if f.Name == qd.Name {
-				fqd = qd
-				break
-			}
-		}
+// fieldMatchesDimension checks if the field corresponds to the dimension selector.
+// See findFieldForDimension for details.
+func (a *AST) fieldMatchesDimension(f FieldNode, dim *runtimev1.MetricsViewSpec_DimensionSelector) bool {
+	// If name matches, we're done
+	if dim.Name == f.Name {
+		return true
+	}
 
-		// If it's a computed dimension, check against the underlying dimension name (and time grain if specified)
-		if fqd.Compute != nil && fqd.Compute.TimeFloor != nil {
-			if dim.Name != fqd.Compute.TimeFloor.Dimension {
-				continue
-			}
+	// Find original query dimension for the field
+	var fqd Dimension
+	for _, qd := range a.query.Dimensions {
+		if f.Name == qd.Name {
+			fqd = qd
+			break
+		}
+	}
 
-			if dim.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED && dim.TimeGrain != fqd.Compute.TimeFloor.Grain.ToProto() {
-				continue
-			}
+	// If it's a computed dimension, check against the underlying dimension name (and time grain if specified)
+	if fqd.Compute != nil && fqd.Compute.TimeFloor != nil {
+		if dim.Name != fqd.Compute.TimeFloor.Dimension {
+			return false
+		}
 
-			return f, true
+		if dim.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED && dim.TimeGrain != fqd.Compute.TimeFloor.Grain.ToProto() {
+			return false
 		}
+
+		return true
 	}
 
-	return FieldNode{}, false
+	return false
+}
+
+// findUnderlyingTimeWhere returns the time range filter applied to the innermost SELECT that targets the underlying table.
+// It follows FromSelect, so for a comparison SELECT it returns the comparison time range.
+func (a *AST) findUnderlyingTimeWhere(n *SelectNode) *ExprNode {
+	for n != nil {
+		if n.FromTable != nil {
+			return n.TimeWhere
+		}
+		n = n.FromSelect
+	}
+	return nil
 }
 
 // generateIdentifier generates a unique table identifier for use in the AST.
@@ -992,6 +1116,130 @@ func (a *AST) sqlForMeasure(m *runtimev1.MetricsViewSpec_MeasureV2, n *SelectNod
 	return b.String(), nil
 }
 
+// sqlForDimension builds a SQL expression for a dimension against the underlying table.
+// For bucket dimensions, it adds a join to the underlying table that assigns each entity to a bucket (unless already added).
+func (a *AST) sqlForDimension(dim *runtimev1.MetricsViewSpec_DimensionV2) (string, error) {
+	if dim.Bucket == nil {
+		return a.dialect.MetricsViewDimensionExpression(dim), nil
+	}
+
+	alias, ok := a.bucketAliases[dim.Name]
+	if !ok {
+		var err error
+		alias, err = a.addBucketJoin(dim)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return a.sqlForMember(alias, alias+"_bucket"), nil
+}
+
+// addBucketJoin adds a LEFT JOIN to the underlying table expression that maps each entity of a bucket dimension to its bucket.
+// The joined sub-query aggregates the bucket's measure per entity (respecting the security row filter, but not the query's filters), and then assigns each entity to a bucket label.
+// It returns the alias of the joined sub-query. The key and bucket columns are named "<alias>_key" and "<alias>_bucket" respectively.
+func (a *AST) addBucketJoin(dim *runtimev1.MetricsViewSpec_DimensionV2) (string, error) {
+	if !a.dialect.SupportsBucketDimensions() {
+		return "", fmt.Errorf("bucket dimensions are not supported for dialect %q", a.dialect.String())
+	}
+
+	b := dim.Bucket
+	entity, err := a.lookupDimension(b.EntityDimension, false)
+	if err != nil {
+		return "", fmt.Errorf("invalid bucket entity: %w", err)
+	}
+	if entity.Bucket != nil || entity.Unnest {
+		return "", fmt.Errorf("bucket entity %q must be a regular dimension", entity.Name)
+	}
+
+	m, err := a.lookupMeasure(b.Measure, false)
+	if err != nil {
+		return "", fmt.Errorf("invalid bucket measure: %w", err)
+	}
+	if m.Type != runtimev1.MetricsViewSpec_MEASURE_TYPE_UNSPECIFIED && m.Type != runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE {
+		return "", fmt.Errorf("bucket measure %q must be a simple measure", m.Name)
+	}
+
+	n := len(b.Bins) + 1
+	if len(b.Bins) == 0 {
+		n = int(b.Quantiles)
+	}
+	if n < 1 {
+		return "", errors.New("bucket must have bins or quantiles")
+	}
+	if len(b.Labels) > 0 && len(b.Labels) != n {
+		return "", fmt.Errorf("expected %d bucket labels, got %d", n, len(b.Labels))
+	}
+	labels := make([]string, n)
+	for i := range labels {
+		switch {
+		case len(b.Labels) > 0:
+			labels[i] = b.Labels[i]
+		case len(b.Bins) == 0:
+			labels[i] = fmt.Sprintf("Q%d", i+1)
+		case i == 0:
+			labels[i] = fmt.Sprintf("< %s", strconv.FormatFloat(b.Bins[0], 'f', -1, 64))
+		case i == n-1:
+			labels[i] = fmt.Sprintf(">= %s", strconv.FormatFloat(b.Bins[i-1], 'f', -1, 64))
+		default:
+			labels[i] = fmt.Sprintf("%s - %s", strconv.FormatFloat(b.Bins[i-1], 'f', -1, 64), strconv.FormatFloat(b.Bins[i], 'f', -1, 64))
+		}
+	}
+
+	alias := a.generateIdentifier()
+	aggAlias := a.generateIdentifier()
+	keyCol := a.dialect.EscapeIdentifier(alias + "_key")
+	valCol := a.dialect.EscapeIdentifier(alias + "_value")
+	bucketCol := a.dialect.EscapeIdentifier(alias + "_bucket")
+	entityExpr := a.dialect.MetricsViewDimensionExpression(entity)
+
+	// Build the SELECT that aggregates the measure per entity
+	agg := &strings.Builder{}
+	fmt.Fprintf(agg, "SELECT (%s) AS %s, (%s) AS %s FROM %s", entityExpr, keyCol, m.Expression, valCol, a.dialect.EscapeTable(a.metricsView.Database, a.metricsView.DatabaseSchema, a.metricsView.Table))
+	var where []string
+	if a.security != nil && a.security.RowFilter != "" {
+		where = append(where, a.security.RowFilter)
+	}
+	if b.Where != "" {
+		where = append(where, b.Where)
+	}
+	if len(where) > 0 {
+		fmt.Fprintf(agg, " WHERE (%s)", strings.Join(where, ") AND ("))
+	}
+	agg.WriteString(" GROUP BY 1")
+
+	// Build the expression that maps the aggregated value to a bucket label
+	expr := &strings.Builder{}
+	var filter string
+	if len(b.Bins) > 0 {
+		fmt.Fprintf(expr, "CASE WHEN %s IS NULL THEN NULL", valCol)
+		for i, bin := range b.Bins {
+			fmt.Fprintf(expr, " WHEN %s < %s THEN %s", valCol, strconv.FormatFloat(bin, 'f', -1, 64), a.dialect.EscapeStringValue(labels[i]))
+		}
+		fmt.Fprintf(expr, " ELSE %s END", a.dialect.EscapeStringValue(labels[n-1]))
+	} else {
+		fmt.Fprintf(expr, "CASE NTILE(%d) OVER (ORDER BY %s)", n, valCol)
+		for i, label := range labels {
+			fmt.Fprintf(expr, " WHEN %d THEN %s", i+1, a.dialect.EscapeStringValue(label))
+		}
+		expr.WriteString(" END")
+		filter = fmt.Sprintf(" WHERE %s IS NOT NULL", valCol)
+	}
+
+	join := fmt.Sprintf(
+		" LEFT JOIN (SELECT %s AS %s, (%s) AS %s FROM (%s) %s%s) %s ON (%s) = %s",
+		keyCol, keyCol, expr.String(), bucketCol, agg.String(), aggAlias, filter, alias, entityExpr, a.sqlForMember(alias, alias+"_key"),
+	)
+	*a.underlyingTable += join
+
+	if a.bucketAliases == nil {
+		a.bucketAliases = make(map[string]string)
+	}
+	a.bucketAliases[dim.Name] = alias
+
+	return alias, nil
+}
+
 // sqlForMember builds a SQL expression for a column in a table.
 // It does not escape the tbl identifier because we currently only use it for internally generated aliases.
 func (a *AST) sqlForMember(tbl, name string) string {
@@ -1003,5 +1251,10 @@ func (a *AST) sqlForMember(tbl, name string) string {
 
 // sqlForAnyInGroup returns a SQL expression for passing through a field in a GROUP BY.
 func (a *AST) sqlForAnyInGroup(expr string) string {
+	// ANY_VALUE was only added in Postgres 16, so we fall back to taking the first element of ARRAY_AGG.
+	// Unlike MIN, it works for types without an ordering, such as booleans and JSON.
+	if a.dialect == drivers.DialectPostgres {
+		return fmt.Sprintf("(ARRAY_AGG(%s))[1]", expr)
+	}
 	return fmt.Sprintf("ANY_VALUE(%s)", expr)
 }
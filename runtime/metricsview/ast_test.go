@@ -0,0 +1,340 @@
+package metricsview
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+	"time"
+
+	_ "github.com/marcboeker/go-duckdb"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerivedMeasureWithPer(t *testing.T) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE orders AS SELECT * FROM (VALUES
+			(TIMESTAMP '2024-01-01', 'US', 'NYC', 10),
+			(TIMESTAMP '2024-01-01', 'US', 'SF', 30),
+			(TIMESTAMP '2024-01-02', 'DK', 'CPH', 20),
+			(TIMESTAMP '2024-01-02', 'US', 'NYC', 40),
+			(TIMESTAMP '2024-02-01', 'US', 'NYC', 100)
+		) t(time, country, city, revenue)
+	`)
+	require.NoError(t, err)
+
+	mv := &runtimev1.MetricsViewSpec{
+		Table:         "orders",
+		TimeDimension: "time",
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "country", Column: "country"},
+			{Name: "city", Column: "city"},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Expression: "SUM(revenue)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+			{
+				Name:               "country_revenue",
+				Expression:         "revenue",
+				Type:               runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED,
+				PerDimensions:      []*runtimev1.MetricsViewSpec_DimensionSelector{{Name: "country"}},
+				ReferencedMeasures: []string{"revenue"},
+			},
+			{
+				Name:               "country_share",
+				Expression:         "revenue / country_revenue",
+				Type:               runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED,
+				ReferencedMeasures: []string{"revenue", "country_revenue"},
+			},
+		},
+	}
+
+	jan := &TimeRange{
+		Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tt := []struct {
+		name  string
+		query *Query
+		want  [][]any
+	}{
+		{
+			name: "by country and city",
+			query: &Query{
+				Dimensions: []Dimension{{Name: "country"}, {Name: "city"}},
+				Measures:   []Measure{{Name: "revenue"}, {Name: "country_revenue"}, {Name: "country_share"}},
+				TimeRange:  jan,
+				Sort:       []Sort{{Name: "country"}, {Name: "city"}},
+			},
+			want: [][]any{
+				{"DK", "CPH", 20.0, 20.0, 1.0},
+				{"US", "NYC", 50.0, 80.0, 0.625},
+				{"US", "SF", 30.0, 80.0, 0.375},
+			},
+		},
+		{
+			name: "per dimension not in query",
+			query: &Query{
+				Dimensions: []Dimension{{Name: "city"}},
+				Measures:   []Measure{{Name: "country_revenue"}},
+				Sort:       []Sort{{Name: "city"}},
+			},
+			want: [][]any{
+				{"CPH", 200.0},
+				{"NYC", 200.0},
+				{"SF", 200.0},
+			},
+		},
+		{
+			name: "with where",
+			query: &Query{
+				Dimensions: []Dimension{{Name: "country"}, {Name: "city"}},
+				Measures:   []Measure{{Name: "country_share"}},
+				Where:      &Expression{Condition: &Condition{Operator: OperatorEq, Expressions: []*Expression{{Name: "country"}, {Value: "US"}}}},
+				TimeRange:  jan,
+				Sort:       []Sort{{Name: "city"}},
+			},
+			want: [][]any{
+				{"US", "NYC", 0.625},
+				{"US", "SF", 0.375},
+			},
+		},
+		{
+			name: "with comparison",
+			query: &Query{
+				Dimensions: []Dimension{{Name: "country"}},
+				Measures: []Measure{
+					{Name: "country_revenue"},
+					{Name: "country_revenue_prev", Compute: &MeasureCompute{ComparisonValue: &MeasureComputeComparisonValue{Measure: "country_revenue"}}},
+				},
+				TimeRange: &TimeRange{
+					Start: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+					End:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+				},
+				ComparisonTimeRange: jan,
+				Sort:                []Sort{{Name: "country"}},
+			},
+			want: [][]any{
+				{"DK", nil, 20.0},
+				{"US", 100.0, 80.0},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, queryAST(t, db, mv, tc.query))
+		})
+	}
+}
+
+func TestRewriteDruidJoinsWithPer(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table: "orders",
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "country", Column: "country"},
+			{Name: "city", Column: "city"},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Expression: "SUM(revenue)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+			{
+				Name:               "country_revenue",
+				Expression:         "revenue",
+				Type:               runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED,
+				PerDimensions:      []*runtimev1.MetricsViewSpec_DimensionSelector{{Name: "country"}},
+				ReferencedMeasures: []string{"revenue"},
+			},
+		},
+	}
+
+	limit := int64(10)
+	ast, err := NewAST(mv, nil, &Query{
+		Dimensions: []Dimension{{Name: "country"}, {Name: "city"}},
+		Measures:   []Measure{{Name: "revenue"}, {Name: "country_revenue"}},
+		Sort:       []Sort{{Name: "revenue", Desc: true}},
+		Limit:      &limit,
+	}, drivers.DialectDruid)
+	require.NoError(t, err)
+
+	e := &Executor{instanceCfg: drivers.InstanceConfig{MetricsApproximateComparisons: true}}
+	require.NoError(t, e.rewriteDruidJoins(ast))
+
+	// The "per" sub-selects are unordered, so limiting them would drop arbitrary rows
+	var walk func(n *SelectNode) int
+	walk = func(n *SelectNode) int {
+		if n == nil {
+			return 0
+		}
+		count := walk(n.FromSelect)
+		for _, ljs := range n.LeftJoinSelects {
+			require.Nil(t, ljs.Limit)
+			count += 1 + walk(ljs)
+		}
+		return count
+	}
+	require.Equal(t, 1, walk(ast.Root))
+	require.Equal(t, limit, *ast.Root.Limit)
+}
+
+func TestBucketDimension(t *testing.T) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE orders AS SELECT * FROM (VALUES
+			('a', 'US', 10),
+			('a', 'US', 20),
+			('b', 'US', 150),
+			('c', 'DK', 500),
+			('c', 'DK', 700),
+			('d', 'DK', 5)
+		) t(customer, country, amount)
+	`)
+	require.NoError(t, err)
+
+	mv := &runtimev1.MetricsViewSpec{
+		Table: "orders",
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "customer", Column: "customer"},
+			{Name: "country", Column: "country"},
+			{
+				Name: "tier",
+				Bucket: &runtimev1.MetricsViewSpec_DimensionBucket{
+					EntityDimension: "customer",
+					Measure:         "revenue",
+					Bins:            []float64{100, 1000},
+					Labels:          []string{"low", "mid", "high"},
+				},
+			},
+			{
+				Name: "half",
+				Bucket: &runtimev1.MetricsViewSpec_DimensionBucket{
+					EntityDimension: "customer",
+					Measure:         "revenue",
+					Where:           "country = 'US'",
+					Quantiles:       2,
+				},
+			},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Expression: "SUM(amount)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+		},
+	}
+
+	tt := []struct {
+		name  string
+		query *Query
+		want  [][]any
+	}{
+		{
+			name: "bins",
+			query: &Query{
+				Dimensions: []Dimension{{Name: "tier"}},
+				Measures:   []Measure{{Name: "revenue"}},
+				Sort:       []Sort{{Name: "tier"}},
+			},
+			want: [][]any{
+				{"high", 1200.0},
+				{"low", 35.0},
+				{"mid", 150.0},
+			},
+		},
+		{
+			name: "bins with where",
+			query: &Query{
+				Dimensions: []Dimension{{Name: "tier"}},
+				Measures:   []Measure{{Name: "revenue"}},
+				Where:      &Expression{Condition: &Condition{Operator: OperatorEq, Expressions: []*Expression{{Name: "country"}, {Value: "US"}}}},
+				Sort:       []Sort{{Name: "tier"}},
+			},
+			want: [][]any{
+				{"low", 30.0},
+				{"mid", 150.0},
+			},
+		},
+		{
+			name: "quantiles with bucket filter",
+			query: &Query{
+				Dimensions: []Dimension{{Name: "half"}},
+				Measures:   []Measure{{Name: "revenue"}},
+				Sort:       []Sort{{Name: "half"}},
+			},
+			want: [][]any{
+				{"Q1", 30.0},
+				{"Q2", 150.0},
+				{nil, 1205.0},
+			},
+		},
+		{
+			name: "filter on bucket not in query",
+			query: &Query{
+				Dimensions: []Dimension{{Name: "customer"}},
+				Measures:   []Measure{{Name: "revenue"}},
+				Where:      &Expression{Condition: &Condition{Operator: OperatorEq, Expressions: []*Expression{{Name: "tier"}, {Value: "low"}}}},
+				Sort:       []Sort{{Name: "customer"}},
+			},
+			want: [][]any{
+				{"a", 30.0},
+				{"d", 5.0},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, queryAST(t, db, mv, tc.query))
+		})
+	}
+}
+
+// queryAST builds and runs the SQL for a metrics query against db, returning the result rows with numbers converted to float64.
+func queryAST(t *testing.T, db *sql.DB, mv *runtimev1.MetricsViewSpec, qry *Query) [][]any {
+	ast, err := NewAST(mv, nil, qry, drivers.DialectDuckDB)
+	require.NoError(t, err)
+
+	sql, args, err := ast.SQL()
+	require.NoError(t, err)
+
+	rows, err := db.Query(sql, args...)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	require.NoError(t, err)
+
+	var got [][]any
+	for rows.Next() {
+		row := make([]any, len(cols))
+		ptrs := make([]any, len(cols))
+		for i := range row {
+			ptrs[i] = &row[i]
+		}
+		require.NoError(t, rows.Scan(ptrs...))
+		for i, v := range row {
+			switch v := v.(type) {
+			case int64:
+				row[i] = float64(v)
+			case *big.Int:
+				row[i] = float64(v.Int64())
+			}
+		}
+		got = append(got, row)
+	}
+	require.NoError(t, rows.Err())
+	return got
+}
+
+func TestSQLForAnyInGroup(t *testing.T) {
+	// MIN isn't defined for Postgres booleans, so Postgres must use an aggregate that works for any type
+	a := &AST{dialect: drivers.DialectPostgres}
+	require.Equal(t, `(ARRAY_AGG("is_active"))[1]`, a.sqlForAnyInGroup(`"is_active"`))
+
+	a = &AST{dialect: drivers.DialectDuckDB}
+	require.Equal(t, `ANY_VALUE("is_active")`, a.sqlForAnyInGroup(`"is_active"`))
+}
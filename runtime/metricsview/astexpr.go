@@ -532,8 +532,13 @@ func (b *sqlExprBuilder) sqlForName(name string) (expr string, unnest bool, err
 			return "", false, fmt.Errorf("invalid dimension reference %q: %w", name, err)
 		}
 
+		expr, err := b.ast.sqlForDimension(dim)
+		if err != nil {
+			return "", false, fmt.Errorf("invalid dimension reference %q: %w", name, err)
+		}
+
 		// Note: If dim.Unnest is true, we need to unnest it inside of the generated expression (because it's not part of the dimFields and therefore not unnested with a LATERAL JOIN).
-		return b.ast.dialect.MetricsViewDimensionExpression(dim), dim.Unnest, nil
+		return expr, dim.Unnest, nil
 	}
 
 	// Since node is not nil, we're in the context of a wrapped SELECT.
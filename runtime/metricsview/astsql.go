@@ -221,13 +221,14 @@ func (b *sqlBuilder) writeJoin(joinType string, baseSelect, joinSelect *SelectNo
 	b.out.WriteString(") ")
 	b.out.WriteString(joinSelect.Alias)
 
-	if len(baseSelect.DimFields) == 0 {
+	// We join on the dimensions of the joined SELECT, which are either the same as the base SELECT's (for comparison joins) or a subset (for "per" joins).
+	if len(joinSelect.DimFields) == 0 {
 		b.out.WriteString(" ON TRUE")
 		return nil
 	}
 
 	b.out.WriteString(" ON ")
-	for i, f := range baseSelect.DimFields {
+	for i, f := range joinSelect.DimFields {
 		if i > 0 {
 			b.out.WriteString(" AND ")
 		}
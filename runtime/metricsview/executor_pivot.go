@@ -9,6 +9,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/rilldata/rill/runtime/drivers"
 	"go.uber.org/zap"
@@ -17,7 +18,8 @@ import (
 // rewriteQueryForPivot rewrites a query for pivoting if qry.PivotOn is not empty.
 // It rewrites queries with PivotOn fields to a simpler underlying query,
 // and returns a pivotAST that represents a PIVOT query against the results of the underlying query.
-func (e *Executor) rewriteQueryForPivot(qry *Query) (*pivotAST, bool, error) {
+// If export is true, it also caps the number of rows in the underlying query to enforce the pivot cell limit early.
+func (e *Executor) rewriteQueryForPivot(qry *Query, export bool) (*pivotAST, bool, error) {
 	// Skip if we're not pivoting
 	if len(qry.PivotOn) == 0 {
 		return nil, false, nil
@@ -102,18 +104,147 @@ func (e *Executor) rewriteQueryForPivot(qry *Query) (*pivotAST, bool, error) {
 
 	// If we have a cell limit, apply a row limit just above it to the underlying query.
 	// This prevents the DB from scanning too much data before we can detect that the query will exceed the cell limit.
+	// For interactive pivots, the cell limit is instead enforced against the pivoted output in executePivotQuery.
 	if e.instanceCfg.PivotCellLimit != 0 {
 		cols := int64(len(qry.Dimensions) + len(qry.Measures))
 		ast.underlyingCellCap = e.instanceCfg.PivotCellLimit
 		ast.underlyingRowCap = e.instanceCfg.PivotCellLimit / cols
 
-		tmp := ast.underlyingRowCap + 1
-		qry.Limit = &tmp
+		if export {
+			tmp := ast.underlyingRowCap + 1
+			qry.Limit = &tmp
+		}
 	}
 
 	return ast, true, nil
 }
 
+// executePivotQuery executes a pivot query prepared using rewriteQueryForPivot natively against the metrics view's OLAP connector.
+// It first resolves the distinct values of the pivoted dimensions, and then runs the pivot as a conditional aggregation with the sort, limit and offset pushed down.
+// It returns the result and the max number of rows allowed by the pivot cell limit (0 if there is no cap).
+func (e *Executor) executePivotQuery(ctx context.Context, ast *AST, pivot *pivotAST) (*drivers.Result, int64, error) {
+	underlyingSQL, args, err := ast.SQL()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Limit the number of pivot columns such that there's room for at least one row
+	var maxValues int64
+	if pivot.underlyingCellCap > 0 && len(pivot.using) > 0 {
+		maxValues = (pivot.underlyingCellCap - int64(len(pivot.keep))) / int64(len(pivot.using))
+		if maxValues <= 0 {
+			return nil, 0, fmt.Errorf("pivot query exceeds limit of %d cells", pivot.underlyingCellCap)
+		}
+	}
+
+	values, err := e.resolvePivotValues(ctx, pivot, underlyingSQL, args, maxValues)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Enforce the cell limit by capping the number of rows in the pivoted output
+	var rowCap int64
+	if pivot.underlyingCellCap > 0 {
+		cols := int64(len(pivot.keep) + len(values)*len(pivot.using))
+		rowCap = pivot.underlyingCellCap / cols
+		if pivot.limit != nil && *pivot.limit <= rowCap {
+			rowCap = 0
+		}
+	}
+
+	sql, pivotArgs, err := pivot.conditionalAggregationSQL(ast, underlyingSQL, values, rowCap)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res, err := e.olap.Execute(ctx, &drivers.Statement{
+		Query:            sql,
+		Args:             append(pivotArgs, args...),
+		Priority:         e.priority,
+		ExecutionTimeout: defaultInteractiveTimeout,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res, rowCap, nil
+}
+
+// resolvePivotValues returns the distinct combinations of non-null values of the pivoted dimensions in the underlying query.
+// If maxValues is positive, it returns an error if there are more than maxValues combinations.
+func (e *Executor) resolvePivotValues(ctx context.Context, pivot *pivotAST, underlyingSQL string, args []any, maxValues int64) ([][]any, error) {
+	dialect := e.olap.Dialect()
+
+	b := &strings.Builder{}
+	b.WriteString("SELECT DISTINCT ")
+	for i, fn := range pivot.on {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(dialect.EscapeIdentifier(fn))
+	}
+	b.WriteString(" FROM (")
+	b.WriteString(underlyingSQL)
+	b.WriteString(") ")
+	alias, err := randomString("t", 8)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate random alias: %w", err)
+	}
+	b.WriteString(alias)
+	b.WriteString(" WHERE ")
+	for i, fn := range pivot.on {
+		if i > 0 {
+			b.WriteString(" AND ")
+		}
+		b.WriteString(dialect.EscapeIdentifier(fn))
+		b.WriteString(" IS NOT NULL")
+	}
+	b.WriteString(" ORDER BY ")
+	for i := range pivot.on {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(strconv.Itoa(i + 1))
+	}
+	if maxValues > 0 {
+		b.WriteString(" LIMIT ")
+		b.WriteString(strconv.FormatInt(maxValues+1, 10))
+	}
+
+	res, err := e.olap.Execute(ctx, &drivers.Statement{
+		Query:            b.String(),
+		Args:             args,
+		Priority:         e.priority,
+		ExecutionTimeout: defaultInteractiveTimeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve pivot values: %w", err)
+	}
+	defer res.Close()
+
+	var values [][]any
+	for res.Next() {
+		row := make([]any, len(pivot.on))
+		ptrs := make([]any, len(row))
+		for i := range row {
+			ptrs[i] = &row[i]
+		}
+		if err := res.Scan(ptrs...); err != nil {
+			return nil, fmt.Errorf("failed to resolve pivot values: %w", err)
+		}
+		values = append(values, row)
+	}
+	if err := res.Err(); err != nil {
+		return nil, fmt.Errorf("failed to resolve pivot values: %w", err)
+	}
+
+	if maxValues > 0 && int64(len(values)) > maxValues {
+		return nil, fmt.Errorf("pivot query exceeds limit of %d cells", pivot.underlyingCellCap)
+	}
+
+	return values, nil
+}
+
 // executePivotExport executes a PIVOT query prepared using rewriteQueryForPivot, and exports the result to a file in the given format.
 func (e *Executor) executePivotExport(ctx context.Context, ast *AST, pivot *pivotAST, format string) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, defaultPivotExportTimeout)
@@ -355,6 +486,149 @@ func (a *pivotAST) SQL(underlyingAST *AST, underlyingAlias string, checkCap bool
 	return b.String(), nil
 }
 
+// conditionalAggregationSQL generates a query that pivots the data produced by underlyingSQL using conditional aggregation.
+// Unlike SQL, it does not rely on dialect support for PIVOT, so it can run directly against any OLAP.
+// The values must contain the combinations of values of the pivoted dimensions to output as columns (see resolvePivotValues).
+// The columns are named like the columns in DuckDB's PIVOT, i.e. "<value1>_<value2>_<measure>".
+// If rowCap is positive and no lower limit is set, the output is limited to rowCap+1 rows (so the caller can detect when the cap is exceeded).
+// The returned args must be passed before the args of the underlying query.
+func (a *pivotAST) conditionalAggregationSQL(underlyingAST *AST, underlyingSQL string, values [][]any, rowCap int64) (string, []any, error) {
+	dialect := underlyingAST.dialect
+	b := &strings.Builder{}
+	var args []any
+
+	b.WriteString("SELECT ")
+	for i, fn := range a.keep {
+		f, ok := findField(fn, underlyingAST.Root.DimFields)
+		if !ok {
+			return "", nil, fmt.Errorf("pivot keep dimension %q not found in underlying query", fn)
+		}
+
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(dialect.EscapeIdentifier(f.Name))
+		if a.label && f.Label != "" {
+			b.WriteString(" AS ")
+			b.WriteString(dialect.EscapeIdentifier(f.Label))
+		}
+	}
+
+	n := len(a.keep)
+	for _, vals := range values {
+		prefix := &strings.Builder{}
+		for _, v := range vals {
+			prefix.WriteString(pivotValueString(v))
+			prefix.WriteByte('_')
+		}
+
+		for _, fn := range a.using {
+			f, ok := findField(fn, underlyingAST.Root.MeasureFields)
+			if !ok {
+				return "", nil, fmt.Errorf("pivot using measure %q not found in underlying query", fn)
+			}
+
+			if n > 0 {
+				b.WriteString(", ")
+			}
+			n++
+
+			b.WriteString("MAX(CASE WHEN ")
+			for i, fn := range a.on {
+				if i > 0 {
+					b.WriteString(" AND ")
+				}
+				b.WriteString(dialect.EscapeIdentifier(fn))
+				b.WriteString(" = ?")
+				args = append(args, vals[i])
+			}
+			b.WriteString(" THEN ")
+			b.WriteString(dialect.EscapeIdentifier(f.Name))
+			b.WriteString(" END) AS ")
+			if a.label && f.Label != "" {
+				b.WriteString(dialect.EscapeIdentifier(prefix.String() + f.Label))
+			} else {
+				b.WriteString(dialect.EscapeIdentifier(prefix.String() + f.Name))
+			}
+		}
+	}
+
+	// Ensure we select something even if the pivot has no keep dimensions and no values
+	if n == 0 {
+		b.WriteString("1")
+	}
+
+	b.WriteString(" FROM (")
+	b.WriteString(underlyingSQL)
+	b.WriteString(") ")
+	alias, err := randomString("t", 8)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate random alias: %w", err)
+	}
+	b.WriteString(alias)
+
+	if len(a.keep) > 0 {
+		b.WriteString(" GROUP BY ")
+		for i := range a.keep {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(strconv.Itoa(i + 1))
+		}
+	}
+
+	if len(a.orderBy) > 0 {
+		b.WriteString(" ORDER BY ")
+		for i, f := range a.orderBy {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			if a.label {
+				if df, ok := findField(f.Name, underlyingAST.Root.DimFields); ok && df.Label != "" {
+					b.WriteString(dialect.OrderByExpression(df.Label, f.Desc))
+					continue
+				}
+			}
+			b.WriteString(dialect.OrderByExpression(f.Name, f.Desc))
+		}
+	}
+
+	limit := a.limit
+	if rowCap > 0 && (limit == nil || *limit > rowCap) {
+		tmp := rowCap + 1
+		limit = &tmp
+	}
+	if limit != nil {
+		b.WriteString(" LIMIT ")
+		b.WriteString(strconv.FormatInt(*limit, 10))
+	}
+
+	if a.offset != nil {
+		b.WriteString(" OFFSET ")
+		b.WriteString(strconv.FormatInt(*a.offset, 10))
+	}
+
+	return b.String(), args, nil
+}
+
+// pivotValueString formats a value of a pivoted dimension for use in a pivot column name.
+func pivotValueString(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format("2006-01-02 15:04:05.999999")
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
 func randomString(prefix string, n int) (string, error) {
 	b := make([]byte, n)
 	_, err := rand.Read(b)
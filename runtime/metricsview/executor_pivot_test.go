@@ -0,0 +1,139 @@
+package metricsview
+
+import (
+	"database/sql"
+	"testing"
+
+	_ "github.com/marcboeker/go-duckdb"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPivotConditionalAggregation(t *testing.T) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE orders AS SELECT * FROM (VALUES
+			('US', 'web', 2023, 10),
+			('US', 'app', 2023, 30),
+			('US', 'web', 2024, 20),
+			('DK', 'web', 2024, 40),
+			('DK', NULL, 2024, 5),
+			('SE', 'app', 2023, 50)
+		) t(country, channel, year, revenue)
+	`)
+	require.NoError(t, err)
+
+	mv := &runtimev1.MetricsViewSpec{
+		Table: "orders",
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "country", Column: "country", Label: "Country"},
+			{Name: "channel", Column: "channel"},
+			{Name: "year", Column: "year"},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Expression: "SUM(revenue)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE, Label: "Revenue"},
+			{Name: "orders", Expression: "COUNT(*)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+		},
+	}
+
+	limit := int64(2)
+	offset := int64(1)
+	tt := []struct {
+		name   string
+		pivot  *pivotAST
+		values [][]any
+	}{
+		{
+			name: "single pivot dimension",
+			pivot: &pivotAST{
+				keep:    []string{"country"},
+				on:      []string{"channel"},
+				using:   []string{"revenue", "orders"},
+				orderBy: []OrderFieldNode{{Name: "country"}},
+			},
+			values: [][]any{{"app"}, {"web"}},
+		},
+		{
+			name: "multiple pivot dimensions with limit and offset",
+			pivot: &pivotAST{
+				keep:    []string{"country"},
+				on:      []string{"channel", "year"},
+				using:   []string{"revenue"},
+				orderBy: []OrderFieldNode{{Name: "country", Desc: true}},
+				limit:   &limit,
+				offset:  &offset,
+			},
+			values: [][]any{{"app", int32(2023)}, {"app", int32(2024)}, {"web", int32(2023)}, {"web", int32(2024)}},
+		},
+		{
+			name: "labels",
+			pivot: &pivotAST{
+				keep:    []string{"country"},
+				on:      []string{"channel"},
+				using:   []string{"revenue"},
+				orderBy: []OrderFieldNode{{Name: "country"}},
+				label:   true,
+			},
+			values: [][]any{{"app"}, {"web"}},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			qry := &Query{}
+			for _, d := range append(append([]string{}, tc.pivot.keep...), tc.pivot.on...) {
+				qry.Dimensions = append(qry.Dimensions, Dimension{Name: d})
+			}
+			for _, m := range tc.pivot.using {
+				qry.Measures = append(qry.Measures, Measure{Name: m})
+			}
+			tc.pivot.dialect = drivers.DialectDuckDB
+
+			ast, err := NewAST(mv, nil, qry, drivers.DialectDuckDB)
+			require.NoError(t, err)
+			underlyingSQL, args, err := ast.SQL()
+			require.NoError(t, err)
+
+			// Compute the expected result with DuckDB's PIVOT
+			_, err = db.Exec("CREATE OR REPLACE TEMPORARY TABLE underlying AS ("+underlyingSQL+")", args...)
+			require.NoError(t, err)
+			pivotSQL, err := tc.pivot.SQL(ast, "underlying", false)
+			require.NoError(t, err)
+			wantCols, want := queryRows(t, db, pivotSQL)
+
+			// Compute the result with conditional aggregation
+			sql, pivotArgs, err := tc.pivot.conditionalAggregationSQL(ast, underlyingSQL, tc.values, 0)
+			require.NoError(t, err)
+			gotCols, got := queryRows(t, db, sql, append(pivotArgs, args...)...)
+
+			require.Equal(t, wantCols, gotCols)
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func queryRows(t *testing.T, db *sql.DB, query string, args ...any) ([]string, [][]any) {
+	rows, err := db.Query(query, args...)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	require.NoError(t, err)
+
+	var res [][]any
+	for rows.Next() {
+		row := make([]any, len(cols))
+		ptrs := make([]any, len(cols))
+		for i := range row {
+			ptrs[i] = &row[i]
+		}
+		require.NoError(t, rows.Scan(ptrs...))
+		res = append(res, row)
+	}
+	require.NoError(t, rows.Err())
+	return cols, res
+}
@@ -37,16 +37,18 @@ func (e *Executor) rewriteDruidJoinsWalk(n *SelectNode, limit *int64) error {
 		return e.rewriteDruidJoinsWalk(n.FromSelect, limit)
 	}
 
-	// Apply limits and recurse
-	applyLimit(n.FromSelect, limit)
+	// Apply limits and recurse.
+	// The "per" sub-selects in LeftJoinSelects are not limited since they are not ordered, so a limit would drop arbitrary rows needed by the base SELECT.
+	// They are at most as large as the base SELECT, so we only need to limit the base SELECT for comparison joins.
+	if n.JoinComparisonSelect != nil {
+		applyLimit(n.FromSelect, limit)
+	}
 	err := e.rewriteDruidJoinsWalk(n.FromSelect, limit)
 	if err != nil {
 		return err
 	}
 
 	for _, ljs := range n.LeftJoinSelects {
-		applyLimit(ljs, limit)
-
 		err := e.rewriteDruidJoinsWalk(ljs, limit)
 		if err != nil {
 			return err
@@ -36,6 +36,8 @@ type ScheduledReport struct {
 	OpenLink       string
 	DownloadLink   string
 	EditLink       string
+	Attachment     *Attachment      // Optional
+	Preview        *drivers.Preview // Optional
 }
 
 type scheduledReportData struct {
@@ -45,6 +47,8 @@ type scheduledReportData struct {
 	OpenLink         template.URL
 	DownloadLink     template.URL
 	EditLink         template.URL
+	Attached         bool
+	Preview          *drivers.Preview
 }
 
 func (c *Client) SendScheduledReport(opts *ScheduledReport) error {
@@ -56,6 +60,8 @@ func (c *Client) SendScheduledReport(opts *ScheduledReport) error {
 		OpenLink:         template.URL(opts.OpenLink),
 		DownloadLink:     template.URL(opts.DownloadLink),
 		EditLink:         template.URL(opts.EditLink),
+		Attached:         opts.Attachment != nil,
+		Preview:          opts.Preview,
 	}
 
 	// Build subject
@@ -69,6 +75,9 @@ func (c *Client) SendScheduledReport(opts *ScheduledReport) error {
 	}
 	html := buf.String()
 
+	if opts.Attachment != nil {
+		return c.Sender.SendWithAttachments(opts.ToEmail, opts.ToName, subject, html, []*Attachment{opts.Attachment})
+	}
 	return c.Sender.Send(opts.ToEmail, opts.ToName, subject, html)
 }
 
@@ -88,6 +97,7 @@ func (c *Client) SendAlertStatus(opts *drivers.AlertStatus) error {
 			Title:               opts.Title,
 			ExecutionTimeString: opts.ExecutionTime.Format(time.RFC1123),
 			FailRow:             opts.FailRow,
+			Preview:             opts.Preview,
 			OpenLink:            template.URL(opts.OpenLink),
 			EditLink:            template.URL(opts.EditLink),
 		})
@@ -109,6 +119,7 @@ type alertFailData struct {
 	Title               string
 	ExecutionTimeString string // Will be inferred from ExecutionTime
 	FailRow             map[string]any
+	Preview             *drivers.Preview
 	OpenLink            template.URL
 	EditLink            template.URL
 }
@@ -12,19 +12,25 @@ import (
 )
 
 type mockSender struct {
-	fromEmail string
-	fromName  string
-	toEmail   string
-	toName    string
-	subject   string
-	body      string
+	fromEmail   string
+	fromName    string
+	toEmail     string
+	toName      string
+	subject     string
+	body        string
+	attachments []*Attachment
 }
 
 func (m *mockSender) Send(toEmail, toName, subject, body string) error {
+	return m.SendWithAttachments(toEmail, toName, subject, body, nil)
+}
+
+func (m *mockSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
 	m.toEmail = toEmail
 	m.toName = toName
 	m.subject = subject
 	m.body = body
+	m.attachments = attachments
 	return nil
 }
 
@@ -129,3 +135,88 @@ func TestAlertError(t *testing.T) {
 	require.Contains(t, mock.body, opts.ExecutionTime.Format(time.RFC1123))
 	require.Contains(t, mock.body, "hello error")
 }
+
+func TestScheduledReportAttachment(t *testing.T) {
+	mock := &mockSender{}
+	client := New(mock)
+
+	opts := &ScheduledReport{
+		ToEmail:        uuid.New().String(),
+		Title:          "Foobar",
+		ReportTime:     time.Date(2024, 01, 27, 0, 0, 0, 0, time.UTC),
+		DownloadFormat: "CSV",
+		OpenLink:       "https://example.com",
+		DownloadLink:   "https://example.com",
+		EditLink:       "https://example.com",
+		Attachment: &Attachment{
+			Filename:    "foobar.csv",
+			ContentType: "text/csv",
+			Data:        []byte("a,b\n1,2\n"),
+		},
+	}
+	err := client.SendScheduledReport(opts)
+	require.NoError(t, err)
+
+	require.Equal(t, opts.ToEmail, mock.toEmail)
+	require.Contains(t, mock.body, "The data is attached as a CSV file.")
+	require.Equal(t, []*Attachment{opts.Attachment}, mock.attachments)
+
+	opts.Attachment = nil
+	err = client.SendScheduledReport(opts)
+	require.NoError(t, err)
+	require.NotContains(t, mock.body, "The data is attached")
+	require.Nil(t, mock.attachments)
+}
+
+func TestPreview(t *testing.T) {
+	mock := &mockSender{}
+	client := New(mock)
+
+	preview := &drivers.Preview{
+		Columns: []string{"Country", "Revenue"},
+		Rows: [][]string{
+			{"Denmark", "$1.2k"},
+			{"<Unknown>", "$800"},
+		},
+	}
+
+	err := client.SendScheduledReport(&ScheduledReport{
+		ToEmail:        uuid.New().String(),
+		Title:          "Foobar",
+		ReportTime:     time.Date(2024, 01, 27, 0, 0, 0, 0, time.UTC),
+		DownloadFormat: "CSV",
+		OpenLink:       "https://example.com",
+		DownloadLink:   "https://example.com",
+		EditLink:       "https://example.com",
+		Preview:        preview,
+	})
+	require.NoError(t, err)
+	require.Contains(t, mock.body, `<th style="padding:4px 8px;">Revenue</th>`)
+	require.Contains(t, mock.body, `<td style="padding:4px 8px;">Denmark</td>`)
+	require.Contains(t, mock.body, `<td style="padding:4px 8px;">&lt;Unknown&gt;</td>`)
+
+	err = client.SendAlertStatus(&drivers.AlertStatus{
+		ToEmail:       uuid.New().String(),
+		Title:         "Foobar",
+		ExecutionTime: time.Date(2024, 01, 27, 0, 0, 0, 0, time.UTC),
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		FailRow:       map[string]any{"Country": "Denmark"},
+		OpenLink:      "https://example.com",
+		EditLink:      "https://example.com",
+		Preview:       preview,
+	})
+	require.NoError(t, err)
+	require.Contains(t, mock.body, `<td style="padding:4px 8px;">$1.2k</td>`)
+
+	err = client.SendAlertStatus(&drivers.AlertStatus{
+		ToEmail:       uuid.New().String(),
+		Title:         "Foobar",
+		ExecutionTime: time.Date(2024, 01, 27, 0, 0, 0, 0, time.UTC),
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		FailRow:       map[string]any{"Country": "Denmark"},
+		OpenLink:      "https://example.com",
+		EditLink:      "https://example.com",
+	})
+	require.NoError(t, err)
+	require.NotContains(t, mock.body, "<th")
+}
@@ -1,9 +1,15 @@
 package email
 
 import (
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
 	"fmt"
+	"mime"
+	"mime/multipart"
 	"net/mail"
 	"net/smtp"
+	"net/textproto"
 	"strconv"
 
 	"go.uber.org/zap"
@@ -11,6 +17,15 @@ import (
 
 type Sender interface {
 	Send(toEmail, toName, subject, body string) error
+	// SendWithAttachments is like Send, but also attaches files to the email.
+	SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error
+}
+
+// Attachment is a file attached to an email.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
 }
 
 type SMTPOptions struct {
@@ -18,9 +33,12 @@ type SMTPOptions struct {
 	SMTPPort     int
 	SMTPUsername string
 	SMTPPassword string
-	FromEmail    string
-	FromName     string
-	BCC          string
+	// SMTPTLS connects to the SMTP server over TLS (usually on port 465).
+	// If false, the connection is upgraded with STARTTLS if the server supports it.
+	SMTPTLS   bool
+	FromEmail string
+	FromName  string
+	BCC       string
 }
 
 type smtpSender struct {
@@ -48,16 +66,30 @@ func NewSMTPSender(opts *SMTPOptions) (Sender, error) {
 }
 
 func (s *smtpSender) Send(toEmail, toName, subject, body string) error {
+	return s.SendWithAttachments(toEmail, toName, subject, body, nil)
+}
+
+func (s *smtpSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
 	// Compose the email message
 	from := mail.Address{Name: s.opts.FromName, Address: s.opts.FromEmail}
 	to := mail.Address{Name: toName, Address: toEmail}
-	message := []byte("From: " + from.String() + "\r\n" +
+	header := "From: " + from.String() + "\r\n" +
 		"To: " + to.String() + "\r\n" +
-		"Subject: " + subject + "\r\n" +
-		"Content-Type: text/html; charset=utf-8\r\n" +
-		"\r\n" +
-		body + "\r\n",
-	)
+		"Subject: " + subject + "\r\n"
+	var message []byte
+	if len(attachments) == 0 {
+		message = []byte(header +
+			"Content-Type: text/html; charset=utf-8\r\n" +
+			"\r\n" +
+			body + "\r\n",
+		)
+	} else {
+		var err error
+		message, err = multipartMessage(header, body, attachments)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Build recipients list
 	recipients := []string{toEmail}
@@ -66,13 +98,105 @@ func (s *smtpSender) Send(toEmail, toName, subject, body string) error {
 	}
 
 	// Connect to the SMTP server
+	addr := s.opts.SMTPHost + ":" + strconv.Itoa(s.opts.SMTPPort)
 	auth := smtp.PlainAuth("", s.opts.SMTPUsername, s.opts.SMTPPassword, s.opts.SMTPHost)
-	err := smtp.SendMail(s.opts.SMTPHost+":"+strconv.Itoa(s.opts.SMTPPort), auth, from.Address, recipients, message)
+	if !s.opts.SMTPTLS {
+		return smtp.SendMail(addr, auth, from.Address, recipients, message)
+	}
+	return sendMailTLS(addr, s.opts.SMTPHost, auth, from.Address, recipients, message)
+}
+
+// multipartMessage composes a MIME multipart email message with an HTML body and file attachments.
+func multipartMessage(header, body string, attachments []*Attachment) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := multipart.NewWriter(buf)
+
+	buf.WriteString(header)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: multipart/mixed; boundary=" + w.Boundary() + "\r\n")
+	buf.WriteString("\r\n")
+
+	part, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"text/html; charset=utf-8"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write([]byte(body + "\r\n")); err != nil {
+		return nil, err
+	}
+
+	for _, a := range attachments {
+		contentType := a.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		part, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {mime.FormatMediaType(contentType, map[string]string{"name": a.Filename})},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Base64 encode with line breaks as required by RFC 2045
+		encoded := base64.StdEncoding.EncodeToString(a.Data)
+		for len(encoded) > 76 {
+			if _, err := part.Write([]byte(encoded[:76] + "\r\n")); err != nil {
+				return nil, err
+			}
+			encoded = encoded[76:]
+		}
+		if _, err := part.Write([]byte(encoded + "\r\n")); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// sendMailTLS is like smtp.SendMail, but connects to the server over TLS instead of using STARTTLS.
+func sendMailTLS(addr, host string, auth smtp.Auth, from string, to []string, msg []byte) error {
+	conn, err := tls.Dial("tcp", addr, &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12})
 	if err != nil {
 		return err
 	}
 
-	return nil
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if err := c.Auth(auth); err != nil {
+		return err
+	}
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	for _, addr := range to {
+		if err := c.Rcpt(addr); err != nil {
+			return err
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
 }
 
 type consoleSender struct {
@@ -86,6 +210,14 @@ func NewConsoleSender(logger *zap.Logger, fromEmail, fromName string) (Sender, e
 }
 
 func (s *consoleSender) Send(toEmail, toName, subject, body string) error {
+	return s.SendWithAttachments(toEmail, toName, subject, body, nil)
+}
+
+func (s *consoleSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
+	filenames := make([]string, len(attachments))
+	for i, a := range attachments {
+		filenames[i] = a.Filename
+	}
 	s.logger.Info("email sent",
 		zap.String("from_email", s.fromEmail),
 		zap.String("from_name", s.fromName),
@@ -93,6 +225,7 @@ func (s *consoleSender) Send(toEmail, toName, subject, body string) error {
 		zap.String("to_name", toName),
 		zap.String("subject", subject),
 		zap.String("body", body),
+		zap.Strings("attachments", filenames),
 	)
 	return nil
 }
@@ -107,12 +240,17 @@ func (s *noopSender) Send(toEmail, toName, subject, body string) error {
 	return nil
 }
 
+func (s *noopSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
+	return nil
+}
+
 type TestSender struct {
 	Emails []struct {
-		ToEmail string
-		ToName  string
-		Subject string
-		Body    string
+		ToEmail     string
+		ToName      string
+		Subject     string
+		Body        string
+		Attachments []*Attachment
 	}
 }
 
@@ -121,16 +259,22 @@ func NewTestSender() Sender {
 }
 
 func (s *TestSender) Send(toEmail, toName, subject, body string) error {
+	return s.SendWithAttachments(toEmail, toName, subject, body, nil)
+}
+
+func (s *TestSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
 	s.Emails = append(s.Emails, struct {
-		ToEmail string
-		ToName  string
-		Subject string
-		Body    string
+		ToEmail     string
+		ToName      string
+		Subject     string
+		Body        string
+		Attachments []*Attachment
 	}{
-		ToEmail: toEmail,
-		ToName:  toName,
-		Subject: subject,
-		Body:    body,
+		ToEmail:     toEmail,
+		ToName:      toName,
+		Subject:     subject,
+		Body:        body,
+		Attachments: attachments,
 	})
 	return nil
 }
@@ -0,0 +1,56 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultipartMessage(t *testing.T) {
+	data := bytes.Repeat([]byte("a,b\n1,2\n"), 100)
+	header := "From: a@example.com\r\nTo: b@example.com\r\nSubject: Report\r\n"
+	msg, err := multipartMessage(header, "<p>Hello</p>", []*Attachment{
+		{Filename: "report.csv", ContentType: "text/csv", Data: data},
+	})
+	require.NoError(t, err)
+
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	require.NoError(t, err)
+	require.Equal(t, "Report", m.Header.Get("Subject"))
+
+	mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed", mediaType)
+
+	r := multipart.NewReader(m.Body, params["boundary"])
+
+	part, err := r.NextPart()
+	require.NoError(t, err)
+	require.Equal(t, "text/html; charset=utf-8", part.Header.Get("Content-Type"))
+	body, err := io.ReadAll(part)
+	require.NoError(t, err)
+	require.Equal(t, "<p>Hello</p>", strings.TrimSpace(string(body)))
+
+	part, err = r.NextPart()
+	require.NoError(t, err)
+	require.Equal(t, "report.csv", part.FileName())
+	// NextPart decodes quoted-printable but not base64, so we decode it manually
+	encoded, err := io.ReadAll(part)
+	require.NoError(t, err)
+	for _, line := range strings.Split(strings.TrimSpace(string(encoded)), "\r\n") {
+		require.LessOrEqual(t, len(line), 76)
+	}
+	decoded, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, bytes.NewReader(bytes.ReplaceAll(encoded, []byte("\r\n"), nil))))
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+
+	_, err = r.NextPart()
+	require.ErrorIs(t, err, io.EOF)
+}
@@ -0,0 +1,382 @@
+package formatter
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// d3Specifier matches a d3-format specifier: [[fill]align][sign][symbol][0][width][,][.precision][~][type]
+// See https://github.com/d3/d3-format#locale_format for details.
+var d3Specifier = regexp.MustCompile(`(?i)^(?:(.)?([<>=^]))?([+\-( ])?([$#])?(0)?(\d+)?(,)?(\.\d+)?(~)?([a-z%])?$`)
+
+// d3Minus is the minus sign used by d3-format's default locale.
+const d3Minus = "−"
+
+// siPrefixes are the SI prefixes used by the "s" type, indexed by (exponent/3 + 8).
+var siPrefixes = []string{"y", "z", "a", "f", "p", "n", "µ", "m", "", "k", "M", "G", "T", "P", "E", "Z", "Y"}
+
+// d3Formatter formats numbers using a d3-format specifier.
+// It mirrors the behavior of d3-format's default (en-US) locale, which is what the frontend uses for the "format_d3" property of measures.
+type d3Formatter struct {
+	fill      string
+	align     string
+	sign      string
+	symbol    string
+	zero      bool
+	width     int
+	comma     bool
+	precision int // -1 if not set
+	trim      bool
+	typ       string
+}
+
+func newD3Formatter(specifier string) (*d3Formatter, error) {
+	m := d3Specifier.FindStringSubmatch(specifier)
+	if m == nil {
+		return nil, fmt.Errorf("invalid d3 format specifier %q", specifier)
+	}
+
+	f := &d3Formatter{
+		fill:      m[1],
+		align:     m[2],
+		sign:      m[3],
+		symbol:    m[4],
+		zero:      m[5] != "",
+		comma:     m[7] != "",
+		precision: -1,
+		trim:      m[9] != "",
+		typ:       m[10],
+	}
+	if f.fill == "" {
+		f.fill = " "
+	}
+	if f.align == "" {
+		f.align = ">"
+	}
+	if f.sign == "" {
+		f.sign = "-"
+	}
+	if m[6] != "" {
+		f.width, _ = strconv.Atoi(m[6])
+	}
+	if m[8] != "" {
+		f.precision, _ = strconv.Atoi(m[8][1:])
+	}
+
+	// The "n" type is shorthand for ",g", and unknown types fall back to the default type (like in d3-format)
+	switch f.typ {
+	case "n":
+		f.comma = true
+		f.typ = "g"
+	case "e", "f", "g", "r", "s", "%", "p", "d", "b", "o", "x", "X":
+	default:
+		f.typ = ""
+	}
+
+	// If zero fill is specified, padding goes after the sign and before the digits
+	if f.zero || (f.fill == "0" && f.align == "=") {
+		f.zero = true
+		f.fill = "0"
+		f.align = "="
+	}
+
+	// Default precision is 6, except for the default type (~g with precision 12) and integer types (which ignore it)
+	if f.precision == -1 {
+		if f.typ == "" {
+			f.precision = 12
+		} else {
+			f.precision = 6
+		}
+	}
+	switch f.typ {
+	case "g", "p", "r", "s", "":
+		f.precision = max(1, min(21, f.precision))
+	default:
+		f.precision = max(0, min(20, f.precision))
+	}
+
+	return f, nil
+}
+
+func (f *d3Formatter) StringFormat(x any) (string, error) {
+	var v float64
+	if u, ok := asUnsigned(x); ok {
+		v = float64(u)
+	} else if i, ok := asInteger(x); ok {
+		v = float64(i)
+	} else if fl, ok := asFloat(x); ok {
+		v = fl
+	} else {
+		return "", fmt.Errorf("not a number: %v", x)
+	}
+	return f.format(v), nil
+}
+
+func (f *d3Formatter) format(v float64) string {
+	typ := f.typ
+	trim := f.trim
+	if typ == "" {
+		typ = "g"
+		trim = true
+	}
+
+	// Integer types round to the nearest integer
+	if typ == "d" || typ == "b" || typ == "o" || typ == "x" || typ == "X" {
+		v = math.Round(v)
+	}
+
+	neg := v < 0 || (v == 0 && math.Signbit(v))
+	abs := math.Abs(v)
+
+	var digits, suffix string
+	if math.IsNaN(v) {
+		digits = "NaN"
+		neg = false
+	} else if math.IsInf(v, 0) {
+		digits = "Infinity"
+	} else {
+		digits, suffix = f.formatAbs(abs, typ)
+		if trim {
+			digits = trimInsignificantZeros(digits)
+		}
+	}
+
+	// Don't show a negative sign if the formatted value rounds to zero
+	if neg && isZeroDigits(digits) {
+		neg = false
+	}
+
+	// Compute the prefix
+	prefix := ""
+	if f.symbol == "$" {
+		prefix = "$"
+	} else if f.symbol == "#" {
+		switch typ {
+		case "b":
+			prefix = "0b"
+		case "o":
+			prefix = "0o"
+		case "x", "X":
+			prefix = "0x"
+		}
+	}
+	signStr := ""
+	if neg {
+		if f.sign == "(" {
+			signStr = "("
+			suffix += ")"
+		} else {
+			signStr = d3Minus
+		}
+	} else if f.sign == "+" {
+		signStr = "+"
+	} else if f.sign == " " {
+		signStr = " "
+	}
+	prefix = signStr + prefix
+
+	// Split the digits into the integer part that can be grouped and the fractional or exponential part that can't
+	if i := strings.IndexAny(digits, ".e"); i >= 0 {
+		suffix = digits[i:] + suffix
+		digits = digits[:i]
+	}
+
+	// Group the integer part (unless zero-padding, in which case grouping is applied after padding)
+	if f.comma && !f.zero {
+		digits = groupThousands(digits, math.MaxInt)
+	}
+
+	// Pad to the requested width
+	length := runeLen(prefix) + runeLen(digits) + runeLen(suffix)
+	padding := ""
+	if length < f.width {
+		padding = strings.Repeat(f.fill, f.width-length)
+	}
+
+	if f.comma && f.zero {
+		width := math.MaxInt
+		if padding != "" {
+			width = f.width - runeLen(suffix)
+		}
+		digits = groupThousands(padding+digits, width)
+		padding = ""
+	}
+
+	switch f.align {
+	case "<":
+		return prefix + digits + suffix + padding
+	case "=":
+		return prefix + padding + digits + suffix
+	case "^":
+		n := runeLen(padding) / 2
+		left := string([]rune(padding)[:n])
+		right := string([]rune(padding)[n:])
+		return left + prefix + digits + suffix + right
+	default:
+		return padding + prefix + digits + suffix
+	}
+}
+
+// formatAbs formats a non-negative number according to the type.
+// It returns the formatted digits and any suffix (such as an SI prefix or a percent sign).
+func (f *d3Formatter) formatAbs(v float64, typ string) (string, string) {
+	p := f.precision
+	switch typ {
+	case "e":
+		return formatExponent(formatFloat(v, 'e', p)), ""
+	case "f":
+		return formatFloat(v, 'f', p), ""
+	case "g":
+		return toPrecision(v, p), ""
+	case "r":
+		return toRounded(v, p), ""
+	case "%":
+		return formatFloat(v*100, 'f', p), "%"
+	case "p":
+		return toRounded(v*100, p), "%"
+	case "s":
+		return toSIPrefix(v, p)
+	case "d":
+		return formatFloat(v, 'f', 0), ""
+	case "b":
+		return strconv.FormatUint(uint64(v), 2), ""
+	case "o":
+		return strconv.FormatUint(uint64(v), 8), ""
+	case "x":
+		return strconv.FormatUint(uint64(v), 16), ""
+	case "X":
+		return strings.ToUpper(strconv.FormatUint(uint64(v), 16)), ""
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64), ""
+	}
+}
+
+// toPrecision mirrors JavaScript's Number.prototype.toPrecision.
+func toPrecision(v float64, p int) string {
+	if v == 0 {
+		return strconv.FormatFloat(0, 'f', p-1, 64)
+	}
+	exp := decimalExponent(v, p)
+	if exp < -6 || exp >= p {
+		return formatExponent(formatFloat(v, 'e', p-1))
+	}
+	return formatFloat(v, 'f', p-1-exp)
+}
+
+// toRounded rounds a number to p significant digits and formats it in fixed notation.
+func toRounded(v float64, p int) string {
+	if v == 0 {
+		return strconv.FormatFloat(0, 'f', p-1, 64)
+	}
+	exp := decimalExponent(v, p)
+	if exp >= p-1 {
+		rounded, _ := strconv.ParseFloat(formatFloat(v, 'e', p-1), 64)
+		return strconv.FormatFloat(rounded, 'f', 0, 64)
+	}
+	return formatFloat(v, 'f', p-1-exp)
+}
+
+// toSIPrefix formats a number with p significant digits and an SI prefix.
+func toSIPrefix(v float64, p int) (string, string) {
+	if v == 0 {
+		return strconv.FormatFloat(0, 'f', p-1, 64), ""
+	}
+	exp := decimalExponent(v, p)
+	i := max(-8, min(8, int(math.Floor(float64(exp)/3))))
+	scaled := v / math.Pow(10, float64(i*3))
+	digits := max(0, p-1-(exp-i*3))
+	return formatFloat(scaled, 'f', digits), siPrefixes[i+8]
+}
+
+// decimalExponent returns the decimal exponent of v after rounding it to p significant digits.
+func decimalExponent(v float64, p int) int {
+	s := formatFloat(v, 'e', p-1)
+	exp, _ := strconv.Atoi(s[strings.IndexByte(s, 'e')+1:])
+	return exp
+}
+
+// formatFloat formats a non-negative float like strconv.FormatFloat, but rounds exact ties up instead of to even.
+// This matches JavaScript's Number.prototype.toFixed, toExponential and toPrecision, which d3-format is built on.
+func formatFloat(v float64, format byte, prec int) string {
+	exact := new(big.Float).SetFloat64(v).Text(format, 1100)
+	if i := strings.IndexByte(exact, 'e'); i >= 0 {
+		exact = exact[:i]
+	}
+	if i := strings.IndexByte(exact, '.') + 1 + prec; i < len(exact) && exact[i] == '5' && strings.TrimRight(exact[i+1:], "0") == "" {
+		v = math.Nextafter(v, math.Inf(1))
+	}
+	return strconv.FormatFloat(v, format, prec, 64)
+}
+
+// formatExponent rewrites a Go exponent (e.g. "1.5e+03") in JavaScript style (e.g. "1.5e+3").
+func formatExponent(s string) string {
+	i := strings.IndexByte(s, 'e')
+	if i < 0 {
+		return s
+	}
+	mantissa, exp := s[:i], s[i+1:]
+	sign := exp[:1]
+	exp = strings.TrimLeft(exp[1:], "0")
+	if exp == "" {
+		exp = "0"
+	}
+	return mantissa + "e" + sign + exp
+}
+
+// trimInsignificantZeros removes trailing zeros after the decimal point (and the point itself if nothing is left).
+func trimInsignificantZeros(s string) string {
+	mantissa, exp := s, ""
+	if i := strings.IndexByte(s, 'e'); i >= 0 {
+		mantissa, exp = s[:i], s[i:]
+	}
+	if strings.IndexByte(mantissa, '.') >= 0 {
+		mantissa = strings.TrimRight(mantissa, "0")
+		mantissa = strings.TrimSuffix(mantissa, ".")
+	}
+	return mantissa + exp
+}
+
+// groupThousands inserts commas between groups of three digits in s.
+// Like d3-format, it stops adding groups once the output reaches the given width, which drops excess zero padding.
+func groupThousands(s string, width int) string {
+	var groups []string
+	i := len(s)
+	length := 0
+	for i > 0 {
+		g := 3
+		if length+g+1 > width {
+			g = max(1, width-length)
+		}
+		start := max(0, i-g)
+		groups = append(groups, s[start:i])
+		i = start
+		length += g + 1
+		if length > width {
+			break
+		}
+	}
+	for l, r := 0, len(groups)-1; l < r; l, r = l+1, r-1 {
+		groups[l], groups[r] = groups[r], groups[l]
+	}
+	return strings.Join(groups, ",")
+}
+
+func isZeroDigits(s string) bool {
+	for _, r := range s {
+		if r >= '1' && r <= '9' {
+			return false
+		}
+		if r == 'e' {
+			break
+		}
+	}
+	return true
+}
+
+func runeLen(s string) int {
+	return len([]rune(s))
+}
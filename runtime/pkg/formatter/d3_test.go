@@ -0,0 +1,98 @@
+package formatter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestD3Formatter(t *testing.T) {
+	tests := []struct {
+		specifier string
+		value     any
+		want      string
+	}{
+		// Default type
+		{"", 0.1 + 0.2, "0.3"},
+		{"", 1234.5, "1234.5"},
+		{"", -42, "−42"},
+		// Fixed point
+		{".2f", 3.14159, "3.14"},
+		{".0f", 2.5, "3"},
+		{",.2f", 1234567.891, "1,234,567.89"},
+		{"$,.2f", 1234.5, "$1,234.50"},
+		{"$,.2f", -1234.5, "−$1,234.50"},
+		{"(,.2f", -1234.5, "(1,234.50)"},
+		{"+.1f", 2, "+2.0"},
+		{".1f", -0.01, "0.0"},
+		// Integers
+		{"d", 42.7, "43"},
+		{",d", 1234567, "1,234,567"},
+		{"#x", 255, "0xff"},
+		{"X", 255, "FF"},
+		{"b", 5, "101"},
+		{"o", 8, "10"},
+		// Significant digits
+		{".3g", 1234.5, "1.23e+3"},
+		{".3g", 0.0012345, "0.00123"},
+		{".3r", 1234.5, "1230"},
+		{"~g", 1.5, "1.5"},
+		// SI prefix
+		{".3s", 1234567, "1.23M"},
+		{"~s", 1500, "1.5k"},
+		{".2s", 0.0042, "4.2m"},
+		{"$.3s", 42e9, "$42.0G"},
+		// Exponent
+		{".2e", 12345, "1.23e+4"},
+		{"~e", 1500, "1.5e+3"},
+		// Percentages
+		{".1%", 0.123, "12.3%"},
+		{".0%", 0.5, "50%"},
+		{".2p", 0.01234, "1.2%"},
+		// Width, fill and alignment
+		{"8d", 42, "      42"},
+		{"08d", 42, "00000042"},
+		{"08d", -42, "−0000042"},
+		{"<6d", 42, "42    "},
+		{"^6d", 42, "  42  "},
+		{"*^7d", 42, "**42***"},
+		{"08,d", 1234, "0,001,234"},
+		{"012,.2f", 1234.5, "0,001,234.50"},
+		// Unknown types fall back to the default type
+		{".2q", 1234.5, "1.2e+3"},
+		// Unsigned integers
+		{",", uint64(1000), "1,000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.specifier, func(t *testing.T) {
+			f, err := NewD3Formatter(tt.specifier, false)
+			require.NoError(t, err)
+			got, err := f.StringFormat(tt.value)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got, "formatting %v with %q", tt.value, tt.specifier)
+		})
+	}
+}
+
+func TestD3FormatterInvalid(t *testing.T) {
+	for _, spec := range []string{"abc", "$$", ",.f", ".2.2f"} {
+		_, err := NewD3Formatter(spec, false)
+		require.Error(t, err, spec)
+	}
+}
+
+func TestMeasureFormatter(t *testing.T) {
+	f, err := NewMeasureFormatter(",.1f", "currency_usd", false)
+	require.NoError(t, err)
+	s, err := f.StringFormat(1234.56)
+	require.NoError(t, err)
+	require.Equal(t, "1,234.6", s)
+
+	// Falls back to the preset for invalid specifiers
+	f, err = NewMeasureFormatter("invalid", "currency_usd", false)
+	require.NoError(t, err)
+	s, err = f.StringFormat(1234.56)
+	require.NoError(t, err)
+	require.Equal(t, "$1.2k", s)
+}
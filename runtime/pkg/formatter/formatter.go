@@ -42,7 +42,29 @@ func NewPresetFormatter(preset string, useUnabridged bool) (Formatter, error) {
 	return newPerRangeFormatter(defaultGenericNumOptions())
 }
 
-func NewD3Formatter(useUnabridged bool) (Formatter, error) {
-	// TODO: implement d3 formatter
-	return newNonFormatter(), nil
+// NewD3Formatter returns a formatter for a d3-format specifier, such as ",.2f" or "$.3s".
+// It returns an error if the specifier is invalid.
+func NewD3Formatter(specifier string, useUnabridged bool) (Formatter, error) {
+	f, err := newD3Formatter(specifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if useUnabridged {
+		return newNonFormatter(), nil
+	}
+
+	return f, nil
+}
+
+// NewMeasureFormatter returns a formatter for a measure with the given format_d3 and format_preset properties.
+// Like the frontend, it uses the d3 specifier if it is set and valid, and otherwise falls back to the preset.
+func NewMeasureFormatter(formatD3, formatPreset string, useUnabridged bool) (Formatter, error) {
+	if formatD3 != "" {
+		f, err := NewD3Formatter(formatD3, useUnabridged)
+		if err == nil {
+			return f, nil
+		}
+	}
+	return NewPresetFormatter(formatPreset, useUnabridged)
 }
@@ -94,6 +94,25 @@ func (q *ColumnDescriptiveStatistics) Resolve(ctx context.Context, rt *runtime.R
 			sanitizedColumnName,
 			sanitizedColumnName,
 			olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName))
+	case drivers.DialectPostgres:
+		// Postgres doesn't have approximate quantiles, so we use exact percentiles instead
+		descriptiveStatisticsSQL = fmt.Sprintf("SELECT "+
+			"min(%s)::DOUBLE PRECISION as min, "+
+			"percentile_cont(0.25) WITHIN GROUP (ORDER BY %s)::DOUBLE PRECISION as q25, "+
+			"percentile_cont(0.5) WITHIN GROUP (ORDER BY %s)::DOUBLE PRECISION as q50, "+
+			"percentile_cont(0.75) WITHIN GROUP (ORDER BY %s)::DOUBLE PRECISION as q75, "+
+			"max(%s)::DOUBLE PRECISION as max, "+
+			"avg(%s)::DOUBLE PRECISION as mean, "+
+			"stddev_pop(%s)::DOUBLE PRECISION as sd "+
+			"FROM %s",
+			sanitizedColumnName,
+			sanitizedColumnName,
+			sanitizedColumnName,
+			sanitizedColumnName,
+			sanitizedColumnName,
+			sanitizedColumnName,
+			sanitizedColumnName,
+			olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName))
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
@@ -313,6 +313,11 @@ func (builder *ExpressionBuilder) buildExpression(expr *runtimev1.Expression) (s
 		return "?", []any{arg}, nil
 
 	case *runtimev1.Expression_Ident:
+		for _, dim := range builder.mv.Dimensions {
+			if dim.Name == e.Ident && dim.Bucket != nil {
+				return "", nil, errBucketDimensionNotSupported(dim.Name)
+			}
+		}
 		expr, isIdent := builder.columnIdentifierExpression(e.Ident)
 		if !isIdent {
 			return "", nil, fmt.Errorf("unknown column filter: %s", e.Ident)
@@ -625,15 +630,26 @@ func convertToXLSXValue(pbvalue *structpb.Value) (interface{}, error) {
 	}
 }
 
+// metricsViewDimension looks up a dimension for use in a legacy query's SQL.
+// It returns an error for bucket dimensions, so all dimensions passed to Dialect.DimensionSelect or Dialect.MetricsViewDimensionExpression must be looked up with it.
 func metricsViewDimension(mv *runtimev1.MetricsViewSpec, dimName string) (*runtimev1.MetricsViewSpec_DimensionV2, error) {
 	for _, dimension := range mv.Dimensions {
 		if strings.EqualFold(dimension.Name, dimName) {
+			if dimension.Bucket != nil {
+				return nil, errBucketDimensionNotSupported(dimension.Name)
+			}
 			return dimension, nil
 		}
 	}
 	return nil, fmt.Errorf("dimension %s not found", dimName)
 }
 
+// errBucketDimensionNotSupported is returned when a bucket dimension is used in a query that doesn't go through the metricsview package.
+// Bucket dimensions are compiled to a join that is only implemented by the metricsview AST.
+func errBucketDimensionNotSupported(name string) error {
+	return fmt.Errorf("bucket dimension %q is not supported in this query", name)
+}
+
 func metricsViewMeasureExpression(mv *runtimev1.MetricsViewSpec, measureName string) (string, error) {
 	for _, measure := range mv.Measures {
 		if strings.EqualFold(measure.Name, measureName) {
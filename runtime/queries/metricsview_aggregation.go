@@ -129,7 +129,7 @@ func (q *MetricsViewAggregation) Resolve(ctx context.Context, rt *runtime.Runtim
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPinot {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPinot && olap.Dialect() != drivers.DialectPostgres {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -2911,6 +2911,11 @@ func (q *MetricsViewAggregation) buildTimestampExpr(mv *runtimev1.MetricsViewSpe
 		}
 		// The return type of date_trunc('month', ...) is Date so need another TIMESTAMP cast
 		return fmt.Sprintf("toTimezone(date_trunc('%s', toTimezone(%s::TIMESTAMP, ?))::TIMESTAMP, ?)", dialect.ConvertToDateTruncSpecifier(dim.TimeGrain), col), []any{dim.TimeZone, dim.TimeZone}, nil
+	case drivers.DialectPostgres:
+		if dim.TimeZone == "" || dim.TimeZone == "UTC" || dim.TimeZone == "Etc/UTC" {
+			return fmt.Sprintf("date_trunc('%s', %s::TIMESTAMP)", dialect.ConvertToDateTruncSpecifier(dim.TimeGrain), col), nil, nil
+		}
+		return fmt.Sprintf("timezone(?, date_trunc('%s', timezone(?, %s::TIMESTAMPTZ)))::TIMESTAMP", dialect.ConvertToDateTruncSpecifier(dim.TimeGrain), col), []any{dim.TimeZone, dim.TimeZone}, nil
 	case drivers.DialectPinot:
 		// ToDateTime format truncates millis to secs because we don't support that, for example timeseries api does timestamppb.New(ts) which truncates to seconds
 		return fmt.Sprintf("ToDateTime(date_trunc('%s', %s, 'MILLISECONDS', ?), 'yyyy-MM-dd''T''HH:mm:ss''Z''')", dialect.ConvertToDateTruncSpecifier(dim.TimeGrain), col), []any{dim.TimeZone}, nil
@@ -2937,6 +2942,8 @@ func (q *MetricsViewAggregation) buildOffsetExpression(col string, timeGrain run
 		timeOffsetColumn = fmt.Sprintf("timestamp_to_millis(%s)-timestamp_to_millis(%s) as t_offset", timeCol, start)
 	} else if dialect == drivers.DialectClickHouse {
 		timeOffsetColumn = fmt.Sprintf("toUnixTimestamp(%s)-toUnixTimestamp(%s) as t_offset", timeCol, start)
+	} else if dialect == drivers.DialectPostgres {
+		timeOffsetColumn = fmt.Sprintf("(extract(epoch from %s)-extract(epoch from %s))*1000 as t_offset", timeCol, start)
 	} else {
 		return "", fmt.Errorf("unsupported dialect %q", dialect)
 	}
@@ -2951,6 +2958,8 @@ func (q *MetricsViewAggregation) trancationExpression(s string, timeGrain runtim
 		return fmt.Sprintf("date_trunc('%s', CAST(%s AS TIMESTAMP))", dialect.ConvertToDateTruncSpecifier(timeGrain), s), nil
 	case drivers.DialectClickHouse:
 		return fmt.Sprintf("date_trunc('%s', %s)", dialect.ConvertToDateTruncSpecifier(timeGrain), s), nil
+	case drivers.DialectPostgres:
+		return fmt.Sprintf("date_trunc('%s', %s::TIMESTAMP)", dialect.ConvertToDateTruncSpecifier(timeGrain), s), nil
 	default:
 		return "", fmt.Errorf("unsupported dialect %q", dialect)
 	}
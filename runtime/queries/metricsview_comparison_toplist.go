@@ -92,7 +92,7 @@ func (q *MetricsViewComparison) Resolve(ctx context.Context, rt *runtime.Runtime
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPinot {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPinot && olap.Dialect() != drivers.DialectPostgres {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -1125,7 +1125,7 @@ func (q *MetricsViewComparison) Export(ctx context.Context, rt *runtime.Runtime,
 				return err
 			}
 		}
-	case drivers.DialectDruid, drivers.DialectClickHouse, drivers.DialectPinot:
+	case drivers.DialectDruid, drivers.DialectClickHouse, drivers.DialectPinot, drivers.DialectPostgres:
 		if err := q.generalExport(ctx, rt, instanceID, w, opts, mv); err != nil {
 			return err
 		}
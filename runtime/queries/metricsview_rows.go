@@ -72,7 +72,7 @@ func (q *MetricsViewRows) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPinot {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPinot && olap.Dialect() != drivers.DialectPostgres {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -152,7 +152,7 @@ func (q *MetricsViewRows) Export(ctx context.Context, rt *runtime.Runtime, insta
 				return err
 			}
 		}
-	case drivers.DialectDruid, drivers.DialectClickHouse, drivers.DialectPinot:
+	case drivers.DialectDruid, drivers.DialectClickHouse, drivers.DialectPinot, drivers.DialectPostgres:
 		if err := q.generalExport(ctx, rt, instanceID, w, opts, q.MetricsView); err != nil {
 			return err
 		}
@@ -2,10 +2,12 @@ package queries
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/stretchr/testify/require"
 	"github.com/xuri/excelize/v2"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -454,3 +456,61 @@ func Test_writeXLSX_quotes(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "a\"", v)
 }
+
+func Test_bucketDimensionRejected(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "country", Column: "country"},
+			{Name: "tier", Bucket: &runtimev1.MetricsViewSpec_DimensionBucket{}},
+		},
+	}
+
+	_, err := metricsViewDimension(mv, "country")
+	require.NoError(t, err)
+	_, err = metricsViewDimension(mv, "tier")
+	require.ErrorContains(t, err, `bucket dimension "tier" is not supported`)
+
+	builder := &ExpressionBuilder{mv: mv}
+	_, _, err = builder.buildExpression(&runtimev1.Expression{Expression: &runtimev1.Expression_Ident{Ident: "tier"}})
+	require.ErrorContains(t, err, `bucket dimension "tier" is not supported`)
+}
+
+func Test_bucketDimensionRejectedByLegacyQueries(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table:         "tbl",
+		TimeDimension: "ts",
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "country", Column: "country"},
+			{Name: "tier", Bucket: &runtimev1.MetricsViewSpec_DimensionBucket{}},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "count", Expression: "COUNT(*)"},
+		},
+	}
+	const errMsg = `bucket dimension "tier" is not supported`
+
+	toplist := &MetricsViewToplist{DimensionName: "tier", MeasureNames: []string{"count"}}
+	_, _, err := toplist.buildMetricsTopListSQL(mv, drivers.DialectDuckDB, nil)
+	require.ErrorContains(t, err, errMsg)
+
+	comparison := &MetricsViewComparison{
+		DimensionName: "tier",
+		Measures:      []*runtimev1.MetricsViewAggregationMeasure{{Name: "count"}},
+	}
+	_, _, err = comparison.buildMetricsTopListSQL(mv, drivers.DialectDuckDB, nil, false)
+	require.ErrorContains(t, err, errMsg)
+	_, _, err = comparison.buildMetricsComparisonTopListSQL(mv, drivers.DialectDuckDB, nil, false)
+	require.ErrorContains(t, err, errMsg)
+
+	aggregation := &MetricsViewAggregation{
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{{Name: "tier"}},
+		Measures:   []*runtimev1.MetricsViewAggregationMeasure{{Name: "count"}},
+	}
+	_, _, err = aggregation.buildMetricsAggregationSQL(mv, drivers.DialectDuckDB, nil, 0)
+	require.ErrorContains(t, err, errMsg)
+	_, _, err = aggregation.buildMetricsComparisonAggregationSQL(context.Background(), nil, 0, mv, drivers.DialectDuckDB, nil, false)
+	require.ErrorContains(t, err, errMsg)
+
+	_, _, err = aggregation.buildTimestampExpr(mv, &runtimev1.MetricsViewAggregationDimension{Name: "tier", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY}, drivers.DialectDuckDB)
+	require.ErrorContains(t, err, errMsg)
+}
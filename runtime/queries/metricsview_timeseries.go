@@ -313,7 +313,7 @@ func (q *MetricsViewTimeSeries) buildMetricsTimeseriesSQL(olap drivers.OLAPStore
 	}
 
 	td := safeName(mv.TimeDimension)
-	if olap.Dialect() == drivers.DialectDuckDB {
+	if olap.Dialect() == drivers.DialectDuckDB || olap.Dialect() == drivers.DialectPostgres {
 		td = fmt.Sprintf("%s::TIMESTAMP", td)
 	}
 
@@ -382,6 +382,11 @@ func (q *MetricsViewTimeSeries) buildMetricsTimeseriesSQL(olap drivers.OLAPStore
 		sql = q.buildPinotSQL(mv, tsAlias, selectCols, whereClause, havingClause)
 	case drivers.DialectClickHouse:
 		sql = q.buildClickHouseSQL(mv, tsAlias, selectCols, whereClause, havingClause, timezone)
+	case drivers.DialectPostgres:
+		sql, err = q.buildPostgresSQL(mv, tsAlias, selectCols, whereClause, havingClause, timezone)
+		if err != nil {
+			return "", "", nil, err
+		}
 	default:
 		return "", "", nil, fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
@@ -408,6 +413,26 @@ func (q *MetricsViewTimeSeries) buildPinotSQL(mv *runtimev1.MetricsViewSpec, tsA
 	return sql
 }
 
+func (q *MetricsViewTimeSeries) buildPostgresSQL(mv *runtimev1.MetricsViewSpec, tsAlias string, selectCols []string, whereClause, havingClause, timezone string) (string, error) {
+	timeDim := &runtimev1.MetricsViewSpec_DimensionV2{Column: mv.TimeDimension}
+	timeClause, err := drivers.DialectPostgres.DateTruncExpr(timeDim, q.TimeGranularity, timezone, int(mv.FirstDayOfWeek), int(mv.FirstMonthOfYear))
+	if err != nil {
+		return "", err
+	}
+
+	sql := fmt.Sprintf(
+		`SELECT %s AS %s, %s FROM %s WHERE %s GROUP BY 1 %s ORDER BY 1`,
+		timeClause,
+		tsAlias,
+		strings.Join(selectCols, ", "),
+		escapeMetricsViewTable(drivers.DialectPostgres, mv),
+		whereClause,
+		havingClause,
+	)
+
+	return sql, nil
+}
+
 func (q *MetricsViewTimeSeries) buildDruidSQL(mv *runtimev1.MetricsViewSpec, tsAlias string, selectCols []string, whereClause, havingClause string) string {
 	tsSpecifier := convertToDruidTimeFloorSpecifier(q.TimeGranularity)
 
@@ -552,17 +552,8 @@ func (r *AlertReconciler) executeSingleWrapped(ctx context.Context, self *runtim
 		return nil, err
 	}
 
-	// Evaluate query attributes
-	var queryForAttrs map[string]any
-	if adminMeta != nil {
-		queryForAttrs = adminMeta.QueryForAttributes
-	}
-	if a.Spec.GetQueryForAttributes() != nil { // Explicit attributes take precedence
-		queryForAttrs = a.Spec.GetQueryForAttributes().AsMap()
-	}
-
 	// Create and execute query
-	q, err := queries.ProtoToQuery(qpb, queryForAttrs)
+	q, err := queries.ProtoToQuery(qpb, alertQueryAttributes(a, adminMeta))
 	if err != nil {
 		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
@@ -652,6 +643,22 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 		executionTime = current.ExecutionTime.AsTime()
 	}
 
+	// Don't notify if notifications are disabled for the instance (such as for preview deployments)
+	if notify {
+		inst, err := r.C.Runtime.Instance(ctx, r.C.InstanceID)
+		if err != nil {
+			return err
+		}
+		cfg, err := inst.Config()
+		if err != nil {
+			return err
+		}
+		if cfg.NotificationsDisabled {
+			r.C.Logger.Info("Skipped sending alert notification because notifications are disabled", zap.String("name", self.Meta.Name.Name), zap.Time("execution_time", executionTime))
+			notify = false
+		}
+	}
+
 	// Generate the notification message to send (if any)
 	var msg *drivers.AlertStatus
 	if notify {
@@ -687,6 +694,23 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 				Status:        current.Result.Status,
 				FailRow:       current.Result.FailRow.AsMap(),
 			}
+
+			// Include a preview of the query result if configured
+			if a.Spec.PreviewRows > 0 {
+				preview, err := buildPreview(ctx, r.C, a.Spec.QueryName, a.Spec.QueryArgsJson, executionTime, alertQueryAttributes(a, adminMeta), a.Spec.PreviewRows, alertQueryPriority)
+				if err != nil {
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+					if errors.Is(err, errMissingQueryAttributes) {
+						r.C.Logger.Info("Skipped alert preview because the alert has no owner or query attributes to enforce the security policy with", zap.String("name", self.Meta.Name.Name), zap.Time("execution_time", executionTime))
+					} else {
+						r.C.Logger.Warn("Failed to build alert preview", zap.String("name", self.Meta.Name.Name), zap.Time("execution_time", executionTime), zap.Error(err))
+					}
+				} else {
+					msg.Preview = preview
+				}
+			}
 		case runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR:
 			if !a.Spec.NotifyOnError {
 				break
@@ -718,50 +742,36 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 		}
 
 		for _, notifier := range a.Spec.Notifiers {
-			switch notifier.Connector {
-			// TODO: transform email client to notifier
-			case "email":
-				recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
-				for _, recipient := range recipients {
-					msg.ToEmail = recipient
-					err := r.C.Runtime.Email.SendAlertStatus(msg)
-					if err != nil {
-						notificationErr = fmt.Errorf("failed to send email to %q: %w", recipient, err)
-						break
-					}
+			err := func() (outErr error) {
+				conn, release, err := r.C.Runtime.AcquireHandle(ctx, r.C.InstanceID, notifier.Connector)
+				if err != nil {
+					return err
 				}
-			default:
-				err := func() (outErr error) {
-					conn, release, err := r.C.Runtime.AcquireHandle(ctx, r.C.InstanceID, notifier.Connector)
-					if err != nil {
-						return err
-					}
-					defer release()
-					n, err := conn.AsNotifier(notifier.Properties.AsMap())
-					if err != nil {
-						return err
-					}
-					start := time.Now()
-					defer func() {
-						totalLatency := time.Since(start).Milliseconds()
-
-						if r.C.Activity != nil {
-							r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
-								attribute.Bool("failed", outErr != nil),
-								attribute.String("connector", notifier.Connector),
-								attribute.String("notification_type", "alert_status"),
-							)
-						}
-					}()
-					err = n.SendAlertStatus(msg)
-					if err != nil {
-						notificationErr = fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
+				defer release()
+				n, err := conn.AsNotifier(notifier.Properties.AsMap())
+				if err != nil {
+					return err
+				}
+				start := time.Now()
+				defer func() {
+					totalLatency := time.Since(start).Milliseconds()
+
+					if r.C.Activity != nil {
+						r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
+							attribute.Bool("failed", outErr != nil),
+							attribute.String("connector", notifier.Connector),
+							attribute.String("notification_type", "alert_status"),
+						)
 					}
-					return nil
 				}()
+				err = n.SendAlertStatus(msg)
 				if err != nil {
-					return err
+					notificationErr = fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
 				}
+				return nil
+			}()
+			if err != nil {
+				return err
 			}
 		}
 		sentNotifications = true
@@ -788,6 +798,17 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 	return r.C.UpdateState(ctx, self.Meta.Name, self)
 }
 
+// alertQueryAttributes returns the security attributes to use when running the alert's query.
+func alertQueryAttributes(a *runtimev1.Alert, adminMeta *drivers.AlertMetadata) map[string]any {
+	if a.Spec.GetQueryForAttributes() != nil { // Explicit attributes take precedence
+		return a.Spec.GetQueryForAttributes().AsMap()
+	}
+	if adminMeta != nil {
+		return adminMeta.QueryForAttributes
+	}
+	return nil
+}
+
 // computeInheritedWatermark computes the inherited watermark for the alert.
 // It returns false if the watermark could not be computed.
 func (r *AlertReconciler) computeInheritedWatermark(ctx context.Context, refs []*runtimev1.ResourceName) (time.Time, bool, error) {
@@ -949,7 +970,7 @@ func formatMetricsViewComparisonResult(q *queries.MetricsViewComparison, measure
 }
 
 // getComparisonMeasureLabelAndFormatter gets the measure label and formatter by a measure name and adds a suffix if it was compared measure.
-// for relative change comparison it uses percent formatter, uses defined preset for everything else
+// for relative change comparison it uses percent formatter, uses the measure's d3 format or preset for everything else
 // if a measure is not found in the request list, it returns the measure name as the label and no formatter.
 // if the measure is not found in the metrics view measures, it returns the measure name as the label and no formatter.
 // if the formatter fails to load, it logs the error and returns the measure name as the label and no formatter.
@@ -991,6 +1012,7 @@ func getComparisonMeasureLabelAndFormatter(measureName string, reqMeasures []*ru
 	if measureLabel == "" {
 		measureLabel = measureName
 	}
+	formatD3 := measure.FormatD3
 	formatPreset := measure.FormatPreset
 	if effectiveMeasure != measureName {
 		// comparison measure, add a suffix based on type
@@ -1001,12 +1023,12 @@ func getComparisonMeasureLabelAndFormatter(measureName string, reqMeasures []*ru
 			measureLabel += " (Δ)"
 		case *runtimev1.MetricsViewAggregationMeasure_ComparisonRatio:
 			measureLabel += " (Δ%)"
+			formatD3 = ""
 			formatPreset = "percentage"
 		}
 	}
 
-	// D3 formatting isn't implemented yet so using the format preset only for now
-	f, err := formatter.NewPresetFormatter(formatPreset, false)
+	f, err := formatter.NewMeasureFormatter(formatD3, formatPreset, false)
 	if err != nil {
 		logger.Warn("Failed to get formatter, using no formatter", zap.Error(err))
 		return measureLabel, nil
@@ -1036,8 +1058,7 @@ func getMeasureLabelAndFormatter(measureName string, measures []*runtimev1.Metri
 		measureLabel = measureName
 	}
 
-	// D3 formatting isn't implemented yet so using the format preset only for now
-	f, err := formatter.NewPresetFormatter(measure.FormatPreset, false)
+	f, err := formatter.NewMeasureFormatter(measure.FormatD3, measure.FormatPreset, false)
 	if err != nil {
 		logger.Warn("Failed to get formatter, using no formatter", zap.Error(err))
 		return measureLabel, nil
@@ -1,6 +1,7 @@
 package reconcilers_test
 
 import (
+	"context"
 	"fmt"
 	"slices"
 	"testing"
@@ -8,7 +9,6 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
-	"github.com/rilldata/rill/runtime/pkg/email"
 	"github.com/rilldata/rill/runtime/testruntime"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -16,7 +16,11 @@ import (
 )
 
 func TestLegacyAlert(t *testing.T) {
-	rt, id := testruntime.NewInstance(t)
+	smtp := testruntime.NewSMTPServer(t)
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files:     map[string]string{"rill.yaml": ""},
+		Variables: smtp.Variables(),
+	})
 	testruntime.PutFiles(t, rt, id, map[string]string{
 		"/models/bar.sql": `
 SELECT '2024-01-01T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
@@ -165,14 +169,18 @@ SELECT '2024-01-04T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
 	testruntime.RequireResource(t, rt, id, a1)
 
 	// Check that the alert was sent
-	emails := rt.Email.Sender.(*email.TestSender).Emails
+	emails := smtp.Emails()
 	require.Len(t, emails, 1)
-	require.Equal(t, emails[0].ToEmail, "somebody@example.com")
-	require.Contains(t, emails[0].Body, "Denmark")
+	require.Equal(t, []string{"somebody@example.com"}, emails[0].To)
+	require.Contains(t, emails[0].Data, "Denmark")
 }
 
 func TestAlert(t *testing.T) {
-	rt, id := testruntime.NewInstance(t)
+	smtp := testruntime.NewSMTPServer(t)
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files:     map[string]string{"rill.yaml": ""},
+		Variables: smtp.Variables(),
+	})
 	testruntime.PutFiles(t, rt, id, map[string]string{
 		"/models/bar.sql": `
 SELECT '2024-01-01T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
@@ -319,10 +327,10 @@ SELECT '2024-01-04T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
 	testruntime.RequireResource(t, rt, id, a1)
 
 	// Check that the alert was sent
-	emails := rt.Email.Sender.(*email.TestSender).Emails
+	emails := smtp.Emails()
 	require.Len(t, emails, 1)
-	require.Equal(t, emails[0].ToEmail, "somebody@example.com")
-	require.Contains(t, emails[0].Body, "Denmark")
+	require.Equal(t, []string{"somebody@example.com"}, emails[0].To)
+	require.Contains(t, emails[0].Data, "Denmark")
 }
 
 func newMetricsView(name, table, timeDim string, measures, dimensions []string) (*runtimev1.MetricsViewV2, *runtimev1.Resource) {
@@ -388,3 +396,72 @@ func must[T any](v T, err error) T {
 	}
 	return v
 }
+
+func TestAlertNotificationsDisabled(t *testing.T) {
+	smtp := testruntime.NewSMTPServer(t)
+	vars := smtp.Variables()
+	vars["rill.notifications.disabled"] = "true"
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Variables: vars,
+		Files: map[string]string{
+			"rill.yaml": "",
+			"/models/bar.sql": `
+SELECT '2023-12-31T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
+UNION ALL
+SELECT '2024-01-01T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
+`,
+			"/dashboards/mv1.yaml": `
+title: mv1
+model: bar
+timeseries: __time
+dimensions:
+- column: country
+measures:
+- expression: count(*)
+`,
+			"/alerts/a1.yaml": `
+type: alert
+title: Test Alert
+refs:
+- type: MetricsView
+  name: mv1
+watermark: inherit
+intervals:
+  duration: P1D
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+    dimensions:
+    - name: country
+    measures:
+    - name: measure_0
+    time_range:
+      iso_duration: P1W
+    having:
+      cond:
+        op: OPERATION_GTE
+        exprs:
+        - ident: measure_0
+        - val: 1
+notify:
+  email:
+    recipients:
+      - somebody@example.com
+`,
+		},
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 4, 0, 0)
+
+	// Check that the alert failed, but no notification was sent
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+	r, err := ctrl.Get(context.Background(), &runtimev1.ResourceName{Kind: runtime.ResourceKindAlert, Name: "a1"}, false)
+	require.NoError(t, err)
+	history := r.GetAlert().State.ExecutionHistory
+	require.Len(t, history, 1)
+	require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, history[0].Result.Status)
+	require.False(t, history[0].SentNotifications)
+	require.Empty(t, smtp.Emails())
+}
@@ -97,7 +97,8 @@ func (r *MetricsViewReconciler) Reconcile(ctx context.Context, n *runtimev1.Reso
 		}
 
 		// If not, we assume the metrics view is based on an externally managed table and set the streaming state to true.
-		mv.State.Streaming = !hasInternalRef
+		// It's also streaming if it references a source that continuously receives new data (such as a Kafka topic).
+		mv.State.Streaming = !hasInternalRef || hasStreamingRef(ctx, r.C, self.Meta.Refs)
 	}
 
 	// Update state. Even if the validation result is unchanged, we always update the state to ensure the state version is incremented.
@@ -18,7 +18,9 @@ import (
 	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -256,6 +258,15 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		PreviousResult:   prevResult,
 	}
 
+	// Report the progress of the execution on the model's state
+	progress := newExecutionProgress(ctx, r.C, self, func(p *runtimev1.ExecutionProgress) {
+		mdl := self.GetModel()
+		state := proto.Clone(mdl.State).(*runtimev1.ModelState)
+		state.Progress = p
+		mdl.State = state
+	})
+	opts.Progress = progress
+
 	// Open executor for the new output
 	executorConnector, executor, release, err := r.acquireExecutor(ctx, opts)
 	if err != nil {
@@ -277,7 +288,20 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	}
 
 	// Build the output
+	executeStart := time.Now()
 	execRes, execErr := executor.Execute(ctx)
+	progress.finish()
+	executeDims := []attribute.KeyValue{
+		attribute.String("executor", executorConnector),
+		attribute.String("input_connector", model.Spec.InputConnector),
+		attribute.String("output_connector", model.Spec.OutputConnector),
+		attribute.Bool("cancelled", errors.Is(execErr, context.Canceled)),
+		attribute.Bool("failed", execErr != nil),
+	}
+	r.C.Activity.RecordMetric(ctx, "model_execution_ms", float64(time.Since(executeStart).Milliseconds()), executeDims...)
+	r.C.Activity.RecordMetric(ctx, "model_execution_bytes", float64(progress.observed(drivers.ProgressUnitByte)), executeDims...)
+	r.C.Activity.RecordMetric(ctx, "model_execution_files", float64(progress.observed(drivers.ProgressUnitFile)), executeDims...)
+	r.C.Activity.RecordMetric(ctx, "model_execution_records", float64(progress.observed(drivers.ProgressUnitRecord)), executeDims...)
 	if execErr != nil {
 		execErr = fmt.Errorf("failed to build output: %w", execErr)
 	}
@@ -297,6 +321,10 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		model.State.RefreshedOn = timestamppb.Now()
 		model.State.IncrementalState = newIncrementalState
 		model.State.IncrementalStateSchema = newIncrementalStateSchema
+		model.State.PartitionsTouched = execRes.Partitions
+		if len(execRes.Partitions) > 0 {
+			r.C.Logger.Debug("Overwrote model partitions", zap.String("name", n.Name), zap.Int("partitions", len(execRes.Partitions)), zap.Strings("partitions_touched", execRes.Partitions))
+		}
 		err := r.updateStateWithResult(ctx, self, execRes)
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
@@ -525,6 +553,7 @@ func (r *ModelReconciler) updateStateClear(ctx context.Context, self *runtimev1.
 	mdl.State.RefreshedOn = nil
 	mdl.State.IncrementalState = nil
 	mdl.State.IncrementalStateSchema = nil
+	mdl.State.PartitionsTouched = nil
 
 	return r.C.UpdateState(ctx, self.Meta.Name, self)
 }
@@ -0,0 +1,196 @@
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/formatter"
+	"github.com/rilldata/rill/runtime/queries"
+	"go.uber.org/zap"
+)
+
+// buildPreview runs a report or alert query and formats its top rows for inclusion as a table in notifications.
+// Measure values are formatted using the measure's format_d3 or format_preset, and columns use dimension and measure labels.
+// It returns errMissingQueryAttributes if attrs is empty and the metrics view has a security policy.
+func buildPreview(ctx context.Context, c *runtime.Controller, queryName, queryArgsJSON string, executionTime time.Time, attrs map[string]any, rows uint32, priority int) (*drivers.Preview, error) {
+	err := checkQueryAttributes(ctx, c, queryName, queryArgsJSON, attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	qpb, err := queries.ProtoFromJSON(queryName, queryArgsJSON, &executionTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse query: %w", err)
+	}
+
+	metricsViewName, err := queries.MetricsViewFromQuery(queryName, queryArgsJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed extract metrics view name from query: %w", err)
+	}
+	mv, err := c.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: metricsViewName}, false)
+	if err != nil {
+		return nil, err
+	}
+	spec := mv.GetMetricsView().Spec
+
+	q, err := queries.ProtoToQuery(qpb, attrs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	// Apply the preview limit (unless the query has a lower limit of its own)
+	limit := int64(rows)
+	switch q := q.(type) {
+	case *queries.MetricsViewAggregation:
+		if l := qpb.GetMetricsViewAggregationRequest().Limit; l != 0 && l < limit {
+			limit = l
+		}
+		q.Limit = &limit
+	case *queries.MetricsViewComparison:
+		if q.Limit == 0 || q.Limit > limit {
+			q.Limit = limit
+		}
+	}
+
+	err = c.Runtime.Query(ctx, c.InstanceID, q, priority)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	switch q := q.(type) {
+	case *queries.MetricsViewAggregation:
+		return previewMetricsViewAggregationResult(q, spec, c.Logger), nil
+	case *queries.MetricsViewComparison:
+		return previewMetricsViewComparisonResult(q, spec, c.Logger), nil
+	default:
+		return nil, fmt.Errorf("query type %T not supported for previews", q)
+	}
+}
+
+func previewMetricsViewAggregationResult(q *queries.MetricsViewAggregation, spec *runtimev1.MetricsViewSpec, logger *zap.Logger) *drivers.Preview {
+	if q.Result == nil || q.Result.Schema == nil {
+		return &drivers.Preview{}
+	}
+
+	fields := q.Result.Schema.Fields
+	columns := make([]string, len(fields))
+	formatters := make([]formatter.Formatter, len(fields))
+	for i, f := range fields {
+		if label, ok := getDimensionLabel(f.Name, q.Dimensions, spec.Dimensions); ok {
+			columns[i] = label
+			continue
+		}
+		columns[i], formatters[i] = getComparisonMeasureLabelAndFormatter(f.Name, q.Measures, spec.Measures, logger)
+	}
+
+	res := &drivers.Preview{Columns: columns}
+	for _, row := range q.Result.Data {
+		vals := make([]string, len(fields))
+		for i, f := range fields {
+			v := row.Fields[f.Name]
+			if v == nil {
+				continue
+			}
+			vals[i] = formatPreviewValue(formatters[i], v.AsInterface(), logger)
+		}
+		res.Rows = append(res.Rows, vals)
+	}
+	return res
+}
+
+func previewMetricsViewComparisonResult(q *queries.MetricsViewComparison, spec *runtimev1.MetricsViewSpec, logger *zap.Logger) *drivers.Preview {
+	if q.Result == nil || len(q.Result.Rows) == 0 {
+		return &drivers.Preview{}
+	}
+
+	dimensionLabel := q.DimensionName
+	for _, d := range spec.Dimensions {
+		if d.Name == q.DimensionName && d.Label != "" {
+			dimensionLabel = d.Label
+			break
+		}
+	}
+
+	percentFormatter, err := formatter.NewPresetFormatter("percentage", false)
+	if err != nil {
+		logger.Warn("Failed to get formatter, using no formatter", zap.Error(err))
+		percentFormatter = nil
+	}
+
+	// The rows all contain the same measure values, so the first row determines the columns
+	res := &drivers.Preview{Columns: []string{dimensionLabel}}
+	measureFormatters := make([]formatter.Formatter, len(q.Result.Rows[0].MeasureValues))
+	for i, v := range q.Result.Rows[0].MeasureValues {
+		var measureLabel string
+		measureLabel, measureFormatters[i] = getMeasureLabelAndFormatter(v.MeasureName, spec.Measures, logger)
+		res.Columns = append(res.Columns, measureLabel)
+		if v.ComparisonValue != nil {
+			res.Columns = append(res.Columns, measureLabel+" (prev)")
+		}
+		if v.DeltaAbs != nil {
+			res.Columns = append(res.Columns, measureLabel+" (Δ)")
+		}
+		if v.DeltaRel != nil {
+			res.Columns = append(res.Columns, measureLabel+" (Δ%)")
+		}
+	}
+
+	for _, row := range q.Result.Rows {
+		vals := []string{formatPreviewValue(nil, row.DimensionValue.AsInterface(), logger)}
+		for i, v := range row.MeasureValues {
+			var f formatter.Formatter
+			if i < len(measureFormatters) {
+				f = measureFormatters[i]
+			}
+			vals = append(vals, formatPreviewValue(f, v.BaseValue.AsInterface(), logger))
+			if v.ComparisonValue != nil {
+				vals = append(vals, formatPreviewValue(f, v.ComparisonValue.AsInterface(), logger))
+			}
+			if v.DeltaAbs != nil {
+				vals = append(vals, formatPreviewValue(f, v.DeltaAbs.AsInterface(), logger))
+			}
+			if v.DeltaRel != nil {
+				vals = append(vals, formatPreviewValue(percentFormatter, v.DeltaRel.AsInterface(), logger))
+			}
+		}
+		res.Rows = append(res.Rows, vals)
+	}
+	return res
+}
+
+// getDimensionLabel returns the label of a dimension in an aggregation result.
+// It returns false if the field name is not one of the requested dimensions.
+func getDimensionLabel(fieldName string, reqDimensions []*runtimev1.MetricsViewAggregationDimension, dimensions []*runtimev1.MetricsViewSpec_DimensionV2) (string, bool) {
+	for _, d := range reqDimensions {
+		if d.Alias != "" && d.Alias == fieldName {
+			return fieldName, true
+		}
+		if d.Name != fieldName {
+			continue
+		}
+		for _, dim := range dimensions {
+			if dim.Name == fieldName && dim.Label != "" {
+				return dim.Label, true
+			}
+		}
+		return fieldName, true
+	}
+	return "", false
+}
+
+// formatPreviewValue formats a value as a string for a preview table.
+// Nil values are formatted as an empty string.
+func formatPreviewValue(f formatter.Formatter, v any, logger *zap.Logger) string {
+	v = formatValue(f, v, logger)
+	if v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprintf("%v", v)
+}
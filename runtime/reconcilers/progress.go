@@ -0,0 +1,124 @@
+package reconcilers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// progressUpdateInterval is the minimum interval between progress updates to a resource's state.
+const progressUpdateInterval = 5 * time.Second
+
+// progressLogInterval is the minimum interval between progress log messages for a resource.
+const progressLogInterval = 30 * time.Second
+
+// executionProgress is a drivers.Progress that reports the progress of a source ingestion or model execution on the resource's state.
+// It throttles updates to the state, which are streamed to clients watching the resource, and periodically logs the progress.
+// Observe and Target may be called concurrently, but not after finish.
+type executionProgress struct {
+	ctx       context.Context
+	c         *runtime.Controller
+	self      *runtimev1.Resource
+	set       func(p *runtimev1.ExecutionProgress)
+	startedOn time.Time
+
+	mu          sync.Mutex
+	current     map[drivers.ProgressUnit]int64
+	target      map[drivers.ProgressUnit]int64
+	lastUpdated time.Time
+	lastLogged  time.Time
+}
+
+var _ drivers.Progress = (*executionProgress)(nil)
+
+// newExecutionProgress creates a progress tracker for the resource reconciled in ctx.
+// The set function should assign the progress to the state of self. It must replace the state instead of mutating it,
+// since the state is shared with the controller's catalog after each update.
+func newExecutionProgress(ctx context.Context, c *runtime.Controller, self *runtimev1.Resource, set func(p *runtimev1.ExecutionProgress)) *executionProgress {
+	now := time.Now()
+	return &executionProgress{
+		ctx:        ctx,
+		c:          c,
+		self:       self,
+		set:        set,
+		startedOn:  now,
+		current:    make(map[drivers.ProgressUnit]int64),
+		target:     make(map[drivers.ProgressUnit]int64),
+		lastLogged: now,
+	}
+}
+
+func (p *executionProgress) Target(val int64, unit drivers.ProgressUnit) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.target[unit] = val
+	p.update(false)
+}
+
+func (p *executionProgress) Observe(val int64, unit drivers.ProgressUnit) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.current[unit] += val
+	p.update(false)
+}
+
+// observed returns the total amount observed for the unit.
+func (p *executionProgress) observed(unit drivers.ProgressUnit) int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.current[unit]
+}
+
+// finish updates the state with the final progress.
+func (p *executionProgress) finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.update(true)
+}
+
+// update sets the progress on the resource's state if the update interval has passed or force is true.
+// It must be called while holding p.mu.
+func (p *executionProgress) update(force bool) {
+	now := time.Now()
+
+	if now.Sub(p.lastLogged) >= progressLogInterval {
+		p.lastLogged = now
+		p.c.Logger.Info("Execution progress",
+			zap.String("name", p.self.Meta.Name.Name),
+			zap.Int64("current_bytes", p.current[drivers.ProgressUnitByte]),
+			zap.Int64("target_bytes", p.target[drivers.ProgressUnitByte]),
+			zap.Int64("current_files", p.current[drivers.ProgressUnitFile]),
+			zap.Int64("target_files", p.target[drivers.ProgressUnitFile]),
+			zap.Int64("current_records", p.current[drivers.ProgressUnitRecord]),
+			zap.Int64("target_records", p.target[drivers.ProgressUnitRecord]),
+		)
+	}
+
+	if !force && now.Sub(p.lastUpdated) < progressUpdateInterval {
+		return
+	}
+	p.lastUpdated = now
+
+	p.set(&runtimev1.ExecutionProgress{
+		CurrentBytes:   p.current[drivers.ProgressUnitByte],
+		TargetBytes:    p.target[drivers.ProgressUnitByte],
+		CurrentFiles:   p.current[drivers.ProgressUnitFile],
+		TargetFiles:    p.target[drivers.ProgressUnitFile],
+		CurrentRecords: p.current[drivers.ProgressUnitRecord],
+		TargetRecords:  p.target[drivers.ProgressUnitRecord],
+		StartedOn:      timestamppb.New(p.startedOn),
+		UpdatedOn:      timestamppb.New(now),
+	})
+
+	// Progress is informational, so we don't fail the execution if the update fails.
+	err := p.c.UpdateProgress(p.ctx, p.self.Meta.Name, p.self)
+	if err != nil {
+		p.c.Logger.Warn("Failed to update execution progress", zap.String("name", p.self.Meta.Name.Name), zap.Error(err))
+	}
+}
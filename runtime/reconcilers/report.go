@@ -1,6 +1,7 @@
 package reconcilers
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -12,8 +13,6 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/duration"
-	"github.com/rilldata/rill/runtime/pkg/email"
-	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/server"
 	"go.opentelemetry.io/otel/attribute"
@@ -27,6 +26,8 @@ const (
 	reportCheckDefaultTimeout   = 5 * time.Minute
 	reportDefaultIntervalsLimit = 25
 	reportQueryPriority         = 1
+	// reportDefaultAttachMaxBytes is the default max size of exported data attached to notifications.
+	reportDefaultAttachMaxBytes = 10 * 1024 * 1024
 )
 
 func init() {
@@ -378,6 +379,19 @@ func (r *ReportReconciler) executeSingle(ctx context.Context, self *runtimev1.Re
 // sendReport composes and sends the actual report to the configured recipients.
 // It returns true if an error occurred after some or all notifications were sent.
 func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, t time.Time) (bool, error) {
+	inst, err := r.C.Runtime.Instance(ctx, r.C.InstanceID)
+	if err != nil {
+		return false, err
+	}
+	cfg, err := inst.Config()
+	if err != nil {
+		return false, err
+	}
+	if cfg.NotificationsDisabled {
+		r.C.Logger.Info("Skipped sending report because notifications are disabled", zap.String("report", self.Meta.Name.Name), zap.Time("report_time", t))
+		return false, nil
+	}
+
 	r.C.Logger.Info("Sending report", zap.String("report", self.Meta.Name.Name), zap.Time("report_time", t))
 
 	admin, release, err := r.C.Runtime.Admin(ctx, r.C.InstanceID)
@@ -418,74 +432,201 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 	}
 	exportURL.RawQuery = exportURLQry.Encode()
 
+	// Export the data for attaching to notifications if configured
+	var attachment *drivers.Attachment
+	if rep.Spec.ExportAttach {
+		attachment, err = r.exportAttachment(ctx, rep, t, meta.QueryForAttributes)
+		if err != nil {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			// Fall back to only including the download link
+			if errors.Is(err, errMissingQueryAttributes) {
+				r.C.Logger.Info("Skipped attaching report data because the report has no owner to enforce the security policy with", zap.String("report", self.Meta.Name.Name), zap.Time("report_time", t))
+			} else {
+				r.C.Logger.Warn("Failed to export report data for attachment", zap.String("report", self.Meta.Name.Name), zap.Time("report_time", t), zap.Error(err))
+			}
+		} else if attachment == nil {
+			r.C.Logger.Info("Skipped attaching report data because it exceeds the max attachment size", zap.String("report", self.Meta.Name.Name), zap.Time("report_time", t))
+		}
+	}
+
+	// Build a preview of the query result for including in notifications if configured
+	var preview *drivers.Preview
+	if rep.Spec.PreviewRows > 0 {
+		preview, err = buildPreview(ctx, r.C, rep.Spec.QueryName, rep.Spec.QueryArgsJson, t, meta.QueryForAttributes, rep.Spec.PreviewRows, reportQueryPriority)
+		if err != nil {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			if errors.Is(err, errMissingQueryAttributes) {
+				r.C.Logger.Info("Skipped report preview because the report has no owner to enforce the security policy with", zap.String("report", self.Meta.Name.Name), zap.Time("report_time", t))
+			} else {
+				r.C.Logger.Warn("Failed to build report preview", zap.String("report", self.Meta.Name.Name), zap.Time("report_time", t), zap.Error(err))
+			}
+		}
+	}
+
 	sent := false
 	for _, notifier := range rep.Spec.Notifiers {
-		switch notifier.Connector {
-		case "email":
-			recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
-			for _, recipient := range recipients {
-				err := r.C.Runtime.Email.SendScheduledReport(&email.ScheduledReport{
-					ToEmail:        recipient,
-					ToName:         "",
-					Title:          rep.Spec.Title,
-					ReportTime:     t,
-					DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
-					OpenLink:       meta.OpenURL,
-					DownloadLink:   exportURL.String(),
-					EditLink:       meta.EditURL,
-				})
-				sent = true
-				if err != nil {
-					return true, fmt.Errorf("failed to generate report for %q: %w", recipient, err)
-				}
+		err := func() (outErr error) {
+			conn, release, err := r.C.Runtime.AcquireHandle(ctx, r.C.InstanceID, notifier.Connector)
+			if err != nil {
+				return err
 			}
-		default:
-			err := func() (outErr error) {
-				conn, release, err := r.C.Runtime.AcquireHandle(ctx, r.C.InstanceID, notifier.Connector)
-				if err != nil {
-					return err
-				}
-				defer release()
-				n, err := conn.AsNotifier(notifier.Properties.AsMap())
-				if err != nil {
-					return err
-				}
-				msg := &drivers.ScheduledReport{
-					Title:          rep.Spec.Title,
-					ReportTime:     t,
-					DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
-					OpenLink:       meta.OpenURL,
-					DownloadLink:   exportURL.String(),
-					EditLink:       meta.EditURL,
-				}
-				start := time.Now()
-				defer func() {
-					totalLatency := time.Since(start).Milliseconds()
-
-					if r.C.Activity != nil {
-						r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
-							attribute.Bool("failed", outErr != nil),
-							attribute.String("connector", notifier.Connector),
-							attribute.String("notification_type", "scheduled_report"),
-						)
-					}
-				}()
-				err = n.SendScheduledReport(msg)
-				sent = true
-				if err != nil {
-					return fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
+			defer release()
+			n, err := conn.AsNotifier(notifier.Properties.AsMap())
+			if err != nil {
+				return err
+			}
+			msg := &drivers.ScheduledReport{
+				Title:          rep.Spec.Title,
+				ReportTime:     t,
+				DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
+				OpenLink:       meta.OpenURL,
+				DownloadLink:   exportURL.String(),
+				EditLink:       meta.EditURL,
+				Attachment:     attachment,
+				Preview:        preview,
+			}
+			start := time.Now()
+			defer func() {
+				totalLatency := time.Since(start).Milliseconds()
+
+				if r.C.Activity != nil {
+					r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
+						attribute.Bool("failed", outErr != nil),
+						attribute.String("connector", notifier.Connector),
+						attribute.String("notification_type", "scheduled_report"),
+					)
 				}
-				return nil
 			}()
+			err = n.SendScheduledReport(msg)
+			sent = true
 			if err != nil {
-				return sent, err
+				return fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
 			}
+			return nil
+		}()
+		if err != nil {
+			return sent, err
 		}
 	}
 
 	return false, nil
 }
 
+// errMissingQueryAttributes is returned when query results would be included in a notification without security attributes to run the query with.
+// Queries without attributes bypass the metrics view's security policy, so their results must not be sent to recipients.
+var errMissingQueryAttributes = errors.New("no owner or query attributes to enforce the metrics view's security policy with")
+
+// checkQueryAttributes returns errMissingQueryAttributes if attrs is empty and the query's metrics view has a security policy.
+func checkQueryAttributes(ctx context.Context, c *runtime.Controller, queryName, queryArgsJSON string, attrs map[string]any) error {
+	if len(attrs) > 0 {
+		return nil
+	}
+
+	metricsViewName, err := queries.MetricsViewFromQuery(queryName, queryArgsJSON)
+	if err != nil {
+		return fmt.Errorf("failed extract metrics view name from query: %w", err)
+	}
+	mv, err := c.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: metricsViewName}, false)
+	if err != nil {
+		return err
+	}
+
+	if mv.GetMetricsView().Spec.GetSecurity() != nil || mv.GetMetricsView().State.GetValidSpec().GetSecurity() != nil {
+		return errMissingQueryAttributes
+	}
+	return nil
+}
+
+// exportAttachment exports the report's data for attaching it to notifications.
+// It returns nil if the exported data exceeds the report's max attachment size.
+// It returns errMissingQueryAttributes if the report has no owner and the metrics view has a security policy.
+func (r *ReportReconciler) exportAttachment(ctx context.Context, rep *runtimev1.Report, t time.Time, attrs map[string]any) (*drivers.Attachment, error) {
+	err := checkQueryAttributes(ctx, r.C, rep.Spec.QueryName, rep.Spec.QueryArgsJson, attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	maxBytes := int64(rep.Spec.ExportAttachMaxBytes)
+	if maxBytes == 0 {
+		maxBytes = reportDefaultAttachMaxBytes
+	}
+
+	var filename string
+	buf := &limitedBuffer{max: maxBytes}
+	err = r.C.Runtime.ResolveExport(ctx, buf, &runtime.ResolveExportOptions{
+		InstanceID: r.C.InstanceID,
+		Resolver:   "legacy_metrics",
+		ResolverProperties: map[string]any{
+			"query_name":      rep.Spec.QueryName,
+			"query_args_json": rep.Spec.QueryArgsJson,
+		},
+		Args: map[string]any{
+			"priority":       reportQueryPriority,
+			"execution_time": t.Format(time.RFC3339Nano),
+			"limit":          rep.Spec.ExportLimit,
+		},
+		UserAttributes: attrs,
+		Format:         rep.Spec.ExportFormat,
+		PreWriteHook: func(name string) error {
+			filename = name
+			return nil
+		},
+	})
+	if err != nil {
+		if errors.Is(err, errLimitedBufferExceeded) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	ext, contentType, err := exportFormatFileType(rep.Spec.ExportFormat)
+	if err != nil {
+		return nil, err
+	}
+	if filename == "" {
+		filename = "report"
+	}
+
+	return &drivers.Attachment{
+		Filename:    fmt.Sprintf("%s_%s.%s", filename, t.UTC().Format("20060102150405"), ext),
+		ContentType: contentType,
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+// exportFormatFileType returns the file extension and content type for an export format.
+func exportFormatFileType(f runtimev1.ExportFormat) (string, string, error) {
+	switch f {
+	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
+		return "csv", "text/csv", nil
+	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
+		return "xlsx", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", nil
+	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
+		return "parquet", "application/octet-stream", nil
+	default:
+		return "", "", fmt.Errorf("unsupported format %q", f.String())
+	}
+}
+
+var errLimitedBufferExceeded = errors.New("limited buffer: max size exceeded")
+
+// limitedBuffer is a bytes.Buffer that returns errLimitedBufferExceeded from Write if writing would grow it beyond max bytes.
+type limitedBuffer struct {
+	bytes.Buffer
+	max int64
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if int64(b.Len()+len(p)) > b.max {
+		return 0, errLimitedBufferExceeded
+	}
+	return b.Buffer.Write(p)
+}
+
 func formatExportFormat(f runtimev1.ExportFormat) string {
 	switch f {
 	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
@@ -16,6 +16,7 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -80,6 +81,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 
 	// Handle deletion
 	if self.Meta.DeletedOn != nil {
+		r.stopChanges(ctx, self, src.State.IncrementalState.AsMap())
 		olapDropTableIfExists(ctx, r.C, src.State.Connector, src.State.Table, false)
 		olapDropTableIfExists(ctx, r.C, src.State.Connector, r.stagingTableName(tableName), false)
 		return runtime.ReconcileResult{}
@@ -115,11 +117,14 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 	if err != nil {
 		if !src.Spec.StageChanges && src.State.Table != "" {
 			// Remove previously ingested table
+			r.stopChanges(ctx, self, src.State.IncrementalState.AsMap())
 			olapDropTableIfExists(ctx, r.C, src.State.Connector, src.State.Table, false)
 			src.State.Connector = ""
 			src.State.Table = ""
 			src.State.SpecHash = ""
 			src.State.RefreshedOn = nil
+			src.State.Streaming = false
+			src.State.IncrementalState = nil
 			err = r.C.UpdateState(ctx, self.Meta.Name, self)
 			if err != nil {
 				r.C.Logger.Error("refs check: failed to update state", zap.Any("error", err))
@@ -174,12 +179,28 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		olapDropTableIfExists(ctx, r.C, src.State.Connector, r.stagingTableName(src.State.Table), false)
 	}
 
+	// Sources with incremental state (such as the consumed offsets of a Kafka topic, a replication position or the ingested files) apply new data to the existing table.
+	// It's reset if the spec has changed or the table has disappeared.
+	incremental := src.State.IncrementalState != nil
+	incremental = incremental && src.State.SpecHash == hash
+	incremental = incremental && src.State.Connector == src.Spec.SinkConnector
+	incremental = incremental && (src.State.Table == "" || tableExists) // The table is only missing if no data has been received yet
+
+	// Release the resources used to capture changes for the incremental state that is being reset (such as a Postgres replication slot)
+	if !incremental && src.State.IncrementalState != nil {
+		r.stopChanges(ctx, self, src.State.IncrementalState.AsMap())
+	}
+
 	// Prepare for ingestion
 	stagingTableName := tableName
 	connector := src.Spec.SinkConnector
-	if src.Spec.StageChanges {
+	if src.Spec.StageChanges && !incremental {
 		stagingTableName = r.stagingTableName(tableName)
 	}
+	var prevState map[string]any
+	if incremental {
+		prevState = src.State.IncrementalState.AsMap()
+	}
 
 	// Should never happen, but if somehow the staging table was corrupted into a view, drop it
 	if t, ok := olapTableInfo(ctx, r.C, connector, stagingTableName); ok && t.View {
@@ -188,12 +209,18 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 
 	// Execute ingestion
 	r.C.Logger.Info("Ingesting source data", zap.String("name", n.Name), zap.String("connector", connector))
-	ingestErr := r.ingestSource(ctx, self, srcConfig, driversSink(stagingTableName))
+	ingestRes, ingestErr := r.ingestSource(ctx, self, srcConfig, driversSink(stagingTableName), prevState)
 	if ingestErr != nil {
 		ingestErr = fmt.Errorf("failed to ingest source: %w", ingestErr)
 	}
 
-	if ingestErr == nil && src.Spec.StageChanges {
+	// A streaming source doesn't create a table until it has received data
+	ingestedTable := true
+	if ingestErr == nil && ingestRes.Streaming {
+		_, ingestedTable = olapTableInfo(ctx, r.C, connector, stagingTableName)
+	}
+
+	if ingestErr == nil && ingestedTable && stagingTableName != tableName {
 		// Rename staging table to main table
 		err = olapForceRenameTable(ctx, r.C, connector, stagingTableName, false, tableName)
 		if err != nil {
@@ -203,6 +230,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 
 	// How we handle ingestErr depends on several things:
 	// If ctx was cancelled, we cleanup and exit
+	// If the ingestion was incremental, we retain the data ingested so far and its state, but still return the error.
 	// If StageChanges is true, we retain the existing table, but still return the error.
 	// If StageChanges is false, we clear the existing table and return the error.
 
@@ -222,19 +250,40 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		update = true
 		src.State.Connector = connector
 		src.State.Table = tableName
+		if !ingestedTable {
+			src.State.Table = ""
+		}
 		src.State.SpecHash = hash
 		src.State.RefreshedOn = timestamppb.Now()
+		src.State.Streaming = ingestRes.Streaming
+		src.State.IncrementalState, err = incrementalStateToPB(ingestRes.State)
+		if err != nil {
+			return runtime.ReconcileResult{Err: err}
+		}
+	} else if incremental {
+		// Failed incremental ingestion to main table
+		if ingestRes.State != nil {
+			update = true
+			src.State.IncrementalState, err = incrementalStateToPB(ingestRes.State)
+			if err != nil {
+				return runtime.ReconcileResult{Err: err}
+			}
+		}
 	} else if src.Spec.StageChanges {
 		// Failed ingestion to staging table
 		olapDropTableIfExists(cleanupCtx, r.C, connector, stagingTableName, false)
+		r.stopChanges(cleanupCtx, self, ingestRes.State)
 	} else {
 		// Failed ingestion to main table
 		update = true
 		olapDropTableIfExists(cleanupCtx, r.C, connector, tableName, false)
+		r.stopChanges(cleanupCtx, self, ingestRes.State)
 		src.State.Connector = ""
 		src.State.Table = ""
 		src.State.SpecHash = ""
 		src.State.RefreshedOn = nil
+		src.State.Streaming = false
+		src.State.IncrementalState = nil
 	}
 	if update {
 		err = r.C.UpdateState(ctx, self.Meta.Name, self)
@@ -322,30 +371,42 @@ func (r *SourceReconciler) setTriggerFalse(ctx context.Context, n *runtimev1.Res
 	return r.C.UpdateSpec(ctx, self.Meta.Name, self)
 }
 
+// ingestResult is the result of a call to ingestSource.
+type ingestResult struct {
+	// State is the last state set by the transporter. It is nil if the transporter doesn't persist incremental state.
+	// It may be set even if ingestion failed, in which case it reflects the data ingested before the failure.
+	State map[string]any
+	// Streaming is true if the source connector continuously receives new data.
+	Streaming bool
+}
+
 // ingestSource ingests the source into a table with tableName.
+// If prevState is not nil, transporters that support it append new data to the existing table.
 // It does NOT drop the table if ingestion fails after the table has been created.
 // It will return an error if the sink connector is not an OLAP.
-func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Resource, srcConfig, sinkConfig map[string]any) (outErr error) {
+func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Resource, srcConfig, sinkConfig, prevState map[string]any) (res *ingestResult, outErr error) {
 	src := self.GetSource().Spec
+	res = &ingestResult{}
 
 	// Get connections and transporter
 	srcConn, release1, err := r.C.AcquireConn(ctx, src.SourceConnector)
 	if err != nil {
-		return err
+		return res, err
 	}
 	defer release1()
 	sinkConn, release2, err := r.C.AcquireConn(ctx, src.SinkConnector)
 	if err != nil {
-		return err
+		return res, err
 	}
 	defer release2()
 	t, ok := sinkConn.AsTransporter(srcConn, sinkConn)
 	if !ok {
 		t, ok = srcConn.AsTransporter(srcConn, sinkConn)
 		if !ok {
-			return fmt.Errorf("cannot transfer data between connectors %q and %q", src.SourceConnector, src.SinkConnector)
+			return res, fmt.Errorf("cannot transfer data between connectors %q and %q", src.SourceConnector, src.SinkConnector)
 		}
 	}
+	_, res.Streaming = srcConn.(drivers.StreamStore)
 
 	// Set timeout on ctx
 	timeout := _defaultIngestTimeout
@@ -358,11 +419,19 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Res
 	// Get repo root
 	repo, release, err := r.C.Runtime.Repo(ctx, r.C.InstanceID)
 	if err != nil {
-		return fmt.Errorf("failed to access repo: %w", err)
+		return res, fmt.Errorf("failed to access repo: %w", err)
 	}
 	repoRoot := repo.Root()
 	release()
 
+	// Report the progress of the transfer on the source's state
+	progress := newExecutionProgress(ctx, r.C, self, func(p *runtimev1.ExecutionProgress) {
+		src := self.GetSource()
+		state := proto.Clone(src.State).(*runtimev1.SourceState)
+		state.Progress = p
+		src.State = state
+	})
+
 	// Execute the data transfer
 	opts := &drivers.TransferOptions{
 		AllowHostAccess: r.C.Runtime.AllowHostAccess(),
@@ -370,11 +439,17 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Res
 		AcquireConnector: func(name string) (drivers.Handle, func(), error) {
 			return r.C.AcquireConn(ctx, name)
 		},
-		Progress: drivers.NoOpProgress{},
+		Progress: progress,
+		State:    prevState,
+		SetState: func(state map[string]any) {
+			res.State = state
+		},
 	}
 
 	transferStart := time.Now()
 	defer func() {
+		progress.finish()
+
 		transferLatency := time.Since(transferStart).Milliseconds()
 		commonDims := []attribute.KeyValue{
 			attribute.String("source", srcConn.Driver()),
@@ -383,12 +458,55 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Res
 			attribute.Bool("failed", outErr != nil),
 		}
 		r.C.Activity.RecordMetric(ctx, "ingestion_ms", float64(transferLatency), commonDims...)
-
-		// TODO: emit the number of bytes ingested (this might be extracted from a progress)
+		r.C.Activity.RecordMetric(ctx, "ingestion_bytes", float64(progress.observed(drivers.ProgressUnitByte)), commonDims...)
+		r.C.Activity.RecordMetric(ctx, "ingestion_files", float64(progress.observed(drivers.ProgressUnitFile)), commonDims...)
+		r.C.Activity.RecordMetric(ctx, "ingestion_records", float64(progress.observed(drivers.ProgressUnitRecord)), commonDims...)
 	}()
 
 	err = t.Transfer(ctx, srcConfig, sinkConfig, opts)
-	return err
+	return res, err
+}
+
+// stopChanges releases the resources used to capture changes from the source connector (such as a replication slot) for an incremental state.
+// It does nothing if the state is empty or the source connector doesn't capture changes.
+// Errors are logged instead of returned since they shouldn't prevent deleting or re-ingesting the source.
+func (r *SourceReconciler) stopChanges(ctx context.Context, self *runtimev1.Resource, state map[string]any) {
+	if len(state) == 0 {
+		return
+	}
+	src := self.GetSource().Spec
+
+	conn, release, err := r.C.AcquireConn(ctx, src.SourceConnector)
+	if err != nil {
+		r.C.Logger.Warn("failed to stop capturing changes", zap.String("name", self.Meta.Name.Name), zap.Error(err))
+		return
+	}
+	defer release()
+	cs, ok := conn.(drivers.ChangeStore)
+	if !ok {
+		return
+	}
+
+	props, err := r.driversSource(ctx, self, src.Properties)
+	if err != nil {
+		props = src.Properties.AsMap()
+	}
+	err = cs.StopChanges(ctx, props, state)
+	if err != nil {
+		r.C.Logger.Warn("failed to stop capturing changes", zap.String("name", self.Meta.Name.Name), zap.Error(err))
+	}
+}
+
+// incrementalStateToPB converts incremental state set by a transporter to a protobuf struct.
+func incrementalStateToPB(state map[string]any) (*structpb.Struct, error) {
+	if state == nil {
+		return nil, nil
+	}
+	s, err := structpb.NewStruct(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize incremental state: %w", err)
+	}
+	return s, nil
 }
 
 func (r *SourceReconciler) driversSource(ctx context.Context, self *runtimev1.Resource, propsPB *structpb.Struct) (map[string]any, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -35,22 +36,50 @@ func checkRefs(ctx context.Context, c *runtime.Controller, refs []*runtimev1.Res
 }
 
 // hasStreamingRef returns true if one or more of the refs have data that may be updated outside of a reconcile.
+// Refs are checked transitively, so a resource that depends on a model that depends on a streaming source is also streaming.
 func hasStreamingRef(ctx context.Context, c *runtime.Controller, refs []*runtimev1.ResourceName) bool {
-	for _, ref := range refs {
-		// Currently only metrics views can be streaming.
-		if ref.Kind != runtime.ResourceKindMetricsView {
+	return hasStreamingRefFunc(refs, func(ref *runtimev1.ResourceName) (*runtimev1.Resource, error) {
+		return c.Get(ctx, ref, false)
+	})
+}
+
+// hasStreamingRefFunc implements hasStreamingRef using get to look up resources.
+func hasStreamingRefFunc(refs []*runtimev1.ResourceName, get func(*runtimev1.ResourceName) (*runtimev1.Resource, error)) bool {
+	visited := make(map[string]bool)
+	queue := slices.Clone(refs)
+	for len(queue) > 0 {
+		ref := queue[0]
+		queue = queue[1:]
+
+		// Currently only metrics views and sources can be streaming. Models are traversed to find streaming sources they depend on.
+		if ref.Kind != runtime.ResourceKindMetricsView && ref.Kind != runtime.ResourceKindSource && ref.Kind != runtime.ResourceKindModel {
 			continue
 		}
 
-		res, err := c.Get(ctx, ref, false)
+		key := ref.Kind + "/" + strings.ToLower(ref.Name)
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+
+		res, err := get(ref)
 		if err != nil {
 			// Broken refs are not streaming.
 			continue
 		}
-		mv := res.GetMetricsView()
 
-		if mv.State.Streaming {
-			return true
+		switch ref.Kind {
+		case runtime.ResourceKindMetricsView:
+			// The metrics view's streaming state already accounts for its own refs.
+			if res.GetMetricsView().State.Streaming {
+				return true
+			}
+		case runtime.ResourceKindSource:
+			if res.GetSource().State.Streaming {
+				return true
+			}
+		case runtime.ResourceKindModel:
+			queue = append(queue, res.Meta.Refs...)
 		}
 	}
 	return false
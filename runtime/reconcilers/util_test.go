@@ -0,0 +1,53 @@
+package reconcilers
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasStreamingRef(t *testing.T) {
+	source := func(name string, streaming bool) *runtimev1.Resource {
+		return &runtimev1.Resource{
+			Meta:     &runtimev1.ResourceMeta{Name: &runtimev1.ResourceName{Kind: runtime.ResourceKindSource, Name: name}},
+			Resource: &runtimev1.Resource_Source{Source: &runtimev1.SourceV2{State: &runtimev1.SourceState{Streaming: streaming}}},
+		}
+	}
+	model := func(name string, refs ...*runtimev1.ResourceName) *runtimev1.Resource {
+		return &runtimev1.Resource{
+			Meta:     &runtimev1.ResourceMeta{Name: &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: name}, Refs: refs},
+			Resource: &runtimev1.Resource_Model{Model: &runtimev1.ModelV2{State: &runtimev1.ModelState{}}},
+		}
+	}
+	ref := func(kind, name string) *runtimev1.ResourceName {
+		return &runtimev1.ResourceName{Kind: kind, Name: name}
+	}
+
+	resources := []*runtimev1.Resource{
+		source("kafka", true),
+		source("s3", false),
+		model("events", ref(runtime.ResourceKindSource, "kafka")),
+		model("events_agg", ref(runtime.ResourceKindModel, "events")),
+		model("static", ref(runtime.ResourceKindSource, "s3")),
+		model("cycle_a", ref(runtime.ResourceKindModel, "cycle_b")),
+		model("cycle_b", ref(runtime.ResourceKindModel, "cycle_a")),
+	}
+	get := func(n *runtimev1.ResourceName) (*runtimev1.Resource, error) {
+		for _, r := range resources {
+			if r.Meta.Name.Kind == n.Kind && r.Meta.Name.Name == n.Name {
+				return r, nil
+			}
+		}
+		return nil, drivers.ErrResourceNotFound
+	}
+
+	require.True(t, hasStreamingRefFunc([]*runtimev1.ResourceName{ref(runtime.ResourceKindSource, "kafka")}, get))
+	require.True(t, hasStreamingRefFunc([]*runtimev1.ResourceName{ref(runtime.ResourceKindModel, "events")}, get))
+	require.True(t, hasStreamingRefFunc([]*runtimev1.ResourceName{ref(runtime.ResourceKindModel, "static"), ref(runtime.ResourceKindModel, "events_agg")}, get))
+	require.False(t, hasStreamingRefFunc([]*runtimev1.ResourceName{ref(runtime.ResourceKindModel, "static")}, get))
+	require.False(t, hasStreamingRefFunc([]*runtimev1.ResourceName{ref(runtime.ResourceKindModel, "cycle_a")}, get))
+	require.False(t, hasStreamingRefFunc([]*runtimev1.ResourceName{ref(runtime.ResourceKindModel, "missing")}, get))
+}
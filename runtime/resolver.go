@@ -9,6 +9,7 @@ import (
 	"io"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
 )
 
 // Resolver represents logic, such as a SQL query, that produces output data.
@@ -34,6 +35,9 @@ type Resolver interface {
 	ResolveInteractive(ctx context.Context) (*ResolverResult, error)
 	// ResolveExport resolve data for export (e.g. downloads or reports).
 	ResolveExport(ctx context.Context, w io.Writer, opts *ResolverExportOptions) error
+	// ResolveArrow resolves data for streaming as Arrow record batches (e.g. large API responses).
+	// The caller reads the data with the result's ArrowRecordReader method and must close the result when done.
+	ResolveArrow(ctx context.Context) (*drivers.Result, error)
 }
 
 // ResolverResult is the result of a resolver's execution.
@@ -176,3 +180,70 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolveRes
 	}
 	return val.(ResolveResult), nil
 }
+
+// ResolveExportOptions are the options passed to the runtime's ResolveExport method.
+type ResolveExportOptions struct {
+	InstanceID         string
+	Resolver           string
+	ResolverProperties map[string]any
+	Args               map[string]any
+	UserAttributes     map[string]any
+	Format             runtimev1.ExportFormat
+	PreWriteHook       func(filename string) error
+}
+
+// ResolveExport resolves a query using the given options and writes the exported result to w.
+// Unlike Resolve, the result is not cached.
+func (r *Runtime) ResolveExport(ctx context.Context, w io.Writer, opts *ResolveExportOptions) error {
+	initializer, ok := ResolverInitializers[opts.Resolver]
+	if !ok {
+		return fmt.Errorf("no resolver found for name %q", opts.Resolver)
+	}
+	resolver, err := initializer(ctx, &ResolverOptions{
+		Runtime:        r,
+		InstanceID:     opts.InstanceID,
+		Properties:     opts.ResolverProperties,
+		Args:           opts.Args,
+		UserAttributes: opts.UserAttributes,
+		ForExport:      true,
+	})
+	if err != nil {
+		return err
+	}
+	defer resolver.Close()
+
+	return resolver.ResolveExport(ctx, w, &ResolverExportOptions{
+		Format:       opts.Format,
+		PreWriteHook: opts.PreWriteHook,
+	})
+}
+
+// ResolveArrow resolves a query using the given options and returns the result for streaming as Arrow record batches.
+// Unlike Resolve, the result is not buffered or cached. The caller must close the returned result.
+func (r *Runtime) ResolveArrow(ctx context.Context, opts *ResolveOptions) (*drivers.Result, error) {
+	initializer, ok := ResolverInitializers[opts.Resolver]
+	if !ok {
+		return nil, fmt.Errorf("no resolver found for name %q", opts.Resolver)
+	}
+	resolver, err := initializer(ctx, &ResolverOptions{
+		Runtime:        r,
+		InstanceID:     opts.InstanceID,
+		Properties:     opts.ResolverProperties,
+		Args:           opts.Args,
+		UserAttributes: opts.UserAttributes,
+		ForExport:      false,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resolver.ResolveArrow(ctx)
+	if err != nil {
+		_ = resolver.Close()
+		return nil, err
+	}
+
+	// The resolver must stay open until the caller is done reading the result
+	res.SetCleanupFunc(resolver.Close)
+	return res, nil
+}
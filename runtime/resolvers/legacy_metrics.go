@@ -0,0 +1,161 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/mapstructureutil"
+	"github.com/rilldata/rill/runtime/queries"
+)
+
+func init() {
+	runtime.RegisterResolverInitializer("legacy_metrics", newLegacyMetrics)
+}
+
+// legacyMetricsResolver resolves a legacy metrics query identified by a query name and JSON args, such as those used in reports and alerts.
+type legacyMetricsResolver struct {
+	runtime    *runtime.Runtime
+	instanceID string
+	props      *legacyMetricsResolverProps
+	args       *legacyMetricsResolverArgs
+	query      runtime.Query
+}
+
+type legacyMetricsResolverProps struct {
+	QueryName     string `mapstructure:"query_name"`
+	QueryArgsJSON string `mapstructure:"query_args_json"`
+}
+
+type legacyMetricsResolverArgs struct {
+	Priority      int        `mapstructure:"priority"`
+	ExecutionTime *time.Time `mapstructure:"execution_time"`
+	// Limit is the max number of rows to return. If the query has its own limit, the lower of the two is used.
+	Limit int64 `mapstructure:"limit"`
+}
+
+func newLegacyMetrics(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Resolver, error) {
+	props := &legacyMetricsResolverProps{}
+	if err := mapstructureutil.WeakDecode(opts.Properties, props); err != nil {
+		return nil, err
+	}
+
+	args := &legacyMetricsResolverArgs{}
+	if err := mapstructureutil.WeakDecode(opts.Args, args); err != nil {
+		return nil, err
+	}
+
+	qpb, err := queries.ProtoFromJSON(props.QueryName, props.QueryArgsJSON, args.ExecutionTime)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := queries.ProtoToQuery(qpb, opts.UserAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := opts.Runtime.InstanceConfig(ctx, opts.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := args.Limit
+	if opts.ForExport && cfg.DownloadRowLimit != 0 && (limit == 0 || limit > cfg.DownloadRowLimit) {
+		limit = cfg.DownloadRowLimit
+	}
+
+	switch q := q.(type) {
+	case *queries.MetricsViewAggregation:
+		limit = minNonZero(limit, qpb.GetMetricsViewAggregationRequest().Limit)
+		if limit != 0 {
+			q.Limit = &limit
+		}
+	case *queries.MetricsViewComparison:
+		q.Limit = minNonZero(limit, q.Limit)
+	}
+
+	return &legacyMetricsResolver{
+		runtime:    opts.Runtime,
+		instanceID: opts.InstanceID,
+		props:      props,
+		args:       args,
+		query:      q,
+	}, nil
+}
+
+func (r *legacyMetricsResolver) Close() error {
+	return nil
+}
+
+func (r *legacyMetricsResolver) Key() string {
+	return r.query.Key()
+}
+
+func (r *legacyMetricsResolver) Refs() []*runtimev1.ResourceName {
+	return r.query.Deps()
+}
+
+func (r *legacyMetricsResolver) Validate(ctx context.Context) error {
+	return nil
+}
+
+func (r *legacyMetricsResolver) ResolveInteractive(ctx context.Context) (*runtime.ResolverResult, error) {
+	q, ok := r.query.(*queries.MetricsViewAggregation)
+	if !ok {
+		return nil, fmt.Errorf("query %q can only be resolved for export", r.props.QueryName)
+	}
+
+	err := r.runtime.Query(ctx, r.instanceID, q, r.args.Priority)
+	if err != nil {
+		return nil, err
+	}
+	if q.Result == nil {
+		return nil, errors.New("query returned no result")
+	}
+
+	out := make([]map[string]any, len(q.Result.Data))
+	for i, row := range q.Result.Data {
+		out[i] = row.AsMap()
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtime.ResolverResult{
+		Data:   data,
+		Schema: q.Result.Schema,
+		Cache:  false, // Already cached by the query cache
+	}, nil
+}
+
+func (r *legacyMetricsResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runtime.ResolverExportOptions) error {
+	return r.query.Export(ctx, r.runtime, r.instanceID, w, &runtime.ExportOptions{
+		Format:       opts.Format,
+		Priority:     r.args.Priority,
+		PreWriteHook: opts.PreWriteHook,
+	})
+}
+
+func (r *legacyMetricsResolver) ResolveArrow(ctx context.Context) (*drivers.Result, error) {
+	return nil, fmt.Errorf("query %q does not support streaming results", r.props.QueryName)
+}
+
+// minNonZero returns the lowest of a and b, treating zero as no limit.
+func minNonZero(a, b int64) int64 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 || a < b {
+		return a
+	}
+	return b
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/mitchellh/hashstructure/v2"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/metricsview"
 	"github.com/rilldata/rill/runtime/pkg/mapstructureutil"
 )
@@ -136,6 +137,11 @@ func (r *metricsResolver) ResolveInteractive(ctx context.Context) (*runtime.Reso
 	}, nil
 }
 
+func (r *metricsResolver) ResolveArrow(ctx context.Context) (*drivers.Result, error) {
+	res, _, err := r.executor.Query(ctx, r.query, r.args.ExecutionTime)
+	return res, err
+}
+
 func (r *metricsResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runtime.ResolverExportOptions) error {
 	return errors.New("not implemented")
 }
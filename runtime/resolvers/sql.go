@@ -190,6 +190,28 @@ func (r *sqlResolver) ResolveInteractive(ctx context.Context) (*runtime.Resolver
 	}, nil
 }
 
+func (r *sqlResolver) ResolveArrow(ctx context.Context) (*drivers.Result, error) {
+	// Apply the interactive row limit like ResolveInteractive. Reading the result errors if the limit is exceeded.
+	sql := r.sql
+	if r.interactiveRowLimit != 0 {
+		sql = fmt.Sprintf("SELECT * FROM (%s) LIMIT %d", r.sql, r.interactiveRowLimit+1)
+	}
+
+	res, err := r.olap.Execute(ctx, &drivers.Statement{
+		Query:    sql,
+		Priority: r.priority,
+		Arrow:    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if r.interactiveRowLimit != 0 {
+		res.SetCap(r.interactiveRowLimit)
+	}
+	return res, nil
+}
+
 func (r *sqlResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runtime.ResolverExportOptions) error {
 	exportOpts := &runtime.ExportOptions{
 		Format:       opts.Format,
@@ -205,7 +227,7 @@ func (r *sqlResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runt
 			return queries.DuckDBCopyExport(ctx, w, exportOpts, r.sql, nil, filename, r.olap, opts.Format)
 		}
 		return r.generalExport(ctx, w, filename, exportOpts)
-	case drivers.DialectDruid, drivers.DialectClickHouse:
+	case drivers.DialectDruid, drivers.DialectClickHouse, drivers.DialectPostgres:
 		return r.generalExport(ctx, w, filename, exportOpts)
 	default:
 		return fmt.Errorf("export not available for dialect %q", r.olap.Dialect().String())
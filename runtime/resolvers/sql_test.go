@@ -37,6 +37,64 @@ func TestSimpleSQLApi(t *testing.T) {
 	require.Equal(t, "2022-03-05T14:49:50.459Z", rows[0]["timestamp"])
 }
 
+func TestSimpleSQLApiArrow(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	api, err := rt.APIForName(context.Background(), instanceID, "simple_sql_api")
+	require.NoError(t, err)
+
+	res, err := rt.ResolveArrow(context.Background(), &runtime.ResolveOptions{
+		InstanceID:         instanceID,
+		Resolver:           api.Spec.Resolver,
+		ResolverProperties: api.Spec.ResolverProperties.AsMap(),
+	})
+	require.NoError(t, err)
+	defer res.Close()
+
+	rdr, err := res.ArrowRecordReader(0)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(rdr.Schema().Fields()))
+
+	var n int64
+	for rdr.Next() {
+		n += rdr.Record().NumRows()
+	}
+	require.NoError(t, rdr.Err())
+	require.Equal(t, int64(5), n)
+}
+
+func TestSQLArrowRowLimit(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files:     map[string]string{"rill.yaml": ""},
+		Variables: map[string]string{"rill.interactive_sql_row_limit": "3"},
+	})
+
+	resolve := func(sql string) (int64, error) {
+		res, err := rt.ResolveArrow(context.Background(), &runtime.ResolveOptions{
+			InstanceID:         instanceID,
+			Resolver:           "sql",
+			ResolverProperties: map[string]any{"sql": sql},
+		})
+		require.NoError(t, err)
+		defer res.Close()
+
+		rdr, err := res.ArrowRecordReader(0)
+		require.NoError(t, err)
+		var n int64
+		for rdr.Next() {
+			n += rdr.Record().NumRows()
+		}
+		return n, rdr.Err()
+	}
+
+	n, err := resolve("SELECT * FROM range(3)")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), n)
+
+	_, err = resolve("SELECT * FROM range(10)")
+	require.ErrorContains(t, err, "result cap exceeded")
+}
+
 func TestTemplateSQLApi(t *testing.T) {
 	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
 
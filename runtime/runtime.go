@@ -11,7 +11,6 @@ import (
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/conncache"
-	"github.com/rilldata/rill/runtime/pkg/email"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
@@ -32,7 +31,6 @@ type Options struct {
 }
 
 type Runtime struct {
-	Email          *email.Client
 	opts           *Options
 	logger         *zap.Logger
 	activity       *activity.Client
@@ -43,13 +41,8 @@ type Runtime struct {
 	securityEngine *securityEngine
 }
 
-func New(ctx context.Context, opts *Options, logger *zap.Logger, ac *activity.Client, emailClient *email.Client) (*Runtime, error) {
-	if emailClient == nil {
-		emailClient = email.New(email.NewNoopSender())
-	}
-
+func New(ctx context.Context, opts *Options, logger *zap.Logger, ac *activity.Client) (*Runtime, error) {
 	rt := &Runtime{
-		Email:          emailClient,
 		opts:           opts,
 		logger:         logger,
 		activity:       ac,
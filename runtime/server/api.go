@@ -1,17 +1,23 @@
 package server
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
+	"strings"
 
+	"github.com/apache/arrow/go/v14/arrow/ipc"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/httputil"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
@@ -57,14 +63,37 @@ func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
 		return httputil.Error(http.StatusInternalServerError, err)
 	}
 
-	// Resolve the API to JSON data
-	res, err := s.runtime.Resolve(ctx, &runtime.ResolveOptions{
+	opts := &runtime.ResolveOptions{
 		InstanceID:         instanceID,
 		Resolver:           api.Spec.Resolver,
 		ResolverProperties: api.Spec.ResolverProperties.AsMap(),
 		Args:               args,
 		UserAttributes:     auth.GetClaims(ctx).Attributes(),
-	})
+	}
+
+	// Stream the result as Arrow record batches if the client accepts it
+	if strings.Contains(req.Header.Get("Accept"), arrowStreamContentType) {
+		res, err := s.runtime.ResolveArrow(ctx, opts)
+		if err != nil {
+			return httputil.Error(http.StatusBadRequest, err)
+		}
+		defer res.Close()
+
+		w.Header().Set("Content-Type", arrowStreamContentType)
+		err = writeArrowStream(w, res, func() error {
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+			return nil
+		})
+		if err != nil {
+			return httputil.Error(http.StatusInternalServerError, err)
+		}
+		return nil
+	}
+
+	// Resolve the API to JSON data
+	res, err := s.runtime.Resolve(ctx, opts)
 	if err != nil {
 		return httputil.Error(http.StatusBadRequest, err)
 	}
@@ -78,3 +107,78 @@ func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
 
 	return nil
 }
+
+// StreamAPI implements QueryService.
+func (s *Server) StreamAPI(req *runtimev1.StreamAPIRequest, srv runtimev1.QueryService_StreamAPIServer) error {
+	ctx := srv.Context()
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.name", req.Name),
+	)
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadAPI) {
+		return ErrForbidden
+	}
+
+	api, err := s.runtime.APIForName(ctx, req.InstanceId, req.Name)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return status.Errorf(codes.NotFound, "api with name %q not found", req.Name)
+		}
+		return err
+	}
+
+	res, err := s.runtime.ResolveArrow(ctx, &runtime.ResolveOptions{
+		InstanceID:         req.InstanceId,
+		Resolver:           api.Spec.Resolver,
+		ResolverProperties: api.Spec.ResolverProperties.AsMap(),
+		Args:               req.Args.AsMap(),
+		UserAttributes:     auth.GetClaims(ctx).Attributes(),
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer res.Close()
+
+	// Send the IPC stream in chunks of one record batch each
+	var buf bytes.Buffer
+	return writeArrowStream(&buf, res, func() error {
+		if buf.Len() == 0 {
+			return nil
+		}
+		err := srv.Send(&runtimev1.StreamAPIResponse{Data: bytes.Clone(buf.Bytes())})
+		buf.Reset()
+		return err
+	})
+}
+
+// arrowStreamContentType is the media type of the Arrow IPC streaming format.
+const arrowStreamContentType = "application/vnd.apache.arrow.stream"
+
+// writeArrowStream writes a result to w in the Arrow IPC streaming format.
+// The flush func is called after each record batch and after the end of the stream.
+func writeArrowStream(w io.Writer, res *drivers.Result, flush func() error) error {
+	rdr, err := res.ArrowRecordReader(drivers.DefaultArrowBatchSize)
+	if err != nil {
+		return err
+	}
+
+	wr := ipc.NewWriter(w, ipc.WithSchema(rdr.Schema()))
+	for rdr.Next() {
+		if err := wr.Write(rdr.Record()); err != nil {
+			return err
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+	if err := rdr.Err(); err != nil {
+		return err
+	}
+
+	if err := wr.Close(); err != nil {
+		return err
+	}
+	return flush()
+}
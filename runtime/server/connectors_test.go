@@ -0,0 +1,42 @@
+package server_test
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeConnectorsHidesSystemEmailConfig(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": `
+connectors:
+- name: email
+  type: email
+`,
+		},
+	})
+	srv, err := server.NewServer(testCtx(), &server.Options{}, rt, nil, ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	res, err := srv.AnalyzeConnectors(testCtx(), &runtimev1.AnalyzeConnectorsRequest{InstanceId: instanceID})
+	require.NoError(t, err)
+
+	var found bool
+	for _, c := range res.Connectors {
+		if c.Name != "email" {
+			continue
+		}
+		found = true
+		require.Empty(t, c.ErrorMessage)
+		require.NotContains(t, c.Config, "smtp_password")
+		require.NotContains(t, c.PresetConfig, "smtp_password")
+		require.NotContains(t, c.EnvConfig, "smtp_password")
+	}
+	require.True(t, found)
+}
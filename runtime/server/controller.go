@@ -11,9 +11,9 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	emaildriver "github.com/rilldata/rill/runtime/drivers/email"
 	"github.com/rilldata/rill/runtime/drivers/slack"
 	"github.com/rilldata/rill/runtime/pkg/observability"
-	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -399,8 +399,11 @@ func (s *Server) applySecurityPolicyReport(ctx context.Context, r *runtimev1.Res
 	for _, notifier := range report.Spec.Notifiers {
 		switch notifier.Connector {
 		case "email":
-			recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
-			for _, recipient := range recipients {
+			props, err := emaildriver.DecodeProps(notifier.Properties.AsMap())
+			if err != nil {
+				return nil, false, err
+			}
+			for _, recipient := range props.Recipients {
 				if recipient == email {
 					return r, true, nil
 				}
@@ -450,8 +453,11 @@ func (s *Server) applySecurityPolicyAlert(ctx context.Context, r *runtimev1.Reso
 	for _, notifier := range alert.Spec.Notifiers {
 		switch notifier.Connector {
 		case "email":
-			recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
-			for _, recipient := range recipients {
+			props, err := emaildriver.DecodeProps(notifier.Properties.AsMap())
+			if err != nil {
+				return nil, false, err
+			}
+			for _, recipient := range props.Recipients {
 				if recipient == email {
 					return r, true, nil
 				}
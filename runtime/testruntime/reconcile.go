@@ -158,11 +158,13 @@ func RequireResource(t testing.TB, rt *runtime.Runtime, id string, a *runtimev1.
 		state := b.GetSource().State
 		state.RefreshedOn = nil
 		state.SpecHash = ""
+		state.Progress = nil
 	case runtime.ResourceKindModel:
 		state := b.GetModel().State
 		state.RefreshedOn = nil
 		state.SpecHash = ""
 		state.RefsHash = ""
+		state.Progress = nil
 	case runtime.ResourceKindAlert:
 		state := b.GetAlert().State
 		state.SpecHash = ""
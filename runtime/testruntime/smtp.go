@@ -0,0 +1,156 @@
+package testruntime
+
+import (
+	"bufio"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+
+	"github.com/stretchr/testify/require"
+)
+
+// SMTPServer is a fake SMTP server that records the emails sent to it.
+// Configure an instance's email connector to use it with Variables.
+type SMTPServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	emails   []*SMTPEmail
+}
+
+// SMTPEmail is an email received by SMTPServer.
+type SMTPEmail struct {
+	From string
+	To   []string
+	// Data is the raw message, including headers.
+	Data string
+}
+
+// NewSMTPServer starts a fake SMTP server that is stopped when the test finishes.
+func NewSMTPServer(t TestingT) *SMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &SMTPServer{listener: listener}
+	go s.serve()
+	t.Cleanup(func() { s.listener.Close() })
+	return s
+}
+
+// Variables returns instance variables that configure the email connector to send emails to the server.
+func (s *SMTPServer) Variables() map[string]string {
+	host, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return map[string]string{
+		"connector.email.smtp_host":     host,
+		"connector.email.smtp_port":     port,
+		"connector.email.smtp_username": "test",
+		"connector.email.smtp_password": "test",
+		"connector.email.from_email":    "rill-test@rilldata.io",
+	}
+}
+
+// Emails returns the emails received so far.
+func (s *SMTPServer) Emails() []*SMTPEmail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*SMTPEmail(nil), s.emails...)
+}
+
+func (s *SMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+// handle implements the subset of SMTP used by net/smtp.SendMail.
+// It doesn't advertise STARTTLS and accepts any credentials.
+func (s *SMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	c := textproto.NewConn(conn)
+
+	reply := func(code int, msg string) bool {
+		return c.PrintfLine("%d %s", code, msg) == nil
+	}
+
+	if !reply(220, "localhost ESMTP") {
+		return
+	}
+	email := &SMTPEmail{}
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(cmd) {
+		case "EHLO", "HELO":
+			if c.PrintfLine("250-localhost") != nil || !reply(250, "AUTH PLAIN") {
+				return
+			}
+		case "AUTH":
+			if !reply(235, "Authentication successful") {
+				return
+			}
+		case "MAIL":
+			email = &SMTPEmail{From: smtpAddress(arg)}
+			if !reply(250, "OK") {
+				return
+			}
+		case "RCPT":
+			email.To = append(email.To, smtpAddress(arg))
+			if !reply(250, "OK") {
+				return
+			}
+		case "DATA":
+			if !reply(354, "Start mail input") {
+				return
+			}
+			data, err := readDotLines(c.Reader.R)
+			if err != nil {
+				return
+			}
+			email.Data = data
+			s.mu.Lock()
+			s.emails = append(s.emails, email)
+			s.mu.Unlock()
+			if !reply(250, "OK") {
+				return
+			}
+		case "QUIT":
+			reply(221, "Bye")
+			return
+		default:
+			if !reply(250, "OK") {
+				return
+			}
+		}
+	}
+}
+
+// smtpAddress extracts the address from a MAIL or RCPT argument such as "FROM:<a@example.com>".
+func smtpAddress(arg string) string {
+	_, addr, _ := strings.Cut(arg, ":")
+	addr, _, _ = strings.Cut(addr, " ")
+	return strings.Trim(addr, "<>")
+}
+
+// readDotLines reads a message terminated by a line containing a single dot.
+func readDotLines(r *bufio.Reader) (string, error) {
+	var sb strings.Builder
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "." {
+			return sb.String(), nil
+		}
+		sb.WriteString(strings.TrimPrefix(line, "."))
+		sb.WriteString("\n")
+	}
+}
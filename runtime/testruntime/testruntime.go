@@ -14,7 +14,6 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
-	"github.com/rilldata/rill/runtime/pkg/email"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
@@ -27,6 +26,7 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/file"
 	_ "github.com/rilldata/rill/runtime/drivers/gcs"
 	_ "github.com/rilldata/rill/runtime/drivers/https"
+	_ "github.com/rilldata/rill/runtime/drivers/kafka"
 	_ "github.com/rilldata/rill/runtime/drivers/postgres"
 	_ "github.com/rilldata/rill/runtime/drivers/s3"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
@@ -54,6 +54,13 @@ func New(t TestingT) *runtime.Runtime {
 				// "cache=shared" is needed to prevent threading problems.
 				Config: map[string]string{"dsn": fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())},
 			},
+			{
+				// Emails are logged unless an instance configures an SMTP server (see NewSMTPServer).
+				// The password is only set to test that the system connector's config isn't exposed to instances.
+				Type:   "email",
+				Name:   "email",
+				Config: map[string]string{"from_email": "rill-test@rilldata.io", "smtp_password": "system-secret"},
+			},
 		},
 		ConnectionCacheSize:          100,
 		QueryCacheSizeBytes:          int64(datasize.MB * 100),
@@ -68,7 +75,7 @@ func New(t TestingT) *runtime.Runtime {
 	// logger, err := zap.NewDevelopment()
 	// require.NoError(t, err)
 
-	rt, err := runtime.New(context.Background(), opts, logger, activity.NewNoopClient(), email.New(email.NewTestSender()))
+	rt, err := runtime.New(context.Background(), opts, logger, activity.NewNoopClient())
 	require.NoError(t, err)
 	t.Cleanup(func() { rt.Close() })
 
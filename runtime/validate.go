@@ -98,6 +98,13 @@ func (r *Runtime) ValidateMetricsView(ctx context.Context, instanceID string, mv
 		validateIndividualDimensionsAndMeasures(ctx, olap, t, mv, fields, res)
 	}
 
+	// Bucket dimensions are compiled to joins that are only supported for some dialects
+	for _, d := range mv.Dimensions {
+		if d.Bucket != nil && !olap.Dialect().SupportsBucketDimensions() {
+			res.OtherErrs = append(res.OtherErrs, fmt.Errorf("bucket dimension %q is not supported for dialect %q", d.Name, olap.Dialect().String()))
+		}
+	}
+
 	// Pinot does have any native support for time shift using time grain specifiers
 	if olap.Dialect() == drivers.DialectPinot && (mv.FirstDayOfWeek > 1 || mv.FirstMonthOfYear > 1) {
 		res.OtherErrs = append(res.OtherErrs, fmt.Errorf("time shift not supported for Pinot dialect, so FirstDayOfWeek and FirstMonthOfYear should be 1"))
@@ -123,13 +130,16 @@ func validateAllDimensionsAndMeasures(ctx context.Context, olap drivers.OLAPStor
 	var dimExprs []string
 	var unnestClauses []string
 	var groupIndexes []string
-	for idx, d := range mv.Dimensions {
+	for _, d := range mv.Dimensions {
+		if d.Bucket != nil { // Bucket dimensions are validated individually
+			continue
+		}
 		dimExpr, unnestClause := dialect.DimensionSelect(t.Database, t.DatabaseSchema, t.Name, d)
 		dimExprs = append(dimExprs, dimExpr)
 		if unnestClause != "" {
 			unnestClauses = append(unnestClauses, unnestClause)
 		}
-		groupIndexes = append(groupIndexes, strconv.Itoa(idx+1))
+		groupIndexes = append(groupIndexes, strconv.Itoa(len(dimExprs)))
 	}
 	var metricExprs []string
 	for _, m := range mv.Measures {
@@ -235,6 +245,22 @@ func validateIndividualDimensionsAndMeasures(ctx context.Context, olap drivers.O
 
 // validateDimension validates a metrics view dimension.
 func validateDimension(ctx context.Context, olap drivers.OLAPStore, t *drivers.Table, d *runtimev1.MetricsViewSpec_DimensionV2, fields map[string]*runtimev1.StructType_Field) error {
+	// Bucket dimensions reference other dimensions and measures, so we only need to validate the bucket's filter
+	if d.Bucket != nil {
+		if d.Bucket.Where == "" {
+			return nil
+		}
+		dialect := olap.Dialect()
+		err := olap.Exec(ctx, &drivers.Statement{
+			Query:  fmt.Sprintf("SELECT 1 FROM %s WHERE %s", dialect.EscapeTable(t.Database, t.DatabaseSchema, t.Name), d.Bucket.Where),
+			DryRun: true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to validate bucket filter for dimension %q: %w", d.Name, err)
+		}
+		return nil
+	}
+
 	// Validate with a simple check if it's a column
 	if d.Column != "" {
 		if _, isColumn := fields[strings.ToLower(d.Column)]; !isColumn {
@@ -74,6 +74,8 @@ import type {
   AdminServiceCreateProjectWhitelistedDomainBodyBody,
   V1RemoveProjectWhitelistedDomainResponse,
   V1ListWhitelistedDomainsResponse,
+  V1ListAuditEventsResponse,
+  AdminServiceListAuditEventsParams,
   V1CreateWhitelistedDomainResponse,
   V1RemoveWhitelistedDomainResponse,
   V1ListProjectsForOrganizationResponse,
@@ -139,6 +141,37 @@ import type {
   V1UpdateUserPreferencesRequest,
   V1SearchUsersResponse,
   AdminServiceSearchUsersParams,
+  V1ListProjectMemberUsergroupsResponse,
+  AdminServiceListProjectMemberUsergroupsParams,
+  V1RemoveProjectMemberUsergroupResponse,
+  V1AddProjectMemberUsergroupResponse,
+  V1SetProjectMemberUsergroupRoleResponse,
+  V1ListOrganizationMemberUsergroupsResponse,
+  AdminServiceListOrganizationMemberUsergroupsParams,
+  V1CreateUsergroupResponse,
+  AdminServiceCreateUsergroupBodyBody,
+  V1GetUsergroupResponse,
+  V1DeleteUsergroupResponse,
+  V1RenameUsergroupResponse,
+  V1RemoveOrganizationMemberUsergroupResponse,
+  V1AddOrganizationMemberUsergroupResponse,
+  V1SetOrganizationMemberUsergroupRoleResponse,
+  V1ListUsergroupMemberUsersResponse,
+  AdminServiceListUsergroupMemberUsersParams,
+  V1RemoveUsergroupMemberUserResponse,
+  V1AddUsergroupMemberUserResponse,
+  V1ListProjectRolesResponse,
+  V1CreateProjectRoleResponse,
+  AdminServiceCreateProjectRoleBody,
+  V1DeleteProjectRoleResponse,
+  V1UpdateProjectRoleResponse,
+  AdminServiceUpdateProjectRoleBody,
+  V1ListOrganizationRolesResponse,
+  V1CreateOrganizationRoleResponse,
+  AdminServiceCreateOrganizationRoleBody,
+  V1DeleteOrganizationRoleResponse,
+  V1UpdateOrganizationRoleResponse,
+  AdminServiceUpdateOrganizationRoleBody,
 } from "../index.schemas";
 import { httpClient } from "../../http-client";
 
@@ -987,6 +1020,231 @@ export const createAdminServiceLeaveOrganization = <
     TContext
   >(mutationFn, mutationOptions);
 };
+/**
+ * @summary ListProjectRoles lists the built-in and custom project roles available in the organization's projects
+ */
+export const adminServiceListProjectRoles = (
+  organization: string,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListProjectRolesResponse>({
+    url: `/v1/organizations/${organization}/project-roles`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceListProjectRolesQueryKey = (
+  organization: string,
+) => [`/v1/organizations/${organization}/project-roles`];
+
+export type AdminServiceListProjectRolesQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectRoles>>
+>;
+export type AdminServiceListProjectRolesQueryError = RpcStatus;
+
+export const createAdminServiceListProjectRoles = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectRoles>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListProjectRoles>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListProjectRolesQueryKey(organization);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListProjectRoles>>
+  > = ({ signal }) => adminServiceListProjectRoles(organization, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListProjectRoles>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organization,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary CreateProjectRole creates a custom project role in the organization
+ */
+export const adminServiceCreateProjectRole = (
+  organization: string,
+  adminServiceCreateProjectRoleBody: AdminServiceCreateProjectRoleBody,
+) => {
+  return httpClient<V1CreateProjectRoleResponse>({
+    url: `/v1/organizations/${organization}/project-roles`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateProjectRoleBody,
+  });
+};
+
+export type AdminServiceCreateProjectRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateProjectRole>>
+>;
+export type AdminServiceCreateProjectRoleMutationBody =
+  AdminServiceCreateProjectRoleBody;
+export type AdminServiceCreateProjectRoleMutationError = RpcStatus;
+
+export const createAdminServiceCreateProjectRole = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateProjectRole>>,
+    TError,
+    { organization: string; data: AdminServiceCreateProjectRoleBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateProjectRole>>,
+    { organization: string; data: AdminServiceCreateProjectRoleBody }
+  > = (props) => {
+    const { organization, data } = props ?? {};
+
+    return adminServiceCreateProjectRole(organization, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceCreateProjectRole>>,
+    TError,
+    { organization: string; data: AdminServiceCreateProjectRoleBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary DeleteProjectRole deletes a custom project role that is not assigned to anyone
+ */
+export const adminServiceDeleteProjectRole = (
+  organization: string,
+  role: string,
+) => {
+  return httpClient<V1DeleteProjectRoleResponse>({
+    url: `/v1/organizations/${organization}/project-roles/${role}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceDeleteProjectRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteProjectRole>>
+>;
+
+export type AdminServiceDeleteProjectRoleMutationError = RpcStatus;
+
+export const createAdminServiceDeleteProjectRole = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteProjectRole>>,
+    TError,
+    { organization: string; role: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDeleteProjectRole>>,
+    { organization: string; role: string }
+  > = (props) => {
+    const { organization, role } = props ?? {};
+
+    return adminServiceDeleteProjectRole(organization, role);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceDeleteProjectRole>>,
+    TError,
+    { organization: string; role: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary UpdateProjectRole updates a custom project role
+ */
+export const adminServiceUpdateProjectRole = (
+  organization: string,
+  role: string,
+  adminServiceUpdateProjectRoleBody: AdminServiceUpdateProjectRoleBody,
+) => {
+  return httpClient<V1UpdateProjectRoleResponse>({
+    url: `/v1/organizations/${organization}/project-roles/${role}`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceUpdateProjectRoleBody,
+  });
+};
+
+export type AdminServiceUpdateProjectRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUpdateProjectRole>>
+>;
+export type AdminServiceUpdateProjectRoleMutationBody =
+  AdminServiceUpdateProjectRoleBody;
+export type AdminServiceUpdateProjectRoleMutationError = RpcStatus;
+
+export const createAdminServiceUpdateProjectRole = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceUpdateProjectRole>>,
+    TError,
+    {
+      organization: string;
+      role: string;
+      data: AdminServiceUpdateProjectRoleBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceUpdateProjectRole>>,
+    {
+      organization: string;
+      role: string;
+      data: AdminServiceUpdateProjectRoleBody;
+    }
+  > = (props) => {
+    const { organization, role, data } = props ?? {};
+
+    return adminServiceUpdateProjectRole(organization, role, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceUpdateProjectRole>>,
+    TError,
+    {
+      organization: string;
+      role: string;
+      data: AdminServiceUpdateProjectRoleBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary CreateAlert adds a virtual file for an alert, triggers a reconcile, and waits for the alert to be added to the runtime catalog
  */
@@ -2365,39 +2623,46 @@ export const createAdminServiceSearchProjectUsers = <
 };
 
 /**
- * @summary ListWhitelistedDomains lists all the whitelisted domains of the project
+ * @summary ListProjectMemberUsergroups lists the user groups that have a role in the project
  */
-export const adminServiceListProjectWhitelistedDomains = (
+export const adminServiceListProjectMemberUsergroups = (
   organization: string,
   project: string,
+  params?: AdminServiceListProjectMemberUsergroupsParams,
   signal?: AbortSignal,
 ) => {
-  return httpClient<V1ListProjectWhitelistedDomainsResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/whitelisted`,
+  return httpClient<V1ListProjectMemberUsergroupsResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/usergroups`,
     method: "get",
+    params,
     signal,
   });
 };
 
-export const getAdminServiceListProjectWhitelistedDomainsQueryKey = (
+export const getAdminServiceListProjectMemberUsergroupsQueryKey = (
   organization: string,
   project: string,
-) => [`/v1/organizations/${organization}/projects/${project}/whitelisted`];
+  params?: AdminServiceListProjectMemberUsergroupsParams,
+) => [
+  `/v1/organizations/${organization}/projects/${project}/usergroups`,
+  ...(params ? [params] : []),
+];
 
-export type AdminServiceListProjectWhitelistedDomainsQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceListProjectWhitelistedDomains>>
+export type AdminServiceListProjectMemberUsergroupsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>
 >;
-export type AdminServiceListProjectWhitelistedDomainsQueryError = RpcStatus;
+export type AdminServiceListProjectMemberUsergroupsQueryError = RpcStatus;
 
-export const createAdminServiceListProjectWhitelistedDomains = <
-  TData = Awaited<ReturnType<typeof adminServiceListProjectWhitelistedDomains>>,
+export const createAdminServiceListProjectMemberUsergroups = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>,
   TError = RpcStatus,
 >(
   organization: string,
   project: string,
+  params?: AdminServiceListProjectMemberUsergroupsParams,
   options?: {
     query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceListProjectWhitelistedDomains>>,
+      Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>,
       TError,
       TData
     >;
@@ -2407,15 +2672,24 @@ export const createAdminServiceListProjectWhitelistedDomains = <
 
   const queryKey =
     queryOptions?.queryKey ??
-    getAdminServiceListProjectWhitelistedDomainsQueryKey(organization, project);
+    getAdminServiceListProjectMemberUsergroupsQueryKey(
+      organization,
+      project,
+      params,
+    );
 
   const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceListProjectWhitelistedDomains>>
+    Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>
   > = ({ signal }) =>
-    adminServiceListProjectWhitelistedDomains(organization, project, signal);
+    adminServiceListProjectMemberUsergroups(
+      organization,
+      project,
+      params,
+      signal,
+    );
 
   const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceListProjectWhitelistedDomains>>,
+    Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>,
     TError,
     TData
   >({
@@ -2431,128 +2705,1322 @@ export const createAdminServiceListProjectWhitelistedDomains = <
 };
 
 /**
- * @summary CreateProjectWhitelistedDomain adds a domain to the project's whitelisted
+ * @summary RemoveProjectMemberUsergroup revokes the role of a user group in the project
  */
-export const adminServiceCreateProjectWhitelistedDomain = (
+export const adminServiceRemoveProjectMemberUsergroup = (
   organization: string,
   project: string,
-  adminServiceCreateProjectWhitelistedDomainBodyBody: AdminServiceCreateProjectWhitelistedDomainBodyBody,
+  usergroup: string,
 ) => {
-  return httpClient<V1CreateProjectWhitelistedDomainResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/whitelisted`,
-    method: "post",
-    headers: { "Content-Type": "application/json" },
-    data: adminServiceCreateProjectWhitelistedDomainBodyBody,
+  return httpClient<V1RemoveProjectMemberUsergroupResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/usergroups/${usergroup}/roles`,
+    method: "delete",
   });
 };
 
-export type AdminServiceCreateProjectWhitelistedDomainMutationResult =
+export type AdminServiceRemoveProjectMemberUsergroupMutationResult =
   NonNullable<
-    Awaited<ReturnType<typeof adminServiceCreateProjectWhitelistedDomain>>
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMemberUsergroup>>
   >;
-export type AdminServiceCreateProjectWhitelistedDomainMutationBody =
-  AdminServiceCreateProjectWhitelistedDomainBodyBody;
-export type AdminServiceCreateProjectWhitelistedDomainMutationError = RpcStatus;
 
-export const createAdminServiceCreateProjectWhitelistedDomain = <
+export type AdminServiceRemoveProjectMemberUsergroupMutationError = RpcStatus;
+
+export const createAdminServiceRemoveProjectMemberUsergroup = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceCreateProjectWhitelistedDomain>>,
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMemberUsergroup>>,
     TError,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceCreateProjectWhitelistedDomainBodyBody;
-    },
+    { organization: string; project: string; usergroup: string },
     TContext
   >;
 }) => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceCreateProjectWhitelistedDomain>>,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceCreateProjectWhitelistedDomainBodyBody;
-    }
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMemberUsergroup>>,
+    { organization: string; project: string; usergroup: string }
   > = (props) => {
-    const { organization, project, data } = props ?? {};
+    const { organization, project, usergroup } = props ?? {};
 
-    return adminServiceCreateProjectWhitelistedDomain(
+    return adminServiceRemoveProjectMemberUsergroup(
       organization,
       project,
-      data,
+      usergroup,
     );
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceCreateProjectWhitelistedDomain>>,
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMemberUsergroup>>,
     TError,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceCreateProjectWhitelistedDomainBodyBody;
-    },
+    { organization: string; project: string; usergroup: string },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary RemoveProjectWhitelistedDomain removes a domain from the project's whitelisted
+ * @summary AddProjectMemberUsergroup grants a user group a role in the project
  */
-export const adminServiceRemoveProjectWhitelistedDomain = (
+export const adminServiceAddProjectMemberUsergroup = (
   organization: string,
   project: string,
-  domain: string,
+  usergroup: string,
+  adminServiceSetOrganizationMemberRoleBodyBody: AdminServiceSetOrganizationMemberRoleBodyBody,
 ) => {
-  return httpClient<V1RemoveProjectWhitelistedDomainResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/whitelisted/${domain}`,
-    method: "delete",
+  return httpClient<V1AddProjectMemberUsergroupResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/usergroups/${usergroup}/roles`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceSetOrganizationMemberRoleBodyBody,
   });
 };
 
-export type AdminServiceRemoveProjectWhitelistedDomainMutationResult =
-  NonNullable<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectWhitelistedDomain>>
-  >;
-
-export type AdminServiceRemoveProjectWhitelistedDomainMutationError = RpcStatus;
+export type AdminServiceAddProjectMemberUsergroupMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceAddProjectMemberUsergroup>>
+>;
+export type AdminServiceAddProjectMemberUsergroupMutationBody =
+  AdminServiceSetOrganizationMemberRoleBodyBody;
+export type AdminServiceAddProjectMemberUsergroupMutationError = RpcStatus;
 
-export const createAdminServiceRemoveProjectWhitelistedDomain = <
+export const createAdminServiceAddProjectMemberUsergroup = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectWhitelistedDomain>>,
+    Awaited<ReturnType<typeof adminServiceAddProjectMemberUsergroup>>,
     TError,
-    { organization: string; project: string; domain: string },
+    {
+      organization: string;
+      project: string;
+      usergroup: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    },
     TContext
   >;
 }) => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectWhitelistedDomain>>,
-    { organization: string; project: string; domain: string }
+    Awaited<ReturnType<typeof adminServiceAddProjectMemberUsergroup>>,
+    {
+      organization: string;
+      project: string;
+      usergroup: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    }
   > = (props) => {
-    const { organization, project, domain } = props ?? {};
+    const { organization, project, usergroup, data } = props ?? {};
 
-    return adminServiceRemoveProjectWhitelistedDomain(
+    return adminServiceAddProjectMemberUsergroup(
       organization,
       project,
-      domain,
+      usergroup,
+      data,
     );
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectWhitelistedDomain>>,
+    Awaited<ReturnType<typeof adminServiceAddProjectMemberUsergroup>>,
     TError,
-    { organization: string; project: string; domain: string },
-    TContext
-  >(mutationFn, mutationOptions);
-};
+    {
+      organization: string;
+      project: string;
+      usergroup: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary SetProjectMemberUsergroupRole sets the role of a user group in the project
+ */
+export const adminServiceSetProjectMemberUsergroupRole = (
+  organization: string,
+  project: string,
+  usergroup: string,
+  adminServiceSetOrganizationMemberRoleBodyBody: AdminServiceSetOrganizationMemberRoleBodyBody,
+) => {
+  return httpClient<V1SetProjectMemberUsergroupRoleResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/usergroups/${usergroup}/roles`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceSetOrganizationMemberRoleBodyBody,
+  });
+};
+
+export type AdminServiceSetProjectMemberUsergroupRoleMutationResult =
+  NonNullable<
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberUsergroupRole>>
+  >;
+export type AdminServiceSetProjectMemberUsergroupRoleMutationBody =
+  AdminServiceSetOrganizationMemberRoleBodyBody;
+export type AdminServiceSetProjectMemberUsergroupRoleMutationError = RpcStatus;
+
+export const createAdminServiceSetProjectMemberUsergroupRole = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberUsergroupRole>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      usergroup: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberUsergroupRole>>,
+    {
+      organization: string;
+      project: string;
+      usergroup: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, usergroup, data } = props ?? {};
+
+    return adminServiceSetProjectMemberUsergroupRole(
+      organization,
+      project,
+      usergroup,
+      data,
+    );
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberUsergroupRole>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      usergroup: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary ListWhitelistedDomains lists all the whitelisted domains of the project
+ */
+export const adminServiceListProjectWhitelistedDomains = (
+  organization: string,
+  project: string,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListProjectWhitelistedDomainsResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/whitelisted`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceListProjectWhitelistedDomainsQueryKey = (
+  organization: string,
+  project: string,
+) => [`/v1/organizations/${organization}/projects/${project}/whitelisted`];
+
+export type AdminServiceListProjectWhitelistedDomainsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectWhitelistedDomains>>
+>;
+export type AdminServiceListProjectWhitelistedDomainsQueryError = RpcStatus;
+
+export const createAdminServiceListProjectWhitelistedDomains = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectWhitelistedDomains>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  project: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListProjectWhitelistedDomains>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListProjectWhitelistedDomainsQueryKey(organization, project);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListProjectWhitelistedDomains>>
+  > = ({ signal }) =>
+    adminServiceListProjectWhitelistedDomains(organization, project, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListProjectWhitelistedDomains>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary CreateProjectWhitelistedDomain adds a domain to the project's whitelisted
+ */
+export const adminServiceCreateProjectWhitelistedDomain = (
+  organization: string,
+  project: string,
+  adminServiceCreateProjectWhitelistedDomainBodyBody: AdminServiceCreateProjectWhitelistedDomainBodyBody,
+) => {
+  return httpClient<V1CreateProjectWhitelistedDomainResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/whitelisted`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateProjectWhitelistedDomainBodyBody,
+  });
+};
+
+export type AdminServiceCreateProjectWhitelistedDomainMutationResult =
+  NonNullable<
+    Awaited<ReturnType<typeof adminServiceCreateProjectWhitelistedDomain>>
+  >;
+export type AdminServiceCreateProjectWhitelistedDomainMutationBody =
+  AdminServiceCreateProjectWhitelistedDomainBodyBody;
+export type AdminServiceCreateProjectWhitelistedDomainMutationError = RpcStatus;
+
+export const createAdminServiceCreateProjectWhitelistedDomain = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateProjectWhitelistedDomain>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateProjectWhitelistedDomainBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateProjectWhitelistedDomain>>,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateProjectWhitelistedDomainBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, data } = props ?? {};
+
+    return adminServiceCreateProjectWhitelistedDomain(
+      organization,
+      project,
+      data,
+    );
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceCreateProjectWhitelistedDomain>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateProjectWhitelistedDomainBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary RemoveProjectWhitelistedDomain removes a domain from the project's whitelisted
+ */
+export const adminServiceRemoveProjectWhitelistedDomain = (
+  organization: string,
+  project: string,
+  domain: string,
+) => {
+  return httpClient<V1RemoveProjectWhitelistedDomainResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/whitelisted/${domain}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceRemoveProjectWhitelistedDomainMutationResult =
+  NonNullable<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectWhitelistedDomain>>
+  >;
+
+export type AdminServiceRemoveProjectWhitelistedDomainMutationError = RpcStatus;
+
+export const createAdminServiceRemoveProjectWhitelistedDomain = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectWhitelistedDomain>>,
+    TError,
+    { organization: string; project: string; domain: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectWhitelistedDomain>>,
+    { organization: string; project: string; domain: string }
+  > = (props) => {
+    const { organization, project, domain } = props ?? {};
+
+    return adminServiceRemoveProjectWhitelistedDomain(
+      organization,
+      project,
+      domain,
+    );
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectWhitelistedDomain>>,
+    TError,
+    { organization: string; project: string; domain: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary ListOrganizationRoles lists the built-in and custom org roles available in the organization
+ */
+export const adminServiceListOrganizationRoles = (
+  organization: string,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListOrganizationRolesResponse>({
+    url: `/v1/organizations/${organization}/roles`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceListOrganizationRolesQueryKey = (
+  organization: string,
+) => [`/v1/organizations/${organization}/roles`];
+
+export type AdminServiceListOrganizationRolesQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListOrganizationRoles>>
+>;
+export type AdminServiceListOrganizationRolesQueryError = RpcStatus;
+
+export const createAdminServiceListOrganizationRoles = <
+  TData = Awaited<ReturnType<typeof adminServiceListOrganizationRoles>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListOrganizationRoles>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListOrganizationRolesQueryKey(organization);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListOrganizationRoles>>
+  > = ({ signal }) => adminServiceListOrganizationRoles(organization, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListOrganizationRoles>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organization,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary CreateOrganizationRole creates a custom org role in the organization
+ */
+export const adminServiceCreateOrganizationRole = (
+  organization: string,
+  adminServiceCreateOrganizationRoleBody: AdminServiceCreateOrganizationRoleBody,
+) => {
+  return httpClient<V1CreateOrganizationRoleResponse>({
+    url: `/v1/organizations/${organization}/roles`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateOrganizationRoleBody,
+  });
+};
+
+export type AdminServiceCreateOrganizationRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateOrganizationRole>>
+>;
+export type AdminServiceCreateOrganizationRoleMutationBody =
+  AdminServiceCreateOrganizationRoleBody;
+export type AdminServiceCreateOrganizationRoleMutationError = RpcStatus;
+
+export const createAdminServiceCreateOrganizationRole = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateOrganizationRole>>,
+    TError,
+    { organization: string; data: AdminServiceCreateOrganizationRoleBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateOrganizationRole>>,
+    { organization: string; data: AdminServiceCreateOrganizationRoleBody }
+  > = (props) => {
+    const { organization, data } = props ?? {};
+
+    return adminServiceCreateOrganizationRole(organization, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceCreateOrganizationRole>>,
+    TError,
+    { organization: string; data: AdminServiceCreateOrganizationRoleBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary DeleteOrganizationRole deletes a custom org role that is not assigned to anyone
+ */
+export const adminServiceDeleteOrganizationRole = (
+  organization: string,
+  role: string,
+) => {
+  return httpClient<V1DeleteOrganizationRoleResponse>({
+    url: `/v1/organizations/${organization}/roles/${role}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceDeleteOrganizationRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteOrganizationRole>>
+>;
+
+export type AdminServiceDeleteOrganizationRoleMutationError = RpcStatus;
+
+export const createAdminServiceDeleteOrganizationRole = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteOrganizationRole>>,
+    TError,
+    { organization: string; role: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDeleteOrganizationRole>>,
+    { organization: string; role: string }
+  > = (props) => {
+    const { organization, role } = props ?? {};
+
+    return adminServiceDeleteOrganizationRole(organization, role);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceDeleteOrganizationRole>>,
+    TError,
+    { organization: string; role: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary UpdateOrganizationRole updates a custom org role
+ */
+export const adminServiceUpdateOrganizationRole = (
+  organization: string,
+  role: string,
+  adminServiceUpdateOrganizationRoleBody: AdminServiceUpdateOrganizationRoleBody,
+) => {
+  return httpClient<V1UpdateOrganizationRoleResponse>({
+    url: `/v1/organizations/${organization}/roles/${role}`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceUpdateOrganizationRoleBody,
+  });
+};
+
+export type AdminServiceUpdateOrganizationRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUpdateOrganizationRole>>
+>;
+export type AdminServiceUpdateOrganizationRoleMutationBody =
+  AdminServiceUpdateOrganizationRoleBody;
+export type AdminServiceUpdateOrganizationRoleMutationError = RpcStatus;
+
+export const createAdminServiceUpdateOrganizationRole = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceUpdateOrganizationRole>>,
+    TError,
+    {
+      organization: string;
+      role: string;
+      data: AdminServiceUpdateOrganizationRoleBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceUpdateOrganizationRole>>,
+    {
+      organization: string;
+      role: string;
+      data: AdminServiceUpdateOrganizationRoleBody;
+    }
+  > = (props) => {
+    const { organization, role, data } = props ?? {};
+
+    return adminServiceUpdateOrganizationRole(organization, role, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceUpdateOrganizationRole>>,
+    TError,
+    {
+      organization: string;
+      role: string;
+      data: AdminServiceUpdateOrganizationRoleBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary ListOrganizationMemberUsergroups lists all the user groups in the organization and their org role (if any)
+ */
+export const adminServiceListOrganizationMemberUsergroups = (
+  organization: string,
+  params?: AdminServiceListOrganizationMemberUsergroupsParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListOrganizationMemberUsergroupsResponse>({
+    url: `/v1/organizations/${organization}/usergroups`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListOrganizationMemberUsergroupsQueryKey = (
+  organization: string,
+  params?: AdminServiceListOrganizationMemberUsergroupsParams,
+) => [
+  `/v1/organizations/${organization}/usergroups`,
+  ...(params ? [params] : []),
+];
+
+export type AdminServiceListOrganizationMemberUsergroupsQueryResult =
+  NonNullable<
+    Awaited<ReturnType<typeof adminServiceListOrganizationMemberUsergroups>>
+  >;
+export type AdminServiceListOrganizationMemberUsergroupsQueryError = RpcStatus;
+
+export const createAdminServiceListOrganizationMemberUsergroups = <
+  TData = Awaited<
+    ReturnType<typeof adminServiceListOrganizationMemberUsergroups>
+  >,
+  TError = RpcStatus,
+>(
+  organization: string,
+  params?: AdminServiceListOrganizationMemberUsergroupsParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListOrganizationMemberUsergroups>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListOrganizationMemberUsergroupsQueryKey(
+      organization,
+      params,
+    );
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListOrganizationMemberUsergroups>>
+  > = ({ signal }) =>
+    adminServiceListOrganizationMemberUsergroups(organization, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListOrganizationMemberUsergroups>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organization,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary CreateUsergroup creates a user group in the organization
+ */
+export const adminServiceCreateUsergroup = (
+  organization: string,
+  adminServiceCreateUsergroupBodyBody: AdminServiceCreateUsergroupBodyBody,
+) => {
+  return httpClient<V1CreateUsergroupResponse>({
+    url: `/v1/organizations/${organization}/usergroups`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateUsergroupBodyBody,
+  });
+};
+
+export type AdminServiceCreateUsergroupMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateUsergroup>>
+>;
+export type AdminServiceCreateUsergroupMutationBody =
+  AdminServiceCreateUsergroupBodyBody;
+export type AdminServiceCreateUsergroupMutationError = RpcStatus;
+
+export const createAdminServiceCreateUsergroup = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateUsergroup>>,
+    TError,
+    { organization: string; data: AdminServiceCreateUsergroupBodyBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateUsergroup>>,
+    { organization: string; data: AdminServiceCreateUsergroupBodyBody }
+  > = (props) => {
+    const { organization, data } = props ?? {};
+
+    return adminServiceCreateUsergroup(organization, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceCreateUsergroup>>,
+    TError,
+    { organization: string; data: AdminServiceCreateUsergroupBodyBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary GetUsergroup returns a user group in the organization
+ */
+export const adminServiceGetUsergroup = (
+  organization: string,
+  usergroup: string,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1GetUsergroupResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceGetUsergroupQueryKey = (
+  organization: string,
+  usergroup: string,
+) => [`/v1/organizations/${organization}/usergroups/${usergroup}`];
+
+export type AdminServiceGetUsergroupQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetUsergroup>>
+>;
+export type AdminServiceGetUsergroupQueryError = RpcStatus;
+
+export const createAdminServiceGetUsergroup = <
+  TData = Awaited<ReturnType<typeof adminServiceGetUsergroup>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  usergroup: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetUsergroup>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetUsergroupQueryKey(organization, usergroup);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetUsergroup>>
+  > = ({ signal }) => adminServiceGetUsergroup(organization, usergroup, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceGetUsergroup>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && usergroup),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary DeleteUsergroup deletes a user group
+ */
+export const adminServiceDeleteUsergroup = (
+  organization: string,
+  usergroup: string,
+) => {
+  return httpClient<V1DeleteUsergroupResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceDeleteUsergroupMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteUsergroup>>
+>;
+
+export type AdminServiceDeleteUsergroupMutationError = RpcStatus;
+
+export const createAdminServiceDeleteUsergroup = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteUsergroup>>,
+    TError,
+    { organization: string; usergroup: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDeleteUsergroup>>,
+    { organization: string; usergroup: string }
+  > = (props) => {
+    const { organization, usergroup } = props ?? {};
+
+    return adminServiceDeleteUsergroup(organization, usergroup);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceDeleteUsergroup>>,
+    TError,
+    { organization: string; usergroup: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary RenameUsergroup renames a user group
+ */
+export const adminServiceRenameUsergroup = (
+  organization: string,
+  usergroup: string,
+  adminServiceCreateUsergroupBodyBody: AdminServiceCreateUsergroupBodyBody,
+) => {
+  return httpClient<V1RenameUsergroupResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}`,
+    method: "patch",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateUsergroupBodyBody,
+  });
+};
+
+export type AdminServiceRenameUsergroupMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRenameUsergroup>>
+>;
+export type AdminServiceRenameUsergroupMutationBody =
+  AdminServiceCreateUsergroupBodyBody;
+export type AdminServiceRenameUsergroupMutationError = RpcStatus;
+
+export const createAdminServiceRenameUsergroup = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRenameUsergroup>>,
+    TError,
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceCreateUsergroupBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRenameUsergroup>>,
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceCreateUsergroupBodyBody;
+    }
+  > = (props) => {
+    const { organization, usergroup, data } = props ?? {};
+
+    return adminServiceRenameUsergroup(organization, usergroup, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceRenameUsergroup>>,
+    TError,
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceCreateUsergroupBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary RemoveOrganizationMemberUsergroup revokes the role of a user group in the organization
+ */
+export const adminServiceRemoveOrganizationMemberUsergroup = (
+  organization: string,
+  usergroup: string,
+) => {
+  return httpClient<V1RemoveOrganizationMemberUsergroupResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}/roles`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceRemoveOrganizationMemberUsergroupMutationResult =
+  NonNullable<
+    Awaited<ReturnType<typeof adminServiceRemoveOrganizationMemberUsergroup>>
+  >;
+
+export type AdminServiceRemoveOrganizationMemberUsergroupMutationError = RpcStatus;
+
+export const createAdminServiceRemoveOrganizationMemberUsergroup = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRemoveOrganizationMemberUsergroup>>,
+    TError,
+    { organization: string; usergroup: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRemoveOrganizationMemberUsergroup>>,
+    { organization: string; usergroup: string }
+  > = (props) => {
+    const { organization, usergroup } = props ?? {};
+
+    return adminServiceRemoveOrganizationMemberUsergroup(
+      organization,
+      usergroup,
+    );
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceRemoveOrganizationMemberUsergroup>>,
+    TError,
+    { organization: string; usergroup: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary AddOrganizationMemberUsergroup grants a user group a role in the organization
+ */
+export const adminServiceAddOrganizationMemberUsergroup = (
+  organization: string,
+  usergroup: string,
+  adminServiceSetOrganizationMemberRoleBodyBody: AdminServiceSetOrganizationMemberRoleBodyBody,
+) => {
+  return httpClient<V1AddOrganizationMemberUsergroupResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}/roles`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceSetOrganizationMemberRoleBodyBody,
+  });
+};
+
+export type AdminServiceAddOrganizationMemberUsergroupMutationResult =
+  NonNullable<
+    Awaited<ReturnType<typeof adminServiceAddOrganizationMemberUsergroup>>
+  >;
+export type AdminServiceAddOrganizationMemberUsergroupMutationBody =
+  AdminServiceSetOrganizationMemberRoleBodyBody;
+export type AdminServiceAddOrganizationMemberUsergroupMutationError = RpcStatus;
+
+export const createAdminServiceAddOrganizationMemberUsergroup = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceAddOrganizationMemberUsergroup>>,
+    TError,
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceAddOrganizationMemberUsergroup>>,
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    }
+  > = (props) => {
+    const { organization, usergroup, data } = props ?? {};
+
+    return adminServiceAddOrganizationMemberUsergroup(
+      organization,
+      usergroup,
+      data,
+    );
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceAddOrganizationMemberUsergroup>>,
+    TError,
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary SetOrganizationMemberUsergroupRole sets the role of a user group in the organization
+ */
+export const adminServiceSetOrganizationMemberUsergroupRole = (
+  organization: string,
+  usergroup: string,
+  adminServiceSetOrganizationMemberRoleBodyBody: AdminServiceSetOrganizationMemberRoleBodyBody,
+) => {
+  return httpClient<V1SetOrganizationMemberUsergroupRoleResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}/roles`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceSetOrganizationMemberRoleBodyBody,
+  });
+};
+
+export type AdminServiceSetOrganizationMemberUsergroupRoleMutationResult =
+  NonNullable<
+    Awaited<ReturnType<typeof adminServiceSetOrganizationMemberUsergroupRole>>
+  >;
+export type AdminServiceSetOrganizationMemberUsergroupRoleMutationBody =
+  AdminServiceSetOrganizationMemberRoleBodyBody;
+export type AdminServiceSetOrganizationMemberUsergroupRoleMutationError = RpcStatus;
+
+export const createAdminServiceSetOrganizationMemberUsergroupRole = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceSetOrganizationMemberUsergroupRole>>,
+    TError,
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceSetOrganizationMemberUsergroupRole>>,
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    }
+  > = (props) => {
+    const { organization, usergroup, data } = props ?? {};
+
+    return adminServiceSetOrganizationMemberUsergroupRole(
+      organization,
+      usergroup,
+      data,
+    );
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceSetOrganizationMemberUsergroupRole>>,
+    TError,
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary ListUsergroupMemberUsers lists the users in a user group
+ */
+export const adminServiceListUsergroupMemberUsers = (
+  organization: string,
+  usergroup: string,
+  params?: AdminServiceListUsergroupMemberUsersParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListUsergroupMemberUsersResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}/users`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListUsergroupMemberUsersQueryKey = (
+  organization: string,
+  usergroup: string,
+  params?: AdminServiceListUsergroupMemberUsersParams,
+) => [
+  `/v1/organizations/${organization}/usergroups/${usergroup}/users`,
+  ...(params ? [params] : []),
+];
+
+export type AdminServiceListUsergroupMemberUsersQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListUsergroupMemberUsers>>
+>;
+export type AdminServiceListUsergroupMemberUsersQueryError = RpcStatus;
+
+export const createAdminServiceListUsergroupMemberUsers = <
+  TData = Awaited<ReturnType<typeof adminServiceListUsergroupMemberUsers>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  usergroup: string,
+  params?: AdminServiceListUsergroupMemberUsersParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListUsergroupMemberUsers>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListUsergroupMemberUsersQueryKey(
+      organization,
+      usergroup,
+      params,
+    );
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListUsergroupMemberUsers>>
+  > = ({ signal }) =>
+    adminServiceListUsergroupMemberUsers(
+      organization,
+      usergroup,
+      params,
+      signal,
+    );
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListUsergroupMemberUsers>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && usergroup),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary RemoveUsergroupMemberUser removes a user from a user group
+ */
+export const adminServiceRemoveUsergroupMemberUser = (
+  organization: string,
+  usergroup: string,
+  email: string,
+) => {
+  return httpClient<V1RemoveUsergroupMemberUserResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}/users/${email}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceRemoveUsergroupMemberUserMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRemoveUsergroupMemberUser>>
+>;
+
+export type AdminServiceRemoveUsergroupMemberUserMutationError = RpcStatus;
+
+export const createAdminServiceRemoveUsergroupMemberUser = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRemoveUsergroupMemberUser>>,
+    TError,
+    { organization: string; usergroup: string; email: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRemoveUsergroupMemberUser>>,
+    { organization: string; usergroup: string; email: string }
+  > = (props) => {
+    const { organization, usergroup, email } = props ?? {};
+
+    return adminServiceRemoveUsergroupMemberUser(
+      organization,
+      usergroup,
+      email,
+    );
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceRemoveUsergroupMemberUser>>,
+    TError,
+    { organization: string; usergroup: string; email: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary AddUsergroupMemberUser adds an organization member to a user group
+ */
+export const adminServiceAddUsergroupMemberUser = (
+  organization: string,
+  usergroup: string,
+  email: string,
+  adminServiceTriggerReconcileBodyBody: AdminServiceTriggerReconcileBodyBody,
+) => {
+  return httpClient<V1AddUsergroupMemberUserResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}/users/${email}`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceTriggerReconcileBodyBody,
+  });
+};
+
+export type AdminServiceAddUsergroupMemberUserMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceAddUsergroupMemberUser>>
+>;
+export type AdminServiceAddUsergroupMemberUserMutationBody =
+  AdminServiceTriggerReconcileBodyBody;
+export type AdminServiceAddUsergroupMemberUserMutationError = RpcStatus;
+
+export const createAdminServiceAddUsergroupMemberUser = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceAddUsergroupMemberUser>>,
+    TError,
+    {
+      organization: string;
+      usergroup: string;
+      email: string;
+      data: AdminServiceTriggerReconcileBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceAddUsergroupMemberUser>>,
+    {
+      organization: string;
+      usergroup: string;
+      email: string;
+      data: AdminServiceTriggerReconcileBodyBody;
+    }
+  > = (props) => {
+    const { organization, usergroup, email, data } = props ?? {};
+
+    return adminServiceAddUsergroupMemberUser(
+      organization,
+      usergroup,
+      email,
+      data,
+    );
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceAddUsergroupMemberUser>>,
+    TError,
+    {
+      organization: string;
+      usergroup: string;
+      email: string;
+      data: AdminServiceTriggerReconcileBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary ListWhitelistedDomains lists all the whitelisted domains for the organization
  */
@@ -2615,6 +4083,73 @@ export const createAdminServiceListWhitelistedDomains = <
   return query;
 };
 
+/**
+ * @summary ListAuditEvents lists the audit log of member, role, variable, service and domain changes in an organization, most recent first
+ */
+export const adminServiceListAuditEvents = (
+  organization: string,
+  params?: AdminServiceListAuditEventsParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListAuditEventsResponse>({
+    url: `/v1/organizations/${organization}/audit`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListAuditEventsQueryKey = (
+  organization: string,
+  params?: AdminServiceListAuditEventsParams,
+) => [`/v1/organizations/${organization}/audit`, ...(params ? [params] : [])];
+
+export type AdminServiceListAuditEventsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListAuditEvents>>
+>;
+export type AdminServiceListAuditEventsQueryError = RpcStatus;
+
+export const createAdminServiceListAuditEvents = <
+  TData = Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  params?: AdminServiceListAuditEventsParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListAuditEventsQueryKey(organization, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListAuditEvents>>
+  > = ({ signal }) =>
+    adminServiceListAuditEvents(organization, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organization,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
 /**
  * @summary CreateWhitelistedDomain adds a domain to the whitelist
  */
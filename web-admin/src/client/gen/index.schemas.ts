@@ -86,9 +86,16 @@ export type AdminServiceUpdateProjectBody = {
   newName?: string;
   prodTtlSeconds?: string;
   prodVersion?: string;
+  previewDeployments?: boolean;
 };
 
-export type AdminServiceGetProjectParams = { accessTokenTtlSeconds?: number };
+export type AdminServiceGetProjectParams = {
+  accessTokenTtlSeconds?: number;
+  /**
+   * Branch to get a deployment for. If not set, the production branch is used. For other branches, the jwt is issued for the branch's preview deployment.
+   */
+  branch?: string;
+};
 
 export type AdminServiceCreateProjectBodyVariables = { [key: string]: string };
 
@@ -112,6 +119,31 @@ export type AdminServiceListProjectsForOrganizationParams = {
   pageToken?: string;
 };
 
+export type AdminServiceListUsergroupMemberUsersParams = {
+  pageSize?: number;
+  pageToken?: string;
+};
+
+export type AdminServiceListOrganizationMemberUsergroupsParams = {
+  pageSize?: number;
+  pageToken?: string;
+};
+
+export type AdminServiceUpdateOrganizationRoleBody = {
+  newName?: string;
+  permissions?: V1OrganizationPermissions;
+};
+
+export type AdminServiceCreateOrganizationRoleBody = {
+  name?: string;
+  permissions?: V1OrganizationPermissions;
+};
+
+export type AdminServiceListProjectMemberUsergroupsParams = {
+  pageSize?: number;
+  pageToken?: string;
+};
+
 export type AdminServiceSearchProjectUsersParams = {
   emailQuery?: string;
   pageSize?: number;
@@ -178,6 +210,41 @@ export type AdminServiceGetDeploymentCredentialsBody = {
   attributes?: AdminServiceGetDeploymentCredentialsBodyAttributes;
 };
 
+export type AdminServiceUpdateProjectRoleBody = {
+  newName?: string;
+  permissions?: V1ProjectPermissions;
+};
+
+export type AdminServiceCreateProjectRoleBody = {
+  name?: string;
+  permissions?: V1ProjectPermissions;
+};
+
+export type AdminServiceListAuditEventsParams = {
+  /**
+   * Only list events for the project with this name.
+   */
+  project?: string;
+  /**
+   * Only list events made by the user with this email or the service with this name.
+   */
+  actor?: string;
+  /**
+   * Only list events with this action, such as "project.member.add".
+   */
+  action?: string;
+  /**
+   * Only list events recorded at or after this time.
+   */
+  after?: string;
+  /**
+   * Only list events recorded before this time.
+   */
+  before?: string;
+  pageSize?: number;
+  pageToken?: string;
+};
+
 export type AdminServiceRemoveOrganizationMemberParams = {
   keepProjectRoles?: boolean;
 };
@@ -230,6 +297,10 @@ export type AdminServiceSetOrganizationMemberRoleBodyBody = {
   role?: string;
 };
 
+export type AdminServiceCreateUsergroupBodyBody = {
+  name?: string;
+};
+
 export type AdminServiceTriggerReconcileBodyBody = { [key: string]: any };
 
 export interface V1WhitelistedDomain {
@@ -244,6 +315,16 @@ export interface V1VirtualFile {
   updatedOn?: string;
 }
 
+export interface V1Usergroup {
+  groupId?: string;
+  groupName?: string;
+  /** True for the group that all members of the organization are automatically added to.
+Its name and members can't be changed. */
+  managed?: boolean;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
 export interface V1UserQuotas {
   singleuserOrgs?: number;
 }
@@ -288,10 +369,18 @@ export interface V1UpdateProjectVariablesResponse {
   variables?: V1UpdateProjectVariablesResponseVariables;
 }
 
+export interface V1UpdateProjectRoleResponse {
+  role?: V1ProjectRole;
+}
+
 export interface V1UpdateProjectResponse {
   project?: V1Project;
 }
 
+export interface V1UpdateOrganizationRoleResponse {
+  role?: V1OrganizationRole;
+}
+
 export interface V1UpdateOrganizationResponse {
   organization?: V1Organization;
 }
@@ -392,10 +481,18 @@ export interface V1SetSuperuserRequest {
   superuser?: boolean;
 }
 
+export interface V1SetProjectMemberUsergroupRoleResponse {
+  [key: string]: any;
+}
+
 export interface V1SetProjectMemberRoleResponse {
   [key: string]: any;
 }
 
+export interface V1SetOrganizationMemberUsergroupRoleResponse {
+  [key: string]: any;
+}
+
 export interface V1SetOrganizationMemberRoleResponse {
   [key: string]: any;
 }
@@ -454,18 +551,34 @@ export interface V1ReportOptions {
   slackWebhooks?: string[];
 }
 
+export interface V1RenameUsergroupResponse {
+  usergroup?: V1Usergroup;
+}
+
 export interface V1RemoveWhitelistedDomainResponse {
   [key: string]: any;
 }
 
+export interface V1RemoveUsergroupMemberUserResponse {
+  [key: string]: any;
+}
+
 export interface V1RemoveProjectWhitelistedDomainResponse {
   [key: string]: any;
 }
 
+export interface V1RemoveProjectMemberUsergroupResponse {
+  [key: string]: any;
+}
+
 export interface V1RemoveProjectMemberResponse {
   [key: string]: any;
 }
 
+export interface V1RemoveOrganizationMemberUsergroupResponse {
+  [key: string]: any;
+}
+
 export interface V1RemoveOrganizationMemberResponse {
   [key: string]: any;
 }
@@ -489,6 +602,16 @@ export interface V1PullVirtualRepoResponse {
   nextPageToken?: string;
 }
 
+export interface V1ProjectRole {
+  id?: string;
+  name?: string;
+  /** True for the built-in roles (admin, collaborator and viewer), which are available in all organizations and can't be changed. */
+  builtin?: boolean;
+  permissions?: V1ProjectPermissions;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
 export interface V1ProjectPermissions {
   readProject?: boolean;
   manageProject?: boolean;
@@ -528,6 +651,8 @@ export interface V1Project {
   prodTtlSeconds?: string;
   annotations?: V1ProjectAnnotations;
   prodVersion?: string;
+  /** If true, a preview deployment is created for every pull request opened against the project's Github repository. */
+  previewDeployments?: boolean;
   createdOn?: string;
   updatedOn?: string;
 }
@@ -537,6 +662,16 @@ export interface V1PingResponse {
   time?: string;
 }
 
+export interface V1OrganizationRole {
+  id?: string;
+  name?: string;
+  /** True for the built-in roles (admin, collaborator and viewer), which are available in all organizations and can't be changed. */
+  builtin?: boolean;
+  permissions?: V1OrganizationPermissions;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
 export interface V1OrganizationQuotas {
   projects?: number;
   deployments?: number;
@@ -564,6 +699,26 @@ export interface V1Organization {
   updatedOn?: string;
 }
 
+export interface V1MemberUsergroup {
+  groupId?: string;
+  groupName?: string;
+  /** Role of the group in the org or project. Empty if the group is listed for an org but has no org role. */
+  roleName?: string;
+  managed?: boolean;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
+/**
+ * MemberUser is a user in a user group.
+ */
+export interface V1MemberUser {
+  userId?: string;
+  userEmail?: string;
+  userName?: string;
+  createdOn?: string;
+}
+
 export interface V1Member {
   userId?: string;
   userEmail?: string;
@@ -577,6 +732,11 @@ export interface V1ListWhitelistedDomainsResponse {
   domains?: V1WhitelistedDomain[];
 }
 
+export interface V1ListUsergroupMemberUsersResponse {
+  members?: V1MemberUser[];
+  nextPageToken?: string;
+}
+
 export interface V1ListSuperusersResponse {
   users?: V1User[];
 }
@@ -598,11 +758,20 @@ export interface V1ListProjectWhitelistedDomainsResponse {
   domains?: V1WhitelistedDomain[];
 }
 
+export interface V1ListProjectRolesResponse {
+  roles?: V1ProjectRole[];
+}
+
 export interface V1ListProjectMembersResponse {
   members?: V1Member[];
   nextPageToken?: string;
 }
 
+export interface V1ListProjectMemberUsergroupsResponse {
+  members?: V1MemberUsergroup[];
+  nextPageToken?: string;
+}
+
 export interface V1ListProjectInvitesResponse {
   invites?: V1UserInvite[];
   nextPageToken?: string;
@@ -613,11 +782,20 @@ export interface V1ListOrganizationsResponse {
   nextPageToken?: string;
 }
 
+export interface V1ListOrganizationRolesResponse {
+  roles?: V1OrganizationRole[];
+}
+
 export interface V1ListOrganizationMembersResponse {
   members?: V1Member[];
   nextPageToken?: string;
 }
 
+export interface V1ListOrganizationMemberUsergroupsResponse {
+  members?: V1MemberUsergroup[];
+  nextPageToken?: string;
+}
+
 export interface V1ListOrganizationInvitesResponse {
   invites?: V1UserInvite[];
   nextPageToken?: string;
@@ -627,6 +805,11 @@ export interface V1ListBookmarksResponse {
   bookmarks?: V1Bookmark[];
 }
 
+export interface V1ListAuditEventsResponse {
+  events?: V1AuditEvent[];
+  nextPageToken?: string;
+}
+
 export interface V1LeaveOrganizationResponse {
   [key: string]: any;
 }
@@ -654,14 +837,24 @@ export const V1GithubPermission = {
   GITHUB_PERMISSION_WRITE: "GITHUB_PERMISSION_WRITE",
 } as const;
 
+export interface V1GetUsergroupResponse {
+  usergroup?: V1Usergroup;
+}
+
 export interface V1GetUserResponse {
   user?: V1User;
 }
 
+/**
+ * Security attributes of the report's owner, if any. Used when attaching exported data to notifications.
+ */
+export type V1GetReportMetaResponseQueryForAttributes = { [key: string]: any };
+
 export interface V1GetReportMetaResponse {
   openUrl?: string;
   exportUrl?: string;
   editUrl?: string;
+  queryForAttributes?: V1GetReportMetaResponseQueryForAttributes;
 }
 
 export interface V1GetRepoMetaResponse {
@@ -679,6 +872,7 @@ export interface V1GetProjectVariablesResponse {
 export interface V1GetProjectResponse {
   project?: V1Project;
   prodDeployment?: V1Deployment;
+  previewDeployment?: V1Deployment;
   jwt?: string;
   projectPermissions?: V1ProjectPermissions;
 }
@@ -802,6 +996,14 @@ export interface V1Deployment {
   statusMessage?: string;
   createdOn?: string;
   updatedOn?: string;
+  /** Number of the Github pull request for preview deployments. It's 0 for the prod deployment. */
+  pullRequest?: string;
+  /** Time when a preview deployment is torn down if its pull request is still open. */
+  expiresOn?: string;
+}
+
+export interface V1DeleteUsergroupResponse {
+  [key: string]: any;
 }
 
 export interface V1DeleteServiceResponse {
@@ -812,10 +1014,18 @@ export interface V1DeleteReportResponse {
   [key: string]: any;
 }
 
+export interface V1DeleteProjectRoleResponse {
+  [key: string]: any;
+}
+
 export interface V1DeleteProjectResponse {
   [key: string]: any;
 }
 
+export interface V1DeleteOrganizationRoleResponse {
+  [key: string]: any;
+}
+
 export interface V1DeleteOrganizationResponse {
   [key: string]: any;
 }
@@ -828,6 +1038,10 @@ export interface V1CreateWhitelistedDomainResponse {
   [key: string]: any;
 }
 
+export interface V1CreateUsergroupResponse {
+  usergroup?: V1Usergroup;
+}
+
 export interface V1CreateServiceResponse {
   service?: V1Service;
 }
@@ -840,10 +1054,18 @@ export interface V1CreateProjectWhitelistedDomainResponse {
   [key: string]: any;
 }
 
+export interface V1CreateProjectRoleResponse {
+  role?: V1ProjectRole;
+}
+
 export interface V1CreateProjectResponse {
   project?: V1Project;
 }
 
+export interface V1CreateOrganizationRoleResponse {
+  role?: V1OrganizationRole;
+}
+
 export interface V1CreateOrganizationResponse {
   organization?: V1Organization;
 }
@@ -900,6 +1122,25 @@ export interface V1Bookmark {
   updatedOn?: string;
 }
 
+export type V1AuditEventDetails = { [key: string]: any };
+
+export interface V1AuditEvent {
+  id?: string;
+  projectId?: string;
+  projectName?: string;
+  /** Type of the actor that made the change. One of "user", "service", "deployment" or "system". */
+  actorType?: string;
+  actorId?: string;
+  /** Email of the user or name of the service that made the change. */
+  actorName?: string;
+  actorSuperuser?: boolean;
+  action?: string;
+  /** The member email, service name, domain or other identifier of what was changed. */
+  target?: string;
+  details?: V1AuditEventDetails;
+  createdOn?: string;
+}
+
 export interface V1AlertOptions {
   title?: string;
   intervalDuration?: string;
@@ -914,10 +1155,22 @@ export interface V1AlertOptions {
   slackWebhooks?: string[];
 }
 
+export interface V1AddUsergroupMemberUserResponse {
+  [key: string]: any;
+}
+
+export interface V1AddProjectMemberUsergroupResponse {
+  [key: string]: any;
+}
+
 export interface V1AddProjectMemberResponse {
   pendingSignup?: boolean;
 }
 
+export interface V1AddOrganizationMemberUsergroupResponse {
+  [key: string]: any;
+}
+
 export interface V1AddOrganizationMemberResponse {
   pendingSignup?: boolean;
 }
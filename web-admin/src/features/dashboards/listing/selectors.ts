@@ -8,6 +8,7 @@ import {
   PollTimeWhenProjectDeploymentError,
   PollTimeWhenProjectDeploymentPending,
 } from "@rilldata/web-admin/features/projects/status/selectors";
+import { getProjectDeployment } from "@rilldata/web-admin/features/projects/selectors";
 import { useValidDashboards } from "@rilldata/web-common/features/dashboards/selectors";
 import { refreshResource } from "@rilldata/web-common/features/entity-management/resource-invalidations";
 import { ResourceKind } from "@rilldata/web-common/features/entity-management/resource-selectors";
@@ -34,12 +35,13 @@ export async function getDashboardsForProject(
   projectData: V1GetProjectResponse,
 ): Promise<V1Resource[]> {
   // There may not be a prodDeployment if the project was hibernated
-  if (!projectData.prodDeployment) {
+  const deployment = getProjectDeployment(projectData);
+  if (!deployment) {
     return [];
   }
 
   // Hack: in development, the runtime host is actually on port 8081
-  const runtimeHost = projectData.prodDeployment.runtimeHost.replace(
+  const runtimeHost = deployment.runtimeHost.replace(
     "localhost:9091",
     "localhost:8081",
   );
@@ -53,7 +55,7 @@ export async function getDashboardsForProject(
 
   // TODO: use resource API
   const catalogEntriesResponse = await axios.get(
-    `/v1/instances/${projectData.prodDeployment.runtimeInstanceId}/resources?kind=${ResourceKind.MetricsView}`,
+    `/v1/instances/${deployment.runtimeInstanceId}/resources?kind=${ResourceKind.MetricsView}`,
   );
 
   const catalogEntries = catalogEntriesResponse.data?.resources as V1Resource[];
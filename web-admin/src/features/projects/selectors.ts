@@ -1,6 +1,7 @@
 import {
   createAdminServiceGetProject,
   createAdminServiceListProjectMembers,
+  type V1GetProjectResponse,
 } from "@rilldata/web-admin/client";
 import { RUNTIME_ACCESS_TOKEN_DEFAULT_TTL } from "@rilldata/web-common/runtime-client/constants";
 
@@ -28,34 +29,73 @@ export function getProjectPermissions(orgName: string, projName: string) {
  * stores. Then, we can update the JWT independently from the runtime's instanceID. This will prevent
  * unnecessary query cancellations and refetches.
  */
-export function getProjectRuntimeQueryKey(orgName: string, projName: string) {
-  return ["projectRuntime", orgName, projName];
+export function getProjectRuntimeQueryKey(
+  orgName: string,
+  projName: string,
+  branch?: string,
+) {
+  return branch
+    ? ["projectRuntime", orgName, projName, branch]
+    : ["projectRuntime", orgName, projName];
 }
 
-export function useProjectRuntime(orgName: string, projName: string) {
-  return createAdminServiceGetProject(orgName, projName, undefined, {
-    query: {
-      queryKey: getProjectRuntimeQueryKey(orgName, projName),
-      // Proactively refetch the JWT before it expires
-      refetchInterval: RUNTIME_ACCESS_TOKEN_DEFAULT_TTL / 2,
-      select: (data) => {
-        // There may not be a prodDeployment if the project was hibernated
-        if (!data.prodDeployment) {
-          return;
-        }
+/**
+ * Returns the branch of the preview deployment to view, which is set with the `branch` query param.
+ * Github's status checks for pull requests link to their previews with it.
+ */
+export function getProjectBranch(url: URL): string | undefined {
+  return url.searchParams.get("branch") || undefined;
+}
+
+/**
+ * Returns the params of `GetProject` requests for the given branch.
+ * If no branch is set, the project's prod deployment is returned.
+ */
+export function getProjectParams(branch?: string) {
+  return branch ? { branch } : undefined;
+}
+
+/**
+ * Returns the deployment of a `GetProject` response, which is the preview deployment if a branch was requested.
+ */
+export function getProjectDeployment(data: V1GetProjectResponse | undefined) {
+  return data?.prodDeployment ?? data?.previewDeployment;
+}
 
-        return {
-          // Hack: in development, the runtime host is actually on port 8081
-          host: data.prodDeployment.runtimeHost.replace(
-            "localhost:9091",
-            "localhost:8081",
-          ),
-          instanceId: data.prodDeployment.runtimeInstanceId,
-          jwt: data?.jwt,
-        };
+export function useProjectRuntime(
+  orgName: string,
+  projName: string,
+  branch?: string,
+) {
+  return createAdminServiceGetProject(
+    orgName,
+    projName,
+    getProjectParams(branch),
+    {
+      query: {
+        queryKey: getProjectRuntimeQueryKey(orgName, projName, branch),
+        // Proactively refetch the JWT before it expires
+        refetchInterval: RUNTIME_ACCESS_TOKEN_DEFAULT_TTL / 2,
+        select: (data) => {
+          // There may not be a prodDeployment if the project was hibernated
+          const deployment = getProjectDeployment(data);
+          if (!deployment) {
+            return;
+          }
+
+          return {
+            // Hack: in development, the runtime host is actually on port 8081
+            host: deployment.runtimeHost.replace(
+              "localhost:9091",
+              "localhost:8081",
+            ),
+            instanceId: deployment.runtimeInstanceId,
+            jwt: data?.jwt,
+          };
+        },
       },
     },
-  });
+  );
 }
 
 export function useProjectMembersEmails(organization: string, project: string) {
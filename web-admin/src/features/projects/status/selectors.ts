@@ -2,22 +2,30 @@ import {
   V1DeploymentStatus,
   createAdminServiceGetProject,
 } from "@rilldata/web-admin/client";
+import {
+  getProjectDeployment,
+  getProjectParams,
+} from "@rilldata/web-admin/features/projects/selectors";
 
 export const PollTimeWhenProjectDeploymentPending = 1000;
 export const PollTimeWhenProjectDeploymentError = 5000;
 export const PollTimeWhenProjectDeployed = 60 * 1000;
 
-export function useProjectDeploymentStatus(orgName: string, projName: string) {
+export function useProjectDeploymentStatus(
+  orgName: string,
+  projName: string,
+  branch?: string,
+) {
   return createAdminServiceGetProject<V1DeploymentStatus>(
     orgName,
     projName,
-    undefined,
+    getProjectParams(branch),
     {
       query: {
         select: (data) => {
           // There may not be a prodDeployment if the project is hibernating
           return (
-            data?.prodDeployment?.status ||
+            getProjectDeployment(data)?.status ||
             V1DeploymentStatus.DEPLOYMENT_STATUS_UNSPECIFIED
           );
         },
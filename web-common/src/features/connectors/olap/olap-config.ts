@@ -1,4 +1,9 @@
-export const OLAP_DRIVERS_WITHOUT_MODELING = ["clickhouse", "druid", "pinot"];
+export const OLAP_DRIVERS_WITHOUT_MODELING = [
+  "clickhouse",
+  "druid",
+  "pinot",
+  "postgres",
+];
 
 export function makeFullyQualifiedTableName(
   driver: string,
@@ -15,6 +20,8 @@ export function makeFullyQualifiedTableName(
       return `${database}.${databaseSchema}.${table}`;
     case "pinot":
       return table;
+    case "postgres":
+      return `${databaseSchema}.${table}`;
     default:
       throw new Error(`Unsupported OLAP connector: ${driver}`);
   }
@@ -46,6 +53,9 @@ export function makeSufficientlyQualifiedTableName(
     case "pinot":
       // TODO
       return table;
+    case "postgres":
+      if (databaseSchema === "public") return table;
+      return `${databaseSchema}.${table}`;
     default:
       throw new Error(`Unsupported OLAP connector: ${driver}`);
   }
@@ -67,6 +77,8 @@ export function makeTablePreviewHref(
       return `/connector/duckdb/${connectorName}/${database}/${databaseSchema}/${table}`;
     case "pinot":
       return `/connector/pinot/${connectorName}/${table}`;
+    case "postgres":
+      return `/connector/postgres/${connectorName}/${databaseSchema}/${table}`;
     default:
       throw new Error(`Unsupported connector: ${driver}`);
   }
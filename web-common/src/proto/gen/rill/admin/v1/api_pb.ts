@@ -650,6 +650,13 @@ export class GetProjectRequest extends Message<GetProjectRequest> {
    */
   accessTokenTtlSeconds = 0;
 
+  /**
+   * Branch to get a deployment for. If not set, the production branch is used. For other branches, the jwt is issued for the branch's preview deployment.
+   *
+   * @generated from field: string branch = 4;
+   */
+  branch = "";
+
   constructor(data?: PartialMessage<GetProjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -661,6 +668,7 @@ export class GetProjectRequest extends Message<GetProjectRequest> {
     { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "access_token_ttl_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProjectRequest {
@@ -694,6 +702,11 @@ export class GetProjectResponse extends Message<GetProjectResponse> {
    */
   prodDeployment?: Deployment;
 
+  /**
+   * @generated from field: rill.admin.v1.Deployment preview_deployment = 5;
+   */
+  previewDeployment?: Deployment;
+
   /**
    * @generated from field: string jwt = 3;
    */
@@ -714,6 +727,7 @@ export class GetProjectResponse extends Message<GetProjectResponse> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "message", T: Project },
     { no: 2, name: "prod_deployment", kind: "message", T: Deployment },
+    { no: 5, name: "preview_deployment", kind: "message", T: Deployment },
     { no: 3, name: "jwt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "project_permissions", kind: "message", T: ProjectPermissions },
   ]);
@@ -1960,6 +1974,11 @@ export class UpdateProjectRequest extends Message<UpdateProjectRequest> {
    */
   prodVersion?: string;
 
+  /**
+   * @generated from field: optional bool preview_deployments = 12;
+   */
+  previewDeployments?: boolean;
+
   constructor(data?: PartialMessage<UpdateProjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1979,6 +1998,7 @@ export class UpdateProjectRequest extends Message<UpdateProjectRequest> {
     { no: 9, name: "new_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 10, name: "prod_ttl_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
     { no: 11, name: "prod_version", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 12, name: "preview_deployments", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProjectRequest {
@@ -3853,4265 +3873,6667 @@ export class SetProjectMemberRoleResponse extends Message<SetProjectMemberRoleRe
 }
 
 /**
- * @generated from message rill.admin.v1.GetCurrentUserRequest
+ * @generated from message rill.admin.v1.CreateUsergroupRequest
  */
-export class GetCurrentUserRequest extends Message<GetCurrentUserRequest> {
-  constructor(data?: PartialMessage<GetCurrentUserRequest>) {
+export class CreateUsergroupRequest extends Message<CreateUsergroupRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<CreateUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetCurrentUserRequest";
+  static readonly typeName = "rill.admin.v1.CreateUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserRequest {
-    return new GetCurrentUserRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
-    return new GetCurrentUserRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
-    return new GetCurrentUserRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined, b: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined): boolean {
-    return proto3.util.equals(GetCurrentUserRequest, a, b);
+  static equals(a: CreateUsergroupRequest | PlainMessage<CreateUsergroupRequest> | undefined, b: CreateUsergroupRequest | PlainMessage<CreateUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(CreateUsergroupRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetCurrentUserResponse
+ * @generated from message rill.admin.v1.CreateUsergroupResponse
  */
-export class GetCurrentUserResponse extends Message<GetCurrentUserResponse> {
-  /**
-   * @generated from field: rill.admin.v1.User user = 1;
-   */
-  user?: User;
-
+export class CreateUsergroupResponse extends Message<CreateUsergroupResponse> {
   /**
-   * @generated from field: rill.admin.v1.UserPreferences preferences = 2;
+   * @generated from field: rill.admin.v1.Usergroup usergroup = 1;
    */
-  preferences?: UserPreferences;
+  usergroup?: Usergroup;
 
-  constructor(data?: PartialMessage<GetCurrentUserResponse>) {
+  constructor(data?: PartialMessage<CreateUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetCurrentUserResponse";
+  static readonly typeName = "rill.admin.v1.CreateUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "user", kind: "message", T: User },
-    { no: 2, name: "preferences", kind: "message", T: UserPreferences },
+    { no: 1, name: "usergroup", kind: "message", T: Usergroup },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserResponse {
-    return new GetCurrentUserResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
-    return new GetCurrentUserResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
-    return new GetCurrentUserResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined, b: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined): boolean {
-    return proto3.util.equals(GetCurrentUserResponse, a, b);
+  static equals(a: CreateUsergroupResponse | PlainMessage<CreateUsergroupResponse> | undefined, b: CreateUsergroupResponse | PlainMessage<CreateUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(CreateUsergroupResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetUserRequest
+ * @generated from message rill.admin.v1.GetUsergroupRequest
  */
-export class GetUserRequest extends Message<GetUserRequest> {
+export class GetUsergroupRequest extends Message<GetUsergroupRequest> {
   /**
-   * @generated from field: string email = 1;
+   * @generated from field: string organization = 1;
    */
-  email = "";
+  organization = "";
 
-  constructor(data?: PartialMessage<GetUserRequest>) {
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  constructor(data?: PartialMessage<GetUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetUserRequest";
+  static readonly typeName = "rill.admin.v1.GetUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserRequest {
-    return new GetUserRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUsergroupRequest {
+    return new GetUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserRequest {
-    return new GetUserRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUsergroupRequest {
+    return new GetUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserRequest {
-    return new GetUserRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUsergroupRequest {
+    return new GetUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetUserRequest | PlainMessage<GetUserRequest> | undefined, b: GetUserRequest | PlainMessage<GetUserRequest> | undefined): boolean {
-    return proto3.util.equals(GetUserRequest, a, b);
+  static equals(a: GetUsergroupRequest | PlainMessage<GetUsergroupRequest> | undefined, b: GetUsergroupRequest | PlainMessage<GetUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(GetUsergroupRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetUserResponse
+ * @generated from message rill.admin.v1.GetUsergroupResponse
  */
-export class GetUserResponse extends Message<GetUserResponse> {
+export class GetUsergroupResponse extends Message<GetUsergroupResponse> {
   /**
-   * @generated from field: rill.admin.v1.User user = 1;
+   * @generated from field: rill.admin.v1.Usergroup usergroup = 1;
    */
-  user?: User;
+  usergroup?: Usergroup;
 
-  constructor(data?: PartialMessage<GetUserResponse>) {
+  constructor(data?: PartialMessage<GetUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetUserResponse";
+  static readonly typeName = "rill.admin.v1.GetUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "user", kind: "message", T: User },
+    { no: 1, name: "usergroup", kind: "message", T: Usergroup },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserResponse {
-    return new GetUserResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUsergroupResponse {
+    return new GetUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserResponse {
-    return new GetUserResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUsergroupResponse {
+    return new GetUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserResponse {
-    return new GetUserResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUsergroupResponse {
+    return new GetUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetUserResponse | PlainMessage<GetUserResponse> | undefined, b: GetUserResponse | PlainMessage<GetUserResponse> | undefined): boolean {
-    return proto3.util.equals(GetUserResponse, a, b);
+  static equals(a: GetUsergroupResponse | PlainMessage<GetUsergroupResponse> | undefined, b: GetUsergroupResponse | PlainMessage<GetUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(GetUsergroupResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.UserPreferences
+ * @generated from message rill.admin.v1.RenameUsergroupRequest
  */
-export class UserPreferences extends Message<UserPreferences> {
+export class RenameUsergroupRequest extends Message<RenameUsergroupRequest> {
   /**
-   * @generated from field: optional string time_zone = 1;
+   * @generated from field: string organization = 1;
    */
-  timeZone?: string;
+  organization = "";
 
-  constructor(data?: PartialMessage<UserPreferences>) {
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<RenameUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.UserPreferences";
+  static readonly typeName = "rill.admin.v1.RenameUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UserPreferences {
-    return new UserPreferences().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameUsergroupRequest {
+    return new RenameUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UserPreferences {
-    return new UserPreferences().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameUsergroupRequest {
+    return new RenameUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UserPreferences {
-    return new UserPreferences().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameUsergroupRequest {
+    return new RenameUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UserPreferences | PlainMessage<UserPreferences> | undefined, b: UserPreferences | PlainMessage<UserPreferences> | undefined): boolean {
-    return proto3.util.equals(UserPreferences, a, b);
+  static equals(a: RenameUsergroupRequest | PlainMessage<RenameUsergroupRequest> | undefined, b: RenameUsergroupRequest | PlainMessage<RenameUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(RenameUsergroupRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.UpdateUserPreferencesRequest
+ * @generated from message rill.admin.v1.RenameUsergroupResponse
  */
-export class UpdateUserPreferencesRequest extends Message<UpdateUserPreferencesRequest> {
+export class RenameUsergroupResponse extends Message<RenameUsergroupResponse> {
   /**
-   * @generated from field: rill.admin.v1.UserPreferences preferences = 1;
+   * @generated from field: rill.admin.v1.Usergroup usergroup = 1;
    */
-  preferences?: UserPreferences;
+  usergroup?: Usergroup;
 
-  constructor(data?: PartialMessage<UpdateUserPreferencesRequest>) {
+  constructor(data?: PartialMessage<RenameUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.UpdateUserPreferencesRequest";
+  static readonly typeName = "rill.admin.v1.RenameUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "preferences", kind: "message", T: UserPreferences },
+    { no: 1, name: "usergroup", kind: "message", T: Usergroup },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateUserPreferencesRequest {
-    return new UpdateUserPreferencesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameUsergroupResponse {
+    return new RenameUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateUserPreferencesRequest {
-    return new UpdateUserPreferencesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameUsergroupResponse {
+    return new RenameUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateUserPreferencesRequest {
-    return new UpdateUserPreferencesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameUsergroupResponse {
+    return new RenameUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateUserPreferencesRequest | PlainMessage<UpdateUserPreferencesRequest> | undefined, b: UpdateUserPreferencesRequest | PlainMessage<UpdateUserPreferencesRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateUserPreferencesRequest, a, b);
+  static equals(a: RenameUsergroupResponse | PlainMessage<RenameUsergroupResponse> | undefined, b: RenameUsergroupResponse | PlainMessage<RenameUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(RenameUsergroupResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.UpdateUserPreferencesResponse
+ * @generated from message rill.admin.v1.DeleteUsergroupRequest
  */
-export class UpdateUserPreferencesResponse extends Message<UpdateUserPreferencesResponse> {
+export class DeleteUsergroupRequest extends Message<DeleteUsergroupRequest> {
   /**
-   * @generated from field: rill.admin.v1.UserPreferences preferences = 1;
+   * @generated from field: string organization = 1;
    */
-  preferences?: UserPreferences;
+  organization = "";
 
-  constructor(data?: PartialMessage<UpdateUserPreferencesResponse>) {
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  constructor(data?: PartialMessage<DeleteUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.UpdateUserPreferencesResponse";
+  static readonly typeName = "rill.admin.v1.DeleteUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "preferences", kind: "message", T: UserPreferences },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateUserPreferencesResponse {
-    return new UpdateUserPreferencesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteUsergroupRequest {
+    return new DeleteUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateUserPreferencesResponse {
-    return new UpdateUserPreferencesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteUsergroupRequest {
+    return new DeleteUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateUserPreferencesResponse {
-    return new UpdateUserPreferencesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteUsergroupRequest {
+    return new DeleteUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateUserPreferencesResponse | PlainMessage<UpdateUserPreferencesResponse> | undefined, b: UpdateUserPreferencesResponse | PlainMessage<UpdateUserPreferencesResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateUserPreferencesResponse, a, b);
+  static equals(a: DeleteUsergroupRequest | PlainMessage<DeleteUsergroupRequest> | undefined, b: DeleteUsergroupRequest | PlainMessage<DeleteUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteUsergroupRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListBookmarksRequest
+ * @generated from message rill.admin.v1.DeleteUsergroupResponse
  */
-export class ListBookmarksRequest extends Message<ListBookmarksRequest> {
-  /**
-   * @generated from field: string project_id = 1;
-   */
-  projectId = "";
-
-  /**
-   * @generated from field: string resource_kind = 2;
-   */
-  resourceKind = "";
-
-  /**
-   * @generated from field: string resource_name = 3;
-   */
-  resourceName = "";
-
-  constructor(data?: PartialMessage<ListBookmarksRequest>) {
+export class DeleteUsergroupResponse extends Message<DeleteUsergroupResponse> {
+  constructor(data?: PartialMessage<DeleteUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListBookmarksRequest";
+  static readonly typeName = "rill.admin.v1.DeleteUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "resource_kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "resource_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBookmarksRequest {
-    return new ListBookmarksRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteUsergroupResponse {
+    return new DeleteUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBookmarksRequest {
-    return new ListBookmarksRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteUsergroupResponse {
+    return new DeleteUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBookmarksRequest {
-    return new ListBookmarksRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteUsergroupResponse {
+    return new DeleteUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListBookmarksRequest | PlainMessage<ListBookmarksRequest> | undefined, b: ListBookmarksRequest | PlainMessage<ListBookmarksRequest> | undefined): boolean {
-    return proto3.util.equals(ListBookmarksRequest, a, b);
+  static equals(a: DeleteUsergroupResponse | PlainMessage<DeleteUsergroupResponse> | undefined, b: DeleteUsergroupResponse | PlainMessage<DeleteUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteUsergroupResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListBookmarksResponse
+ * @generated from message rill.admin.v1.ListOrganizationMemberUsergroupsRequest
  */
-export class ListBookmarksResponse extends Message<ListBookmarksResponse> {
+export class ListOrganizationMemberUsergroupsRequest extends Message<ListOrganizationMemberUsergroupsRequest> {
   /**
-   * @generated from field: repeated rill.admin.v1.Bookmark bookmarks = 1;
+   * @generated from field: string organization = 1;
    */
-  bookmarks: Bookmark[] = [];
+  organization = "";
 
-  constructor(data?: PartialMessage<ListBookmarksResponse>) {
+  /**
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationMemberUsergroupsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListBookmarksResponse";
+  static readonly typeName = "rill.admin.v1.ListOrganizationMemberUsergroupsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "bookmarks", kind: "message", T: Bookmark, repeated: true },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBookmarksResponse {
-    return new ListBookmarksResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationMemberUsergroupsRequest {
+    return new ListOrganizationMemberUsergroupsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBookmarksResponse {
-    return new ListBookmarksResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationMemberUsergroupsRequest {
+    return new ListOrganizationMemberUsergroupsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBookmarksResponse {
-    return new ListBookmarksResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationMemberUsergroupsRequest {
+    return new ListOrganizationMemberUsergroupsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListBookmarksResponse | PlainMessage<ListBookmarksResponse> | undefined, b: ListBookmarksResponse | PlainMessage<ListBookmarksResponse> | undefined): boolean {
-    return proto3.util.equals(ListBookmarksResponse, a, b);
+  static equals(a: ListOrganizationMemberUsergroupsRequest | PlainMessage<ListOrganizationMemberUsergroupsRequest> | undefined, b: ListOrganizationMemberUsergroupsRequest | PlainMessage<ListOrganizationMemberUsergroupsRequest> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationMemberUsergroupsRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetBookmarkRequest
+ * @generated from message rill.admin.v1.ListOrganizationMemberUsergroupsResponse
  */
-export class GetBookmarkRequest extends Message<GetBookmarkRequest> {
+export class ListOrganizationMemberUsergroupsResponse extends Message<ListOrganizationMemberUsergroupsResponse> {
   /**
-   * @generated from field: string bookmark_id = 1;
+   * @generated from field: repeated rill.admin.v1.MemberUsergroup members = 1;
    */
-  bookmarkId = "";
+  members: MemberUsergroup[] = [];
 
-  constructor(data?: PartialMessage<GetBookmarkRequest>) {
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationMemberUsergroupsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetBookmarkRequest";
+  static readonly typeName = "rill.admin.v1.ListOrganizationMemberUsergroupsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "bookmark_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "members", kind: "message", T: MemberUsergroup, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBookmarkRequest {
-    return new GetBookmarkRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationMemberUsergroupsResponse {
+    return new ListOrganizationMemberUsergroupsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBookmarkRequest {
-    return new GetBookmarkRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationMemberUsergroupsResponse {
+    return new ListOrganizationMemberUsergroupsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBookmarkRequest {
-    return new GetBookmarkRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationMemberUsergroupsResponse {
+    return new ListOrganizationMemberUsergroupsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetBookmarkRequest | PlainMessage<GetBookmarkRequest> | undefined, b: GetBookmarkRequest | PlainMessage<GetBookmarkRequest> | undefined): boolean {
-    return proto3.util.equals(GetBookmarkRequest, a, b);
+  static equals(a: ListOrganizationMemberUsergroupsResponse | PlainMessage<ListOrganizationMemberUsergroupsResponse> | undefined, b: ListOrganizationMemberUsergroupsResponse | PlainMessage<ListOrganizationMemberUsergroupsResponse> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationMemberUsergroupsResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetBookmarkResponse
+ * @generated from message rill.admin.v1.AddOrganizationMemberUsergroupRequest
  */
-export class GetBookmarkResponse extends Message<GetBookmarkResponse> {
+export class AddOrganizationMemberUsergroupRequest extends Message<AddOrganizationMemberUsergroupRequest> {
   /**
-   * @generated from field: rill.admin.v1.Bookmark bookmark = 1;
+   * @generated from field: string organization = 1;
    */
-  bookmark?: Bookmark;
+  organization = "";
 
-  constructor(data?: PartialMessage<GetBookmarkResponse>) {
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string role = 3;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<AddOrganizationMemberUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetBookmarkResponse";
+  static readonly typeName = "rill.admin.v1.AddOrganizationMemberUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "bookmark", kind: "message", T: Bookmark },
-  ]);
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBookmarkResponse {
-    return new GetBookmarkResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddOrganizationMemberUsergroupRequest {
+    return new AddOrganizationMemberUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBookmarkResponse {
-    return new GetBookmarkResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddOrganizationMemberUsergroupRequest {
+    return new AddOrganizationMemberUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBookmarkResponse {
-    return new GetBookmarkResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddOrganizationMemberUsergroupRequest {
+    return new AddOrganizationMemberUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetBookmarkResponse | PlainMessage<GetBookmarkResponse> | undefined, b: GetBookmarkResponse | PlainMessage<GetBookmarkResponse> | undefined): boolean {
-    return proto3.util.equals(GetBookmarkResponse, a, b);
+  static equals(a: AddOrganizationMemberUsergroupRequest | PlainMessage<AddOrganizationMemberUsergroupRequest> | undefined, b: AddOrganizationMemberUsergroupRequest | PlainMessage<AddOrganizationMemberUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(AddOrganizationMemberUsergroupRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateBookmarkRequest
+ * @generated from message rill.admin.v1.AddOrganizationMemberUsergroupResponse
  */
-export class CreateBookmarkRequest extends Message<CreateBookmarkRequest> {
-  /**
-   * @generated from field: string display_name = 1;
-   */
-  displayName = "";
+export class AddOrganizationMemberUsergroupResponse extends Message<AddOrganizationMemberUsergroupResponse> {
+  constructor(data?: PartialMessage<AddOrganizationMemberUsergroupResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * @generated from field: string description = 2;
-   */
-  description = "";
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AddOrganizationMemberUsergroupResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
 
-  /**
-   * @generated from field: bytes data = 3;
-   */
-  data = new Uint8Array(0);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddOrganizationMemberUsergroupResponse {
+    return new AddOrganizationMemberUsergroupResponse().fromBinary(bytes, options);
+  }
 
-  /**
-   * @generated from field: string resource_kind = 4;
-   */
-  resourceKind = "";
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddOrganizationMemberUsergroupResponse {
+    return new AddOrganizationMemberUsergroupResponse().fromJson(jsonValue, options);
+  }
 
-  /**
-   * @generated from field: string resource_name = 5;
-   */
-  resourceName = "";
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddOrganizationMemberUsergroupResponse {
+    return new AddOrganizationMemberUsergroupResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddOrganizationMemberUsergroupResponse | PlainMessage<AddOrganizationMemberUsergroupResponse> | undefined, b: AddOrganizationMemberUsergroupResponse | PlainMessage<AddOrganizationMemberUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(AddOrganizationMemberUsergroupResponse, a, b);
+  }
+}
 
+/**
+ * @generated from message rill.admin.v1.SetOrganizationMemberUsergroupRoleRequest
+ */
+export class SetOrganizationMemberUsergroupRoleRequest extends Message<SetOrganizationMemberUsergroupRoleRequest> {
   /**
-   * @generated from field: string project_id = 6;
+   * @generated from field: string organization = 1;
    */
-  projectId = "";
+  organization = "";
 
   /**
-   * @generated from field: bool default = 7;
+   * @generated from field: string usergroup = 2;
    */
-  default = false;
+  usergroup = "";
 
   /**
-   * @generated from field: bool shared = 8;
+   * @generated from field: string role = 3;
    */
-  shared = false;
+  role = "";
 
-  constructor(data?: PartialMessage<CreateBookmarkRequest>) {
+  constructor(data?: PartialMessage<SetOrganizationMemberUsergroupRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateBookmarkRequest";
+  static readonly typeName = "rill.admin.v1.SetOrganizationMemberUsergroupRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
-    { no: 4, name: "resource_kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "resource_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "default", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 8, name: "shared", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBookmarkRequest {
-    return new CreateBookmarkRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetOrganizationMemberUsergroupRoleRequest {
+    return new SetOrganizationMemberUsergroupRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBookmarkRequest {
-    return new CreateBookmarkRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetOrganizationMemberUsergroupRoleRequest {
+    return new SetOrganizationMemberUsergroupRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBookmarkRequest {
-    return new CreateBookmarkRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetOrganizationMemberUsergroupRoleRequest {
+    return new SetOrganizationMemberUsergroupRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateBookmarkRequest | PlainMessage<CreateBookmarkRequest> | undefined, b: CreateBookmarkRequest | PlainMessage<CreateBookmarkRequest> | undefined): boolean {
-    return proto3.util.equals(CreateBookmarkRequest, a, b);
+  static equals(a: SetOrganizationMemberUsergroupRoleRequest | PlainMessage<SetOrganizationMemberUsergroupRoleRequest> | undefined, b: SetOrganizationMemberUsergroupRoleRequest | PlainMessage<SetOrganizationMemberUsergroupRoleRequest> | undefined): boolean {
+    return proto3.util.equals(SetOrganizationMemberUsergroupRoleRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateBookmarkResponse
+ * @generated from message rill.admin.v1.SetOrganizationMemberUsergroupRoleResponse
  */
-export class CreateBookmarkResponse extends Message<CreateBookmarkResponse> {
-  /**
-   * @generated from field: rill.admin.v1.Bookmark bookmark = 1;
-   */
-  bookmark?: Bookmark;
-
-  constructor(data?: PartialMessage<CreateBookmarkResponse>) {
+export class SetOrganizationMemberUsergroupRoleResponse extends Message<SetOrganizationMemberUsergroupRoleResponse> {
+  constructor(data?: PartialMessage<SetOrganizationMemberUsergroupRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateBookmarkResponse";
+  static readonly typeName = "rill.admin.v1.SetOrganizationMemberUsergroupRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "bookmark", kind: "message", T: Bookmark },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBookmarkResponse {
-    return new CreateBookmarkResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetOrganizationMemberUsergroupRoleResponse {
+    return new SetOrganizationMemberUsergroupRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBookmarkResponse {
-    return new CreateBookmarkResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetOrganizationMemberUsergroupRoleResponse {
+    return new SetOrganizationMemberUsergroupRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBookmarkResponse {
-    return new CreateBookmarkResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetOrganizationMemberUsergroupRoleResponse {
+    return new SetOrganizationMemberUsergroupRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateBookmarkResponse | PlainMessage<CreateBookmarkResponse> | undefined, b: CreateBookmarkResponse | PlainMessage<CreateBookmarkResponse> | undefined): boolean {
-    return proto3.util.equals(CreateBookmarkResponse, a, b);
+  static equals(a: SetOrganizationMemberUsergroupRoleResponse | PlainMessage<SetOrganizationMemberUsergroupRoleResponse> | undefined, b: SetOrganizationMemberUsergroupRoleResponse | PlainMessage<SetOrganizationMemberUsergroupRoleResponse> | undefined): boolean {
+    return proto3.util.equals(SetOrganizationMemberUsergroupRoleResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.UpdateBookmarkRequest
+ * @generated from message rill.admin.v1.RemoveOrganizationMemberUsergroupRequest
  */
-export class UpdateBookmarkRequest extends Message<UpdateBookmarkRequest> {
-  /**
-   * @generated from field: string bookmark_id = 1;
-   */
-  bookmarkId = "";
-
-  /**
-   * @generated from field: string display_name = 2;
-   */
-  displayName = "";
-
-  /**
-   * @generated from field: string description = 4;
-   */
-  description = "";
-
+export class RemoveOrganizationMemberUsergroupRequest extends Message<RemoveOrganizationMemberUsergroupRequest> {
   /**
-   * @generated from field: bytes data = 3;
-   */
-  data = new Uint8Array(0);
-
-  /**
-   * @generated from field: bool default = 5;
+   * @generated from field: string organization = 1;
    */
-  default = false;
+  organization = "";
 
   /**
-   * @generated from field: bool shared = 6;
+   * @generated from field: string usergroup = 2;
    */
-  shared = false;
+  usergroup = "";
 
-  constructor(data?: PartialMessage<UpdateBookmarkRequest>) {
+  constructor(data?: PartialMessage<RemoveOrganizationMemberUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.UpdateBookmarkRequest";
+  static readonly typeName = "rill.admin.v1.RemoveOrganizationMemberUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "bookmark_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
-    { no: 5, name: "default", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 6, name: "shared", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateBookmarkRequest {
-    return new UpdateBookmarkRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveOrganizationMemberUsergroupRequest {
+    return new RemoveOrganizationMemberUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateBookmarkRequest {
-    return new UpdateBookmarkRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberUsergroupRequest {
+    return new RemoveOrganizationMemberUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateBookmarkRequest {
-    return new UpdateBookmarkRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberUsergroupRequest {
+    return new RemoveOrganizationMemberUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateBookmarkRequest | PlainMessage<UpdateBookmarkRequest> | undefined, b: UpdateBookmarkRequest | PlainMessage<UpdateBookmarkRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateBookmarkRequest, a, b);
+  static equals(a: RemoveOrganizationMemberUsergroupRequest | PlainMessage<RemoveOrganizationMemberUsergroupRequest> | undefined, b: RemoveOrganizationMemberUsergroupRequest | PlainMessage<RemoveOrganizationMemberUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveOrganizationMemberUsergroupRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.UpdateBookmarkResponse
+ * @generated from message rill.admin.v1.RemoveOrganizationMemberUsergroupResponse
  */
-export class UpdateBookmarkResponse extends Message<UpdateBookmarkResponse> {
-  constructor(data?: PartialMessage<UpdateBookmarkResponse>) {
+export class RemoveOrganizationMemberUsergroupResponse extends Message<RemoveOrganizationMemberUsergroupResponse> {
+  constructor(data?: PartialMessage<RemoveOrganizationMemberUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.UpdateBookmarkResponse";
+  static readonly typeName = "rill.admin.v1.RemoveOrganizationMemberUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateBookmarkResponse {
-    return new UpdateBookmarkResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveOrganizationMemberUsergroupResponse {
+    return new RemoveOrganizationMemberUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateBookmarkResponse {
-    return new UpdateBookmarkResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberUsergroupResponse {
+    return new RemoveOrganizationMemberUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateBookmarkResponse {
-    return new UpdateBookmarkResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberUsergroupResponse {
+    return new RemoveOrganizationMemberUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateBookmarkResponse | PlainMessage<UpdateBookmarkResponse> | undefined, b: UpdateBookmarkResponse | PlainMessage<UpdateBookmarkResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateBookmarkResponse, a, b);
+  static equals(a: RemoveOrganizationMemberUsergroupResponse | PlainMessage<RemoveOrganizationMemberUsergroupResponse> | undefined, b: RemoveOrganizationMemberUsergroupResponse | PlainMessage<RemoveOrganizationMemberUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveOrganizationMemberUsergroupResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RemoveBookmarkRequest
+ * @generated from message rill.admin.v1.ListProjectMemberUsergroupsRequest
  */
-export class RemoveBookmarkRequest extends Message<RemoveBookmarkRequest> {
+export class ListProjectMemberUsergroupsRequest extends Message<ListProjectMemberUsergroupsRequest> {
   /**
-   * @generated from field: string bookmark_id = 1;
+   * @generated from field: string organization = 1;
    */
-  bookmarkId = "";
+  organization = "";
 
-  constructor(data?: PartialMessage<RemoveBookmarkRequest>) {
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListProjectMemberUsergroupsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RemoveBookmarkRequest";
+  static readonly typeName = "rill.admin.v1.ListProjectMemberUsergroupsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "bookmark_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveBookmarkRequest {
-    return new RemoveBookmarkRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectMemberUsergroupsRequest {
+    return new ListProjectMemberUsergroupsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveBookmarkRequest {
-    return new RemoveBookmarkRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsRequest {
+    return new ListProjectMemberUsergroupsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveBookmarkRequest {
-    return new RemoveBookmarkRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsRequest {
+    return new ListProjectMemberUsergroupsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RemoveBookmarkRequest | PlainMessage<RemoveBookmarkRequest> | undefined, b: RemoveBookmarkRequest | PlainMessage<RemoveBookmarkRequest> | undefined): boolean {
-    return proto3.util.equals(RemoveBookmarkRequest, a, b);
+  static equals(a: ListProjectMemberUsergroupsRequest | PlainMessage<ListProjectMemberUsergroupsRequest> | undefined, b: ListProjectMemberUsergroupsRequest | PlainMessage<ListProjectMemberUsergroupsRequest> | undefined): boolean {
+    return proto3.util.equals(ListProjectMemberUsergroupsRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RemoveBookmarkResponse
+ * @generated from message rill.admin.v1.ListProjectMemberUsergroupsResponse
  */
-export class RemoveBookmarkResponse extends Message<RemoveBookmarkResponse> {
-  constructor(data?: PartialMessage<RemoveBookmarkResponse>) {
+export class ListProjectMemberUsergroupsResponse extends Message<ListProjectMemberUsergroupsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.MemberUsergroup members = 1;
+   */
+  members: MemberUsergroup[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListProjectMemberUsergroupsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RemoveBookmarkResponse";
+  static readonly typeName = "rill.admin.v1.ListProjectMemberUsergroupsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "members", kind: "message", T: MemberUsergroup, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveBookmarkResponse {
-    return new RemoveBookmarkResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectMemberUsergroupsResponse {
+    return new ListProjectMemberUsergroupsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveBookmarkResponse {
-    return new RemoveBookmarkResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsResponse {
+    return new ListProjectMemberUsergroupsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveBookmarkResponse {
-    return new RemoveBookmarkResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsResponse {
+    return new ListProjectMemberUsergroupsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RemoveBookmarkResponse | PlainMessage<RemoveBookmarkResponse> | undefined, b: RemoveBookmarkResponse | PlainMessage<RemoveBookmarkResponse> | undefined): boolean {
-    return proto3.util.equals(RemoveBookmarkResponse, a, b);
+  static equals(a: ListProjectMemberUsergroupsResponse | PlainMessage<ListProjectMemberUsergroupsResponse> | undefined, b: ListProjectMemberUsergroupsResponse | PlainMessage<ListProjectMemberUsergroupsResponse> | undefined): boolean {
+    return proto3.util.equals(ListProjectMemberUsergroupsResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.SearchUsersRequest
+ * @generated from message rill.admin.v1.AddProjectMemberUsergroupRequest
  */
-export class SearchUsersRequest extends Message<SearchUsersRequest> {
+export class AddProjectMemberUsergroupRequest extends Message<AddProjectMemberUsergroupRequest> {
   /**
-   * @generated from field: string email_pattern = 1;
+   * @generated from field: string organization = 1;
    */
-  emailPattern = "";
+  organization = "";
 
   /**
-   * @generated from field: uint32 page_size = 2;
+   * @generated from field: string project = 2;
    */
-  pageSize = 0;
+  project = "";
 
   /**
-   * @generated from field: string page_token = 3;
+   * @generated from field: string usergroup = 3;
    */
-  pageToken = "";
+  usergroup = "";
 
-  constructor(data?: PartialMessage<SearchUsersRequest>) {
+  /**
+   * @generated from field: string role = 4;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<AddProjectMemberUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SearchUsersRequest";
+  static readonly typeName = "rill.admin.v1.AddProjectMemberUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "email_pattern", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchUsersRequest {
-    return new SearchUsersRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddProjectMemberUsergroupRequest {
+    return new AddProjectMemberUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchUsersRequest {
-    return new SearchUsersRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddProjectMemberUsergroupRequest {
+    return new AddProjectMemberUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchUsersRequest {
-    return new SearchUsersRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddProjectMemberUsergroupRequest {
+    return new AddProjectMemberUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SearchUsersRequest | PlainMessage<SearchUsersRequest> | undefined, b: SearchUsersRequest | PlainMessage<SearchUsersRequest> | undefined): boolean {
-    return proto3.util.equals(SearchUsersRequest, a, b);
+  static equals(a: AddProjectMemberUsergroupRequest | PlainMessage<AddProjectMemberUsergroupRequest> | undefined, b: AddProjectMemberUsergroupRequest | PlainMessage<AddProjectMemberUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(AddProjectMemberUsergroupRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.SearchUsersResponse
+ * @generated from message rill.admin.v1.AddProjectMemberUsergroupResponse
  */
-export class SearchUsersResponse extends Message<SearchUsersResponse> {
-  /**
-   * @generated from field: repeated rill.admin.v1.User users = 1;
-   */
-  users: User[] = [];
-
-  /**
-   * @generated from field: string next_page_token = 2;
-   */
-  nextPageToken = "";
-
-  constructor(data?: PartialMessage<SearchUsersResponse>) {
+export class AddProjectMemberUsergroupResponse extends Message<AddProjectMemberUsergroupResponse> {
+  constructor(data?: PartialMessage<AddProjectMemberUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SearchUsersResponse";
+  static readonly typeName = "rill.admin.v1.AddProjectMemberUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "users", kind: "message", T: User, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchUsersResponse {
-    return new SearchUsersResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddProjectMemberUsergroupResponse {
+    return new AddProjectMemberUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchUsersResponse {
-    return new SearchUsersResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddProjectMemberUsergroupResponse {
+    return new AddProjectMemberUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchUsersResponse {
-    return new SearchUsersResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddProjectMemberUsergroupResponse {
+    return new AddProjectMemberUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SearchUsersResponse | PlainMessage<SearchUsersResponse> | undefined, b: SearchUsersResponse | PlainMessage<SearchUsersResponse> | undefined): boolean {
-    return proto3.util.equals(SearchUsersResponse, a, b);
+  static equals(a: AddProjectMemberUsergroupResponse | PlainMessage<AddProjectMemberUsergroupResponse> | undefined, b: AddProjectMemberUsergroupResponse | PlainMessage<AddProjectMemberUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(AddProjectMemberUsergroupResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RevokeCurrentAuthTokenRequest
+ * @generated from message rill.admin.v1.SetProjectMemberUsergroupRoleRequest
  */
-export class RevokeCurrentAuthTokenRequest extends Message<RevokeCurrentAuthTokenRequest> {
-  constructor(data?: PartialMessage<RevokeCurrentAuthTokenRequest>) {
+export class SetProjectMemberUsergroupRoleRequest extends Message<SetProjectMemberUsergroupRoleRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string usergroup = 3;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string role = 4;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<SetProjectMemberUsergroupRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RevokeCurrentAuthTokenRequest";
+  static readonly typeName = "rill.admin.v1.SetProjectMemberUsergroupRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeCurrentAuthTokenRequest {
-    return new RevokeCurrentAuthTokenRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetProjectMemberUsergroupRoleRequest {
+    return new SetProjectMemberUsergroupRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenRequest {
-    return new RevokeCurrentAuthTokenRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetProjectMemberUsergroupRoleRequest {
+    return new SetProjectMemberUsergroupRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenRequest {
-    return new RevokeCurrentAuthTokenRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetProjectMemberUsergroupRoleRequest {
+    return new SetProjectMemberUsergroupRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RevokeCurrentAuthTokenRequest | PlainMessage<RevokeCurrentAuthTokenRequest> | undefined, b: RevokeCurrentAuthTokenRequest | PlainMessage<RevokeCurrentAuthTokenRequest> | undefined): boolean {
-    return proto3.util.equals(RevokeCurrentAuthTokenRequest, a, b);
+  static equals(a: SetProjectMemberUsergroupRoleRequest | PlainMessage<SetProjectMemberUsergroupRoleRequest> | undefined, b: SetProjectMemberUsergroupRoleRequest | PlainMessage<SetProjectMemberUsergroupRoleRequest> | undefined): boolean {
+    return proto3.util.equals(SetProjectMemberUsergroupRoleRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RevokeCurrentAuthTokenResponse
+ * @generated from message rill.admin.v1.SetProjectMemberUsergroupRoleResponse
  */
-export class RevokeCurrentAuthTokenResponse extends Message<RevokeCurrentAuthTokenResponse> {
-  /**
-   * @generated from field: string token_id = 1;
-   */
-  tokenId = "";
-
-  constructor(data?: PartialMessage<RevokeCurrentAuthTokenResponse>) {
+export class SetProjectMemberUsergroupRoleResponse extends Message<SetProjectMemberUsergroupRoleResponse> {
+  constructor(data?: PartialMessage<SetProjectMemberUsergroupRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RevokeCurrentAuthTokenResponse";
+  static readonly typeName = "rill.admin.v1.SetProjectMemberUsergroupRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "token_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeCurrentAuthTokenResponse {
-    return new RevokeCurrentAuthTokenResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetProjectMemberUsergroupRoleResponse {
+    return new SetProjectMemberUsergroupRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenResponse {
-    return new RevokeCurrentAuthTokenResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetProjectMemberUsergroupRoleResponse {
+    return new SetProjectMemberUsergroupRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenResponse {
-    return new RevokeCurrentAuthTokenResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetProjectMemberUsergroupRoleResponse {
+    return new SetProjectMemberUsergroupRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RevokeCurrentAuthTokenResponse | PlainMessage<RevokeCurrentAuthTokenResponse> | undefined, b: RevokeCurrentAuthTokenResponse | PlainMessage<RevokeCurrentAuthTokenResponse> | undefined): boolean {
-    return proto3.util.equals(RevokeCurrentAuthTokenResponse, a, b);
+  static equals(a: SetProjectMemberUsergroupRoleResponse | PlainMessage<SetProjectMemberUsergroupRoleResponse> | undefined, b: SetProjectMemberUsergroupRoleResponse | PlainMessage<SetProjectMemberUsergroupRoleResponse> | undefined): boolean {
+    return proto3.util.equals(SetProjectMemberUsergroupRoleResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.IssueRepresentativeAuthTokenRequest
+ * @generated from message rill.admin.v1.RemoveProjectMemberUsergroupRequest
  */
-export class IssueRepresentativeAuthTokenRequest extends Message<IssueRepresentativeAuthTokenRequest> {
+export class RemoveProjectMemberUsergroupRequest extends Message<RemoveProjectMemberUsergroupRequest> {
   /**
-   * @generated from field: string email = 1;
+   * @generated from field: string organization = 1;
    */
-  email = "";
+  organization = "";
 
   /**
-   * @generated from field: int64 ttl_minutes = 2;
+   * @generated from field: string project = 2;
    */
-  ttlMinutes = protoInt64.zero;
+  project = "";
 
-  constructor(data?: PartialMessage<IssueRepresentativeAuthTokenRequest>) {
+  /**
+   * @generated from field: string usergroup = 3;
+   */
+  usergroup = "";
+
+  constructor(data?: PartialMessage<RemoveProjectMemberUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.IssueRepresentativeAuthTokenRequest";
+  static readonly typeName = "rill.admin.v1.RemoveProjectMemberUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "ttl_minutes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueRepresentativeAuthTokenRequest {
-    return new IssueRepresentativeAuthTokenRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveProjectMemberUsergroupRequest {
+    return new RemoveProjectMemberUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenRequest {
-    return new IssueRepresentativeAuthTokenRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveProjectMemberUsergroupRequest {
+    return new RemoveProjectMemberUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenRequest {
-    return new IssueRepresentativeAuthTokenRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveProjectMemberUsergroupRequest {
+    return new RemoveProjectMemberUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: IssueRepresentativeAuthTokenRequest | PlainMessage<IssueRepresentativeAuthTokenRequest> | undefined, b: IssueRepresentativeAuthTokenRequest | PlainMessage<IssueRepresentativeAuthTokenRequest> | undefined): boolean {
-    return proto3.util.equals(IssueRepresentativeAuthTokenRequest, a, b);
+  static equals(a: RemoveProjectMemberUsergroupRequest | PlainMessage<RemoveProjectMemberUsergroupRequest> | undefined, b: RemoveProjectMemberUsergroupRequest | PlainMessage<RemoveProjectMemberUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveProjectMemberUsergroupRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.IssueRepresentativeAuthTokenResponse
+ * @generated from message rill.admin.v1.RemoveProjectMemberUsergroupResponse
  */
-export class IssueRepresentativeAuthTokenResponse extends Message<IssueRepresentativeAuthTokenResponse> {
-  /**
-   * @generated from field: string token = 1;
-   */
-  token = "";
-
-  constructor(data?: PartialMessage<IssueRepresentativeAuthTokenResponse>) {
+export class RemoveProjectMemberUsergroupResponse extends Message<RemoveProjectMemberUsergroupResponse> {
+  constructor(data?: PartialMessage<RemoveProjectMemberUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.IssueRepresentativeAuthTokenResponse";
+  static readonly typeName = "rill.admin.v1.RemoveProjectMemberUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueRepresentativeAuthTokenResponse {
-    return new IssueRepresentativeAuthTokenResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveProjectMemberUsergroupResponse {
+    return new RemoveProjectMemberUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenResponse {
-    return new IssueRepresentativeAuthTokenResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveProjectMemberUsergroupResponse {
+    return new RemoveProjectMemberUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenResponse {
-    return new IssueRepresentativeAuthTokenResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveProjectMemberUsergroupResponse {
+    return new RemoveProjectMemberUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: IssueRepresentativeAuthTokenResponse | PlainMessage<IssueRepresentativeAuthTokenResponse> | undefined, b: IssueRepresentativeAuthTokenResponse | PlainMessage<IssueRepresentativeAuthTokenResponse> | undefined): boolean {
-    return proto3.util.equals(IssueRepresentativeAuthTokenResponse, a, b);
+  static equals(a: RemoveProjectMemberUsergroupResponse | PlainMessage<RemoveProjectMemberUsergroupResponse> | undefined, b: RemoveProjectMemberUsergroupResponse | PlainMessage<RemoveProjectMemberUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveProjectMemberUsergroupResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RevokeServiceAuthTokenRequest
+ * @generated from message rill.admin.v1.ListUsergroupMemberUsersRequest
  */
-export class RevokeServiceAuthTokenRequest extends Message<RevokeServiceAuthTokenRequest> {
+export class ListUsergroupMemberUsersRequest extends Message<ListUsergroupMemberUsersRequest> {
   /**
-   * @generated from field: string token_id = 1;
+   * @generated from field: string organization = 1;
    */
-  tokenId = "";
+  organization = "";
 
-  constructor(data?: PartialMessage<RevokeServiceAuthTokenRequest>) {
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListUsergroupMemberUsersRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RevokeServiceAuthTokenRequest";
+  static readonly typeName = "rill.admin.v1.ListUsergroupMemberUsersRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "token_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeServiceAuthTokenRequest {
-    return new RevokeServiceAuthTokenRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupMemberUsersRequest {
+    return new ListUsergroupMemberUsersRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenRequest {
-    return new RevokeServiceAuthTokenRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupMemberUsersRequest {
+    return new ListUsergroupMemberUsersRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenRequest {
-    return new RevokeServiceAuthTokenRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupMemberUsersRequest {
+    return new ListUsergroupMemberUsersRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RevokeServiceAuthTokenRequest | PlainMessage<RevokeServiceAuthTokenRequest> | undefined, b: RevokeServiceAuthTokenRequest | PlainMessage<RevokeServiceAuthTokenRequest> | undefined): boolean {
-    return proto3.util.equals(RevokeServiceAuthTokenRequest, a, b);
+  static equals(a: ListUsergroupMemberUsersRequest | PlainMessage<ListUsergroupMemberUsersRequest> | undefined, b: ListUsergroupMemberUsersRequest | PlainMessage<ListUsergroupMemberUsersRequest> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupMemberUsersRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RevokeServiceAuthTokenResponse
+ * @generated from message rill.admin.v1.ListUsergroupMemberUsersResponse
  */
-export class RevokeServiceAuthTokenResponse extends Message<RevokeServiceAuthTokenResponse> {
-  constructor(data?: PartialMessage<RevokeServiceAuthTokenResponse>) {
+export class ListUsergroupMemberUsersResponse extends Message<ListUsergroupMemberUsersResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.MemberUser members = 1;
+   */
+  members: MemberUser[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListUsergroupMemberUsersResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RevokeServiceAuthTokenResponse";
+  static readonly typeName = "rill.admin.v1.ListUsergroupMemberUsersResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "members", kind: "message", T: MemberUser, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeServiceAuthTokenResponse {
-    return new RevokeServiceAuthTokenResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupMemberUsersResponse {
+    return new ListUsergroupMemberUsersResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenResponse {
-    return new RevokeServiceAuthTokenResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupMemberUsersResponse {
+    return new ListUsergroupMemberUsersResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenResponse {
-    return new RevokeServiceAuthTokenResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupMemberUsersResponse {
+    return new ListUsergroupMemberUsersResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RevokeServiceAuthTokenResponse | PlainMessage<RevokeServiceAuthTokenResponse> | undefined, b: RevokeServiceAuthTokenResponse | PlainMessage<RevokeServiceAuthTokenResponse> | undefined): boolean {
-    return proto3.util.equals(RevokeServiceAuthTokenResponse, a, b);
+  static equals(a: ListUsergroupMemberUsersResponse | PlainMessage<ListUsergroupMemberUsersResponse> | undefined, b: ListUsergroupMemberUsersResponse | PlainMessage<ListUsergroupMemberUsersResponse> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupMemberUsersResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.IssueServiceAuthTokenRequest
+ * @generated from message rill.admin.v1.AddUsergroupMemberUserRequest
  */
-export class IssueServiceAuthTokenRequest extends Message<IssueServiceAuthTokenRequest> {
+export class AddUsergroupMemberUserRequest extends Message<AddUsergroupMemberUserRequest> {
   /**
-   * @generated from field: string organization_name = 1;
+   * @generated from field: string organization = 1;
    */
-  organizationName = "";
+  organization = "";
 
   /**
-   * @generated from field: string service_name = 2;
+   * @generated from field: string usergroup = 2;
    */
-  serviceName = "";
+  usergroup = "";
 
-  constructor(data?: PartialMessage<IssueServiceAuthTokenRequest>) {
+  /**
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<AddUsergroupMemberUserRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.IssueServiceAuthTokenRequest";
+  static readonly typeName = "rill.admin.v1.AddUsergroupMemberUserRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueServiceAuthTokenRequest {
-    return new IssueServiceAuthTokenRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddUsergroupMemberUserRequest {
+    return new AddUsergroupMemberUserRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenRequest {
-    return new IssueServiceAuthTokenRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddUsergroupMemberUserRequest {
+    return new AddUsergroupMemberUserRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenRequest {
-    return new IssueServiceAuthTokenRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddUsergroupMemberUserRequest {
+    return new AddUsergroupMemberUserRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: IssueServiceAuthTokenRequest | PlainMessage<IssueServiceAuthTokenRequest> | undefined, b: IssueServiceAuthTokenRequest | PlainMessage<IssueServiceAuthTokenRequest> | undefined): boolean {
-    return proto3.util.equals(IssueServiceAuthTokenRequest, a, b);
+  static equals(a: AddUsergroupMemberUserRequest | PlainMessage<AddUsergroupMemberUserRequest> | undefined, b: AddUsergroupMemberUserRequest | PlainMessage<AddUsergroupMemberUserRequest> | undefined): boolean {
+    return proto3.util.equals(AddUsergroupMemberUserRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.IssueServiceAuthTokenResponse
+ * @generated from message rill.admin.v1.AddUsergroupMemberUserResponse
  */
-export class IssueServiceAuthTokenResponse extends Message<IssueServiceAuthTokenResponse> {
-  /**
-   * @generated from field: string token = 1;
-   */
-  token = "";
-
-  constructor(data?: PartialMessage<IssueServiceAuthTokenResponse>) {
+export class AddUsergroupMemberUserResponse extends Message<AddUsergroupMemberUserResponse> {
+  constructor(data?: PartialMessage<AddUsergroupMemberUserResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.IssueServiceAuthTokenResponse";
+  static readonly typeName = "rill.admin.v1.AddUsergroupMemberUserResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueServiceAuthTokenResponse {
-    return new IssueServiceAuthTokenResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddUsergroupMemberUserResponse {
+    return new AddUsergroupMemberUserResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenResponse {
-    return new IssueServiceAuthTokenResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddUsergroupMemberUserResponse {
+    return new AddUsergroupMemberUserResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenResponse {
-    return new IssueServiceAuthTokenResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddUsergroupMemberUserResponse {
+    return new AddUsergroupMemberUserResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: IssueServiceAuthTokenResponse | PlainMessage<IssueServiceAuthTokenResponse> | undefined, b: IssueServiceAuthTokenResponse | PlainMessage<IssueServiceAuthTokenResponse> | undefined): boolean {
-    return proto3.util.equals(IssueServiceAuthTokenResponse, a, b);
+  static equals(a: AddUsergroupMemberUserResponse | PlainMessage<AddUsergroupMemberUserResponse> | undefined, b: AddUsergroupMemberUserResponse | PlainMessage<AddUsergroupMemberUserResponse> | undefined): boolean {
+    return proto3.util.equals(AddUsergroupMemberUserResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListServiceAuthTokensRequest
+ * @generated from message rill.admin.v1.RemoveUsergroupMemberUserRequest
  */
-export class ListServiceAuthTokensRequest extends Message<ListServiceAuthTokensRequest> {
+export class RemoveUsergroupMemberUserRequest extends Message<RemoveUsergroupMemberUserRequest> {
   /**
-   * @generated from field: string organization_name = 1;
+   * @generated from field: string organization = 1;
    */
-  organizationName = "";
+  organization = "";
 
   /**
-   * @generated from field: string service_name = 2;
+   * @generated from field: string usergroup = 2;
    */
-  serviceName = "";
+  usergroup = "";
 
-  constructor(data?: PartialMessage<ListServiceAuthTokensRequest>) {
+  /**
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<RemoveUsergroupMemberUserRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListServiceAuthTokensRequest";
+  static readonly typeName = "rill.admin.v1.RemoveUsergroupMemberUserRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListServiceAuthTokensRequest {
-    return new ListServiceAuthTokensRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveUsergroupMemberUserRequest {
+    return new RemoveUsergroupMemberUserRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListServiceAuthTokensRequest {
-    return new ListServiceAuthTokensRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberUserRequest {
+    return new RemoveUsergroupMemberUserRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListServiceAuthTokensRequest {
-    return new ListServiceAuthTokensRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberUserRequest {
+    return new RemoveUsergroupMemberUserRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListServiceAuthTokensRequest | PlainMessage<ListServiceAuthTokensRequest> | undefined, b: ListServiceAuthTokensRequest | PlainMessage<ListServiceAuthTokensRequest> | undefined): boolean {
-    return proto3.util.equals(ListServiceAuthTokensRequest, a, b);
+  static equals(a: RemoveUsergroupMemberUserRequest | PlainMessage<RemoveUsergroupMemberUserRequest> | undefined, b: RemoveUsergroupMemberUserRequest | PlainMessage<RemoveUsergroupMemberUserRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveUsergroupMemberUserRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListServiceAuthTokensResponse
+ * @generated from message rill.admin.v1.RemoveUsergroupMemberUserResponse
  */
-export class ListServiceAuthTokensResponse extends Message<ListServiceAuthTokensResponse> {
-  /**
-   * @generated from field: repeated rill.admin.v1.ServiceToken tokens = 1;
-   */
-  tokens: ServiceToken[] = [];
-
-  constructor(data?: PartialMessage<ListServiceAuthTokensResponse>) {
+export class RemoveUsergroupMemberUserResponse extends Message<RemoveUsergroupMemberUserResponse> {
+  constructor(data?: PartialMessage<RemoveUsergroupMemberUserResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListServiceAuthTokensResponse";
+  static readonly typeName = "rill.admin.v1.RemoveUsergroupMemberUserResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "tokens", kind: "message", T: ServiceToken, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListServiceAuthTokensResponse {
-    return new ListServiceAuthTokensResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveUsergroupMemberUserResponse {
+    return new RemoveUsergroupMemberUserResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListServiceAuthTokensResponse {
-    return new ListServiceAuthTokensResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberUserResponse {
+    return new RemoveUsergroupMemberUserResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListServiceAuthTokensResponse {
-    return new ListServiceAuthTokensResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberUserResponse {
+    return new RemoveUsergroupMemberUserResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListServiceAuthTokensResponse | PlainMessage<ListServiceAuthTokensResponse> | undefined, b: ListServiceAuthTokensResponse | PlainMessage<ListServiceAuthTokensResponse> | undefined): boolean {
-    return proto3.util.equals(ListServiceAuthTokensResponse, a, b);
+  static equals(a: RemoveUsergroupMemberUserResponse | PlainMessage<RemoveUsergroupMemberUserResponse> | undefined, b: RemoveUsergroupMemberUserResponse | PlainMessage<RemoveUsergroupMemberUserResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveUsergroupMemberUserResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetGithubRepoStatusRequest
+ * @generated from message rill.admin.v1.ListOrganizationRolesRequest
  */
-export class GetGithubRepoStatusRequest extends Message<GetGithubRepoStatusRequest> {
+export class ListOrganizationRolesRequest extends Message<ListOrganizationRolesRequest> {
   /**
-   * @generated from field: string github_url = 1;
+   * @generated from field: string organization = 1;
    */
-  githubUrl = "";
+  organization = "";
 
-  constructor(data?: PartialMessage<GetGithubRepoStatusRequest>) {
+  constructor(data?: PartialMessage<ListOrganizationRolesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetGithubRepoStatusRequest";
+  static readonly typeName = "rill.admin.v1.ListOrganizationRolesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "github_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGithubRepoStatusRequest {
-    return new GetGithubRepoStatusRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationRolesRequest {
+    return new ListOrganizationRolesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGithubRepoStatusRequest {
-    return new GetGithubRepoStatusRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationRolesRequest {
+    return new ListOrganizationRolesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGithubRepoStatusRequest {
-    return new GetGithubRepoStatusRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationRolesRequest {
+    return new ListOrganizationRolesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetGithubRepoStatusRequest | PlainMessage<GetGithubRepoStatusRequest> | undefined, b: GetGithubRepoStatusRequest | PlainMessage<GetGithubRepoStatusRequest> | undefined): boolean {
-    return proto3.util.equals(GetGithubRepoStatusRequest, a, b);
+  static equals(a: ListOrganizationRolesRequest | PlainMessage<ListOrganizationRolesRequest> | undefined, b: ListOrganizationRolesRequest | PlainMessage<ListOrganizationRolesRequest> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationRolesRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetGithubRepoStatusResponse
+ * @generated from message rill.admin.v1.ListOrganizationRolesResponse
  */
-export class GetGithubRepoStatusResponse extends Message<GetGithubRepoStatusResponse> {
-  /**
-   * @generated from field: bool has_access = 1;
-   */
-  hasAccess = false;
-
-  /**
-   * @generated from field: string grant_access_url = 2;
-   */
-  grantAccessUrl = "";
-
+export class ListOrganizationRolesResponse extends Message<ListOrganizationRolesResponse> {
   /**
-   * @generated from field: string default_branch = 3;
+   * @generated from field: repeated rill.admin.v1.OrganizationRole roles = 1;
    */
-  defaultBranch = "";
+  roles: OrganizationRole[] = [];
 
-  constructor(data?: PartialMessage<GetGithubRepoStatusResponse>) {
+  constructor(data?: PartialMessage<ListOrganizationRolesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetGithubRepoStatusResponse";
+  static readonly typeName = "rill.admin.v1.ListOrganizationRolesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "has_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 2, name: "grant_access_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "default_branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "roles", kind: "message", T: OrganizationRole, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGithubRepoStatusResponse {
-    return new GetGithubRepoStatusResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationRolesResponse {
+    return new ListOrganizationRolesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGithubRepoStatusResponse {
-    return new GetGithubRepoStatusResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationRolesResponse {
+    return new ListOrganizationRolesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGithubRepoStatusResponse {
-    return new GetGithubRepoStatusResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationRolesResponse {
+    return new ListOrganizationRolesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetGithubRepoStatusResponse | PlainMessage<GetGithubRepoStatusResponse> | undefined, b: GetGithubRepoStatusResponse | PlainMessage<GetGithubRepoStatusResponse> | undefined): boolean {
-    return proto3.util.equals(GetGithubRepoStatusResponse, a, b);
+  static equals(a: ListOrganizationRolesResponse | PlainMessage<ListOrganizationRolesResponse> | undefined, b: ListOrganizationRolesResponse | PlainMessage<ListOrganizationRolesResponse> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationRolesResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetGithubUserStatusRequest
+ * @generated from message rill.admin.v1.CreateOrganizationRoleRequest
  */
-export class GetGithubUserStatusRequest extends Message<GetGithubUserStatusRequest> {
-  constructor(data?: PartialMessage<GetGithubUserStatusRequest>) {
+export class CreateOrganizationRoleRequest extends Message<CreateOrganizationRoleRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: rill.admin.v1.OrganizationPermissions permissions = 3;
+   */
+  permissions?: OrganizationPermissions;
+
+  constructor(data?: PartialMessage<CreateOrganizationRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetGithubUserStatusRequest";
+  static readonly typeName = "rill.admin.v1.CreateOrganizationRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "permissions", kind: "message", T: OrganizationPermissions },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGithubUserStatusRequest {
-    return new GetGithubUserStatusRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationRoleRequest {
+    return new CreateOrganizationRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGithubUserStatusRequest {
-    return new GetGithubUserStatusRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationRoleRequest {
+    return new CreateOrganizationRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGithubUserStatusRequest {
-    return new GetGithubUserStatusRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationRoleRequest {
+    return new CreateOrganizationRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetGithubUserStatusRequest | PlainMessage<GetGithubUserStatusRequest> | undefined, b: GetGithubUserStatusRequest | PlainMessage<GetGithubUserStatusRequest> | undefined): boolean {
-    return proto3.util.equals(GetGithubUserStatusRequest, a, b);
+  static equals(a: CreateOrganizationRoleRequest | PlainMessage<CreateOrganizationRoleRequest> | undefined, b: CreateOrganizationRoleRequest | PlainMessage<CreateOrganizationRoleRequest> | undefined): boolean {
+    return proto3.util.equals(CreateOrganizationRoleRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetGithubUserStatusResponse
+ * @generated from message rill.admin.v1.CreateOrganizationRoleResponse
  */
-export class GetGithubUserStatusResponse extends Message<GetGithubUserStatusResponse> {
-  /**
-   * @generated from field: bool has_access = 1;
-   */
-  hasAccess = false;
-
-  /**
-   * @generated from field: string grant_access_url = 2;
-   */
-  grantAccessUrl = "";
-
-  /**
-   * @generated from field: string access_token = 3;
-   */
-  accessToken = "";
-
-  /**
-   * @generated from field: string account = 4;
-   */
-  account = "";
-
-  /**
-   * @generated from field: rill.admin.v1.GithubPermission user_installation_permission = 6;
-   */
-  userInstallationPermission = GithubPermission.UNSPECIFIED;
-
-  /**
-   * @generated from field: map<string, rill.admin.v1.GithubPermission> organization_installation_permissions = 7;
-   */
-  organizationInstallationPermissions: { [key: string]: GithubPermission } = {};
-
+export class CreateOrganizationRoleResponse extends Message<CreateOrganizationRoleResponse> {
   /**
-   * DEPRECATED: Use organization_installation_permissions instead.
-   *
-   * @generated from field: repeated string organizations = 5 [deprecated = true];
-   * @deprecated
+   * @generated from field: rill.admin.v1.OrganizationRole role = 1;
    */
-  organizations: string[] = [];
+  role?: OrganizationRole;
 
-  constructor(data?: PartialMessage<GetGithubUserStatusResponse>) {
+  constructor(data?: PartialMessage<CreateOrganizationRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetGithubUserStatusResponse";
+  static readonly typeName = "rill.admin.v1.CreateOrganizationRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "has_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 2, name: "grant_access_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "account", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "user_installation_permission", kind: "enum", T: proto3.getEnumType(GithubPermission) },
-    { no: 7, name: "organization_installation_permissions", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "enum", T: proto3.getEnumType(GithubPermission)} },
-    { no: 5, name: "organizations", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 1, name: "role", kind: "message", T: OrganizationRole },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGithubUserStatusResponse {
-    return new GetGithubUserStatusResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationRoleResponse {
+    return new CreateOrganizationRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGithubUserStatusResponse {
-    return new GetGithubUserStatusResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationRoleResponse {
+    return new CreateOrganizationRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGithubUserStatusResponse {
-    return new GetGithubUserStatusResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationRoleResponse {
+    return new CreateOrganizationRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetGithubUserStatusResponse | PlainMessage<GetGithubUserStatusResponse> | undefined, b: GetGithubUserStatusResponse | PlainMessage<GetGithubUserStatusResponse> | undefined): boolean {
-    return proto3.util.equals(GetGithubUserStatusResponse, a, b);
+  static equals(a: CreateOrganizationRoleResponse | PlainMessage<CreateOrganizationRoleResponse> | undefined, b: CreateOrganizationRoleResponse | PlainMessage<CreateOrganizationRoleResponse> | undefined): boolean {
+    return proto3.util.equals(CreateOrganizationRoleResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetGitCredentialsRequest
+ * @generated from message rill.admin.v1.UpdateOrganizationRoleRequest
  */
-export class GetGitCredentialsRequest extends Message<GetGitCredentialsRequest> {
+export class UpdateOrganizationRoleRequest extends Message<UpdateOrganizationRoleRequest> {
   /**
    * @generated from field: string organization = 1;
    */
   organization = "";
 
   /**
-   * @generated from field: string project = 2;
+   * @generated from field: string role = 2;
    */
-  project = "";
+  role = "";
 
-  constructor(data?: PartialMessage<GetGitCredentialsRequest>) {
+  /**
+   * @generated from field: optional string new_name = 3;
+   */
+  newName?: string;
+
+  /**
+   * Replaces the permissions of the role if set.
+   *
+   * @generated from field: rill.admin.v1.OrganizationPermissions permissions = 4;
+   */
+  permissions?: OrganizationPermissions;
+
+  constructor(data?: PartialMessage<UpdateOrganizationRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetGitCredentialsRequest";
+  static readonly typeName = "rill.admin.v1.UpdateOrganizationRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "new_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "permissions", kind: "message", T: OrganizationPermissions },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGitCredentialsRequest {
-    return new GetGitCredentialsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationRoleRequest {
+    return new UpdateOrganizationRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGitCredentialsRequest {
-    return new GetGitCredentialsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationRoleRequest {
+    return new UpdateOrganizationRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGitCredentialsRequest {
-    return new GetGitCredentialsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationRoleRequest {
+    return new UpdateOrganizationRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetGitCredentialsRequest | PlainMessage<GetGitCredentialsRequest> | undefined, b: GetGitCredentialsRequest | PlainMessage<GetGitCredentialsRequest> | undefined): boolean {
-    return proto3.util.equals(GetGitCredentialsRequest, a, b);
+  static equals(a: UpdateOrganizationRoleRequest | PlainMessage<UpdateOrganizationRoleRequest> | undefined, b: UpdateOrganizationRoleRequest | PlainMessage<UpdateOrganizationRoleRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateOrganizationRoleRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetGitCredentialsResponse
+ * @generated from message rill.admin.v1.UpdateOrganizationRoleResponse
  */
-export class GetGitCredentialsResponse extends Message<GetGitCredentialsResponse> {
-  /**
-   * @generated from field: string repo_url = 1;
-   */
-  repoUrl = "";
-
-  /**
-   * @generated from field: string username = 2;
-   */
-  username = "";
-
-  /**
-   * @generated from field: string password = 3;
-   */
-  password = "";
-
-  /**
-   * @generated from field: string subpath = 4;
-   */
-  subpath = "";
-
+export class UpdateOrganizationRoleResponse extends Message<UpdateOrganizationRoleResponse> {
   /**
-   * @generated from field: string prod_branch = 5;
+   * @generated from field: rill.admin.v1.OrganizationRole role = 1;
    */
-  prodBranch = "";
+  role?: OrganizationRole;
 
-  constructor(data?: PartialMessage<GetGitCredentialsResponse>) {
+  constructor(data?: PartialMessage<UpdateOrganizationRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetGitCredentialsResponse";
+  static readonly typeName = "rill.admin.v1.UpdateOrganizationRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "subpath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "prod_branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "role", kind: "message", T: OrganizationRole },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGitCredentialsResponse {
-    return new GetGitCredentialsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationRoleResponse {
+    return new UpdateOrganizationRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGitCredentialsResponse {
-    return new GetGitCredentialsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationRoleResponse {
+    return new UpdateOrganizationRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGitCredentialsResponse {
-    return new GetGitCredentialsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationRoleResponse {
+    return new UpdateOrganizationRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetGitCredentialsResponse | PlainMessage<GetGitCredentialsResponse> | undefined, b: GetGitCredentialsResponse | PlainMessage<GetGitCredentialsResponse> | undefined): boolean {
-    return proto3.util.equals(GetGitCredentialsResponse, a, b);
+  static equals(a: UpdateOrganizationRoleResponse | PlainMessage<UpdateOrganizationRoleResponse> | undefined, b: UpdateOrganizationRoleResponse | PlainMessage<UpdateOrganizationRoleResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateOrganizationRoleResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateWhitelistedDomainRequest
+ * @generated from message rill.admin.v1.DeleteOrganizationRoleRequest
  */
-export class CreateWhitelistedDomainRequest extends Message<CreateWhitelistedDomainRequest> {
+export class DeleteOrganizationRoleRequest extends Message<DeleteOrganizationRoleRequest> {
   /**
    * @generated from field: string organization = 1;
    */
   organization = "";
 
   /**
-   * @generated from field: string domain = 2;
-   */
-  domain = "";
-
-  /**
-   * @generated from field: string role = 3;
+   * @generated from field: string role = 2;
    */
   role = "";
 
-  constructor(data?: PartialMessage<CreateWhitelistedDomainRequest>) {
+  constructor(data?: PartialMessage<DeleteOrganizationRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateWhitelistedDomainRequest";
+  static readonly typeName = "rill.admin.v1.DeleteOrganizationRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWhitelistedDomainRequest {
-    return new CreateWhitelistedDomainRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteOrganizationRoleRequest {
+    return new DeleteOrganizationRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainRequest {
-    return new CreateWhitelistedDomainRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteOrganizationRoleRequest {
+    return new DeleteOrganizationRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainRequest {
-    return new CreateWhitelistedDomainRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteOrganizationRoleRequest {
+    return new DeleteOrganizationRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateWhitelistedDomainRequest | PlainMessage<CreateWhitelistedDomainRequest> | undefined, b: CreateWhitelistedDomainRequest | PlainMessage<CreateWhitelistedDomainRequest> | undefined): boolean {
-    return proto3.util.equals(CreateWhitelistedDomainRequest, a, b);
+  static equals(a: DeleteOrganizationRoleRequest | PlainMessage<DeleteOrganizationRoleRequest> | undefined, b: DeleteOrganizationRoleRequest | PlainMessage<DeleteOrganizationRoleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteOrganizationRoleRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateWhitelistedDomainResponse
+ * @generated from message rill.admin.v1.DeleteOrganizationRoleResponse
  */
-export class CreateWhitelistedDomainResponse extends Message<CreateWhitelistedDomainResponse> {
-  constructor(data?: PartialMessage<CreateWhitelistedDomainResponse>) {
+export class DeleteOrganizationRoleResponse extends Message<DeleteOrganizationRoleResponse> {
+  constructor(data?: PartialMessage<DeleteOrganizationRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateWhitelistedDomainResponse";
+  static readonly typeName = "rill.admin.v1.DeleteOrganizationRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWhitelistedDomainResponse {
-    return new CreateWhitelistedDomainResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteOrganizationRoleResponse {
+    return new DeleteOrganizationRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainResponse {
-    return new CreateWhitelistedDomainResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteOrganizationRoleResponse {
+    return new DeleteOrganizationRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainResponse {
-    return new CreateWhitelistedDomainResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteOrganizationRoleResponse {
+    return new DeleteOrganizationRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateWhitelistedDomainResponse | PlainMessage<CreateWhitelistedDomainResponse> | undefined, b: CreateWhitelistedDomainResponse | PlainMessage<CreateWhitelistedDomainResponse> | undefined): boolean {
-    return proto3.util.equals(CreateWhitelistedDomainResponse, a, b);
+  static equals(a: DeleteOrganizationRoleResponse | PlainMessage<DeleteOrganizationRoleResponse> | undefined, b: DeleteOrganizationRoleResponse | PlainMessage<DeleteOrganizationRoleResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteOrganizationRoleResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RemoveWhitelistedDomainRequest
+ * @generated from message rill.admin.v1.ListProjectRolesRequest
  */
-export class RemoveWhitelistedDomainRequest extends Message<RemoveWhitelistedDomainRequest> {
+export class ListProjectRolesRequest extends Message<ListProjectRolesRequest> {
   /**
    * @generated from field: string organization = 1;
    */
   organization = "";
 
-  /**
-   * @generated from field: string domain = 2;
-   */
-  domain = "";
-
-  constructor(data?: PartialMessage<RemoveWhitelistedDomainRequest>) {
+  constructor(data?: PartialMessage<ListProjectRolesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RemoveWhitelistedDomainRequest";
+  static readonly typeName = "rill.admin.v1.ListProjectRolesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveWhitelistedDomainRequest {
-    return new RemoveWhitelistedDomainRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectRolesRequest {
+    return new ListProjectRolesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainRequest {
-    return new RemoveWhitelistedDomainRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectRolesRequest {
+    return new ListProjectRolesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainRequest {
-    return new RemoveWhitelistedDomainRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectRolesRequest {
+    return new ListProjectRolesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RemoveWhitelistedDomainRequest | PlainMessage<RemoveWhitelistedDomainRequest> | undefined, b: RemoveWhitelistedDomainRequest | PlainMessage<RemoveWhitelistedDomainRequest> | undefined): boolean {
-    return proto3.util.equals(RemoveWhitelistedDomainRequest, a, b);
+  static equals(a: ListProjectRolesRequest | PlainMessage<ListProjectRolesRequest> | undefined, b: ListProjectRolesRequest | PlainMessage<ListProjectRolesRequest> | undefined): boolean {
+    return proto3.util.equals(ListProjectRolesRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RemoveWhitelistedDomainResponse
+ * @generated from message rill.admin.v1.ListProjectRolesResponse
  */
-export class RemoveWhitelistedDomainResponse extends Message<RemoveWhitelistedDomainResponse> {
-  constructor(data?: PartialMessage<RemoveWhitelistedDomainResponse>) {
+export class ListProjectRolesResponse extends Message<ListProjectRolesResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.ProjectRole roles = 1;
+   */
+  roles: ProjectRole[] = [];
+
+  constructor(data?: PartialMessage<ListProjectRolesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RemoveWhitelistedDomainResponse";
+  static readonly typeName = "rill.admin.v1.ListProjectRolesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "roles", kind: "message", T: ProjectRole, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveWhitelistedDomainResponse {
-    return new RemoveWhitelistedDomainResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectRolesResponse {
+    return new ListProjectRolesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainResponse {
-    return new RemoveWhitelistedDomainResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectRolesResponse {
+    return new ListProjectRolesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainResponse {
-    return new RemoveWhitelistedDomainResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectRolesResponse {
+    return new ListProjectRolesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RemoveWhitelistedDomainResponse | PlainMessage<RemoveWhitelistedDomainResponse> | undefined, b: RemoveWhitelistedDomainResponse | PlainMessage<RemoveWhitelistedDomainResponse> | undefined): boolean {
-    return proto3.util.equals(RemoveWhitelistedDomainResponse, a, b);
+  static equals(a: ListProjectRolesResponse | PlainMessage<ListProjectRolesResponse> | undefined, b: ListProjectRolesResponse | PlainMessage<ListProjectRolesResponse> | undefined): boolean {
+    return proto3.util.equals(ListProjectRolesResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListWhitelistedDomainsRequest
+ * @generated from message rill.admin.v1.CreateProjectRoleRequest
  */
-export class ListWhitelistedDomainsRequest extends Message<ListWhitelistedDomainsRequest> {
+export class CreateProjectRoleRequest extends Message<CreateProjectRoleRequest> {
   /**
    * @generated from field: string organization = 1;
    */
   organization = "";
 
-  constructor(data?: PartialMessage<ListWhitelistedDomainsRequest>) {
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: rill.admin.v1.ProjectPermissions permissions = 3;
+   */
+  permissions?: ProjectPermissions;
+
+  constructor(data?: PartialMessage<CreateProjectRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListWhitelistedDomainsRequest";
+  static readonly typeName = "rill.admin.v1.CreateProjectRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "permissions", kind: "message", T: ProjectPermissions },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWhitelistedDomainsRequest {
-    return new ListWhitelistedDomainsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectRoleRequest {
+    return new CreateProjectRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsRequest {
-    return new ListWhitelistedDomainsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectRoleRequest {
+    return new CreateProjectRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsRequest {
-    return new ListWhitelistedDomainsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectRoleRequest {
+    return new CreateProjectRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListWhitelistedDomainsRequest | PlainMessage<ListWhitelistedDomainsRequest> | undefined, b: ListWhitelistedDomainsRequest | PlainMessage<ListWhitelistedDomainsRequest> | undefined): boolean {
-    return proto3.util.equals(ListWhitelistedDomainsRequest, a, b);
+  static equals(a: CreateProjectRoleRequest | PlainMessage<CreateProjectRoleRequest> | undefined, b: CreateProjectRoleRequest | PlainMessage<CreateProjectRoleRequest> | undefined): boolean {
+    return proto3.util.equals(CreateProjectRoleRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListWhitelistedDomainsResponse
+ * @generated from message rill.admin.v1.CreateProjectRoleResponse
  */
-export class ListWhitelistedDomainsResponse extends Message<ListWhitelistedDomainsResponse> {
+export class CreateProjectRoleResponse extends Message<CreateProjectRoleResponse> {
   /**
-   * @generated from field: repeated rill.admin.v1.WhitelistedDomain domains = 1;
+   * @generated from field: rill.admin.v1.ProjectRole role = 1;
    */
-  domains: WhitelistedDomain[] = [];
+  role?: ProjectRole;
 
-  constructor(data?: PartialMessage<ListWhitelistedDomainsResponse>) {
+  constructor(data?: PartialMessage<CreateProjectRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListWhitelistedDomainsResponse";
+  static readonly typeName = "rill.admin.v1.CreateProjectRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "domains", kind: "message", T: WhitelistedDomain, repeated: true },
+    { no: 1, name: "role", kind: "message", T: ProjectRole },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWhitelistedDomainsResponse {
-    return new ListWhitelistedDomainsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectRoleResponse {
+    return new CreateProjectRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
-    return new ListWhitelistedDomainsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectRoleResponse {
+    return new CreateProjectRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
-    return new ListWhitelistedDomainsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectRoleResponse {
+    return new CreateProjectRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined, b: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined): boolean {
-    return proto3.util.equals(ListWhitelistedDomainsResponse, a, b);
+  static equals(a: CreateProjectRoleResponse | PlainMessage<CreateProjectRoleResponse> | undefined, b: CreateProjectRoleResponse | PlainMessage<CreateProjectRoleResponse> | undefined): boolean {
+    return proto3.util.equals(CreateProjectRoleResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateProjectWhitelistedDomainRequest
+ * @generated from message rill.admin.v1.UpdateProjectRoleRequest
  */
-export class CreateProjectWhitelistedDomainRequest extends Message<CreateProjectWhitelistedDomainRequest> {
+export class UpdateProjectRoleRequest extends Message<UpdateProjectRoleRequest> {
   /**
    * @generated from field: string organization = 1;
    */
   organization = "";
 
   /**
-   * @generated from field: string project = 2;
+   * @generated from field: string role = 2;
    */
-  project = "";
+  role = "";
 
   /**
-   * @generated from field: string domain = 3;
+   * @generated from field: optional string new_name = 3;
    */
-  domain = "";
+  newName?: string;
 
   /**
-   * @generated from field: string role = 4;
+   * Replaces the permissions of the role if set.
+   *
+   * @generated from field: rill.admin.v1.ProjectPermissions permissions = 4;
    */
-  role = "";
+  permissions?: ProjectPermissions;
 
-  constructor(data?: PartialMessage<CreateProjectWhitelistedDomainRequest>) {
+  constructor(data?: PartialMessage<UpdateProjectRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateProjectWhitelistedDomainRequest";
+  static readonly typeName = "rill.admin.v1.UpdateProjectRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "new_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "permissions", kind: "message", T: ProjectPermissions },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectWhitelistedDomainRequest {
-    return new CreateProjectWhitelistedDomainRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProjectRoleRequest {
+    return new UpdateProjectRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectWhitelistedDomainRequest {
-    return new CreateProjectWhitelistedDomainRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateProjectRoleRequest {
+    return new UpdateProjectRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectWhitelistedDomainRequest {
-    return new CreateProjectWhitelistedDomainRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateProjectRoleRequest {
+    return new UpdateProjectRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateProjectWhitelistedDomainRequest | PlainMessage<CreateProjectWhitelistedDomainRequest> | undefined, b: CreateProjectWhitelistedDomainRequest | PlainMessage<CreateProjectWhitelistedDomainRequest> | undefined): boolean {
-    return proto3.util.equals(CreateProjectWhitelistedDomainRequest, a, b);
+  static equals(a: UpdateProjectRoleRequest | PlainMessage<UpdateProjectRoleRequest> | undefined, b: UpdateProjectRoleRequest | PlainMessage<UpdateProjectRoleRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateProjectRoleRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateProjectWhitelistedDomainResponse
+ * @generated from message rill.admin.v1.UpdateProjectRoleResponse
  */
-export class CreateProjectWhitelistedDomainResponse extends Message<CreateProjectWhitelistedDomainResponse> {
-  constructor(data?: PartialMessage<CreateProjectWhitelistedDomainResponse>) {
+export class UpdateProjectRoleResponse extends Message<UpdateProjectRoleResponse> {
+  /**
+   * @generated from field: rill.admin.v1.ProjectRole role = 1;
+   */
+  role?: ProjectRole;
+
+  constructor(data?: PartialMessage<UpdateProjectRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateProjectWhitelistedDomainResponse";
+  static readonly typeName = "rill.admin.v1.UpdateProjectRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "role", kind: "message", T: ProjectRole },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectWhitelistedDomainResponse {
-    return new CreateProjectWhitelistedDomainResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProjectRoleResponse {
+    return new UpdateProjectRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectWhitelistedDomainResponse {
-    return new CreateProjectWhitelistedDomainResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateProjectRoleResponse {
+    return new UpdateProjectRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectWhitelistedDomainResponse {
-    return new CreateProjectWhitelistedDomainResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateProjectRoleResponse {
+    return new UpdateProjectRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateProjectWhitelistedDomainResponse | PlainMessage<CreateProjectWhitelistedDomainResponse> | undefined, b: CreateProjectWhitelistedDomainResponse | PlainMessage<CreateProjectWhitelistedDomainResponse> | undefined): boolean {
-    return proto3.util.equals(CreateProjectWhitelistedDomainResponse, a, b);
+  static equals(a: UpdateProjectRoleResponse | PlainMessage<UpdateProjectRoleResponse> | undefined, b: UpdateProjectRoleResponse | PlainMessage<UpdateProjectRoleResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateProjectRoleResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RemoveProjectWhitelistedDomainRequest
+ * @generated from message rill.admin.v1.DeleteProjectRoleRequest
  */
-export class RemoveProjectWhitelistedDomainRequest extends Message<RemoveProjectWhitelistedDomainRequest> {
+export class DeleteProjectRoleRequest extends Message<DeleteProjectRoleRequest> {
   /**
    * @generated from field: string organization = 1;
    */
   organization = "";
 
   /**
-   * @generated from field: string project = 2;
-   */
-  project = "";
-
-  /**
-   * @generated from field: string domain = 3;
+   * @generated from field: string role = 2;
    */
-  domain = "";
+  role = "";
 
-  constructor(data?: PartialMessage<RemoveProjectWhitelistedDomainRequest>) {
+  constructor(data?: PartialMessage<DeleteProjectRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RemoveProjectWhitelistedDomainRequest";
+  static readonly typeName = "rill.admin.v1.DeleteProjectRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveProjectWhitelistedDomainRequest {
-    return new RemoveProjectWhitelistedDomainRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteProjectRoleRequest {
+    return new DeleteProjectRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveProjectWhitelistedDomainRequest {
-    return new RemoveProjectWhitelistedDomainRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteProjectRoleRequest {
+    return new DeleteProjectRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveProjectWhitelistedDomainRequest {
-    return new RemoveProjectWhitelistedDomainRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteProjectRoleRequest {
+    return new DeleteProjectRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RemoveProjectWhitelistedDomainRequest | PlainMessage<RemoveProjectWhitelistedDomainRequest> | undefined, b: RemoveProjectWhitelistedDomainRequest | PlainMessage<RemoveProjectWhitelistedDomainRequest> | undefined): boolean {
-    return proto3.util.equals(RemoveProjectWhitelistedDomainRequest, a, b);
+  static equals(a: DeleteProjectRoleRequest | PlainMessage<DeleteProjectRoleRequest> | undefined, b: DeleteProjectRoleRequest | PlainMessage<DeleteProjectRoleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteProjectRoleRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RemoveProjectWhitelistedDomainResponse
+ * @generated from message rill.admin.v1.DeleteProjectRoleResponse
  */
-export class RemoveProjectWhitelistedDomainResponse extends Message<RemoveProjectWhitelistedDomainResponse> {
-  constructor(data?: PartialMessage<RemoveProjectWhitelistedDomainResponse>) {
+export class DeleteProjectRoleResponse extends Message<DeleteProjectRoleResponse> {
+  constructor(data?: PartialMessage<DeleteProjectRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RemoveProjectWhitelistedDomainResponse";
+  static readonly typeName = "rill.admin.v1.DeleteProjectRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveProjectWhitelistedDomainResponse {
-    return new RemoveProjectWhitelistedDomainResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteProjectRoleResponse {
+    return new DeleteProjectRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveProjectWhitelistedDomainResponse {
-    return new RemoveProjectWhitelistedDomainResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteProjectRoleResponse {
+    return new DeleteProjectRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveProjectWhitelistedDomainResponse {
-    return new RemoveProjectWhitelistedDomainResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteProjectRoleResponse {
+    return new DeleteProjectRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RemoveProjectWhitelistedDomainResponse | PlainMessage<RemoveProjectWhitelistedDomainResponse> | undefined, b: RemoveProjectWhitelistedDomainResponse | PlainMessage<RemoveProjectWhitelistedDomainResponse> | undefined): boolean {
-    return proto3.util.equals(RemoveProjectWhitelistedDomainResponse, a, b);
+  static equals(a: DeleteProjectRoleResponse | PlainMessage<DeleteProjectRoleResponse> | undefined, b: DeleteProjectRoleResponse | PlainMessage<DeleteProjectRoleResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteProjectRoleResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListProjectWhitelistedDomainsRequest
+ * @generated from message rill.admin.v1.GetCurrentUserRequest
  */
-export class ListProjectWhitelistedDomainsRequest extends Message<ListProjectWhitelistedDomainsRequest> {
-  /**
-   * @generated from field: string organization = 1;
-   */
-  organization = "";
-
-  /**
-   * @generated from field: string project = 2;
-   */
-  project = "";
-
-  constructor(data?: PartialMessage<ListProjectWhitelistedDomainsRequest>) {
+export class GetCurrentUserRequest extends Message<GetCurrentUserRequest> {
+  constructor(data?: PartialMessage<GetCurrentUserRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListProjectWhitelistedDomainsRequest";
+  static readonly typeName = "rill.admin.v1.GetCurrentUserRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectWhitelistedDomainsRequest {
-    return new ListProjectWhitelistedDomainsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserRequest {
+    return new GetCurrentUserRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectWhitelistedDomainsRequest {
-    return new ListProjectWhitelistedDomainsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
+    return new GetCurrentUserRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectWhitelistedDomainsRequest {
-    return new ListProjectWhitelistedDomainsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
+    return new GetCurrentUserRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListProjectWhitelistedDomainsRequest | PlainMessage<ListProjectWhitelistedDomainsRequest> | undefined, b: ListProjectWhitelistedDomainsRequest | PlainMessage<ListProjectWhitelistedDomainsRequest> | undefined): boolean {
-    return proto3.util.equals(ListProjectWhitelistedDomainsRequest, a, b);
+  static equals(a: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined, b: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined): boolean {
+    return proto3.util.equals(GetCurrentUserRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListProjectWhitelistedDomainsResponse
+ * @generated from message rill.admin.v1.GetCurrentUserResponse
  */
-export class ListProjectWhitelistedDomainsResponse extends Message<ListProjectWhitelistedDomainsResponse> {
+export class GetCurrentUserResponse extends Message<GetCurrentUserResponse> {
   /**
-   * @generated from field: repeated rill.admin.v1.WhitelistedDomain domains = 1;
+   * @generated from field: rill.admin.v1.User user = 1;
    */
-  domains: WhitelistedDomain[] = [];
+  user?: User;
 
-  constructor(data?: PartialMessage<ListProjectWhitelistedDomainsResponse>) {
+  /**
+   * @generated from field: rill.admin.v1.UserPreferences preferences = 2;
+   */
+  preferences?: UserPreferences;
+
+  constructor(data?: PartialMessage<GetCurrentUserResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListProjectWhitelistedDomainsResponse";
+  static readonly typeName = "rill.admin.v1.GetCurrentUserResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "domains", kind: "message", T: WhitelistedDomain, repeated: true },
+    { no: 1, name: "user", kind: "message", T: User },
+    { no: 2, name: "preferences", kind: "message", T: UserPreferences },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectWhitelistedDomainsResponse {
-    return new ListProjectWhitelistedDomainsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserResponse {
+    return new GetCurrentUserResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectWhitelistedDomainsResponse {
-    return new ListProjectWhitelistedDomainsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
+    return new GetCurrentUserResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectWhitelistedDomainsResponse {
-    return new ListProjectWhitelistedDomainsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
+    return new GetCurrentUserResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListProjectWhitelistedDomainsResponse | PlainMessage<ListProjectWhitelistedDomainsResponse> | undefined, b: ListProjectWhitelistedDomainsResponse | PlainMessage<ListProjectWhitelistedDomainsResponse> | undefined): boolean {
-    return proto3.util.equals(ListProjectWhitelistedDomainsResponse, a, b);
+  static equals(a: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined, b: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined): boolean {
+    return proto3.util.equals(GetCurrentUserResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetRepoMetaRequest
+ * @generated from message rill.admin.v1.GetUserRequest
  */
-export class GetRepoMetaRequest extends Message<GetRepoMetaRequest> {
-  /**
-   * @generated from field: string project_id = 1;
-   */
-  projectId = "";
-
+export class GetUserRequest extends Message<GetUserRequest> {
   /**
-   * @generated from field: string branch = 2;
+   * @generated from field: string email = 1;
    */
-  branch = "";
+  email = "";
 
-  constructor(data?: PartialMessage<GetRepoMetaRequest>) {
+  constructor(data?: PartialMessage<GetUserRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetRepoMetaRequest";
+  static readonly typeName = "rill.admin.v1.GetUserRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRepoMetaRequest {
-    return new GetRepoMetaRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserRequest {
+    return new GetUserRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRepoMetaRequest {
-    return new GetRepoMetaRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserRequest {
+    return new GetUserRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRepoMetaRequest {
-    return new GetRepoMetaRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserRequest {
+    return new GetUserRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetRepoMetaRequest | PlainMessage<GetRepoMetaRequest> | undefined, b: GetRepoMetaRequest | PlainMessage<GetRepoMetaRequest> | undefined): boolean {
-    return proto3.util.equals(GetRepoMetaRequest, a, b);
+  static equals(a: GetUserRequest | PlainMessage<GetUserRequest> | undefined, b: GetUserRequest | PlainMessage<GetUserRequest> | undefined): boolean {
+    return proto3.util.equals(GetUserRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetRepoMetaResponse
+ * @generated from message rill.admin.v1.GetUserResponse
  */
-export class GetRepoMetaResponse extends Message<GetRepoMetaResponse> {
-  /**
-   * @generated from field: string git_url = 1;
-   */
-  gitUrl = "";
-
-  /**
-   * @generated from field: google.protobuf.Timestamp git_url_expires_on = 2;
-   */
-  gitUrlExpiresOn?: Timestamp;
-
+export class GetUserResponse extends Message<GetUserResponse> {
   /**
-   * @generated from field: string git_subpath = 3;
+   * @generated from field: rill.admin.v1.User user = 1;
    */
-  gitSubpath = "";
+  user?: User;
 
-  constructor(data?: PartialMessage<GetRepoMetaResponse>) {
+  constructor(data?: PartialMessage<GetUserResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetRepoMetaResponse";
+  static readonly typeName = "rill.admin.v1.GetUserResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "git_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "git_url_expires_on", kind: "message", T: Timestamp },
-    { no: 3, name: "git_subpath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "user", kind: "message", T: User },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRepoMetaResponse {
-    return new GetRepoMetaResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserResponse {
+    return new GetUserResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRepoMetaResponse {
-    return new GetRepoMetaResponse().fromJson(jsonValue, options);
-  }
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserResponse {
+    return new GetUserResponse().fromJson(jsonValue, options);
+  }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRepoMetaResponse {
-    return new GetRepoMetaResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserResponse {
+    return new GetUserResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetRepoMetaResponse | PlainMessage<GetRepoMetaResponse> | undefined, b: GetRepoMetaResponse | PlainMessage<GetRepoMetaResponse> | undefined): boolean {
-    return proto3.util.equals(GetRepoMetaResponse, a, b);
+  static equals(a: GetUserResponse | PlainMessage<GetUserResponse> | undefined, b: GetUserResponse | PlainMessage<GetUserResponse> | undefined): boolean {
+    return proto3.util.equals(GetUserResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.PullVirtualRepoRequest
+ * @generated from message rill.admin.v1.UserPreferences
  */
-export class PullVirtualRepoRequest extends Message<PullVirtualRepoRequest> {
-  /**
-   * @generated from field: string project_id = 1;
-   */
-  projectId = "";
-
-  /**
-   * @generated from field: string branch = 2;
-   */
-  branch = "";
-
-  /**
-   * @generated from field: uint32 page_size = 3;
-   */
-  pageSize = 0;
-
+export class UserPreferences extends Message<UserPreferences> {
   /**
-   * @generated from field: string page_token = 4;
+   * @generated from field: optional string time_zone = 1;
    */
-  pageToken = "";
+  timeZone?: string;
 
-  constructor(data?: PartialMessage<PullVirtualRepoRequest>) {
+  constructor(data?: PartialMessage<UserPreferences>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.PullVirtualRepoRequest";
+  static readonly typeName = "rill.admin.v1.UserPreferences";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullVirtualRepoRequest {
-    return new PullVirtualRepoRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UserPreferences {
+    return new UserPreferences().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullVirtualRepoRequest {
-    return new PullVirtualRepoRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UserPreferences {
+    return new UserPreferences().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullVirtualRepoRequest {
-    return new PullVirtualRepoRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UserPreferences {
+    return new UserPreferences().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PullVirtualRepoRequest | PlainMessage<PullVirtualRepoRequest> | undefined, b: PullVirtualRepoRequest | PlainMessage<PullVirtualRepoRequest> | undefined): boolean {
-    return proto3.util.equals(PullVirtualRepoRequest, a, b);
+  static equals(a: UserPreferences | PlainMessage<UserPreferences> | undefined, b: UserPreferences | PlainMessage<UserPreferences> | undefined): boolean {
+    return proto3.util.equals(UserPreferences, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.PullVirtualRepoResponse
+ * @generated from message rill.admin.v1.UpdateUserPreferencesRequest
  */
-export class PullVirtualRepoResponse extends Message<PullVirtualRepoResponse> {
-  /**
-   * @generated from field: repeated rill.admin.v1.VirtualFile files = 1;
-   */
-  files: VirtualFile[] = [];
-
+export class UpdateUserPreferencesRequest extends Message<UpdateUserPreferencesRequest> {
   /**
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: rill.admin.v1.UserPreferences preferences = 1;
    */
-  nextPageToken = "";
+  preferences?: UserPreferences;
 
-  constructor(data?: PartialMessage<PullVirtualRepoResponse>) {
+  constructor(data?: PartialMessage<UpdateUserPreferencesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.PullVirtualRepoResponse";
+  static readonly typeName = "rill.admin.v1.UpdateUserPreferencesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "files", kind: "message", T: VirtualFile, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "preferences", kind: "message", T: UserPreferences },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullVirtualRepoResponse {
-    return new PullVirtualRepoResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateUserPreferencesRequest {
+    return new UpdateUserPreferencesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullVirtualRepoResponse {
-    return new PullVirtualRepoResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateUserPreferencesRequest {
+    return new UpdateUserPreferencesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullVirtualRepoResponse {
-    return new PullVirtualRepoResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateUserPreferencesRequest {
+    return new UpdateUserPreferencesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PullVirtualRepoResponse | PlainMessage<PullVirtualRepoResponse> | undefined, b: PullVirtualRepoResponse | PlainMessage<PullVirtualRepoResponse> | undefined): boolean {
-    return proto3.util.equals(PullVirtualRepoResponse, a, b);
+  static equals(a: UpdateUserPreferencesRequest | PlainMessage<UpdateUserPreferencesRequest> | undefined, b: UpdateUserPreferencesRequest | PlainMessage<UpdateUserPreferencesRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateUserPreferencesRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetReportMetaRequest
+ * @generated from message rill.admin.v1.UpdateUserPreferencesResponse
  */
-export class GetReportMetaRequest extends Message<GetReportMetaRequest> {
-  /**
-   * @generated from field: string project_id = 1;
-   */
-  projectId = "";
-
-  /**
-   * @generated from field: string branch = 2;
-   */
-  branch = "";
-
-  /**
-   * @generated from field: string report = 3;
-   */
-  report = "";
-
-  /**
-   * @generated from field: map<string, string> annotations = 4;
-   */
-  annotations: { [key: string]: string } = {};
-
+export class UpdateUserPreferencesResponse extends Message<UpdateUserPreferencesResponse> {
   /**
-   * @generated from field: google.protobuf.Timestamp execution_time = 5;
+   * @generated from field: rill.admin.v1.UserPreferences preferences = 1;
    */
-  executionTime?: Timestamp;
+  preferences?: UserPreferences;
 
-  constructor(data?: PartialMessage<GetReportMetaRequest>) {
+  constructor(data?: PartialMessage<UpdateUserPreferencesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetReportMetaRequest";
+  static readonly typeName = "rill.admin.v1.UpdateUserPreferencesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "report", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
-    { no: 5, name: "execution_time", kind: "message", T: Timestamp },
+    { no: 1, name: "preferences", kind: "message", T: UserPreferences },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReportMetaRequest {
-    return new GetReportMetaRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateUserPreferencesResponse {
+    return new UpdateUserPreferencesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReportMetaRequest {
-    return new GetReportMetaRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateUserPreferencesResponse {
+    return new UpdateUserPreferencesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReportMetaRequest {
-    return new GetReportMetaRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateUserPreferencesResponse {
+    return new UpdateUserPreferencesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetReportMetaRequest | PlainMessage<GetReportMetaRequest> | undefined, b: GetReportMetaRequest | PlainMessage<GetReportMetaRequest> | undefined): boolean {
-    return proto3.util.equals(GetReportMetaRequest, a, b);
+  static equals(a: UpdateUserPreferencesResponse | PlainMessage<UpdateUserPreferencesResponse> | undefined, b: UpdateUserPreferencesResponse | PlainMessage<UpdateUserPreferencesResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateUserPreferencesResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetReportMetaResponse
+ * @generated from message rill.admin.v1.ListBookmarksRequest
  */
-export class GetReportMetaResponse extends Message<GetReportMetaResponse> {
+export class ListBookmarksRequest extends Message<ListBookmarksRequest> {
   /**
-   * @generated from field: string open_url = 1;
+   * @generated from field: string project_id = 1;
    */
-  openUrl = "";
+  projectId = "";
 
   /**
-   * @generated from field: string export_url = 2;
+   * @generated from field: string resource_kind = 2;
    */
-  exportUrl = "";
+  resourceKind = "";
 
   /**
-   * @generated from field: string edit_url = 3;
+   * @generated from field: string resource_name = 3;
    */
-  editUrl = "";
+  resourceName = "";
 
-  constructor(data?: PartialMessage<GetReportMetaResponse>) {
+  constructor(data?: PartialMessage<ListBookmarksRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetReportMetaResponse";
+  static readonly typeName = "rill.admin.v1.ListBookmarksRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "open_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "export_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "edit_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "resource_kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "resource_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReportMetaResponse {
-    return new GetReportMetaResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBookmarksRequest {
+    return new ListBookmarksRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReportMetaResponse {
-    return new GetReportMetaResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBookmarksRequest {
+    return new ListBookmarksRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReportMetaResponse {
-    return new GetReportMetaResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBookmarksRequest {
+    return new ListBookmarksRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetReportMetaResponse | PlainMessage<GetReportMetaResponse> | undefined, b: GetReportMetaResponse | PlainMessage<GetReportMetaResponse> | undefined): boolean {
-    return proto3.util.equals(GetReportMetaResponse, a, b);
+  static equals(a: ListBookmarksRequest | PlainMessage<ListBookmarksRequest> | undefined, b: ListBookmarksRequest | PlainMessage<ListBookmarksRequest> | undefined): boolean {
+    return proto3.util.equals(ListBookmarksRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetAlertMetaRequest
+ * @generated from message rill.admin.v1.ListBookmarksResponse
  */
-export class GetAlertMetaRequest extends Message<GetAlertMetaRequest> {
-  /**
-   * @generated from field: string project_id = 1;
-   */
-  projectId = "";
-
-  /**
-   * @generated from field: string branch = 2;
-   */
-  branch = "";
-
-  /**
-   * @generated from field: string alert = 3;
-   */
-  alert = "";
-
-  /**
-   * @generated from field: map<string, string> annotations = 4;
-   */
-  annotations: { [key: string]: string } = {};
-
+export class ListBookmarksResponse extends Message<ListBookmarksResponse> {
   /**
-   * @generated from oneof rill.admin.v1.GetAlertMetaRequest.query_for
+   * @generated from field: repeated rill.admin.v1.Bookmark bookmarks = 1;
    */
-  queryFor: {
-    /**
-     * @generated from field: string query_for_user_id = 5;
-     */
-    value: string;
-    case: "queryForUserId";
-  } | {
-    /**
-     * @generated from field: string query_for_user_email = 6;
-     */
-    value: string;
-    case: "queryForUserEmail";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  bookmarks: Bookmark[] = [];
 
-  constructor(data?: PartialMessage<GetAlertMetaRequest>) {
+  constructor(data?: PartialMessage<ListBookmarksResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetAlertMetaRequest";
+  static readonly typeName = "rill.admin.v1.ListBookmarksResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "alert", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
-    { no: 5, name: "query_for_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "query_for" },
-    { no: 6, name: "query_for_user_email", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "query_for" },
+    { no: 1, name: "bookmarks", kind: "message", T: Bookmark, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlertMetaRequest {
-    return new GetAlertMetaRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBookmarksResponse {
+    return new ListBookmarksResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlertMetaRequest {
-    return new GetAlertMetaRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBookmarksResponse {
+    return new ListBookmarksResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlertMetaRequest {
-    return new GetAlertMetaRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBookmarksResponse {
+    return new ListBookmarksResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetAlertMetaRequest | PlainMessage<GetAlertMetaRequest> | undefined, b: GetAlertMetaRequest | PlainMessage<GetAlertMetaRequest> | undefined): boolean {
-    return proto3.util.equals(GetAlertMetaRequest, a, b);
+  static equals(a: ListBookmarksResponse | PlainMessage<ListBookmarksResponse> | undefined, b: ListBookmarksResponse | PlainMessage<ListBookmarksResponse> | undefined): boolean {
+    return proto3.util.equals(ListBookmarksResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetAlertMetaResponse
+ * @generated from message rill.admin.v1.GetBookmarkRequest
  */
-export class GetAlertMetaResponse extends Message<GetAlertMetaResponse> {
+export class GetBookmarkRequest extends Message<GetBookmarkRequest> {
   /**
-   * @generated from field: string open_url = 1;
+   * @generated from field: string bookmark_id = 1;
    */
-  openUrl = "";
+  bookmarkId = "";
 
-  /**
-   * @generated from field: string edit_url = 2;
-   */
-  editUrl = "";
+  constructor(data?: PartialMessage<GetBookmarkRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetBookmarkRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bookmark_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBookmarkRequest {
+    return new GetBookmarkRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBookmarkRequest {
+    return new GetBookmarkRequest().fromJson(jsonValue, options);
+  }
 
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBookmarkRequest {
+    return new GetBookmarkRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBookmarkRequest | PlainMessage<GetBookmarkRequest> | undefined, b: GetBookmarkRequest | PlainMessage<GetBookmarkRequest> | undefined): boolean {
+    return proto3.util.equals(GetBookmarkRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetBookmarkResponse
+ */
+export class GetBookmarkResponse extends Message<GetBookmarkResponse> {
   /**
-   * @generated from field: google.protobuf.Struct query_for_attributes = 3;
+   * @generated from field: rill.admin.v1.Bookmark bookmark = 1;
    */
-  queryForAttributes?: Struct;
+  bookmark?: Bookmark;
 
-  constructor(data?: PartialMessage<GetAlertMetaResponse>) {
+  constructor(data?: PartialMessage<GetBookmarkResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetAlertMetaResponse";
+  static readonly typeName = "rill.admin.v1.GetBookmarkResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "open_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "edit_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "query_for_attributes", kind: "message", T: Struct },
+    { no: 1, name: "bookmark", kind: "message", T: Bookmark },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlertMetaResponse {
-    return new GetAlertMetaResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBookmarkResponse {
+    return new GetBookmarkResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlertMetaResponse {
-    return new GetAlertMetaResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBookmarkResponse {
+    return new GetBookmarkResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlertMetaResponse {
-    return new GetAlertMetaResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBookmarkResponse {
+    return new GetBookmarkResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetAlertMetaResponse | PlainMessage<GetAlertMetaResponse> | undefined, b: GetAlertMetaResponse | PlainMessage<GetAlertMetaResponse> | undefined): boolean {
-    return proto3.util.equals(GetAlertMetaResponse, a, b);
+  static equals(a: GetBookmarkResponse | PlainMessage<GetBookmarkResponse> | undefined, b: GetBookmarkResponse | PlainMessage<GetBookmarkResponse> | undefined): boolean {
+    return proto3.util.equals(GetBookmarkResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateReportRequest
+ * @generated from message rill.admin.v1.CreateBookmarkRequest
  */
-export class CreateReportRequest extends Message<CreateReportRequest> {
+export class CreateBookmarkRequest extends Message<CreateBookmarkRequest> {
   /**
-   * @generated from field: string organization = 1;
+   * @generated from field: string display_name = 1;
    */
-  organization = "";
+  displayName = "";
 
   /**
-   * @generated from field: string project = 2;
+   * @generated from field: string description = 2;
    */
-  project = "";
+  description = "";
 
   /**
-   * @generated from field: rill.admin.v1.ReportOptions options = 3;
+   * @generated from field: bytes data = 3;
    */
-  options?: ReportOptions;
+  data = new Uint8Array(0);
 
-  constructor(data?: PartialMessage<CreateReportRequest>) {
+  /**
+   * @generated from field: string resource_kind = 4;
+   */
+  resourceKind = "";
+
+  /**
+   * @generated from field: string resource_name = 5;
+   */
+  resourceName = "";
+
+  /**
+   * @generated from field: string project_id = 6;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: bool default = 7;
+   */
+  default = false;
+
+  /**
+   * @generated from field: bool shared = 8;
+   */
+  shared = false;
+
+  constructor(data?: PartialMessage<CreateBookmarkRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateReportRequest";
+  static readonly typeName = "rill.admin.v1.CreateBookmarkRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "options", kind: "message", T: ReportOptions },
+    { no: 1, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 4, name: "resource_kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "resource_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "default", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "shared", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateReportRequest {
-    return new CreateReportRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBookmarkRequest {
+    return new CreateBookmarkRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateReportRequest {
-    return new CreateReportRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBookmarkRequest {
+    return new CreateBookmarkRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateReportRequest {
-    return new CreateReportRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBookmarkRequest {
+    return new CreateBookmarkRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateReportRequest | PlainMessage<CreateReportRequest> | undefined, b: CreateReportRequest | PlainMessage<CreateReportRequest> | undefined): boolean {
-    return proto3.util.equals(CreateReportRequest, a, b);
+  static equals(a: CreateBookmarkRequest | PlainMessage<CreateBookmarkRequest> | undefined, b: CreateBookmarkRequest | PlainMessage<CreateBookmarkRequest> | undefined): boolean {
+    return proto3.util.equals(CreateBookmarkRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateReportResponse
+ * @generated from message rill.admin.v1.CreateBookmarkResponse
  */
-export class CreateReportResponse extends Message<CreateReportResponse> {
+export class CreateBookmarkResponse extends Message<CreateBookmarkResponse> {
   /**
-   * @generated from field: string name = 1;
+   * @generated from field: rill.admin.v1.Bookmark bookmark = 1;
    */
-  name = "";
+  bookmark?: Bookmark;
 
-  constructor(data?: PartialMessage<CreateReportResponse>) {
+  constructor(data?: PartialMessage<CreateBookmarkResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateReportResponse";
+  static readonly typeName = "rill.admin.v1.CreateBookmarkResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "bookmark", kind: "message", T: Bookmark },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateReportResponse {
-    return new CreateReportResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBookmarkResponse {
+    return new CreateBookmarkResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateReportResponse {
-    return new CreateReportResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBookmarkResponse {
+    return new CreateBookmarkResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateReportResponse {
-    return new CreateReportResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBookmarkResponse {
+    return new CreateBookmarkResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateReportResponse | PlainMessage<CreateReportResponse> | undefined, b: CreateReportResponse | PlainMessage<CreateReportResponse> | undefined): boolean {
-    return proto3.util.equals(CreateReportResponse, a, b);
+  static equals(a: CreateBookmarkResponse | PlainMessage<CreateBookmarkResponse> | undefined, b: CreateBookmarkResponse | PlainMessage<CreateBookmarkResponse> | undefined): boolean {
+    return proto3.util.equals(CreateBookmarkResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.EditReportRequest
+ * @generated from message rill.admin.v1.UpdateBookmarkRequest
  */
-export class EditReportRequest extends Message<EditReportRequest> {
+export class UpdateBookmarkRequest extends Message<UpdateBookmarkRequest> {
   /**
-   * @generated from field: string organization = 1;
+   * @generated from field: string bookmark_id = 1;
    */
-  organization = "";
+  bookmarkId = "";
 
   /**
-   * @generated from field: string project = 2;
+   * @generated from field: string display_name = 2;
    */
-  project = "";
+  displayName = "";
 
   /**
-   * @generated from field: string name = 3;
+   * @generated from field: string description = 4;
    */
-  name = "";
+  description = "";
 
   /**
-   * @generated from field: rill.admin.v1.ReportOptions options = 4;
+   * @generated from field: bytes data = 3;
    */
-  options?: ReportOptions;
+  data = new Uint8Array(0);
 
-  constructor(data?: PartialMessage<EditReportRequest>) {
+  /**
+   * @generated from field: bool default = 5;
+   */
+  default = false;
+
+  /**
+   * @generated from field: bool shared = 6;
+   */
+  shared = false;
+
+  constructor(data?: PartialMessage<UpdateBookmarkRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.EditReportRequest";
+  static readonly typeName = "rill.admin.v1.UpdateBookmarkRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "options", kind: "message", T: ReportOptions },
+    { no: 1, name: "bookmark_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 5, name: "default", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "shared", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EditReportRequest {
-    return new EditReportRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateBookmarkRequest {
+    return new UpdateBookmarkRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EditReportRequest {
-    return new EditReportRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateBookmarkRequest {
+    return new UpdateBookmarkRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EditReportRequest {
-    return new EditReportRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateBookmarkRequest {
+    return new UpdateBookmarkRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: EditReportRequest | PlainMessage<EditReportRequest> | undefined, b: EditReportRequest | PlainMessage<EditReportRequest> | undefined): boolean {
-    return proto3.util.equals(EditReportRequest, a, b);
+  static equals(a: UpdateBookmarkRequest | PlainMessage<UpdateBookmarkRequest> | undefined, b: UpdateBookmarkRequest | PlainMessage<UpdateBookmarkRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateBookmarkRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.EditReportResponse
+ * @generated from message rill.admin.v1.UpdateBookmarkResponse
  */
-export class EditReportResponse extends Message<EditReportResponse> {
-  constructor(data?: PartialMessage<EditReportResponse>) {
+export class UpdateBookmarkResponse extends Message<UpdateBookmarkResponse> {
+  constructor(data?: PartialMessage<UpdateBookmarkResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.EditReportResponse";
+  static readonly typeName = "rill.admin.v1.UpdateBookmarkResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EditReportResponse {
-    return new EditReportResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateBookmarkResponse {
+    return new UpdateBookmarkResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EditReportResponse {
-    return new EditReportResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateBookmarkResponse {
+    return new UpdateBookmarkResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EditReportResponse {
-    return new EditReportResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateBookmarkResponse {
+    return new UpdateBookmarkResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: EditReportResponse | PlainMessage<EditReportResponse> | undefined, b: EditReportResponse | PlainMessage<EditReportResponse> | undefined): boolean {
-    return proto3.util.equals(EditReportResponse, a, b);
+  static equals(a: UpdateBookmarkResponse | PlainMessage<UpdateBookmarkResponse> | undefined, b: UpdateBookmarkResponse | PlainMessage<UpdateBookmarkResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateBookmarkResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.UnsubscribeReportRequest
+ * @generated from message rill.admin.v1.RemoveBookmarkRequest
  */
-export class UnsubscribeReportRequest extends Message<UnsubscribeReportRequest> {
-  /**
-   * @generated from field: string organization = 1;
-   */
-  organization = "";
-
-  /**
-   * @generated from field: string project = 2;
-   */
-  project = "";
-
+export class RemoveBookmarkRequest extends Message<RemoveBookmarkRequest> {
   /**
-   * @generated from field: string name = 3;
+   * @generated from field: string bookmark_id = 1;
    */
-  name = "";
+  bookmarkId = "";
 
-  constructor(data?: PartialMessage<UnsubscribeReportRequest>) {
+  constructor(data?: PartialMessage<RemoveBookmarkRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.UnsubscribeReportRequest";
+  static readonly typeName = "rill.admin.v1.RemoveBookmarkRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "bookmark_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsubscribeReportRequest {
-    return new UnsubscribeReportRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveBookmarkRequest {
+    return new RemoveBookmarkRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsubscribeReportRequest {
-    return new UnsubscribeReportRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveBookmarkRequest {
+    return new RemoveBookmarkRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsubscribeReportRequest {
-    return new UnsubscribeReportRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveBookmarkRequest {
+    return new RemoveBookmarkRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UnsubscribeReportRequest | PlainMessage<UnsubscribeReportRequest> | undefined, b: UnsubscribeReportRequest | PlainMessage<UnsubscribeReportRequest> | undefined): boolean {
-    return proto3.util.equals(UnsubscribeReportRequest, a, b);
+  static equals(a: RemoveBookmarkRequest | PlainMessage<RemoveBookmarkRequest> | undefined, b: RemoveBookmarkRequest | PlainMessage<RemoveBookmarkRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveBookmarkRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.UnsubscribeReportResponse
+ * @generated from message rill.admin.v1.RemoveBookmarkResponse
  */
-export class UnsubscribeReportResponse extends Message<UnsubscribeReportResponse> {
-  constructor(data?: PartialMessage<UnsubscribeReportResponse>) {
+export class RemoveBookmarkResponse extends Message<RemoveBookmarkResponse> {
+  constructor(data?: PartialMessage<RemoveBookmarkResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.UnsubscribeReportResponse";
+  static readonly typeName = "rill.admin.v1.RemoveBookmarkResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsubscribeReportResponse {
-    return new UnsubscribeReportResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveBookmarkResponse {
+    return new RemoveBookmarkResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsubscribeReportResponse {
-    return new UnsubscribeReportResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveBookmarkResponse {
+    return new RemoveBookmarkResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsubscribeReportResponse {
-    return new UnsubscribeReportResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveBookmarkResponse {
+    return new RemoveBookmarkResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UnsubscribeReportResponse | PlainMessage<UnsubscribeReportResponse> | undefined, b: UnsubscribeReportResponse | PlainMessage<UnsubscribeReportResponse> | undefined): boolean {
-    return proto3.util.equals(UnsubscribeReportResponse, a, b);
+  static equals(a: RemoveBookmarkResponse | PlainMessage<RemoveBookmarkResponse> | undefined, b: RemoveBookmarkResponse | PlainMessage<RemoveBookmarkResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveBookmarkResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.DeleteReportRequest
+ * @generated from message rill.admin.v1.SearchUsersRequest
  */
-export class DeleteReportRequest extends Message<DeleteReportRequest> {
+export class SearchUsersRequest extends Message<SearchUsersRequest> {
   /**
-   * @generated from field: string organization = 1;
+   * @generated from field: string email_pattern = 1;
    */
-  organization = "";
+  emailPattern = "";
 
   /**
-   * @generated from field: string project = 2;
+   * @generated from field: uint32 page_size = 2;
    */
-  project = "";
+  pageSize = 0;
 
   /**
-   * @generated from field: string name = 3;
+   * @generated from field: string page_token = 3;
    */
-  name = "";
+  pageToken = "";
 
-  constructor(data?: PartialMessage<DeleteReportRequest>) {
+  constructor(data?: PartialMessage<SearchUsersRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.DeleteReportRequest";
+  static readonly typeName = "rill.admin.v1.SearchUsersRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "email_pattern", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteReportRequest {
-    return new DeleteReportRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchUsersRequest {
+    return new SearchUsersRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteReportRequest {
-    return new DeleteReportRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchUsersRequest {
+    return new SearchUsersRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteReportRequest {
-    return new DeleteReportRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchUsersRequest {
+    return new SearchUsersRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteReportRequest | PlainMessage<DeleteReportRequest> | undefined, b: DeleteReportRequest | PlainMessage<DeleteReportRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteReportRequest, a, b);
+  static equals(a: SearchUsersRequest | PlainMessage<SearchUsersRequest> | undefined, b: SearchUsersRequest | PlainMessage<SearchUsersRequest> | undefined): boolean {
+    return proto3.util.equals(SearchUsersRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.DeleteReportResponse
+ * @generated from message rill.admin.v1.SearchUsersResponse
  */
-export class DeleteReportResponse extends Message<DeleteReportResponse> {
-  constructor(data?: PartialMessage<DeleteReportResponse>) {
+export class SearchUsersResponse extends Message<SearchUsersResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.User users = 1;
+   */
+  users: User[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<SearchUsersResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.DeleteReportResponse";
+  static readonly typeName = "rill.admin.v1.SearchUsersResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "users", kind: "message", T: User, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteReportResponse {
-    return new DeleteReportResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchUsersResponse {
+    return new SearchUsersResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteReportResponse {
-    return new DeleteReportResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchUsersResponse {
+    return new SearchUsersResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteReportResponse {
-    return new DeleteReportResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchUsersResponse {
+    return new SearchUsersResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteReportResponse | PlainMessage<DeleteReportResponse> | undefined, b: DeleteReportResponse | PlainMessage<DeleteReportResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteReportResponse, a, b);
+  static equals(a: SearchUsersResponse | PlainMessage<SearchUsersResponse> | undefined, b: SearchUsersResponse | PlainMessage<SearchUsersResponse> | undefined): boolean {
+    return proto3.util.equals(SearchUsersResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.TriggerReportRequest
+ * @generated from message rill.admin.v1.RevokeCurrentAuthTokenRequest
  */
-export class TriggerReportRequest extends Message<TriggerReportRequest> {
-  /**
-   * @generated from field: string organization = 1;
-   */
-  organization = "";
-
-  /**
-   * @generated from field: string project = 2;
-   */
-  project = "";
-
-  /**
-   * @generated from field: string name = 3;
-   */
-  name = "";
-
-  constructor(data?: PartialMessage<TriggerReportRequest>) {
+export class RevokeCurrentAuthTokenRequest extends Message<RevokeCurrentAuthTokenRequest> {
+  constructor(data?: PartialMessage<RevokeCurrentAuthTokenRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.TriggerReportRequest";
+  static readonly typeName = "rill.admin.v1.RevokeCurrentAuthTokenRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerReportRequest {
-    return new TriggerReportRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeCurrentAuthTokenRequest {
+    return new RevokeCurrentAuthTokenRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerReportRequest {
-    return new TriggerReportRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenRequest {
+    return new RevokeCurrentAuthTokenRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerReportRequest {
-    return new TriggerReportRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenRequest {
+    return new RevokeCurrentAuthTokenRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TriggerReportRequest | PlainMessage<TriggerReportRequest> | undefined, b: TriggerReportRequest | PlainMessage<TriggerReportRequest> | undefined): boolean {
-    return proto3.util.equals(TriggerReportRequest, a, b);
+  static equals(a: RevokeCurrentAuthTokenRequest | PlainMessage<RevokeCurrentAuthTokenRequest> | undefined, b: RevokeCurrentAuthTokenRequest | PlainMessage<RevokeCurrentAuthTokenRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeCurrentAuthTokenRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.TriggerReportResponse
+ * @generated from message rill.admin.v1.RevokeCurrentAuthTokenResponse
  */
-export class TriggerReportResponse extends Message<TriggerReportResponse> {
-  constructor(data?: PartialMessage<TriggerReportResponse>) {
+export class RevokeCurrentAuthTokenResponse extends Message<RevokeCurrentAuthTokenResponse> {
+  /**
+   * @generated from field: string token_id = 1;
+   */
+  tokenId = "";
+
+  constructor(data?: PartialMessage<RevokeCurrentAuthTokenResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.TriggerReportResponse";
+  static readonly typeName = "rill.admin.v1.RevokeCurrentAuthTokenResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerReportResponse {
-    return new TriggerReportResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeCurrentAuthTokenResponse {
+    return new RevokeCurrentAuthTokenResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerReportResponse {
-    return new TriggerReportResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenResponse {
+    return new RevokeCurrentAuthTokenResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerReportResponse {
-    return new TriggerReportResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenResponse {
+    return new RevokeCurrentAuthTokenResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TriggerReportResponse | PlainMessage<TriggerReportResponse> | undefined, b: TriggerReportResponse | PlainMessage<TriggerReportResponse> | undefined): boolean {
-    return proto3.util.equals(TriggerReportResponse, a, b);
+  static equals(a: RevokeCurrentAuthTokenResponse | PlainMessage<RevokeCurrentAuthTokenResponse> | undefined, b: RevokeCurrentAuthTokenResponse | PlainMessage<RevokeCurrentAuthTokenResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeCurrentAuthTokenResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GenerateReportYAMLRequest
+ * @generated from message rill.admin.v1.IssueRepresentativeAuthTokenRequest
  */
-export class GenerateReportYAMLRequest extends Message<GenerateReportYAMLRequest> {
-  /**
-   * @generated from field: string organization = 1;
-   */
-  organization = "";
-
+export class IssueRepresentativeAuthTokenRequest extends Message<IssueRepresentativeAuthTokenRequest> {
   /**
-   * @generated from field: string project = 2;
+   * @generated from field: string email = 1;
    */
-  project = "";
+  email = "";
 
   /**
-   * @generated from field: rill.admin.v1.ReportOptions options = 3;
+   * @generated from field: int64 ttl_minutes = 2;
    */
-  options?: ReportOptions;
+  ttlMinutes = protoInt64.zero;
 
-  constructor(data?: PartialMessage<GenerateReportYAMLRequest>) {
+  constructor(data?: PartialMessage<IssueRepresentativeAuthTokenRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GenerateReportYAMLRequest";
+  static readonly typeName = "rill.admin.v1.IssueRepresentativeAuthTokenRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "options", kind: "message", T: ReportOptions },
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ttl_minutes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateReportYAMLRequest {
-    return new GenerateReportYAMLRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueRepresentativeAuthTokenRequest {
+    return new IssueRepresentativeAuthTokenRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateReportYAMLRequest {
-    return new GenerateReportYAMLRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenRequest {
+    return new IssueRepresentativeAuthTokenRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateReportYAMLRequest {
-    return new GenerateReportYAMLRequest().fromJsonString(jsonString, options);
-  }
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenRequest {
+    return new IssueRepresentativeAuthTokenRequest().fromJsonString(jsonString, options);
+  }
 
-  static equals(a: GenerateReportYAMLRequest | PlainMessage<GenerateReportYAMLRequest> | undefined, b: GenerateReportYAMLRequest | PlainMessage<GenerateReportYAMLRequest> | undefined): boolean {
-    return proto3.util.equals(GenerateReportYAMLRequest, a, b);
+  static equals(a: IssueRepresentativeAuthTokenRequest | PlainMessage<IssueRepresentativeAuthTokenRequest> | undefined, b: IssueRepresentativeAuthTokenRequest | PlainMessage<IssueRepresentativeAuthTokenRequest> | undefined): boolean {
+    return proto3.util.equals(IssueRepresentativeAuthTokenRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GenerateReportYAMLResponse
+ * @generated from message rill.admin.v1.IssueRepresentativeAuthTokenResponse
  */
-export class GenerateReportYAMLResponse extends Message<GenerateReportYAMLResponse> {
+export class IssueRepresentativeAuthTokenResponse extends Message<IssueRepresentativeAuthTokenResponse> {
   /**
-   * @generated from field: string yaml = 1;
+   * @generated from field: string token = 1;
    */
-  yaml = "";
+  token = "";
 
-  constructor(data?: PartialMessage<GenerateReportYAMLResponse>) {
+  constructor(data?: PartialMessage<IssueRepresentativeAuthTokenResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GenerateReportYAMLResponse";
+  static readonly typeName = "rill.admin.v1.IssueRepresentativeAuthTokenResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateReportYAMLResponse {
-    return new GenerateReportYAMLResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueRepresentativeAuthTokenResponse {
+    return new IssueRepresentativeAuthTokenResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateReportYAMLResponse {
-    return new GenerateReportYAMLResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenResponse {
+    return new IssueRepresentativeAuthTokenResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateReportYAMLResponse {
-    return new GenerateReportYAMLResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenResponse {
+    return new IssueRepresentativeAuthTokenResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GenerateReportYAMLResponse | PlainMessage<GenerateReportYAMLResponse> | undefined, b: GenerateReportYAMLResponse | PlainMessage<GenerateReportYAMLResponse> | undefined): boolean {
-    return proto3.util.equals(GenerateReportYAMLResponse, a, b);
+  static equals(a: IssueRepresentativeAuthTokenResponse | PlainMessage<IssueRepresentativeAuthTokenResponse> | undefined, b: IssueRepresentativeAuthTokenResponse | PlainMessage<IssueRepresentativeAuthTokenResponse> | undefined): boolean {
+    return proto3.util.equals(IssueRepresentativeAuthTokenResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateAlertRequest
+ * @generated from message rill.admin.v1.RevokeServiceAuthTokenRequest
  */
-export class CreateAlertRequest extends Message<CreateAlertRequest> {
-  /**
-   * @generated from field: string organization = 1;
-   */
-  organization = "";
-
-  /**
-   * @generated from field: string project = 2;
-   */
-  project = "";
-
+export class RevokeServiceAuthTokenRequest extends Message<RevokeServiceAuthTokenRequest> {
   /**
-   * @generated from field: rill.admin.v1.AlertOptions options = 3;
+   * @generated from field: string token_id = 1;
    */
-  options?: AlertOptions;
+  tokenId = "";
 
-  constructor(data?: PartialMessage<CreateAlertRequest>) {
+  constructor(data?: PartialMessage<RevokeServiceAuthTokenRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateAlertRequest";
+  static readonly typeName = "rill.admin.v1.RevokeServiceAuthTokenRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "options", kind: "message", T: AlertOptions },
+    { no: 1, name: "token_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAlertRequest {
-    return new CreateAlertRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeServiceAuthTokenRequest {
+    return new RevokeServiceAuthTokenRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAlertRequest {
-    return new CreateAlertRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenRequest {
+    return new RevokeServiceAuthTokenRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAlertRequest {
-    return new CreateAlertRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenRequest {
+    return new RevokeServiceAuthTokenRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateAlertRequest | PlainMessage<CreateAlertRequest> | undefined, b: CreateAlertRequest | PlainMessage<CreateAlertRequest> | undefined): boolean {
-    return proto3.util.equals(CreateAlertRequest, a, b);
+  static equals(a: RevokeServiceAuthTokenRequest | PlainMessage<RevokeServiceAuthTokenRequest> | undefined, b: RevokeServiceAuthTokenRequest | PlainMessage<RevokeServiceAuthTokenRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeServiceAuthTokenRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateAlertResponse
+ * @generated from message rill.admin.v1.RevokeServiceAuthTokenResponse
  */
-export class CreateAlertResponse extends Message<CreateAlertResponse> {
-  /**
-   * @generated from field: string name = 1;
-   */
-  name = "";
-
-  constructor(data?: PartialMessage<CreateAlertResponse>) {
+export class RevokeServiceAuthTokenResponse extends Message<RevokeServiceAuthTokenResponse> {
+  constructor(data?: PartialMessage<RevokeServiceAuthTokenResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateAlertResponse";
+  static readonly typeName = "rill.admin.v1.RevokeServiceAuthTokenResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAlertResponse {
-    return new CreateAlertResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeServiceAuthTokenResponse {
+    return new RevokeServiceAuthTokenResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAlertResponse {
-    return new CreateAlertResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenResponse {
+    return new RevokeServiceAuthTokenResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAlertResponse {
-    return new CreateAlertResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenResponse {
+    return new RevokeServiceAuthTokenResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateAlertResponse | PlainMessage<CreateAlertResponse> | undefined, b: CreateAlertResponse | PlainMessage<CreateAlertResponse> | undefined): boolean {
-    return proto3.util.equals(CreateAlertResponse, a, b);
+  static equals(a: RevokeServiceAuthTokenResponse | PlainMessage<RevokeServiceAuthTokenResponse> | undefined, b: RevokeServiceAuthTokenResponse | PlainMessage<RevokeServiceAuthTokenResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeServiceAuthTokenResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.EditAlertRequest
+ * @generated from message rill.admin.v1.IssueServiceAuthTokenRequest
  */
-export class EditAlertRequest extends Message<EditAlertRequest> {
-  /**
-   * @generated from field: string organization = 1;
-   */
-  organization = "";
-
-  /**
-   * @generated from field: string project = 2;
-   */
-  project = "";
-
+export class IssueServiceAuthTokenRequest extends Message<IssueServiceAuthTokenRequest> {
   /**
-   * @generated from field: string name = 3;
+   * @generated from field: string organization_name = 1;
    */
-  name = "";
+  organizationName = "";
 
   /**
-   * @generated from field: rill.admin.v1.AlertOptions options = 4;
+   * @generated from field: string service_name = 2;
    */
-  options?: AlertOptions;
+  serviceName = "";
 
-  constructor(data?: PartialMessage<EditAlertRequest>) {
+  constructor(data?: PartialMessage<IssueServiceAuthTokenRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.EditAlertRequest";
+  static readonly typeName = "rill.admin.v1.IssueServiceAuthTokenRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "options", kind: "message", T: AlertOptions },
+    { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EditAlertRequest {
-    return new EditAlertRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueServiceAuthTokenRequest {
+    return new IssueServiceAuthTokenRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EditAlertRequest {
-    return new EditAlertRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenRequest {
+    return new IssueServiceAuthTokenRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EditAlertRequest {
-    return new EditAlertRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenRequest {
+    return new IssueServiceAuthTokenRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: EditAlertRequest | PlainMessage<EditAlertRequest> | undefined, b: EditAlertRequest | PlainMessage<EditAlertRequest> | undefined): boolean {
-    return proto3.util.equals(EditAlertRequest, a, b);
+  static equals(a: IssueServiceAuthTokenRequest | PlainMessage<IssueServiceAuthTokenRequest> | undefined, b: IssueServiceAuthTokenRequest | PlainMessage<IssueServiceAuthTokenRequest> | undefined): boolean {
+    return proto3.util.equals(IssueServiceAuthTokenRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.EditAlertResponse
+ * @generated from message rill.admin.v1.IssueServiceAuthTokenResponse
  */
-export class EditAlertResponse extends Message<EditAlertResponse> {
-  constructor(data?: PartialMessage<EditAlertResponse>) {
+export class IssueServiceAuthTokenResponse extends Message<IssueServiceAuthTokenResponse> {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<IssueServiceAuthTokenResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.EditAlertResponse";
+  static readonly typeName = "rill.admin.v1.IssueServiceAuthTokenResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EditAlertResponse {
-    return new EditAlertResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueServiceAuthTokenResponse {
+    return new IssueServiceAuthTokenResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EditAlertResponse {
-    return new EditAlertResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenResponse {
+    return new IssueServiceAuthTokenResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EditAlertResponse {
-    return new EditAlertResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenResponse {
+    return new IssueServiceAuthTokenResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: EditAlertResponse | PlainMessage<EditAlertResponse> | undefined, b: EditAlertResponse | PlainMessage<EditAlertResponse> | undefined): boolean {
-    return proto3.util.equals(EditAlertResponse, a, b);
+  static equals(a: IssueServiceAuthTokenResponse | PlainMessage<IssueServiceAuthTokenResponse> | undefined, b: IssueServiceAuthTokenResponse | PlainMessage<IssueServiceAuthTokenResponse> | undefined): boolean {
+    return proto3.util.equals(IssueServiceAuthTokenResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.UnsubscribeAlertRequest
+ * @generated from message rill.admin.v1.ListServiceAuthTokensRequest
  */
-export class UnsubscribeAlertRequest extends Message<UnsubscribeAlertRequest> {
-  /**
-   * @generated from field: string organization = 1;
-   */
-  organization = "";
-
+export class ListServiceAuthTokensRequest extends Message<ListServiceAuthTokensRequest> {
   /**
-   * @generated from field: string project = 2;
+   * @generated from field: string organization_name = 1;
    */
-  project = "";
+  organizationName = "";
 
   /**
-   * @generated from field: string name = 3;
+   * @generated from field: string service_name = 2;
    */
-  name = "";
+  serviceName = "";
 
-  constructor(data?: PartialMessage<UnsubscribeAlertRequest>) {
+  constructor(data?: PartialMessage<ListServiceAuthTokensRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.UnsubscribeAlertRequest";
+  static readonly typeName = "rill.admin.v1.ListServiceAuthTokensRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsubscribeAlertRequest {
-    return new UnsubscribeAlertRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListServiceAuthTokensRequest {
+    return new ListServiceAuthTokensRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsubscribeAlertRequest {
-    return new UnsubscribeAlertRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListServiceAuthTokensRequest {
+    return new ListServiceAuthTokensRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsubscribeAlertRequest {
-    return new UnsubscribeAlertRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListServiceAuthTokensRequest {
+    return new ListServiceAuthTokensRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UnsubscribeAlertRequest | PlainMessage<UnsubscribeAlertRequest> | undefined, b: UnsubscribeAlertRequest | PlainMessage<UnsubscribeAlertRequest> | undefined): boolean {
-    return proto3.util.equals(UnsubscribeAlertRequest, a, b);
+  static equals(a: ListServiceAuthTokensRequest | PlainMessage<ListServiceAuthTokensRequest> | undefined, b: ListServiceAuthTokensRequest | PlainMessage<ListServiceAuthTokensRequest> | undefined): boolean {
+    return proto3.util.equals(ListServiceAuthTokensRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.UnsubscribeAlertResponse
+ * @generated from message rill.admin.v1.ListServiceAuthTokensResponse
  */
-export class UnsubscribeAlertResponse extends Message<UnsubscribeAlertResponse> {
-  constructor(data?: PartialMessage<UnsubscribeAlertResponse>) {
+export class ListServiceAuthTokensResponse extends Message<ListServiceAuthTokensResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.ServiceToken tokens = 1;
+   */
+  tokens: ServiceToken[] = [];
+
+  constructor(data?: PartialMessage<ListServiceAuthTokensResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.UnsubscribeAlertResponse";
+  static readonly typeName = "rill.admin.v1.ListServiceAuthTokensResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tokens", kind: "message", T: ServiceToken, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsubscribeAlertResponse {
-    return new UnsubscribeAlertResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListServiceAuthTokensResponse {
+    return new ListServiceAuthTokensResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsubscribeAlertResponse {
-    return new UnsubscribeAlertResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListServiceAuthTokensResponse {
+    return new ListServiceAuthTokensResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsubscribeAlertResponse {
-    return new UnsubscribeAlertResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListServiceAuthTokensResponse {
+    return new ListServiceAuthTokensResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UnsubscribeAlertResponse | PlainMessage<UnsubscribeAlertResponse> | undefined, b: UnsubscribeAlertResponse | PlainMessage<UnsubscribeAlertResponse> | undefined): boolean {
-    return proto3.util.equals(UnsubscribeAlertResponse, a, b);
+  static equals(a: ListServiceAuthTokensResponse | PlainMessage<ListServiceAuthTokensResponse> | undefined, b: ListServiceAuthTokensResponse | PlainMessage<ListServiceAuthTokensResponse> | undefined): boolean {
+    return proto3.util.equals(ListServiceAuthTokensResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.DeleteAlertRequest
+ * @generated from message rill.admin.v1.GetGithubRepoStatusRequest
  */
-export class DeleteAlertRequest extends Message<DeleteAlertRequest> {
-  /**
-   * @generated from field: string organization = 1;
-   */
-  organization = "";
-
-  /**
-   * @generated from field: string project = 2;
-   */
-  project = "";
-
+export class GetGithubRepoStatusRequest extends Message<GetGithubRepoStatusRequest> {
   /**
-   * @generated from field: string name = 3;
+   * @generated from field: string github_url = 1;
    */
-  name = "";
-
-  constructor(data?: PartialMessage<DeleteAlertRequest>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
-
-  static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.DeleteAlertRequest";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-  ]);
-
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAlertRequest {
-    return new DeleteAlertRequest().fromBinary(bytes, options);
-  }
-
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAlertRequest {
-    return new DeleteAlertRequest().fromJson(jsonValue, options);
-  }
-
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAlertRequest {
-    return new DeleteAlertRequest().fromJsonString(jsonString, options);
-  }
-
-  static equals(a: DeleteAlertRequest | PlainMessage<DeleteAlertRequest> | undefined, b: DeleteAlertRequest | PlainMessage<DeleteAlertRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteAlertRequest, a, b);
-  }
-}
+  githubUrl = "";
 
-/**
- * @generated from message rill.admin.v1.DeleteAlertResponse
- */
-export class DeleteAlertResponse extends Message<DeleteAlertResponse> {
-  constructor(data?: PartialMessage<DeleteAlertResponse>) {
+  constructor(data?: PartialMessage<GetGithubRepoStatusRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.DeleteAlertResponse";
+  static readonly typeName = "rill.admin.v1.GetGithubRepoStatusRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "github_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAlertResponse {
-    return new DeleteAlertResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGithubRepoStatusRequest {
+    return new GetGithubRepoStatusRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAlertResponse {
-    return new DeleteAlertResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGithubRepoStatusRequest {
+    return new GetGithubRepoStatusRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAlertResponse {
-    return new DeleteAlertResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGithubRepoStatusRequest {
+    return new GetGithubRepoStatusRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteAlertResponse | PlainMessage<DeleteAlertResponse> | undefined, b: DeleteAlertResponse | PlainMessage<DeleteAlertResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteAlertResponse, a, b);
+  static equals(a: GetGithubRepoStatusRequest | PlainMessage<GetGithubRepoStatusRequest> | undefined, b: GetGithubRepoStatusRequest | PlainMessage<GetGithubRepoStatusRequest> | undefined): boolean {
+    return proto3.util.equals(GetGithubRepoStatusRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GenerateAlertYAMLRequest
+ * @generated from message rill.admin.v1.GetGithubRepoStatusResponse
  */
-export class GenerateAlertYAMLRequest extends Message<GenerateAlertYAMLRequest> {
+export class GetGithubRepoStatusResponse extends Message<GetGithubRepoStatusResponse> {
   /**
-   * @generated from field: string organization = 1;
+   * @generated from field: bool has_access = 1;
    */
-  organization = "";
+  hasAccess = false;
 
   /**
-   * @generated from field: string project = 2;
+   * @generated from field: string grant_access_url = 2;
    */
-  project = "";
+  grantAccessUrl = "";
 
   /**
-   * @generated from field: rill.admin.v1.AlertOptions options = 3;
+   * @generated from field: string default_branch = 3;
    */
-  options?: AlertOptions;
+  defaultBranch = "";
 
-  constructor(data?: PartialMessage<GenerateAlertYAMLRequest>) {
+  constructor(data?: PartialMessage<GetGithubRepoStatusResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GenerateAlertYAMLRequest";
+  static readonly typeName = "rill.admin.v1.GetGithubRepoStatusResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "options", kind: "message", T: AlertOptions },
+    { no: 1, name: "has_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "grant_access_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "default_branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateAlertYAMLRequest {
-    return new GenerateAlertYAMLRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGithubRepoStatusResponse {
+    return new GetGithubRepoStatusResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateAlertYAMLRequest {
-    return new GenerateAlertYAMLRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGithubRepoStatusResponse {
+    return new GetGithubRepoStatusResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateAlertYAMLRequest {
-    return new GenerateAlertYAMLRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGithubRepoStatusResponse {
+    return new GetGithubRepoStatusResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GenerateAlertYAMLRequest | PlainMessage<GenerateAlertYAMLRequest> | undefined, b: GenerateAlertYAMLRequest | PlainMessage<GenerateAlertYAMLRequest> | undefined): boolean {
-    return proto3.util.equals(GenerateAlertYAMLRequest, a, b);
+  static equals(a: GetGithubRepoStatusResponse | PlainMessage<GetGithubRepoStatusResponse> | undefined, b: GetGithubRepoStatusResponse | PlainMessage<GetGithubRepoStatusResponse> | undefined): boolean {
+    return proto3.util.equals(GetGithubRepoStatusResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GenerateAlertYAMLResponse
+ * @generated from message rill.admin.v1.GetGithubUserStatusRequest
  */
-export class GenerateAlertYAMLResponse extends Message<GenerateAlertYAMLResponse> {
-  /**
-   * @generated from field: string yaml = 1;
-   */
-  yaml = "";
-
-  constructor(data?: PartialMessage<GenerateAlertYAMLResponse>) {
+export class GetGithubUserStatusRequest extends Message<GetGithubUserStatusRequest> {
+  constructor(data?: PartialMessage<GetGithubUserStatusRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GenerateAlertYAMLResponse";
+  static readonly typeName = "rill.admin.v1.GetGithubUserStatusRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateAlertYAMLResponse {
-    return new GenerateAlertYAMLResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGithubUserStatusRequest {
+    return new GetGithubUserStatusRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateAlertYAMLResponse {
-    return new GenerateAlertYAMLResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGithubUserStatusRequest {
+    return new GetGithubUserStatusRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateAlertYAMLResponse {
-    return new GenerateAlertYAMLResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGithubUserStatusRequest {
+    return new GetGithubUserStatusRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GenerateAlertYAMLResponse | PlainMessage<GenerateAlertYAMLResponse> | undefined, b: GenerateAlertYAMLResponse | PlainMessage<GenerateAlertYAMLResponse> | undefined): boolean {
-    return proto3.util.equals(GenerateAlertYAMLResponse, a, b);
+  static equals(a: GetGithubUserStatusRequest | PlainMessage<GetGithubUserStatusRequest> | undefined, b: GetGithubUserStatusRequest | PlainMessage<GetGithubUserStatusRequest> | undefined): boolean {
+    return proto3.util.equals(GetGithubUserStatusRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetAlertYAMLRequest
+ * @generated from message rill.admin.v1.GetGithubUserStatusResponse
  */
-export class GetAlertYAMLRequest extends Message<GetAlertYAMLRequest> {
+export class GetGithubUserStatusResponse extends Message<GetGithubUserStatusResponse> {
   /**
-   * @generated from field: string organization = 1;
+   * @generated from field: bool has_access = 1;
    */
-  organization = "";
+  hasAccess = false;
 
   /**
-   * @generated from field: string project = 2;
+   * @generated from field: string grant_access_url = 2;
    */
-  project = "";
+  grantAccessUrl = "";
 
   /**
-   * @generated from field: string name = 3;
+   * @generated from field: string access_token = 3;
    */
-  name = "";
+  accessToken = "";
 
-  constructor(data?: PartialMessage<GetAlertYAMLRequest>) {
+  /**
+   * @generated from field: string account = 4;
+   */
+  account = "";
+
+  /**
+   * @generated from field: rill.admin.v1.GithubPermission user_installation_permission = 6;
+   */
+  userInstallationPermission = GithubPermission.UNSPECIFIED;
+
+  /**
+   * @generated from field: map<string, rill.admin.v1.GithubPermission> organization_installation_permissions = 7;
+   */
+  organizationInstallationPermissions: { [key: string]: GithubPermission } = {};
+
+  /**
+   * DEPRECATED: Use organization_installation_permissions instead.
+   *
+   * @generated from field: repeated string organizations = 5 [deprecated = true];
+   * @deprecated
+   */
+  organizations: string[] = [];
+
+  constructor(data?: PartialMessage<GetGithubUserStatusResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetAlertYAMLRequest";
+  static readonly typeName = "rill.admin.v1.GetGithubUserStatusResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "has_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "grant_access_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "account", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "user_installation_permission", kind: "enum", T: proto3.getEnumType(GithubPermission) },
+    { no: 7, name: "organization_installation_permissions", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "enum", T: proto3.getEnumType(GithubPermission)} },
+    { no: 5, name: "organizations", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlertYAMLRequest {
-    return new GetAlertYAMLRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGithubUserStatusResponse {
+    return new GetGithubUserStatusResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlertYAMLRequest {
-    return new GetAlertYAMLRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGithubUserStatusResponse {
+    return new GetGithubUserStatusResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlertYAMLRequest {
-    return new GetAlertYAMLRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGithubUserStatusResponse {
+    return new GetGithubUserStatusResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetAlertYAMLRequest | PlainMessage<GetAlertYAMLRequest> | undefined, b: GetAlertYAMLRequest | PlainMessage<GetAlertYAMLRequest> | undefined): boolean {
-    return proto3.util.equals(GetAlertYAMLRequest, a, b);
+  static equals(a: GetGithubUserStatusResponse | PlainMessage<GetGithubUserStatusResponse> | undefined, b: GetGithubUserStatusResponse | PlainMessage<GetGithubUserStatusResponse> | undefined): boolean {
+    return proto3.util.equals(GetGithubUserStatusResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetAlertYAMLResponse
+ * @generated from message rill.admin.v1.GetGitCredentialsRequest
  */
-export class GetAlertYAMLResponse extends Message<GetAlertYAMLResponse> {
+export class GetGitCredentialsRequest extends Message<GetGitCredentialsRequest> {
   /**
-   * @generated from field: string yaml = 1;
+   * @generated from field: string organization = 1;
    */
-  yaml = "";
+  organization = "";
 
-  constructor(data?: PartialMessage<GetAlertYAMLResponse>) {
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  constructor(data?: PartialMessage<GetGitCredentialsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetAlertYAMLResponse";
+  static readonly typeName = "rill.admin.v1.GetGitCredentialsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlertYAMLResponse {
-    return new GetAlertYAMLResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGitCredentialsRequest {
+    return new GetGitCredentialsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlertYAMLResponse {
-    return new GetAlertYAMLResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGitCredentialsRequest {
+    return new GetGitCredentialsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlertYAMLResponse {
-    return new GetAlertYAMLResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGitCredentialsRequest {
+    return new GetGitCredentialsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetAlertYAMLResponse | PlainMessage<GetAlertYAMLResponse> | undefined, b: GetAlertYAMLResponse | PlainMessage<GetAlertYAMLResponse> | undefined): boolean {
-    return proto3.util.equals(GetAlertYAMLResponse, a, b);
+  static equals(a: GetGitCredentialsRequest | PlainMessage<GetGitCredentialsRequest> | undefined, b: GetGitCredentialsRequest | PlainMessage<GetGitCredentialsRequest> | undefined): boolean {
+    return proto3.util.equals(GetGitCredentialsRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.TelemetryRequest
+ * @generated from message rill.admin.v1.GetGitCredentialsResponse
  */
-export class TelemetryRequest extends Message<TelemetryRequest> {
+export class GetGitCredentialsResponse extends Message<GetGitCredentialsResponse> {
+  /**
+   * @generated from field: string repo_url = 1;
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username = "";
+
+  /**
+   * @generated from field: string password = 3;
+   */
+  password = "";
+
+  /**
+   * @generated from field: string subpath = 4;
+   */
+  subpath = "";
+
+  /**
+   * @generated from field: string prod_branch = 5;
+   */
+  prodBranch = "";
+
+  constructor(data?: PartialMessage<GetGitCredentialsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetGitCredentialsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "subpath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "prod_branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGitCredentialsResponse {
+    return new GetGitCredentialsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGitCredentialsResponse {
+    return new GetGitCredentialsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGitCredentialsResponse {
+    return new GetGitCredentialsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetGitCredentialsResponse | PlainMessage<GetGitCredentialsResponse> | undefined, b: GetGitCredentialsResponse | PlainMessage<GetGitCredentialsResponse> | undefined): boolean {
+    return proto3.util.equals(GetGitCredentialsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateWhitelistedDomainRequest
+ */
+export class CreateWhitelistedDomainRequest extends Message<CreateWhitelistedDomainRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string domain = 2;
+   */
+  domain = "";
+
+  /**
+   * @generated from field: string role = 3;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<CreateWhitelistedDomainRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateWhitelistedDomainRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWhitelistedDomainRequest {
+    return new CreateWhitelistedDomainRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainRequest {
+    return new CreateWhitelistedDomainRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainRequest {
+    return new CreateWhitelistedDomainRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWhitelistedDomainRequest | PlainMessage<CreateWhitelistedDomainRequest> | undefined, b: CreateWhitelistedDomainRequest | PlainMessage<CreateWhitelistedDomainRequest> | undefined): boolean {
+    return proto3.util.equals(CreateWhitelistedDomainRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateWhitelistedDomainResponse
+ */
+export class CreateWhitelistedDomainResponse extends Message<CreateWhitelistedDomainResponse> {
+  constructor(data?: PartialMessage<CreateWhitelistedDomainResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateWhitelistedDomainResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWhitelistedDomainResponse {
+    return new CreateWhitelistedDomainResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainResponse {
+    return new CreateWhitelistedDomainResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainResponse {
+    return new CreateWhitelistedDomainResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWhitelistedDomainResponse | PlainMessage<CreateWhitelistedDomainResponse> | undefined, b: CreateWhitelistedDomainResponse | PlainMessage<CreateWhitelistedDomainResponse> | undefined): boolean {
+    return proto3.util.equals(CreateWhitelistedDomainResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveWhitelistedDomainRequest
+ */
+export class RemoveWhitelistedDomainRequest extends Message<RemoveWhitelistedDomainRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string domain = 2;
+   */
+  domain = "";
+
+  constructor(data?: PartialMessage<RemoveWhitelistedDomainRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveWhitelistedDomainRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveWhitelistedDomainRequest {
+    return new RemoveWhitelistedDomainRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainRequest {
+    return new RemoveWhitelistedDomainRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainRequest {
+    return new RemoveWhitelistedDomainRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveWhitelistedDomainRequest | PlainMessage<RemoveWhitelistedDomainRequest> | undefined, b: RemoveWhitelistedDomainRequest | PlainMessage<RemoveWhitelistedDomainRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveWhitelistedDomainRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveWhitelistedDomainResponse
+ */
+export class RemoveWhitelistedDomainResponse extends Message<RemoveWhitelistedDomainResponse> {
+  constructor(data?: PartialMessage<RemoveWhitelistedDomainResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveWhitelistedDomainResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveWhitelistedDomainResponse {
+    return new RemoveWhitelistedDomainResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainResponse {
+    return new RemoveWhitelistedDomainResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainResponse {
+    return new RemoveWhitelistedDomainResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveWhitelistedDomainResponse | PlainMessage<RemoveWhitelistedDomainResponse> | undefined, b: RemoveWhitelistedDomainResponse | PlainMessage<RemoveWhitelistedDomainResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveWhitelistedDomainResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListWhitelistedDomainsRequest
+ */
+export class ListWhitelistedDomainsRequest extends Message<ListWhitelistedDomainsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  constructor(data?: PartialMessage<ListWhitelistedDomainsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListWhitelistedDomainsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWhitelistedDomainsRequest {
+    return new ListWhitelistedDomainsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsRequest {
+    return new ListWhitelistedDomainsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsRequest {
+    return new ListWhitelistedDomainsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWhitelistedDomainsRequest | PlainMessage<ListWhitelistedDomainsRequest> | undefined, b: ListWhitelistedDomainsRequest | PlainMessage<ListWhitelistedDomainsRequest> | undefined): boolean {
+    return proto3.util.equals(ListWhitelistedDomainsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListWhitelistedDomainsResponse
+ */
+export class ListWhitelistedDomainsResponse extends Message<ListWhitelistedDomainsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.WhitelistedDomain domains = 1;
+   */
+  domains: WhitelistedDomain[] = [];
+
+  constructor(data?: PartialMessage<ListWhitelistedDomainsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListWhitelistedDomainsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "domains", kind: "message", T: WhitelistedDomain, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWhitelistedDomainsResponse {
+    return new ListWhitelistedDomainsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
+    return new ListWhitelistedDomainsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
+    return new ListWhitelistedDomainsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined, b: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined): boolean {
+    return proto3.util.equals(ListWhitelistedDomainsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListAuditEventsRequest
+ */
+export class ListAuditEventsRequest extends Message<ListAuditEventsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * Only list events for the project with this name.
+   *
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * Only list events made by the user with this email or the service with this name.
+   *
+   * @generated from field: string actor = 3;
+   */
+  actor = "";
+
+  /**
+   * Only list events with this action, such as "project.member.add".
+   *
+   * @generated from field: string action = 4;
+   */
+  action = "";
+
+  /**
+   * Only list events recorded at or after this time.
+   *
+   * @generated from field: google.protobuf.Timestamp after = 5;
+   */
+  after?: Timestamp;
+
+  /**
+   * Only list events recorded before this time.
+   *
+   * @generated from field: google.protobuf.Timestamp before = 6;
+   */
+  before?: Timestamp;
+
+  /**
+   * @generated from field: uint32 page_size = 7;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 8;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListAuditEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListAuditEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "actor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "after", kind: "message", T: Timestamp },
+    { no: 6, name: "before", kind: "message", T: Timestamp },
+    { no: 7, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 8, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined, b: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListAuditEventsResponse
+ */
+export class ListAuditEventsResponse extends Message<ListAuditEventsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListAuditEventsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListAuditEventsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "events", kind: "message", T: AuditEvent, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined, b: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateProjectWhitelistedDomainRequest
+ */
+export class CreateProjectWhitelistedDomainRequest extends Message<CreateProjectWhitelistedDomainRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string domain = 3;
+   */
+  domain = "";
+
+  /**
+   * @generated from field: string role = 4;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<CreateProjectWhitelistedDomainRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateProjectWhitelistedDomainRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectWhitelistedDomainRequest {
+    return new CreateProjectWhitelistedDomainRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectWhitelistedDomainRequest {
+    return new CreateProjectWhitelistedDomainRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectWhitelistedDomainRequest {
+    return new CreateProjectWhitelistedDomainRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateProjectWhitelistedDomainRequest | PlainMessage<CreateProjectWhitelistedDomainRequest> | undefined, b: CreateProjectWhitelistedDomainRequest | PlainMessage<CreateProjectWhitelistedDomainRequest> | undefined): boolean {
+    return proto3.util.equals(CreateProjectWhitelistedDomainRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateProjectWhitelistedDomainResponse
+ */
+export class CreateProjectWhitelistedDomainResponse extends Message<CreateProjectWhitelistedDomainResponse> {
+  constructor(data?: PartialMessage<CreateProjectWhitelistedDomainResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateProjectWhitelistedDomainResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectWhitelistedDomainResponse {
+    return new CreateProjectWhitelistedDomainResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectWhitelistedDomainResponse {
+    return new CreateProjectWhitelistedDomainResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectWhitelistedDomainResponse {
+    return new CreateProjectWhitelistedDomainResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateProjectWhitelistedDomainResponse | PlainMessage<CreateProjectWhitelistedDomainResponse> | undefined, b: CreateProjectWhitelistedDomainResponse | PlainMessage<CreateProjectWhitelistedDomainResponse> | undefined): boolean {
+    return proto3.util.equals(CreateProjectWhitelistedDomainResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveProjectWhitelistedDomainRequest
+ */
+export class RemoveProjectWhitelistedDomainRequest extends Message<RemoveProjectWhitelistedDomainRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string domain = 3;
+   */
+  domain = "";
+
+  constructor(data?: PartialMessage<RemoveProjectWhitelistedDomainRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveProjectWhitelistedDomainRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveProjectWhitelistedDomainRequest {
+    return new RemoveProjectWhitelistedDomainRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveProjectWhitelistedDomainRequest {
+    return new RemoveProjectWhitelistedDomainRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveProjectWhitelistedDomainRequest {
+    return new RemoveProjectWhitelistedDomainRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveProjectWhitelistedDomainRequest | PlainMessage<RemoveProjectWhitelistedDomainRequest> | undefined, b: RemoveProjectWhitelistedDomainRequest | PlainMessage<RemoveProjectWhitelistedDomainRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveProjectWhitelistedDomainRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveProjectWhitelistedDomainResponse
+ */
+export class RemoveProjectWhitelistedDomainResponse extends Message<RemoveProjectWhitelistedDomainResponse> {
+  constructor(data?: PartialMessage<RemoveProjectWhitelistedDomainResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveProjectWhitelistedDomainResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveProjectWhitelistedDomainResponse {
+    return new RemoveProjectWhitelistedDomainResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveProjectWhitelistedDomainResponse {
+    return new RemoveProjectWhitelistedDomainResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveProjectWhitelistedDomainResponse {
+    return new RemoveProjectWhitelistedDomainResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveProjectWhitelistedDomainResponse | PlainMessage<RemoveProjectWhitelistedDomainResponse> | undefined, b: RemoveProjectWhitelistedDomainResponse | PlainMessage<RemoveProjectWhitelistedDomainResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveProjectWhitelistedDomainResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListProjectWhitelistedDomainsRequest
+ */
+export class ListProjectWhitelistedDomainsRequest extends Message<ListProjectWhitelistedDomainsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  constructor(data?: PartialMessage<ListProjectWhitelistedDomainsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListProjectWhitelistedDomainsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectWhitelistedDomainsRequest {
+    return new ListProjectWhitelistedDomainsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectWhitelistedDomainsRequest {
+    return new ListProjectWhitelistedDomainsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectWhitelistedDomainsRequest {
+    return new ListProjectWhitelistedDomainsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProjectWhitelistedDomainsRequest | PlainMessage<ListProjectWhitelistedDomainsRequest> | undefined, b: ListProjectWhitelistedDomainsRequest | PlainMessage<ListProjectWhitelistedDomainsRequest> | undefined): boolean {
+    return proto3.util.equals(ListProjectWhitelistedDomainsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListProjectWhitelistedDomainsResponse
+ */
+export class ListProjectWhitelistedDomainsResponse extends Message<ListProjectWhitelistedDomainsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.WhitelistedDomain domains = 1;
+   */
+  domains: WhitelistedDomain[] = [];
+
+  constructor(data?: PartialMessage<ListProjectWhitelistedDomainsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListProjectWhitelistedDomainsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "domains", kind: "message", T: WhitelistedDomain, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectWhitelistedDomainsResponse {
+    return new ListProjectWhitelistedDomainsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectWhitelistedDomainsResponse {
+    return new ListProjectWhitelistedDomainsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectWhitelistedDomainsResponse {
+    return new ListProjectWhitelistedDomainsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProjectWhitelistedDomainsResponse | PlainMessage<ListProjectWhitelistedDomainsResponse> | undefined, b: ListProjectWhitelistedDomainsResponse | PlainMessage<ListProjectWhitelistedDomainsResponse> | undefined): boolean {
+    return proto3.util.equals(ListProjectWhitelistedDomainsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetRepoMetaRequest
+ */
+export class GetRepoMetaRequest extends Message<GetRepoMetaRequest> {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string branch = 2;
+   */
+  branch = "";
+
+  constructor(data?: PartialMessage<GetRepoMetaRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetRepoMetaRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRepoMetaRequest {
+    return new GetRepoMetaRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRepoMetaRequest {
+    return new GetRepoMetaRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRepoMetaRequest {
+    return new GetRepoMetaRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRepoMetaRequest | PlainMessage<GetRepoMetaRequest> | undefined, b: GetRepoMetaRequest | PlainMessage<GetRepoMetaRequest> | undefined): boolean {
+    return proto3.util.equals(GetRepoMetaRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetRepoMetaResponse
+ */
+export class GetRepoMetaResponse extends Message<GetRepoMetaResponse> {
+  /**
+   * @generated from field: string git_url = 1;
+   */
+  gitUrl = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp git_url_expires_on = 2;
+   */
+  gitUrlExpiresOn?: Timestamp;
+
+  /**
+   * @generated from field: string git_subpath = 3;
+   */
+  gitSubpath = "";
+
+  constructor(data?: PartialMessage<GetRepoMetaResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetRepoMetaResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "git_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "git_url_expires_on", kind: "message", T: Timestamp },
+    { no: 3, name: "git_subpath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRepoMetaResponse {
+    return new GetRepoMetaResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRepoMetaResponse {
+    return new GetRepoMetaResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRepoMetaResponse {
+    return new GetRepoMetaResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRepoMetaResponse | PlainMessage<GetRepoMetaResponse> | undefined, b: GetRepoMetaResponse | PlainMessage<GetRepoMetaResponse> | undefined): boolean {
+    return proto3.util.equals(GetRepoMetaResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.PullVirtualRepoRequest
+ */
+export class PullVirtualRepoRequest extends Message<PullVirtualRepoRequest> {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string branch = 2;
+   */
+  branch = "";
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<PullVirtualRepoRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.PullVirtualRepoRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullVirtualRepoRequest {
+    return new PullVirtualRepoRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullVirtualRepoRequest {
+    return new PullVirtualRepoRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullVirtualRepoRequest {
+    return new PullVirtualRepoRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PullVirtualRepoRequest | PlainMessage<PullVirtualRepoRequest> | undefined, b: PullVirtualRepoRequest | PlainMessage<PullVirtualRepoRequest> | undefined): boolean {
+    return proto3.util.equals(PullVirtualRepoRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.PullVirtualRepoResponse
+ */
+export class PullVirtualRepoResponse extends Message<PullVirtualRepoResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.VirtualFile files = 1;
+   */
+  files: VirtualFile[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<PullVirtualRepoResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.PullVirtualRepoResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "files", kind: "message", T: VirtualFile, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullVirtualRepoResponse {
+    return new PullVirtualRepoResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullVirtualRepoResponse {
+    return new PullVirtualRepoResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullVirtualRepoResponse {
+    return new PullVirtualRepoResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PullVirtualRepoResponse | PlainMessage<PullVirtualRepoResponse> | undefined, b: PullVirtualRepoResponse | PlainMessage<PullVirtualRepoResponse> | undefined): boolean {
+    return proto3.util.equals(PullVirtualRepoResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetReportMetaRequest
+ */
+export class GetReportMetaRequest extends Message<GetReportMetaRequest> {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string branch = 2;
+   */
+  branch = "";
+
+  /**
+   * @generated from field: string report = 3;
+   */
+  report = "";
+
+  /**
+   * @generated from field: map<string, string> annotations = 4;
+   */
+  annotations: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: google.protobuf.Timestamp execution_time = 5;
+   */
+  executionTime?: Timestamp;
+
+  constructor(data?: PartialMessage<GetReportMetaRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetReportMetaRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "report", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 5, name: "execution_time", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReportMetaRequest {
+    return new GetReportMetaRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReportMetaRequest {
+    return new GetReportMetaRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReportMetaRequest {
+    return new GetReportMetaRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetReportMetaRequest | PlainMessage<GetReportMetaRequest> | undefined, b: GetReportMetaRequest | PlainMessage<GetReportMetaRequest> | undefined): boolean {
+    return proto3.util.equals(GetReportMetaRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetReportMetaResponse
+ */
+export class GetReportMetaResponse extends Message<GetReportMetaResponse> {
+  /**
+   * @generated from field: string open_url = 1;
+   */
+  openUrl = "";
+
+  /**
+   * @generated from field: string export_url = 2;
+   */
+  exportUrl = "";
+
+  /**
+   * @generated from field: string edit_url = 3;
+   */
+  editUrl = "";
+
+  /**
+   * Security attributes of the report's owner, if any. Used when attaching exported data to notifications.
+   *
+   * @generated from field: google.protobuf.Struct query_for_attributes = 4;
+   */
+  queryForAttributes?: Struct;
+
+  constructor(data?: PartialMessage<GetReportMetaResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetReportMetaResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "open_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "export_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "edit_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "query_for_attributes", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReportMetaResponse {
+    return new GetReportMetaResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReportMetaResponse {
+    return new GetReportMetaResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReportMetaResponse {
+    return new GetReportMetaResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetReportMetaResponse | PlainMessage<GetReportMetaResponse> | undefined, b: GetReportMetaResponse | PlainMessage<GetReportMetaResponse> | undefined): boolean {
+    return proto3.util.equals(GetReportMetaResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetAlertMetaRequest
+ */
+export class GetAlertMetaRequest extends Message<GetAlertMetaRequest> {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string branch = 2;
+   */
+  branch = "";
+
+  /**
+   * @generated from field: string alert = 3;
+   */
+  alert = "";
+
+  /**
+   * @generated from field: map<string, string> annotations = 4;
+   */
+  annotations: { [key: string]: string } = {};
+
+  /**
+   * @generated from oneof rill.admin.v1.GetAlertMetaRequest.query_for
+   */
+  queryFor: {
+    /**
+     * @generated from field: string query_for_user_id = 5;
+     */
+    value: string;
+    case: "queryForUserId";
+  } | {
+    /**
+     * @generated from field: string query_for_user_email = 6;
+     */
+    value: string;
+    case: "queryForUserEmail";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<GetAlertMetaRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetAlertMetaRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "alert", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 5, name: "query_for_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "query_for" },
+    { no: 6, name: "query_for_user_email", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "query_for" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlertMetaRequest {
+    return new GetAlertMetaRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlertMetaRequest {
+    return new GetAlertMetaRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlertMetaRequest {
+    return new GetAlertMetaRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAlertMetaRequest | PlainMessage<GetAlertMetaRequest> | undefined, b: GetAlertMetaRequest | PlainMessage<GetAlertMetaRequest> | undefined): boolean {
+    return proto3.util.equals(GetAlertMetaRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetAlertMetaResponse
+ */
+export class GetAlertMetaResponse extends Message<GetAlertMetaResponse> {
+  /**
+   * @generated from field: string open_url = 1;
+   */
+  openUrl = "";
+
+  /**
+   * @generated from field: string edit_url = 2;
+   */
+  editUrl = "";
+
+  /**
+   * @generated from field: google.protobuf.Struct query_for_attributes = 3;
+   */
+  queryForAttributes?: Struct;
+
+  constructor(data?: PartialMessage<GetAlertMetaResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetAlertMetaResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "open_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "edit_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "query_for_attributes", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlertMetaResponse {
+    return new GetAlertMetaResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlertMetaResponse {
+    return new GetAlertMetaResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlertMetaResponse {
+    return new GetAlertMetaResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAlertMetaResponse | PlainMessage<GetAlertMetaResponse> | undefined, b: GetAlertMetaResponse | PlainMessage<GetAlertMetaResponse> | undefined): boolean {
+    return proto3.util.equals(GetAlertMetaResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateReportRequest
+ */
+export class CreateReportRequest extends Message<CreateReportRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: rill.admin.v1.ReportOptions options = 3;
+   */
+  options?: ReportOptions;
+
+  constructor(data?: PartialMessage<CreateReportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateReportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "options", kind: "message", T: ReportOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateReportRequest {
+    return new CreateReportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateReportRequest {
+    return new CreateReportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateReportRequest {
+    return new CreateReportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateReportRequest | PlainMessage<CreateReportRequest> | undefined, b: CreateReportRequest | PlainMessage<CreateReportRequest> | undefined): boolean {
+    return proto3.util.equals(CreateReportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateReportResponse
+ */
+export class CreateReportResponse extends Message<CreateReportResponse> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<CreateReportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateReportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateReportResponse {
+    return new CreateReportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateReportResponse {
+    return new CreateReportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateReportResponse {
+    return new CreateReportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateReportResponse | PlainMessage<CreateReportResponse> | undefined, b: CreateReportResponse | PlainMessage<CreateReportResponse> | undefined): boolean {
+    return proto3.util.equals(CreateReportResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.EditReportRequest
+ */
+export class EditReportRequest extends Message<EditReportRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: rill.admin.v1.ReportOptions options = 4;
+   */
+  options?: ReportOptions;
+
+  constructor(data?: PartialMessage<EditReportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.EditReportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "options", kind: "message", T: ReportOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EditReportRequest {
+    return new EditReportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EditReportRequest {
+    return new EditReportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EditReportRequest {
+    return new EditReportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EditReportRequest | PlainMessage<EditReportRequest> | undefined, b: EditReportRequest | PlainMessage<EditReportRequest> | undefined): boolean {
+    return proto3.util.equals(EditReportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.EditReportResponse
+ */
+export class EditReportResponse extends Message<EditReportResponse> {
+  constructor(data?: PartialMessage<EditReportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.EditReportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EditReportResponse {
+    return new EditReportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EditReportResponse {
+    return new EditReportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EditReportResponse {
+    return new EditReportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EditReportResponse | PlainMessage<EditReportResponse> | undefined, b: EditReportResponse | PlainMessage<EditReportResponse> | undefined): boolean {
+    return proto3.util.equals(EditReportResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.UnsubscribeReportRequest
+ */
+export class UnsubscribeReportRequest extends Message<UnsubscribeReportRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<UnsubscribeReportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.UnsubscribeReportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsubscribeReportRequest {
+    return new UnsubscribeReportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsubscribeReportRequest {
+    return new UnsubscribeReportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsubscribeReportRequest {
+    return new UnsubscribeReportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnsubscribeReportRequest | PlainMessage<UnsubscribeReportRequest> | undefined, b: UnsubscribeReportRequest | PlainMessage<UnsubscribeReportRequest> | undefined): boolean {
+    return proto3.util.equals(UnsubscribeReportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.UnsubscribeReportResponse
+ */
+export class UnsubscribeReportResponse extends Message<UnsubscribeReportResponse> {
+  constructor(data?: PartialMessage<UnsubscribeReportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.UnsubscribeReportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsubscribeReportResponse {
+    return new UnsubscribeReportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsubscribeReportResponse {
+    return new UnsubscribeReportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsubscribeReportResponse {
+    return new UnsubscribeReportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnsubscribeReportResponse | PlainMessage<UnsubscribeReportResponse> | undefined, b: UnsubscribeReportResponse | PlainMessage<UnsubscribeReportResponse> | undefined): boolean {
+    return proto3.util.equals(UnsubscribeReportResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteReportRequest
+ */
+export class DeleteReportRequest extends Message<DeleteReportRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<DeleteReportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteReportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteReportRequest {
+    return new DeleteReportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteReportRequest {
+    return new DeleteReportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteReportRequest {
+    return new DeleteReportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteReportRequest | PlainMessage<DeleteReportRequest> | undefined, b: DeleteReportRequest | PlainMessage<DeleteReportRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteReportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteReportResponse
+ */
+export class DeleteReportResponse extends Message<DeleteReportResponse> {
+  constructor(data?: PartialMessage<DeleteReportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteReportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteReportResponse {
+    return new DeleteReportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteReportResponse {
+    return new DeleteReportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteReportResponse {
+    return new DeleteReportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteReportResponse | PlainMessage<DeleteReportResponse> | undefined, b: DeleteReportResponse | PlainMessage<DeleteReportResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteReportResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.TriggerReportRequest
+ */
+export class TriggerReportRequest extends Message<TriggerReportRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<TriggerReportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.TriggerReportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerReportRequest {
+    return new TriggerReportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerReportRequest {
+    return new TriggerReportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerReportRequest {
+    return new TriggerReportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerReportRequest | PlainMessage<TriggerReportRequest> | undefined, b: TriggerReportRequest | PlainMessage<TriggerReportRequest> | undefined): boolean {
+    return proto3.util.equals(TriggerReportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.TriggerReportResponse
+ */
+export class TriggerReportResponse extends Message<TriggerReportResponse> {
+  constructor(data?: PartialMessage<TriggerReportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.TriggerReportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerReportResponse {
+    return new TriggerReportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerReportResponse {
+    return new TriggerReportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerReportResponse {
+    return new TriggerReportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerReportResponse | PlainMessage<TriggerReportResponse> | undefined, b: TriggerReportResponse | PlainMessage<TriggerReportResponse> | undefined): boolean {
+    return proto3.util.equals(TriggerReportResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GenerateReportYAMLRequest
+ */
+export class GenerateReportYAMLRequest extends Message<GenerateReportYAMLRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: rill.admin.v1.ReportOptions options = 3;
+   */
+  options?: ReportOptions;
+
+  constructor(data?: PartialMessage<GenerateReportYAMLRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GenerateReportYAMLRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "options", kind: "message", T: ReportOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateReportYAMLRequest {
+    return new GenerateReportYAMLRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateReportYAMLRequest {
+    return new GenerateReportYAMLRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateReportYAMLRequest {
+    return new GenerateReportYAMLRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateReportYAMLRequest | PlainMessage<GenerateReportYAMLRequest> | undefined, b: GenerateReportYAMLRequest | PlainMessage<GenerateReportYAMLRequest> | undefined): boolean {
+    return proto3.util.equals(GenerateReportYAMLRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GenerateReportYAMLResponse
+ */
+export class GenerateReportYAMLResponse extends Message<GenerateReportYAMLResponse> {
+  /**
+   * @generated from field: string yaml = 1;
+   */
+  yaml = "";
+
+  constructor(data?: PartialMessage<GenerateReportYAMLResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GenerateReportYAMLResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateReportYAMLResponse {
+    return new GenerateReportYAMLResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateReportYAMLResponse {
+    return new GenerateReportYAMLResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateReportYAMLResponse {
+    return new GenerateReportYAMLResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateReportYAMLResponse | PlainMessage<GenerateReportYAMLResponse> | undefined, b: GenerateReportYAMLResponse | PlainMessage<GenerateReportYAMLResponse> | undefined): boolean {
+    return proto3.util.equals(GenerateReportYAMLResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateAlertRequest
+ */
+export class CreateAlertRequest extends Message<CreateAlertRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: rill.admin.v1.AlertOptions options = 3;
+   */
+  options?: AlertOptions;
+
+  constructor(data?: PartialMessage<CreateAlertRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateAlertRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "options", kind: "message", T: AlertOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAlertRequest {
+    return new CreateAlertRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAlertRequest {
+    return new CreateAlertRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAlertRequest {
+    return new CreateAlertRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateAlertRequest | PlainMessage<CreateAlertRequest> | undefined, b: CreateAlertRequest | PlainMessage<CreateAlertRequest> | undefined): boolean {
+    return proto3.util.equals(CreateAlertRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateAlertResponse
+ */
+export class CreateAlertResponse extends Message<CreateAlertResponse> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<CreateAlertResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateAlertResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAlertResponse {
+    return new CreateAlertResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAlertResponse {
+    return new CreateAlertResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAlertResponse {
+    return new CreateAlertResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateAlertResponse | PlainMessage<CreateAlertResponse> | undefined, b: CreateAlertResponse | PlainMessage<CreateAlertResponse> | undefined): boolean {
+    return proto3.util.equals(CreateAlertResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.EditAlertRequest
+ */
+export class EditAlertRequest extends Message<EditAlertRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: rill.admin.v1.AlertOptions options = 4;
+   */
+  options?: AlertOptions;
+
+  constructor(data?: PartialMessage<EditAlertRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.EditAlertRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "options", kind: "message", T: AlertOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EditAlertRequest {
+    return new EditAlertRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EditAlertRequest {
+    return new EditAlertRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EditAlertRequest {
+    return new EditAlertRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EditAlertRequest | PlainMessage<EditAlertRequest> | undefined, b: EditAlertRequest | PlainMessage<EditAlertRequest> | undefined): boolean {
+    return proto3.util.equals(EditAlertRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.EditAlertResponse
+ */
+export class EditAlertResponse extends Message<EditAlertResponse> {
+  constructor(data?: PartialMessage<EditAlertResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.EditAlertResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EditAlertResponse {
+    return new EditAlertResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EditAlertResponse {
+    return new EditAlertResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EditAlertResponse {
+    return new EditAlertResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EditAlertResponse | PlainMessage<EditAlertResponse> | undefined, b: EditAlertResponse | PlainMessage<EditAlertResponse> | undefined): boolean {
+    return proto3.util.equals(EditAlertResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.UnsubscribeAlertRequest
+ */
+export class UnsubscribeAlertRequest extends Message<UnsubscribeAlertRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<UnsubscribeAlertRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.UnsubscribeAlertRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsubscribeAlertRequest {
+    return new UnsubscribeAlertRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsubscribeAlertRequest {
+    return new UnsubscribeAlertRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsubscribeAlertRequest {
+    return new UnsubscribeAlertRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnsubscribeAlertRequest | PlainMessage<UnsubscribeAlertRequest> | undefined, b: UnsubscribeAlertRequest | PlainMessage<UnsubscribeAlertRequest> | undefined): boolean {
+    return proto3.util.equals(UnsubscribeAlertRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.UnsubscribeAlertResponse
+ */
+export class UnsubscribeAlertResponse extends Message<UnsubscribeAlertResponse> {
+  constructor(data?: PartialMessage<UnsubscribeAlertResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.UnsubscribeAlertResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsubscribeAlertResponse {
+    return new UnsubscribeAlertResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsubscribeAlertResponse {
+    return new UnsubscribeAlertResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsubscribeAlertResponse {
+    return new UnsubscribeAlertResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnsubscribeAlertResponse | PlainMessage<UnsubscribeAlertResponse> | undefined, b: UnsubscribeAlertResponse | PlainMessage<UnsubscribeAlertResponse> | undefined): boolean {
+    return proto3.util.equals(UnsubscribeAlertResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteAlertRequest
+ */
+export class DeleteAlertRequest extends Message<DeleteAlertRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<DeleteAlertRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteAlertRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAlertRequest {
+    return new DeleteAlertRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAlertRequest {
+    return new DeleteAlertRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAlertRequest {
+    return new DeleteAlertRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAlertRequest | PlainMessage<DeleteAlertRequest> | undefined, b: DeleteAlertRequest | PlainMessage<DeleteAlertRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteAlertRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteAlertResponse
+ */
+export class DeleteAlertResponse extends Message<DeleteAlertResponse> {
+  constructor(data?: PartialMessage<DeleteAlertResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteAlertResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAlertResponse {
+    return new DeleteAlertResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAlertResponse {
+    return new DeleteAlertResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAlertResponse {
+    return new DeleteAlertResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAlertResponse | PlainMessage<DeleteAlertResponse> | undefined, b: DeleteAlertResponse | PlainMessage<DeleteAlertResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteAlertResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GenerateAlertYAMLRequest
+ */
+export class GenerateAlertYAMLRequest extends Message<GenerateAlertYAMLRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: rill.admin.v1.AlertOptions options = 3;
+   */
+  options?: AlertOptions;
+
+  constructor(data?: PartialMessage<GenerateAlertYAMLRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GenerateAlertYAMLRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "options", kind: "message", T: AlertOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateAlertYAMLRequest {
+    return new GenerateAlertYAMLRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateAlertYAMLRequest {
+    return new GenerateAlertYAMLRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateAlertYAMLRequest {
+    return new GenerateAlertYAMLRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateAlertYAMLRequest | PlainMessage<GenerateAlertYAMLRequest> | undefined, b: GenerateAlertYAMLRequest | PlainMessage<GenerateAlertYAMLRequest> | undefined): boolean {
+    return proto3.util.equals(GenerateAlertYAMLRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GenerateAlertYAMLResponse
+ */
+export class GenerateAlertYAMLResponse extends Message<GenerateAlertYAMLResponse> {
+  /**
+   * @generated from field: string yaml = 1;
+   */
+  yaml = "";
+
+  constructor(data?: PartialMessage<GenerateAlertYAMLResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GenerateAlertYAMLResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateAlertYAMLResponse {
+    return new GenerateAlertYAMLResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateAlertYAMLResponse {
+    return new GenerateAlertYAMLResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateAlertYAMLResponse {
+    return new GenerateAlertYAMLResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateAlertYAMLResponse | PlainMessage<GenerateAlertYAMLResponse> | undefined, b: GenerateAlertYAMLResponse | PlainMessage<GenerateAlertYAMLResponse> | undefined): boolean {
+    return proto3.util.equals(GenerateAlertYAMLResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetAlertYAMLRequest
+ */
+export class GetAlertYAMLRequest extends Message<GetAlertYAMLRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<GetAlertYAMLRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetAlertYAMLRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlertYAMLRequest {
+    return new GetAlertYAMLRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlertYAMLRequest {
+    return new GetAlertYAMLRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlertYAMLRequest {
+    return new GetAlertYAMLRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAlertYAMLRequest | PlainMessage<GetAlertYAMLRequest> | undefined, b: GetAlertYAMLRequest | PlainMessage<GetAlertYAMLRequest> | undefined): boolean {
+    return proto3.util.equals(GetAlertYAMLRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetAlertYAMLResponse
+ */
+export class GetAlertYAMLResponse extends Message<GetAlertYAMLResponse> {
+  /**
+   * @generated from field: string yaml = 1;
+   */
+  yaml = "";
+
+  constructor(data?: PartialMessage<GetAlertYAMLResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetAlertYAMLResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlertYAMLResponse {
+    return new GetAlertYAMLResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlertYAMLResponse {
+    return new GetAlertYAMLResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlertYAMLResponse {
+    return new GetAlertYAMLResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAlertYAMLResponse | PlainMessage<GetAlertYAMLResponse> | undefined, b: GetAlertYAMLResponse | PlainMessage<GetAlertYAMLResponse> | undefined): boolean {
+    return proto3.util.equals(GetAlertYAMLResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.TelemetryRequest
+ */
+export class TelemetryRequest extends Message<TelemetryRequest> {
+  /**
+   * Name passed to activity module's name arg
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Value passed to activity module's value arg
+   *
+   * @generated from field: float value = 2;
+   */
+  value = 0;
+
+  /**
+   * Free form struct of the actual event
+   *
+   * @generated from field: google.protobuf.Struct event = 3;
+   */
+  event?: Struct;
+
+  constructor(data?: PartialMessage<TelemetryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.TelemetryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 3, name: "event", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TelemetryRequest {
+    return new TelemetryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TelemetryRequest {
+    return new TelemetryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TelemetryRequest {
+    return new TelemetryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TelemetryRequest | PlainMessage<TelemetryRequest> | undefined, b: TelemetryRequest | PlainMessage<TelemetryRequest> | undefined): boolean {
+    return proto3.util.equals(TelemetryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.TelemetryResponse
+ */
+export class TelemetryResponse extends Message<TelemetryResponse> {
+  constructor(data?: PartialMessage<TelemetryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.TelemetryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TelemetryResponse {
+    return new TelemetryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TelemetryResponse {
+    return new TelemetryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TelemetryResponse {
+    return new TelemetryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TelemetryResponse | PlainMessage<TelemetryResponse> | undefined, b: TelemetryResponse | PlainMessage<TelemetryResponse> | undefined): boolean {
+    return proto3.util.equals(TelemetryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.User
+ */
+export class User extends Message<User> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email = "";
+
+  /**
+   * @generated from field: string display_name = 3;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: string photo_url = 4;
+   */
+  photoUrl = "";
+
+  /**
+   * @generated from field: rill.admin.v1.UserQuotas quotas = 5;
+   */
+  quotas?: UserQuotas;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 6;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 7;
+   */
+  updatedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<User>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.User";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "photo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "quotas", kind: "message", T: UserQuotas },
+    { no: 6, name: "created_on", kind: "message", T: Timestamp },
+    { no: 7, name: "updated_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): User {
+    return new User().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): User {
+    return new User().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): User {
+    return new User().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: User | PlainMessage<User> | undefined, b: User | PlainMessage<User> | undefined): boolean {
+    return proto3.util.equals(User, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.Service
+ */
+export class Service extends Message<Service> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
   /**
-   * Name passed to activity module's name arg
-   *
-   * @generated from field: string name = 1;
+   * @generated from field: string name = 2;
    */
   name = "";
 
   /**
-   * Value passed to activity module's value arg
-   *
-   * @generated from field: float value = 2;
+   * @generated from field: string org_id = 3;
    */
-  value = 0;
+  orgId = "";
 
   /**
-   * Free form struct of the actual event
+   * @generated from field: string org_name = 4;
+   */
+  orgName = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 5;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 6;
+   */
+  updatedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<Service>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.Service";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "org_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_on", kind: "message", T: Timestamp },
+    { no: 6, name: "updated_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Service {
+    return new Service().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Service {
+    return new Service().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Service {
+    return new Service().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Service | PlainMessage<Service> | undefined, b: Service | PlainMessage<Service> | undefined): boolean {
+    return proto3.util.equals(Service, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.Organization
+ */
+export class Organization extends Message<Organization> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * Globally unique
    *
-   * @generated from field: google.protobuf.Struct event = 3;
+   * @generated from field: string name = 2;
    */
-  event?: Struct;
+  name = "";
 
-  constructor(data?: PartialMessage<TelemetryRequest>) {
+  /**
+   * @generated from field: string description = 3;
+   */
+  description = "";
+
+  /**
+   * @generated from field: rill.admin.v1.OrganizationQuotas quotas = 4;
+   */
+  quotas?: OrganizationQuotas;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 5;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 6;
+   */
+  updatedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<Organization>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.TelemetryRequest";
+  static readonly typeName = "rill.admin.v1.Organization";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "value", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
-    { no: 3, name: "event", kind: "message", T: Struct },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "quotas", kind: "message", T: OrganizationQuotas },
+    { no: 5, name: "created_on", kind: "message", T: Timestamp },
+    { no: 6, name: "updated_on", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TelemetryRequest {
-    return new TelemetryRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Organization {
+    return new Organization().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TelemetryRequest {
-    return new TelemetryRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Organization {
+    return new Organization().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TelemetryRequest {
-    return new TelemetryRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Organization {
+    return new Organization().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TelemetryRequest | PlainMessage<TelemetryRequest> | undefined, b: TelemetryRequest | PlainMessage<TelemetryRequest> | undefined): boolean {
-    return proto3.util.equals(TelemetryRequest, a, b);
+  static equals(a: Organization | PlainMessage<Organization> | undefined, b: Organization | PlainMessage<Organization> | undefined): boolean {
+    return proto3.util.equals(Organization, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.TelemetryResponse
+ * @generated from message rill.admin.v1.UserQuotas
  */
-export class TelemetryResponse extends Message<TelemetryResponse> {
-  constructor(data?: PartialMessage<TelemetryResponse>) {
+export class UserQuotas extends Message<UserQuotas> {
+  /**
+   * @generated from field: uint32 singleuser_orgs = 1;
+   */
+  singleuserOrgs = 0;
+
+  constructor(data?: PartialMessage<UserQuotas>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.TelemetryResponse";
+  static readonly typeName = "rill.admin.v1.UserQuotas";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "singleuser_orgs", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TelemetryResponse {
-    return new TelemetryResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UserQuotas {
+    return new UserQuotas().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TelemetryResponse {
-    return new TelemetryResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UserQuotas {
+    return new UserQuotas().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TelemetryResponse {
-    return new TelemetryResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UserQuotas {
+    return new UserQuotas().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TelemetryResponse | PlainMessage<TelemetryResponse> | undefined, b: TelemetryResponse | PlainMessage<TelemetryResponse> | undefined): boolean {
-    return proto3.util.equals(TelemetryResponse, a, b);
+  static equals(a: UserQuotas | PlainMessage<UserQuotas> | undefined, b: UserQuotas | PlainMessage<UserQuotas> | undefined): boolean {
+    return proto3.util.equals(UserQuotas, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.User
+ * @generated from message rill.admin.v1.OrganizationQuotas
  */
-export class User extends Message<User> {
+export class OrganizationQuotas extends Message<OrganizationQuotas> {
+  /**
+   * @generated from field: uint32 projects = 1;
+   */
+  projects = 0;
+
+  /**
+   * @generated from field: uint32 deployments = 2;
+   */
+  deployments = 0;
+
+  /**
+   * @generated from field: uint32 slots_total = 3;
+   */
+  slotsTotal = 0;
+
+  /**
+   * @generated from field: uint32 slots_per_deployment = 4;
+   */
+  slotsPerDeployment = 0;
+
+  /**
+   * @generated from field: uint32 outstanding_invites = 5;
+   */
+  outstandingInvites = 0;
+
+  constructor(data?: PartialMessage<OrganizationQuotas>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.OrganizationQuotas";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "projects", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "deployments", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "slots_total", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "slots_per_deployment", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "outstanding_invites", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationQuotas {
+    return new OrganizationQuotas().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationQuotas {
+    return new OrganizationQuotas().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationQuotas {
+    return new OrganizationQuotas().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OrganizationQuotas | PlainMessage<OrganizationQuotas> | undefined, b: OrganizationQuotas | PlainMessage<OrganizationQuotas> | undefined): boolean {
+    return proto3.util.equals(OrganizationQuotas, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.Project
+ */
+export class Project extends Message<Project> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * Unique in organization
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string org_id = 3;
+   */
+  orgId = "";
+
+  /**
+   * @generated from field: string org_name = 4;
+   */
+  orgName = "";
+
+  /**
+   * @generated from field: string description = 5;
+   */
+  description = "";
+
+  /**
+   * @generated from field: bool public = 6;
+   */
+  public = false;
+
+  /**
+   * @generated from field: string created_by_user_id = 22;
+   */
+  createdByUserId = "";
+
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: string provisioner = 7;
    */
-  id = "";
+  provisioner = "";
 
   /**
-   * @generated from field: string email = 2;
+   * @generated from field: string github_url = 8;
    */
-  email = "";
+  githubUrl = "";
 
   /**
-   * @generated from field: string display_name = 3;
+   * @generated from field: string subpath = 17;
    */
-  displayName = "";
+  subpath = "";
 
   /**
-   * @generated from field: string photo_url = 4;
+   * @generated from field: string prod_branch = 9;
    */
-  photoUrl = "";
+  prodBranch = "";
 
   /**
-   * @generated from field: rill.admin.v1.UserQuotas quotas = 5;
+   * @generated from field: string prod_olap_driver = 10;
    */
-  quotas?: UserQuotas;
+  prodOlapDriver = "";
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_on = 6;
+   * @generated from field: string prod_olap_dsn = 11;
    */
-  createdOn?: Timestamp;
+  prodOlapDsn = "";
 
   /**
-   * @generated from field: google.protobuf.Timestamp updated_on = 7;
+   * @generated from field: int64 prod_slots = 12;
    */
-  updatedOn?: Timestamp;
-
-  constructor(data?: PartialMessage<User>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
-
-  static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.User";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "photo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "quotas", kind: "message", T: UserQuotas },
-    { no: 6, name: "created_on", kind: "message", T: Timestamp },
-    { no: 7, name: "updated_on", kind: "message", T: Timestamp },
-  ]);
-
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): User {
-    return new User().fromBinary(bytes, options);
-  }
-
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): User {
-    return new User().fromJson(jsonValue, options);
-  }
+  prodSlots = protoInt64.zero;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): User {
-    return new User().fromJsonString(jsonString, options);
-  }
+  /**
+   * @generated from field: string prod_deployment_id = 13;
+   */
+  prodDeploymentId = "";
 
-  static equals(a: User | PlainMessage<User> | undefined, b: User | PlainMessage<User> | undefined): boolean {
-    return proto3.util.equals(User, a, b);
-  }
-}
+  /**
+   * @generated from field: string frontend_url = 16;
+   */
+  frontendUrl = "";
 
-/**
- * @generated from message rill.admin.v1.Service
- */
-export class Service extends Message<Service> {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: int64 prod_ttl_seconds = 18;
    */
-  id = "";
+  prodTtlSeconds = protoInt64.zero;
 
   /**
-   * @generated from field: string name = 2;
+   * @generated from field: map<string, string> annotations = 20;
    */
-  name = "";
+  annotations: { [key: string]: string } = {};
 
   /**
-   * @generated from field: string org_id = 3;
+   * @generated from field: string prod_version = 21;
    */
-  orgId = "";
+  prodVersion = "";
 
   /**
-   * @generated from field: string org_name = 4;
+   * If true, a preview deployment is created for every pull request opened against the project's Github repository.
+   *
+   * @generated from field: bool preview_deployments = 23;
    */
-  orgName = "";
+  previewDeployments = false;
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_on = 5;
+   * @generated from field: google.protobuf.Timestamp created_on = 14;
    */
   createdOn?: Timestamp;
 
   /**
-   * @generated from field: google.protobuf.Timestamp updated_on = 6;
+   * @generated from field: google.protobuf.Timestamp updated_on = 15;
    */
   updatedOn?: Timestamp;
 
-  constructor(data?: PartialMessage<Service>) {
+  constructor(data?: PartialMessage<Project>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.Service";
+  static readonly typeName = "rill.admin.v1.Project";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "org_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "created_on", kind: "message", T: Timestamp },
-    { no: 6, name: "updated_on", kind: "message", T: Timestamp },
+    { no: 5, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "public", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 22, name: "created_by_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "provisioner", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "github_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 17, name: "subpath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "prod_branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "prod_olap_driver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "prod_olap_dsn", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "prod_slots", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 13, name: "prod_deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 16, name: "frontend_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 18, name: "prod_ttl_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 20, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 21, name: "prod_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 23, name: "preview_deployments", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 14, name: "created_on", kind: "message", T: Timestamp },
+    { no: 15, name: "updated_on", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Service {
-    return new Service().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Project {
+    return new Project().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Service {
-    return new Service().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Project {
+    return new Project().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Service {
-    return new Service().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Project {
+    return new Project().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Service | PlainMessage<Service> | undefined, b: Service | PlainMessage<Service> | undefined): boolean {
-    return proto3.util.equals(Service, a, b);
+  static equals(a: Project | PlainMessage<Project> | undefined, b: Project | PlainMessage<Project> | undefined): boolean {
+    return proto3.util.equals(Project, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.Organization
+ * @generated from message rill.admin.v1.Deployment
  */
-export class Organization extends Message<Organization> {
+export class Deployment extends Message<Deployment> {
   /**
    * @generated from field: string id = 1;
    */
   id = "";
 
   /**
-   * Globally unique
-   *
-   * @generated from field: string name = 2;
+   * @generated from field: string project_id = 2;
    */
-  name = "";
+  projectId = "";
 
   /**
-   * @generated from field: string description = 3;
+   * @generated from field: int64 slots = 3;
    */
-  description = "";
+  slots = protoInt64.zero;
 
   /**
-   * @generated from field: rill.admin.v1.OrganizationQuotas quotas = 4;
+   * @generated from field: string branch = 4;
    */
-  quotas?: OrganizationQuotas;
+  branch = "";
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_on = 5;
+   * @generated from field: string runtime_host = 5;
    */
-  createdOn?: Timestamp;
+  runtimeHost = "";
 
   /**
-   * @generated from field: google.protobuf.Timestamp updated_on = 6;
+   * @generated from field: string runtime_instance_id = 6;
    */
-  updatedOn?: Timestamp;
-
-  constructor(data?: PartialMessage<Organization>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
+  runtimeInstanceId = "";
 
-  static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.Organization";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "quotas", kind: "message", T: OrganizationQuotas },
-    { no: 5, name: "created_on", kind: "message", T: Timestamp },
-    { no: 6, name: "updated_on", kind: "message", T: Timestamp },
-  ]);
+  /**
+   * @generated from field: rill.admin.v1.DeploymentStatus status = 7;
+   */
+  status = DeploymentStatus.UNSPECIFIED;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Organization {
-    return new Organization().fromBinary(bytes, options);
-  }
+  /**
+   * @generated from field: string status_message = 8;
+   */
+  statusMessage = "";
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Organization {
-    return new Organization().fromJson(jsonValue, options);
-  }
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 9;
+   */
+  createdOn?: Timestamp;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Organization {
-    return new Organization().fromJsonString(jsonString, options);
-  }
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 10;
+   */
+  updatedOn?: Timestamp;
 
-  static equals(a: Organization | PlainMessage<Organization> | undefined, b: Organization | PlainMessage<Organization> | undefined): boolean {
-    return proto3.util.equals(Organization, a, b);
-  }
-}
+  /**
+   * Number of the Github pull request for preview deployments. It's 0 for the prod deployment.
+   *
+   * @generated from field: int64 pull_request = 11;
+   */
+  pullRequest = protoInt64.zero;
 
-/**
- * @generated from message rill.admin.v1.UserQuotas
- */
-export class UserQuotas extends Message<UserQuotas> {
   /**
-   * @generated from field: uint32 singleuser_orgs = 1;
+   * Time when a preview deployment is torn down if its pull request is still open.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_on = 12;
    */
-  singleuserOrgs = 0;
+  expiresOn?: Timestamp;
 
-  constructor(data?: PartialMessage<UserQuotas>) {
+  constructor(data?: PartialMessage<Deployment>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.UserQuotas";
+  static readonly typeName = "rill.admin.v1.Deployment";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "singleuser_orgs", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "slots", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "runtime_host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "runtime_instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "status", kind: "enum", T: proto3.getEnumType(DeploymentStatus) },
+    { no: 8, name: "status_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "created_on", kind: "message", T: Timestamp },
+    { no: 10, name: "updated_on", kind: "message", T: Timestamp },
+    { no: 11, name: "pull_request", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 12, name: "expires_on", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UserQuotas {
-    return new UserQuotas().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Deployment {
+    return new Deployment().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UserQuotas {
-    return new UserQuotas().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Deployment {
+    return new Deployment().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UserQuotas {
-    return new UserQuotas().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Deployment {
+    return new Deployment().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UserQuotas | PlainMessage<UserQuotas> | undefined, b: UserQuotas | PlainMessage<UserQuotas> | undefined): boolean {
-    return proto3.util.equals(UserQuotas, a, b);
+  static equals(a: Deployment | PlainMessage<Deployment> | undefined, b: Deployment | PlainMessage<Deployment> | undefined): boolean {
+    return proto3.util.equals(Deployment, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.OrganizationQuotas
+ * @generated from message rill.admin.v1.OrganizationPermissions
  */
-export class OrganizationQuotas extends Message<OrganizationQuotas> {
+export class OrganizationPermissions extends Message<OrganizationPermissions> {
+  /**
+   * @generated from field: bool read_org = 1;
+   */
+  readOrg = false;
+
+  /**
+   * @generated from field: bool manage_org = 2;
+   */
+  manageOrg = false;
+
   /**
-   * @generated from field: uint32 projects = 1;
+   * @generated from field: bool read_projects = 3;
    */
-  projects = 0;
+  readProjects = false;
 
   /**
-   * @generated from field: uint32 deployments = 2;
+   * @generated from field: bool create_projects = 4;
    */
-  deployments = 0;
+  createProjects = false;
 
   /**
-   * @generated from field: uint32 slots_total = 3;
+   * @generated from field: bool manage_projects = 5;
    */
-  slotsTotal = 0;
+  manageProjects = false;
 
   /**
-   * @generated from field: uint32 slots_per_deployment = 4;
+   * @generated from field: bool read_org_members = 6;
    */
-  slotsPerDeployment = 0;
+  readOrgMembers = false;
 
   /**
-   * @generated from field: uint32 outstanding_invites = 5;
+   * @generated from field: bool manage_org_members = 7;
    */
-  outstandingInvites = 0;
+  manageOrgMembers = false;
 
-  constructor(data?: PartialMessage<OrganizationQuotas>) {
+  constructor(data?: PartialMessage<OrganizationPermissions>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.OrganizationQuotas";
+  static readonly typeName = "rill.admin.v1.OrganizationPermissions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "projects", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 2, name: "deployments", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 3, name: "slots_total", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 4, name: "slots_per_deployment", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 5, name: "outstanding_invites", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 1, name: "read_org", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "manage_org", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "read_projects", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "create_projects", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "manage_projects", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "read_org_members", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "manage_org_members", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationQuotas {
-    return new OrganizationQuotas().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationPermissions {
+    return new OrganizationPermissions().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationQuotas {
-    return new OrganizationQuotas().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationPermissions {
+    return new OrganizationPermissions().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationQuotas {
-    return new OrganizationQuotas().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationPermissions {
+    return new OrganizationPermissions().fromJsonString(jsonString, options);
   }
 
-  static equals(a: OrganizationQuotas | PlainMessage<OrganizationQuotas> | undefined, b: OrganizationQuotas | PlainMessage<OrganizationQuotas> | undefined): boolean {
-    return proto3.util.equals(OrganizationQuotas, a, b);
+  static equals(a: OrganizationPermissions | PlainMessage<OrganizationPermissions> | undefined, b: OrganizationPermissions | PlainMessage<OrganizationPermissions> | undefined): boolean {
+    return proto3.util.equals(OrganizationPermissions, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.Project
+ * @generated from message rill.admin.v1.ProjectPermissions
  */
-export class Project extends Message<Project> {
+export class ProjectPermissions extends Message<ProjectPermissions> {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: bool read_project = 1;
    */
-  id = "";
+  readProject = false;
 
   /**
-   * Unique in organization
-   *
-   * @generated from field: string name = 2;
+   * @generated from field: bool manage_project = 2;
    */
-  name = "";
+  manageProject = false;
 
   /**
-   * @generated from field: string org_id = 3;
+   * @generated from field: bool read_prod = 3;
    */
-  orgId = "";
+  readProd = false;
 
   /**
-   * @generated from field: string org_name = 4;
+   * @generated from field: bool read_prod_status = 4;
    */
-  orgName = "";
+  readProdStatus = false;
 
   /**
-   * @generated from field: string description = 5;
+   * @generated from field: bool manage_prod = 5;
    */
-  description = "";
+  manageProd = false;
 
   /**
-   * @generated from field: bool public = 6;
+   * @generated from field: bool read_dev = 6;
    */
-  public = false;
+  readDev = false;
 
   /**
-   * @generated from field: string created_by_user_id = 22;
+   * @generated from field: bool read_dev_status = 7;
    */
-  createdByUserId = "";
+  readDevStatus = false;
 
   /**
-   * @generated from field: string provisioner = 7;
+   * @generated from field: bool manage_dev = 8;
    */
-  provisioner = "";
+  manageDev = false;
 
   /**
-   * @generated from field: string github_url = 8;
+   * @generated from field: bool read_project_members = 9;
    */
-  githubUrl = "";
+  readProjectMembers = false;
 
   /**
-   * @generated from field: string subpath = 17;
+   * @generated from field: bool manage_project_members = 10;
    */
-  subpath = "";
+  manageProjectMembers = false;
 
   /**
-   * @generated from field: string prod_branch = 9;
+   * @generated from field: bool create_reports = 11;
    */
-  prodBranch = "";
+  createReports = false;
 
   /**
-   * @generated from field: string prod_olap_driver = 10;
+   * @generated from field: bool manage_reports = 12;
    */
-  prodOlapDriver = "";
+  manageReports = false;
 
   /**
-   * @generated from field: string prod_olap_dsn = 11;
+   * @generated from field: bool create_alerts = 13;
    */
-  prodOlapDsn = "";
+  createAlerts = false;
 
   /**
-   * @generated from field: int64 prod_slots = 12;
+   * @generated from field: bool manage_alerts = 14;
    */
-  prodSlots = protoInt64.zero;
+  manageAlerts = false;
 
-  /**
-   * @generated from field: string prod_deployment_id = 13;
-   */
-  prodDeploymentId = "";
+  constructor(data?: PartialMessage<ProjectPermissions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ProjectPermissions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "read_project", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "manage_project", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "read_prod", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "read_prod_status", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "manage_prod", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "read_dev", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "read_dev_status", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "manage_dev", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "read_project_members", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "manage_project_members", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "create_reports", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 12, name: "manage_reports", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 13, name: "create_alerts", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 14, name: "manage_alerts", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectPermissions {
+    return new ProjectPermissions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectPermissions {
+    return new ProjectPermissions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectPermissions {
+    return new ProjectPermissions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProjectPermissions | PlainMessage<ProjectPermissions> | undefined, b: ProjectPermissions | PlainMessage<ProjectPermissions> | undefined): boolean {
+    return proto3.util.equals(ProjectPermissions, a, b);
+  }
+}
 
+/**
+ * @generated from message rill.admin.v1.OrganizationRole
+ */
+export class OrganizationRole extends Message<OrganizationRole> {
   /**
-   * @generated from field: string frontend_url = 16;
+   * @generated from field: string id = 1;
    */
-  frontendUrl = "";
+  id = "";
 
   /**
-   * @generated from field: int64 prod_ttl_seconds = 18;
+   * @generated from field: string name = 2;
    */
-  prodTtlSeconds = protoInt64.zero;
+  name = "";
 
   /**
-   * @generated from field: map<string, string> annotations = 20;
+   * True for the built-in roles (admin, collaborator and viewer), which are available in all organizations and can't be changed.
+   *
+   * @generated from field: bool builtin = 3;
    */
-  annotations: { [key: string]: string } = {};
+  builtin = false;
 
   /**
-   * @generated from field: string prod_version = 21;
+   * @generated from field: rill.admin.v1.OrganizationPermissions permissions = 4;
    */
-  prodVersion = "";
+  permissions?: OrganizationPermissions;
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_on = 14;
+   * @generated from field: google.protobuf.Timestamp created_on = 5;
    */
   createdOn?: Timestamp;
 
   /**
-   * @generated from field: google.protobuf.Timestamp updated_on = 15;
+   * @generated from field: google.protobuf.Timestamp updated_on = 6;
    */
   updatedOn?: Timestamp;
 
-  constructor(data?: PartialMessage<Project>) {
+  constructor(data?: PartialMessage<OrganizationRole>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.Project";
+  static readonly typeName = "rill.admin.v1.OrganizationRole";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "org_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "public", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 22, name: "created_by_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "provisioner", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 8, name: "github_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 17, name: "subpath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 9, name: "prod_branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 10, name: "prod_olap_driver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 11, name: "prod_olap_dsn", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 12, name: "prod_slots", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
-    { no: 13, name: "prod_deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 16, name: "frontend_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 18, name: "prod_ttl_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
-    { no: 20, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
-    { no: 21, name: "prod_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 14, name: "created_on", kind: "message", T: Timestamp },
-    { no: 15, name: "updated_on", kind: "message", T: Timestamp },
+    { no: 3, name: "builtin", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "permissions", kind: "message", T: OrganizationPermissions },
+    { no: 5, name: "created_on", kind: "message", T: Timestamp },
+    { no: 6, name: "updated_on", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Project {
-    return new Project().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationRole {
+    return new OrganizationRole().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Project {
-    return new Project().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationRole {
+    return new OrganizationRole().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Project {
-    return new Project().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationRole {
+    return new OrganizationRole().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Project | PlainMessage<Project> | undefined, b: Project | PlainMessage<Project> | undefined): boolean {
-    return proto3.util.equals(Project, a, b);
+  static equals(a: OrganizationRole | PlainMessage<OrganizationRole> | undefined, b: OrganizationRole | PlainMessage<OrganizationRole> | undefined): boolean {
+    return proto3.util.equals(OrganizationRole, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.Deployment
+ * @generated from message rill.admin.v1.ProjectRole
  */
-export class Deployment extends Message<Deployment> {
+export class ProjectRole extends Message<ProjectRole> {
   /**
    * @generated from field: string id = 1;
    */
   id = "";
 
   /**
-   * @generated from field: string project_id = 2;
-   */
-  projectId = "";
-
-  /**
-   * @generated from field: int64 slots = 3;
-   */
-  slots = protoInt64.zero;
-
-  /**
-   * @generated from field: string branch = 4;
-   */
-  branch = "";
-
-  /**
-   * @generated from field: string runtime_host = 5;
-   */
-  runtimeHost = "";
-
-  /**
-   * @generated from field: string runtime_instance_id = 6;
+   * @generated from field: string name = 2;
    */
-  runtimeInstanceId = "";
+  name = "";
 
   /**
-   * @generated from field: rill.admin.v1.DeploymentStatus status = 7;
+   * True for the built-in roles (admin, collaborator and viewer), which are available in all organizations and can't be changed.
+   *
+   * @generated from field: bool builtin = 3;
    */
-  status = DeploymentStatus.UNSPECIFIED;
+  builtin = false;
 
   /**
-   * @generated from field: string status_message = 8;
+   * @generated from field: rill.admin.v1.ProjectPermissions permissions = 4;
    */
-  statusMessage = "";
+  permissions?: ProjectPermissions;
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_on = 9;
+   * @generated from field: google.protobuf.Timestamp created_on = 5;
    */
   createdOn?: Timestamp;
 
   /**
-   * @generated from field: google.protobuf.Timestamp updated_on = 10;
+   * @generated from field: google.protobuf.Timestamp updated_on = 6;
    */
   updatedOn?: Timestamp;
 
-  constructor(data?: PartialMessage<Deployment>) {
+  constructor(data?: PartialMessage<ProjectRole>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.Deployment";
+  static readonly typeName = "rill.admin.v1.ProjectRole";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "slots", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
-    { no: 4, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "runtime_host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "runtime_instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "status", kind: "enum", T: proto3.getEnumType(DeploymentStatus) },
-    { no: 8, name: "status_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 9, name: "created_on", kind: "message", T: Timestamp },
-    { no: 10, name: "updated_on", kind: "message", T: Timestamp },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "builtin", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "permissions", kind: "message", T: ProjectPermissions },
+    { no: 5, name: "created_on", kind: "message", T: Timestamp },
+    { no: 6, name: "updated_on", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Deployment {
-    return new Deployment().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectRole {
+    return new ProjectRole().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Deployment {
-    return new Deployment().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectRole {
+    return new ProjectRole().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Deployment {
-    return new Deployment().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectRole {
+    return new ProjectRole().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Deployment | PlainMessage<Deployment> | undefined, b: Deployment | PlainMessage<Deployment> | undefined): boolean {
-    return proto3.util.equals(Deployment, a, b);
+  static equals(a: ProjectRole | PlainMessage<ProjectRole> | undefined, b: ProjectRole | PlainMessage<ProjectRole> | undefined): boolean {
+    return proto3.util.equals(ProjectRole, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.OrganizationPermissions
+ * @generated from message rill.admin.v1.Member
  */
-export class OrganizationPermissions extends Message<OrganizationPermissions> {
-  /**
-   * @generated from field: bool read_org = 1;
-   */
-  readOrg = false;
-
+export class Member extends Message<Member> {
   /**
-   * @generated from field: bool manage_org = 2;
+   * @generated from field: string user_id = 1;
    */
-  manageOrg = false;
+  userId = "";
 
   /**
-   * @generated from field: bool read_projects = 3;
+   * @generated from field: string user_email = 3;
    */
-  readProjects = false;
+  userEmail = "";
 
   /**
-   * @generated from field: bool create_projects = 4;
+   * @generated from field: string user_name = 2;
    */
-  createProjects = false;
+  userName = "";
 
   /**
-   * @generated from field: bool manage_projects = 5;
+   * @generated from field: string role_name = 4;
    */
-  manageProjects = false;
+  roleName = "";
 
   /**
-   * @generated from field: bool read_org_members = 6;
+   * @generated from field: google.protobuf.Timestamp created_on = 5;
    */
-  readOrgMembers = false;
+  createdOn?: Timestamp;
 
   /**
-   * @generated from field: bool manage_org_members = 7;
+   * @generated from field: google.protobuf.Timestamp updated_on = 6;
    */
-  manageOrgMembers = false;
+  updatedOn?: Timestamp;
 
-  constructor(data?: PartialMessage<OrganizationPermissions>) {
+  constructor(data?: PartialMessage<Member>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.OrganizationPermissions";
+  static readonly typeName = "rill.admin.v1.Member";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "read_org", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 2, name: "manage_org", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 3, name: "read_projects", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 4, name: "create_projects", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 5, name: "manage_projects", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 6, name: "read_org_members", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 7, name: "manage_org_members", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "user_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_on", kind: "message", T: Timestamp },
+    { no: 6, name: "updated_on", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationPermissions {
-    return new OrganizationPermissions().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Member {
+    return new Member().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationPermissions {
-    return new OrganizationPermissions().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Member {
+    return new Member().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationPermissions {
-    return new OrganizationPermissions().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Member {
+    return new Member().fromJsonString(jsonString, options);
   }
 
-  static equals(a: OrganizationPermissions | PlainMessage<OrganizationPermissions> | undefined, b: OrganizationPermissions | PlainMessage<OrganizationPermissions> | undefined): boolean {
-    return proto3.util.equals(OrganizationPermissions, a, b);
+  static equals(a: Member | PlainMessage<Member> | undefined, b: Member | PlainMessage<Member> | undefined): boolean {
+    return proto3.util.equals(Member, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ProjectPermissions
+ * @generated from message rill.admin.v1.Usergroup
  */
-export class ProjectPermissions extends Message<ProjectPermissions> {
+export class Usergroup extends Message<Usergroup> {
   /**
-   * @generated from field: bool read_project = 1;
+   * @generated from field: string group_id = 1;
    */
-  readProject = false;
+  groupId = "";
 
   /**
-   * @generated from field: bool manage_project = 2;
+   * @generated from field: string group_name = 2;
    */
-  manageProject = false;
+  groupName = "";
 
   /**
-   * @generated from field: bool read_prod = 3;
+   * True for the group that all members of the organization are automatically added to.
+   * Its name and members can't be changed.
+   *
+   * @generated from field: bool managed = 3;
    */
-  readProd = false;
+  managed = false;
 
   /**
-   * @generated from field: bool read_prod_status = 4;
+   * @generated from field: google.protobuf.Timestamp created_on = 4;
    */
-  readProdStatus = false;
+  createdOn?: Timestamp;
 
   /**
-   * @generated from field: bool manage_prod = 5;
+   * @generated from field: google.protobuf.Timestamp updated_on = 5;
    */
-  manageProd = false;
+  updatedOn?: Timestamp;
 
-  /**
-   * @generated from field: bool read_dev = 6;
-   */
-  readDev = false;
+  constructor(data?: PartialMessage<Usergroup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * @generated from field: bool read_dev_status = 7;
-   */
-  readDevStatus = false;
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.Usergroup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "group_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "managed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "created_on", kind: "message", T: Timestamp },
+    { no: 5, name: "updated_on", kind: "message", T: Timestamp },
+  ]);
 
-  /**
-   * @generated from field: bool manage_dev = 8;
-   */
-  manageDev = false;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Usergroup {
+    return new Usergroup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Usergroup {
+    return new Usergroup().fromJson(jsonValue, options);
+  }
 
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Usergroup {
+    return new Usergroup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Usergroup | PlainMessage<Usergroup> | undefined, b: Usergroup | PlainMessage<Usergroup> | undefined): boolean {
+    return proto3.util.equals(Usergroup, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.MemberUsergroup
+ */
+export class MemberUsergroup extends Message<MemberUsergroup> {
   /**
-   * @generated from field: bool read_project_members = 9;
+   * @generated from field: string group_id = 1;
    */
-  readProjectMembers = false;
+  groupId = "";
 
   /**
-   * @generated from field: bool manage_project_members = 10;
+   * @generated from field: string group_name = 2;
    */
-  manageProjectMembers = false;
+  groupName = "";
 
   /**
-   * @generated from field: bool create_reports = 11;
+   * Role of the group in the org or project. Empty if the group is listed for an org but has no org role.
+   *
+   * @generated from field: string role_name = 3;
    */
-  createReports = false;
+  roleName = "";
 
   /**
-   * @generated from field: bool manage_reports = 12;
+   * @generated from field: bool managed = 4;
    */
-  manageReports = false;
+  managed = false;
 
   /**
-   * @generated from field: bool create_alerts = 13;
+   * @generated from field: google.protobuf.Timestamp created_on = 5;
    */
-  createAlerts = false;
+  createdOn?: Timestamp;
 
   /**
-   * @generated from field: bool manage_alerts = 14;
+   * @generated from field: google.protobuf.Timestamp updated_on = 6;
    */
-  manageAlerts = false;
+  updatedOn?: Timestamp;
 
-  constructor(data?: PartialMessage<ProjectPermissions>) {
+  constructor(data?: PartialMessage<MemberUsergroup>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ProjectPermissions";
+  static readonly typeName = "rill.admin.v1.MemberUsergroup";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "read_project", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 2, name: "manage_project", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 3, name: "read_prod", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 4, name: "read_prod_status", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 5, name: "manage_prod", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 6, name: "read_dev", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 7, name: "read_dev_status", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 8, name: "manage_dev", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 9, name: "read_project_members", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 10, name: "manage_project_members", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 11, name: "create_reports", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 12, name: "manage_reports", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 13, name: "create_alerts", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 14, name: "manage_alerts", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "group_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "managed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "created_on", kind: "message", T: Timestamp },
+    { no: 6, name: "updated_on", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectPermissions {
-    return new ProjectPermissions().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MemberUsergroup {
+    return new MemberUsergroup().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectPermissions {
-    return new ProjectPermissions().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MemberUsergroup {
+    return new MemberUsergroup().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectPermissions {
-    return new ProjectPermissions().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MemberUsergroup {
+    return new MemberUsergroup().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ProjectPermissions | PlainMessage<ProjectPermissions> | undefined, b: ProjectPermissions | PlainMessage<ProjectPermissions> | undefined): boolean {
-    return proto3.util.equals(ProjectPermissions, a, b);
+  static equals(a: MemberUsergroup | PlainMessage<MemberUsergroup> | undefined, b: MemberUsergroup | PlainMessage<MemberUsergroup> | undefined): boolean {
+    return proto3.util.equals(MemberUsergroup, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.Member
+ * MemberUser is a user in a user group.
+ *
+ * @generated from message rill.admin.v1.MemberUser
  */
-export class Member extends Message<Member> {
+export class MemberUser extends Message<MemberUser> {
   /**
    * @generated from field: string user_id = 1;
    */
   userId = "";
 
   /**
-   * @generated from field: string user_email = 3;
+   * @generated from field: string user_email = 2;
    */
   userEmail = "";
 
   /**
-   * @generated from field: string user_name = 2;
+   * @generated from field: string user_name = 3;
    */
   userName = "";
 
   /**
-   * @generated from field: string role_name = 4;
-   */
-  roleName = "";
-
-  /**
-   * @generated from field: google.protobuf.Timestamp created_on = 5;
+   * @generated from field: google.protobuf.Timestamp created_on = 4;
    */
   createdOn?: Timestamp;
 
-  /**
-   * @generated from field: google.protobuf.Timestamp updated_on = 6;
-   */
-  updatedOn?: Timestamp;
-
-  constructor(data?: PartialMessage<Member>) {
+  constructor(data?: PartialMessage<MemberUser>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.Member";
+  static readonly typeName = "rill.admin.v1.MemberUser";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "user_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "user_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "role_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "created_on", kind: "message", T: Timestamp },
-    { no: 6, name: "updated_on", kind: "message", T: Timestamp },
+    { no: 2, name: "user_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "user_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_on", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Member {
-    return new Member().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MemberUser {
+    return new MemberUser().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Member {
-    return new Member().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MemberUser {
+    return new MemberUser().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Member {
-    return new Member().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MemberUser {
+    return new MemberUser().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Member | PlainMessage<Member> | undefined, b: Member | PlainMessage<Member> | undefined): boolean {
-    return proto3.util.equals(Member, a, b);
+  static equals(a: MemberUser | PlainMessage<MemberUser> | undefined, b: MemberUser | PlainMessage<MemberUser> | undefined): boolean {
+    return proto3.util.equals(MemberUser, a, b);
   }
 }
 
@@ -8207,6 +10629,109 @@ export class WhitelistedDomain extends Message<WhitelistedDomain> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.AuditEvent
+ */
+export class AuditEvent extends Message<AuditEvent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string project_name = 3;
+   */
+  projectName = "";
+
+  /**
+   * Type of the actor that made the change. One of "user", "service", "deployment" or "system".
+   *
+   * @generated from field: string actor_type = 4;
+   */
+  actorType = "";
+
+  /**
+   * @generated from field: string actor_id = 5;
+   */
+  actorId = "";
+
+  /**
+   * Email of the user or name of the service that made the change.
+   *
+   * @generated from field: string actor_name = 6;
+   */
+  actorName = "";
+
+  /**
+   * @generated from field: bool actor_superuser = 7;
+   */
+  actorSuperuser = false;
+
+  /**
+   * @generated from field: string action = 8;
+   */
+  action = "";
+
+  /**
+   * The member email, service name, domain or other identifier of what was changed.
+   *
+   * @generated from field: string target = 9;
+   */
+  target = "";
+
+  /**
+   * @generated from field: google.protobuf.Struct details = 10;
+   */
+  details?: Struct;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 11;
+   */
+  createdOn?: Timestamp;
+
+  constructor(data?: PartialMessage<AuditEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AuditEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "project_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "actor_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "actor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "actor_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "actor_superuser", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "target", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "details", kind: "message", T: Struct },
+    { no: 11, name: "created_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEvent {
+    return new AuditEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEvent | PlainMessage<AuditEvent> | undefined, b: AuditEvent | PlainMessage<AuditEvent> | undefined): boolean {
+    return proto3.util.equals(AuditEvent, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.Bookmark
  */
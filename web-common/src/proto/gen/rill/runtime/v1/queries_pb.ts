@@ -348,6 +348,98 @@ export class QueryBatchResponse extends Message<QueryBatchResponse> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.StreamAPIRequest
+ */
+export class StreamAPIRequest extends Message<StreamAPIRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * Name of the custom API to resolve
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Args to pass to the API's resolver
+   *
+   * @generated from field: google.protobuf.Struct args = 3;
+   */
+  args?: Struct;
+
+  constructor(data?: PartialMessage<StreamAPIRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.StreamAPIRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "args", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamAPIRequest {
+    return new StreamAPIRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamAPIRequest {
+    return new StreamAPIRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamAPIRequest {
+    return new StreamAPIRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StreamAPIRequest | PlainMessage<StreamAPIRequest> | undefined, b: StreamAPIRequest | PlainMessage<StreamAPIRequest> | undefined): boolean {
+    return proto3.util.equals(StreamAPIRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.StreamAPIResponse
+ */
+export class StreamAPIResponse extends Message<StreamAPIResponse> {
+  /**
+   * Data is a chunk of the Arrow IPC stream. The first message contains the schema.
+   *
+   * @generated from field: bytes data = 1;
+   */
+  data = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<StreamAPIResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.StreamAPIResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamAPIResponse {
+    return new StreamAPIResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamAPIResponse {
+    return new StreamAPIResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamAPIResponse {
+    return new StreamAPIResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StreamAPIResponse | PlainMessage<StreamAPIResponse> | undefined, b: StreamAPIResponse | PlainMessage<StreamAPIResponse> | undefined): boolean {
+    return proto3.util.equals(StreamAPIResponse, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.ExportRequest
  */
@@ -664,6 +664,28 @@ export class SourceState extends Message<SourceState> {
    */
   refreshedOn?: Timestamp;
 
+  /**
+   * Streaming is true if the source's connector continuously receives new data, such as a Kafka topic.
+   *
+   * @generated from field: bool streaming = 5;
+   */
+  streaming = false;
+
+  /**
+   * State persisted by the source's connector between refreshes, such as the consumed offsets of a Kafka topic.
+   * If it is set, refreshes append to the existing table. It is cleared when the source is fully re-ingested.
+   *
+   * @generated from field: google.protobuf.Struct incremental_state = 6;
+   */
+  incrementalState?: Struct;
+
+  /**
+   * Progress of the current or last ingestion.
+   *
+   * @generated from field: rill.runtime.v1.ExecutionProgress progress = 7;
+   */
+  progress?: ExecutionProgress;
+
   constructor(data?: PartialMessage<SourceState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -676,6 +698,9 @@ export class SourceState extends Message<SourceState> {
     { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "spec_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "refreshed_on", kind: "message", T: Timestamp },
+    { no: 5, name: "streaming", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "incremental_state", kind: "message", T: Struct },
+    { no: 7, name: "progress", kind: "message", T: ExecutionProgress },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SourceState {
@@ -695,6 +720,88 @@ export class SourceState extends Message<SourceState> {
   }
 }
 
+/**
+ * ExecutionProgress is the progress of a source ingestion or model execution.
+ * The targets are 0 if they are not known, and not all executors report all units.
+ *
+ * @generated from message rill.runtime.v1.ExecutionProgress
+ */
+export class ExecutionProgress extends Message<ExecutionProgress> {
+  /**
+   * @generated from field: int64 current_bytes = 1;
+   */
+  currentBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 target_bytes = 2;
+   */
+  targetBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 current_files = 3;
+   */
+  currentFiles = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 target_files = 4;
+   */
+  targetFiles = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 current_records = 5;
+   */
+  currentRecords = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 target_records = 6;
+   */
+  targetRecords = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_on = 7;
+   */
+  startedOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 8;
+   */
+  updatedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<ExecutionProgress>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ExecutionProgress";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "current_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "target_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "current_files", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "target_files", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "current_records", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "target_records", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "started_on", kind: "message", T: Timestamp },
+    { no: 8, name: "updated_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExecutionProgress {
+    return new ExecutionProgress().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExecutionProgress {
+    return new ExecutionProgress().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExecutionProgress {
+    return new ExecutionProgress().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExecutionProgress | PlainMessage<ExecutionProgress> | undefined, b: ExecutionProgress | PlainMessage<ExecutionProgress> | undefined): boolean {
+    return proto3.util.equals(ExecutionProgress, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.ModelV2
  */
@@ -878,6 +985,21 @@ export class ModelState extends Message<ModelState> {
    */
   incrementalStateSchema?: StructType;
 
+  /**
+   * Partitions overwritten by the last execution.
+   * Only set for incremental runs of models that use the partition_overwrite incremental strategy.
+   *
+   * @generated from field: repeated string partitions_touched = 10;
+   */
+  partitionsTouched: string[] = [];
+
+  /**
+   * Progress of the current or last execution.
+   *
+   * @generated from field: rill.runtime.v1.ExecutionProgress progress = 11;
+   */
+  progress?: ExecutionProgress;
+
   constructor(data?: PartialMessage<ModelState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -895,6 +1017,8 @@ export class ModelState extends Message<ModelState> {
     { no: 4, name: "refreshed_on", kind: "message", T: Timestamp },
     { no: 7, name: "incremental_state", kind: "message", T: Struct },
     { no: 8, name: "incremental_state_schema", kind: "message", T: StructType },
+    { no: 10, name: "partitions_touched", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 11, name: "progress", kind: "message", T: ExecutionProgress },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelState {
@@ -1266,6 +1390,13 @@ export class MetricsViewSpec_DimensionV2 extends Message<MetricsViewSpec_Dimensi
    */
   unnest = false;
 
+  /**
+   * If set, the dimension assigns each entity to a bucket instead of using column or expression
+   *
+   * @generated from field: rill.runtime.v1.MetricsViewSpec.DimensionBucket bucket = 7;
+   */
+  bucket?: MetricsViewSpec_DimensionBucket;
+
   constructor(data?: PartialMessage<MetricsViewSpec_DimensionV2>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1280,6 +1411,7 @@ export class MetricsViewSpec_DimensionV2 extends Message<MetricsViewSpec_Dimensi
     { no: 3, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "unnest", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "bucket", kind: "message", T: MetricsViewSpec_DimensionBucket },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_DimensionV2 {
@@ -1299,6 +1431,87 @@ export class MetricsViewSpec_DimensionV2 extends Message<MetricsViewSpec_Dimensi
   }
 }
 
+/**
+ * Bucketing of entities based on a measure aggregated per entity
+ *
+ * @generated from message rill.runtime.v1.MetricsViewSpec.DimensionBucket
+ */
+export class MetricsViewSpec_DimensionBucket extends Message<MetricsViewSpec_DimensionBucket> {
+  /**
+   * Name of the dimension that identifies an entity
+   *
+   * @generated from field: string entity_dimension = 1;
+   */
+  entityDimension = "";
+
+  /**
+   * Name of the simple measure to aggregate per entity
+   *
+   * @generated from field: string measure = 2;
+   */
+  measure = "";
+
+  /**
+   * Optional SQL expression to filter the rows aggregated per entity
+   *
+   * @generated from field: string where = 3;
+   */
+  where = "";
+
+  /**
+   * Ascending bin boundaries. A value belongs to the first bin with a boundary greater than the value.
+   *
+   * @generated from field: repeated double bins = 4;
+   */
+  bins: number[] = [];
+
+  /**
+   * Number of equal-sized quantile buckets to assign entities to (only if bins is empty)
+   *
+   * @generated from field: uint32 quantiles = 5;
+   */
+  quantiles = 0;
+
+  /**
+   * Optional labels for the buckets. If set, there must be one label per bucket.
+   *
+   * @generated from field: repeated string labels = 6;
+   */
+  labels: string[] = [];
+
+  constructor(data?: PartialMessage<MetricsViewSpec_DimensionBucket>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewSpec.DimensionBucket";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entity_dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "measure", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "where", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "bins", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, repeated: true },
+    { no: 5, name: "quantiles", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "labels", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_DimensionBucket {
+    return new MetricsViewSpec_DimensionBucket().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewSpec_DimensionBucket {
+    return new MetricsViewSpec_DimensionBucket().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewSpec_DimensionBucket {
+    return new MetricsViewSpec_DimensionBucket().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewSpec_DimensionBucket | PlainMessage<MetricsViewSpec_DimensionBucket> | undefined, b: MetricsViewSpec_DimensionBucket | PlainMessage<MetricsViewSpec_DimensionBucket> | undefined): boolean {
+    return proto3.util.equals(MetricsViewSpec_DimensionBucket, a, b);
+  }
+}
+
 /**
  * Selector for a dimension
  *
@@ -2010,6 +2223,27 @@ export class ReportSpec extends Message<ReportSpec> {
    */
   intervalsCheckUnclosed = false;
 
+  /**
+   * If true, the exported data is attached to notifications (when supported by the notifier).
+   *
+   * @generated from field: bool export_attach = 16;
+   */
+  exportAttach = false;
+
+  /**
+   * Max size in bytes of an attachment. If the export exceeds it, notifications fall back to only including a download link.
+   *
+   * @generated from field: uint64 export_attach_max_bytes = 17;
+   */
+  exportAttachMaxBytes = protoInt64.zero;
+
+  /**
+   * Number of rows of the query result to include as a preview table in notifications. If 0, no preview is included.
+   *
+   * @generated from field: uint32 preview_rows = 18;
+   */
+  previewRows = 0;
+
   constructor(data?: PartialMessage<ReportSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2032,6 +2266,9 @@ export class ReportSpec extends Message<ReportSpec> {
     { no: 13, name: "intervals_iso_duration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 14, name: "intervals_limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 15, name: "intervals_check_unclosed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 16, name: "export_attach", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 17, name: "export_attach_max_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 18, name: "preview_rows", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSpec {
@@ -2324,6 +2561,13 @@ export class AlertSpec extends Message<AlertSpec> {
    */
   annotations: { [key: string]: string } = {};
 
+  /**
+   * Number of rows of the query result to include as a preview table in failure notifications. If 0, no preview is included.
+   *
+   * @generated from field: uint32 preview_rows = 22;
+   */
+  previewRows = 0;
+
   constructor(data?: PartialMessage<AlertSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2352,6 +2596,7 @@ export class AlertSpec extends Message<AlertSpec> {
     { no: 19, name: "renotify_after_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
     { no: 21, name: "notifiers", kind: "message", T: Notifier, repeated: true },
     { no: 20, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 22, name: "preview_rows", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertSpec {
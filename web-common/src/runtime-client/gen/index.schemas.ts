@@ -301,6 +301,17 @@ export type QueryServiceColumnCardinalityParams = {
   priority?: number;
 };
 
+export type QueryServiceStreamAPI200 = {
+  result?: V1StreamAPIResponse;
+  error?: RpcStatus;
+};
+
+export type QueryServiceStreamAPIBodyArgs = { [key: string]: any };
+
+export type QueryServiceStreamAPIBody = {
+  args?: QueryServiceStreamAPIBodyArgs;
+};
+
 export type RuntimeServiceWatchLogs200 = {
   result?: V1WatchLogsResponse;
   error?: RpcStatus;
@@ -658,11 +669,29 @@ export interface V1StructType {
   fields?: StructTypeField[];
 }
 
+export interface V1StreamAPIResponse {
+  /** Data is a chunk of the Arrow IPC stream. The first message contains the schema. */
+  data?: string;
+}
+
+/**
+ * State persisted by the source's connector between refreshes, such as the consumed offsets of a Kafka topic.
+If it is set, refreshes append to the existing table. It is cleared when the source is fully re-ingested.
+ */
+export type V1SourceStateIncrementalState = { [key: string]: any };
+
 export interface V1SourceState {
   connector?: string;
   table?: string;
   specHash?: string;
   refreshedOn?: string;
+  /** Streaming is true if the source's connector continuously receives new data, such as a Kafka topic. */
+  streaming?: boolean;
+  /** State persisted by the source's connector between refreshes, such as the consumed offsets of a Kafka topic.
+If it is set, refreshes append to the existing table. It is cleared when the source is fully re-ingested. */
+  incrementalState?: V1SourceStateIncrementalState;
+  /** Progress of the current or last ingestion. */
+  progress?: V1ExecutionProgress;
 }
 
 export type V1SourceSpecProperties = { [key: string]: any };
@@ -788,6 +817,12 @@ export interface V1ReportSpec {
   intervalsIsoDuration?: string;
   intervalsLimit?: number;
   intervalsCheckUnclosed?: boolean;
+  /** If true, the exported data is attached to notifications (when supported by the notifier). */
+  exportAttach?: boolean;
+  /** Max size in bytes of an attachment. If the export exceeds it, notifications fall back to only including a download link. */
+  exportAttachMaxBytes?: string;
+  /** Number of rows of the query result to include as a preview table in notifications. If 0, no preview is included. */
+  previewRows?: number;
 }
 
 export interface V1ReportExecution {
@@ -1023,6 +1058,11 @@ export interface V1ModelState {
   refreshedOn?: string;
   incrementalState?: V1ModelStateIncrementalState;
   incrementalStateSchema?: V1StructType;
+  /** Partitions overwritten by the last execution.
+Only set for incremental runs of models that use the partition_overwrite incremental strategy. */
+  partitionsTouched?: string[];
+  /** Progress of the current or last execution. */
+  progress?: V1ExecutionProgress;
 }
 
 export interface V1ModelV2 {
@@ -1592,6 +1632,21 @@ export const V1ExportFormat = {
   EXPORT_FORMAT_PARQUET: "EXPORT_FORMAT_PARQUET",
 } as const;
 
+/**
+ * ExecutionProgress is the progress of a source ingestion or model execution.
+The targets are 0 if they are not known, and not all executors report all units.
+ */
+export interface V1ExecutionProgress {
+  currentBytes?: string;
+  targetBytes?: string;
+  currentFiles?: string;
+  targetFiles?: string;
+  currentRecords?: string;
+  targetRecords?: string;
+  startedOn?: string;
+  updatedOn?: string;
+}
+
 /**
  * Example contains metadata about an example project that is available for unpacking.
  */
@@ -2030,6 +2085,8 @@ export interface V1AlertSpec {
   renotifyAfterSeconds?: number;
   notifiers?: V1Notifier[];
   annotations?: V1AlertSpecAnnotations;
+  /** Number of rows of the query result to include as a preview table in failure notifications. If 0, no preview is included. */
+  previewRows?: number;
 }
 
 export interface V1AlertExecution {
@@ -2202,6 +2259,18 @@ export interface MetricsViewSpecDimensionV2 {
   label?: string;
   description?: string;
   unnest?: boolean;
+  bucket?: MetricsViewSpecDimensionBucket;
+}
+
+export interface MetricsViewSpecDimensionBucket {
+  entityDimension?: string;
+  measure?: string;
+  where?: string;
+  /** Ascending bin boundaries. A value belongs to the first bin with a boundary greater than the value. */
+  bins?: number[];
+  quantiles?: number;
+  /** Optional labels for the buckets. If set, there must be one label per bucket. */
+  labels?: string[];
 }
 
 export interface MetricsViewSpecDimensionSelector {
@@ -14,8 +14,10 @@ import type {
   QueryKey,
 } from "@tanstack/svelte-query";
 import type {
-  V1ColumnCardinalityResponse,
+  QueryServiceStreamAPI200,
   RpcStatus,
+  QueryServiceStreamAPIBody,
+  V1ColumnCardinalityResponse,
   QueryServiceColumnCardinalityParams,
   V1TableColumnsResponse,
   QueryServiceTableColumnsParams,
@@ -71,6 +73,70 @@ type AwaitedInput<T> = PromiseLike<T> | T;
 
 type Awaited<O> = O extends AwaitedInput<infer T> ? T : never;
 
+/**
+ * @summary StreamAPI resolves a custom API and streams the result in the Arrow IPC streaming format.
+Concatenating the data of all the response messages produces a valid Arrow IPC stream.
+ */
+export const queryServiceStreamAPI = (
+  instanceId: string,
+  name: string,
+  queryServiceStreamAPIBody: QueryServiceStreamAPIBody,
+) => {
+  return httpClient<QueryServiceStreamAPI200>({
+    url: `/v1/instances/${instanceId}/queries/api/${name}/stream`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: queryServiceStreamAPIBody,
+  });
+};
+
+export type QueryServiceStreamAPIMutationResult = NonNullable<
+  Awaited<ReturnType<typeof queryServiceStreamAPI>>
+>;
+export type QueryServiceStreamAPIMutationBody = QueryServiceStreamAPIBody;
+export type QueryServiceStreamAPIMutationError = ErrorType<RpcStatus>;
+
+export const createQueryServiceStreamAPI = <
+  TError = ErrorType<RpcStatus>,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof queryServiceStreamAPI>>,
+    TError,
+    {
+      instanceId: string;
+      name: string;
+      data: QueryServiceStreamAPIBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof queryServiceStreamAPI>>,
+    {
+      instanceId: string;
+      name: string;
+      data: QueryServiceStreamAPIBody;
+    }
+  > = (props) => {
+    const { instanceId, name, data } = props ?? {};
+
+    return queryServiceStreamAPI(instanceId, name, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof queryServiceStreamAPI>>,
+    TError,
+    {
+      instanceId: string;
+      name: string;
+      data: QueryServiceStreamAPIBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary Get cardinality for a column
  */